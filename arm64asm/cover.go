@@ -0,0 +1,64 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import "sync/atomic"
+
+var (
+	coverOn      int32                              // atomic; nonzero while recording coverage
+	decoderCover = make([]uint64, len(instFormats)) // atomic; match counts for instFormats
+)
+
+// cover records a match of instFormats[i], if recording is on.
+func cover(i int) {
+	if atomic.LoadInt32(&coverOn) != 0 {
+		atomic.AddUint64(&decoderCover[i], 1)
+	}
+}
+
+// SetCoverage turns the recording of decoding table coverage on or off.
+// Recording is off by default. While it is on, each successful call to Decode
+// counts the decoding table entry that produced the instruction.
+// It is safe to decode from multiple goroutines while recording.
+func SetCoverage(on bool) {
+	v := int32(0)
+	if on {
+		v = 1
+	}
+	atomic.StoreInt32(&coverOn, v)
+}
+
+// ResetCoverage sets all the coverage counts to zero.
+func ResetCoverage() {
+	for i := range decoderCover {
+		atomic.StoreUint64(&decoderCover[i], 0)
+	}
+}
+
+// A CoverageEntry reports the coverage of a single decoding table entry.
+type CoverageEntry struct {
+	Op     Op
+	Mask   uint32
+	Value  uint32
+	Syntax string // assembly syntax, like "ADD <Rd|SP>, <Rn|SP>, #<imm>{, LSL #12}"
+	Count  uint64 // number of instructions decoded using the entry
+}
+
+// Coverage returns the coverage of every decoding table entry, in table order.
+// Entries with a zero Count were not used while recording was on.
+func Coverage() []CoverageEntry {
+	list := make([]CoverageEntry, len(instFormats))
+	for i := range instFormats {
+		f := &instFormats[i]
+		list[i] = CoverageEntry{
+			Op:     f.op,
+			Mask:   f.mask,
+			Value:  f.value,
+			Syntax: instSyntax[i],
+			Count:  atomic.LoadUint64(&decoderCover[i]),
+		}
+	}
+	return list
+}
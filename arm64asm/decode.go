@@ -0,0 +1,980 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// An instFormat describes the format of an instruction encoding.
+// An instruction with 32-bit value x matches the format if x&mask == value
+// and every argument decodes successfully.
+// Formats are tried in table order and the first match wins,
+// so the table lists preferred aliases before the instructions they alias.
+// Alias conditions that cannot be expressed as a mask, such as
+// Rn == Rm for ROR, are checked by the argument decoders.
+// args is stored as a fixed-size array; if there are fewer than len(args) arguments,
+// args[i] == 0 marks the end of the argument list.
+type instFormat struct {
+	mask  uint32
+	value uint32
+	op    Op
+	args  instArgs
+}
+
+type instArgs [5]instArg
+
+var (
+	errShort   = fmt.Errorf("truncated instruction")
+	errUnknown = fmt.Errorf("unknown instruction")
+)
+
+// Decode decodes the leading bytes in src as a single A64 instruction.
+func Decode(src []byte) (inst Inst, err error) {
+	if len(src) < 4 {
+		return Inst{}, errShort
+	}
+
+	inst, i := decode(binary.LittleEndian.Uint32(src))
+	if i < 0 {
+		return Inst{}, errUnknown
+	}
+	cover(i)
+	return inst, nil
+}
+
+// decode decodes the A64 instruction x,
+// returning the instruction and the index of the
+// instFormats entry that produced it, or -1 if none did.
+func decode(x uint32) (Inst, int) {
+Search:
+	for i := range instFormats {
+		f := &instFormats[i]
+		if x&f.mask != f.value {
+			continue
+		}
+		var args Args
+		for j, aop := range f.args {
+			if aop == 0 {
+				break
+			}
+			arg := decodeArg(aop, x)
+			if arg == nil { // cannot decode argument
+				continue Search
+			}
+			args[j] = arg
+		}
+
+		return Inst{
+			Op:   f.op,
+			Enc:  x,
+			Args: args,
+		}, i
+	}
+	return Inst{}, -1
+}
+
+// An instArg describes the encoding of a single argument.
+// Register arguments are named for the ARM manual's field names:
+// Rd at bit 0, Rn at bit 5, Rt2 and Ra at bit 10, Rm and Rs at bit 16.
+// A W or X prefix fixes the register width; an R prefix takes the width
+// from the sf bit (bit 31), or from the bit given by a _30 or _31 suffix.
+// The _SP suffix means register 31 is the stack pointer rather than
+// the zero register.
+// The Z and P arguments are SVE vector and predicate registers.
+// A _T suffix takes the element size from the size field (bits 22-23),
+// and a _D suffix fixes it at 64 bits. The governing predicate Pg
+// is at bit 10, with a /M or /Z qualifier given by an _M or _Z suffix;
+// Pg4 is the 4-bit form.
+// The V arguments are Advanced SIMD vector registers, with an arrangement
+// taken from the Q bit (bit 30) and, for a _T suffix, the size field;
+// the F arguments are SIMD and floating-point scalar registers.
+// The rest should be somewhat self-explanatory, at least given
+// the decodeArg function.
+type instArg uint8
+
+const (
+	_ instArg = iota
+	arg_Dd
+	arg_Fd_across
+	arg_Ft2_pair
+	arg_Ft_ldst
+	arg_Ft_lit
+	arg_Ft_pair
+	arg_Pd_B
+	arg_Pd_T
+	arg_Pg
+	arg_Pg4
+	arg_Pg4_M
+	arg_Pg_M
+	arg_Pg_Z
+	arg_Pt
+	arg_Ra
+	arg_Rd
+	arg_Rd_SP
+	arg_Rd_SP_movsp
+	arg_Rd_smov
+	arg_Rd_umov
+	arg_Rd_umov_mov
+	arg_Rm
+	arg_Rm_SP
+	arg_Rm_extend
+	arg_Rm_sf12
+	arg_Rm_shift
+	arg_Rm_shift_arith
+	arg_Rn
+	arg_Rn_SP
+	arg_Rn_SP_sz
+	arg_Rn_dup
+	arg_Rn_eq_Rm
+	arg_Rn_sf12
+	arg_Rs1_pair
+	arg_Rs_30
+	arg_Rs_pair
+	arg_Rt2_30
+	arg_Rt1_pair
+	arg_Rt2_31
+	arg_Rt_30
+	arg_Rt_31
+	arg_Rt_pair
+	arg_Vd_2D
+	arg_Vd_B
+	arg_Vd_H
+	arg_Vd_S
+	arg_Vd_T
+	arg_Vd_T_BHS
+	arg_Vd_Tfp
+	arg_Vd_dup
+	arg_Vd_elem
+	arg_Vm_B
+	arg_Vm_T
+	arg_Vm_Tfp
+	arg_Vn_B
+	arg_Vn_B_eq_Vm
+	arg_Vn_T
+	arg_Vn_Tfp
+	arg_Vn_elem
+	arg_Vn_elem_ins
+	arg_Vt_list
+	arg_Wd
+	arg_Wm
+	arg_Wn
+	arg_Ws
+	arg_Wt
+	arg_Xa
+	arg_Xd
+	arg_Xm
+	arg_Xn
+	arg_Xt
+	arg_Xt2
+	arg_Zd_D
+	arg_Zd_T
+	arg_Zd_T_eq_Zm
+	arg_Zd_T_fp
+	arg_Zm_D
+	arg_Zm_T
+	arg_Zn_D
+	arg_Zn_D_eq_Zm
+	arg_Zn_T
+	arg_Zt
+	arg_Zt_list
+	arg_barrier
+	arg_bfiz_lsb
+	arg_bfiz_width
+	arg_bfx_lsb
+	arg_bfx_width
+	arg_bitmask
+	arg_bitmask_mov
+	arg_bti
+	arg_cond_0
+	arg_cond_12
+	arg_cond_12_inv
+	arg_crm
+	arg_hint
+	arg_imm12_shift
+	arg_imm16
+	arg_imm16_hw
+	arg_imm5_16
+	arg_immr
+	arg_immr_shift
+	arg_imms
+	arg_imms_lsb
+	arg_imms_ror
+	arg_label14
+	arg_label19
+	arg_label26
+	arg_label_adr
+	arg_label_adrp
+	arg_lsl_shift
+	arg_mem_Xn_SP
+	arg_mem_extend
+	arg_mem_pac_offset
+	arg_mem_pac_preindex
+	arg_mem_post_Xm
+	arg_mem_post_list
+	arg_mem_simm7_offset
+	arg_mem_simm7_postindex
+	arg_mem_simm7_preindex
+	arg_mem_simm9_offset
+	arg_mem_simm9_postindex
+	arg_mem_simm9_preindex
+	arg_mem_sve_Xm
+	arg_mem_sve_imm4
+	arg_mem_sve_imm9
+	arg_mem_uimm12
+	arg_movn_imm
+	arg_movz_imm
+	arg_nzcv
+	arg_prfop
+	arg_pstate
+	arg_simd_imm64
+	arg_simd_imm8
+	arg_simd_imm8_lsl
+	arg_sve_dup_imm
+	arg_sve_mul
+	arg_sve_pattern
+	arg_sysreg
+	arg_tbz_bit
+)
+
+// decodeArg decodes the arg described by aop from the instruction bits x.
+// It returns nil if x cannot be decoded according to aop.
+func decodeArg(aop instArg, x uint32) Arg {
+	sf := x>>31 == 1
+	rd := x & (1<<5 - 1)
+	rn := (x >> 5) & (1<<5 - 1)
+	ra := (x >> 10) & (1<<5 - 1)
+	rm := (x >> 16) & (1<<5 - 1)
+
+	switch aop {
+	default:
+		return nil
+
+	case arg_Rd:
+		return reg(rd, sf, false)
+	case arg_Rd_SP:
+		return reg(rd, sf, true)
+	case arg_Rd_SP_movsp:
+		// MOV to or from SP is ADD #0 with Rd or Rn the stack pointer.
+		if rd != 31 && rn != 31 {
+			return nil
+		}
+		return reg(rd, sf, true)
+	case arg_Rn:
+		return reg(rn, sf, false)
+	case arg_Rn_SP:
+		return reg(rn, sf, true)
+	case arg_Rn_eq_Rm:
+		if rn != rm {
+			return nil
+		}
+		return reg(rn, sf, false)
+	case arg_Rm:
+		return reg(rm, sf, false)
+	case arg_Rm_SP:
+		return reg(rm, sf, true)
+	case arg_Ra:
+		return reg(ra, sf, false)
+
+	case arg_Wd:
+		return reg(rd, false, false)
+	case arg_Wn:
+		return reg(rn, false, false)
+	case arg_Wm:
+		return reg(rm, false, false)
+	case arg_Ws:
+		return reg(rm, false, false)
+	case arg_Wt:
+		return reg(rd, false, false)
+	case arg_Xd:
+		return reg(rd, true, false)
+	case arg_Xn:
+		return reg(rn, true, false)
+	case arg_Xm:
+		return reg(rm, true, false)
+	case arg_Xa:
+		return reg(ra, true, false)
+	case arg_Xt:
+		return reg(rd, true, false)
+	case arg_Xt2:
+		return reg(ra, true, false)
+
+	case arg_Rt_30:
+		return reg(rd, x>>30&1 == 1, false)
+	case arg_Rt2_30:
+		return reg(ra, x>>30&1 == 1, false)
+	case arg_Rt_31:
+		return reg(rd, sf, false)
+	case arg_Rt2_31:
+		return reg(ra, sf, false)
+
+	case arg_Rs_30:
+		return reg(rm, x>>30&1 == 1, false)
+	case arg_Rs_pair, arg_Rs1_pair, arg_Rt_pair, arg_Rt1_pair:
+		// CASP operates on even-numbered consecutive register pairs.
+		n := rm
+		if aop == arg_Rt_pair || aop == arg_Rt1_pair {
+			n = rd
+		}
+		if n&1 != 0 {
+			return nil
+		}
+		if aop == arg_Rs1_pair || aop == arg_Rt1_pair {
+			n++
+		}
+		return reg(n, x>>30&1 == 1, false)
+
+	case arg_Rm_shift, arg_Rm_shift_arith:
+		typ := Shift((x >> 22) & (1<<2 - 1))
+		amount := (x >> 10) & (1<<6 - 1)
+		if !sf && amount >= 32 || aop == arg_Rm_shift_arith && typ == ShiftROR {
+			return nil
+		}
+		return RegShift{reg(rm, sf, false), typ, uint8(amount)}
+
+	case arg_Rm_extend:
+		option := (x >> 13) & (1<<3 - 1)
+		amount := (x >> 10) & (1<<3 - 1)
+		if amount > 4 {
+			return nil
+		}
+		r := RegExtend{Reg: reg(rm, sf && option&3 == 3, false), Extend: Extend(option), Amount: uint8(amount)}
+		// When the stack pointer is involved, UXTW (32-bit) or UXTX (64-bit)
+		// is written LSL, and omitted entirely if there is no shift.
+		s := x>>29&1 == 1
+		if (rd == 31 && !s || rn == 31) && r.Extend == ExtendUXTW|Extend(x>>31) {
+			r.Extend = ExtendLSL
+		}
+		return r
+
+	case arg_imm12_shift:
+		return ImmShift{uint16((x >> 10) & (1<<12 - 1)), uint8(12 * ((x >> 22) & 1))}
+
+	case arg_bitmask:
+		v, ok := decodeBitMask(x)
+		if !ok {
+			return nil
+		}
+		return Imm64(v)
+
+	case arg_bitmask_mov:
+		v, ok := decodeBitMask(x)
+		if !ok || moveWidePreferred(x) {
+			return nil
+		}
+		return Imm64(v)
+
+	case arg_imm16_hw:
+		hw := (x >> 21) & (1<<2 - 1)
+		if !sf && hw >= 2 {
+			return nil
+		}
+		return ImmShift{uint16(x >> 5), uint8(16 * hw)}
+
+	case arg_movz_imm, arg_movn_imm:
+		hw := (x >> 21) & (1<<2 - 1)
+		imm16 := uint64((x >> 5) & (1<<16 - 1))
+		if !sf && hw >= 2 || imm16 == 0 && hw != 0 {
+			return nil
+		}
+		v := imm16 << (16 * hw)
+		if aop == arg_movn_imm {
+			if !sf && imm16 == 0xffff {
+				return nil
+			}
+			v = ^v
+			if !sf {
+				v &= 1<<32 - 1
+			}
+		}
+		return Imm64(v)
+
+	case arg_immr, arg_imms, arg_immr_shift, arg_lsl_shift,
+		arg_bfiz_lsb, arg_bfiz_width, arg_bfx_lsb, arg_bfx_width:
+		size := uint32(32)
+		if sf {
+			size = 64
+		}
+		immr := (x >> 16) & (1<<6 - 1)
+		imms := (x >> 10) & (1<<6 - 1)
+		if immr >= size || imms >= size {
+			return nil
+		}
+		var v uint32
+		switch aop {
+		case arg_immr:
+			v = immr
+		case arg_imms:
+			v = imms
+		case arg_immr_shift:
+			// ASR or LSR: imms == size-1.
+			if imms != size-1 {
+				return nil
+			}
+			v = immr
+		case arg_lsl_shift:
+			// LSL: imms != size-1 && imms+1 == immr.
+			if imms == size-1 || imms+1 != immr {
+				return nil
+			}
+			v = size - 1 - imms
+		case arg_bfiz_lsb, arg_bfiz_width:
+			// Insert in zero: imms < immr.
+			if imms >= immr {
+				return nil
+			}
+			v = -immr & (size - 1)
+			if aop == arg_bfiz_width {
+				v = imms + 1
+			}
+		case arg_bfx_lsb, arg_bfx_width:
+			// Extract: imms >= immr.
+			if imms < immr {
+				return nil
+			}
+			v = immr
+			if aop == arg_bfx_width {
+				v = imms - immr + 1
+			}
+		}
+		return Imm{v, true}
+
+	case arg_imms_lsb:
+		imms := (x >> 10) & (1<<6 - 1)
+		if !sf && imms >= 32 {
+			return nil
+		}
+		return Imm{imms, true}
+
+	case arg_imms_ror:
+		imms := (x >> 10) & (1<<6 - 1)
+		if rn != rm || !sf && imms >= 32 {
+			return nil
+		}
+		return Imm{imms, true}
+
+	case arg_cond_0:
+		return Cond(x & (1<<4 - 1))
+	case arg_cond_12:
+		return Cond((x >> 12) & (1<<4 - 1))
+	case arg_cond_12_inv:
+		// CSET, CINC, and the like cannot use AL or NV.
+		cond := (x >> 12) & (1<<4 - 1)
+		if cond>>1 == 7 {
+			return nil
+		}
+		return Cond(cond ^ 1)
+
+	case arg_nzcv:
+		return Imm{x & (1<<4 - 1), true}
+	case arg_imm5_16:
+		return Imm{(x >> 16) & (1<<5 - 1), false}
+
+	case arg_label26:
+		return PCRel(signExtend(x&(1<<26-1), 26) * 4)
+	case arg_label19:
+		return PCRel(signExtend((x>>5)&(1<<19-1), 19) * 4)
+	case arg_label14:
+		return PCRel(signExtend((x>>5)&(1<<14-1), 14) * 4)
+	case arg_label_adr, arg_label_adrp:
+		imm := (x>>5)&(1<<19-1)<<2 | (x>>29)&(1<<2-1)
+		v := signExtend(imm, 21)
+		if aop == arg_label_adrp {
+			v <<= 12
+		}
+		return PCRel(v)
+
+	case arg_tbz_bit:
+		return Imm{x>>31<<5 | (x>>19)&(1<<5-1), true}
+
+	case arg_imm16:
+		return Imm{(x >> 5) & (1<<16 - 1), false}
+	case arg_bti:
+		// BTI with no targets has its own format.
+		if (x>>6)&3 == 0 {
+			return nil
+		}
+		return BTITarget((x >> 6) & 3)
+	case arg_hint:
+		return Imm{(x >> 5) & (1<<7 - 1), false}
+	case arg_crm:
+		return Imm{(x >> 8) & (1<<4 - 1), false}
+	case arg_barrier:
+		return BarrierOpt((x >> 8) & (1<<4 - 1))
+	case arg_prfop:
+		return Imm{rd, false}
+	case arg_pstate:
+		return PState((x>>16)&(1<<3-1)<<3 | (x>>5)&(1<<3-1))
+	case arg_sysreg:
+		return Sysreg{
+			Op0: uint8(2 + (x>>19)&1),
+			Op1: uint8((x >> 16) & (1<<3 - 1)),
+			CRn: uint8((x >> 12) & (1<<4 - 1)),
+			CRm: uint8((x >> 8) & (1<<4 - 1)),
+			Op2: uint8((x >> 5) & (1<<3 - 1)),
+		}
+
+	case arg_Ft_ldst:
+		// Size and opc<1> select B, H, S, D, or Q.
+		size := x >> 30
+		if (x>>23)&1 == 1 {
+			if size != 0 {
+				return nil
+			}
+			size = 4
+		}
+		return simdReg(rd, ElemB+ElemSize(size))
+	case arg_Ft_lit, arg_Ft_pair, arg_Ft2_pair:
+		opc := x >> 30
+		if opc == 3 {
+			return nil
+		}
+		if aop == arg_Ft2_pair {
+			rd = ra
+		}
+		return simdReg(rd, ElemS+ElemSize(opc))
+	case arg_Dd:
+		return simdReg(rd, ElemD)
+	case arg_Fd_across:
+		size := (x >> 22) & 3
+		if size == 3 || size == 2 && (x>>30)&1 == 0 {
+			return nil
+		}
+		return simdReg(rd, ElemB+ElemSize(size))
+
+	case arg_Vd_T, arg_Vd_T_BHS:
+		size := (x >> 22) & 3
+		if size == 3 && ((x>>30)&1 == 0 || aop == arg_Vd_T_BHS) {
+			return nil
+		}
+		return VReg{uint8(rd), vecArr(x>>30, size)}
+	case arg_Vn_T:
+		return VReg{uint8(rn), vecArr(x>>30, x>>22)}
+	case arg_Vm_T:
+		return VReg{uint8(rm), vecArr(x>>30, x>>22)}
+	case arg_Vd_Tfp, arg_Vn_Tfp, arg_Vm_Tfp:
+		// The sz bit selects 32-bit or 64-bit elements; there is no 1D form.
+		sz := (x >> 22) & 1
+		if sz == 1 && (x>>30)&1 == 0 {
+			return nil
+		}
+		n := rd
+		switch aop {
+		case arg_Vn_Tfp:
+			n = rn
+		case arg_Vm_Tfp:
+			n = rm
+		}
+		return VReg{uint8(n), vecArr(x>>30, 2+sz)}
+	case arg_Vd_B:
+		return VReg{uint8(rd), vecArr(x>>30, 0)}
+	case arg_Vn_B:
+		return VReg{uint8(rn), vecArr(x>>30, 0)}
+	case arg_Vm_B:
+		return VReg{uint8(rm), vecArr(x>>30, 0)}
+	case arg_Vn_B_eq_Vm:
+		// ORR with Vn == Vm is MOV.
+		if rn != rm {
+			return nil
+		}
+		return VReg{uint8(rn), vecArr(x>>30, 0)}
+	case arg_Vd_H:
+		return VReg{uint8(rd), vecArr(x>>30, 1)}
+	case arg_Vd_S:
+		return VReg{uint8(rd), vecArr(x>>30, 2)}
+	case arg_Vd_2D:
+		return VReg{uint8(rd), vecArr(1, 3)}
+
+	case arg_Vd_dup:
+		size, _, ok := simdElem(x >> 16)
+		if !ok || size == 3 && (x>>30)&1 == 0 {
+			return nil
+		}
+		return VReg{uint8(rd), vecArr(x>>30, size)}
+	case arg_Vd_elem:
+		size, index, ok := simdElem(x >> 16)
+		if !ok {
+			return nil
+		}
+		return VElem{uint8(rd), ElemB + ElemSize(size), uint8(index)}
+	case arg_Vn_elem:
+		size, index, ok := simdElem(x >> 16)
+		if !ok {
+			return nil
+		}
+		return VElem{uint8(rn), ElemB + ElemSize(size), uint8(index)}
+	case arg_Vn_elem_ins:
+		// The source index is in imm4, scaled by the element size.
+		size, _, ok := simdElem(x >> 16)
+		if !ok {
+			return nil
+		}
+		return VElem{uint8(rn), ElemB + ElemSize(size), uint8((x >> 11) & 15 >> size)}
+	case arg_Rn_dup:
+		size, _, ok := simdElem(x >> 16)
+		if !ok {
+			return nil
+		}
+		return reg(rn, size == 3, false)
+	case arg_Rd_umov, arg_Rd_umov_mov:
+		// UMOV to an X register moves a D element, and to a W register
+		// a B, H, or S element. MOV is preferred for S and D.
+		size, _, ok := simdElem(x >> 16)
+		q := (x>>30)&1 == 1
+		if !ok || q != (size == 3) || aop == arg_Rd_umov_mov && size < 2 {
+			return nil
+		}
+		return reg(rd, q, false)
+	case arg_Rd_smov:
+		// SMOV sign-extends a B or H element into a W register,
+		// or a B, H, or S element into an X register.
+		size, _, ok := simdElem(x >> 16)
+		q := (x>>30)&1 == 1
+		if !ok || size == 3 || size == 2 && !q {
+			return nil
+		}
+		return reg(rd, q, false)
+
+	case arg_Vt_list:
+		opcode := (x >> 12) & 15
+		size := (x >> 10) & 3
+		n := vecListLen(opcode)
+		if n == 0 || size == 3 && (x>>30)&1 == 0 && opcode&3 == 0 {
+			// LD2, LD3, and LD4 have no 1D form.
+			return nil
+		}
+		return VRegList{VReg{uint8(rd), vecArr(x>>30, size)}, n}
+	case arg_mem_post_list:
+		total := vecArr(x>>30, 0).Bytes() * int(vecListLen((x>>12)&15))
+		return MemImm{reg(rn, true, true), AddrPostIndex, int32(total)}
+	case arg_mem_post_Xm:
+		// Xm == XZR means post-index by the transfer size.
+		if rm == 31 {
+			return nil
+		}
+		return MemPostReg{reg(rn, true, true), reg(rm, true, false)}
+
+	case arg_simd_imm8:
+		return Imm{simdImm8(x), false}
+	case arg_simd_imm8_lsl:
+		// cmode<2:1> (or cmode<1> for halfwords) gives the shift in bytes.
+		cmode := (x >> 12) & 15
+		shift := cmode >> 1 & 3
+		if cmode&8 != 0 {
+			shift &= 1
+		}
+		return ImmShift{uint16(simdImm8(x)), uint8(shift * 8)}
+	case arg_simd_imm64:
+		// Each bit of imm8 expands to a byte of ones or zeros.
+		imm8 := simdImm8(x)
+		var v uint64
+		for i := uint(0); i < 8; i++ {
+			if imm8>>i&1 != 0 {
+				v |= 0xff << (8 * i)
+			}
+		}
+		return Imm64(v)
+
+	case arg_Zd_T:
+		return ZReg{uint8(rd), elemSize(x >> 22)}
+	case arg_Zd_T_fp:
+		// There are no 8-bit floating-point elements.
+		if (x>>22)&3 == 0 {
+			return nil
+		}
+		return ZReg{uint8(rd), elemSize(x >> 22)}
+	case arg_Zd_T_eq_Zm:
+		// SEL with Zd == Zm is MOV (predicated).
+		if rd != rm {
+			return nil
+		}
+		return ZReg{uint8(rd), elemSize(x >> 22)}
+	case arg_Zn_T:
+		return ZReg{uint8(rn), elemSize(x >> 22)}
+	case arg_Zm_T:
+		return ZReg{uint8(rm), elemSize(x >> 22)}
+	case arg_Zd_D:
+		return ZReg{uint8(rd), ElemD}
+	case arg_Zn_D:
+		return ZReg{uint8(rn), ElemD}
+	case arg_Zn_D_eq_Zm:
+		if rn != rm {
+			return nil
+		}
+		return ZReg{uint8(rn), ElemD}
+	case arg_Zm_D:
+		return ZReg{uint8(rm), ElemD}
+	case arg_Zt:
+		return ZReg{uint8(rd), ElemNone}
+	case arg_Zt_list:
+		// Contiguous loads and stores take the element size from bits 21-22.
+		return ZRegList{ZReg{uint8(rd), elemSize(x >> 21)}, 1}
+
+	case arg_Pd_T:
+		return PReg{uint8(rd & 15), elemSize(x >> 22), PredNone}
+	case arg_Pd_B:
+		return PReg{uint8(rd & 15), ElemB, PredNone}
+	case arg_Pt:
+		return PReg{uint8(rd & 15), ElemNone, PredNone}
+	case arg_Pg:
+		return PReg{uint8((x >> 10) & 7), ElemNone, PredNone}
+	case arg_Pg_M:
+		return PReg{uint8((x >> 10) & 7), ElemNone, PredMerge}
+	case arg_Pg_Z:
+		return PReg{uint8((x >> 10) & 7), ElemNone, PredZero}
+	case arg_Pg4:
+		return PReg{uint8((x >> 10) & 15), ElemNone, PredNone}
+	case arg_Pg4_M:
+		return PReg{uint8((x >> 10) & 15), ElemNone, PredMerge}
+
+	case arg_Rn_sf12:
+		return reg(rn, (x>>12)&1 == 1, false)
+	case arg_Rm_sf12:
+		return reg(rm, (x>>12)&1 == 1, false)
+	case arg_Rn_SP_sz:
+		return reg(rn, (x>>22)&3 == 3, true)
+
+	case arg_sve_pattern:
+		return Pattern(rn)
+	case arg_sve_mul:
+		return Mul((x>>16)&15 + 1)
+	case arg_sve_dup_imm:
+		imm := int32(signExtend((x>>5)&(1<<8-1), 8))
+		if (x>>13)&1 == 0 {
+			return SImmShift{imm, 0}
+		}
+		if (x>>22)&3 == 0 {
+			// No shifted form for 8-bit elements.
+			return nil
+		}
+		return SImmShift{imm, 8}
+
+	case arg_mem_Xn_SP:
+		return MemImm{reg(rn, true, true), AddrOffset, 0}
+
+	case arg_mem_uimm12:
+		// Scaled by the access size.
+		imm := int32((x >> 10) & (1<<12 - 1))
+		return MemImm{reg(rn, true, true), AddrOffset, imm << ldstScale(x)}
+
+	case arg_mem_simm9_offset, arg_mem_simm9_preindex, arg_mem_simm9_postindex:
+		mode := AddrOffset
+		switch aop {
+		case arg_mem_simm9_preindex:
+			mode = AddrPreIndex
+		case arg_mem_simm9_postindex:
+			mode = AddrPostIndex
+		}
+		return MemImm{reg(rn, true, true), mode, int32(signExtend((x>>12)&(1<<9-1), 9))}
+
+	case arg_mem_simm7_offset, arg_mem_simm7_preindex, arg_mem_simm7_postindex:
+		mode := AddrOffset
+		switch aop {
+		case arg_mem_simm7_preindex:
+			mode = AddrPreIndex
+		case arg_mem_simm7_postindex:
+			mode = AddrPostIndex
+		}
+		// Scaled by the size of one register of the pair.
+		imm := int32(signExtend((x>>15)&(1<<7-1), 7))
+		return MemImm{reg(rn, true, true), mode, imm << pairScale(x)}
+
+	case arg_mem_extend:
+		option := (x >> 13) & (1<<3 - 1)
+		if option&2 == 0 {
+			return nil
+		}
+		index := RegExtend{Reg: reg(rm, option&1 == 1, false), Extend: Extend(option)}
+		if index.Extend == ExtendUXTX {
+			index.Extend = ExtendLSL
+		}
+		if (x>>12)&1 == 1 {
+			index.Amount = uint8(ldstScale(x))
+			index.HasAmount = true
+		}
+		return MemExtend{reg(rn, true, true), index}
+
+	case arg_mem_pac_offset, arg_mem_pac_preindex:
+		// The 10-bit offset S:imm9 is scaled by 8.
+		imm := (x>>22)&1<<9 | (x>>12)&(1<<9-1)
+		mode := AddrOffset
+		if aop == arg_mem_pac_preindex {
+			mode = AddrPreIndex
+		}
+		return MemImm{reg(rn, true, true), mode, int32(signExtend(imm, 10)) << 3}
+
+	case arg_mem_sve_imm4:
+		return MemVL{reg(rn, true, true), int32(signExtend((x>>16)&15, 4))}
+	case arg_mem_sve_imm9:
+		imm := (x>>16)&(1<<6-1)<<3 | (x>>10)&7
+		return MemVL{reg(rn, true, true), int32(signExtend(imm, 9))}
+	case arg_mem_sve_Xm:
+		// Xm == XZR is reserved; the index is scaled by the access size.
+		if rm == 31 {
+			return nil
+		}
+		index := RegExtend{Reg: reg(rm, true, false), Extend: ExtendLSL}
+		if msz := (x >> 23) & 3; msz != 0 {
+			index.Amount = uint8(msz)
+			index.HasAmount = true
+		}
+		return MemExtend{reg(rn, true, true), index}
+	}
+}
+
+// reg returns the general register numbered n.
+// Register 31 is the stack pointer if sp is set and the zero register otherwise.
+func reg(n uint32, is64, sp bool) Reg {
+	if n == 31 && sp {
+		if is64 {
+			return SP
+		}
+		return WSP
+	}
+	if is64 {
+		return X0 + Reg(n)
+	}
+	return W0 + Reg(n)
+}
+
+// ldstScale returns the log2 of the access size of the load or store
+// register instruction x, which scales its immediate offset or index.
+func ldstScale(x uint32) uint32 {
+	if (x>>26)&1 == 1 && (x>>23)&1 == 1 {
+		// 128-bit SIMD and floating-point register.
+		return 4
+	}
+	return x >> 30
+}
+
+// pairScale returns the log2 of the size of one register
+// of the load or store pair instruction x.
+func pairScale(x uint32) uint32 {
+	if (x>>26)&1 == 1 {
+		// SIMD and floating-point: S, D, or Q.
+		return 2 + x>>30
+	}
+	// 32-bit pairs and LDPSW, or 64-bit pairs.
+	return 2 + x>>31
+}
+
+// vecArr returns the arrangement of a vector register
+// with the given Q bit and element size field.
+func vecArr(q, size uint32) Arrangement {
+	size &= 3
+	return Arrangement{uint8((8 << (q & 1)) >> size), ElemB + ElemSize(size)}
+}
+
+// simdElem decodes the imm5 field of an AdvSIMD copy instruction,
+// whose lowest set bit gives the element size and whose
+// higher bits give the element index.
+func simdElem(imm5 uint32) (size, index uint32, ok bool) {
+	imm5 &= 31
+	for size = 0; size < 4; size++ {
+		if imm5>>size&1 != 0 {
+			return size, imm5 >> (size + 1), true
+		}
+	}
+	return 0, 0, false
+}
+
+// vecListLen returns the number of registers transferred by the
+// AdvSIMD load/store multiple structures instruction with the given opcode,
+// or 0 if the opcode is unallocated.
+func vecListLen(opcode uint32) uint8 {
+	switch opcode {
+	case 7:
+		return 1
+	case 8, 10:
+		return 2
+	case 4, 6:
+		return 3
+	case 0, 2:
+		return 4
+	}
+	return 0
+}
+
+// simdImm8 returns the a:b:c:d:e:f:g:h immediate of an
+// AdvSIMD modified immediate instruction.
+func simdImm8(x uint32) uint32 {
+	return (x>>16)&7<<5 | (x>>5)&31
+}
+
+// elemSize returns the element size encoded in the low two bits of size.
+func elemSize(size uint32) ElemSize {
+	return ElemB + ElemSize(size&3)
+}
+
+// signExtend sign-extends the low n bits of v.
+func signExtend(v uint32, n uint) int64 {
+	return int64(int32(v<<(32-n)) >> (32 - n))
+}
+
+// decodeBitMask decodes the logical immediate in the N, immr, and imms
+// fields of x, following DecodeBitMasks in the ARM manual.
+// It reports false for reserved encodings.
+func decodeBitMask(x uint32) (uint64, bool) {
+	sf := x >> 31
+	n := (x >> 22) & 1
+	immr := (x >> 16) & (1<<6 - 1)
+	imms := (x >> 10) & (1<<6 - 1)
+	if sf == 0 && n == 1 {
+		return 0, false
+	}
+
+	// The element size is given by the highest set bit of N:NOT(imms).
+	v := n<<6 | ^imms&(1<<6-1)
+	if v < 2 {
+		return 0, false
+	}
+	esize := uint(2)
+	for v >= 4 {
+		v >>= 1
+		esize <<= 1
+	}
+	levels := uint32(esize - 1)
+	s := imms & levels
+	r := uint(immr & levels)
+	if s == levels {
+		return 0, false
+	}
+
+	emask := ^uint64(0) >> (64 - esize)
+	elem := uint64(1)<<(s+1) - 1
+	elem = (elem>>r | elem<<(esize-r)) & emask
+	for size := esize; size < 64; size *= 2 {
+		elem |= elem << size
+	}
+	if sf == 0 {
+		elem &= 1<<32 - 1
+	}
+	return elem, true
+}
+
+// moveWidePreferred reports whether the logical immediate in x
+// can be written with MOVZ or MOVN, in which case ORR is not written as MOV.
+func moveWidePreferred(x uint32) bool {
+	sf := x >> 31
+	n := (x >> 22) & 1
+	r := (x >> 16) & (1<<6 - 1)
+	s := (x >> 10) & (1<<6 - 1)
+	width := uint32(32) << sf
+
+	// The element size must be the full register width.
+	if sf == 1 && n != 1 || sf == 0 && (n != 0 || s >= 32) {
+		return false
+	}
+	// MOVZ: no more than 16 ones, not spanning a halfword boundary.
+	if s < 16 {
+		return -r&15 <= 15-s
+	}
+	// MOVN: no more than 16 zeros, not spanning a halfword boundary.
+	if s >= width-15 {
+		return r&15 <= s-(width-15)
+	}
+	return false
+}
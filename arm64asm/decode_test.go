@@ -0,0 +1,206 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"encoding/hex"
+	"io/ioutil"
+	"strings"
+	"testing"
+)
+
+func TestDecode(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/decode.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	all := string(data)
+	for strings.Contains(all, "\t\t") {
+		all = strings.Replace(all, "\t\t", "\t", -1)
+	}
+	for _, line := range strings.Split(all, "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		f := strings.SplitN(line, "\t", 3)
+		i := strings.Index(f[0], "|")
+		if i < 0 {
+			t.Errorf("parsing %q: missing | separator", f[0])
+			continue
+		}
+		if i%2 != 0 {
+			t.Errorf("parsing %q: misaligned | separator", f[0])
+		}
+		code, err := hex.DecodeString(f[0][:i] + f[0][i+1:])
+		if err != nil {
+			t.Errorf("parsing %q: %v", f[0], err)
+			continue
+		}
+		if len(f) < 3 {
+			t.Errorf("parsing %q: missing fields", line)
+			continue
+		}
+		syntax, asm := f[1], f[2]
+		inst, err := Decode(code)
+		var out string
+		if err != nil {
+			out = "error: " + err.Error()
+		} else {
+			switch syntax {
+			case "arm":
+				out = inst.String()
+			case "gnu":
+				out = GNUSyntax(inst)
+			case "plan9":
+				// Branch targets are shown for an instruction at 0x1000.
+				out = GoSyntax(inst, 0x1000, nil, nil)
+			default:
+				t.Errorf("unknown syntax %q", syntax)
+				continue
+			}
+		}
+		if out != asm {
+			t.Errorf("Decode(%s) [%s] = %s, want %s", f[0], syntax, out, asm)
+		}
+	}
+}
+
+func TestDecodeBitMask(t *testing.T) {
+	// Each logical immediate round-trips through the ORR encoding.
+	tests := []struct {
+		enc uint32
+		val uint64
+	}{
+		{0xb2400fe0, 0xf},                // N=1 immr=0 imms=3
+		{0xb27f7be0, 0xfffffffe},         // N=1 immr=63 imms=30
+		{0xb200c3e0, 0x0101010101010101}, // 8-bit element
+		{0xb203e7e0, 0x6666666666666666}, // 4-bit element, rotated
+		{0x32000fe0, 0xf},                // 32-bit
+		{0x321f0be0, 0xe},                // 32-bit, rotated
+	}
+	for _, tt := range tests {
+		v, ok := decodeBitMask(tt.enc)
+		if !ok || v != tt.val {
+			t.Errorf("decodeBitMask(%#x) = %#x, %v, want %#x, true", tt.enc, v, ok, tt.val)
+		}
+	}
+	// imms all ones within the element is reserved, as is N=1 with sf=0.
+	for _, enc := range []uint32{0xb2407fe0 | 0x3f<<10, 0x32400000} {
+		if v, ok := decodeBitMask(enc); ok {
+			t.Errorf("decodeBitMask(%#x) = %#x, true, want reserved", enc, v)
+		}
+	}
+}
+
+func TestOpDescription(t *testing.T) {
+	tests := []struct {
+		op   Op
+		desc string
+	}{
+		{ADC, "Add with Carry"},
+		{LDXR, "Load Exclusive Register"},
+		{LDADDALB, "Atomic Add Byte (acquire-release)"},
+		{STSETL, "Atomic Bit Set, without Return (release)"},
+		{CASP, "Compare and Swap Pair"},
+		{0, ""},
+	}
+	for _, tt := range tests {
+		if desc := OpDescription(tt.op); desc != tt.desc {
+			t.Errorf("OpDescription(%v) = %q, want %q", tt.op, desc, tt.desc)
+		}
+	}
+	for op := Op(1); op < Op(len(opstr)); op++ {
+		if OpDescription(op) == "" {
+			t.Errorf("OpDescription(%v) is empty", op)
+		}
+	}
+}
+
+func TestCoverage(t *testing.T) {
+	SetCoverage(true)
+	ResetCoverage()
+	Decode([]byte{0x20, 0x00, 0x02, 0x8b}) // ADD X0, X1, X2
+	SetCoverage(false)
+	Decode([]byte{0x20, 0x00, 0x02, 0x8b})
+	var used []CoverageEntry
+	for _, e := range Coverage() {
+		if e.Count > 0 {
+			used = append(used, e)
+		}
+	}
+	if len(used) != 1 || used[0].Op != ADD || used[0].Count != 1 {
+		t.Errorf("Coverage() used %+v, want one ADD entry", used)
+	}
+	ResetCoverage()
+}
+
+func TestArgMask(t *testing.T) {
+	inst, err := Decode([]byte{0x20, 0x04, 0x40, 0x91}) // ADD X0, X1, #1, LSL #12
+	if err != nil {
+		t.Fatal(err)
+	}
+	for n, want := range []uint32{
+		0x8000001f, // Rd and sf
+		0x800003e0, // Rn and sf
+		0x007ffc00, // imm12 and sh
+		0,
+	} {
+		if got := inst.ArgMask(n); got != want {
+			t.Errorf("ArgMask(%d) = %#08x, want %#08x", n, got, want)
+		}
+	}
+	if m := (Inst{}).ArgMask(0); m != 0 {
+		t.Errorf("Inst{}.ArgMask(0) = %#08x, want 0", m)
+	}
+}
+
+// gnuVersionTests lists instructions printed differently by
+// GNUSyntaxVersion for different binutils releases.
+// TestObjdumpGNUVersion checks them against an installed objdump.
+var gnuVersionTests = []struct {
+	enc     uint32
+	version string
+	want    string
+}{
+	{0xf9400420, "binutils-2.40", "ldr x0, [x1, #8]"},
+	{0xf9400420, "binutils-2.24", "ldr x0, [x1,#8]"},
+	{0xa9bf7bfd, "2.24", "stp x29, x30, [sp,#-16]!"},
+	{0xa8c17bfd, "2.24", "ldp x29, x30, [sp], #16"},
+	{0x52800020, "binutils-2.40", "mov w0, #0x1"},
+	{0x52800020, "binutils-2.24", "movz w0, #0x1"},
+	{0x12800000, "binutils-2.24", "movn w0, #0x0"},
+	{0xb2401fe0, "binutils-2.24", "orr x0, xzr, #0xff"},
+	{0xaa0103e0, "binutils-2.24", "mov x0, x1"},
+}
+
+func TestGNUSyntaxVersion(t *testing.T) {
+	for _, tt := range gnuVersionTests {
+		v, err := ParseGNUVersion(tt.version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		src := []byte{byte(tt.enc), byte(tt.enc >> 8), byte(tt.enc >> 16), byte(tt.enc >> 24)}
+		inst, err := Decode(src)
+		if err != nil {
+			t.Errorf("Decode(%#08x): %v", tt.enc, err)
+			continue
+		}
+		if got := GNUSyntaxVersion(inst, v); got != tt.want {
+			t.Errorf("GNUSyntaxVersion(%#08x, %v) = %q, want %q", tt.enc, v, got, tt.want)
+		}
+	}
+
+	for _, s := range []string{"binutils-2.24.51", "2.40"} {
+		if _, err := ParseGNUVersion(s); err != nil {
+			t.Errorf("ParseGNUVersion(%q): %v", s, err)
+		}
+	}
+	for _, s := range []string{"", "binutils", "2", "2.x", "gcc-2.24"} {
+		if v, err := ParseGNUVersion(s); err == nil {
+			t.Errorf("ParseGNUVersion(%q) = %v, want error", s, v)
+		}
+	}
+}
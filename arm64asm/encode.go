@@ -0,0 +1,950 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"encoding/binary"
+	"fmt"
+)
+
+// Encode returns the encoding of inst, the inverse of Decode.
+// The Enc field of inst is ignored.
+//
+// Encode accepts the arguments that Decode produces, along with a few
+// simpler forms: a Reg in place of an unshifted RegShift or RegExtend,
+// and an Imm in place of an unshifted ImmShift. The Decimal flag of an
+// Imm is ignored. Aliases such as MOV and LSL are encoded as the
+// instructions they alias, so decoding the result may yield a different
+// but equivalent Inst only if inst is not itself the preferred form.
+func Encode(inst Inst) ([]byte, error) {
+Search:
+	for i := range instFormats {
+		f := &instFormats[i]
+		if f.op != inst.Op {
+			continue
+		}
+		x := f.value
+		for j, aop := range f.args {
+			if aop == 0 {
+				if inst.Args[j] != nil {
+					continue Search
+				}
+				break
+			}
+			var ok bool
+			if x, ok = encodeArg(aop, inst.Args[j], x); !ok {
+				continue Search
+			}
+		}
+
+		// Check that x decodes back to inst using this format.
+		// This catches arguments that conflict over a shared field
+		// (such as sf) as well as unmet alias conditions.
+		if x&f.mask != f.value {
+			continue
+		}
+		for j, aop := range f.args {
+			if aop == 0 {
+				break
+			}
+			if !argEqual(decodeArg(aop, x), inst.Args[j]) {
+				continue Search
+			}
+		}
+		buf := make([]byte, 4)
+		binary.LittleEndian.PutUint32(buf, x)
+		return buf, nil
+	}
+	return nil, fmt.Errorf("cannot encode %v", inst)
+}
+
+// An Encoding describes one way to encode an instruction with a given Op.
+// An instruction word x uses the encoding if x&Mask == Value,
+// although the decoder may still reject particular operand values
+// or prefer an earlier encoding, such as an alias, that also matches.
+type Encoding struct {
+	Mask   uint32 // bits fixed by the encoding
+	Value  uint32 // values of the fixed bits
+	Syntax string // assembly syntax in the ARM manual, like "ADD <Rd|SP>, <Rn|SP>, #<imm>{, LSL #12}"
+}
+
+// Encodings returns the encodings that decode to op,
+// in decoder table order.
+func Encodings(op Op) []Encoding {
+	var list []Encoding
+	for i := range instFormats {
+		f := &instFormats[i]
+		if f.op == op {
+			list = append(list, Encoding{f.mask, f.value, instSyntax[i]})
+		}
+	}
+	return list
+}
+
+// argEqual reports whether the decoded argument d matches the argument a
+// passed to Encode, allowing for the simpler forms Encode accepts.
+func argEqual(d, a Arg) bool {
+	if d == nil || a == nil {
+		return d == a
+	}
+	switch d := d.(type) {
+	case Imm:
+		switch a := a.(type) {
+		case Imm:
+			return d.Imm == a.Imm
+		}
+	case ImmShift:
+		switch a := a.(type) {
+		case Imm:
+			return d.Shift == 0 && uint32(d.Imm) == a.Imm
+		}
+	case Imm64:
+		switch a := a.(type) {
+		case Imm:
+			return uint64(d) == uint64(a.Imm)
+		}
+	case RegShift:
+		switch a := a.(type) {
+		case Reg:
+			return d == RegShift{Reg: a}
+		}
+	case RegExtend:
+		switch a := a.(type) {
+		case Reg:
+			return d == RegExtend{Reg: a, Extend: ExtendLSL}
+		case RegExtend:
+			return regExtendEqual(d, a)
+		}
+	case MemExtend:
+		switch a := a.(type) {
+		case MemExtend:
+			return d.Base == a.Base && regExtendEqual(d.Index, a.Index)
+		}
+	}
+	return d == a
+}
+
+// regExtendEqual reports whether d and a are the same extended register.
+// A nonzero shift amount is always written, so a need not set HasAmount.
+func regExtendEqual(d, a RegExtend) bool {
+	if a.Amount != 0 {
+		a.HasAmount = d.HasAmount
+	}
+	return d == a
+}
+
+// encodeArg encodes arg as described by aop into the instruction bits x.
+// It reports false if arg cannot be encoded according to aop.
+// Arguments are encoded in order, so the encoders for later arguments
+// may depend on fields set by earlier ones, such as the sf bit.
+func encodeArg(aop instArg, arg Arg, x uint32) (uint32, bool) {
+	sf := x>>31 == 1
+
+	switch aop {
+	default:
+		return x, false
+
+	case arg_Rd, arg_Rt_31:
+		return encodeReg(x, arg, 0, 31, false)
+	case arg_Rd_SP, arg_Rd_SP_movsp:
+		return encodeReg(x, arg, 0, 31, true)
+	case arg_Rn:
+		return encodeReg(x, arg, 5, 31, false)
+	case arg_Rn_SP:
+		return encodeReg(x, arg, 5, 31, true)
+	case arg_Rn_eq_Rm:
+		if x, ok := encodeReg(x, arg, 5, 31, false); ok {
+			return encodeReg(x, arg, 16, 31, false)
+		}
+		return x, false
+	case arg_Rm:
+		return encodeReg(x, arg, 16, 31, false)
+	case arg_Rm_SP:
+		return encodeReg(x, arg, 16, 31, true)
+	case arg_Ra:
+		return encodeReg(x, arg, 10, 31, false)
+	case arg_Rt_30:
+		return encodeReg(x, arg, 0, 30, false)
+	case arg_Rt2_30:
+		return encodeReg(x, arg, 10, 30, false)
+	case arg_Rt2_31:
+		return encodeReg(x, arg, 10, 31, false)
+	case arg_Rs_30, arg_Rs_pair:
+		return encodeReg(x, arg, 16, 30, false)
+	case arg_Rt_pair:
+		return encodeReg(x, arg, 0, 30, false)
+	case arg_Rs1_pair, arg_Rt1_pair:
+		// Implied by the first register of the pair.
+		return x, true
+
+	case arg_Wd, arg_Wt:
+		return encodeFixedReg(x, arg, 0, false)
+	case arg_Wn:
+		return encodeFixedReg(x, arg, 5, false)
+	case arg_Wm, arg_Ws:
+		return encodeFixedReg(x, arg, 16, false)
+	case arg_Xd, arg_Xt:
+		return encodeFixedReg(x, arg, 0, true)
+	case arg_Xn:
+		return encodeFixedReg(x, arg, 5, true)
+	case arg_Xm:
+		return encodeFixedReg(x, arg, 16, true)
+	case arg_Xa, arg_Xt2:
+		return encodeFixedReg(x, arg, 10, true)
+
+	case arg_Rm_shift, arg_Rm_shift_arith:
+		var rs RegShift
+		switch a := arg.(type) {
+		case Reg:
+			rs.Reg = a
+		case RegShift:
+			rs = a
+		default:
+			return x, false
+		}
+		if rs.Amount >= 64 {
+			return x, false
+		}
+		x, ok := encodeReg(x, rs.Reg, 16, 31, false)
+		return x | uint32(rs.Shift&3)<<22 | uint32(rs.Amount)<<10, ok
+
+	case arg_Rm_extend:
+		var re RegExtend
+		switch a := arg.(type) {
+		case Reg:
+			re = RegExtend{Reg: a, Extend: ExtendLSL}
+		case RegExtend:
+			re = a
+		default:
+			return x, false
+		}
+		option := uint32(re.Extend)
+		if re.Extend == ExtendLSL {
+			option = uint32(ExtendUXTW) | x>>31
+		}
+		n, _, ok := regNum(re.Reg, false)
+		if !ok || re.Amount > 4 {
+			return x, false
+		}
+		return x | option<<13 | uint32(re.Amount)<<10 | n<<16, true
+
+	case arg_imm12_shift:
+		var is ImmShift
+		switch a := arg.(type) {
+		case Imm:
+			if a.Imm >= 1<<12 {
+				return x, false
+			}
+			is.Imm = uint16(a.Imm)
+		case ImmShift:
+			is = a
+		default:
+			return x, false
+		}
+		if is.Imm >= 1<<12 || is.Shift != 0 && is.Shift != 12 {
+			return x, false
+		}
+		return x | uint32(is.Imm)<<10 | uint32(is.Shift/12)<<22, true
+
+	case arg_bitmask, arg_bitmask_mov:
+		v, ok := imm64(arg)
+		if !ok {
+			return x, false
+		}
+		bits, ok := encodeBitMask(v, sf)
+		return x | bits, ok
+
+	case arg_imm16_hw:
+		var is ImmShift
+		switch a := arg.(type) {
+		case Imm:
+			if a.Imm >= 1<<16 {
+				return x, false
+			}
+			is.Imm = uint16(a.Imm)
+		case ImmShift:
+			is = a
+		default:
+			return x, false
+		}
+		if is.Shift%16 != 0 || is.Shift > 48 {
+			return x, false
+		}
+		return x | uint32(is.Imm)<<5 | uint32(is.Shift/16)<<21, true
+
+	case arg_movz_imm, arg_movn_imm:
+		v, ok := imm64(arg)
+		if !ok {
+			return x, false
+		}
+		if aop == arg_movn_imm {
+			v = ^v
+			if !sf {
+				v &= 1<<32 - 1
+			}
+		}
+		for hw := uint32(0); hw < 4; hw++ {
+			if v&^(0xffff<<(16*hw)) == 0 {
+				return x | uint32(v>>(16*hw))<<5 | hw<<21, true
+			}
+		}
+		return x, false
+
+	case arg_immr, arg_imms, arg_immr_shift, arg_lsl_shift,
+		arg_bfiz_lsb, arg_bfiz_width, arg_bfx_lsb, arg_bfx_width,
+		arg_imms_lsb, arg_imms_ror:
+		a, ok := arg.(Imm)
+		size := uint32(32)
+		if sf {
+			size = 64
+		}
+		if !ok || a.Imm > size {
+			return x, false
+		}
+		v := a.Imm
+		immr := (x >> 16) & (1<<6 - 1)
+		var r, s uint32
+		switch aop {
+		case arg_immr:
+			return x | v<<16, true
+		case arg_imms, arg_imms_lsb:
+			return x | v<<10, true
+		case arg_imms_ror:
+			// ROR is EXTR with Rn == Rm.
+			rn := (x >> 5) & (1<<5 - 1)
+			return x | rn<<16 | v<<10, true
+		case arg_immr_shift:
+			r, s = v, size-1
+		case arg_lsl_shift:
+			r, s = -v&(size-1), size-1-v
+		case arg_bfiz_lsb, arg_bfx_lsb:
+			if aop == arg_bfiz_lsb {
+				v = -v & (size - 1)
+			}
+			return x | v<<16, true
+		case arg_bfiz_width:
+			return x | (v-1)<<10, v > 0
+		case arg_bfx_width:
+			return x | (immr+v-1)<<10, v > 0
+		}
+		return x | r<<16 | s<<10, true
+
+	case arg_cond_0, arg_cond_12, arg_cond_12_inv:
+		c, ok := arg.(Cond)
+		if !ok {
+			return x, false
+		}
+		switch aop {
+		case arg_cond_0:
+			return x | uint32(c&15), true
+		case arg_cond_12_inv:
+			c ^= 1
+		}
+		return x | uint32(c&15)<<12, true
+
+	case arg_nzcv:
+		return encodeImm(x, arg, 0, 4)
+	case arg_imm5_16:
+		return encodeImm(x, arg, 16, 5)
+	case arg_imm16:
+		return encodeImm(x, arg, 5, 16)
+	case arg_hint:
+		return encodeImm(x, arg, 5, 7)
+	case arg_crm:
+		return encodeImm(x, arg, 8, 4)
+	case arg_prfop:
+		return encodeImm(x, arg, 0, 5)
+
+	case arg_tbz_bit:
+		a, ok := arg.(Imm)
+		if !ok || a.Imm >= 64 {
+			return x, false
+		}
+		return x&^(1<<31) | a.Imm>>5<<31 | (a.Imm&31)<<19, true
+
+	case arg_label26, arg_label19, arg_label14, arg_label_adr, arg_label_adrp:
+		a, ok := arg.(PCRel)
+		if !ok {
+			return x, false
+		}
+		v := int64(a)
+		switch aop {
+		case arg_label_adr, arg_label_adrp:
+			if aop == arg_label_adrp {
+				if v&(1<<12-1) != 0 {
+					return x, false
+				}
+				v >>= 12
+			}
+			if v < -1<<20 || v >= 1<<20 {
+				return x, false
+			}
+			imm := uint32(v) & (1<<21 - 1)
+			return x | (imm&3)<<29 | (imm>>2)<<5, true
+		}
+		n, shift := uint(26), uint(0)
+		switch aop {
+		case arg_label19:
+			n, shift = 19, 5
+		case arg_label14:
+			n, shift = 14, 5
+		}
+		if v&3 != 0 || v>>2 < -1<<(n-1) || v>>2 >= 1<<(n-1) {
+			return x, false
+		}
+		return x | (uint32(v>>2)&(1<<n-1))<<shift, true
+
+	case arg_bti:
+		a, ok := arg.(BTITarget)
+		if !ok || a == 0 || a > BTIJC {
+			return x, false
+		}
+		return x | uint32(a)<<6, true
+
+	case arg_barrier:
+		a, ok := arg.(BarrierOpt)
+		return x | uint32(a&15)<<8, ok
+
+	case arg_pstate:
+		a, ok := arg.(PState)
+		if !ok || a >= 1<<6 {
+			return x, false
+		}
+		return x | uint32(a>>3)<<16 | uint32(a&7)<<5, true
+
+	case arg_sysreg:
+		a, ok := arg.(Sysreg)
+		if !ok || a.Op0 < 2 || a.Op0 > 3 || a.Op1 > 7 || a.CRn > 15 || a.CRm > 15 || a.Op2 > 7 {
+			return x, false
+		}
+		return x | uint32(a.Op0-2)<<19 | uint32(a.Op1)<<16 | uint32(a.CRn)<<12 | uint32(a.CRm)<<8 | uint32(a.Op2)<<5, true
+
+	case arg_Ft_ldst:
+		r, ok := arg.(Reg)
+		if !ok || !r.IsSIMD() {
+			return x, false
+		}
+		size := uint32(r-B0) / 32
+		if size == 4 {
+			x |= 1 << 23
+			size = 0
+		}
+		return x | size<<30 | uint32(r.Num()), true
+	case arg_Ft_lit, arg_Ft_pair, arg_Ft2_pair:
+		r, ok := arg.(Reg)
+		if !ok || r < S0 || r > Q31 {
+			return x, false
+		}
+		shift := uint(0)
+		if aop == arg_Ft2_pair {
+			shift = 10
+		}
+		return x | (uint32(r-S0)/32)<<30 | uint32(r.Num())<<shift, true
+	case arg_Dd:
+		r, ok := arg.(Reg)
+		if !ok || r < D0 || r > D31 {
+			return x, false
+		}
+		return x | uint32(r.Num()), true
+	case arg_Fd_across:
+		r, ok := arg.(Reg)
+		if !ok || !r.IsSIMD() {
+			return x, false
+		}
+		return x | (uint32(r-B0)/32)<<22 | uint32(r.Num()), true
+
+	case arg_Vd_T, arg_Vd_T_BHS:
+		return encodeVReg(x, arg, 0, 22)
+	case arg_Vn_T:
+		return encodeVReg(x, arg, 5, 22)
+	case arg_Vm_T:
+		return encodeVReg(x, arg, 16, 22)
+	case arg_Vd_B, arg_Vd_H, arg_Vd_S, arg_Vd_2D:
+		return encodeVReg(x, arg, 0, 0)
+	case arg_Vn_B:
+		return encodeVReg(x, arg, 5, 0)
+	case arg_Vm_B:
+		return encodeVReg(x, arg, 16, 0)
+	case arg_Vn_B_eq_Vm:
+		if x, ok := encodeVReg(x, arg, 5, 0); ok {
+			return encodeVReg(x, arg, 16, 0)
+		}
+		return x, false
+	case arg_Vd_Tfp, arg_Vn_Tfp, arg_Vm_Tfp:
+		v, ok := arg.(VReg)
+		if !ok || v.N >= 32 || v.Arr.Elem != ElemS && v.Arr.Elem != ElemD {
+			return x, false
+		}
+		shift := uint(0)
+		switch aop {
+		case arg_Vn_Tfp:
+			shift = 5
+		case arg_Vm_Tfp:
+			shift = 16
+		}
+		x |= uint32(v.N) << shift
+		if v.Arr.Elem == ElemD {
+			x |= 1 << 22
+		}
+		if v.Arr.Bytes() == 16 {
+			x |= 1 << 30
+		}
+		return x, true
+
+	case arg_Vd_dup:
+		v, ok := arg.(VReg)
+		if !ok || v.N >= 32 || v.Arr.Elem < ElemB || v.Arr.Elem > ElemD {
+			return x, false
+		}
+		if v.Arr.Bytes() == 16 {
+			x |= 1 << 30
+		}
+		return x | 1<<(v.Arr.Elem-ElemB)<<16 | uint32(v.N), true
+	case arg_Vd_elem, arg_Vn_elem:
+		e, ok := arg.(VElem)
+		if !ok || e.N >= 32 || e.Elem < ElemB || e.Elem > ElemD {
+			return x, false
+		}
+		size := uint(e.Elem - ElemB)
+		if uint32(e.Index) >= 16>>size {
+			return x, false
+		}
+		shift := uint(0)
+		if aop == arg_Vn_elem {
+			shift = 5
+		}
+		return x | (1<<size|uint32(e.Index)<<(size+1))<<16 | uint32(e.N)<<shift, true
+	case arg_Vn_elem_ins:
+		e, ok := arg.(VElem)
+		if !ok || e.N >= 32 || e.Elem < ElemB || e.Elem > ElemD {
+			return x, false
+		}
+		size := uint(e.Elem - ElemB)
+		if uint32(e.Index) >= 16>>size {
+			return x, false
+		}
+		return x | uint32(e.Index)<<size<<11 | uint32(e.N)<<5, true
+	case arg_Rn_dup:
+		size, _, _ := simdElem(x >> 16)
+		return encodeFixedReg(x, arg, 5, size == 3)
+	case arg_Rd_umov, arg_Rd_umov_mov, arg_Rd_smov:
+		return encodeReg(x, arg, 0, 30, false)
+
+	case arg_Vt_list:
+		l, ok := arg.(VRegList)
+		if !ok || l.First.N >= 32 || l.First.Arr.Elem < ElemB || l.First.Arr.Elem > ElemD {
+			return x, false
+		}
+		if l.First.Arr.Bytes() == 16 {
+			x |= 1 << 30
+		}
+		return x | uint32(l.First.Arr.Elem-ElemB)<<10 | uint32(l.First.N), true
+	case arg_mem_post_list:
+		m, ok := arg.(MemImm)
+		if !ok {
+			return x, false
+		}
+		// The increment is implied by the register list.
+		return encodeFixedReg(x, m.Base, 5, true)
+	case arg_mem_post_Xm:
+		m, ok := arg.(MemPostReg)
+		if !ok {
+			return x, false
+		}
+		if x, ok = encodeFixedReg(x, m.Base, 5, true); !ok {
+			return x, false
+		}
+		return encodeFixedReg(x, m.Index, 16, true)
+
+	case arg_simd_imm8:
+		a, ok := arg.(Imm)
+		if !ok || a.Imm >= 1<<8 {
+			return x, false
+		}
+		return x | encodeSIMDImm8(a.Imm), true
+	case arg_simd_imm8_lsl:
+		var is ImmShift
+		switch a := arg.(type) {
+		case Imm:
+			is.Imm = uint16(a.Imm)
+			if a.Imm >= 1<<8 {
+				return x, false
+			}
+		case ImmShift:
+			is = a
+		default:
+			return x, false
+		}
+		if is.Imm >= 1<<8 || is.Shift%8 != 0 || is.Shift > 24 {
+			return x, false
+		}
+		return x | encodeSIMDImm8(uint32(is.Imm)) | uint32(is.Shift/8)<<13, true
+	case arg_simd_imm64:
+		v, ok := imm64(arg)
+		if !ok {
+			return x, false
+		}
+		var imm8 uint32
+		for i := uint(0); i < 8; i++ {
+			switch v >> (8 * i) & 0xff {
+			case 0xff:
+				imm8 |= 1 << i
+			case 0:
+			default:
+				return x, false
+			}
+		}
+		return x | encodeSIMDImm8(imm8), true
+
+	case arg_Zd_T, arg_Zd_T_fp:
+		return encodeZReg(x, arg, 0, 22, ElemNone)
+	case arg_Zd_T_eq_Zm:
+		if x, ok := encodeZReg(x, arg, 0, 22, ElemNone); ok {
+			return encodeZReg(x, arg, 16, 22, ElemNone)
+		}
+		return x, false
+	case arg_Zn_T:
+		return encodeZReg(x, arg, 5, 22, ElemNone)
+	case arg_Zm_T:
+		return encodeZReg(x, arg, 16, 22, ElemNone)
+	case arg_Zd_D:
+		return encodeZReg(x, arg, 0, 0, ElemD)
+	case arg_Zn_D:
+		return encodeZReg(x, arg, 5, 0, ElemD)
+	case arg_Zn_D_eq_Zm:
+		if x, ok := encodeZReg(x, arg, 5, 0, ElemD); ok {
+			return encodeZReg(x, arg, 16, 0, ElemD)
+		}
+		return x, false
+	case arg_Zm_D:
+		return encodeZReg(x, arg, 16, 0, ElemD)
+	case arg_Zt:
+		return encodeZReg(x, arg, 0, 0, ElemNone)
+	case arg_Zt_list:
+		l, ok := arg.(ZRegList)
+		if !ok || l.Count != 1 {
+			return x, false
+		}
+		return encodeZReg(x, l.First, 0, 21, ElemNone)
+
+	case arg_Pd_T:
+		return encodePReg(x, arg, 0, 4, PredNone, 22, ElemNone)
+	case arg_Pd_B:
+		return encodePReg(x, arg, 0, 4, PredNone, 0, ElemB)
+	case arg_Pt:
+		return encodePReg(x, arg, 0, 4, PredNone, 0, ElemNone)
+	case arg_Pg:
+		return encodePReg(x, arg, 10, 3, PredNone, 0, ElemNone)
+	case arg_Pg_M:
+		return encodePReg(x, arg, 10, 3, PredMerge, 0, ElemNone)
+	case arg_Pg_Z:
+		return encodePReg(x, arg, 10, 3, PredZero, 0, ElemNone)
+	case arg_Pg4:
+		return encodePReg(x, arg, 10, 4, PredNone, 0, ElemNone)
+	case arg_Pg4_M:
+		return encodePReg(x, arg, 10, 4, PredMerge, 0, ElemNone)
+
+	case arg_Rn_sf12:
+		return encodeReg(x, arg, 5, 12, false)
+	case arg_Rm_sf12:
+		return encodeReg(x, arg, 16, 12, false)
+	case arg_Rn_SP_sz:
+		return encodeFixedReg(x, arg, 5, (x>>22)&3 == 3)
+
+	case arg_sve_pattern:
+		a, ok := arg.(Pattern)
+		if !ok || a >= 32 {
+			return x, false
+		}
+		return x | uint32(a)<<5, true
+	case arg_sve_mul:
+		a, ok := arg.(Mul)
+		if !ok || a < 1 || a > 16 {
+			return x, false
+		}
+		return x | uint32(a-1)<<16, true
+	case arg_sve_dup_imm:
+		a, ok := arg.(SImmShift)
+		if !ok || a.Imm < -128 || a.Imm > 127 || a.Shift != 0 && a.Shift != 8 {
+			return x, false
+		}
+		return x | (uint32(a.Imm)&(1<<8-1))<<5 | uint32(a.Shift/8)<<13, true
+
+	case arg_mem_Xn_SP, arg_mem_uimm12,
+		arg_mem_simm9_offset, arg_mem_simm9_preindex, arg_mem_simm9_postindex,
+		arg_mem_simm7_offset, arg_mem_simm7_preindex, arg_mem_simm7_postindex:
+		m, ok := arg.(MemImm)
+		if !ok {
+			return x, false
+		}
+		if x, ok = encodeFixedReg(x, m.Base, 5, true); !ok {
+			return x, false
+		}
+		mode := AddrOffset
+		switch aop {
+		case arg_mem_simm9_preindex, arg_mem_simm7_preindex:
+			mode = AddrPreIndex
+		case arg_mem_simm9_postindex, arg_mem_simm7_postindex:
+			mode = AddrPostIndex
+		}
+		if m.Mode != mode {
+			return x, false
+		}
+		imm := m.Imm
+		switch aop {
+		case arg_mem_Xn_SP:
+			return x, imm == 0
+		case arg_mem_uimm12:
+			scale := ldstScale(x)
+			if imm < 0 || imm&(1<<scale-1) != 0 || imm>>scale >= 1<<12 {
+				return x, false
+			}
+			return x | uint32(imm>>scale)<<10, true
+		case arg_mem_simm9_offset, arg_mem_simm9_preindex, arg_mem_simm9_postindex:
+			if imm < -256 || imm > 255 {
+				return x, false
+			}
+			return x | (uint32(imm)&(1<<9-1))<<12, true
+		}
+		scale := pairScale(x)
+		if imm&(1<<scale-1) != 0 || imm>>scale < -64 || imm>>scale > 63 {
+			return x, false
+		}
+		return x | (uint32(imm>>scale)&(1<<7-1))<<15, true
+
+	case arg_mem_extend:
+		m, ok := arg.(MemExtend)
+		if !ok {
+			return x, false
+		}
+		if x, ok = encodeFixedReg(x, m.Base, 5, true); !ok {
+			return x, false
+		}
+		n, _, ok := regNum(m.Index.Reg, false)
+		if !ok {
+			return x, false
+		}
+		option := uint32(m.Index.Extend)
+		if m.Index.Extend == ExtendLSL {
+			option = uint32(ExtendUXTX)
+		}
+		x |= n<<16 | option<<13
+		if m.Index.HasAmount || m.Index.Amount != 0 {
+			x |= 1 << 12
+		}
+		return x, true
+
+	case arg_mem_pac_offset, arg_mem_pac_preindex:
+		m, ok := arg.(MemImm)
+		if !ok {
+			return x, false
+		}
+		if x, ok = encodeFixedReg(x, m.Base, 5, true); !ok {
+			return x, false
+		}
+		mode := AddrOffset
+		if aop == arg_mem_pac_preindex {
+			mode = AddrPreIndex
+		}
+		imm := m.Imm
+		if m.Mode != mode || imm&7 != 0 || imm < -4096 || imm > 4088 {
+			return x, false
+		}
+		v := uint32(imm>>3) & (1<<10 - 1)
+		return x | v>>9<<22 | (v&(1<<9-1))<<12, true
+
+	case arg_mem_sve_imm4, arg_mem_sve_imm9:
+		m, ok := arg.(MemVL)
+		if !ok {
+			return x, false
+		}
+		if x, ok = encodeFixedReg(x, m.Base, 5, true); !ok {
+			return x, false
+		}
+		imm := m.Imm
+		if aop == arg_mem_sve_imm4 {
+			if imm < -8 || imm > 7 {
+				return x, false
+			}
+			return x | (uint32(imm)&15)<<16, true
+		}
+		if imm < -256 || imm > 255 {
+			return x, false
+		}
+		return x | (uint32(imm)>>3&(1<<6-1))<<16 | (uint32(imm)&7)<<10, true
+
+	case arg_mem_sve_Xm:
+		m, ok := arg.(MemExtend)
+		if !ok || m.Index.Extend != ExtendLSL {
+			return x, false
+		}
+		if x, ok = encodeFixedReg(x, m.Base, 5, true); !ok {
+			return x, false
+		}
+		return encodeFixedReg(x, m.Index.Reg, 16, true)
+	}
+}
+
+// regNum returns the number and width of the general register arg.
+// Register 31 must be the stack pointer if sp is set and the zero register otherwise.
+func regNum(arg Arg, sp bool) (n uint32, is64, ok bool) {
+	r, ok := arg.(Reg)
+	if !ok || r > SP {
+		return 0, false, false
+	}
+	if (r == WSP || r == SP) != sp && r.Num() == 31 {
+		return 0, false, false
+	}
+	return uint32(r.Num()), r.Is64(), true
+}
+
+// encodeReg encodes the register arg into the 5-bit field at bit shift of x,
+// setting the bit at widthBit to give its width.
+func encodeReg(x uint32, arg Arg, shift, widthBit uint, sp bool) (uint32, bool) {
+	n, is64, ok := regNum(arg, sp)
+	if !ok {
+		return x, false
+	}
+	x = x&^(1<<widthBit) | n<<shift
+	if is64 {
+		x |= 1 << widthBit
+	}
+	return x, true
+}
+
+// encodeFixedReg encodes the register arg into the 5-bit field at bit shift of x.
+// The register must be 64-bit if is64 is set and 32-bit otherwise.
+// A 64-bit register 31 is taken to be SP, the base register of a memory operand.
+func encodeFixedReg(x uint32, arg Arg, shift uint, is64 bool) (uint32, bool) {
+	r, ok := arg.(Reg)
+	sp := ok && (r == SP || r == WSP)
+	n, w, ok := regNum(arg, sp)
+	if !ok || w != is64 {
+		return x, false
+	}
+	return x | n<<shift, true
+}
+
+// encodeVReg encodes the vector register arg into the 5-bit field at bit shift of x,
+// setting the Q bit from its arrangement. If sizeShift is nonzero, the
+// element size is encoded in the 2-bit field at bit sizeShift.
+func encodeVReg(x uint32, arg Arg, shift, sizeShift uint) (uint32, bool) {
+	v, ok := arg.(VReg)
+	if !ok || v.N >= 32 || v.Arr.Elem < ElemB || v.Arr.Elem > ElemD {
+		return x, false
+	}
+	if v.Arr.Bytes() == 16 {
+		x |= 1 << 30
+	}
+	if sizeShift != 0 {
+		x |= uint32(v.Arr.Elem-ElemB) << sizeShift
+	}
+	return x | uint32(v.N)<<shift, true
+}
+
+// encodeSIMDImm8 returns the a:b:c:d:e:f:g:h fields encoding imm8
+// in an AdvSIMD modified immediate instruction.
+func encodeSIMDImm8(imm8 uint32) uint32 {
+	return imm8>>5<<16 | (imm8&31)<<5
+}
+
+// encodeZReg encodes the SVE vector register arg into the 5-bit field at bit shift of x.
+// If sizeShift is nonzero, the element size is encoded in the 2-bit field at
+// bit sizeShift; otherwise the element size must be want.
+func encodeZReg(x uint32, arg Arg, shift, sizeShift uint, want ElemSize) (uint32, bool) {
+	z, ok := arg.(ZReg)
+	if !ok || z.N >= 32 {
+		return x, false
+	}
+	x, ok = encodeElemSize(x, z.Size, sizeShift, want)
+	return x | uint32(z.N)<<shift, ok
+}
+
+// encodePReg encodes the SVE predicate register arg, which must have
+// qualifier qual, into the n-bit field at bit shift of x.
+// The element size is handled as in encodeZReg.
+func encodePReg(x uint32, arg Arg, shift, n uint, qual PredQual, sizeShift uint, want ElemSize) (uint32, bool) {
+	p, ok := arg.(PReg)
+	if !ok || p.N >= 1<<n || p.Qual != qual {
+		return x, false
+	}
+	x, ok = encodeElemSize(x, p.Size, sizeShift, want)
+	return x | uint32(p.N)<<shift, ok
+}
+
+// encodeElemSize encodes the element size size into the 2-bit field at bit shift of x,
+// or, if shift is zero, checks that size is want.
+func encodeElemSize(x uint32, size ElemSize, shift uint, want ElemSize) (uint32, bool) {
+	if shift == 0 {
+		return x, size == want
+	}
+	if size < ElemB || size > ElemD {
+		return x, false
+	}
+	return x | uint32(size-ElemB)<<shift, true
+}
+
+// encodeImm encodes the Imm arg into the n-bit field at bit shift of x.
+func encodeImm(x uint32, arg Arg, shift, n uint) (uint32, bool) {
+	a, ok := arg.(Imm)
+	if !ok || a.Imm >= 1<<n {
+		return x, false
+	}
+	return x | a.Imm<<shift, true
+}
+
+// imm64 returns the value of an Imm64 or Imm argument.
+func imm64(arg Arg) (uint64, bool) {
+	switch a := arg.(type) {
+	case Imm64:
+		return uint64(a), true
+	case Imm:
+		return uint64(a.Imm), true
+	}
+	return 0, false
+}
+
+// encodeBitMask returns the N, immr, and imms fields encoding v as a
+// logical immediate for a 64-bit (sf) or 32-bit operation.
+// It reports false if v cannot be encoded.
+func encodeBitMask(v uint64, sf bool) (uint32, bool) {
+	if !sf {
+		if v>>32 != 0 {
+			return 0, false
+		}
+		v |= v << 32
+	}
+	// Find the smallest element size whose replication yields v.
+	esize := uint(64)
+	for esize > 2 {
+		half := esize / 2
+		if v&(1<<half-1) != v>>half&(1<<half-1) {
+			break
+		}
+		esize = half
+	}
+	emask := ^uint64(0) >> (64 - esize)
+	elem := v & emask
+	if elem == 0 || elem == emask {
+		return 0, false
+	}
+	// The element must be a rotated run of ones.
+	ones := uint(0)
+	for e := elem; e != 0; e &= e - 1 {
+		ones++
+	}
+	run := uint64(1)<<ones - 1
+	for r := uint(0); r < esize; r++ {
+		if (run>>r|run<<(esize-r))&emask == elem {
+			n := uint32(0)
+			if esize == 64 {
+				n = 1
+			}
+			imms := uint32(^(esize*2-1))&(1<<6-1) | uint32(ones-1)
+			return n<<22 | uint32(r)<<16 | imms<<10, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,152 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"io/ioutil"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// TestEncodeDecodeTxt checks that every instruction in testdata/decode.txt
+// encodes back to its original bytes.
+func TestEncodeDecodeTxt(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/decode.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line == "" || strings.HasPrefix(line, "#") || strings.Contains(line, "error:") {
+			continue
+		}
+		i := strings.Index(line, "|")
+		code, err := hex.DecodeString(line[:i])
+		if err != nil {
+			t.Errorf("parsing %q: %v", line, err)
+			continue
+		}
+		inst, err := Decode(code)
+		if err != nil {
+			t.Errorf("Decode(%x): %v", code, err)
+			continue
+		}
+		enc, err := Encode(inst)
+		if err != nil {
+			t.Errorf("Encode(%v): %v", inst, err)
+			continue
+		}
+		if string(enc) != string(code) {
+			t.Errorf("Encode(%v) = %x, want %x", inst, enc, code)
+		}
+	}
+}
+
+// TestEncodeRandom checks that encoding a decoded random instruction
+// yields an encoding that decodes to the same instruction.
+// The encoding may differ from the original in bits the decoder ignores.
+func TestEncodeRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	n := 200000
+	if testing.Short() {
+		n = 10000
+	}
+	var buf [4]byte
+	for i := 0; i < n; i++ {
+		binary.LittleEndian.PutUint32(buf[:], r.Uint32())
+		inst, err := Decode(buf[:])
+		if err != nil {
+			continue
+		}
+		enc, err := Encode(inst)
+		if err != nil {
+			t.Errorf("Encode(%v) [%x]: %v", inst, buf, err)
+			continue
+		}
+		inst2, err := Decode(enc)
+		inst.Enc, inst2.Enc = 0, 0
+		if err != nil || inst2 != inst {
+			t.Errorf("Encode(%v) [%x] = %x, decodes to %v, %v", inst, buf, enc, inst2, err)
+		}
+	}
+}
+
+var encodeTests = []struct {
+	inst Inst
+	enc  string // hex, or "error"
+}{
+	{Inst{Op: ADD, Args: Args{X0, X1, X2}}, "2000028b"},
+	{Inst{Op: ADD, Args: Args{X0, X1, Imm{Imm: 16}}}, "20400091"},
+	{Inst{Op: ADD, Args: Args{X0, X1, Imm{Imm: 4096}}}, "error"},
+	{Inst{Op: ADD, Args: Args{SP, SP, ImmShift{1, 12}}}, "ff074091"},
+	{Inst{Op: ADD, Args: Args{X0, W1, X2}}, "error"},
+	{Inst{Op: MOV, Args: Args{X0, X1}}, "e00301aa"},
+	{Inst{Op: MOV, Args: Args{X29, SP}}, "fd030091"},
+	{Inst{Op: MOV, Args: Args{X0, Imm64(0x12340000)}}, "8046a2d2"},
+	{Inst{Op: MOV, Args: Args{W0, Imm64(0xffffffff)}}, "00008012"},
+	{Inst{Op: MOV, Args: Args{X0, Imm64(0x5555555555555555)}}, "e0f300b2"},
+	{Inst{Op: AND, Args: Args{W0, W1, Imm64(0xff)}}, "201c0012"},
+	{Inst{Op: AND, Args: Args{W0, W1, Imm64(0x12345)}}, "error"},
+	{Inst{Op: LSL, Args: Args{X0, X1, Imm{Imm: 4}}}, "20ec7cd3"},
+	{Inst{Op: UBFX, Args: Args{W0, W1, Imm{Imm: 8}, Imm{Imm: 8}}}, "203c0853"},
+	{Inst{Op: LDR, Args: Args{X0, MemImm{X1, AddrOffset, 8}}}, "200440f9"},
+	{Inst{Op: LDR, Args: Args{X0, MemImm{X1, AddrOffset, 9}}}, "error"},
+	{Inst{Op: LDUR, Args: Args{X0, MemImm{X1, AddrOffset, 9}}}, "209040f8"},
+	{Inst{Op: LDR, Args: Args{W0, MemExtend{X1, RegExtend{X2, ExtendLSL, 2, false}}}}, "207862b8"},
+	{Inst{Op: STP, Args: Args{X29, X30, MemImm{SP, AddrPreIndex, -16}}}, "fd7bbfa9"},
+	{Inst{Op: B, Args: Args{PCRel(-4)}}, "ffffff17"},
+	{Inst{Op: B, Args: Args{Cond(1), PCRel(8)}}, "41000054"},
+	{Inst{Op: B, Args: Args{PCRel(2)}}, "error"},
+	{Inst{Op: TBZ, Args: Args{X0, Imm{Imm: 40}, PCRel(8)}}, "400040b6"},
+	{Inst{Op: CSET, Args: Args{W0, Cond(0)}}, "e0179f1a"},
+	{Inst{Op: RET}, "c0035fd6"},
+	{Inst{Op: RET, Args: Args{X0}}, "00005fd6"},
+	{Inst{Op: MRS, Args: Args{X0, Sysreg{3, 3, 13, 0, 2}}}, "40d03bd5"},
+	{Inst{Op: ADD, Args: Args{ZReg{0, ElemS}, ZReg{1, ElemS}, ZReg{2, ElemS}}}, "2000a204"},
+	{Inst{Op: ADD, Args: Args{ZReg{0, ElemS}, ZReg{1, ElemS}, ZReg{2, ElemD}}}, "error"},
+	{Inst{Op: PTRUE, Args: Args{PReg{0, ElemS, PredNone}}}, "e0e39825"},
+	{Inst{Op: LD1D, Args: Args{ZRegList{ZReg{0, ElemD}, 1}, PReg{0, ElemNone, PredZero}, MemVL{X0, 1}}}, "00a0e1a5"},
+	{Inst{Op: LD1D, Args: Args{ZRegList{ZReg{0, ElemD}, 1}, PReg{0, ElemNone, PredZero}, MemVL{X0, 8}}}, "error"},
+	{Inst{Op: MOV, Args: Args{ZReg{1, ElemH}, SImmShift{-1, 8}}}, "e1ff7825"},
+}
+
+func TestEncode(t *testing.T) {
+	for _, tt := range encodeTests {
+		enc, err := Encode(tt.inst)
+		want := tt.enc
+		if want == "error" {
+			if err == nil {
+				t.Errorf("Encode(%v) = %x, want error", tt.inst, enc)
+			}
+			continue
+		}
+		if err != nil || hex.EncodeToString(enc) != want {
+			t.Errorf("Encode(%v) = %x, %v, want %s", tt.inst, enc, err, want)
+		}
+	}
+}
+
+func TestEncodings(t *testing.T) {
+	encs := Encodings(ADD)
+	if len(encs) == 0 {
+		t.Fatal("Encodings(ADD) = none")
+	}
+	if enc := encs[0]; enc.Mask != 0x7f800000 || enc.Value != 0x11000000 || enc.Syntax != "ADD <Rd|SP>, <Rn|SP>, #<imm>{, LSL #12}" {
+		t.Errorf("Encodings(ADD)[0] = %+v", enc)
+	}
+	for op := Op(1); op < Op(len(opstr)); op++ {
+		encs := Encodings(op)
+		if len(encs) == 0 {
+			t.Errorf("Encodings(%v) = none", op)
+		}
+		for _, enc := range encs {
+			if enc.Value&^enc.Mask != 0 {
+				t.Errorf("Encodings(%v): value %#08x has bits outside mask %#08x", op, enc.Value, enc.Mask)
+			}
+		}
+	}
+}
@@ -0,0 +1,32 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+// ArgMask returns the bits of i's encoding, i.Enc, that determine i.Args[n]:
+// changing any of them changes or invalidates the decoded argument.
+// A bit shared by several arguments, such as the sf bit giving
+// the register width, appears in the mask of each.
+// The bits that select the instruction itself are not included.
+// ArgMask returns 0 if i.Enc does not decode or has no argument n.
+func (i Inst) ArgMask(n int) uint32 {
+	_, j := decode(i.Enc)
+	if j < 0 || n < 0 || n >= len(i.Args) {
+		return 0
+	}
+	f := &instFormats[j]
+	aop := f.args[n]
+	if aop == 0 {
+		return 0
+	}
+	arg := decodeArg(aop, i.Enc)
+	var mask uint32
+	for b := uint(0); b < 32; b++ {
+		bit := uint32(1) << b
+		if f.mask&bit == 0 && decodeArg(aop, i.Enc^bit) != arg {
+			mask |= bit
+		}
+	}
+	return mask
+}
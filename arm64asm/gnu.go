@@ -0,0 +1,162 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// GNUSyntax returns the GNU assembler syntax for the instruction, as defined by GNU binutils.
+// This form typically matches the syntax defined in the ARM Reference Manual,
+// in lower case.
+func GNUSyntax(inst Inst) string {
+	return gnuSyntax(inst, false)
+}
+
+// A GNUVersion identifies a GNU binutils release, such as 2.24.
+type GNUVersion struct {
+	Major, Minor int
+}
+
+// ParseGNUVersion parses a binutils release name
+// like "binutils-2.24" or "2.24".
+func ParseGNUVersion(s string) (GNUVersion, error) {
+	t := strings.TrimPrefix(s, "binutils-")
+	i := strings.Index(t, ".")
+	if i < 0 {
+		return GNUVersion{}, fmt.Errorf("invalid binutils version %q", s)
+	}
+	if j := strings.Index(t[i+1:], "."); j >= 0 {
+		t = t[:i+1+j] // ignore patch level
+	}
+	major, err1 := strconv.Atoi(t[:i])
+	minor, err2 := strconv.Atoi(t[i+1:])
+	if err1 != nil || err2 != nil || major < 0 || minor < 0 {
+		return GNUVersion{}, fmt.Errorf("invalid binutils version %q", s)
+	}
+	return GNUVersion{major, minor}, nil
+}
+
+func (v GNUVersion) String() string {
+	return fmt.Sprintf("binutils-%d.%d", v.Major, v.Minor)
+}
+
+// before reports whether v is an earlier release than major.minor.
+func (v GNUVersion) before(major, minor int) bool {
+	return v.Major < major || v.Major == major && v.Minor < minor
+}
+
+// GNUSyntaxVersion is like GNUSyntax but returns the text printed by
+// the objdump from binutils release v, for comparing against golden
+// files produced by that release. GNUSyntax corresponds to recent releases.
+// The known differences are in releases before 2.25, which
+//
+//   - print no space after commas inside memory operands, as in [x1,#8];
+//   - print MOVZ, MOVN, and ORR (immediate) instead of their MOV aliases.
+//
+// The list and the 2.25 boundary are not taken from the binutils
+// change log; TestObjdumpGNUVersion checks them against an installed objdump.
+// Instructions added to binutils after v are still printed
+// as in recent releases.
+func GNUSyntaxVersion(inst Inst, v GNUVersion) string {
+	return gnuSyntax(inst, v.before(2, 25))
+}
+
+// gnuSyntax returns the GNU syntax for inst,
+// in the form used before binutils 2.25 if old is set.
+func gnuSyntax(inst Inst, old bool) string {
+	if old && inst.Op == MOV {
+		inst = unaliasMOV(inst)
+	}
+	var buf bytes.Buffer
+	buf.WriteString(strings.ToLower(inst.Op.String()))
+	args := inst.Args[:]
+	if cond, ok := args[0].(Cond); ok && inst.Op == B {
+		buf.WriteString("." + strings.ToLower(cond.String()))
+		args = args[1:]
+	}
+	sep := " "
+	for i, arg := range args {
+		if arg == nil {
+			break
+		}
+		buf.WriteString(sep)
+		sep = ", "
+		text := gnuArg(&inst, i, arg)
+		if old {
+			switch arg.(type) {
+			case MemImm, MemExtend, MemPostReg, MemVL:
+				// Only the commas inside the brackets lose their spaces.
+				if j := strings.Index(text, "]"); j >= 0 {
+					text = strings.Replace(text[:j], ", ", ",", -1) + text[j:]
+				}
+			}
+		}
+		buf.WriteString(text)
+	}
+	return buf.String()
+}
+
+// unaliasMOV returns the instruction that the MOV inst is an alias of,
+// if it is an alias of MOVZ, MOVN, or ORR (immediate).
+// Otherwise it returns inst unchanged.
+func unaliasMOV(inst Inst) Inst {
+	for i := range instFormats {
+		f := &instFormats[i]
+		if inst.Enc&f.mask != f.value {
+			continue
+		}
+		switch {
+		case f.op == MOVZ, f.op == MOVN, f.op == ORR && f.args[2] == arg_bitmask:
+		default:
+			continue
+		}
+		args := Args{}
+		for j, aop := range f.args {
+			if aop == 0 {
+				break
+			}
+			if args[j] = decodeArg(aop, inst.Enc); args[j] == nil {
+				return inst
+			}
+		}
+		return Inst{Op: f.op, Enc: inst.Enc, Args: args}
+	}
+	return inst
+}
+
+var prfopType = [...]string{"PLD", "PLI", "PST"}
+
+// prfopName returns the name of the PRFM prefetch operation op,
+// such as PLDL1KEEP, or the empty string if op has no name.
+func prfopName(op uint32) string {
+	// Prefetch operation: type, target cache level, and policy.
+	typ, target, policy := op>>3, op>>1&3, op&1
+	if typ >= 3 || target >= 3 {
+		return ""
+	}
+	return fmt.Sprintf("%sL%d%s", prfopType[typ], target+1, []string{"KEEP", "STRM"}[policy])
+}
+
+func gnuArg(inst *Inst, argIndex int, arg Arg) string {
+	switch arg := arg.(type) {
+	case Imm:
+		switch inst.Op {
+		case PRFM:
+			if name := prfopName(arg.Imm); name != "" {
+				return strings.ToLower(name)
+			}
+		case CCMN, CCMP:
+			if argIndex == 2 {
+				// binutils prints the NZCV flags in hex.
+				return fmt.Sprintf("#%#x", arg.Imm)
+			}
+		}
+	}
+	return strings.ToLower(arg.String())
+}
@@ -0,0 +1,899 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package arm64asm implements decoding of A64 instructions,
+// the instruction set used by ARMv8 processors in the AArch64 execution state.
+//
+// It is a sibling of armasm, which decodes the 32-bit ARM instruction set,
+// and follows its design: Decode matches the instruction word against a
+// table of encodings and decodes the arguments of the first match.
+// As with armasm, the table in tables.go is generated: the arm64map command
+// writes it from the instruction list in arm64.csv (see the Makefile),
+// and can also generate lines for arm64.csv from ARM's machine-readable
+// XML specification.
+// It covers the base integer instruction set: data processing, loads and stores,
+// branches, the ARMv8.1 LSE atomics (CAS, CASP, SWP, LDADD and the other
+// atomic memory operations), and the common system instructions, including the pointer
+// authentication and branch target identification instructions
+// (see PAC, BranchType, and LandingPad), along with a subset of the
+// Scalable Vector Extension (SVE and SVE2): unpredicated and predicated
+// integer arithmetic, bitwise operations, compares, predicate setup,
+// element counts, broadcasts, and contiguous loads and stores.
+// Advanced SIMD coverage includes SIMD and floating-point register loads
+// and stores, structure loads and stores, vector integer, logical, and
+// floating-point arithmetic, element moves, and immediate moves.
+// Scalar floating-point arithmetic is not yet decoded.
+package arm64asm
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// An Op is an A64 opcode.
+type Op uint16
+
+// NOTE: The actual Op values are defined in tables.go.
+
+func (op Op) String() string {
+	if op >= Op(len(opstr)) || opstr[op] == "" {
+		return fmt.Sprintf("Op(%d)", int(op))
+	}
+	return opstr[op]
+}
+
+// OpDescription returns a short description of the opcode op,
+// such as "Load Exclusive Register" for LDXR, or "" if op is unknown.
+func OpDescription(op Op) string {
+	if op >= Op(len(opdesc)) {
+		return ""
+	}
+	return opdesc[op]
+}
+
+// An Inst is a single instruction.
+type Inst struct {
+	Op   Op     // Opcode mnemonic
+	Enc  uint32 // Raw encoding bits.
+	Args Args   // Instruction arguments, in ARM manual order.
+}
+
+// Len returns the length of the encoding of i in bytes.
+// A64 instructions are always 4 bytes.
+func (i Inst) Len() int {
+	return 4
+}
+
+func (i Inst) String() string {
+	var buf bytes.Buffer
+	args := i.Args[:]
+	buf.WriteString(i.Op.String())
+	if cond, ok := args[0].(Cond); ok && i.Op == B {
+		// Conditional branch: B.cond label.
+		buf.WriteString("." + cond.String())
+		args = args[1:]
+	}
+	for j, arg := range args {
+		if arg == nil {
+			break
+		}
+		if j == 0 {
+			buf.WriteString(" ")
+		} else {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(arg.String())
+	}
+	return buf.String()
+}
+
+// An Args holds the instruction arguments.
+// If an instruction has fewer than 5 arguments,
+// the final elements in the array are nil.
+type Args [5]Arg
+
+// An Arg is a single instruction argument, one of these types:
+// Reg, Imm, Imm64, ImmShift, PCRel, Cond, RegShift, RegExtend,
+// MemImm, MemExtend, MemPostReg, Sysreg, BarrierOpt, PState, BTITarget,
+// and for Advanced SIMD, VReg, VRegList, VElem,
+// and for SVE, ZReg, ZRegList, PReg, Pattern, Mul, SImmShift, MemVL.
+type Arg interface {
+	IsArg()
+	String() string
+}
+
+// A Reg is a single register.
+// The zero value denotes W0, not the absence of a register.
+type Reg uint16
+
+const (
+	W0 Reg = iota
+	W1
+	W2
+	W3
+	W4
+	W5
+	W6
+	W7
+	W8
+	W9
+	W10
+	W11
+	W12
+	W13
+	W14
+	W15
+	W16
+	W17
+	W18
+	W19
+	W20
+	W21
+	W22
+	W23
+	W24
+	W25
+	W26
+	W27
+	W28
+	W29
+	W30
+	WZR
+
+	X0
+	X1
+	X2
+	X3
+	X4
+	X5
+	X6
+	X7
+	X8
+	X9
+	X10
+	X11
+	X12
+	X13
+	X14
+	X15
+	X16
+	X17
+	X18
+	X19
+	X20
+	X21
+	X22
+	X23
+	X24
+	X25
+	X26
+	X27
+	X28
+	X29
+	X30
+	XZR
+
+	WSP
+	SP
+
+	// SIMD and floating-point scalar registers,
+	// the low 8, 16, 32, 64, or 128 bits of V0-V31.
+	B0
+	B1
+	B2
+	B3
+	B4
+	B5
+	B6
+	B7
+	B8
+	B9
+	B10
+	B11
+	B12
+	B13
+	B14
+	B15
+	B16
+	B17
+	B18
+	B19
+	B20
+	B21
+	B22
+	B23
+	B24
+	B25
+	B26
+	B27
+	B28
+	B29
+	B30
+	B31
+
+	H0
+	H1
+	H2
+	H3
+	H4
+	H5
+	H6
+	H7
+	H8
+	H9
+	H10
+	H11
+	H12
+	H13
+	H14
+	H15
+	H16
+	H17
+	H18
+	H19
+	H20
+	H21
+	H22
+	H23
+	H24
+	H25
+	H26
+	H27
+	H28
+	H29
+	H30
+	H31
+
+	S0
+	S1
+	S2
+	S3
+	S4
+	S5
+	S6
+	S7
+	S8
+	S9
+	S10
+	S11
+	S12
+	S13
+	S14
+	S15
+	S16
+	S17
+	S18
+	S19
+	S20
+	S21
+	S22
+	S23
+	S24
+	S25
+	S26
+	S27
+	S28
+	S29
+	S30
+	S31
+
+	D0
+	D1
+	D2
+	D3
+	D4
+	D5
+	D6
+	D7
+	D8
+	D9
+	D10
+	D11
+	D12
+	D13
+	D14
+	D15
+	D16
+	D17
+	D18
+	D19
+	D20
+	D21
+	D22
+	D23
+	D24
+	D25
+	D26
+	D27
+	D28
+	D29
+	D30
+	D31
+
+	Q0
+	Q1
+	Q2
+	Q3
+	Q4
+	Q5
+	Q6
+	Q7
+	Q8
+	Q9
+	Q10
+	Q11
+	Q12
+	Q13
+	Q14
+	Q15
+	Q16
+	Q17
+	Q18
+	Q19
+	Q20
+	Q21
+	Q22
+	Q23
+	Q24
+	Q25
+	Q26
+	Q27
+	Q28
+	Q29
+	Q30
+	Q31
+)
+
+func (Reg) IsArg() {}
+
+func (r Reg) String() string {
+	switch {
+	case r == WZR:
+		return "WZR"
+	case r == XZR:
+		return "XZR"
+	case r == WSP:
+		return "WSP"
+	case r == SP:
+		return "SP"
+	case W0 <= r && r <= W30:
+		return fmt.Sprintf("W%d", int(r-W0))
+	case X0 <= r && r <= X30:
+		return fmt.Sprintf("X%d", int(r-X0))
+	case B0 <= r && r <= Q31:
+		return fmt.Sprintf("%c%d", "BHSDQ"[(r-B0)/32], int(r-B0)%32)
+	}
+	return fmt.Sprintf("Reg(%d)", int(r))
+}
+
+// Is64 reports whether r is a 64-bit general register: X0-X30, XZR, or SP.
+func (r Reg) Is64() bool {
+	return X0 <= r && r <= XZR || r == SP
+}
+
+// Num returns the encoding number of r, 0-31.
+// Both the zero register and the stack pointer are number 31.
+func (r Reg) Num() int {
+	switch {
+	case r <= WZR:
+		return int(r - W0)
+	case r <= XZR:
+		return int(r - X0)
+	case B0 <= r && r <= Q31:
+		return int(r-B0) % 32
+	}
+	return 31
+}
+
+// IsSIMD reports whether r is a SIMD and floating-point register: B0-Q31.
+func (r Reg) IsSIMD() bool {
+	return B0 <= r && r <= Q31
+}
+
+// simdReg returns the SIMD and floating-point register numbered n
+// with the given size.
+func simdReg(n uint32, size ElemSize) Reg {
+	return B0 + Reg(size-ElemB)*32 + Reg(n&31)
+}
+
+// An Imm is an integer constant.
+type Imm struct {
+	Imm     uint32
+	Decimal bool // print in decimal
+}
+
+func (Imm) IsArg() {}
+
+func (i Imm) String() string {
+	if i.Decimal {
+		return fmt.Sprintf("#%d", i.Imm)
+	}
+	return fmt.Sprintf("#%#x", i.Imm)
+}
+
+// An Imm64 is a 64-bit integer constant, such as a logical immediate.
+type Imm64 uint64
+
+func (Imm64) IsArg() {}
+
+func (i Imm64) String() string {
+	return fmt.Sprintf("#%#x", uint64(i))
+}
+
+// An ImmShift is an immediate shifted left by Shift bits, as in MOVK and ADD.
+type ImmShift struct {
+	Imm   uint16
+	Shift uint8
+}
+
+func (ImmShift) IsArg() {}
+
+func (i ImmShift) String() string {
+	if i.Shift == 0 {
+		return fmt.Sprintf("#%#x", i.Imm)
+	}
+	return fmt.Sprintf("#%#x, LSL #%d", i.Imm, i.Shift)
+}
+
+// A PCRel describes a memory address (usually a code label)
+// as a distance relative to the address of the instruction.
+// For ADRP, the distance is relative to the address of the instruction
+// with its low 12 bits cleared.
+type PCRel int64
+
+func (PCRel) IsArg() {}
+
+func (r PCRel) String() string {
+	return fmt.Sprintf(".%+#x", int64(r))
+}
+
+// A Cond is a condition code.
+type Cond uint8
+
+var condName = [16]string{"EQ", "NE", "CS", "CC", "MI", "PL", "VS", "VC", "HI", "LS", "GE", "LT", "GT", "LE", "AL", "NV"}
+
+func (Cond) IsArg() {}
+
+func (c Cond) String() string {
+	return condName[c&15]
+}
+
+// A Shift is a shift applied to a register operand.
+type Shift uint8
+
+const (
+	ShiftLSL Shift = iota
+	ShiftLSR
+	ShiftASR
+	ShiftROR
+)
+
+var shiftName = [...]string{"LSL", "LSR", "ASR", "ROR"}
+
+func (s Shift) String() string {
+	if int(s) < len(shiftName) {
+		return shiftName[s]
+	}
+	return fmt.Sprintf("Shift(%d)", int(s))
+}
+
+// A RegShift is a register shifted by a constant.
+type RegShift struct {
+	Reg    Reg
+	Shift  Shift
+	Amount uint8
+}
+
+func (RegShift) IsArg() {}
+
+func (r RegShift) String() string {
+	if r.Amount == 0 && r.Shift == ShiftLSL {
+		return r.Reg.String()
+	}
+	return fmt.Sprintf("%s, %s #%d", r.Reg, r.Shift, r.Amount)
+}
+
+// An Extend is a register extension applied to a register operand.
+type Extend uint8
+
+const (
+	ExtendUXTB Extend = iota
+	ExtendUXTH
+	ExtendUXTW
+	ExtendUXTX
+	ExtendSXTB
+	ExtendSXTH
+	ExtendSXTW
+	ExtendSXTX
+	ExtendLSL // UXTW or UXTX written as LSL
+)
+
+var extendName = [...]string{"UXTB", "UXTH", "UXTW", "UXTX", "SXTB", "SXTH", "SXTW", "SXTX", "LSL"}
+
+func (e Extend) String() string {
+	if int(e) < len(extendName) {
+		return extendName[e]
+	}
+	return fmt.Sprintf("Extend(%d)", int(e))
+}
+
+// A RegExtend is a register with an extension and left shift.
+type RegExtend struct {
+	Reg       Reg
+	Extend    Extend
+	Amount    uint8
+	HasAmount bool // Amount is written explicitly, even if zero
+}
+
+func (RegExtend) IsArg() {}
+
+func (r RegExtend) String() string {
+	switch {
+	case r.Extend == ExtendLSL && r.Amount == 0 && !r.HasAmount:
+		return r.Reg.String()
+	case r.Amount == 0 && !r.HasAmount:
+		return fmt.Sprintf("%s, %s", r.Reg, r.Extend)
+	}
+	return fmt.Sprintf("%s, %s #%d", r.Reg, r.Extend, r.Amount)
+}
+
+// An AddrMode is an addressing mode.
+type AddrMode uint8
+
+const (
+	_             AddrMode = iota
+	AddrOffset             // [R, #imm]
+	AddrPreIndex           // [R, #imm]!
+	AddrPostIndex          // [R], #imm
+)
+
+// A MemImm is a memory reference made up of a base register
+// and an immediate offset.
+type MemImm struct {
+	Base Reg
+	Mode AddrMode
+	Imm  int32
+}
+
+func (MemImm) IsArg() {}
+
+func (m MemImm) String() string {
+	switch m.Mode {
+	case AddrPreIndex:
+		return fmt.Sprintf("[%s, #%d]!", m.Base, m.Imm)
+	case AddrPostIndex:
+		return fmt.Sprintf("[%s], #%d", m.Base, m.Imm)
+	}
+	if m.Imm == 0 {
+		return fmt.Sprintf("[%s]", m.Base)
+	}
+	return fmt.Sprintf("[%s, #%d]", m.Base, m.Imm)
+}
+
+// A MemExtend is a memory reference made up of a base register
+// and an extended, shifted index register.
+type MemExtend struct {
+	Base  Reg
+	Index RegExtend
+}
+
+func (MemExtend) IsArg() {}
+
+func (m MemExtend) String() string {
+	return fmt.Sprintf("[%s, %s]", m.Base, m.Index)
+}
+
+// A Sysreg is a system register, identified by its encoding.
+type Sysreg struct {
+	Op0, Op1, CRn, CRm, Op2 uint8
+}
+
+func (Sysreg) IsArg() {}
+
+// String returns the architectural name of s if it has one,
+// and otherwise the generic form S<op0>_<op1>_C<n>_C<m>_<op2>.
+func (s Sysreg) String() string {
+	if name := s.Name(); name != "" {
+		return name
+	}
+	return fmt.Sprintf("S%d_%d_C%d_C%d_%d", s.Op0, s.Op1, s.CRn, s.CRm, s.Op2)
+}
+
+// A BarrierOpt is the option operand of a DMB or DSB instruction.
+type BarrierOpt uint8
+
+var barrierName = [16]string{
+	1: "OSHLD", 2: "OSHST", 3: "OSH",
+	5: "NSHLD", 6: "NSHST", 7: "NSH",
+	9: "ISHLD", 10: "ISHST", 11: "ISH",
+	13: "LD", 14: "ST", 15: "SY",
+}
+
+func (BarrierOpt) IsArg() {}
+
+func (b BarrierOpt) String() string {
+	if name := barrierName[b&15]; name != "" {
+		return name
+	}
+	return fmt.Sprintf("#%#x", uint8(b))
+}
+
+// A PState is a processor state field written by MSR (immediate),
+// identified by its op1 and op2 fields as op1<<3 | op2.
+type PState uint8
+
+var pstateName = map[PState]string{
+	0<<3 | 5: "SPSel",
+	3<<3 | 6: "DAIFSet",
+	3<<3 | 7: "DAIFClr",
+}
+
+func (PState) IsArg() {}
+
+func (p PState) String() string {
+	if name, ok := pstateName[p]; ok {
+		return name
+	}
+	return fmt.Sprintf("PState(%d)", int(p))
+}
+
+// A BTITarget is the set of indirect branch types
+// accepted by a BTI landing pad.
+type BTITarget uint8
+
+const (
+	BTIC  BTITarget = 1 // calls: BLR, and BR through X16 or X17
+	BTIJ  BTITarget = 2 // jumps: BR
+	BTIJC BTITarget = 3 // both
+)
+
+func (BTITarget) IsArg() {}
+
+func (t BTITarget) String() string {
+	switch t {
+	case BTIC:
+		return "C"
+	case BTIJ:
+		return "J"
+	case BTIJC:
+		return "JC"
+	}
+	return fmt.Sprintf("BTITarget(%d)", int(t))
+}
+
+// An Arrangement is the arrangement of the elements
+// in an Advanced SIMD vector register, such as 16B or 2D.
+type Arrangement struct {
+	Count uint8    // number of elements
+	Elem  ElemSize // size of each element
+}
+
+func (a Arrangement) String() string {
+	return fmt.Sprintf("%d%s", a.Count, a.Elem)
+}
+
+// Bytes returns the number of bytes in a vector with arrangement a:
+// 8 or 16 for the arrangements used by A64.
+func (a Arrangement) Bytes() int {
+	return int(a.Count) << (a.Elem - ElemB)
+}
+
+// A VReg is an Advanced SIMD vector register, V0-V31,
+// with the arrangement of its elements.
+type VReg struct {
+	N   uint8
+	Arr Arrangement
+}
+
+func (VReg) IsArg() {}
+
+func (v VReg) String() string {
+	return fmt.Sprintf("V%d.%s", v.N, v.Arr)
+}
+
+// A VRegList is a list of consecutive vector registers,
+// wrapping from V31 to V0, as in the register list of LD1.
+type VRegList struct {
+	First VReg
+	Count uint8
+}
+
+func (VRegList) IsArg() {}
+
+func (l VRegList) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("{")
+	for i := 0; i < int(l.Count); i++ {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(VReg{(l.First.N + uint8(i)) & 31, l.First.Arr}.String())
+	}
+	buf.WriteString("}")
+	return buf.String()
+}
+
+// A VElem is a single element of a vector register, as in V0.S[1].
+type VElem struct {
+	N     uint8
+	Elem  ElemSize
+	Index uint8
+}
+
+func (VElem) IsArg() {}
+
+func (e VElem) String() string {
+	return fmt.Sprintf("V%d.%s[%d]", e.N, e.Elem, e.Index)
+}
+
+// A MemPostReg is a memory reference to a base register that is
+// incremented after the access by the value of another register,
+// as in LD1 {V0.16B}, [X0], X2.
+type MemPostReg struct {
+	Base  Reg
+	Index Reg
+}
+
+func (MemPostReg) IsArg() {}
+
+func (m MemPostReg) String() string {
+	return fmt.Sprintf("[%s], %s", m.Base, m.Index)
+}
+
+// An ElemSize is the size of the elements of a vector or predicate register.
+type ElemSize uint8
+
+const (
+	ElemNone ElemSize = iota // no element size
+	ElemB                    // 8-bit bytes
+	ElemH                    // 16-bit halfwords
+	ElemS                    // 32-bit words
+	ElemD                    // 64-bit doublewords
+	ElemQ                    // 128-bit quadwords
+)
+
+var elemSizeName = [...]string{"", "B", "H", "S", "D", "Q"}
+
+func (s ElemSize) String() string {
+	if int(s) < len(elemSizeName) {
+		return elemSizeName[s]
+	}
+	return fmt.Sprintf("ElemSize(%d)", int(s))
+}
+
+// A ZReg is an SVE scalable vector register, Z0-Z31,
+// with the size of the elements it holds.
+type ZReg struct {
+	N    uint8
+	Size ElemSize
+}
+
+func (ZReg) IsArg() {}
+
+func (z ZReg) String() string {
+	if z.Size == ElemNone {
+		return fmt.Sprintf("Z%d", z.N)
+	}
+	return fmt.Sprintf("Z%d.%s", z.N, z.Size)
+}
+
+// A ZRegList is a list of consecutive SVE vector registers,
+// as in the register list of a structure load or store.
+type ZRegList struct {
+	First ZReg
+	Count uint8
+}
+
+func (ZRegList) IsArg() {}
+
+func (l ZRegList) String() string {
+	var buf bytes.Buffer
+	buf.WriteString("{")
+	for i := 0; i < int(l.Count); i++ {
+		if i > 0 {
+			buf.WriteString(", ")
+		}
+		buf.WriteString(ZReg{(l.First.N + uint8(i)) & 31, l.First.Size}.String())
+	}
+	buf.WriteString("}")
+	return buf.String()
+}
+
+// A PredQual is the qualifier of a governing predicate,
+// saying what happens to inactive elements of the destination.
+type PredQual uint8
+
+const (
+	PredNone  PredQual = iota
+	PredZero           // /Z: inactive elements are set to zero
+	PredMerge          // /M: inactive elements are left unchanged
+)
+
+// A PReg is an SVE predicate register, P0-P15, with either the size of
+// the elements it governs or, as a governing predicate, its qualifier.
+type PReg struct {
+	N    uint8
+	Size ElemSize
+	Qual PredQual
+}
+
+func (PReg) IsArg() {}
+
+func (p PReg) String() string {
+	switch {
+	case p.Qual == PredZero:
+		return fmt.Sprintf("P%d/Z", p.N)
+	case p.Qual == PredMerge:
+		return fmt.Sprintf("P%d/M", p.N)
+	case p.Size != ElemNone:
+		return fmt.Sprintf("P%d.%s", p.N, p.Size)
+	}
+	return fmt.Sprintf("P%d", p.N)
+}
+
+// A Pattern is an SVE predicate constraint, such as VL8 or ALL,
+// selecting the number of active elements.
+type Pattern uint8
+
+func (Pattern) IsArg() {}
+
+func (p Pattern) String() string {
+	switch {
+	case p == 0:
+		return "POW2"
+	case 1 <= p && p <= 8:
+		return fmt.Sprintf("VL%d", int(p))
+	case 9 <= p && p <= 13:
+		return fmt.Sprintf("VL%d", 16<<(p-9))
+	case p == 29:
+		return "MUL4"
+	case p == 30:
+		return "MUL3"
+	case p == 31:
+		return "ALL"
+	}
+	return fmt.Sprintf("#%#x", uint8(p))
+}
+
+// A Mul is the multiplier applied to an SVE element count, as in CNTD X0, ALL, MUL #4.
+type Mul uint8
+
+func (Mul) IsArg() {}
+
+func (m Mul) String() string {
+	return fmt.Sprintf("MUL #%d", m)
+}
+
+// A SImmShift is a signed immediate shifted left by Shift bits,
+// as in MOV Z0.H, #-1, LSL #8.
+type SImmShift struct {
+	Imm   int32
+	Shift uint8
+}
+
+func (SImmShift) IsArg() {}
+
+func (i SImmShift) String() string {
+	if i.Shift == 0 {
+		return fmt.Sprintf("#%d", i.Imm)
+	}
+	return fmt.Sprintf("#%d, LSL #%d", i.Imm, i.Shift)
+}
+
+// A MemVL is an SVE memory reference made up of a base register and
+// an immediate offset in multiples of the vector length,
+// or of the predicate length for predicate registers.
+type MemVL struct {
+	Base Reg
+	Imm  int32
+}
+
+func (MemVL) IsArg() {}
+
+func (m MemVL) String() string {
+	if m.Imm == 0 {
+		return fmt.Sprintf("[%s]", m.Base)
+	}
+	return fmt.Sprintf("[%s, #%d, MUL VL]", m.Base, m.Imm)
+}
@@ -0,0 +1,85 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// TestObjdumpGNUVersion checks GNUSyntaxVersion against the AArch64
+// objdump named by $ARM64_OBJDUMP, such as aarch64-linux-gnu-objdump,
+// using the release reported by its -v flag. Running it with
+// objdumps from binutils 2.24 and a current release checks both
+// sides of each difference listed in gnuVersionTests.
+func TestObjdumpGNUVersion(t *testing.T) {
+	objdump := os.Getenv("ARM64_OBJDUMP")
+	if objdump == "" {
+		t.Skip("ARM64_OBJDUMP not set")
+	}
+	out, err := exec.Command(objdump, "-v").Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	line := strings.SplitN(string(out), "\n", 2)[0]
+	f := strings.Fields(line)
+	if len(f) == 0 {
+		t.Fatalf("cannot find version in %q", line)
+	}
+	v, err := ParseGNUVersion(f[len(f)-1])
+	if err != nil {
+		t.Fatalf("cannot find version in %q: %v", line, err)
+	}
+
+	var code []byte
+	for _, tt := range gnuVersionTests {
+		code = binary.LittleEndian.AppendUint32(code, tt.enc)
+	}
+	file := filepath.Join(t.TempDir(), "code.bin")
+	if err := ioutil.WriteFile(file, code, 0666); err != nil {
+		t.Fatal(err)
+	}
+	out, err = exec.Command(objdump, "-b", "binary", "-m", "aarch64", "-D", "-z", file).Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Lines look like "   4:\ta9bf7bfd \tstp\tx29, x30, [sp, #-16]!".
+	n := 0
+	for _, line := range bytes.Split(out, []byte("\n")) {
+		f := strings.Split(string(line), "\t")
+		if len(f) < 3 || !strings.HasSuffix(f[0], ":") {
+			continue
+		}
+		addr, err := strconv.ParseUint(strings.TrimSpace(strings.TrimSuffix(f[0], ":")), 16, 64)
+		if err != nil || addr%4 != 0 || addr/4 >= uint64(len(gnuVersionTests)) {
+			continue
+		}
+		text := strings.TrimSpace(strings.Join(f[2:], " "))
+		if i := strings.Index(text, " //"); i >= 0 {
+			text = strings.TrimSpace(text[:i]) // objdump comment
+		}
+		enc := gnuVersionTests[addr/4].enc
+		inst, err := Decode(code[addr:])
+		if err != nil {
+			t.Errorf("Decode(%#08x): %v", enc, err)
+			continue
+		}
+		if got := GNUSyntaxVersion(inst, v); got != text {
+			t.Errorf("GNUSyntaxVersion(%#08x, %v) = %q, but objdump prints %q", enc, v, got, text)
+		}
+		n++
+	}
+	if n != len(gnuVersionTests) {
+		t.Errorf("found %d instructions in objdump output, want %d:\n%s", n, len(gnuVersionTests), out)
+	}
+}
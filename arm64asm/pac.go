@@ -0,0 +1,128 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import "fmt"
+
+// A PACClass describes the part an instruction plays in pointer authentication.
+type PACClass uint8
+
+const (
+	PACNone   PACClass = iota // not a pointer authentication instruction
+	PACSign                   // adds a pointer authentication code, as PACIASP does
+	PACAuth                   // authenticates a signed pointer, as AUTIASP does
+	PACStrip                  // removes a pointer authentication code without checking it
+	PACBranch                 // authenticates a target and branches to it, as RETAA does
+	PACLoad                   // authenticates an address and loads from it
+)
+
+var pacClassName = [...]string{
+	PACNone:   "none",
+	PACSign:   "sign",
+	PACAuth:   "auth",
+	PACStrip:  "strip",
+	PACBranch: "branch",
+	PACLoad:   "load",
+}
+
+func (c PACClass) String() string {
+	if int(c) < len(pacClassName) {
+		return pacClassName[c]
+	}
+	return fmt.Sprintf("PACClass(%d)", int(c))
+}
+
+// PAC returns the pointer authentication class of i.
+func (i Inst) PAC() PACClass {
+	switch i.Op {
+	case PACIA, PACIB, PACDA, PACDB, PACIZA, PACIZB, PACDZA, PACDZB, PACGA,
+		PACIA1716, PACIB1716, PACIAZ, PACIBZ, PACIASP, PACIBSP:
+		return PACSign
+	case AUTIA, AUTIB, AUTDA, AUTDB, AUTIZA, AUTIZB, AUTDZA, AUTDZB,
+		AUTIA1716, AUTIB1716, AUTIAZ, AUTIBZ, AUTIASP, AUTIBSP:
+		return PACAuth
+	case XPACI, XPACD, XPACLRI:
+		return PACStrip
+	case BRAA, BRAB, BRAAZ, BRABZ, BLRAA, BLRAB, BLRAAZ, BLRABZ,
+		RETAA, RETAB, ERETAA, ERETAB:
+		return PACBranch
+	case LDRAA, LDRAB:
+		return PACLoad
+	}
+	return PACNone
+}
+
+// A BranchType is the type of an indirect branch, as recorded in PSTATE.BTYPE
+// for checking against the landing pad at the branch target.
+type BranchType uint8
+
+const (
+	BranchNone   BranchType = iota // not an indirect branch, or a return (BTYPE 00)
+	BranchJump16                   // BR or BRAA through X16 or X17 (BTYPE 01)
+	BranchCall                     // BLR or BLRAA (BTYPE 10)
+	BranchJump                     // BR or BRAA through any other register (BTYPE 11)
+)
+
+var branchTypeName = [...]string{
+	BranchNone:   "none",
+	BranchJump16: "jump16",
+	BranchCall:   "call",
+	BranchJump:   "jump",
+}
+
+func (t BranchType) String() string {
+	if int(t) < len(branchTypeName) {
+		return branchTypeName[t]
+	}
+	return fmt.Sprintf("BranchType(%d)", int(t))
+}
+
+// BranchType returns the type of the indirect branch i,
+// assuming i is in a guarded page; outside one, every BR is BranchJump16.
+func (i Inst) BranchType() BranchType {
+	switch i.Op {
+	case BLR, BLRAA, BLRAB, BLRAAZ, BLRABZ:
+		return BranchCall
+	case BR, BRAA, BRAB, BRAAZ, BRABZ:
+		if r, ok := i.Args[0].(Reg); ok && (r == X16 || r == X17) {
+			return BranchJump16
+		}
+		return BranchJump
+	}
+	return BranchNone
+}
+
+// LandingPad returns the indirect branch types that may target i
+// when branch target identification is enforced. It returns 0 if i
+// is not a landing pad. PACIASP and PACIBSP are implicit BTI C
+// landing pads, and BRK and HLT are compatible with every branch type.
+func (i Inst) LandingPad() BTITarget {
+	switch i.Op {
+	case BTI:
+		t, _ := i.Args[0].(BTITarget)
+		return t
+	case PACIASP, PACIBSP:
+		return BTIC
+	case BRK, HLT:
+		return BTIJC
+	}
+	return 0
+}
+
+// Accepts reports whether a landing pad accepting the branch types t
+// may be the target of an indirect branch of type b.
+func (t BTITarget) Accepts(b BranchType) bool {
+	switch b {
+	case BranchNone:
+		return true
+	case BranchJump16:
+		return t != 0
+	case BranchCall:
+		return t&BTIC != 0
+	case BranchJump:
+		return t&BTIJ != 0
+	}
+	return false
+}
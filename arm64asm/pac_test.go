@@ -0,0 +1,77 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+var pacTests = []struct {
+	enc    uint32
+	pac    PACClass
+	branch BranchType
+	pad    BTITarget
+}{
+	{0xd503233f, PACSign, BranchNone, BTIC},  // PACIASP
+	{0xd503237f, PACSign, BranchNone, BTIC},  // PACIBSP
+	{0xd50323bf, PACAuth, BranchNone, 0},     // AUTIASP
+	{0xd50320ff, PACStrip, BranchNone, 0},    // XPACLRI
+	{0xd65f0bff, PACBranch, BranchNone, 0},   // RETAA
+	{0xd71f0801, PACBranch, BranchJump, 0},   // BRAA X0, X1
+	{0xd71f0a01, PACBranch, BranchJump16, 0}, // BRAA X16, X1
+	{0xd73f081f, PACBranch, BranchCall, 0},   // BLRAA X0, SP
+	{0xd63f0200, PACNone, BranchCall, 0},     // BLR X16
+	{0xd61f0220, PACNone, BranchJump16, 0},   // BR X17
+	{0xd61f0000, PACNone, BranchJump, 0},     // BR X0
+	{0xf8200420, PACLoad, BranchNone, 0},     // LDRAA X0, [X1]
+	{0xdac10020, PACSign, BranchNone, 0},     // PACIA X0, X1
+	{0xd503241f, PACNone, BranchNone, 0},     // BTI
+	{0xd503245f, PACNone, BranchNone, BTIC},  // BTI C
+	{0xd503249f, PACNone, BranchNone, BTIJ},  // BTI J
+	{0xd50324df, PACNone, BranchNone, BTIJC}, // BTI JC
+	{0xd4200000, PACNone, BranchNone, BTIJC}, // BRK #0
+	{0xd503201f, PACNone, BranchNone, 0},     // NOP
+}
+
+func TestPAC(t *testing.T) {
+	var buf [4]byte
+	for _, tt := range pacTests {
+		binary.LittleEndian.PutUint32(buf[:], tt.enc)
+		inst, err := Decode(buf[:])
+		if err != nil {
+			t.Errorf("Decode(%#08x): %v", tt.enc, err)
+			continue
+		}
+		if pac := inst.PAC(); pac != tt.pac {
+			t.Errorf("%v: PAC() = %v, want %v", inst, pac, tt.pac)
+		}
+		if b := inst.BranchType(); b != tt.branch {
+			t.Errorf("%v: BranchType() = %v, want %v", inst, b, tt.branch)
+		}
+		if pad := inst.LandingPad(); pad != tt.pad {
+			t.Errorf("%v: LandingPad() = %v, want %v", inst, pad, tt.pad)
+		}
+	}
+}
+
+func TestBTIAccepts(t *testing.T) {
+	tests := []struct {
+		pad  BTITarget
+		want [4]bool // indexed by BranchType
+	}{
+		{0, [4]bool{true, false, false, false}},
+		{BTIC, [4]bool{true, true, true, false}},
+		{BTIJ, [4]bool{true, true, false, true}},
+		{BTIJC, [4]bool{true, true, true, true}},
+	}
+	for _, tt := range tests {
+		for b, want := range tt.want {
+			if got := tt.pad.Accepts(BranchType(b)); got != want {
+				t.Errorf("BTITarget(%d).Accepts(%v) = %v, want %v", tt.pad, BranchType(b), got, want)
+			}
+		}
+	}
+}
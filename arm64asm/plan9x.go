@@ -0,0 +1,406 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// GoSyntax returns the Go assembler syntax for the instruction,
+// following the conventions of cmd/internal/obj/arm64.
+// The syntax was originally defined by Plan 9.
+// The pc is the program counter of the instruction, used for expanding
+// PC-relative addresses into absolute ones.
+// The symname function queries the symbol table for the program
+// being disassembled. Given a target address it returns the name and base
+// address of the symbol containing the target, if any; otherwise it returns "", 0.
+// The reader text should read from the text segment using text addresses
+// as offsets; it is used to display pc-relative loads as constant loads.
+// Both symname and text may be nil.
+// SVE instructions and the few other instructions
+// that the Go assembler cannot express are returned in ARM syntax.
+func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text io.ReaderAt) string {
+	if symname == nil {
+		symname = func(uint64) (string, uint64) { return "", 0 }
+	}
+	if isSVE(inst) {
+		// The Go assembler has no syntax for SVE; use ARM's.
+		return inst.String()
+	}
+
+	var args []string
+	for _, a := range inst.Args {
+		if a == nil {
+			break
+		}
+		args = append(args, plan9Arg(&inst, pc, symname, a))
+	}
+	if isVector(inst) {
+		// Vector instructions take a V prefix: VADD, VLD1, VMOV.
+		op := "V" + inst.Op.String()
+		for _, a := range inst.Args {
+			op += plan9Suffix(a)
+		}
+		if !strings.HasPrefix(inst.Op.String(), "ST") {
+			for i, j := 0, len(args)-1; i < j; i, j = i+1, j-1 {
+				args[i], args[j] = args[j], args[i]
+			}
+		}
+		return op + " " + strings.Join(args, ", ")
+	}
+	if s, ok := plan9Atomic(inst, args); ok {
+		return s
+	}
+
+	op := inst.Op.String()
+
+	switch inst.Op {
+	case LDR, LDRB, LDRH, LDRSB, LDRSH, LDRSW, LDUR, LDURB, LDURH, LDURSB, LDURSH, LDURSW,
+		STR, STRB, STRH, STUR, STURB, STURH:
+		rt := inst.Args[0].(Reg)
+		op = plan9Move(inst.Op, rt.Is64()) + plan9Suffix(inst.Args[1])
+		if rt.IsSIMD() {
+			if rt < S0 {
+				// No Go syntax for byte and halfword SIMD registers.
+				return inst.String()
+			}
+			op = "FMOV" + rt.String()[:1] + plan9Suffix(inst.Args[1])
+		}
+		if pcrel, ok := inst.Args[1].(PCRel); ok && text != nil && rt < Q0 {
+			// Literal load: show the constant.
+			size := 4
+			if inst.Op == LDR && (rt.Is64() || D0 <= rt && rt <= D31) {
+				size = 8
+			}
+			buf := make([]byte, 8)
+			if _, err := text.ReadAt(buf[:size], int64(pc+uint64(pcrel))); err == nil {
+				x := binary.LittleEndian.Uint64(buf)
+				if s, base := symname(x); s != "" && x == base {
+					args[1] = fmt.Sprintf("$%s(SB)", s)
+				} else {
+					args[1] = fmt.Sprintf("$%#x", x)
+				}
+			}
+		}
+		if strings.HasPrefix(inst.Op.String(), "ST") {
+			// Stores are written source first, as in ARM order.
+			break
+		}
+		args[0], args[1] = args[1], args[0]
+
+	case LDP, LDNP, LDPSW, STP, STNP:
+		if rt := inst.Args[0].(Reg); rt.IsSIMD() {
+			if inst.Op != LDP && inst.Op != STP {
+				return inst.String()
+			}
+			// FLDPD, FSTPQ, and so on.
+			op = "F" + op + rt.String()[:1]
+		} else if !rt.Is64() {
+			op += "W"
+		}
+		op += plan9Suffix(inst.Args[2])
+		pair := "(" + args[0] + ", " + args[1] + ")"
+		if inst.Op == STP || inst.Op == STNP {
+			args = []string{pair, args[2]}
+		} else {
+			args = []string{args[2], pair}
+		}
+
+	case LDXR, LDAXR, LDAR, STLR, LDXRB, LDAXRB, LDARB, STLRB, LDXRH, LDAXRH, LDARH, STLRH:
+		if rt := inst.Args[0].(Reg); !rt.Is64() && !strings.HasSuffix(op, "B") && !strings.HasSuffix(op, "H") {
+			op += "W"
+		}
+		if !strings.HasPrefix(op, "ST") {
+			args[0], args[1] = args[1], args[0]
+		}
+
+	case STXR, STLXR, STXRB, STLXRB, STXRH, STLXRH:
+		if rt := inst.Args[1].(Reg); !rt.Is64() && !strings.HasSuffix(op, "B") && !strings.HasSuffix(op, "H") {
+			op += "W"
+		}
+		args = []string{args[1], args[2], args[0]}
+
+	case LDXP, LDAXP:
+		if !inst.Args[0].(Reg).Is64() {
+			op += "W"
+		}
+		args = []string{args[2], "(" + args[0] + ", " + args[1] + ")"}
+
+	case STXP, STLXP:
+		if !inst.Args[1].(Reg).Is64() {
+			op += "W"
+		}
+		args = []string{"(" + args[1] + ", " + args[2] + ")", args[3], args[0]}
+
+	case B:
+		if cond, ok := inst.Args[0].(Cond); ok {
+			return "B" + cond.String() + " " + args[1]
+		}
+		op = "JMP"
+	case BL:
+		op = "CALL"
+	case BR:
+		return "JMP (" + args[0] + ")"
+	case BLR:
+		return "CALL (" + args[0] + ")"
+	case RET:
+		if args != nil {
+			return "RET (" + args[0] + ")"
+		}
+	case NOP:
+		op = "NOOP"
+
+	case CBZ, CBNZ:
+		// Not reversed: CBZ Rt, label.
+		if !inst.Args[0].(Reg).Is64() {
+			op += "W"
+		}
+		return op + " " + strings.Join(args, ", ")
+
+	case TBZ, TBNZ:
+		return op + " " + args[1] + ", " + args[0] + ", " + args[2]
+
+	default:
+		if r, ok := inst.Args[0].(Reg); ok && !r.Is64() && !r.IsSIMD() {
+			op += "W"
+		}
+		switch inst.Op {
+		case MOV:
+			op = "MOVD"
+			if r, ok := inst.Args[0].(Reg); ok && !r.Is64() {
+				op = "MOVW"
+			}
+		case CSEL, CSINC, CSINV, CSNEG:
+			// CSEL cond, Rn, Rm, Rd.
+			return op + " " + strings.Join([]string{args[3], args[1], args[2], args[0]}, ", ")
+		case CCMN, CCMP:
+			// CCMP cond, Rn, Rm, $nzcv.
+			return op + " " + strings.Join([]string{args[3], args[0], args[1], args[2]}, ", ")
+		case MADD, MSUB, SMADDL, SMSUBL, UMADDL, UMSUBL:
+			// MADD Rm, Ra, Rn, Rd.
+			return op + " " + strings.Join([]string{args[2], args[3], args[1], args[0]}, ", ")
+		case BFI, BFXIL, SBFIZ, SBFX, UBFIZ, UBFX, BFM, SBFM, UBFM:
+			// UBFX $lsb, Rn, $width, Rd.
+			return op + " " + strings.Join([]string{args[2], args[1], args[3], args[0]}, ", ")
+		}
+		// Reverse args, placing dest last.
+		for i, j := 0, len(args)-1; i < j; i, j = i+1, j-1 {
+			args[i], args[j] = args[j], args[i]
+		}
+	}
+
+	if args != nil {
+		op += " " + strings.Join(args, ", ")
+	}
+	return op
+}
+
+// isSVE reports whether inst is an SVE instruction,
+// which are encoded in the space with bits 25-28 set to 0010.
+func isSVE(inst Inst) bool {
+	return inst.Enc>>25&15 == 2
+}
+
+// plan9Atomic returns the Go syntax for the LSE atomic instructions,
+// which the Go assembler writes with a D, W, H, or B size suffix and with
+// the memory operand between the source and destination: LDADDALD R1, (R2), R3.
+// The Go assembler has no STADD-style aliases, so they are written as the
+// load forms with a ZR destination, and it spells LDSET as LDOR.
+func plan9Atomic(inst Inst, args []string) (string, bool) {
+	x := inst.Enc
+	op := inst.Op.String()
+	switch {
+	case x&0xbfa07c00 == 0x08207c00:
+		// CASPD (R0, R1), (R4), (R2, R3).
+		if x>>30&1 == 1 {
+			op += "D"
+		} else {
+			op += "W"
+		}
+		return fmt.Sprintf("%s (%s, %s), %s, (%s, %s)", op, args[0], args[1], args[4], args[2], args[3]), true
+	case x&0x3fa07c00 == 0x08a07c00, x&0x3f200c00 == 0x38200000:
+		if strings.HasPrefix(op, "ST") {
+			op = "LD" + op[2:]
+			args = []string{args[0], "ZR", args[1]}
+		}
+		if strings.HasPrefix(op, "LDSET") {
+			op = "LDOR" + op[5:]
+		}
+		switch x >> 30 {
+		case 2:
+			op += "W"
+		case 3:
+			op += "D"
+		}
+		return op + " " + args[0] + ", " + args[2] + ", " + args[1], true
+	}
+	return "", false
+}
+
+// isVector reports whether inst is an Advanced SIMD vector instruction.
+func isVector(inst Inst) bool {
+	for _, a := range inst.Args {
+		switch a.(type) {
+		case VReg, VRegList, VElem:
+			return true
+		}
+	}
+	return false
+}
+
+// plan9Move returns the Go MOV instruction for the load or store op
+// with a 64-bit (is64) or 32-bit target register.
+func plan9Move(op Op, is64 bool) string {
+	switch op {
+	case LDR, LDUR:
+		if is64 {
+			return "MOVD"
+		}
+		return "MOVWU"
+	case STR, STUR:
+		if is64 {
+			return "MOVD"
+		}
+		return "MOVW"
+	case LDRB, LDURB:
+		return "MOVBU"
+	case LDRH, LDURH:
+		return "MOVHU"
+	case LDRSB, LDURSB:
+		if is64 {
+			return "MOVB"
+		}
+		return "MOVBW"
+	case LDRSH, LDURSH:
+		if is64 {
+			return "MOVH"
+		}
+		return "MOVHW"
+	case LDRSW, LDURSW:
+		return "MOVW"
+	case STRB, STURB:
+		return "MOVB"
+	case STRH, STURH:
+		return "MOVH"
+	}
+	return op.String()
+}
+
+// plan9Suffix returns the opcode suffix for the addressing mode of a
+// memory argument: .W for pre-index (writeback) and .P for post-index.
+func plan9Suffix(arg Arg) string {
+	switch mem := arg.(type) {
+	case MemImm:
+		switch mem.Mode {
+		case AddrPreIndex:
+			return ".W"
+		case AddrPostIndex:
+			return ".P"
+		}
+	case MemPostReg:
+		return ".P"
+	}
+	return ""
+}
+
+// assembler syntax for the various shifts.
+var plan9Shift = []string{"<<", ">>", "->", "@>"}
+
+func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg Arg) string {
+	switch a := arg.(type) {
+	case Reg:
+		switch {
+		case a == WZR || a == XZR:
+			return "ZR"
+		case a == WSP || a == SP:
+			return "RSP"
+		case a.IsSIMD():
+			return fmt.Sprintf("F%d", a.Num())
+		}
+		return fmt.Sprintf("R%d", a.Num())
+
+	case Imm:
+		if inst.Op == PRFM {
+			if name := prfopName(a.Imm); name != "" {
+				return name
+			}
+		}
+		return fmt.Sprintf("$%d", a.Imm)
+
+	case Imm64:
+		return fmt.Sprintf("$%d", int64(a))
+
+	case ImmShift:
+		if a.Shift == 0 {
+			return fmt.Sprintf("$%d", a.Imm)
+		}
+		return fmt.Sprintf("$(%d<<%d)", a.Imm, a.Shift)
+
+	case PCRel:
+		base := pc
+		if inst.Op == ADRP {
+			base &^= 0xfff
+		}
+		addr := base + uint64(a)
+		if s, base := symname(addr); s != "" && addr == base {
+			return fmt.Sprintf("%s(SB)", s)
+		}
+		return fmt.Sprintf("%#x", addr)
+
+	case RegShift:
+		r := plan9Arg(inst, pc, symname, a.Reg)
+		if a.Shift == ShiftLSL && a.Amount == 0 {
+			return r
+		}
+		return fmt.Sprintf("%s%s%d", r, plan9Shift[a.Shift], a.Amount)
+
+	case RegExtend:
+		r := plan9Arg(inst, pc, symname, a.Reg)
+		if a.Extend != ExtendLSL {
+			r += "." + a.Extend.String()
+		}
+		if a.Amount != 0 || a.Extend == ExtendLSL && a.HasAmount {
+			r += fmt.Sprintf("<<%d", a.Amount)
+		}
+		return r
+
+	case MemImm:
+		base := plan9Arg(inst, pc, symname, a.Base)
+		if a.Imm == 0 {
+			return "(" + base + ")"
+		}
+		return fmt.Sprintf("%d(%s)", a.Imm, base)
+
+	case MemExtend:
+		return fmt.Sprintf("(%s)(%s)", plan9Arg(inst, pc, symname, a.Base), plan9Arg(inst, pc, symname, a.Index))
+
+	case BarrierOpt:
+		return fmt.Sprintf("$%d", uint8(a))
+
+	case PState:
+		return a.String()
+
+	case VReg:
+		// Go writes arrangements element size first: V0.B16.
+		return fmt.Sprintf("V%d.%s%d", a.N, a.Arr.Elem, a.Arr.Count)
+
+	case VRegList:
+		var regs []string
+		for i := 0; i < int(a.Count); i++ {
+			regs = append(regs, plan9Arg(inst, pc, symname, VReg{(a.First.N + uint8(i)) & 31, a.First.Arr}))
+		}
+		return "[" + strings.Join(regs, ", ") + "]"
+
+	case VElem:
+		return a.String()
+
+	case MemPostReg:
+		return fmt.Sprintf("(%s)(%s)", plan9Arg(inst, pc, symname, a.Base), plan9Arg(inst, pc, symname, a.Index))
+	}
+	return strings.ToUpper(arg.String())
+}
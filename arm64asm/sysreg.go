@@ -0,0 +1,55 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// key returns the encoding fields of s packed into a single sortable value.
+func (s Sysreg) key() uint32 {
+	return uint32(s.Op0)<<24 | uint32(s.Op1)<<16 | uint32(s.CRn)<<12 | uint32(s.CRm)<<4 | uint32(s.Op2)
+}
+
+// Name returns the architectural name of the system register s,
+// such as TTBR0_EL1, or the empty string if s has no known name.
+// Implementation-defined registers have no names.
+func (s Sysreg) Name() string {
+	k := s.key()
+	i := sort.Search(len(sysregs), func(i int) bool { return sysregs[i].reg.key() >= k })
+	if i < len(sysregs) && sysregs[i].reg == s {
+		return sysregs[i].name
+	}
+	return ""
+}
+
+// LookupSysreg returns the system register with the given name.
+// The name is case-insensitive and may be either an architectural name,
+// such as MIDR_EL1, or the generic form S<op0>_<op1>_C<n>_C<m>_<op2>.
+func LookupSysreg(name string) (Sysreg, bool) {
+	for _, r := range sysregs {
+		if strings.EqualFold(r.name, name) {
+			return r.reg, true
+		}
+	}
+	var s Sysreg
+	var extra string
+	n, _ := fmt.Sscanf(strings.ToUpper(name)+" ", "S%d_%d_C%d_C%d_%d%s", &s.Op0, &s.Op1, &s.CRn, &s.CRm, &s.Op2, &extra)
+	if n != 5 || s.Op0 < 2 || s.Op0 > 3 || s.Op1 > 7 || s.CRn > 15 || s.CRm > 15 || s.Op2 > 7 {
+		return Sysreg{}, false
+	}
+	return s, true
+}
+
+// Sysregs returns the system registers with known names, in encoding order.
+func Sysregs() []Sysreg {
+	regs := make([]Sysreg, len(sysregs))
+	for i, r := range sysregs {
+		regs[i] = r.reg
+	}
+	return regs
+}
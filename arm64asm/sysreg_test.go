@@ -0,0 +1,49 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+import "testing"
+
+func TestSysregsSorted(t *testing.T) {
+	if n := len(Sysregs()); n != len(sysregs) {
+		t.Errorf("len(Sysregs()) = %d, want %d", n, len(sysregs))
+	}
+	for i := 1; i < len(sysregs); i++ {
+		if sysregs[i-1].reg.key() >= sysregs[i].reg.key() {
+			t.Errorf("sysregs out of order: %s before %s", sysregs[i-1].name, sysregs[i].name)
+		}
+	}
+}
+
+var sysregTests = []struct {
+	name string
+	reg  Sysreg
+	ok   bool
+}{
+	{"MIDR_EL1", Sysreg{3, 0, 0, 0, 0}, true},
+	{"ttbr0_el1", Sysreg{3, 0, 2, 0, 0}, true},
+	{"CurrentEL", Sysreg{3, 0, 4, 2, 2}, true},
+	{"DBGBVR15_EL1", Sysreg{2, 0, 0, 15, 4}, true},
+	{"S3_7_C15_C2_0", Sysreg{3, 7, 15, 2, 0}, true},
+	{"s3_0_c2_c0_0", Sysreg{3, 0, 2, 0, 0}, true},
+	{"S1_0_C2_C0_0", Sysreg{}, false},
+	{"S3_0_C2_C0_0x", Sysreg{}, false},
+	{"NOSUCH_EL1", Sysreg{}, false},
+}
+
+func TestLookupSysreg(t *testing.T) {
+	for _, tt := range sysregTests {
+		reg, ok := LookupSysreg(tt.name)
+		if reg != tt.reg || ok != tt.ok {
+			t.Errorf("LookupSysreg(%q) = %v, %v, want %v, %v", tt.name, reg, ok, tt.reg, tt.ok)
+		}
+	}
+	if name := (Sysreg{3, 3, 13, 0, 2}).Name(); name != "TPIDR_EL0" {
+		t.Errorf("Name() = %q, want TPIDR_EL0", name)
+	}
+	if name := (Sysreg{3, 7, 15, 2, 0}).Name(); name != "" {
+		t.Errorf("Name() = %q, want empty", name)
+	}
+}
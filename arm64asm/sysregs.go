@@ -0,0 +1,299 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arm64asm
+
+// sysregs lists the architectural system registers by encoding,
+// sorted by Op0, Op1, CRn, CRm, Op2.
+var sysregs = [...]struct {
+	reg  Sysreg
+	name string
+}{
+	{Sysreg{2, 0, 0, 0, 2}, "OSDTRRX_EL1"},
+	{Sysreg{2, 0, 0, 0, 4}, "DBGBVR0_EL1"},
+	{Sysreg{2, 0, 0, 0, 5}, "DBGBCR0_EL1"},
+	{Sysreg{2, 0, 0, 0, 6}, "DBGWVR0_EL1"},
+	{Sysreg{2, 0, 0, 0, 7}, "DBGWCR0_EL1"},
+	{Sysreg{2, 0, 0, 1, 4}, "DBGBVR1_EL1"},
+	{Sysreg{2, 0, 0, 1, 5}, "DBGBCR1_EL1"},
+	{Sysreg{2, 0, 0, 1, 6}, "DBGWVR1_EL1"},
+	{Sysreg{2, 0, 0, 1, 7}, "DBGWCR1_EL1"},
+	{Sysreg{2, 0, 0, 2, 0}, "MDCCINT_EL1"},
+	{Sysreg{2, 0, 0, 2, 2}, "MDSCR_EL1"},
+	{Sysreg{2, 0, 0, 2, 4}, "DBGBVR2_EL1"},
+	{Sysreg{2, 0, 0, 2, 5}, "DBGBCR2_EL1"},
+	{Sysreg{2, 0, 0, 2, 6}, "DBGWVR2_EL1"},
+	{Sysreg{2, 0, 0, 2, 7}, "DBGWCR2_EL1"},
+	{Sysreg{2, 0, 0, 3, 2}, "OSDTRTX_EL1"},
+	{Sysreg{2, 0, 0, 3, 4}, "DBGBVR3_EL1"},
+	{Sysreg{2, 0, 0, 3, 5}, "DBGBCR3_EL1"},
+	{Sysreg{2, 0, 0, 3, 6}, "DBGWVR3_EL1"},
+	{Sysreg{2, 0, 0, 3, 7}, "DBGWCR3_EL1"},
+	{Sysreg{2, 0, 0, 4, 4}, "DBGBVR4_EL1"},
+	{Sysreg{2, 0, 0, 4, 5}, "DBGBCR4_EL1"},
+	{Sysreg{2, 0, 0, 4, 6}, "DBGWVR4_EL1"},
+	{Sysreg{2, 0, 0, 4, 7}, "DBGWCR4_EL1"},
+	{Sysreg{2, 0, 0, 5, 4}, "DBGBVR5_EL1"},
+	{Sysreg{2, 0, 0, 5, 5}, "DBGBCR5_EL1"},
+	{Sysreg{2, 0, 0, 5, 6}, "DBGWVR5_EL1"},
+	{Sysreg{2, 0, 0, 5, 7}, "DBGWCR5_EL1"},
+	{Sysreg{2, 0, 0, 6, 2}, "OSECCR_EL1"},
+	{Sysreg{2, 0, 0, 6, 4}, "DBGBVR6_EL1"},
+	{Sysreg{2, 0, 0, 6, 5}, "DBGBCR6_EL1"},
+	{Sysreg{2, 0, 0, 6, 6}, "DBGWVR6_EL1"},
+	{Sysreg{2, 0, 0, 6, 7}, "DBGWCR6_EL1"},
+	{Sysreg{2, 0, 0, 7, 4}, "DBGBVR7_EL1"},
+	{Sysreg{2, 0, 0, 7, 5}, "DBGBCR7_EL1"},
+	{Sysreg{2, 0, 0, 7, 6}, "DBGWVR7_EL1"},
+	{Sysreg{2, 0, 0, 7, 7}, "DBGWCR7_EL1"},
+	{Sysreg{2, 0, 0, 8, 4}, "DBGBVR8_EL1"},
+	{Sysreg{2, 0, 0, 8, 5}, "DBGBCR8_EL1"},
+	{Sysreg{2, 0, 0, 8, 6}, "DBGWVR8_EL1"},
+	{Sysreg{2, 0, 0, 8, 7}, "DBGWCR8_EL1"},
+	{Sysreg{2, 0, 0, 9, 4}, "DBGBVR9_EL1"},
+	{Sysreg{2, 0, 0, 9, 5}, "DBGBCR9_EL1"},
+	{Sysreg{2, 0, 0, 9, 6}, "DBGWVR9_EL1"},
+	{Sysreg{2, 0, 0, 9, 7}, "DBGWCR9_EL1"},
+	{Sysreg{2, 0, 0, 10, 4}, "DBGBVR10_EL1"},
+	{Sysreg{2, 0, 0, 10, 5}, "DBGBCR10_EL1"},
+	{Sysreg{2, 0, 0, 10, 6}, "DBGWVR10_EL1"},
+	{Sysreg{2, 0, 0, 10, 7}, "DBGWCR10_EL1"},
+	{Sysreg{2, 0, 0, 11, 4}, "DBGBVR11_EL1"},
+	{Sysreg{2, 0, 0, 11, 5}, "DBGBCR11_EL1"},
+	{Sysreg{2, 0, 0, 11, 6}, "DBGWVR11_EL1"},
+	{Sysreg{2, 0, 0, 11, 7}, "DBGWCR11_EL1"},
+	{Sysreg{2, 0, 0, 12, 4}, "DBGBVR12_EL1"},
+	{Sysreg{2, 0, 0, 12, 5}, "DBGBCR12_EL1"},
+	{Sysreg{2, 0, 0, 12, 6}, "DBGWVR12_EL1"},
+	{Sysreg{2, 0, 0, 12, 7}, "DBGWCR12_EL1"},
+	{Sysreg{2, 0, 0, 13, 4}, "DBGBVR13_EL1"},
+	{Sysreg{2, 0, 0, 13, 5}, "DBGBCR13_EL1"},
+	{Sysreg{2, 0, 0, 13, 6}, "DBGWVR13_EL1"},
+	{Sysreg{2, 0, 0, 13, 7}, "DBGWCR13_EL1"},
+	{Sysreg{2, 0, 0, 14, 4}, "DBGBVR14_EL1"},
+	{Sysreg{2, 0, 0, 14, 5}, "DBGBCR14_EL1"},
+	{Sysreg{2, 0, 0, 14, 6}, "DBGWVR14_EL1"},
+	{Sysreg{2, 0, 0, 14, 7}, "DBGWCR14_EL1"},
+	{Sysreg{2, 0, 0, 15, 4}, "DBGBVR15_EL1"},
+	{Sysreg{2, 0, 0, 15, 5}, "DBGBCR15_EL1"},
+	{Sysreg{2, 0, 0, 15, 6}, "DBGWVR15_EL1"},
+	{Sysreg{2, 0, 0, 15, 7}, "DBGWCR15_EL1"},
+	{Sysreg{2, 0, 1, 0, 0}, "MDRAR_EL1"},
+	{Sysreg{2, 0, 1, 0, 4}, "OSLAR_EL1"},
+	{Sysreg{2, 0, 1, 1, 4}, "OSLSR_EL1"},
+	{Sysreg{2, 0, 1, 3, 4}, "OSDLR_EL1"},
+	{Sysreg{2, 0, 1, 4, 4}, "DBGPRCR_EL1"},
+	{Sysreg{2, 0, 7, 8, 6}, "DBGCLAIMSET_EL1"},
+	{Sysreg{2, 0, 7, 9, 6}, "DBGCLAIMCLR_EL1"},
+	{Sysreg{2, 0, 7, 14, 6}, "DBGAUTHSTATUS_EL1"},
+	{Sysreg{2, 3, 0, 1, 0}, "MDCCSR_EL0"},
+	{Sysreg{2, 3, 0, 4, 0}, "DBGDTR_EL0"},
+	{Sysreg{2, 3, 0, 5, 0}, "DBGDTRRX_EL0"},
+	{Sysreg{2, 4, 0, 7, 0}, "DBGVCR32_EL2"},
+	{Sysreg{3, 0, 0, 0, 0}, "MIDR_EL1"},
+	{Sysreg{3, 0, 0, 0, 5}, "MPIDR_EL1"},
+	{Sysreg{3, 0, 0, 0, 6}, "REVIDR_EL1"},
+	{Sysreg{3, 0, 0, 1, 0}, "ID_PFR0_EL1"},
+	{Sysreg{3, 0, 0, 1, 1}, "ID_PFR1_EL1"},
+	{Sysreg{3, 0, 0, 1, 2}, "ID_DFR0_EL1"},
+	{Sysreg{3, 0, 0, 1, 3}, "ID_AFR0_EL1"},
+	{Sysreg{3, 0, 0, 1, 4}, "ID_MMFR0_EL1"},
+	{Sysreg{3, 0, 0, 1, 5}, "ID_MMFR1_EL1"},
+	{Sysreg{3, 0, 0, 1, 6}, "ID_MMFR2_EL1"},
+	{Sysreg{3, 0, 0, 1, 7}, "ID_MMFR3_EL1"},
+	{Sysreg{3, 0, 0, 2, 0}, "ID_ISAR0_EL1"},
+	{Sysreg{3, 0, 0, 2, 1}, "ID_ISAR1_EL1"},
+	{Sysreg{3, 0, 0, 2, 2}, "ID_ISAR2_EL1"},
+	{Sysreg{3, 0, 0, 2, 3}, "ID_ISAR3_EL1"},
+	{Sysreg{3, 0, 0, 2, 4}, "ID_ISAR4_EL1"},
+	{Sysreg{3, 0, 0, 2, 5}, "ID_ISAR5_EL1"},
+	{Sysreg{3, 0, 0, 2, 6}, "ID_MMFR4_EL1"},
+	{Sysreg{3, 0, 0, 3, 0}, "MVFR0_EL1"},
+	{Sysreg{3, 0, 0, 3, 1}, "MVFR1_EL1"},
+	{Sysreg{3, 0, 0, 3, 2}, "MVFR2_EL1"},
+	{Sysreg{3, 0, 0, 4, 0}, "ID_AA64PFR0_EL1"},
+	{Sysreg{3, 0, 0, 4, 1}, "ID_AA64PFR1_EL1"},
+	{Sysreg{3, 0, 0, 4, 4}, "ID_AA64ZFR0_EL1"},
+	{Sysreg{3, 0, 0, 5, 0}, "ID_AA64DFR0_EL1"},
+	{Sysreg{3, 0, 0, 5, 1}, "ID_AA64DFR1_EL1"},
+	{Sysreg{3, 0, 0, 5, 4}, "ID_AA64AFR0_EL1"},
+	{Sysreg{3, 0, 0, 5, 5}, "ID_AA64AFR1_EL1"},
+	{Sysreg{3, 0, 0, 6, 0}, "ID_AA64ISAR0_EL1"},
+	{Sysreg{3, 0, 0, 6, 1}, "ID_AA64ISAR1_EL1"},
+	{Sysreg{3, 0, 0, 6, 2}, "ID_AA64ISAR2_EL1"},
+	{Sysreg{3, 0, 0, 7, 0}, "ID_AA64MMFR0_EL1"},
+	{Sysreg{3, 0, 0, 7, 1}, "ID_AA64MMFR1_EL1"},
+	{Sysreg{3, 0, 0, 7, 2}, "ID_AA64MMFR2_EL1"},
+	{Sysreg{3, 0, 1, 0, 0}, "SCTLR_EL1"},
+	{Sysreg{3, 0, 1, 0, 1}, "ACTLR_EL1"},
+	{Sysreg{3, 0, 1, 0, 2}, "CPACR_EL1"},
+	{Sysreg{3, 0, 1, 2, 0}, "ZCR_EL1"},
+	{Sysreg{3, 0, 2, 0, 0}, "TTBR0_EL1"},
+	{Sysreg{3, 0, 2, 0, 1}, "TTBR1_EL1"},
+	{Sysreg{3, 0, 2, 0, 2}, "TCR_EL1"},
+	{Sysreg{3, 0, 2, 1, 0}, "APIAKeyLo_EL1"},
+	{Sysreg{3, 0, 2, 1, 1}, "APIAKeyHi_EL1"},
+	{Sysreg{3, 0, 2, 1, 2}, "APIBKeyLo_EL1"},
+	{Sysreg{3, 0, 2, 1, 3}, "APIBKeyHi_EL1"},
+	{Sysreg{3, 0, 2, 2, 0}, "APDAKeyLo_EL1"},
+	{Sysreg{3, 0, 2, 2, 1}, "APDAKeyHi_EL1"},
+	{Sysreg{3, 0, 2, 2, 2}, "APDBKeyLo_EL1"},
+	{Sysreg{3, 0, 2, 2, 3}, "APDBKeyHi_EL1"},
+	{Sysreg{3, 0, 2, 3, 0}, "APGAKeyLo_EL1"},
+	{Sysreg{3, 0, 2, 3, 1}, "APGAKeyHi_EL1"},
+	{Sysreg{3, 0, 4, 0, 0}, "SPSR_EL1"},
+	{Sysreg{3, 0, 4, 0, 1}, "ELR_EL1"},
+	{Sysreg{3, 0, 4, 1, 0}, "SP_EL0"},
+	{Sysreg{3, 0, 4, 2, 0}, "SPSel"},
+	{Sysreg{3, 0, 4, 2, 2}, "CurrentEL"},
+	{Sysreg{3, 0, 4, 2, 3}, "PAN"},
+	{Sysreg{3, 0, 4, 2, 4}, "UAO"},
+	{Sysreg{3, 0, 4, 6, 0}, "ICC_PMR_EL1"},
+	{Sysreg{3, 0, 5, 1, 0}, "AFSR0_EL1"},
+	{Sysreg{3, 0, 5, 1, 1}, "AFSR1_EL1"},
+	{Sysreg{3, 0, 5, 2, 0}, "ESR_EL1"},
+	{Sysreg{3, 0, 6, 0, 0}, "FAR_EL1"},
+	{Sysreg{3, 0, 7, 4, 0}, "PAR_EL1"},
+	{Sysreg{3, 0, 9, 14, 1}, "PMINTENSET_EL1"},
+	{Sysreg{3, 0, 9, 14, 2}, "PMINTENCLR_EL1"},
+	{Sysreg{3, 0, 10, 2, 0}, "MAIR_EL1"},
+	{Sysreg{3, 0, 10, 3, 0}, "AMAIR_EL1"},
+	{Sysreg{3, 0, 12, 0, 0}, "VBAR_EL1"},
+	{Sysreg{3, 0, 12, 0, 1}, "RVBAR_EL1"},
+	{Sysreg{3, 0, 12, 0, 2}, "RMR_EL1"},
+	{Sysreg{3, 0, 12, 1, 0}, "ISR_EL1"},
+	{Sysreg{3, 0, 12, 8, 0}, "ICC_IAR0_EL1"},
+	{Sysreg{3, 0, 12, 8, 1}, "ICC_EOIR0_EL1"},
+	{Sysreg{3, 0, 12, 8, 2}, "ICC_HPPIR0_EL1"},
+	{Sysreg{3, 0, 12, 8, 3}, "ICC_BPR0_EL1"},
+	{Sysreg{3, 0, 12, 11, 1}, "ICC_DIR_EL1"},
+	{Sysreg{3, 0, 12, 11, 3}, "ICC_RPR_EL1"},
+	{Sysreg{3, 0, 12, 11, 5}, "ICC_SGI1R_EL1"},
+	{Sysreg{3, 0, 12, 11, 6}, "ICC_ASGI1R_EL1"},
+	{Sysreg{3, 0, 12, 11, 7}, "ICC_SGI0R_EL1"},
+	{Sysreg{3, 0, 12, 12, 0}, "ICC_IAR1_EL1"},
+	{Sysreg{3, 0, 12, 12, 1}, "ICC_EOIR1_EL1"},
+	{Sysreg{3, 0, 12, 12, 2}, "ICC_HPPIR1_EL1"},
+	{Sysreg{3, 0, 12, 12, 3}, "ICC_BPR1_EL1"},
+	{Sysreg{3, 0, 12, 12, 4}, "ICC_CTLR_EL1"},
+	{Sysreg{3, 0, 12, 12, 5}, "ICC_SRE_EL1"},
+	{Sysreg{3, 0, 12, 12, 6}, "ICC_IGRPEN0_EL1"},
+	{Sysreg{3, 0, 12, 12, 7}, "ICC_IGRPEN1_EL1"},
+	{Sysreg{3, 0, 13, 0, 1}, "CONTEXTIDR_EL1"},
+	{Sysreg{3, 0, 13, 0, 4}, "TPIDR_EL1"},
+	{Sysreg{3, 0, 14, 1, 0}, "CNTKCTL_EL1"},
+	{Sysreg{3, 1, 0, 0, 0}, "CCSIDR_EL1"},
+	{Sysreg{3, 1, 0, 0, 1}, "CLIDR_EL1"},
+	{Sysreg{3, 1, 0, 0, 7}, "AIDR_EL1"},
+	{Sysreg{3, 2, 0, 0, 0}, "CSSELR_EL1"},
+	{Sysreg{3, 3, 0, 0, 1}, "CTR_EL0"},
+	{Sysreg{3, 3, 0, 0, 7}, "DCZID_EL0"},
+	{Sysreg{3, 3, 2, 4, 0}, "RNDR"},
+	{Sysreg{3, 3, 2, 4, 1}, "RNDRRS"},
+	{Sysreg{3, 3, 4, 2, 0}, "NZCV"},
+	{Sysreg{3, 3, 4, 2, 1}, "DAIF"},
+	{Sysreg{3, 3, 4, 2, 5}, "DIT"},
+	{Sysreg{3, 3, 4, 2, 6}, "SSBS"},
+	{Sysreg{3, 3, 4, 2, 7}, "TCO"},
+	{Sysreg{3, 3, 4, 4, 0}, "FPCR"},
+	{Sysreg{3, 3, 4, 4, 1}, "FPSR"},
+	{Sysreg{3, 3, 4, 5, 0}, "DSPSR_EL0"},
+	{Sysreg{3, 3, 4, 5, 1}, "DLR_EL0"},
+	{Sysreg{3, 3, 9, 12, 0}, "PMCR_EL0"},
+	{Sysreg{3, 3, 9, 12, 1}, "PMCNTENSET_EL0"},
+	{Sysreg{3, 3, 9, 12, 2}, "PMCNTENCLR_EL0"},
+	{Sysreg{3, 3, 9, 12, 3}, "PMOVSCLR_EL0"},
+	{Sysreg{3, 3, 9, 12, 4}, "PMSWINC_EL0"},
+	{Sysreg{3, 3, 9, 12, 5}, "PMSELR_EL0"},
+	{Sysreg{3, 3, 9, 12, 6}, "PMCEID0_EL0"},
+	{Sysreg{3, 3, 9, 12, 7}, "PMCEID1_EL0"},
+	{Sysreg{3, 3, 9, 13, 0}, "PMCCNTR_EL0"},
+	{Sysreg{3, 3, 9, 13, 1}, "PMXEVTYPER_EL0"},
+	{Sysreg{3, 3, 9, 13, 2}, "PMXEVCNTR_EL0"},
+	{Sysreg{3, 3, 9, 14, 0}, "PMUSERENR_EL0"},
+	{Sysreg{3, 3, 9, 14, 3}, "PMOVSSET_EL0"},
+	{Sysreg{3, 3, 13, 0, 2}, "TPIDR_EL0"},
+	{Sysreg{3, 3, 13, 0, 3}, "TPIDRRO_EL0"},
+	{Sysreg{3, 3, 14, 0, 0}, "CNTFRQ_EL0"},
+	{Sysreg{3, 3, 14, 0, 1}, "CNTPCT_EL0"},
+	{Sysreg{3, 3, 14, 0, 2}, "CNTVCT_EL0"},
+	{Sysreg{3, 3, 14, 2, 0}, "CNTP_TVAL_EL0"},
+	{Sysreg{3, 3, 14, 2, 1}, "CNTP_CTL_EL0"},
+	{Sysreg{3, 3, 14, 2, 2}, "CNTP_CVAL_EL0"},
+	{Sysreg{3, 3, 14, 3, 0}, "CNTV_TVAL_EL0"},
+	{Sysreg{3, 3, 14, 3, 1}, "CNTV_CTL_EL0"},
+	{Sysreg{3, 3, 14, 3, 2}, "CNTV_CVAL_EL0"},
+	{Sysreg{3, 3, 14, 15, 7}, "PMCCFILTR_EL0"},
+	{Sysreg{3, 4, 0, 0, 0}, "VPIDR_EL2"},
+	{Sysreg{3, 4, 0, 0, 5}, "VMPIDR_EL2"},
+	{Sysreg{3, 4, 1, 0, 0}, "SCTLR_EL2"},
+	{Sysreg{3, 4, 1, 0, 1}, "ACTLR_EL2"},
+	{Sysreg{3, 4, 1, 1, 0}, "HCR_EL2"},
+	{Sysreg{3, 4, 1, 1, 1}, "MDCR_EL2"},
+	{Sysreg{3, 4, 1, 1, 2}, "CPTR_EL2"},
+	{Sysreg{3, 4, 1, 1, 3}, "HSTR_EL2"},
+	{Sysreg{3, 4, 1, 1, 7}, "HACR_EL2"},
+	{Sysreg{3, 4, 1, 2, 0}, "ZCR_EL2"},
+	{Sysreg{3, 4, 2, 0, 0}, "TTBR0_EL2"},
+	{Sysreg{3, 4, 2, 0, 1}, "TTBR1_EL2"},
+	{Sysreg{3, 4, 2, 0, 2}, "TCR_EL2"},
+	{Sysreg{3, 4, 2, 1, 0}, "VTTBR_EL2"},
+	{Sysreg{3, 4, 2, 1, 2}, "VTCR_EL2"},
+	{Sysreg{3, 4, 3, 0, 0}, "DACR32_EL2"},
+	{Sysreg{3, 4, 4, 0, 0}, "SPSR_EL2"},
+	{Sysreg{3, 4, 4, 0, 1}, "ELR_EL2"},
+	{Sysreg{3, 4, 4, 1, 0}, "SP_EL1"},
+	{Sysreg{3, 4, 4, 3, 0}, "SPSR_irq"},
+	{Sysreg{3, 4, 4, 3, 1}, "SPSR_abt"},
+	{Sysreg{3, 4, 4, 3, 2}, "SPSR_und"},
+	{Sysreg{3, 4, 4, 3, 3}, "SPSR_fiq"},
+	{Sysreg{3, 4, 5, 0, 1}, "IFSR32_EL2"},
+	{Sysreg{3, 4, 5, 1, 0}, "AFSR0_EL2"},
+	{Sysreg{3, 4, 5, 1, 1}, "AFSR1_EL2"},
+	{Sysreg{3, 4, 5, 2, 0}, "ESR_EL2"},
+	{Sysreg{3, 4, 5, 3, 0}, "FPEXC32_EL2"},
+	{Sysreg{3, 4, 6, 0, 0}, "FAR_EL2"},
+	{Sysreg{3, 4, 6, 0, 4}, "HPFAR_EL2"},
+	{Sysreg{3, 4, 10, 2, 0}, "MAIR_EL2"},
+	{Sysreg{3, 4, 10, 3, 0}, "AMAIR_EL2"},
+	{Sysreg{3, 4, 12, 0, 0}, "VBAR_EL2"},
+	{Sysreg{3, 4, 12, 0, 1}, "RVBAR_EL2"},
+	{Sysreg{3, 4, 12, 0, 2}, "RMR_EL2"},
+	{Sysreg{3, 4, 12, 9, 5}, "ICC_SRE_EL2"},
+	{Sysreg{3, 4, 13, 0, 1}, "CONTEXTIDR_EL2"},
+	{Sysreg{3, 4, 13, 0, 2}, "TPIDR_EL2"},
+	{Sysreg{3, 4, 14, 0, 3}, "CNTVOFF_EL2"},
+	{Sysreg{3, 4, 14, 1, 0}, "CNTHCTL_EL2"},
+	{Sysreg{3, 4, 14, 2, 0}, "CNTHP_TVAL_EL2"},
+	{Sysreg{3, 4, 14, 2, 1}, "CNTHP_CTL_EL2"},
+	{Sysreg{3, 4, 14, 2, 2}, "CNTHP_CVAL_EL2"},
+	{Sysreg{3, 6, 1, 0, 0}, "SCTLR_EL3"},
+	{Sysreg{3, 6, 1, 0, 1}, "ACTLR_EL3"},
+	{Sysreg{3, 6, 1, 1, 0}, "SCR_EL3"},
+	{Sysreg{3, 6, 1, 1, 1}, "SDER32_EL3"},
+	{Sysreg{3, 6, 1, 1, 2}, "CPTR_EL3"},
+	{Sysreg{3, 6, 1, 2, 0}, "ZCR_EL3"},
+	{Sysreg{3, 6, 1, 3, 1}, "MDCR_EL3"},
+	{Sysreg{3, 6, 2, 0, 0}, "TTBR0_EL3"},
+	{Sysreg{3, 6, 2, 0, 2}, "TCR_EL3"},
+	{Sysreg{3, 6, 4, 0, 0}, "SPSR_EL3"},
+	{Sysreg{3, 6, 4, 0, 1}, "ELR_EL3"},
+	{Sysreg{3, 6, 4, 1, 0}, "SP_EL2"},
+	{Sysreg{3, 6, 5, 1, 0}, "AFSR0_EL3"},
+	{Sysreg{3, 6, 5, 1, 1}, "AFSR1_EL3"},
+	{Sysreg{3, 6, 5, 2, 0}, "ESR_EL3"},
+	{Sysreg{3, 6, 6, 0, 0}, "FAR_EL3"},
+	{Sysreg{3, 6, 10, 2, 0}, "MAIR_EL3"},
+	{Sysreg{3, 6, 10, 3, 0}, "AMAIR_EL3"},
+	{Sysreg{3, 6, 12, 0, 0}, "VBAR_EL3"},
+	{Sysreg{3, 6, 12, 0, 1}, "RVBAR_EL3"},
+	{Sysreg{3, 6, 12, 0, 2}, "RMR_EL3"},
+	{Sysreg{3, 6, 12, 12, 4}, "ICC_CTLR_EL3"},
+	{Sysreg{3, 6, 12, 12, 5}, "ICC_SRE_EL3"},
+	{Sysreg{3, 6, 12, 12, 7}, "ICC_IGRPEN1_EL3"},
+	{Sysreg{3, 6, 13, 0, 2}, "TPIDR_EL3"},
+	{Sysreg{3, 7, 14, 2, 0}, "CNTPS_TVAL_EL1"},
+	{Sysreg{3, 7, 14, 2, 1}, "CNTPS_CTL_EL1"},
+	{Sysreg{3, 7, 14, 2, 2}, "CNTPS_CVAL_EL1"},
+}
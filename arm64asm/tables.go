@@ -0,0 +1,2785 @@
+package arm64asm
+
+const (
+	_ Op = iota
+	ABS
+	ADC
+	ADCS
+	ADD
+	ADDP
+	ADDS
+	ADDV
+	ADR
+	ADRP
+	AND
+	ANDS
+	ASR
+	AUTDA
+	AUTDB
+	AUTDZA
+	AUTDZB
+	AUTIA
+	AUTIA1716
+	AUTIASP
+	AUTIAZ
+	AUTIB
+	AUTIB1716
+	AUTIBSP
+	AUTIBZ
+	AUTIZA
+	AUTIZB
+	B
+	BCAX
+	BFI
+	BFM
+	BFXIL
+	BIC
+	BICS
+	BIF
+	BIT
+	BL
+	BLR
+	BLRAA
+	BLRAAZ
+	BLRAB
+	BLRABZ
+	BR
+	BRAA
+	BRAAZ
+	BRAB
+	BRABZ
+	BRK
+	BSL
+	BTI
+	CAS
+	CASA
+	CASAB
+	CASAH
+	CASAL
+	CASALB
+	CASALH
+	CASB
+	CASH
+	CASL
+	CASLB
+	CASLH
+	CASP
+	CASPA
+	CASPAL
+	CASPL
+	CBNZ
+	CBZ
+	CCMN
+	CCMP
+	CINC
+	CINV
+	CLREX
+	CLS
+	CLZ
+	CMEQ
+	CMGE
+	CMGT
+	CMHI
+	CMHS
+	CMN
+	CMP
+	CMPEQ
+	CMPGE
+	CMPGT
+	CMPHI
+	CMPHS
+	CMPNE
+	CNEG
+	CNT
+	CNTB
+	CNTD
+	CNTH
+	CNTW
+	CSEL
+	CSET
+	CSETM
+	CSINC
+	CSINV
+	CSNEG
+	DECB
+	DECD
+	DECH
+	DECW
+	DMB
+	DRPS
+	DSB
+	DUP
+	EON
+	EOR
+	EOR3
+	ERET
+	ERETAA
+	ERETAB
+	EXTR
+	FADD
+	FDIV
+	FMAX
+	FMIN
+	FMUL
+	FSUB
+	HINT
+	HLT
+	HVC
+	INCB
+	INCD
+	INCH
+	INCW
+	INS
+	ISB
+	LD1
+	LD1B
+	LD1D
+	LD1H
+	LD1W
+	LD2
+	LD3
+	LD4
+	LDADD
+	LDADDA
+	LDADDAB
+	LDADDAH
+	LDADDAL
+	LDADDALB
+	LDADDALH
+	LDADDB
+	LDADDH
+	LDADDL
+	LDADDLB
+	LDADDLH
+	LDAR
+	LDARB
+	LDARH
+	LDAXP
+	LDAXR
+	LDAXRB
+	LDAXRH
+	LDCLR
+	LDCLRA
+	LDCLRAB
+	LDCLRAH
+	LDCLRAL
+	LDCLRALB
+	LDCLRALH
+	LDCLRB
+	LDCLRH
+	LDCLRL
+	LDCLRLB
+	LDCLRLH
+	LDEOR
+	LDEORA
+	LDEORAB
+	LDEORAH
+	LDEORAL
+	LDEORALB
+	LDEORALH
+	LDEORB
+	LDEORH
+	LDEORL
+	LDEORLB
+	LDEORLH
+	LDNP
+	LDP
+	LDPSW
+	LDR
+	LDRAA
+	LDRAB
+	LDRB
+	LDRH
+	LDRSB
+	LDRSH
+	LDRSW
+	LDSET
+	LDSETA
+	LDSETAB
+	LDSETAH
+	LDSETAL
+	LDSETALB
+	LDSETALH
+	LDSETB
+	LDSETH
+	LDSETL
+	LDSETLB
+	LDSETLH
+	LDSMAX
+	LDSMAXA
+	LDSMAXAB
+	LDSMAXAH
+	LDSMAXAL
+	LDSMAXALB
+	LDSMAXALH
+	LDSMAXB
+	LDSMAXH
+	LDSMAXL
+	LDSMAXLB
+	LDSMAXLH
+	LDSMIN
+	LDSMINA
+	LDSMINAB
+	LDSMINAH
+	LDSMINAL
+	LDSMINALB
+	LDSMINALH
+	LDSMINB
+	LDSMINH
+	LDSMINL
+	LDSMINLB
+	LDSMINLH
+	LDUMAX
+	LDUMAXA
+	LDUMAXAB
+	LDUMAXAH
+	LDUMAXAL
+	LDUMAXALB
+	LDUMAXALH
+	LDUMAXB
+	LDUMAXH
+	LDUMAXL
+	LDUMAXLB
+	LDUMAXLH
+	LDUMIN
+	LDUMINA
+	LDUMINAB
+	LDUMINAH
+	LDUMINAL
+	LDUMINALB
+	LDUMINALH
+	LDUMINB
+	LDUMINH
+	LDUMINL
+	LDUMINLB
+	LDUMINLH
+	LDUR
+	LDURB
+	LDURH
+	LDURSB
+	LDURSH
+	LDURSW
+	LDXP
+	LDXR
+	LDXRB
+	LDXRH
+	LSL
+	LSR
+	MADD
+	MNEG
+	MOV
+	MOVI
+	MOVK
+	MOVN
+	MOVZ
+	MRS
+	MSR
+	MSUB
+	MUL
+	MVN
+	MVNI
+	NEG
+	NEGS
+	NGC
+	NGCS
+	NOP
+	ORN
+	ORR
+	PACDA
+	PACDB
+	PACDZA
+	PACDZB
+	PACGA
+	PACIA
+	PACIA1716
+	PACIASP
+	PACIAZ
+	PACIB
+	PACIB1716
+	PACIBSP
+	PACIBZ
+	PACIZA
+	PACIZB
+	PFALSE
+	PRFM
+	PTRUE
+	PTRUES
+	RBIT
+	RET
+	RETAA
+	RETAB
+	REV
+	REV16
+	REV32
+	REV64
+	ROR
+	SBC
+	SBCS
+	SBFIZ
+	SBFM
+	SBFX
+	SDIV
+	SEL
+	SEV
+	SEVL
+	SMADDL
+	SMAX
+	SMAXV
+	SMC
+	SMIN
+	SMINV
+	SMNEGL
+	SMOV
+	SMSUBL
+	SMULH
+	SMULL
+	SQADD
+	SQSUB
+	ST1
+	ST1B
+	ST1D
+	ST1H
+	ST1W
+	ST2
+	ST3
+	ST4
+	STADD
+	STADDB
+	STADDH
+	STADDL
+	STADDLB
+	STADDLH
+	STCLR
+	STCLRB
+	STCLRH
+	STCLRL
+	STCLRLB
+	STCLRLH
+	STEOR
+	STEORB
+	STEORH
+	STEORL
+	STEORLB
+	STEORLH
+	STLR
+	STLRB
+	STLRH
+	STLXP
+	STLXR
+	STLXRB
+	STLXRH
+	STNP
+	STP
+	STR
+	STRB
+	STRH
+	STSET
+	STSETB
+	STSETH
+	STSETL
+	STSETLB
+	STSETLH
+	STSMAX
+	STSMAXB
+	STSMAXH
+	STSMAXL
+	STSMAXLB
+	STSMAXLH
+	STSMIN
+	STSMINB
+	STSMINH
+	STSMINL
+	STSMINLB
+	STSMINLH
+	STUMAX
+	STUMAXB
+	STUMAXH
+	STUMAXL
+	STUMAXLB
+	STUMAXLH
+	STUMIN
+	STUMINB
+	STUMINH
+	STUMINL
+	STUMINLB
+	STUMINLH
+	STUR
+	STURB
+	STURH
+	STXP
+	STXR
+	STXRB
+	STXRH
+	SUB
+	SUBR
+	SUBS
+	SVC
+	SWP
+	SWPA
+	SWPAB
+	SWPAH
+	SWPAL
+	SWPALB
+	SWPALH
+	SWPB
+	SWPH
+	SWPL
+	SWPLB
+	SWPLH
+	SXTB
+	SXTH
+	SXTW
+	TBNZ
+	TBZ
+	TST
+	UBFIZ
+	UBFM
+	UBFX
+	UDIV
+	UMADDL
+	UMAX
+	UMAXV
+	UMIN
+	UMINV
+	UMNEGL
+	UMOV
+	UMSUBL
+	UMULH
+	UMULL
+	UQADD
+	UQSUB
+	UXTB
+	UXTH
+	WFE
+	WFI
+	WHILELE
+	WHILELO
+	WHILELS
+	WHILELT
+	XPACD
+	XPACI
+	XPACLRI
+	YIELD
+)
+
+var opstr = [...]string{
+	ABS:       "ABS",
+	ADC:       "ADC",
+	ADCS:      "ADCS",
+	ADD:       "ADD",
+	ADDP:      "ADDP",
+	ADDS:      "ADDS",
+	ADDV:      "ADDV",
+	ADR:       "ADR",
+	ADRP:      "ADRP",
+	AND:       "AND",
+	ANDS:      "ANDS",
+	ASR:       "ASR",
+	AUTDA:     "AUTDA",
+	AUTDB:     "AUTDB",
+	AUTDZA:    "AUTDZA",
+	AUTDZB:    "AUTDZB",
+	AUTIA:     "AUTIA",
+	AUTIA1716: "AUTIA1716",
+	AUTIASP:   "AUTIASP",
+	AUTIAZ:    "AUTIAZ",
+	AUTIB:     "AUTIB",
+	AUTIB1716: "AUTIB1716",
+	AUTIBSP:   "AUTIBSP",
+	AUTIBZ:    "AUTIBZ",
+	AUTIZA:    "AUTIZA",
+	AUTIZB:    "AUTIZB",
+	B:         "B",
+	BCAX:      "BCAX",
+	BFI:       "BFI",
+	BFM:       "BFM",
+	BFXIL:     "BFXIL",
+	BIC:       "BIC",
+	BICS:      "BICS",
+	BIF:       "BIF",
+	BIT:       "BIT",
+	BL:        "BL",
+	BLR:       "BLR",
+	BLRAA:     "BLRAA",
+	BLRAAZ:    "BLRAAZ",
+	BLRAB:     "BLRAB",
+	BLRABZ:    "BLRABZ",
+	BR:        "BR",
+	BRAA:      "BRAA",
+	BRAAZ:     "BRAAZ",
+	BRAB:      "BRAB",
+	BRABZ:     "BRABZ",
+	BRK:       "BRK",
+	BSL:       "BSL",
+	BTI:       "BTI",
+	CAS:       "CAS",
+	CASA:      "CASA",
+	CASAB:     "CASAB",
+	CASAH:     "CASAH",
+	CASAL:     "CASAL",
+	CASALB:    "CASALB",
+	CASALH:    "CASALH",
+	CASB:      "CASB",
+	CASH:      "CASH",
+	CASL:      "CASL",
+	CASLB:     "CASLB",
+	CASLH:     "CASLH",
+	CASP:      "CASP",
+	CASPA:     "CASPA",
+	CASPAL:    "CASPAL",
+	CASPL:     "CASPL",
+	CBNZ:      "CBNZ",
+	CBZ:       "CBZ",
+	CCMN:      "CCMN",
+	CCMP:      "CCMP",
+	CINC:      "CINC",
+	CINV:      "CINV",
+	CLREX:     "CLREX",
+	CLS:       "CLS",
+	CLZ:       "CLZ",
+	CMEQ:      "CMEQ",
+	CMGE:      "CMGE",
+	CMGT:      "CMGT",
+	CMHI:      "CMHI",
+	CMHS:      "CMHS",
+	CMN:       "CMN",
+	CMP:       "CMP",
+	CMPEQ:     "CMPEQ",
+	CMPGE:     "CMPGE",
+	CMPGT:     "CMPGT",
+	CMPHI:     "CMPHI",
+	CMPHS:     "CMPHS",
+	CMPNE:     "CMPNE",
+	CNEG:      "CNEG",
+	CNT:       "CNT",
+	CNTB:      "CNTB",
+	CNTD:      "CNTD",
+	CNTH:      "CNTH",
+	CNTW:      "CNTW",
+	CSEL:      "CSEL",
+	CSET:      "CSET",
+	CSETM:     "CSETM",
+	CSINC:     "CSINC",
+	CSINV:     "CSINV",
+	CSNEG:     "CSNEG",
+	DECB:      "DECB",
+	DECD:      "DECD",
+	DECH:      "DECH",
+	DECW:      "DECW",
+	DMB:       "DMB",
+	DRPS:      "DRPS",
+	DSB:       "DSB",
+	DUP:       "DUP",
+	EON:       "EON",
+	EOR:       "EOR",
+	EOR3:      "EOR3",
+	ERET:      "ERET",
+	ERETAA:    "ERETAA",
+	ERETAB:    "ERETAB",
+	EXTR:      "EXTR",
+	FADD:      "FADD",
+	FDIV:      "FDIV",
+	FMAX:      "FMAX",
+	FMIN:      "FMIN",
+	FMUL:      "FMUL",
+	FSUB:      "FSUB",
+	HINT:      "HINT",
+	HLT:       "HLT",
+	HVC:       "HVC",
+	INCB:      "INCB",
+	INCD:      "INCD",
+	INCH:      "INCH",
+	INCW:      "INCW",
+	INS:       "INS",
+	ISB:       "ISB",
+	LD1:       "LD1",
+	LD1B:      "LD1B",
+	LD1D:      "LD1D",
+	LD1H:      "LD1H",
+	LD1W:      "LD1W",
+	LD2:       "LD2",
+	LD3:       "LD3",
+	LD4:       "LD4",
+	LDADD:     "LDADD",
+	LDADDA:    "LDADDA",
+	LDADDAB:   "LDADDAB",
+	LDADDAH:   "LDADDAH",
+	LDADDAL:   "LDADDAL",
+	LDADDALB:  "LDADDALB",
+	LDADDALH:  "LDADDALH",
+	LDADDB:    "LDADDB",
+	LDADDH:    "LDADDH",
+	LDADDL:    "LDADDL",
+	LDADDLB:   "LDADDLB",
+	LDADDLH:   "LDADDLH",
+	LDAR:      "LDAR",
+	LDARB:     "LDARB",
+	LDARH:     "LDARH",
+	LDAXP:     "LDAXP",
+	LDAXR:     "LDAXR",
+	LDAXRB:    "LDAXRB",
+	LDAXRH:    "LDAXRH",
+	LDCLR:     "LDCLR",
+	LDCLRA:    "LDCLRA",
+	LDCLRAB:   "LDCLRAB",
+	LDCLRAH:   "LDCLRAH",
+	LDCLRAL:   "LDCLRAL",
+	LDCLRALB:  "LDCLRALB",
+	LDCLRALH:  "LDCLRALH",
+	LDCLRB:    "LDCLRB",
+	LDCLRH:    "LDCLRH",
+	LDCLRL:    "LDCLRL",
+	LDCLRLB:   "LDCLRLB",
+	LDCLRLH:   "LDCLRLH",
+	LDEOR:     "LDEOR",
+	LDEORA:    "LDEORA",
+	LDEORAB:   "LDEORAB",
+	LDEORAH:   "LDEORAH",
+	LDEORAL:   "LDEORAL",
+	LDEORALB:  "LDEORALB",
+	LDEORALH:  "LDEORALH",
+	LDEORB:    "LDEORB",
+	LDEORH:    "LDEORH",
+	LDEORL:    "LDEORL",
+	LDEORLB:   "LDEORLB",
+	LDEORLH:   "LDEORLH",
+	LDNP:      "LDNP",
+	LDP:       "LDP",
+	LDPSW:     "LDPSW",
+	LDR:       "LDR",
+	LDRAA:     "LDRAA",
+	LDRAB:     "LDRAB",
+	LDRB:      "LDRB",
+	LDRH:      "LDRH",
+	LDRSB:     "LDRSB",
+	LDRSH:     "LDRSH",
+	LDRSW:     "LDRSW",
+	LDSET:     "LDSET",
+	LDSETA:    "LDSETA",
+	LDSETAB:   "LDSETAB",
+	LDSETAH:   "LDSETAH",
+	LDSETAL:   "LDSETAL",
+	LDSETALB:  "LDSETALB",
+	LDSETALH:  "LDSETALH",
+	LDSETB:    "LDSETB",
+	LDSETH:    "LDSETH",
+	LDSETL:    "LDSETL",
+	LDSETLB:   "LDSETLB",
+	LDSETLH:   "LDSETLH",
+	LDSMAX:    "LDSMAX",
+	LDSMAXA:   "LDSMAXA",
+	LDSMAXAB:  "LDSMAXAB",
+	LDSMAXAH:  "LDSMAXAH",
+	LDSMAXAL:  "LDSMAXAL",
+	LDSMAXALB: "LDSMAXALB",
+	LDSMAXALH: "LDSMAXALH",
+	LDSMAXB:   "LDSMAXB",
+	LDSMAXH:   "LDSMAXH",
+	LDSMAXL:   "LDSMAXL",
+	LDSMAXLB:  "LDSMAXLB",
+	LDSMAXLH:  "LDSMAXLH",
+	LDSMIN:    "LDSMIN",
+	LDSMINA:   "LDSMINA",
+	LDSMINAB:  "LDSMINAB",
+	LDSMINAH:  "LDSMINAH",
+	LDSMINAL:  "LDSMINAL",
+	LDSMINALB: "LDSMINALB",
+	LDSMINALH: "LDSMINALH",
+	LDSMINB:   "LDSMINB",
+	LDSMINH:   "LDSMINH",
+	LDSMINL:   "LDSMINL",
+	LDSMINLB:  "LDSMINLB",
+	LDSMINLH:  "LDSMINLH",
+	LDUMAX:    "LDUMAX",
+	LDUMAXA:   "LDUMAXA",
+	LDUMAXAB:  "LDUMAXAB",
+	LDUMAXAH:  "LDUMAXAH",
+	LDUMAXAL:  "LDUMAXAL",
+	LDUMAXALB: "LDUMAXALB",
+	LDUMAXALH: "LDUMAXALH",
+	LDUMAXB:   "LDUMAXB",
+	LDUMAXH:   "LDUMAXH",
+	LDUMAXL:   "LDUMAXL",
+	LDUMAXLB:  "LDUMAXLB",
+	LDUMAXLH:  "LDUMAXLH",
+	LDUMIN:    "LDUMIN",
+	LDUMINA:   "LDUMINA",
+	LDUMINAB:  "LDUMINAB",
+	LDUMINAH:  "LDUMINAH",
+	LDUMINAL:  "LDUMINAL",
+	LDUMINALB: "LDUMINALB",
+	LDUMINALH: "LDUMINALH",
+	LDUMINB:   "LDUMINB",
+	LDUMINH:   "LDUMINH",
+	LDUMINL:   "LDUMINL",
+	LDUMINLB:  "LDUMINLB",
+	LDUMINLH:  "LDUMINLH",
+	LDUR:      "LDUR",
+	LDURB:     "LDURB",
+	LDURH:     "LDURH",
+	LDURSB:    "LDURSB",
+	LDURSH:    "LDURSH",
+	LDURSW:    "LDURSW",
+	LDXP:      "LDXP",
+	LDXR:      "LDXR",
+	LDXRB:     "LDXRB",
+	LDXRH:     "LDXRH",
+	LSL:       "LSL",
+	LSR:       "LSR",
+	MADD:      "MADD",
+	MNEG:      "MNEG",
+	MOV:       "MOV",
+	MOVI:      "MOVI",
+	MOVK:      "MOVK",
+	MOVN:      "MOVN",
+	MOVZ:      "MOVZ",
+	MRS:       "MRS",
+	MSR:       "MSR",
+	MSUB:      "MSUB",
+	MUL:       "MUL",
+	MVN:       "MVN",
+	MVNI:      "MVNI",
+	NEG:       "NEG",
+	NEGS:      "NEGS",
+	NGC:       "NGC",
+	NGCS:      "NGCS",
+	NOP:       "NOP",
+	ORN:       "ORN",
+	ORR:       "ORR",
+	PACDA:     "PACDA",
+	PACDB:     "PACDB",
+	PACDZA:    "PACDZA",
+	PACDZB:    "PACDZB",
+	PACGA:     "PACGA",
+	PACIA:     "PACIA",
+	PACIA1716: "PACIA1716",
+	PACIASP:   "PACIASP",
+	PACIAZ:    "PACIAZ",
+	PACIB:     "PACIB",
+	PACIB1716: "PACIB1716",
+	PACIBSP:   "PACIBSP",
+	PACIBZ:    "PACIBZ",
+	PACIZA:    "PACIZA",
+	PACIZB:    "PACIZB",
+	PFALSE:    "PFALSE",
+	PRFM:      "PRFM",
+	PTRUE:     "PTRUE",
+	PTRUES:    "PTRUES",
+	RBIT:      "RBIT",
+	RET:       "RET",
+	RETAA:     "RETAA",
+	RETAB:     "RETAB",
+	REV:       "REV",
+	REV16:     "REV16",
+	REV32:     "REV32",
+	REV64:     "REV64",
+	ROR:       "ROR",
+	SBC:       "SBC",
+	SBCS:      "SBCS",
+	SBFIZ:     "SBFIZ",
+	SBFM:      "SBFM",
+	SBFX:      "SBFX",
+	SDIV:      "SDIV",
+	SEL:       "SEL",
+	SEV:       "SEV",
+	SEVL:      "SEVL",
+	SMADDL:    "SMADDL",
+	SMAX:      "SMAX",
+	SMAXV:     "SMAXV",
+	SMC:       "SMC",
+	SMIN:      "SMIN",
+	SMINV:     "SMINV",
+	SMNEGL:    "SMNEGL",
+	SMOV:      "SMOV",
+	SMSUBL:    "SMSUBL",
+	SMULH:     "SMULH",
+	SMULL:     "SMULL",
+	SQADD:     "SQADD",
+	SQSUB:     "SQSUB",
+	ST1:       "ST1",
+	ST1B:      "ST1B",
+	ST1D:      "ST1D",
+	ST1H:      "ST1H",
+	ST1W:      "ST1W",
+	ST2:       "ST2",
+	ST3:       "ST3",
+	ST4:       "ST4",
+	STADD:     "STADD",
+	STADDB:    "STADDB",
+	STADDH:    "STADDH",
+	STADDL:    "STADDL",
+	STADDLB:   "STADDLB",
+	STADDLH:   "STADDLH",
+	STCLR:     "STCLR",
+	STCLRB:    "STCLRB",
+	STCLRH:    "STCLRH",
+	STCLRL:    "STCLRL",
+	STCLRLB:   "STCLRLB",
+	STCLRLH:   "STCLRLH",
+	STEOR:     "STEOR",
+	STEORB:    "STEORB",
+	STEORH:    "STEORH",
+	STEORL:    "STEORL",
+	STEORLB:   "STEORLB",
+	STEORLH:   "STEORLH",
+	STLR:      "STLR",
+	STLRB:     "STLRB",
+	STLRH:     "STLRH",
+	STLXP:     "STLXP",
+	STLXR:     "STLXR",
+	STLXRB:    "STLXRB",
+	STLXRH:    "STLXRH",
+	STNP:      "STNP",
+	STP:       "STP",
+	STR:       "STR",
+	STRB:      "STRB",
+	STRH:      "STRH",
+	STSET:     "STSET",
+	STSETB:    "STSETB",
+	STSETH:    "STSETH",
+	STSETL:    "STSETL",
+	STSETLB:   "STSETLB",
+	STSETLH:   "STSETLH",
+	STSMAX:    "STSMAX",
+	STSMAXB:   "STSMAXB",
+	STSMAXH:   "STSMAXH",
+	STSMAXL:   "STSMAXL",
+	STSMAXLB:  "STSMAXLB",
+	STSMAXLH:  "STSMAXLH",
+	STSMIN:    "STSMIN",
+	STSMINB:   "STSMINB",
+	STSMINH:   "STSMINH",
+	STSMINL:   "STSMINL",
+	STSMINLB:  "STSMINLB",
+	STSMINLH:  "STSMINLH",
+	STUMAX:    "STUMAX",
+	STUMAXB:   "STUMAXB",
+	STUMAXH:   "STUMAXH",
+	STUMAXL:   "STUMAXL",
+	STUMAXLB:  "STUMAXLB",
+	STUMAXLH:  "STUMAXLH",
+	STUMIN:    "STUMIN",
+	STUMINB:   "STUMINB",
+	STUMINH:   "STUMINH",
+	STUMINL:   "STUMINL",
+	STUMINLB:  "STUMINLB",
+	STUMINLH:  "STUMINLH",
+	STUR:      "STUR",
+	STURB:     "STURB",
+	STURH:     "STURH",
+	STXP:      "STXP",
+	STXR:      "STXR",
+	STXRB:     "STXRB",
+	STXRH:     "STXRH",
+	SUB:       "SUB",
+	SUBR:      "SUBR",
+	SUBS:      "SUBS",
+	SVC:       "SVC",
+	SWP:       "SWP",
+	SWPA:      "SWPA",
+	SWPAB:     "SWPAB",
+	SWPAH:     "SWPAH",
+	SWPAL:     "SWPAL",
+	SWPALB:    "SWPALB",
+	SWPALH:    "SWPALH",
+	SWPB:      "SWPB",
+	SWPH:      "SWPH",
+	SWPL:      "SWPL",
+	SWPLB:     "SWPLB",
+	SWPLH:     "SWPLH",
+	SXTB:      "SXTB",
+	SXTH:      "SXTH",
+	SXTW:      "SXTW",
+	TBNZ:      "TBNZ",
+	TBZ:       "TBZ",
+	TST:       "TST",
+	UBFIZ:     "UBFIZ",
+	UBFM:      "UBFM",
+	UBFX:      "UBFX",
+	UDIV:      "UDIV",
+	UMADDL:    "UMADDL",
+	UMAX:      "UMAX",
+	UMAXV:     "UMAXV",
+	UMIN:      "UMIN",
+	UMINV:     "UMINV",
+	UMNEGL:    "UMNEGL",
+	UMOV:      "UMOV",
+	UMSUBL:    "UMSUBL",
+	UMULH:     "UMULH",
+	UMULL:     "UMULL",
+	UQADD:     "UQADD",
+	UQSUB:     "UQSUB",
+	UXTB:      "UXTB",
+	UXTH:      "UXTH",
+	WFE:       "WFE",
+	WFI:       "WFI",
+	WHILELE:   "WHILELE",
+	WHILELO:   "WHILELO",
+	WHILELS:   "WHILELS",
+	WHILELT:   "WHILELT",
+	XPACD:     "XPACD",
+	XPACI:     "XPACI",
+	XPACLRI:   "XPACLRI",
+	YIELD:     "YIELD",
+}
+
+var opdesc = [...]string{
+	ABS:       "Absolute Value",
+	ADC:       "Add with Carry",
+	ADCS:      "Add with Carry, Setting Flags",
+	ADD:       "Add",
+	ADDP:      "Add Pairwise",
+	ADDS:      "Add, Setting Flags",
+	ADDV:      "Add Across Vector",
+	ADR:       "Form PC-relative Address",
+	ADRP:      "Form PC-relative Address to 4KB Page",
+	AND:       "Bitwise AND",
+	ANDS:      "Bitwise AND, Setting Flags",
+	ASR:       "Arithmetic Shift Right",
+	AUTDA:     "Authenticate Data Address, using Key A",
+	AUTDB:     "Authenticate Data Address, using Key B",
+	AUTDZA:    "Authenticate Data Address, using Key A and Zero Modifier",
+	AUTDZB:    "Authenticate Data Address, using Key B and Zero Modifier",
+	AUTIA:     "Authenticate Instruction Address, using Key A",
+	AUTIA1716: "Authenticate Instruction Address in X17, using Key A and X16",
+	AUTIASP:   "Authenticate Instruction Address in LR, using Key A and SP",
+	AUTIAZ:    "Authenticate Instruction Address in LR, using Key A and Zero Modifier",
+	AUTIB:     "Authenticate Instruction Address, using Key B",
+	AUTIB1716: "Authenticate Instruction Address in X17, using Key B and X16",
+	AUTIBSP:   "Authenticate Instruction Address in LR, using Key B and SP",
+	AUTIBZ:    "Authenticate Instruction Address in LR, using Key B and Zero Modifier",
+	AUTIZA:    "Authenticate Instruction Address, using Key A and Zero Modifier",
+	AUTIZB:    "Authenticate Instruction Address, using Key B and Zero Modifier",
+	B:         "Branch",
+	BCAX:      "Bit Clear and Exclusive OR",
+	BFI:       "Bitfield Insert",
+	BFM:       "Bitfield Move",
+	BFXIL:     "Bitfield Extract and Insert Low",
+	BIC:       "Bitwise Bit Clear",
+	BICS:      "Bitwise Bit Clear, Setting Flags",
+	BIF:       "Bitwise Insert if False",
+	BIT:       "Bitwise Insert if True",
+	BL:        "Branch with Link",
+	BLR:       "Branch with Link to Register",
+	BLRAA:     "Branch with Link to Register, with Pointer Authentication using Key A",
+	BLRAAZ:    "Branch with Link to Register, with Pointer Authentication using Key A and Zero Modifier",
+	BLRAB:     "Branch with Link to Register, with Pointer Authentication using Key B",
+	BLRABZ:    "Branch with Link to Register, with Pointer Authentication using Key B and Zero Modifier",
+	BR:        "Branch to Register",
+	BRAA:      "Branch to Register, with Pointer Authentication using Key A",
+	BRAAZ:     "Branch to Register, with Pointer Authentication using Key A and Zero Modifier",
+	BRAB:      "Branch to Register, with Pointer Authentication using Key B",
+	BRABZ:     "Branch to Register, with Pointer Authentication using Key B and Zero Modifier",
+	BRK:       "Breakpoint",
+	BSL:       "Bitwise Select",
+	BTI:       "Branch Target Identification",
+	CAS:       "Compare and Swap",
+	CASA:      "Compare and Swap (acquire)",
+	CASAB:     "Compare and Swap Byte (acquire)",
+	CASAH:     "Compare and Swap Halfword (acquire)",
+	CASAL:     "Compare and Swap (acquire-release)",
+	CASALB:    "Compare and Swap Byte (acquire-release)",
+	CASALH:    "Compare and Swap Halfword (acquire-release)",
+	CASB:      "Compare and Swap Byte",
+	CASH:      "Compare and Swap Halfword",
+	CASL:      "Compare and Swap (release)",
+	CASLB:     "Compare and Swap Byte (release)",
+	CASLH:     "Compare and Swap Halfword (release)",
+	CASP:      "Compare and Swap Pair",
+	CASPA:     "Compare and Swap Pair (acquire)",
+	CASPAL:    "Compare and Swap Pair (acquire-release)",
+	CASPL:     "Compare and Swap Pair (release)",
+	CBNZ:      "Compare and Branch on Nonzero",
+	CBZ:       "Compare and Branch on Zero",
+	CCMN:      "Conditional Compare Negative",
+	CCMP:      "Conditional Compare",
+	CINC:      "Conditional Increment",
+	CINV:      "Conditional Invert",
+	CLREX:     "Clear Exclusive",
+	CLS:       "Count Leading Sign Bits",
+	CLZ:       "Count Leading Zeros",
+	CMEQ:      "Compare Bitwise Equal",
+	CMGE:      "Compare Signed Greater Than or Equal",
+	CMGT:      "Compare Signed Greater Than",
+	CMHI:      "Compare Unsigned Higher",
+	CMHS:      "Compare Unsigned Higher or Same",
+	CMN:       "Compare Negative",
+	CMP:       "Compare",
+	CMPEQ:     "Compare Vectors Equal",
+	CMPGE:     "Compare Vectors Signed Greater Than or Equal",
+	CMPGT:     "Compare Vectors Signed Greater Than",
+	CMPHI:     "Compare Vectors Unsigned Higher",
+	CMPHS:     "Compare Vectors Unsigned Higher or Same",
+	CMPNE:     "Compare Vectors Not Equal",
+	CNEG:      "Conditional Negate",
+	CNT:       "Population Count per Byte",
+	CNTB:      "Count Bytes in Vector",
+	CNTD:      "Count Doublewords in Vector",
+	CNTH:      "Count Halfwords in Vector",
+	CNTW:      "Count Words in Vector",
+	CSEL:      "Conditional Select",
+	CSET:      "Conditional Set",
+	CSETM:     "Conditional Set Mask",
+	CSINC:     "Conditional Select Increment",
+	CSINV:     "Conditional Select Invert",
+	CSNEG:     "Conditional Select Negation",
+	DECB:      "Decrement by Vector Byte Count",
+	DECD:      "Decrement by Vector Doubleword Count",
+	DECH:      "Decrement by Vector Halfword Count",
+	DECW:      "Decrement by Vector Word Count",
+	DMB:       "Data Memory Barrier",
+	DRPS:      "Debug Restore Process State",
+	DSB:       "Data Synchronization Barrier",
+	DUP:       "Duplicate",
+	EON:       "Bitwise Exclusive OR NOT",
+	EOR:       "Bitwise Exclusive OR",
+	EOR3:      "Three-way Exclusive OR",
+	ERET:      "Exception Return",
+	ERETAA:    "Exception Return, with Pointer Authentication using Key A",
+	ERETAB:    "Exception Return, with Pointer Authentication using Key B",
+	EXTR:      "Extract Register",
+	FADD:      "Floating-point Add",
+	FDIV:      "Floating-point Divide",
+	FMAX:      "Floating-point Maximum",
+	FMIN:      "Floating-point Minimum",
+	FMUL:      "Floating-point Multiply",
+	FSUB:      "Floating-point Subtract",
+	HINT:      "Hint Instruction",
+	HLT:       "Halt",
+	HVC:       "Hypervisor Call",
+	INCB:      "Increment by Vector Byte Count",
+	INCD:      "Increment by Vector Doubleword Count",
+	INCH:      "Increment by Vector Halfword Count",
+	INCW:      "Increment by Vector Word Count",
+	INS:       "Insert Vector Element",
+	ISB:       "Instruction Synchronization Barrier",
+	LD1:       "Load Single-element Structures to One or More Registers",
+	LD1B:      "Contiguous Load Bytes to Vector",
+	LD1D:      "Contiguous Load Doublewords to Vector",
+	LD1H:      "Contiguous Load Halfwords to Vector",
+	LD1W:      "Contiguous Load Words to Vector",
+	LD2:       "Load 2-element Structures to Two Registers",
+	LD3:       "Load 3-element Structures to Three Registers",
+	LD4:       "Load 4-element Structures to Four Registers",
+	LDADD:     "Atomic Add",
+	LDADDA:    "Atomic Add (acquire)",
+	LDADDAB:   "Atomic Add Byte (acquire)",
+	LDADDAH:   "Atomic Add Halfword (acquire)",
+	LDADDAL:   "Atomic Add (acquire-release)",
+	LDADDALB:  "Atomic Add Byte (acquire-release)",
+	LDADDALH:  "Atomic Add Halfword (acquire-release)",
+	LDADDB:    "Atomic Add Byte",
+	LDADDH:    "Atomic Add Halfword",
+	LDADDL:    "Atomic Add (release)",
+	LDADDLB:   "Atomic Add Byte (release)",
+	LDADDLH:   "Atomic Add Halfword (release)",
+	LDAR:      "Load-Acquire Register",
+	LDARB:     "Load-Acquire Register Byte",
+	LDARH:     "Load-Acquire Register Halfword",
+	LDAXP:     "Load-Acquire Exclusive Pair of Registers",
+	LDAXR:     "Load-Acquire Exclusive Register",
+	LDAXRB:    "Load-Acquire Exclusive Register Byte",
+	LDAXRH:    "Load-Acquire Exclusive Register Halfword",
+	LDCLR:     "Atomic Bit Clear",
+	LDCLRA:    "Atomic Bit Clear (acquire)",
+	LDCLRAB:   "Atomic Bit Clear Byte (acquire)",
+	LDCLRAH:   "Atomic Bit Clear Halfword (acquire)",
+	LDCLRAL:   "Atomic Bit Clear (acquire-release)",
+	LDCLRALB:  "Atomic Bit Clear Byte (acquire-release)",
+	LDCLRALH:  "Atomic Bit Clear Halfword (acquire-release)",
+	LDCLRB:    "Atomic Bit Clear Byte",
+	LDCLRH:    "Atomic Bit Clear Halfword",
+	LDCLRL:    "Atomic Bit Clear (release)",
+	LDCLRLB:   "Atomic Bit Clear Byte (release)",
+	LDCLRLH:   "Atomic Bit Clear Halfword (release)",
+	LDEOR:     "Atomic Exclusive OR",
+	LDEORA:    "Atomic Exclusive OR (acquire)",
+	LDEORAB:   "Atomic Exclusive OR Byte (acquire)",
+	LDEORAH:   "Atomic Exclusive OR Halfword (acquire)",
+	LDEORAL:   "Atomic Exclusive OR (acquire-release)",
+	LDEORALB:  "Atomic Exclusive OR Byte (acquire-release)",
+	LDEORALH:  "Atomic Exclusive OR Halfword (acquire-release)",
+	LDEORB:    "Atomic Exclusive OR Byte",
+	LDEORH:    "Atomic Exclusive OR Halfword",
+	LDEORL:    "Atomic Exclusive OR (release)",
+	LDEORLB:   "Atomic Exclusive OR Byte (release)",
+	LDEORLH:   "Atomic Exclusive OR Halfword (release)",
+	LDNP:      "Load Pair of Registers, with Non-temporal Hint",
+	LDP:       "Load Pair of Registers",
+	LDPSW:     "Load Pair of Registers Signed Word",
+	LDR:       "Load Register",
+	LDRAA:     "Load Register, with Pointer Authentication using Key A",
+	LDRAB:     "Load Register, with Pointer Authentication using Key B",
+	LDRB:      "Load Register Byte",
+	LDRH:      "Load Register Halfword",
+	LDRSB:     "Load Register Signed Byte",
+	LDRSH:     "Load Register Signed Halfword",
+	LDRSW:     "Load Register Signed Word",
+	LDSET:     "Atomic Bit Set",
+	LDSETA:    "Atomic Bit Set (acquire)",
+	LDSETAB:   "Atomic Bit Set Byte (acquire)",
+	LDSETAH:   "Atomic Bit Set Halfword (acquire)",
+	LDSETAL:   "Atomic Bit Set (acquire-release)",
+	LDSETALB:  "Atomic Bit Set Byte (acquire-release)",
+	LDSETALH:  "Atomic Bit Set Halfword (acquire-release)",
+	LDSETB:    "Atomic Bit Set Byte",
+	LDSETH:    "Atomic Bit Set Halfword",
+	LDSETL:    "Atomic Bit Set (release)",
+	LDSETLB:   "Atomic Bit Set Byte (release)",
+	LDSETLH:   "Atomic Bit Set Halfword (release)",
+	LDSMAX:    "Atomic Signed Maximum",
+	LDSMAXA:   "Atomic Signed Maximum (acquire)",
+	LDSMAXAB:  "Atomic Signed Maximum Byte (acquire)",
+	LDSMAXAH:  "Atomic Signed Maximum Halfword (acquire)",
+	LDSMAXAL:  "Atomic Signed Maximum (acquire-release)",
+	LDSMAXALB: "Atomic Signed Maximum Byte (acquire-release)",
+	LDSMAXALH: "Atomic Signed Maximum Halfword (acquire-release)",
+	LDSMAXB:   "Atomic Signed Maximum Byte",
+	LDSMAXH:   "Atomic Signed Maximum Halfword",
+	LDSMAXL:   "Atomic Signed Maximum (release)",
+	LDSMAXLB:  "Atomic Signed Maximum Byte (release)",
+	LDSMAXLH:  "Atomic Signed Maximum Halfword (release)",
+	LDSMIN:    "Atomic Signed Minimum",
+	LDSMINA:   "Atomic Signed Minimum (acquire)",
+	LDSMINAB:  "Atomic Signed Minimum Byte (acquire)",
+	LDSMINAH:  "Atomic Signed Minimum Halfword (acquire)",
+	LDSMINAL:  "Atomic Signed Minimum (acquire-release)",
+	LDSMINALB: "Atomic Signed Minimum Byte (acquire-release)",
+	LDSMINALH: "Atomic Signed Minimum Halfword (acquire-release)",
+	LDSMINB:   "Atomic Signed Minimum Byte",
+	LDSMINH:   "Atomic Signed Minimum Halfword",
+	LDSMINL:   "Atomic Signed Minimum (release)",
+	LDSMINLB:  "Atomic Signed Minimum Byte (release)",
+	LDSMINLH:  "Atomic Signed Minimum Halfword (release)",
+	LDUMAX:    "Atomic Unsigned Maximum",
+	LDUMAXA:   "Atomic Unsigned Maximum (acquire)",
+	LDUMAXAB:  "Atomic Unsigned Maximum Byte (acquire)",
+	LDUMAXAH:  "Atomic Unsigned Maximum Halfword (acquire)",
+	LDUMAXAL:  "Atomic Unsigned Maximum (acquire-release)",
+	LDUMAXALB: "Atomic Unsigned Maximum Byte (acquire-release)",
+	LDUMAXALH: "Atomic Unsigned Maximum Halfword (acquire-release)",
+	LDUMAXB:   "Atomic Unsigned Maximum Byte",
+	LDUMAXH:   "Atomic Unsigned Maximum Halfword",
+	LDUMAXL:   "Atomic Unsigned Maximum (release)",
+	LDUMAXLB:  "Atomic Unsigned Maximum Byte (release)",
+	LDUMAXLH:  "Atomic Unsigned Maximum Halfword (release)",
+	LDUMIN:    "Atomic Unsigned Minimum",
+	LDUMINA:   "Atomic Unsigned Minimum (acquire)",
+	LDUMINAB:  "Atomic Unsigned Minimum Byte (acquire)",
+	LDUMINAH:  "Atomic Unsigned Minimum Halfword (acquire)",
+	LDUMINAL:  "Atomic Unsigned Minimum (acquire-release)",
+	LDUMINALB: "Atomic Unsigned Minimum Byte (acquire-release)",
+	LDUMINALH: "Atomic Unsigned Minimum Halfword (acquire-release)",
+	LDUMINB:   "Atomic Unsigned Minimum Byte",
+	LDUMINH:   "Atomic Unsigned Minimum Halfword",
+	LDUMINL:   "Atomic Unsigned Minimum (release)",
+	LDUMINLB:  "Atomic Unsigned Minimum Byte (release)",
+	LDUMINLH:  "Atomic Unsigned Minimum Halfword (release)",
+	LDUR:      "Load Register, Unscaled",
+	LDURB:     "Load Register Byte, Unscaled",
+	LDURH:     "Load Register Halfword, Unscaled",
+	LDURSB:    "Load Register Signed Byte, Unscaled",
+	LDURSH:    "Load Register Signed Halfword, Unscaled",
+	LDURSW:    "Load Register Signed Word, Unscaled",
+	LDXP:      "Load Exclusive Pair of Registers",
+	LDXR:      "Load Exclusive Register",
+	LDXRB:     "Load Exclusive Register Byte",
+	LDXRH:     "Load Exclusive Register Halfword",
+	LSL:       "Logical Shift Left",
+	LSR:       "Logical Shift Right",
+	MADD:      "Multiply-Add",
+	MNEG:      "Multiply-Negate",
+	MOV:       "Move",
+	MOVI:      "Move Immediate (vector)",
+	MOVK:      "Move Wide with Keep",
+	MOVN:      "Move Wide with NOT",
+	MOVZ:      "Move Wide with Zero",
+	MRS:       "Move System Register to General-purpose Register",
+	MSR:       "Move General-purpose Register to System Register",
+	MSUB:      "Multiply-Subtract",
+	MUL:       "Multiply",
+	MVN:       "Bitwise NOT",
+	MVNI:      "Move Inverted Immediate (vector)",
+	NEG:       "Negate",
+	NEGS:      "Negate, Setting Flags",
+	NGC:       "Negate with Carry",
+	NGCS:      "Negate with Carry, Setting Flags",
+	NOP:       "No Operation",
+	ORN:       "Bitwise OR NOT",
+	ORR:       "Bitwise OR",
+	PACDA:     "Pointer Authentication Code for Data Address, using Key A",
+	PACDB:     "Pointer Authentication Code for Data Address, using Key B",
+	PACDZA:    "Pointer Authentication Code for Data Address, using Key A and Zero Modifier",
+	PACDZB:    "Pointer Authentication Code for Data Address, using Key B and Zero Modifier",
+	PACGA:     "Pointer Authentication Code, using Generic Key",
+	PACIA:     "Pointer Authentication Code for Instruction Address, using Key A",
+	PACIA1716: "Pointer Authentication Code for Instruction Address in X17, using Key A and X16",
+	PACIASP:   "Pointer Authentication Code for Instruction Address in LR, using Key A and SP",
+	PACIAZ:    "Pointer Authentication Code for Instruction Address in LR, using Key A and Zero Modifier",
+	PACIB:     "Pointer Authentication Code for Instruction Address, using Key B",
+	PACIB1716: "Pointer Authentication Code for Instruction Address in X17, using Key B and X16",
+	PACIBSP:   "Pointer Authentication Code for Instruction Address in LR, using Key B and SP",
+	PACIBZ:    "Pointer Authentication Code for Instruction Address in LR, using Key B and Zero Modifier",
+	PACIZA:    "Pointer Authentication Code for Instruction Address, using Key A and Zero Modifier",
+	PACIZB:    "Pointer Authentication Code for Instruction Address, using Key B and Zero Modifier",
+	PFALSE:    "Set All Predicate Elements to False",
+	PRFM:      "Prefetch Memory",
+	PTRUE:     "Initialise Predicate from Named Constraint",
+	PTRUES:    "Initialise Predicate from Named Constraint, Setting Flags",
+	RBIT:      "Reverse Bits",
+	RET:       "Return from Subroutine",
+	RETAA:     "Return from Subroutine, with Pointer Authentication using Key A",
+	RETAB:     "Return from Subroutine, with Pointer Authentication using Key B",
+	REV:       "Reverse Bytes",
+	REV16:     "Reverse Bytes in 16-bit Halfwords",
+	REV32:     "Reverse Bytes in 32-bit Words",
+	REV64:     "Reverse Elements in 64-bit Doublewords",
+	ROR:       "Rotate Right",
+	SBC:       "Subtract with Carry",
+	SBCS:      "Subtract with Carry, Setting Flags",
+	SBFIZ:     "Signed Bitfield Insert in Zero",
+	SBFM:      "Signed Bitfield Move",
+	SBFX:      "Signed Bitfield Extract",
+	SDIV:      "Signed Divide",
+	SEL:       "Conditionally Select Elements",
+	SEV:       "Send Event",
+	SEVL:      "Send Event Local",
+	SMADDL:    "Signed Multiply-Add Long",
+	SMAX:      "Signed Maximum",
+	SMAXV:     "Signed Maximum Across Vector",
+	SMC:       "Secure Monitor Call",
+	SMIN:      "Signed Minimum",
+	SMINV:     "Signed Minimum Across Vector",
+	SMNEGL:    "Signed Multiply-Negate Long",
+	SMOV:      "Signed Move Vector Element to General-purpose Register",
+	SMSUBL:    "Signed Multiply-Subtract Long",
+	SMULH:     "Signed Multiply High",
+	SMULL:     "Signed Multiply Long",
+	SQADD:     "Signed Saturating Add",
+	SQSUB:     "Signed Saturating Subtract",
+	ST1:       "Store Single-element Structures from One or More Registers",
+	ST1B:      "Contiguous Store Bytes from Vector",
+	ST1D:      "Contiguous Store Doublewords from Vector",
+	ST1H:      "Contiguous Store Halfwords from Vector",
+	ST1W:      "Contiguous Store Words from Vector",
+	ST2:       "Store 2-element Structures from Two Registers",
+	ST3:       "Store 3-element Structures from Three Registers",
+	ST4:       "Store 4-element Structures from Four Registers",
+	STADD:     "Atomic Add, without Return",
+	STADDB:    "Atomic Add Byte, without Return",
+	STADDH:    "Atomic Add Halfword, without Return",
+	STADDL:    "Atomic Add, without Return (release)",
+	STADDLB:   "Atomic Add Byte, without Return (release)",
+	STADDLH:   "Atomic Add Halfword, without Return (release)",
+	STCLR:     "Atomic Bit Clear, without Return",
+	STCLRB:    "Atomic Bit Clear Byte, without Return",
+	STCLRH:    "Atomic Bit Clear Halfword, without Return",
+	STCLRL:    "Atomic Bit Clear, without Return (release)",
+	STCLRLB:   "Atomic Bit Clear Byte, without Return (release)",
+	STCLRLH:   "Atomic Bit Clear Halfword, without Return (release)",
+	STEOR:     "Atomic Exclusive OR, without Return",
+	STEORB:    "Atomic Exclusive OR Byte, without Return",
+	STEORH:    "Atomic Exclusive OR Halfword, without Return",
+	STEORL:    "Atomic Exclusive OR, without Return (release)",
+	STEORLB:   "Atomic Exclusive OR Byte, without Return (release)",
+	STEORLH:   "Atomic Exclusive OR Halfword, without Return (release)",
+	STLR:      "Store-Release Register",
+	STLRB:     "Store-Release Register Byte",
+	STLRH:     "Store-Release Register Halfword",
+	STLXP:     "Store-Release Exclusive Pair of Registers",
+	STLXR:     "Store-Release Exclusive Register",
+	STLXRB:    "Store-Release Exclusive Register Byte",
+	STLXRH:    "Store-Release Exclusive Register Halfword",
+	STNP:      "Store Pair of Registers, with Non-temporal Hint",
+	STP:       "Store Pair of Registers",
+	STR:       "Store Register",
+	STRB:      "Store Register Byte",
+	STRH:      "Store Register Halfword",
+	STSET:     "Atomic Bit Set, without Return",
+	STSETB:    "Atomic Bit Set Byte, without Return",
+	STSETH:    "Atomic Bit Set Halfword, without Return",
+	STSETL:    "Atomic Bit Set, without Return (release)",
+	STSETLB:   "Atomic Bit Set Byte, without Return (release)",
+	STSETLH:   "Atomic Bit Set Halfword, without Return (release)",
+	STSMAX:    "Atomic Signed Maximum, without Return",
+	STSMAXB:   "Atomic Signed Maximum Byte, without Return",
+	STSMAXH:   "Atomic Signed Maximum Halfword, without Return",
+	STSMAXL:   "Atomic Signed Maximum, without Return (release)",
+	STSMAXLB:  "Atomic Signed Maximum Byte, without Return (release)",
+	STSMAXLH:  "Atomic Signed Maximum Halfword, without Return (release)",
+	STSMIN:    "Atomic Signed Minimum, without Return",
+	STSMINB:   "Atomic Signed Minimum Byte, without Return",
+	STSMINH:   "Atomic Signed Minimum Halfword, without Return",
+	STSMINL:   "Atomic Signed Minimum, without Return (release)",
+	STSMINLB:  "Atomic Signed Minimum Byte, without Return (release)",
+	STSMINLH:  "Atomic Signed Minimum Halfword, without Return (release)",
+	STUMAX:    "Atomic Unsigned Maximum, without Return",
+	STUMAXB:   "Atomic Unsigned Maximum Byte, without Return",
+	STUMAXH:   "Atomic Unsigned Maximum Halfword, without Return",
+	STUMAXL:   "Atomic Unsigned Maximum, without Return (release)",
+	STUMAXLB:  "Atomic Unsigned Maximum Byte, without Return (release)",
+	STUMAXLH:  "Atomic Unsigned Maximum Halfword, without Return (release)",
+	STUMIN:    "Atomic Unsigned Minimum, without Return",
+	STUMINB:   "Atomic Unsigned Minimum Byte, without Return",
+	STUMINH:   "Atomic Unsigned Minimum Halfword, without Return",
+	STUMINL:   "Atomic Unsigned Minimum, without Return (release)",
+	STUMINLB:  "Atomic Unsigned Minimum Byte, without Return (release)",
+	STUMINLH:  "Atomic Unsigned Minimum Halfword, without Return (release)",
+	STUR:      "Store Register, Unscaled",
+	STURB:     "Store Register Byte, Unscaled",
+	STURH:     "Store Register Halfword, Unscaled",
+	STXP:      "Store Exclusive Pair of Registers",
+	STXR:      "Store Exclusive Register",
+	STXRB:     "Store Exclusive Register Byte",
+	STXRH:     "Store Exclusive Register Halfword",
+	SUB:       "Subtract",
+	SUBR:      "Reversed Subtract",
+	SUBS:      "Subtract, Setting Flags",
+	SVC:       "Supervisor Call",
+	SWP:       "Swap",
+	SWPA:      "Swap (acquire)",
+	SWPAB:     "Swap Byte (acquire)",
+	SWPAH:     "Swap Halfword (acquire)",
+	SWPAL:     "Swap (acquire-release)",
+	SWPALB:    "Swap Byte (acquire-release)",
+	SWPALH:    "Swap Halfword (acquire-release)",
+	SWPB:      "Swap Byte",
+	SWPH:      "Swap Halfword",
+	SWPL:      "Swap (release)",
+	SWPLB:     "Swap Byte (release)",
+	SWPLH:     "Swap Halfword (release)",
+	SXTB:      "Sign Extend Byte",
+	SXTH:      "Sign Extend Halfword",
+	SXTW:      "Sign Extend Word",
+	TBNZ:      "Test Bit and Branch if Nonzero",
+	TBZ:       "Test Bit and Branch if Zero",
+	TST:       "Test Bits",
+	UBFIZ:     "Unsigned Bitfield Insert in Zero",
+	UBFM:      "Unsigned Bitfield Move",
+	UBFX:      "Unsigned Bitfield Extract",
+	UDIV:      "Unsigned Divide",
+	UMADDL:    "Unsigned Multiply-Add Long",
+	UMAX:      "Unsigned Maximum",
+	UMAXV:     "Unsigned Maximum Across Vector",
+	UMIN:      "Unsigned Minimum",
+	UMINV:     "Unsigned Minimum Across Vector",
+	UMNEGL:    "Unsigned Multiply-Negate Long",
+	UMOV:      "Unsigned Move Vector Element to General-purpose Register",
+	UMSUBL:    "Unsigned Multiply-Subtract Long",
+	UMULH:     "Unsigned Multiply High",
+	UMULL:     "Unsigned Multiply Long",
+	UQADD:     "Unsigned Saturating Add",
+	UQSUB:     "Unsigned Saturating Subtract",
+	UXTB:      "Unsigned Extend Byte",
+	UXTH:      "Unsigned Extend Halfword",
+	WFE:       "Wait For Event",
+	WFI:       "Wait For Interrupt",
+	WHILELE:   "While Incrementing Signed Scalar Less Than or Equal to Scalar",
+	WHILELO:   "While Incrementing Unsigned Scalar Lower than Scalar",
+	WHILELS:   "While Incrementing Unsigned Scalar Lower or Same as Scalar",
+	WHILELT:   "While Incrementing Signed Scalar Less Than Scalar",
+	XPACD:     "Strip Pointer Authentication Code from Data Address",
+	XPACI:     "Strip Pointer Authentication Code from Instruction Address",
+	XPACLRI:   "Strip Pointer Authentication Code from LR",
+	YIELD:     "Yield",
+}
+
+var instFormats = [...]instFormat{
+	{0x9f000000, 0x10000000, ADR, instArgs{arg_Xd, arg_label_adr}},                                                  // ADR <Xd>, <label>
+	{0x9f000000, 0x90000000, ADRP, instArgs{arg_Xd, arg_label_adrp}},                                                // ADRP <Xd>, <label>
+	{0x7ffffc00, 0x11000000, MOV, instArgs{arg_Rd_SP_movsp, arg_Rn_SP}},                                             // MOV <Rd|SP>, <Rn|SP>
+	{0x7f800000, 0x11000000, ADD, instArgs{arg_Rd_SP, arg_Rn_SP, arg_imm12_shift}},                                  // ADD <Rd|SP>, <Rn|SP>, #<imm>{, LSL #12}
+	{0x7f80001f, 0x3100001f, CMN, instArgs{arg_Rn_SP, arg_imm12_shift}},                                             // CMN <Rn|SP>, #<imm>{, LSL #12}
+	{0x7f800000, 0x31000000, ADDS, instArgs{arg_Rd, arg_Rn_SP, arg_imm12_shift}},                                    // ADDS <Rd>, <Rn|SP>, #<imm>{, LSL #12}
+	{0x7f800000, 0x51000000, SUB, instArgs{arg_Rd_SP, arg_Rn_SP, arg_imm12_shift}},                                  // SUB <Rd|SP>, <Rn|SP>, #<imm>{, LSL #12}
+	{0x7f80001f, 0x7100001f, CMP, instArgs{arg_Rn_SP, arg_imm12_shift}},                                             // CMP <Rn|SP>, #<imm>{, LSL #12}
+	{0x7f800000, 0x71000000, SUBS, instArgs{arg_Rd, arg_Rn_SP, arg_imm12_shift}},                                    // SUBS <Rd>, <Rn|SP>, #<imm>{, LSL #12}
+	{0x7f800000, 0x12000000, AND, instArgs{arg_Rd_SP, arg_Rn, arg_bitmask}},                                         // AND <Rd|SP>, <Rn>, #<imm>
+	{0x7f8003e0, 0x320003e0, MOV, instArgs{arg_Rd_SP, arg_bitmask_mov}},                                             // MOV <Rd|SP>, #<imm>
+	{0x7f800000, 0x32000000, ORR, instArgs{arg_Rd_SP, arg_Rn, arg_bitmask}},                                         // ORR <Rd|SP>, <Rn>, #<imm>
+	{0x7f800000, 0x52000000, EOR, instArgs{arg_Rd_SP, arg_Rn, arg_bitmask}},                                         // EOR <Rd|SP>, <Rn>, #<imm>
+	{0x7f80001f, 0x7200001f, TST, instArgs{arg_Rn, arg_bitmask}},                                                    // TST <Rn>, #<imm>
+	{0x7f800000, 0x72000000, ANDS, instArgs{arg_Rd, arg_Rn, arg_bitmask}},                                           // ANDS <Rd>, <Rn>, #<imm>
+	{0x7f800000, 0x12800000, MOV, instArgs{arg_Rd, arg_movn_imm}},                                                   // MOV <Rd>, #<imm>
+	{0x7f800000, 0x12800000, MOVN, instArgs{arg_Rd, arg_imm16_hw}},                                                  // MOVN <Rd>, #<imm16>{, LSL #<shift>}
+	{0x7f800000, 0x52800000, MOV, instArgs{arg_Rd, arg_movz_imm}},                                                   // MOV <Rd>, #<imm>
+	{0x7f800000, 0x52800000, MOVZ, instArgs{arg_Rd, arg_imm16_hw}},                                                  // MOVZ <Rd>, #<imm16>{, LSL #<shift>}
+	{0x7f800000, 0x72800000, MOVK, instArgs{arg_Rd, arg_imm16_hw}},                                                  // MOVK <Rd>, #<imm16>{, LSL #<shift>}
+	{0xffc00000, 0x13000000, ASR, instArgs{arg_Rd, arg_Rn, arg_immr_shift}},                                         // ASR <Rd>, <Rn>, #<shift>
+	{0xffc00000, 0x13000000, SBFIZ, instArgs{arg_Rd, arg_Rn, arg_bfiz_lsb, arg_bfiz_width}},                         // SBFIZ <Rd>, <Rn>, #<lsb>, #<width>
+	{0xfffffc00, 0x13001c00, SXTB, instArgs{arg_Rd, arg_Wn}},                                                        // SXTB <Rd>, <Wn>
+	{0xfffffc00, 0x13003c00, SXTH, instArgs{arg_Rd, arg_Wn}},                                                        // SXTH <Rd>, <Wn>
+	{0xffc00000, 0x13000000, SBFX, instArgs{arg_Rd, arg_Rn, arg_bfx_lsb, arg_bfx_width}},                            // SBFX <Rd>, <Rn>, #<lsb>, #<width>
+	{0xffc00000, 0x13000000, SBFM, instArgs{arg_Rd, arg_Rn, arg_immr, arg_imms}},                                    // SBFM <Rd>, <Rn>, #<immr>, #<imms>
+	{0xffc00000, 0x33000000, BFI, instArgs{arg_Rd, arg_Rn, arg_bfiz_lsb, arg_bfiz_width}},                           // BFI <Rd>, <Rn>, #<lsb>, #<width>
+	{0xffc00000, 0x33000000, BFXIL, instArgs{arg_Rd, arg_Rn, arg_bfx_lsb, arg_bfx_width}},                           // BFXIL <Rd>, <Rn>, #<lsb>, #<width>
+	{0xffc00000, 0x33000000, BFM, instArgs{arg_Rd, arg_Rn, arg_immr, arg_imms}},                                     // BFM <Rd>, <Rn>, #<immr>, #<imms>
+	{0xffc00000, 0x53000000, LSL, instArgs{arg_Rd, arg_Rn, arg_lsl_shift}},                                          // LSL <Rd>, <Rn>, #<shift>
+	{0xffc00000, 0x53000000, LSR, instArgs{arg_Rd, arg_Rn, arg_immr_shift}},                                         // LSR <Rd>, <Rn>, #<shift>
+	{0xffc00000, 0x53000000, UBFIZ, instArgs{arg_Rd, arg_Rn, arg_bfiz_lsb, arg_bfiz_width}},                         // UBFIZ <Rd>, <Rn>, #<lsb>, #<width>
+	{0xfffffc00, 0x53001c00, UXTB, instArgs{arg_Wd, arg_Wn}},                                                        // UXTB <Wd>, <Wn>
+	{0xfffffc00, 0x53003c00, UXTH, instArgs{arg_Wd, arg_Wn}},                                                        // UXTH <Wd>, <Wn>
+	{0xffc00000, 0x53000000, UBFX, instArgs{arg_Rd, arg_Rn, arg_bfx_lsb, arg_bfx_width}},                            // UBFX <Rd>, <Rn>, #<lsb>, #<width>
+	{0xffc00000, 0x53000000, UBFM, instArgs{arg_Rd, arg_Rn, arg_immr, arg_imms}},                                    // UBFM <Rd>, <Rn>, #<immr>, #<imms>
+	{0xffc00000, 0x93400000, ASR, instArgs{arg_Rd, arg_Rn, arg_immr_shift}},                                         // ASR <Rd>, <Rn>, #<shift>
+	{0xffc00000, 0x93400000, SBFIZ, instArgs{arg_Rd, arg_Rn, arg_bfiz_lsb, arg_bfiz_width}},                         // SBFIZ <Rd>, <Rn>, #<lsb>, #<width>
+	{0xfffffc00, 0x93401c00, SXTB, instArgs{arg_Rd, arg_Wn}},                                                        // SXTB <Rd>, <Wn>
+	{0xfffffc00, 0x93403c00, SXTH, instArgs{arg_Rd, arg_Wn}},                                                        // SXTH <Rd>, <Wn>
+	{0xfffffc00, 0x93407c00, SXTW, instArgs{arg_Xd, arg_Wn}},                                                        // SXTW <Xd>, <Wn>
+	{0xffc00000, 0x93400000, SBFX, instArgs{arg_Rd, arg_Rn, arg_bfx_lsb, arg_bfx_width}},                            // SBFX <Rd>, <Rn>, #<lsb>, #<width>
+	{0xffc00000, 0x93400000, SBFM, instArgs{arg_Rd, arg_Rn, arg_immr, arg_imms}},                                    // SBFM <Rd>, <Rn>, #<immr>, #<imms>
+	{0xffc00000, 0xb3400000, BFI, instArgs{arg_Rd, arg_Rn, arg_bfiz_lsb, arg_bfiz_width}},                           // BFI <Rd>, <Rn>, #<lsb>, #<width>
+	{0xffc00000, 0xb3400000, BFXIL, instArgs{arg_Rd, arg_Rn, arg_bfx_lsb, arg_bfx_width}},                           // BFXIL <Rd>, <Rn>, #<lsb>, #<width>
+	{0xffc00000, 0xb3400000, BFM, instArgs{arg_Rd, arg_Rn, arg_immr, arg_imms}},                                     // BFM <Rd>, <Rn>, #<immr>, #<imms>
+	{0xffc00000, 0xd3400000, LSL, instArgs{arg_Rd, arg_Rn, arg_lsl_shift}},                                          // LSL <Rd>, <Rn>, #<shift>
+	{0xffc00000, 0xd3400000, LSR, instArgs{arg_Rd, arg_Rn, arg_immr_shift}},                                         // LSR <Rd>, <Rn>, #<shift>
+	{0xffc00000, 0xd3400000, UBFIZ, instArgs{arg_Rd, arg_Rn, arg_bfiz_lsb, arg_bfiz_width}},                         // UBFIZ <Rd>, <Rn>, #<lsb>, #<width>
+	{0xffc00000, 0xd3400000, UBFX, instArgs{arg_Rd, arg_Rn, arg_bfx_lsb, arg_bfx_width}},                            // UBFX <Rd>, <Rn>, #<lsb>, #<width>
+	{0xffc00000, 0xd3400000, UBFM, instArgs{arg_Rd, arg_Rn, arg_immr, arg_imms}},                                    // UBFM <Rd>, <Rn>, #<immr>, #<imms>
+	{0xffe00000, 0x13800000, ROR, instArgs{arg_Rd, arg_Rn, arg_imms_ror}},                                           // ROR <Rd>, <Rn>, #<shift>
+	{0xffe00000, 0x13800000, EXTR, instArgs{arg_Rd, arg_Rn, arg_Rm, arg_imms_lsb}},                                  // EXTR <Rd>, <Rn>, <Rm>, #<lsb>
+	{0xffe00000, 0x93c00000, ROR, instArgs{arg_Rd, arg_Rn, arg_imms_ror}},                                           // ROR <Rd>, <Rn>, #<shift>
+	{0xffe00000, 0x93c00000, EXTR, instArgs{arg_Rd, arg_Rn, arg_Rm, arg_imms_lsb}},                                  // EXTR <Rd>, <Rn>, <Rm>, #<lsb>
+	{0xfc000000, 0x14000000, B, instArgs{arg_label26}},                                                              // B <label>
+	{0xfc000000, 0x94000000, BL, instArgs{arg_label26}},                                                             // BL <label>
+	{0xff000010, 0x54000000, B, instArgs{arg_cond_0, arg_label19}},                                                  // B.<cond> <label>
+	{0x7f000000, 0x34000000, CBZ, instArgs{arg_Rt_31, arg_label19}},                                                 // CBZ <Rt>, <label>
+	{0x7f000000, 0x35000000, CBNZ, instArgs{arg_Rt_31, arg_label19}},                                                // CBNZ <Rt>, <label>
+	{0x7f000000, 0x36000000, TBZ, instArgs{arg_Rt_31, arg_tbz_bit, arg_label14}},                                    // TBZ <R><t>, #<imm>, <label>
+	{0x7f000000, 0x37000000, TBNZ, instArgs{arg_Rt_31, arg_tbz_bit, arg_label14}},                                   // TBNZ <R><t>, #<imm>, <label>
+	{0xffe0001f, 0xd4000001, SVC, instArgs{arg_imm16}},                                                              // SVC #<imm>
+	{0xffe0001f, 0xd4000002, HVC, instArgs{arg_imm16}},                                                              // HVC #<imm>
+	{0xffe0001f, 0xd4000003, SMC, instArgs{arg_imm16}},                                                              // SMC #<imm>
+	{0xffe0001f, 0xd4200000, BRK, instArgs{arg_imm16}},                                                              // BRK #<imm>
+	{0xffe0001f, 0xd4400000, HLT, instArgs{arg_imm16}},                                                              // HLT #<imm>
+	{0xffffffff, 0xd503201f, NOP, instArgs{}},                                                                       // NOP
+	{0xffffffff, 0xd503203f, YIELD, instArgs{}},                                                                     // YIELD
+	{0xffffffff, 0xd503205f, WFE, instArgs{}},                                                                       // WFE
+	{0xffffffff, 0xd503207f, WFI, instArgs{}},                                                                       // WFI
+	{0xffffffff, 0xd503209f, SEV, instArgs{}},                                                                       // SEV
+	{0xffffffff, 0xd50320bf, SEVL, instArgs{}},                                                                      // SEVL
+	{0xffffffff, 0xd503211f, PACIA1716, instArgs{}},                                                                 // PACIA1716
+	{0xffffffff, 0xd503215f, PACIB1716, instArgs{}},                                                                 // PACIB1716
+	{0xffffffff, 0xd503219f, AUTIA1716, instArgs{}},                                                                 // AUTIA1716
+	{0xffffffff, 0xd50321df, AUTIB1716, instArgs{}},                                                                 // AUTIB1716
+	{0xffffffff, 0xd50320ff, XPACLRI, instArgs{}},                                                                   // XPACLRI
+	{0xffffffff, 0xd503231f, PACIAZ, instArgs{}},                                                                    // PACIAZ
+	{0xffffffff, 0xd503233f, PACIASP, instArgs{}},                                                                   // PACIASP
+	{0xffffffff, 0xd503235f, PACIBZ, instArgs{}},                                                                    // PACIBZ
+	{0xffffffff, 0xd503237f, PACIBSP, instArgs{}},                                                                   // PACIBSP
+	{0xffffffff, 0xd503239f, AUTIAZ, instArgs{}},                                                                    // AUTIAZ
+	{0xffffffff, 0xd50323bf, AUTIASP, instArgs{}},                                                                   // AUTIASP
+	{0xffffffff, 0xd50323df, AUTIBZ, instArgs{}},                                                                    // AUTIBZ
+	{0xffffffff, 0xd50323ff, AUTIBSP, instArgs{}},                                                                   // AUTIBSP
+	{0xffffffff, 0xd503241f, BTI, instArgs{}},                                                                       // BTI
+	{0xffffff3f, 0xd503241f, BTI, instArgs{arg_bti}},                                                                // BTI <targets>
+	{0xfffff01f, 0xd503201f, HINT, instArgs{arg_hint}},                                                              // HINT #<imm>
+	{0xffffffff, 0xd5033f5f, CLREX, instArgs{}},                                                                     // CLREX
+	{0xfffff0ff, 0xd503305f, CLREX, instArgs{arg_crm}},                                                              // CLREX #<imm>
+	{0xfffff0ff, 0xd503309f, DSB, instArgs{arg_barrier}},                                                            // DSB <option>
+	{0xfffff0ff, 0xd50330bf, DMB, instArgs{arg_barrier}},                                                            // DMB <option>
+	{0xffffffff, 0xd5033fdf, ISB, instArgs{}},                                                                       // ISB
+	{0xfffff0ff, 0xd50330df, ISB, instArgs{arg_crm}},                                                                // ISB #<imm>
+	{0xfff8f01f, 0xd500401f, MSR, instArgs{arg_pstate, arg_crm}},                                                    // MSR <pstatefield>, #<imm>
+	{0xfff00000, 0xd5100000, MSR, instArgs{arg_sysreg, arg_Xt}},                                                     // MSR <systemreg>, <Xt>
+	{0xfff00000, 0xd5300000, MRS, instArgs{arg_Xt, arg_sysreg}},                                                     // MRS <Xt>, <systemreg>
+	{0xfffffc1f, 0xd61f0000, BR, instArgs{arg_Xn}},                                                                  // BR <Xn>
+	{0xfffffc1f, 0xd63f0000, BLR, instArgs{arg_Xn}},                                                                 // BLR <Xn>
+	{0xffffffff, 0xd65f03c0, RET, instArgs{}},                                                                       // RET
+	{0xfffffc1f, 0xd65f0000, RET, instArgs{arg_Xn}},                                                                 // RET <Xn>
+	{0xffffffff, 0xd69f03e0, ERET, instArgs{}},                                                                      // ERET
+	{0xffffffff, 0xd6bf03e0, DRPS, instArgs{}},                                                                      // DRPS
+	{0xfffffc1f, 0xd61f081f, BRAAZ, instArgs{arg_Xn}},                                                               // BRAAZ <Xn>
+	{0xfffffc1f, 0xd61f0c1f, BRABZ, instArgs{arg_Xn}},                                                               // BRABZ <Xn>
+	{0xfffffc1f, 0xd63f081f, BLRAAZ, instArgs{arg_Xn}},                                                              // BLRAAZ <Xn>
+	{0xfffffc1f, 0xd63f0c1f, BLRABZ, instArgs{arg_Xn}},                                                              // BLRABZ <Xn>
+	{0xffffffff, 0xd65f0bff, RETAA, instArgs{}},                                                                     // RETAA
+	{0xffffffff, 0xd65f0fff, RETAB, instArgs{}},                                                                     // RETAB
+	{0xffffffff, 0xd69f0bff, ERETAA, instArgs{}},                                                                    // ERETAA
+	{0xffffffff, 0xd69f0fff, ERETAB, instArgs{}},                                                                    // ERETAB
+	{0xfffffc00, 0xd71f0800, BRAA, instArgs{arg_Xn, arg_Rd_SP}},                                                     // BRAA <Xn>, <Xm|SP>
+	{0xfffffc00, 0xd71f0c00, BRAB, instArgs{arg_Xn, arg_Rd_SP}},                                                     // BRAB <Xn>, <Xm|SP>
+	{0xfffffc00, 0xd73f0800, BLRAA, instArgs{arg_Xn, arg_Rd_SP}},                                                    // BLRAA <Xn>, <Xm|SP>
+	{0xfffffc00, 0xd73f0c00, BLRAB, instArgs{arg_Xn, arg_Rd_SP}},                                                    // BLRAB <Xn>, <Xm|SP>
+	{0xffe0fc00, 0x08007c00, STXRB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                        // STXRB <Ws>, <Rt>, [<Xn|SP>]
+	{0xffe0fc00, 0x0800fc00, STLXRB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                       // STLXRB <Ws>, <Rt>, [<Xn|SP>]
+	{0xfffffc00, 0x085f7c00, LDXRB, instArgs{arg_Wt, arg_mem_Xn_SP}},                                                // LDXRB <Rt>, [<Xn|SP>]
+	{0xfffffc00, 0x085ffc00, LDAXRB, instArgs{arg_Wt, arg_mem_Xn_SP}},                                               // LDAXRB <Rt>, [<Xn|SP>]
+	{0xfffffc00, 0x089ffc00, STLRB, instArgs{arg_Wt, arg_mem_Xn_SP}},                                                // STLRB <Rt>, [<Xn|SP>]
+	{0xfffffc00, 0x08dffc00, LDARB, instArgs{arg_Wt, arg_mem_Xn_SP}},                                                // LDARB <Rt>, [<Xn|SP>]
+	{0xffe0fc00, 0x48007c00, STXRH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                        // STXRH <Ws>, <Rt>, [<Xn|SP>]
+	{0xffe0fc00, 0x4800fc00, STLXRH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                       // STLXRH <Ws>, <Rt>, [<Xn|SP>]
+	{0xfffffc00, 0x485f7c00, LDXRH, instArgs{arg_Wt, arg_mem_Xn_SP}},                                                // LDXRH <Rt>, [<Xn|SP>]
+	{0xfffffc00, 0x485ffc00, LDAXRH, instArgs{arg_Wt, arg_mem_Xn_SP}},                                               // LDAXRH <Rt>, [<Xn|SP>]
+	{0xfffffc00, 0x489ffc00, STLRH, instArgs{arg_Wt, arg_mem_Xn_SP}},                                                // STLRH <Rt>, [<Xn|SP>]
+	{0xfffffc00, 0x48dffc00, LDARH, instArgs{arg_Wt, arg_mem_Xn_SP}},                                                // LDARH <Rt>, [<Xn|SP>]
+	{0xbfe0fc00, 0x88007c00, STXR, instArgs{arg_Ws, arg_Rt_30, arg_mem_Xn_SP}},                                      // STXR <Ws>, <Rt>, [<Xn|SP>]
+	{0xbfe0fc00, 0x8800fc00, STLXR, instArgs{arg_Ws, arg_Rt_30, arg_mem_Xn_SP}},                                     // STLXR <Ws>, <Rt>, [<Xn|SP>]
+	{0xbffffc00, 0x885f7c00, LDXR, instArgs{arg_Rt_30, arg_mem_Xn_SP}},                                              // LDXR <Rt>, [<Xn|SP>]
+	{0xbffffc00, 0x885ffc00, LDAXR, instArgs{arg_Rt_30, arg_mem_Xn_SP}},                                             // LDAXR <Rt>, [<Xn|SP>]
+	{0xbffffc00, 0x889ffc00, STLR, instArgs{arg_Rt_30, arg_mem_Xn_SP}},                                              // STLR <Rt>, [<Xn|SP>]
+	{0xbffffc00, 0x88dffc00, LDAR, instArgs{arg_Rt_30, arg_mem_Xn_SP}},                                              // LDAR <Rt>, [<Xn|SP>]
+	{0xbfe08000, 0x88200000, STXP, instArgs{arg_Ws, arg_Rt_30, arg_Rt2_30, arg_mem_Xn_SP}},                          // STXP <Ws>, <Rt>, <Rt2>, [<Xn|SP>]
+	{0xbfe08000, 0x88208000, STLXP, instArgs{arg_Ws, arg_Rt_30, arg_Rt2_30, arg_mem_Xn_SP}},                         // STLXP <Ws>, <Rt>, <Rt2>, [<Xn|SP>]
+	{0xbfff8000, 0x887f0000, LDXP, instArgs{arg_Rt_30, arg_Rt2_30, arg_mem_Xn_SP}},                                  // LDXP <Rt>, <Rt2>, [<Xn|SP>]
+	{0xbfff8000, 0x887f8000, LDAXP, instArgs{arg_Rt_30, arg_Rt2_30, arg_mem_Xn_SP}},                                 // LDAXP <Rt>, <Rt2>, [<Xn|SP>]
+	{0xffe0fc00, 0x08a07c00, CASB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                         // CASB <Ws>, <Wt>, [<Xn|SP>{,#0}]
+	{0xffe0fc00, 0x08e07c00, CASAB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                        // CASAB <Ws>, <Wt>, [<Xn|SP>{,#0}]
+	{0xffe0fc00, 0x08e0fc00, CASALB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                       // CASALB <Ws>, <Wt>, [<Xn|SP>{,#0}]
+	{0xffe0fc00, 0x08a0fc00, CASLB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                        // CASLB <Ws>, <Wt>, [<Xn|SP>{,#0}]
+	{0xffe0fc00, 0x48a07c00, CASH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                         // CASH <Ws>, <Wt>, [<Xn|SP>{,#0}]
+	{0xffe0fc00, 0x48e07c00, CASAH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                        // CASAH <Ws>, <Wt>, [<Xn|SP>{,#0}]
+	{0xffe0fc00, 0x48e0fc00, CASALH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                       // CASALH <Ws>, <Wt>, [<Xn|SP>{,#0}]
+	{0xffe0fc00, 0x48a0fc00, CASLH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                        // CASLH <Ws>, <Wt>, [<Xn|SP>{,#0}]
+	{0xbfe0fc00, 0x88a07c00, CAS, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                    // CAS <Ws>, <Wt>, [<Xn|SP>{,#0}]
+	{0xbfe0fc00, 0x88e07c00, CASA, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                   // CASA <Ws>, <Wt>, [<Xn|SP>{,#0}]
+	{0xbfe0fc00, 0x88e0fc00, CASAL, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                  // CASAL <Ws>, <Wt>, [<Xn|SP>{,#0}]
+	{0xbfe0fc00, 0x88a0fc00, CASL, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                   // CASL <Ws>, <Wt>, [<Xn|SP>{,#0}]
+	{0xbfe0fc00, 0x08207c00, CASP, instArgs{arg_Rs_pair, arg_Rs1_pair, arg_Rt_pair, arg_Rt1_pair, arg_mem_Xn_SP}},   // CASP <Ws>, <W(s+1)>, <Wt>, <W(t+1)>, [<Xn|SP>{,#0}]
+	{0xbfe0fc00, 0x08607c00, CASPA, instArgs{arg_Rs_pair, arg_Rs1_pair, arg_Rt_pair, arg_Rt1_pair, arg_mem_Xn_SP}},  // CASPA <Ws>, <W(s+1)>, <Wt>, <W(t+1)>, [<Xn|SP>{,#0}]
+	{0xbfe0fc00, 0x0860fc00, CASPAL, instArgs{arg_Rs_pair, arg_Rs1_pair, arg_Rt_pair, arg_Rt1_pair, arg_mem_Xn_SP}}, // CASPAL <Ws>, <W(s+1)>, <Wt>, <W(t+1)>, [<Xn|SP>{,#0}]
+	{0xbfe0fc00, 0x0820fc00, CASPL, instArgs{arg_Rs_pair, arg_Rs1_pair, arg_Rt_pair, arg_Rt1_pair, arg_mem_Xn_SP}},  // CASPL <Ws>, <W(s+1)>, <Wt>, <W(t+1)>, [<Xn|SP>{,#0}]
+	{0xffe0fc1f, 0x3820001f, STADDB, instArgs{arg_Ws, arg_mem_Xn_SP}},                                               // STADDB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3860001f, STADDLB, instArgs{arg_Ws, arg_mem_Xn_SP}},                                              // STADDLB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3820101f, STCLRB, instArgs{arg_Ws, arg_mem_Xn_SP}},                                               // STCLRB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3860101f, STCLRLB, instArgs{arg_Ws, arg_mem_Xn_SP}},                                              // STCLRLB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3820201f, STEORB, instArgs{arg_Ws, arg_mem_Xn_SP}},                                               // STEORB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3860201f, STEORLB, instArgs{arg_Ws, arg_mem_Xn_SP}},                                              // STEORLB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3820301f, STSETB, instArgs{arg_Ws, arg_mem_Xn_SP}},                                               // STSETB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3860301f, STSETLB, instArgs{arg_Ws, arg_mem_Xn_SP}},                                              // STSETLB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3820401f, STSMAXB, instArgs{arg_Ws, arg_mem_Xn_SP}},                                              // STSMAXB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3860401f, STSMAXLB, instArgs{arg_Ws, arg_mem_Xn_SP}},                                             // STSMAXLB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3820501f, STSMINB, instArgs{arg_Ws, arg_mem_Xn_SP}},                                              // STSMINB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3860501f, STSMINLB, instArgs{arg_Ws, arg_mem_Xn_SP}},                                             // STSMINLB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3820601f, STUMAXB, instArgs{arg_Ws, arg_mem_Xn_SP}},                                              // STUMAXB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3860601f, STUMAXLB, instArgs{arg_Ws, arg_mem_Xn_SP}},                                             // STUMAXLB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3820701f, STUMINB, instArgs{arg_Ws, arg_mem_Xn_SP}},                                              // STUMINB <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x3860701f, STUMINLB, instArgs{arg_Ws, arg_mem_Xn_SP}},                                             // STUMINLB <Ws>, [<Xn|SP>]
+	{0xffe0fc00, 0x38200000, LDADDB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                       // LDADDB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38a00000, LDADDAB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDADDAB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38e00000, LDADDALB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDADDALB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38600000, LDADDLB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDADDLB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38201000, LDCLRB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                       // LDCLRB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38a01000, LDCLRAB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDCLRAB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38e01000, LDCLRALB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDCLRALB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38601000, LDCLRLB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDCLRLB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38202000, LDEORB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                       // LDEORB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38a02000, LDEORAB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDEORAB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38e02000, LDEORALB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDEORALB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38602000, LDEORLB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDEORLB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38203000, LDSETB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                       // LDSETB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38a03000, LDSETAB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDSETAB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38e03000, LDSETALB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDSETALB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38603000, LDSETLB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDSETLB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38204000, LDSMAXB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDSMAXB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38a04000, LDSMAXAB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDSMAXAB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38e04000, LDSMAXALB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                    // LDSMAXALB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38604000, LDSMAXLB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDSMAXLB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38205000, LDSMINB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDSMINB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38a05000, LDSMINAB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDSMINAB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38e05000, LDSMINALB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                    // LDSMINALB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38605000, LDSMINLB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDSMINLB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38206000, LDUMAXB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDUMAXB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38a06000, LDUMAXAB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDUMAXAB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38e06000, LDUMAXALB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                    // LDUMAXALB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38606000, LDUMAXLB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDUMAXLB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38207000, LDUMINB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDUMINB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38a07000, LDUMINAB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDUMINAB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38e07000, LDUMINALB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                    // LDUMINALB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38607000, LDUMINLB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDUMINLB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38208000, SWPB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                         // SWPB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38a08000, SWPAB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                        // SWPAB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38e08000, SWPALB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                       // SWPALB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x38608000, SWPLB, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                        // SWPLB <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7820001f, STADDH, instArgs{arg_Ws, arg_mem_Xn_SP}},                                               // STADDH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7860001f, STADDLH, instArgs{arg_Ws, arg_mem_Xn_SP}},                                              // STADDLH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7820101f, STCLRH, instArgs{arg_Ws, arg_mem_Xn_SP}},                                               // STCLRH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7860101f, STCLRLH, instArgs{arg_Ws, arg_mem_Xn_SP}},                                              // STCLRLH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7820201f, STEORH, instArgs{arg_Ws, arg_mem_Xn_SP}},                                               // STEORH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7860201f, STEORLH, instArgs{arg_Ws, arg_mem_Xn_SP}},                                              // STEORLH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7820301f, STSETH, instArgs{arg_Ws, arg_mem_Xn_SP}},                                               // STSETH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7860301f, STSETLH, instArgs{arg_Ws, arg_mem_Xn_SP}},                                              // STSETLH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7820401f, STSMAXH, instArgs{arg_Ws, arg_mem_Xn_SP}},                                              // STSMAXH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7860401f, STSMAXLH, instArgs{arg_Ws, arg_mem_Xn_SP}},                                             // STSMAXLH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7820501f, STSMINH, instArgs{arg_Ws, arg_mem_Xn_SP}},                                              // STSMINH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7860501f, STSMINLH, instArgs{arg_Ws, arg_mem_Xn_SP}},                                             // STSMINLH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7820601f, STUMAXH, instArgs{arg_Ws, arg_mem_Xn_SP}},                                              // STUMAXH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7860601f, STUMAXLH, instArgs{arg_Ws, arg_mem_Xn_SP}},                                             // STUMAXLH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7820701f, STUMINH, instArgs{arg_Ws, arg_mem_Xn_SP}},                                              // STUMINH <Ws>, [<Xn|SP>]
+	{0xffe0fc1f, 0x7860701f, STUMINLH, instArgs{arg_Ws, arg_mem_Xn_SP}},                                             // STUMINLH <Ws>, [<Xn|SP>]
+	{0xffe0fc00, 0x78200000, LDADDH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                       // LDADDH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78a00000, LDADDAH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDADDAH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78e00000, LDADDALH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDADDALH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78600000, LDADDLH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDADDLH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78201000, LDCLRH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                       // LDCLRH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78a01000, LDCLRAH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDCLRAH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78e01000, LDCLRALH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDCLRALH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78601000, LDCLRLH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDCLRLH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78202000, LDEORH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                       // LDEORH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78a02000, LDEORAH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDEORAH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78e02000, LDEORALH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDEORALH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78602000, LDEORLH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDEORLH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78203000, LDSETH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                       // LDSETH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78a03000, LDSETAH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDSETAH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78e03000, LDSETALH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDSETALH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78603000, LDSETLH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDSETLH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78204000, LDSMAXH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDSMAXH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78a04000, LDSMAXAH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDSMAXAH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78e04000, LDSMAXALH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                    // LDSMAXALH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78604000, LDSMAXLH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDSMAXLH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78205000, LDSMINH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDSMINH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78a05000, LDSMINAH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDSMINAH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78e05000, LDSMINALH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                    // LDSMINALH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78605000, LDSMINLH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDSMINLH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78206000, LDUMAXH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDUMAXH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78a06000, LDUMAXAH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDUMAXAH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78e06000, LDUMAXALH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                    // LDUMAXALH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78606000, LDUMAXLH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDUMAXLH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78207000, LDUMINH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                      // LDUMINH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78a07000, LDUMINAH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDUMINAH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78e07000, LDUMINALH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                    // LDUMINALH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78607000, LDUMINLH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                     // LDUMINLH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78208000, SWPH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                         // SWPH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78a08000, SWPAH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                        // SWPAH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78e08000, SWPALH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                       // SWPALH <Ws>, <Wt>, [<Xn|SP>]
+	{0xffe0fc00, 0x78608000, SWPLH, instArgs{arg_Ws, arg_Wt, arg_mem_Xn_SP}},                                        // SWPLH <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc1f, 0xb820001f, STADD, instArgs{arg_Rs_30, arg_mem_Xn_SP}},                                             // STADD <Ws>, [<Xn|SP>]
+	{0xbfe0fc1f, 0xb860001f, STADDL, instArgs{arg_Rs_30, arg_mem_Xn_SP}},                                            // STADDL <Ws>, [<Xn|SP>]
+	{0xbfe0fc1f, 0xb820101f, STCLR, instArgs{arg_Rs_30, arg_mem_Xn_SP}},                                             // STCLR <Ws>, [<Xn|SP>]
+	{0xbfe0fc1f, 0xb860101f, STCLRL, instArgs{arg_Rs_30, arg_mem_Xn_SP}},                                            // STCLRL <Ws>, [<Xn|SP>]
+	{0xbfe0fc1f, 0xb820201f, STEOR, instArgs{arg_Rs_30, arg_mem_Xn_SP}},                                             // STEOR <Ws>, [<Xn|SP>]
+	{0xbfe0fc1f, 0xb860201f, STEORL, instArgs{arg_Rs_30, arg_mem_Xn_SP}},                                            // STEORL <Ws>, [<Xn|SP>]
+	{0xbfe0fc1f, 0xb820301f, STSET, instArgs{arg_Rs_30, arg_mem_Xn_SP}},                                             // STSET <Ws>, [<Xn|SP>]
+	{0xbfe0fc1f, 0xb860301f, STSETL, instArgs{arg_Rs_30, arg_mem_Xn_SP}},                                            // STSETL <Ws>, [<Xn|SP>]
+	{0xbfe0fc1f, 0xb820401f, STSMAX, instArgs{arg_Rs_30, arg_mem_Xn_SP}},                                            // STSMAX <Ws>, [<Xn|SP>]
+	{0xbfe0fc1f, 0xb860401f, STSMAXL, instArgs{arg_Rs_30, arg_mem_Xn_SP}},                                           // STSMAXL <Ws>, [<Xn|SP>]
+	{0xbfe0fc1f, 0xb820501f, STSMIN, instArgs{arg_Rs_30, arg_mem_Xn_SP}},                                            // STSMIN <Ws>, [<Xn|SP>]
+	{0xbfe0fc1f, 0xb860501f, STSMINL, instArgs{arg_Rs_30, arg_mem_Xn_SP}},                                           // STSMINL <Ws>, [<Xn|SP>]
+	{0xbfe0fc1f, 0xb820601f, STUMAX, instArgs{arg_Rs_30, arg_mem_Xn_SP}},                                            // STUMAX <Ws>, [<Xn|SP>]
+	{0xbfe0fc1f, 0xb860601f, STUMAXL, instArgs{arg_Rs_30, arg_mem_Xn_SP}},                                           // STUMAXL <Ws>, [<Xn|SP>]
+	{0xbfe0fc1f, 0xb820701f, STUMIN, instArgs{arg_Rs_30, arg_mem_Xn_SP}},                                            // STUMIN <Ws>, [<Xn|SP>]
+	{0xbfe0fc1f, 0xb860701f, STUMINL, instArgs{arg_Rs_30, arg_mem_Xn_SP}},                                           // STUMINL <Ws>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8200000, LDADD, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                  // LDADD <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8a00000, LDADDA, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                 // LDADDA <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8e00000, LDADDAL, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                // LDADDAL <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8600000, LDADDL, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                 // LDADDL <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8201000, LDCLR, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                  // LDCLR <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8a01000, LDCLRA, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                 // LDCLRA <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8e01000, LDCLRAL, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                // LDCLRAL <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8601000, LDCLRL, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                 // LDCLRL <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8202000, LDEOR, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                  // LDEOR <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8a02000, LDEORA, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                 // LDEORA <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8e02000, LDEORAL, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                // LDEORAL <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8602000, LDEORL, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                 // LDEORL <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8203000, LDSET, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                  // LDSET <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8a03000, LDSETA, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                 // LDSETA <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8e03000, LDSETAL, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                // LDSETAL <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8603000, LDSETL, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                 // LDSETL <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8204000, LDSMAX, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                 // LDSMAX <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8a04000, LDSMAXA, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                // LDSMAXA <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8e04000, LDSMAXAL, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                               // LDSMAXAL <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8604000, LDSMAXL, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                // LDSMAXL <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8205000, LDSMIN, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                 // LDSMIN <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8a05000, LDSMINA, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                // LDSMINA <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8e05000, LDSMINAL, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                               // LDSMINAL <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8605000, LDSMINL, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                // LDSMINL <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8206000, LDUMAX, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                 // LDUMAX <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8a06000, LDUMAXA, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                // LDUMAXA <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8e06000, LDUMAXAL, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                               // LDUMAXAL <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8606000, LDUMAXL, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                // LDUMAXL <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8207000, LDUMIN, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                 // LDUMIN <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8a07000, LDUMINA, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                // LDUMINA <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8e07000, LDUMINAL, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                               // LDUMINAL <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8607000, LDUMINL, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                // LDUMINL <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8208000, SWP, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                    // SWP <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8a08000, SWPA, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                   // SWPA <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8e08000, SWPAL, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                  // SWPAL <Ws>, <Wt>, [<Xn|SP>]
+	{0xbfe0fc00, 0xb8608000, SWPL, instArgs{arg_Rs_30, arg_Rt_30, arg_mem_Xn_SP}},                                   // SWPL <Ws>, <Wt>, [<Xn|SP>]
+	{0xbf000000, 0x18000000, LDR, instArgs{arg_Rt_30, arg_label19}},                                                 // LDR <Rt>, <label>
+	{0xff000000, 0x98000000, LDRSW, instArgs{arg_Xt, arg_label19}},                                                  // LDRSW <Xt>, <label>
+	{0xff000000, 0xd8000000, PRFM, instArgs{arg_prfop, arg_label19}},                                                // PRFM <prfop>, <label>
+	{0x7fc00000, 0x28000000, STNP, instArgs{arg_Rt_31, arg_Rt2_31, arg_mem_simm7_offset}},                           // STNP <Rt>, <Rt2>, [<Xn|SP>{, #<imm>}]
+	{0x7fc00000, 0x28400000, LDNP, instArgs{arg_Rt_31, arg_Rt2_31, arg_mem_simm7_offset}},                           // LDNP <Rt>, <Rt2>, [<Xn|SP>{, #<imm>}]
+	{0x7fc00000, 0x28800000, STP, instArgs{arg_Rt_31, arg_Rt2_31, arg_mem_simm7_postindex}},                         // STP <Rt>, <Rt2>, [<Xn|SP>], #<imm>
+	{0x7fc00000, 0x29000000, STP, instArgs{arg_Rt_31, arg_Rt2_31, arg_mem_simm7_offset}},                            // STP <Rt>, <Rt2>, [<Xn|SP>{, #<imm>}]
+	{0x7fc00000, 0x29800000, STP, instArgs{arg_Rt_31, arg_Rt2_31, arg_mem_simm7_preindex}},                          // STP <Rt>, <Rt2>, [<Xn|SP>, #<imm>]!
+	{0x7fc00000, 0x28c00000, LDP, instArgs{arg_Rt_31, arg_Rt2_31, arg_mem_simm7_postindex}},                         // LDP <Rt>, <Rt2>, [<Xn|SP>], #<imm>
+	{0x7fc00000, 0x29400000, LDP, instArgs{arg_Rt_31, arg_Rt2_31, arg_mem_simm7_offset}},                            // LDP <Rt>, <Rt2>, [<Xn|SP>{, #<imm>}]
+	{0x7fc00000, 0x29c00000, LDP, instArgs{arg_Rt_31, arg_Rt2_31, arg_mem_simm7_preindex}},                          // LDP <Rt>, <Rt2>, [<Xn|SP>, #<imm>]!
+	{0xffc00000, 0x68c00000, LDPSW, instArgs{arg_Xt, arg_Xt2, arg_mem_simm7_postindex}},                             // LDPSW <Rt>, <Rt2>, [<Xn|SP>], #<imm>
+	{0xffc00000, 0x69400000, LDPSW, instArgs{arg_Xt, arg_Xt2, arg_mem_simm7_offset}},                                // LDPSW <Rt>, <Rt2>, [<Xn|SP>{, #<imm>}]
+	{0xffc00000, 0x69c00000, LDPSW, instArgs{arg_Xt, arg_Xt2, arg_mem_simm7_preindex}},                              // LDPSW <Rt>, <Rt2>, [<Xn|SP>, #<imm>]!
+	{0xffe00c00, 0x38000000, STURB, instArgs{arg_Wt, arg_mem_simm9_offset}},                                         // STURB <Wt>, [<Xn|SP>{, #<simm>}]
+	{0xffe00c00, 0x38000400, STRB, instArgs{arg_Wt, arg_mem_simm9_postindex}},                                       // STRB <Wt>, [<Xn|SP>], #<simm>
+	{0xffe00c00, 0x38000c00, STRB, instArgs{arg_Wt, arg_mem_simm9_preindex}},                                        // STRB <Wt>, [<Xn|SP>, #<simm>]!
+	{0xffc00000, 0x39000000, STRB, instArgs{arg_Wt, arg_mem_uimm12}},                                                // STRB <Wt>, [<Xn|SP>{, #<pimm>}]
+	{0xffe00c00, 0x38200800, STRB, instArgs{arg_Wt, arg_mem_extend}},                                                // STRB <Wt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]
+	{0xffe00c00, 0x38400000, LDURB, instArgs{arg_Wt, arg_mem_simm9_offset}},                                         // LDURB <Wt>, [<Xn|SP>{, #<simm>}]
+	{0xffe00c00, 0x38400400, LDRB, instArgs{arg_Wt, arg_mem_simm9_postindex}},                                       // LDRB <Wt>, [<Xn|SP>], #<simm>
+	{0xffe00c00, 0x38400c00, LDRB, instArgs{arg_Wt, arg_mem_simm9_preindex}},                                        // LDRB <Wt>, [<Xn|SP>, #<simm>]!
+	{0xffc00000, 0x39400000, LDRB, instArgs{arg_Wt, arg_mem_uimm12}},                                                // LDRB <Wt>, [<Xn|SP>{, #<pimm>}]
+	{0xffe00c00, 0x38600800, LDRB, instArgs{arg_Wt, arg_mem_extend}},                                                // LDRB <Wt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]
+	{0xffe00c00, 0x38800000, LDURSB, instArgs{arg_Xt, arg_mem_simm9_offset}},                                        // LDURSB <Xt>, [<Xn|SP>{, #<simm>}]
+	{0xffe00c00, 0x38800400, LDRSB, instArgs{arg_Xt, arg_mem_simm9_postindex}},                                      // LDRSB <Xt>, [<Xn|SP>], #<simm>
+	{0xffe00c00, 0x38800c00, LDRSB, instArgs{arg_Xt, arg_mem_simm9_preindex}},                                       // LDRSB <Xt>, [<Xn|SP>, #<simm>]!
+	{0xffc00000, 0x39800000, LDRSB, instArgs{arg_Xt, arg_mem_uimm12}},                                               // LDRSB <Xt>, [<Xn|SP>{, #<pimm>}]
+	{0xffe00c00, 0x38a00800, LDRSB, instArgs{arg_Xt, arg_mem_extend}},                                               // LDRSB <Xt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]
+	{0xffe00c00, 0x38c00000, LDURSB, instArgs{arg_Wt, arg_mem_simm9_offset}},                                        // LDURSB <Wt>, [<Xn|SP>{, #<simm>}]
+	{0xffe00c00, 0x38c00400, LDRSB, instArgs{arg_Wt, arg_mem_simm9_postindex}},                                      // LDRSB <Wt>, [<Xn|SP>], #<simm>
+	{0xffe00c00, 0x38c00c00, LDRSB, instArgs{arg_Wt, arg_mem_simm9_preindex}},                                       // LDRSB <Wt>, [<Xn|SP>, #<simm>]!
+	{0xffc00000, 0x39c00000, LDRSB, instArgs{arg_Wt, arg_mem_uimm12}},                                               // LDRSB <Wt>, [<Xn|SP>{, #<pimm>}]
+	{0xffe00c00, 0x38e00800, LDRSB, instArgs{arg_Wt, arg_mem_extend}},                                               // LDRSB <Wt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]
+	{0xffe00c00, 0x78000000, STURH, instArgs{arg_Wt, arg_mem_simm9_offset}},                                         // STURH <Wt>, [<Xn|SP>{, #<simm>}]
+	{0xffe00c00, 0x78000400, STRH, instArgs{arg_Wt, arg_mem_simm9_postindex}},                                       // STRH <Wt>, [<Xn|SP>], #<simm>
+	{0xffe00c00, 0x78000c00, STRH, instArgs{arg_Wt, arg_mem_simm9_preindex}},                                        // STRH <Wt>, [<Xn|SP>, #<simm>]!
+	{0xffc00000, 0x79000000, STRH, instArgs{arg_Wt, arg_mem_uimm12}},                                                // STRH <Wt>, [<Xn|SP>{, #<pimm>}]
+	{0xffe00c00, 0x78200800, STRH, instArgs{arg_Wt, arg_mem_extend}},                                                // STRH <Wt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]
+	{0xffe00c00, 0x78400000, LDURH, instArgs{arg_Wt, arg_mem_simm9_offset}},                                         // LDURH <Wt>, [<Xn|SP>{, #<simm>}]
+	{0xffe00c00, 0x78400400, LDRH, instArgs{arg_Wt, arg_mem_simm9_postindex}},                                       // LDRH <Wt>, [<Xn|SP>], #<simm>
+	{0xffe00c00, 0x78400c00, LDRH, instArgs{arg_Wt, arg_mem_simm9_preindex}},                                        // LDRH <Wt>, [<Xn|SP>, #<simm>]!
+	{0xffc00000, 0x79400000, LDRH, instArgs{arg_Wt, arg_mem_uimm12}},                                                // LDRH <Wt>, [<Xn|SP>{, #<pimm>}]
+	{0xffe00c00, 0x78600800, LDRH, instArgs{arg_Wt, arg_mem_extend}},                                                // LDRH <Wt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]
+	{0xffe00c00, 0x78800000, LDURSH, instArgs{arg_Xt, arg_mem_simm9_offset}},                                        // LDURSH <Xt>, [<Xn|SP>{, #<simm>}]
+	{0xffe00c00, 0x78800400, LDRSH, instArgs{arg_Xt, arg_mem_simm9_postindex}},                                      // LDRSH <Xt>, [<Xn|SP>], #<simm>
+	{0xffe00c00, 0x78800c00, LDRSH, instArgs{arg_Xt, arg_mem_simm9_preindex}},                                       // LDRSH <Xt>, [<Xn|SP>, #<simm>]!
+	{0xffc00000, 0x79800000, LDRSH, instArgs{arg_Xt, arg_mem_uimm12}},                                               // LDRSH <Xt>, [<Xn|SP>{, #<pimm>}]
+	{0xffe00c00, 0x78a00800, LDRSH, instArgs{arg_Xt, arg_mem_extend}},                                               // LDRSH <Xt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]
+	{0xffe00c00, 0x78c00000, LDURSH, instArgs{arg_Wt, arg_mem_simm9_offset}},                                        // LDURSH <Wt>, [<Xn|SP>{, #<simm>}]
+	{0xffe00c00, 0x78c00400, LDRSH, instArgs{arg_Wt, arg_mem_simm9_postindex}},                                      // LDRSH <Wt>, [<Xn|SP>], #<simm>
+	{0xffe00c00, 0x78c00c00, LDRSH, instArgs{arg_Wt, arg_mem_simm9_preindex}},                                       // LDRSH <Wt>, [<Xn|SP>, #<simm>]!
+	{0xffc00000, 0x79c00000, LDRSH, instArgs{arg_Wt, arg_mem_uimm12}},                                               // LDRSH <Wt>, [<Xn|SP>{, #<pimm>}]
+	{0xffe00c00, 0x78e00800, LDRSH, instArgs{arg_Wt, arg_mem_extend}},                                               // LDRSH <Wt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]
+	{0xffe00c00, 0xb8000000, STUR, instArgs{arg_Wt, arg_mem_simm9_offset}},                                          // STUR <Wt>, [<Xn|SP>{, #<simm>}]
+	{0xffe00c00, 0xb8000400, STR, instArgs{arg_Wt, arg_mem_simm9_postindex}},                                        // STR <Wt>, [<Xn|SP>], #<simm>
+	{0xffe00c00, 0xb8000c00, STR, instArgs{arg_Wt, arg_mem_simm9_preindex}},                                         // STR <Wt>, [<Xn|SP>, #<simm>]!
+	{0xffc00000, 0xb9000000, STR, instArgs{arg_Wt, arg_mem_uimm12}},                                                 // STR <Wt>, [<Xn|SP>{, #<pimm>}]
+	{0xffe00c00, 0xb8200800, STR, instArgs{arg_Wt, arg_mem_extend}},                                                 // STR <Wt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]
+	{0xffe00c00, 0xb8400000, LDUR, instArgs{arg_Wt, arg_mem_simm9_offset}},                                          // LDUR <Wt>, [<Xn|SP>{, #<simm>}]
+	{0xffe00c00, 0xb8400400, LDR, instArgs{arg_Wt, arg_mem_simm9_postindex}},                                        // LDR <Wt>, [<Xn|SP>], #<simm>
+	{0xffe00c00, 0xb8400c00, LDR, instArgs{arg_Wt, arg_mem_simm9_preindex}},                                         // LDR <Wt>, [<Xn|SP>, #<simm>]!
+	{0xffc00000, 0xb9400000, LDR, instArgs{arg_Wt, arg_mem_uimm12}},                                                 // LDR <Wt>, [<Xn|SP>{, #<pimm>}]
+	{0xffe00c00, 0xb8600800, LDR, instArgs{arg_Wt, arg_mem_extend}},                                                 // LDR <Wt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]
+	{0xffe00c00, 0xb8800000, LDURSW, instArgs{arg_Xt, arg_mem_simm9_offset}},                                        // LDURSW <Xt>, [<Xn|SP>{, #<simm>}]
+	{0xffe00c00, 0xb8800400, LDRSW, instArgs{arg_Xt, arg_mem_simm9_postindex}},                                      // LDRSW <Xt>, [<Xn|SP>], #<simm>
+	{0xffe00c00, 0xb8800c00, LDRSW, instArgs{arg_Xt, arg_mem_simm9_preindex}},                                       // LDRSW <Xt>, [<Xn|SP>, #<simm>]!
+	{0xffc00000, 0xb9800000, LDRSW, instArgs{arg_Xt, arg_mem_uimm12}},                                               // LDRSW <Xt>, [<Xn|SP>{, #<pimm>}]
+	{0xffe00c00, 0xb8a00800, LDRSW, instArgs{arg_Xt, arg_mem_extend}},                                               // LDRSW <Xt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]
+	{0xffe00c00, 0xf8000000, STUR, instArgs{arg_Xt, arg_mem_simm9_offset}},                                          // STUR <Xt>, [<Xn|SP>{, #<simm>}]
+	{0xffe00c00, 0xf8000400, STR, instArgs{arg_Xt, arg_mem_simm9_postindex}},                                        // STR <Xt>, [<Xn|SP>], #<simm>
+	{0xffe00c00, 0xf8000c00, STR, instArgs{arg_Xt, arg_mem_simm9_preindex}},                                         // STR <Xt>, [<Xn|SP>, #<simm>]!
+	{0xffc00000, 0xf9000000, STR, instArgs{arg_Xt, arg_mem_uimm12}},                                                 // STR <Xt>, [<Xn|SP>{, #<pimm>}]
+	{0xffe00c00, 0xf8200800, STR, instArgs{arg_Xt, arg_mem_extend}},                                                 // STR <Xt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]
+	{0xffe00c00, 0xf8400000, LDUR, instArgs{arg_Xt, arg_mem_simm9_offset}},                                          // LDUR <Xt>, [<Xn|SP>{, #<simm>}]
+	{0xffe00c00, 0xf8400400, LDR, instArgs{arg_Xt, arg_mem_simm9_postindex}},                                        // LDR <Xt>, [<Xn|SP>], #<simm>
+	{0xffe00c00, 0xf8400c00, LDR, instArgs{arg_Xt, arg_mem_simm9_preindex}},                                         // LDR <Xt>, [<Xn|SP>, #<simm>]!
+	{0xffc00000, 0xf9400000, LDR, instArgs{arg_Xt, arg_mem_uimm12}},                                                 // LDR <Xt>, [<Xn|SP>{, #<pimm>}]
+	{0xffe00c00, 0xf8600800, LDR, instArgs{arg_Xt, arg_mem_extend}},                                                 // LDR <Xt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]
+	{0xffc00000, 0xf9800000, PRFM, instArgs{arg_prfop, arg_mem_uimm12}},                                             // PRFM <prfop>, [<Xn|SP>{, #<pimm>}]
+	{0xffe00c00, 0xf8a00800, PRFM, instArgs{arg_prfop, arg_mem_extend}},                                             // PRFM <prfop>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]
+	{0x3f000000, 0x1c000000, LDR, instArgs{arg_Ft_lit, arg_label19}},                                                // LDR <St|Dt|Qt>, <label>
+	{0x3fc00000, 0x2c000000, STNP, instArgs{arg_Ft_pair, arg_Ft2_pair, arg_mem_simm7_offset}},                       // STNP <Ft>, <Ft2>, [<Xn|SP>{, #<imm>}]
+	{0x3fc00000, 0x2c400000, LDNP, instArgs{arg_Ft_pair, arg_Ft2_pair, arg_mem_simm7_offset}},                       // LDNP <Ft>, <Ft2>, [<Xn|SP>{, #<imm>}]
+	{0x3fc00000, 0x2c800000, STP, instArgs{arg_Ft_pair, arg_Ft2_pair, arg_mem_simm7_postindex}},                     // STP <Ft>, <Ft2>, [<Xn|SP>], #<imm>
+	{0x3fc00000, 0x2d000000, STP, instArgs{arg_Ft_pair, arg_Ft2_pair, arg_mem_simm7_offset}},                        // STP <Ft>, <Ft2>, [<Xn|SP>{, #<imm>}]
+	{0x3fc00000, 0x2d800000, STP, instArgs{arg_Ft_pair, arg_Ft2_pair, arg_mem_simm7_preindex}},                      // STP <Ft>, <Ft2>, [<Xn|SP>, #<imm>]!
+	{0x3fc00000, 0x2cc00000, LDP, instArgs{arg_Ft_pair, arg_Ft2_pair, arg_mem_simm7_postindex}},                     // LDP <Ft>, <Ft2>, [<Xn|SP>], #<imm>
+	{0x3fc00000, 0x2d400000, LDP, instArgs{arg_Ft_pair, arg_Ft2_pair, arg_mem_simm7_offset}},                        // LDP <Ft>, <Ft2>, [<Xn|SP>{, #<imm>}]
+	{0x3fc00000, 0x2dc00000, LDP, instArgs{arg_Ft_pair, arg_Ft2_pair, arg_mem_simm7_preindex}},                      // LDP <Ft>, <Ft2>, [<Xn|SP>, #<imm>]!
+	{0x3f600c00, 0x3c000000, STUR, instArgs{arg_Ft_ldst, arg_mem_simm9_offset}},                                     // STUR <Ft>, [<Xn|SP>{, #<simm>}]
+	{0x3f600c00, 0x3c000400, STR, instArgs{arg_Ft_ldst, arg_mem_simm9_postindex}},                                   // STR <Ft>, [<Xn|SP>], #<simm>
+	{0x3f600c00, 0x3c000c00, STR, instArgs{arg_Ft_ldst, arg_mem_simm9_preindex}},                                    // STR <Ft>, [<Xn|SP>, #<simm>]!
+	{0x3f400000, 0x3d000000, STR, instArgs{arg_Ft_ldst, arg_mem_uimm12}},                                            // STR <Ft>, [<Xn|SP>{, #<pimm>}]
+	{0x3f600c00, 0x3c200800, STR, instArgs{arg_Ft_ldst, arg_mem_extend}},                                            // STR <Ft>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]
+	{0x3f600c00, 0x3c400000, LDUR, instArgs{arg_Ft_ldst, arg_mem_simm9_offset}},                                     // LDUR <Ft>, [<Xn|SP>{, #<simm>}]
+	{0x3f600c00, 0x3c400400, LDR, instArgs{arg_Ft_ldst, arg_mem_simm9_postindex}},                                   // LDR <Ft>, [<Xn|SP>], #<simm>
+	{0x3f600c00, 0x3c400c00, LDR, instArgs{arg_Ft_ldst, arg_mem_simm9_preindex}},                                    // LDR <Ft>, [<Xn|SP>, #<simm>]!
+	{0x3f400000, 0x3d400000, LDR, instArgs{arg_Ft_ldst, arg_mem_uimm12}},                                            // LDR <Ft>, [<Xn|SP>{, #<pimm>}]
+	{0x3f600c00, 0x3c600800, LDR, instArgs{arg_Ft_ldst, arg_mem_extend}},                                            // LDR <Ft>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]
+	{0xbffff000, 0x0c407000, LD1, instArgs{arg_Vt_list, arg_mem_Xn_SP}},                                             // LD1 { <Vt>.<T>, ... }, [<Xn|SP>]
+	{0xbffff000, 0x0cdf7000, LD1, instArgs{arg_Vt_list, arg_mem_post_list}},                                         // LD1 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>
+	{0xbfe0f000, 0x0cc07000, LD1, instArgs{arg_Vt_list, arg_mem_post_Xm}},                                           // LD1 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>
+	{0xbffff000, 0x0c007000, ST1, instArgs{arg_Vt_list, arg_mem_Xn_SP}},                                             // ST1 { <Vt>.<T>, ... }, [<Xn|SP>]
+	{0xbffff000, 0x0c9f7000, ST1, instArgs{arg_Vt_list, arg_mem_post_list}},                                         // ST1 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>
+	{0xbfe0f000, 0x0c807000, ST1, instArgs{arg_Vt_list, arg_mem_post_Xm}},                                           // ST1 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>
+	{0xbffff000, 0x0c40a000, LD1, instArgs{arg_Vt_list, arg_mem_Xn_SP}},                                             // LD1 { <Vt>.<T>, ... }, [<Xn|SP>]
+	{0xbffff000, 0x0cdfa000, LD1, instArgs{arg_Vt_list, arg_mem_post_list}},                                         // LD1 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>
+	{0xbfe0f000, 0x0cc0a000, LD1, instArgs{arg_Vt_list, arg_mem_post_Xm}},                                           // LD1 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>
+	{0xbffff000, 0x0c00a000, ST1, instArgs{arg_Vt_list, arg_mem_Xn_SP}},                                             // ST1 { <Vt>.<T>, ... }, [<Xn|SP>]
+	{0xbffff000, 0x0c9fa000, ST1, instArgs{arg_Vt_list, arg_mem_post_list}},                                         // ST1 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>
+	{0xbfe0f000, 0x0c80a000, ST1, instArgs{arg_Vt_list, arg_mem_post_Xm}},                                           // ST1 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>
+	{0xbffff000, 0x0c406000, LD1, instArgs{arg_Vt_list, arg_mem_Xn_SP}},                                             // LD1 { <Vt>.<T>, ... }, [<Xn|SP>]
+	{0xbffff000, 0x0cdf6000, LD1, instArgs{arg_Vt_list, arg_mem_post_list}},                                         // LD1 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>
+	{0xbfe0f000, 0x0cc06000, LD1, instArgs{arg_Vt_list, arg_mem_post_Xm}},                                           // LD1 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>
+	{0xbffff000, 0x0c006000, ST1, instArgs{arg_Vt_list, arg_mem_Xn_SP}},                                             // ST1 { <Vt>.<T>, ... }, [<Xn|SP>]
+	{0xbffff000, 0x0c9f6000, ST1, instArgs{arg_Vt_list, arg_mem_post_list}},                                         // ST1 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>
+	{0xbfe0f000, 0x0c806000, ST1, instArgs{arg_Vt_list, arg_mem_post_Xm}},                                           // ST1 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>
+	{0xbffff000, 0x0c402000, LD1, instArgs{arg_Vt_list, arg_mem_Xn_SP}},                                             // LD1 { <Vt>.<T>, ... }, [<Xn|SP>]
+	{0xbffff000, 0x0cdf2000, LD1, instArgs{arg_Vt_list, arg_mem_post_list}},                                         // LD1 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>
+	{0xbfe0f000, 0x0cc02000, LD1, instArgs{arg_Vt_list, arg_mem_post_Xm}},                                           // LD1 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>
+	{0xbffff000, 0x0c002000, ST1, instArgs{arg_Vt_list, arg_mem_Xn_SP}},                                             // ST1 { <Vt>.<T>, ... }, [<Xn|SP>]
+	{0xbffff000, 0x0c9f2000, ST1, instArgs{arg_Vt_list, arg_mem_post_list}},                                         // ST1 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>
+	{0xbfe0f000, 0x0c802000, ST1, instArgs{arg_Vt_list, arg_mem_post_Xm}},                                           // ST1 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>
+	{0xbffff000, 0x0c408000, LD2, instArgs{arg_Vt_list, arg_mem_Xn_SP}},                                             // LD2 { <Vt>.<T>, ... }, [<Xn|SP>]
+	{0xbffff000, 0x0cdf8000, LD2, instArgs{arg_Vt_list, arg_mem_post_list}},                                         // LD2 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>
+	{0xbfe0f000, 0x0cc08000, LD2, instArgs{arg_Vt_list, arg_mem_post_Xm}},                                           // LD2 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>
+	{0xbffff000, 0x0c008000, ST2, instArgs{arg_Vt_list, arg_mem_Xn_SP}},                                             // ST2 { <Vt>.<T>, ... }, [<Xn|SP>]
+	{0xbffff000, 0x0c9f8000, ST2, instArgs{arg_Vt_list, arg_mem_post_list}},                                         // ST2 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>
+	{0xbfe0f000, 0x0c808000, ST2, instArgs{arg_Vt_list, arg_mem_post_Xm}},                                           // ST2 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>
+	{0xbffff000, 0x0c404000, LD3, instArgs{arg_Vt_list, arg_mem_Xn_SP}},                                             // LD3 { <Vt>.<T>, ... }, [<Xn|SP>]
+	{0xbffff000, 0x0cdf4000, LD3, instArgs{arg_Vt_list, arg_mem_post_list}},                                         // LD3 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>
+	{0xbfe0f000, 0x0cc04000, LD3, instArgs{arg_Vt_list, arg_mem_post_Xm}},                                           // LD3 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>
+	{0xbffff000, 0x0c004000, ST3, instArgs{arg_Vt_list, arg_mem_Xn_SP}},                                             // ST3 { <Vt>.<T>, ... }, [<Xn|SP>]
+	{0xbffff000, 0x0c9f4000, ST3, instArgs{arg_Vt_list, arg_mem_post_list}},                                         // ST3 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>
+	{0xbfe0f000, 0x0c804000, ST3, instArgs{arg_Vt_list, arg_mem_post_Xm}},                                           // ST3 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>
+	{0xbffff000, 0x0c400000, LD4, instArgs{arg_Vt_list, arg_mem_Xn_SP}},                                             // LD4 { <Vt>.<T>, ... }, [<Xn|SP>]
+	{0xbffff000, 0x0cdf0000, LD4, instArgs{arg_Vt_list, arg_mem_post_list}},                                         // LD4 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>
+	{0xbfe0f000, 0x0cc00000, LD4, instArgs{arg_Vt_list, arg_mem_post_Xm}},                                           // LD4 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>
+	{0xbffff000, 0x0c000000, ST4, instArgs{arg_Vt_list, arg_mem_Xn_SP}},                                             // ST4 { <Vt>.<T>, ... }, [<Xn|SP>]
+	{0xbffff000, 0x0c9f0000, ST4, instArgs{arg_Vt_list, arg_mem_post_list}},                                         // ST4 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>
+	{0xbfe0f000, 0x0c800000, ST4, instArgs{arg_Vt_list, arg_mem_post_Xm}},                                           // ST4 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>
+	{0xbf20fc00, 0x0e208400, ADD, instArgs{arg_Vd_T, arg_Vn_T, arg_Vm_T}},                                           // ADD <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbf20fc00, 0x2e208400, SUB, instArgs{arg_Vd_T, arg_Vn_T, arg_Vm_T}},                                           // SUB <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbf20fc00, 0x0e209c00, MUL, instArgs{arg_Vd_T_BHS, arg_Vn_T, arg_Vm_T}},                                       // MUL <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbf20fc00, 0x2e208c00, CMEQ, instArgs{arg_Vd_T, arg_Vn_T, arg_Vm_T}},                                          // CMEQ <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbf20fc00, 0x0e203400, CMGT, instArgs{arg_Vd_T, arg_Vn_T, arg_Vm_T}},                                          // CMGT <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbf20fc00, 0x0e203c00, CMGE, instArgs{arg_Vd_T, arg_Vn_T, arg_Vm_T}},                                          // CMGE <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbf20fc00, 0x2e203400, CMHI, instArgs{arg_Vd_T, arg_Vn_T, arg_Vm_T}},                                          // CMHI <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbf20fc00, 0x2e203c00, CMHS, instArgs{arg_Vd_T, arg_Vn_T, arg_Vm_T}},                                          // CMHS <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbf20fc00, 0x0e20bc00, ADDP, instArgs{arg_Vd_T, arg_Vn_T, arg_Vm_T}},                                          // ADDP <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbf20fc00, 0x0e206400, SMAX, instArgs{arg_Vd_T_BHS, arg_Vn_T, arg_Vm_T}},                                      // SMAX <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbf20fc00, 0x2e206400, UMAX, instArgs{arg_Vd_T_BHS, arg_Vn_T, arg_Vm_T}},                                      // UMAX <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbf20fc00, 0x0e206c00, SMIN, instArgs{arg_Vd_T_BHS, arg_Vn_T, arg_Vm_T}},                                      // SMIN <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbf20fc00, 0x2e206c00, UMIN, instArgs{arg_Vd_T_BHS, arg_Vn_T, arg_Vm_T}},                                      // UMIN <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbfe0fc00, 0x0e201c00, AND, instArgs{arg_Vd_B, arg_Vn_B, arg_Vm_B}},                                           // AND <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbfe0fc00, 0x0e601c00, BIC, instArgs{arg_Vd_B, arg_Vn_B, arg_Vm_B}},                                           // BIC <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbfe0fc00, 0x0ea01c00, MOV, instArgs{arg_Vd_B, arg_Vn_B_eq_Vm}},                                               // MOV <Vd>.<T>, <Vn>.<T>
+	{0xbfe0fc00, 0x0ea01c00, ORR, instArgs{arg_Vd_B, arg_Vn_B, arg_Vm_B}},                                           // ORR <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbfe0fc00, 0x0ee01c00, ORN, instArgs{arg_Vd_B, arg_Vn_B, arg_Vm_B}},                                           // ORN <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbfe0fc00, 0x2e201c00, EOR, instArgs{arg_Vd_B, arg_Vn_B, arg_Vm_B}},                                           // EOR <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbfe0fc00, 0x2e601c00, BSL, instArgs{arg_Vd_B, arg_Vn_B, arg_Vm_B}},                                           // BSL <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbfe0fc00, 0x2ea01c00, BIT, instArgs{arg_Vd_B, arg_Vn_B, arg_Vm_B}},                                           // BIT <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbfe0fc00, 0x2ee01c00, BIF, instArgs{arg_Vd_B, arg_Vn_B, arg_Vm_B}},                                           // BIF <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbfa0fc00, 0x0e20d400, FADD, instArgs{arg_Vd_Tfp, arg_Vn_Tfp, arg_Vm_Tfp}},                                    // FADD <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbfa0fc00, 0x0ea0d400, FSUB, instArgs{arg_Vd_Tfp, arg_Vn_Tfp, arg_Vm_Tfp}},                                    // FSUB <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbfa0fc00, 0x2e20dc00, FMUL, instArgs{arg_Vd_Tfp, arg_Vn_Tfp, arg_Vm_Tfp}},                                    // FMUL <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbfa0fc00, 0x2e20fc00, FDIV, instArgs{arg_Vd_Tfp, arg_Vn_Tfp, arg_Vm_Tfp}},                                    // FDIV <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbfa0fc00, 0x0e20f400, FMAX, instArgs{arg_Vd_Tfp, arg_Vn_Tfp, arg_Vm_Tfp}},                                    // FMAX <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbfa0fc00, 0x0ea0f400, FMIN, instArgs{arg_Vd_Tfp, arg_Vn_Tfp, arg_Vm_Tfp}},                                    // FMIN <Vd>.<T>, <Vn>.<T>, <Vm>.<T>
+	{0xbffffc00, 0x0e205800, CNT, instArgs{arg_Vd_B, arg_Vn_B}},                                                     // CNT <Vd>.<T>, <Vn>.<T>
+	{0xbffffc00, 0x2e205800, MVN, instArgs{arg_Vd_B, arg_Vn_B}},                                                     // MVN <Vd>.<T>, <Vn>.<T>
+	{0xbf3ffc00, 0x0e200800, REV64, instArgs{arg_Vd_T_BHS, arg_Vn_T}},                                               // REV64 <Vd>.<T>, <Vn>.<T>
+	{0xbf3ffc00, 0x0e20b800, ABS, instArgs{arg_Vd_T, arg_Vn_T}},                                                     // ABS <Vd>.<T>, <Vn>.<T>
+	{0xbf3ffc00, 0x2e20b800, NEG, instArgs{arg_Vd_T, arg_Vn_T}},                                                     // NEG <Vd>.<T>, <Vn>.<T>
+	{0xbf3ffc00, 0x0e31b800, ADDV, instArgs{arg_Fd_across, arg_Vn_T}},                                               // ADDV <V><d>, <Vn>.<T>
+	{0xbf3ffc00, 0x0e30a800, SMAXV, instArgs{arg_Fd_across, arg_Vn_T}},                                              // SMAXV <V><d>, <Vn>.<T>
+	{0xbf3ffc00, 0x2e30a800, UMAXV, instArgs{arg_Fd_across, arg_Vn_T}},                                              // UMAXV <V><d>, <Vn>.<T>
+	{0xbf3ffc00, 0x0e31a800, SMINV, instArgs{arg_Fd_across, arg_Vn_T}},                                              // SMINV <V><d>, <Vn>.<T>
+	{0xbf3ffc00, 0x2e31a800, UMINV, instArgs{arg_Fd_across, arg_Vn_T}},                                              // UMINV <V><d>, <Vn>.<T>
+	{0xbfe0fc00, 0x0e000400, DUP, instArgs{arg_Vd_dup, arg_Vn_elem}},                                                // DUP <Vd>.<T>, <Vn>.<Ts>[<index>]
+	{0xbfe0fc00, 0x0e000c00, DUP, instArgs{arg_Vd_dup, arg_Rn_dup}},                                                 // DUP <Vd>.<T>, <R><n>
+	{0xbfe0fc00, 0x0e002c00, SMOV, instArgs{arg_Rd_smov, arg_Vn_elem}},                                              // SMOV <R><d>, <Vn>.<Ts>[<index>]
+	{0xbfe0fc00, 0x0e003c00, MOV, instArgs{arg_Rd_umov_mov, arg_Vn_elem}},                                           // MOV <R><d>, <Vn>.<Ts>[<index>]
+	{0xbfe0fc00, 0x0e003c00, UMOV, instArgs{arg_Rd_umov, arg_Vn_elem}},                                              // UMOV <R><d>, <Vn>.<Ts>[<index>]
+	{0xffe0fc00, 0x4e001c00, MOV, instArgs{arg_Vd_elem, arg_Rn_dup}},                                                // MOV <Vd>.<Ts>[<index>], <R><n>
+	{0xffe0fc00, 0x4e001c00, INS, instArgs{arg_Vd_elem, arg_Rn_dup}},                                                // INS <Vd>.<Ts>[<index>], <R><n>
+	{0xffe08400, 0x6e000400, MOV, instArgs{arg_Vd_elem, arg_Vn_elem_ins}},                                           // MOV <Vd>.<Ts>[<index1>], <Vn>.<Ts>[<index2>]
+	{0xffe08400, 0x6e000400, INS, instArgs{arg_Vd_elem, arg_Vn_elem_ins}},                                           // INS <Vd>.<Ts>[<index1>], <Vn>.<Ts>[<index2>]
+	{0xbff8fc00, 0x0f00e400, MOVI, instArgs{arg_Vd_B, arg_simd_imm8}},                                               // MOVI <Vd>.<T>, #<imm8>
+	{0xbff89c00, 0x0f000400, MOVI, instArgs{arg_Vd_S, arg_simd_imm8_lsl}},                                           // MOVI <Vd>.<T>, #<imm8>{, LSL #<amount>}
+	{0xbff89c00, 0x2f000400, MVNI, instArgs{arg_Vd_S, arg_simd_imm8_lsl}},                                           // MVNI <Vd>.<T>, #<imm8>{, LSL #<amount>}
+	{0xbff8dc00, 0x0f008400, MOVI, instArgs{arg_Vd_H, arg_simd_imm8_lsl}},                                           // MOVI <Vd>.<T>, #<imm8>{, LSL #<amount>}
+	{0xbff8dc00, 0x2f008400, MVNI, instArgs{arg_Vd_H, arg_simd_imm8_lsl}},                                           // MVNI <Vd>.<T>, #<imm8>{, LSL #<amount>}
+	{0xfff8fc00, 0x2f00e400, MOVI, instArgs{arg_Dd, arg_simd_imm64}},                                                // MOVI <Dd>, #<imm>
+	{0xfff8fc00, 0x6f00e400, MOVI, instArgs{arg_Vd_2D, arg_simd_imm64}},                                             // MOVI <Vd>.2D, #<imm>
+	{0x7fe0ffe0, 0x2a0003e0, MOV, instArgs{arg_Rd, arg_Rm}},                                                         // MOV <Rd>, <Rm>
+	{0x7f2003e0, 0x2a2003e0, MVN, instArgs{arg_Rd, arg_Rm_shift}},                                                   // MVN <Rd>, <Rm>{, <shift> #<amount>}
+	{0x7f20001f, 0x6a00001f, TST, instArgs{arg_Rn, arg_Rm_shift}},                                                   // TST <Rn>, <Rm>{, <shift> #<amount>}
+	{0x7f200000, 0x0a000000, AND, instArgs{arg_Rd, arg_Rn, arg_Rm_shift}},                                           // AND <Rd>, <Rn>, <Rm>{, <shift> #<amount>}
+	{0x7f200000, 0x0a200000, BIC, instArgs{arg_Rd, arg_Rn, arg_Rm_shift}},                                           // BIC <Rd>, <Rn>, <Rm>{, <shift> #<amount>}
+	{0x7f200000, 0x2a000000, ORR, instArgs{arg_Rd, arg_Rn, arg_Rm_shift}},                                           // ORR <Rd>, <Rn>, <Rm>{, <shift> #<amount>}
+	{0x7f200000, 0x2a200000, ORN, instArgs{arg_Rd, arg_Rn, arg_Rm_shift}},                                           // ORN <Rd>, <Rn>, <Rm>{, <shift> #<amount>}
+	{0x7f200000, 0x4a000000, EOR, instArgs{arg_Rd, arg_Rn, arg_Rm_shift}},                                           // EOR <Rd>, <Rn>, <Rm>{, <shift> #<amount>}
+	{0x7f200000, 0x4a200000, EON, instArgs{arg_Rd, arg_Rn, arg_Rm_shift}},                                           // EON <Rd>, <Rn>, <Rm>{, <shift> #<amount>}
+	{0x7f200000, 0x6a000000, ANDS, instArgs{arg_Rd, arg_Rn, arg_Rm_shift}},                                          // ANDS <Rd>, <Rn>, <Rm>{, <shift> #<amount>}
+	{0x7f200000, 0x6a200000, BICS, instArgs{arg_Rd, arg_Rn, arg_Rm_shift}},                                          // BICS <Rd>, <Rn>, <Rm>{, <shift> #<amount>}
+	{0x7f20001f, 0x2b00001f, CMN, instArgs{arg_Rn, arg_Rm_shift_arith}},                                             // CMN <Rn>, <Rm>{, <shift> #<amount>}
+	{0x7f20001f, 0x6b00001f, CMP, instArgs{arg_Rn, arg_Rm_shift_arith}},                                             // CMP <Rn>, <Rm>{, <shift> #<amount>}
+	{0x7f2003e0, 0x4b0003e0, NEG, instArgs{arg_Rd, arg_Rm_shift_arith}},                                             // NEG <Rd>, <Rm>{, <shift> #<amount>}
+	{0x7f2003e0, 0x6b0003e0, NEGS, instArgs{arg_Rd, arg_Rm_shift_arith}},                                            // NEGS <Rd>, <Rm>{, <shift> #<amount>}
+	{0x7f200000, 0x0b000000, ADD, instArgs{arg_Rd, arg_Rn, arg_Rm_shift_arith}},                                     // ADD <Rd>, <Rn>, <Rm>{, <shift> #<amount>}
+	{0x7f200000, 0x2b000000, ADDS, instArgs{arg_Rd, arg_Rn, arg_Rm_shift_arith}},                                    // ADDS <Rd>, <Rn>, <Rm>{, <shift> #<amount>}
+	{0x7f200000, 0x4b000000, SUB, instArgs{arg_Rd, arg_Rn, arg_Rm_shift_arith}},                                     // SUB <Rd>, <Rn>, <Rm>{, <shift> #<amount>}
+	{0x7f200000, 0x6b000000, SUBS, instArgs{arg_Rd, arg_Rn, arg_Rm_shift_arith}},                                    // SUBS <Rd>, <Rn>, <Rm>{, <shift> #<amount>}
+	{0x7fe0001f, 0x2b20001f, CMN, instArgs{arg_Rn_SP, arg_Rm_extend}},                                               // CMN <Rn|SP>, <R><m>{, <extend> {#<amount>}}
+	{0x7fe0001f, 0x6b20001f, CMP, instArgs{arg_Rn_SP, arg_Rm_extend}},                                               // CMP <Rn|SP>, <R><m>{, <extend> {#<amount>}}
+	{0x7fe00000, 0x0b200000, ADD, instArgs{arg_Rd_SP, arg_Rn_SP, arg_Rm_extend}},                                    // ADD <Rd|SP>, <Rn|SP>, <R><m>{, <extend> {#<amount>}}
+	{0x7fe00000, 0x2b200000, ADDS, instArgs{arg_Rd, arg_Rn_SP, arg_Rm_extend}},                                      // ADDS <Rd>, <Rn|SP>, <R><m>{, <extend> {#<amount>}}
+	{0x7fe00000, 0x4b200000, SUB, instArgs{arg_Rd_SP, arg_Rn_SP, arg_Rm_extend}},                                    // SUB <Rd|SP>, <Rn|SP>, <R><m>{, <extend> {#<amount>}}
+	{0x7fe00000, 0x6b200000, SUBS, instArgs{arg_Rd, arg_Rn_SP, arg_Rm_extend}},                                      // SUBS <Rd>, <Rn|SP>, <R><m>{, <extend> {#<amount>}}
+	{0x7fe0ffe0, 0x5a0003e0, NGC, instArgs{arg_Rd, arg_Rm}},                                                         // NGC <Rd>, <Rm>
+	{0x7fe0ffe0, 0x7a0003e0, NGCS, instArgs{arg_Rd, arg_Rm}},                                                        // NGCS <Rd>, <Rm>
+	{0x7fe0fc00, 0x1a000000, ADC, instArgs{arg_Rd, arg_Rn, arg_Rm}},                                                 // ADC <Rd>, <Rn>, <Rm>
+	{0x7fe0fc00, 0x3a000000, ADCS, instArgs{arg_Rd, arg_Rn, arg_Rm}},                                                // ADCS <Rd>, <Rn>, <Rm>
+	{0x7fe0fc00, 0x5a000000, SBC, instArgs{arg_Rd, arg_Rn, arg_Rm}},                                                 // SBC <Rd>, <Rn>, <Rm>
+	{0x7fe0fc00, 0x7a000000, SBCS, instArgs{arg_Rd, arg_Rn, arg_Rm}},                                                // SBCS <Rd>, <Rn>, <Rm>
+	{0x7fe00c10, 0x3a400000, CCMN, instArgs{arg_Rn, arg_Rm, arg_nzcv, arg_cond_12}},                                 // CCMN <Rn>, <Rm>, #<nzcv>, <cond>
+	{0x7fe00c10, 0x3a400800, CCMN, instArgs{arg_Rn, arg_imm5_16, arg_nzcv, arg_cond_12}},                            // CCMN <Rn>, #<imm>, #<nzcv>, <cond>
+	{0x7fe00c10, 0x7a400000, CCMP, instArgs{arg_Rn, arg_Rm, arg_nzcv, arg_cond_12}},                                 // CCMP <Rn>, <Rm>, #<nzcv>, <cond>
+	{0x7fe00c10, 0x7a400800, CCMP, instArgs{arg_Rn, arg_imm5_16, arg_nzcv, arg_cond_12}},                            // CCMP <Rn>, #<imm>, #<nzcv>, <cond>
+	{0x7fe00c00, 0x1a800000, CSEL, instArgs{arg_Rd, arg_Rn, arg_Rm, arg_cond_12}},                                   // CSEL <Rd>, <Rn>, <Rm>, <cond>
+	{0x7fff0fe0, 0x1a9f07e0, CSET, instArgs{arg_Rd, arg_cond_12_inv}},                                               // CSET <Rd>, <cond>
+	{0x7fe00c00, 0x1a800400, CINC, instArgs{arg_Rd, arg_Rn_eq_Rm, arg_cond_12_inv}},                                 // CINC <Rd>, <Rn>, <cond>
+	{0x7fe00c00, 0x1a800400, CSINC, instArgs{arg_Rd, arg_Rn, arg_Rm, arg_cond_12}},                                  // CSINC <Rd>, <Rn>, <Rm>, <cond>
+	{0x7fff0fe0, 0x5a9f03e0, CSETM, instArgs{arg_Rd, arg_cond_12_inv}},                                              // CSETM <Rd>, <cond>
+	{0x7fe00c00, 0x5a800000, CINV, instArgs{arg_Rd, arg_Rn_eq_Rm, arg_cond_12_inv}},                                 // CINV <Rd>, <Rn>, <cond>
+	{0x7fe00c00, 0x5a800000, CSINV, instArgs{arg_Rd, arg_Rn, arg_Rm, arg_cond_12}},                                  // CSINV <Rd>, <Rn>, <Rm>, <cond>
+	{0x7fe00c00, 0x5a800400, CNEG, instArgs{arg_Rd, arg_Rn_eq_Rm, arg_cond_12_inv}},                                 // CNEG <Rd>, <Rn>, <cond>
+	{0x7fe00c00, 0x5a800400, CSNEG, instArgs{arg_Rd, arg_Rn, arg_Rm, arg_cond_12}},                                  // CSNEG <Rd>, <Rn>, <Rm>, <cond>
+	{0x7ffffc00, 0x5ac00000, RBIT, instArgs{arg_Rd, arg_Rn}},                                                        // RBIT <Rd>, <Rn>
+	{0x7ffffc00, 0x5ac00400, REV16, instArgs{arg_Rd, arg_Rn}},                                                       // REV16 <Rd>, <Rn>
+	{0xfffffc00, 0x5ac00800, REV, instArgs{arg_Wd, arg_Wn}},                                                         // REV <Wd>, <Wn>
+	{0xfffffc00, 0xdac00800, REV32, instArgs{arg_Xd, arg_Xn}},                                                       // REV32 <Xd>, <Xn>
+	{0xfffffc00, 0xdac00c00, REV, instArgs{arg_Xd, arg_Xn}},                                                         // REV <Xd>, <Xn>
+	{0x7ffffc00, 0x5ac01000, CLZ, instArgs{arg_Rd, arg_Rn}},                                                         // CLZ <Rd>, <Rn>
+	{0x7ffffc00, 0x5ac01400, CLS, instArgs{arg_Rd, arg_Rn}},                                                         // CLS <Rd>, <Rn>
+	{0xfffffc00, 0xdac10000, PACIA, instArgs{arg_Rd, arg_Rn_SP}},                                                    // PACIA <Xd>, <Xn|SP>
+	{0xffffffe0, 0xdac123e0, PACIZA, instArgs{arg_Rd}},                                                              // PACIZA <Xd>
+	{0xfffffc00, 0xdac10400, PACIB, instArgs{arg_Rd, arg_Rn_SP}},                                                    // PACIB <Xd>, <Xn|SP>
+	{0xffffffe0, 0xdac127e0, PACIZB, instArgs{arg_Rd}},                                                              // PACIZB <Xd>
+	{0xfffffc00, 0xdac10800, PACDA, instArgs{arg_Rd, arg_Rn_SP}},                                                    // PACDA <Xd>, <Xn|SP>
+	{0xffffffe0, 0xdac12be0, PACDZA, instArgs{arg_Rd}},                                                              // PACDZA <Xd>
+	{0xfffffc00, 0xdac10c00, PACDB, instArgs{arg_Rd, arg_Rn_SP}},                                                    // PACDB <Xd>, <Xn|SP>
+	{0xffffffe0, 0xdac12fe0, PACDZB, instArgs{arg_Rd}},                                                              // PACDZB <Xd>
+	{0xfffffc00, 0xdac11000, AUTIA, instArgs{arg_Rd, arg_Rn_SP}},                                                    // AUTIA <Xd>, <Xn|SP>
+	{0xffffffe0, 0xdac133e0, AUTIZA, instArgs{arg_Rd}},                                                              // AUTIZA <Xd>
+	{0xfffffc00, 0xdac11400, AUTIB, instArgs{arg_Rd, arg_Rn_SP}},                                                    // AUTIB <Xd>, <Xn|SP>
+	{0xffffffe0, 0xdac137e0, AUTIZB, instArgs{arg_Rd}},                                                              // AUTIZB <Xd>
+	{0xfffffc00, 0xdac11800, AUTDA, instArgs{arg_Rd, arg_Rn_SP}},                                                    // AUTDA <Xd>, <Xn|SP>
+	{0xffffffe0, 0xdac13be0, AUTDZA, instArgs{arg_Rd}},                                                              // AUTDZA <Xd>
+	{0xfffffc00, 0xdac11c00, AUTDB, instArgs{arg_Rd, arg_Rn_SP}},                                                    // AUTDB <Xd>, <Xn|SP>
+	{0xffffffe0, 0xdac13fe0, AUTDZB, instArgs{arg_Rd}},                                                              // AUTDZB <Xd>
+	{0xffffffe0, 0xdac143e0, XPACI, instArgs{arg_Rd}},                                                               // XPACI <Xd>
+	{0xffffffe0, 0xdac147e0, XPACD, instArgs{arg_Rd}},                                                               // XPACD <Xd>
+	{0x7fe0fc00, 0x1ac00800, UDIV, instArgs{arg_Rd, arg_Rn, arg_Rm}},                                                // UDIV <Rd>, <Rn>, <Rm>
+	{0x7fe0fc00, 0x1ac00c00, SDIV, instArgs{arg_Rd, arg_Rn, arg_Rm}},                                                // SDIV <Rd>, <Rn>, <Rm>
+	{0x7fe0fc00, 0x1ac02000, LSL, instArgs{arg_Rd, arg_Rn, arg_Rm}},                                                 // LSL <Rd>, <Rn>, <Rm>
+	{0x7fe0fc00, 0x1ac02400, LSR, instArgs{arg_Rd, arg_Rn, arg_Rm}},                                                 // LSR <Rd>, <Rn>, <Rm>
+	{0x7fe0fc00, 0x1ac02800, ASR, instArgs{arg_Rd, arg_Rn, arg_Rm}},                                                 // ASR <Rd>, <Rn>, <Rm>
+	{0x7fe0fc00, 0x1ac02c00, ROR, instArgs{arg_Rd, arg_Rn, arg_Rm}},                                                 // ROR <Rd>, <Rn>, <Rm>
+	{0x7fe0fc00, 0x1b007c00, MUL, instArgs{arg_Rd, arg_Rn, arg_Rm}},                                                 // MUL <Rd>, <Rn>, <Rm>
+	{0x7fe08000, 0x1b000000, MADD, instArgs{arg_Rd, arg_Rn, arg_Rm, arg_Ra}},                                        // MADD <Rd>, <Rn>, <Rm>, <Ra>
+	{0x7fe0fc00, 0x1b00fc00, MNEG, instArgs{arg_Rd, arg_Rn, arg_Rm}},                                                // MNEG <Rd>, <Rn>, <Rm>
+	{0x7fe08000, 0x1b008000, MSUB, instArgs{arg_Rd, arg_Rn, arg_Rm, arg_Ra}},                                        // MSUB <Rd>, <Rn>, <Rm>, <Ra>
+	{0xffe0fc00, 0x9b207c00, SMULL, instArgs{arg_Xd, arg_Wn, arg_Wm}},                                               // SMULL <Xd>, <Wn>, <Wm>
+	{0xffe08000, 0x9b200000, SMADDL, instArgs{arg_Xd, arg_Wn, arg_Wm, arg_Xa}},                                      // SMADDL <Xd>, <Wn>, <Wm>, <Xa>
+	{0xffe0fc00, 0x9b20fc00, SMNEGL, instArgs{arg_Xd, arg_Wn, arg_Wm}},                                              // SMNEGL <Xd>, <Wn>, <Wm>
+	{0xffe08000, 0x9b208000, SMSUBL, instArgs{arg_Xd, arg_Wn, arg_Wm, arg_Xa}},                                      // SMSUBL <Xd>, <Wn>, <Wm>, <Xa>
+	{0xffe0fc00, 0x9ba07c00, UMULL, instArgs{arg_Xd, arg_Wn, arg_Wm}},                                               // UMULL <Xd>, <Wn>, <Wm>
+	{0xffe08000, 0x9ba00000, UMADDL, instArgs{arg_Xd, arg_Wn, arg_Wm, arg_Xa}},                                      // UMADDL <Xd>, <Wn>, <Wm>, <Xa>
+	{0xffe0fc00, 0x9ba0fc00, UMNEGL, instArgs{arg_Xd, arg_Wn, arg_Wm}},                                              // UMNEGL <Xd>, <Wn>, <Wm>
+	{0xffe08000, 0x9ba08000, UMSUBL, instArgs{arg_Xd, arg_Wn, arg_Wm, arg_Xa}},                                      // UMSUBL <Xd>, <Wn>, <Wm>, <Xa>
+	{0xffe0fc00, 0x9b407c00, SMULH, instArgs{arg_Xd, arg_Xn, arg_Xm}},                                               // SMULH <Xd>, <Xn>, <Xm>
+	{0xffe0fc00, 0x9bc07c00, UMULH, instArgs{arg_Xd, arg_Xn, arg_Xm}},                                               // UMULH <Xd>, <Xn>, <Xm>
+	{0xffe0fc00, 0x9ac03000, PACGA, instArgs{arg_Rd, arg_Rn, arg_Rm_SP}},                                            // PACGA <Xd>, <Xn>, <Xm|SP>
+	{0xffa00c00, 0xf8200400, LDRAA, instArgs{arg_Rt_31, arg_mem_pac_offset}},                                        // LDRAA <Xt>, [<Xn|SP>{, #<simm>}]
+	{0xffa00c00, 0xf8200c00, LDRAA, instArgs{arg_Rt_31, arg_mem_pac_preindex}},                                      // LDRAA <Xt>, [<Xn|SP>, #<simm>]!
+	{0xffa00c00, 0xf8a00400, LDRAB, instArgs{arg_Rt_31, arg_mem_pac_offset}},                                        // LDRAB <Xt>, [<Xn|SP>{, #<simm>}]
+	{0xffa00c00, 0xf8a00c00, LDRAB, instArgs{arg_Rt_31, arg_mem_pac_preindex}},                                      // LDRAB <Xt>, [<Xn|SP>, #<simm>]!
+	{0xff20fc00, 0x04200000, ADD, instArgs{arg_Zd_T, arg_Zn_T, arg_Zm_T}},                                           // ADD <Zd>.<T>, <Zn>.<T>, <Zm>.<T>
+	{0xff20fc00, 0x04200400, SUB, instArgs{arg_Zd_T, arg_Zn_T, arg_Zm_T}},                                           // SUB <Zd>.<T>, <Zn>.<T>, <Zm>.<T>
+	{0xff20fc00, 0x04201000, SQADD, instArgs{arg_Zd_T, arg_Zn_T, arg_Zm_T}},                                         // SQADD <Zd>.<T>, <Zn>.<T>, <Zm>.<T>
+	{0xff20fc00, 0x04201400, UQADD, instArgs{arg_Zd_T, arg_Zn_T, arg_Zm_T}},                                         // UQADD <Zd>.<T>, <Zn>.<T>, <Zm>.<T>
+	{0xff20fc00, 0x04201800, SQSUB, instArgs{arg_Zd_T, arg_Zn_T, arg_Zm_T}},                                         // SQSUB <Zd>.<T>, <Zn>.<T>, <Zm>.<T>
+	{0xff20fc00, 0x04201c00, UQSUB, instArgs{arg_Zd_T, arg_Zn_T, arg_Zm_T}},                                         // UQSUB <Zd>.<T>, <Zn>.<T>, <Zm>.<T>
+	{0xff3fe000, 0x04000000, ADD, instArgs{arg_Zd_T, arg_Pg_M, arg_Zd_T, arg_Zn_T}},                                 // ADD <Zdn>.<T>, <Pg>/M, <Zdn>.<T>, <Zm>.<T>
+	{0xff3fe000, 0x04010000, SUB, instArgs{arg_Zd_T, arg_Pg_M, arg_Zd_T, arg_Zn_T}},                                 // SUB <Zdn>.<T>, <Pg>/M, <Zdn>.<T>, <Zm>.<T>
+	{0xff3fe000, 0x04030000, SUBR, instArgs{arg_Zd_T, arg_Pg_M, arg_Zd_T, arg_Zn_T}},                                // SUBR <Zdn>.<T>, <Pg>/M, <Zdn>.<T>, <Zm>.<T>
+	{0xffe0fc00, 0x04203000, AND, instArgs{arg_Zd_D, arg_Zn_D, arg_Zm_D}},                                           // AND <Zd>.D, <Zn>.D, <Zm>.D
+	{0xffe0fc00, 0x04603000, MOV, instArgs{arg_Zd_D, arg_Zn_D_eq_Zm}},                                               // MOV <Zd>.D, <Zn>.D
+	{0xffe0fc00, 0x04603000, ORR, instArgs{arg_Zd_D, arg_Zn_D, arg_Zm_D}},                                           // ORR <Zd>.D, <Zn>.D, <Zm>.D
+	{0xffe0fc00, 0x04a03000, EOR, instArgs{arg_Zd_D, arg_Zn_D, arg_Zm_D}},                                           // EOR <Zd>.D, <Zn>.D, <Zm>.D
+	{0xffe0fc00, 0x04e03000, BIC, instArgs{arg_Zd_D, arg_Zn_D, arg_Zm_D}},                                           // BIC <Zd>.D, <Zn>.D, <Zm>.D
+	{0xffe0fc00, 0x04203800, EOR3, instArgs{arg_Zd_D, arg_Zd_D, arg_Zm_D, arg_Zn_D}},                                // EOR3 <Zdn>.D, <Zdn>.D, <Zm>.D, <Zk>.D
+	{0xffe0fc00, 0x04603800, BCAX, instArgs{arg_Zd_D, arg_Zd_D, arg_Zm_D, arg_Zn_D}},                                // BCAX <Zdn>.D, <Zdn>.D, <Zm>.D, <Zk>.D
+	{0xffe0fc00, 0x04203c00, BSL, instArgs{arg_Zd_D, arg_Zd_D, arg_Zm_D, arg_Zn_D}},                                 // BSL <Zdn>.D, <Zdn>.D, <Zm>.D, <Zk>.D
+	{0xffffffe0, 0x0420e3e0, CNTB, instArgs{arg_Xd}},                                                                // CNTB <Xd>
+	{0xfffffc00, 0x0420e000, CNTB, instArgs{arg_Xd, arg_sve_pattern}},                                               // CNTB <Xd>, <pattern>
+	{0xfff0fc00, 0x0420e000, CNTB, instArgs{arg_Xd, arg_sve_pattern, arg_sve_mul}},                                  // CNTB <Xd>, <pattern>, MUL #<imm>
+	{0xffffffe0, 0x0430e3e0, INCB, instArgs{arg_Xd}},                                                                // INCB <Xd>
+	{0xfffffc00, 0x0430e000, INCB, instArgs{arg_Xd, arg_sve_pattern}},                                               // INCB <Xd>, <pattern>
+	{0xfff0fc00, 0x0430e000, INCB, instArgs{arg_Xd, arg_sve_pattern, arg_sve_mul}},                                  // INCB <Xd>, <pattern>, MUL #<imm>
+	{0xffffffe0, 0x0430e7e0, DECB, instArgs{arg_Xd}},                                                                // DECB <Xd>
+	{0xfffffc00, 0x0430e400, DECB, instArgs{arg_Xd, arg_sve_pattern}},                                               // DECB <Xd>, <pattern>
+	{0xfff0fc00, 0x0430e400, DECB, instArgs{arg_Xd, arg_sve_pattern, arg_sve_mul}},                                  // DECB <Xd>, <pattern>, MUL #<imm>
+	{0xffffffe0, 0x0460e3e0, CNTH, instArgs{arg_Xd}},                                                                // CNTH <Xd>
+	{0xfffffc00, 0x0460e000, CNTH, instArgs{arg_Xd, arg_sve_pattern}},                                               // CNTH <Xd>, <pattern>
+	{0xfff0fc00, 0x0460e000, CNTH, instArgs{arg_Xd, arg_sve_pattern, arg_sve_mul}},                                  // CNTH <Xd>, <pattern>, MUL #<imm>
+	{0xffffffe0, 0x0470e3e0, INCH, instArgs{arg_Xd}},                                                                // INCH <Xd>
+	{0xfffffc00, 0x0470e000, INCH, instArgs{arg_Xd, arg_sve_pattern}},                                               // INCH <Xd>, <pattern>
+	{0xfff0fc00, 0x0470e000, INCH, instArgs{arg_Xd, arg_sve_pattern, arg_sve_mul}},                                  // INCH <Xd>, <pattern>, MUL #<imm>
+	{0xffffffe0, 0x0470e7e0, DECH, instArgs{arg_Xd}},                                                                // DECH <Xd>
+	{0xfffffc00, 0x0470e400, DECH, instArgs{arg_Xd, arg_sve_pattern}},                                               // DECH <Xd>, <pattern>
+	{0xfff0fc00, 0x0470e400, DECH, instArgs{arg_Xd, arg_sve_pattern, arg_sve_mul}},                                  // DECH <Xd>, <pattern>, MUL #<imm>
+	{0xffffffe0, 0x04a0e3e0, CNTW, instArgs{arg_Xd}},                                                                // CNTW <Xd>
+	{0xfffffc00, 0x04a0e000, CNTW, instArgs{arg_Xd, arg_sve_pattern}},                                               // CNTW <Xd>, <pattern>
+	{0xfff0fc00, 0x04a0e000, CNTW, instArgs{arg_Xd, arg_sve_pattern, arg_sve_mul}},                                  // CNTW <Xd>, <pattern>, MUL #<imm>
+	{0xffffffe0, 0x04b0e3e0, INCW, instArgs{arg_Xd}},                                                                // INCW <Xd>
+	{0xfffffc00, 0x04b0e000, INCW, instArgs{arg_Xd, arg_sve_pattern}},                                               // INCW <Xd>, <pattern>
+	{0xfff0fc00, 0x04b0e000, INCW, instArgs{arg_Xd, arg_sve_pattern, arg_sve_mul}},                                  // INCW <Xd>, <pattern>, MUL #<imm>
+	{0xffffffe0, 0x04b0e7e0, DECW, instArgs{arg_Xd}},                                                                // DECW <Xd>
+	{0xfffffc00, 0x04b0e400, DECW, instArgs{arg_Xd, arg_sve_pattern}},                                               // DECW <Xd>, <pattern>
+	{0xfff0fc00, 0x04b0e400, DECW, instArgs{arg_Xd, arg_sve_pattern, arg_sve_mul}},                                  // DECW <Xd>, <pattern>, MUL #<imm>
+	{0xffffffe0, 0x04e0e3e0, CNTD, instArgs{arg_Xd}},                                                                // CNTD <Xd>
+	{0xfffffc00, 0x04e0e000, CNTD, instArgs{arg_Xd, arg_sve_pattern}},                                               // CNTD <Xd>, <pattern>
+	{0xfff0fc00, 0x04e0e000, CNTD, instArgs{arg_Xd, arg_sve_pattern, arg_sve_mul}},                                  // CNTD <Xd>, <pattern>, MUL #<imm>
+	{0xffffffe0, 0x04f0e3e0, INCD, instArgs{arg_Xd}},                                                                // INCD <Xd>
+	{0xfffffc00, 0x04f0e000, INCD, instArgs{arg_Xd, arg_sve_pattern}},                                               // INCD <Xd>, <pattern>
+	{0xfff0fc00, 0x04f0e000, INCD, instArgs{arg_Xd, arg_sve_pattern, arg_sve_mul}},                                  // INCD <Xd>, <pattern>, MUL #<imm>
+	{0xffffffe0, 0x04f0e7e0, DECD, instArgs{arg_Xd}},                                                                // DECD <Xd>
+	{0xfffffc00, 0x04f0e400, DECD, instArgs{arg_Xd, arg_sve_pattern}},                                               // DECD <Xd>, <pattern>
+	{0xfff0fc00, 0x04f0e400, DECD, instArgs{arg_Xd, arg_sve_pattern, arg_sve_mul}},                                  // DECD <Xd>, <pattern>, MUL #<imm>
+	{0xff3fc000, 0x2538c000, MOV, instArgs{arg_Zd_T, arg_sve_dup_imm}},                                              // MOV <Zd>.<T>, #<imm>{, <shift>}
+	{0xff3fc000, 0x2538c000, DUP, instArgs{arg_Zd_T, arg_sve_dup_imm}},                                              // DUP <Zd>.<T>, #<imm>{, <shift>}
+	{0xff3ffc00, 0x05203800, MOV, instArgs{arg_Zd_T, arg_Rn_SP_sz}},                                                 // MOV <Zd>.<T>, <R><n|SP>
+	{0xff3ffc00, 0x05203800, DUP, instArgs{arg_Zd_T, arg_Rn_SP_sz}},                                                 // DUP <Zd>.<T>, <R><n|SP>
+	{0xff20c000, 0x0520c000, MOV, instArgs{arg_Zd_T_eq_Zm, arg_Pg4_M, arg_Zn_T}},                                    // MOV <Zd>.<T>, <Pg>/M, <Zn>.<T>
+	{0xff20c000, 0x0520c000, SEL, instArgs{arg_Zd_T, arg_Pg4, arg_Zn_T, arg_Zm_T}},                                  // SEL <Zd>.<T>, <Pg>, <Zn>.<T>, <Zm>.<T>
+	{0xff20e010, 0x24000000, CMPHS, instArgs{arg_Pd_T, arg_Pg_Z, arg_Zn_T, arg_Zm_T}},                               // CMPHS <Pd>.<T>, <Pg>/Z, <Zn>.<T>, <Zm>.<T>
+	{0xff20e010, 0x24000010, CMPHI, instArgs{arg_Pd_T, arg_Pg_Z, arg_Zn_T, arg_Zm_T}},                               // CMPHI <Pd>.<T>, <Pg>/Z, <Zn>.<T>, <Zm>.<T>
+	{0xff20e010, 0x24008000, CMPGE, instArgs{arg_Pd_T, arg_Pg_Z, arg_Zn_T, arg_Zm_T}},                               // CMPGE <Pd>.<T>, <Pg>/Z, <Zn>.<T>, <Zm>.<T>
+	{0xff20e010, 0x24008010, CMPGT, instArgs{arg_Pd_T, arg_Pg_Z, arg_Zn_T, arg_Zm_T}},                               // CMPGT <Pd>.<T>, <Pg>/Z, <Zn>.<T>, <Zm>.<T>
+	{0xff20e010, 0x2400a000, CMPEQ, instArgs{arg_Pd_T, arg_Pg_Z, arg_Zn_T, arg_Zm_T}},                               // CMPEQ <Pd>.<T>, <Pg>/Z, <Zn>.<T>, <Zm>.<T>
+	{0xff20e010, 0x2400a010, CMPNE, instArgs{arg_Pd_T, arg_Pg_Z, arg_Zn_T, arg_Zm_T}},                               // CMPNE <Pd>.<T>, <Pg>/Z, <Zn>.<T>, <Zm>.<T>
+	{0xff3ffff0, 0x2518e3e0, PTRUE, instArgs{arg_Pd_T}},                                                             // PTRUE <Pd>.<T>
+	{0xff3ffc10, 0x2518e000, PTRUE, instArgs{arg_Pd_T, arg_sve_pattern}},                                            // PTRUE <Pd>.<T>, <pattern>
+	{0xff3ffff0, 0x2519e3e0, PTRUES, instArgs{arg_Pd_T}},                                                            // PTRUES <Pd>.<T>
+	{0xff3ffc10, 0x2519e000, PTRUES, instArgs{arg_Pd_T, arg_sve_pattern}},                                           // PTRUES <Pd>.<T>, <pattern>
+	{0xfffffff0, 0x2518e400, PFALSE, instArgs{arg_Pd_B}},                                                            // PFALSE <Pd>.B
+	{0xff20ec10, 0x25200400, WHILELT, instArgs{arg_Pd_T, arg_Rn_sf12, arg_Rm_sf12}},                                 // WHILELT <Pd>.<T>, <R><n>, <R><m>
+	{0xff20ec10, 0x25200410, WHILELE, instArgs{arg_Pd_T, arg_Rn_sf12, arg_Rm_sf12}},                                 // WHILELE <Pd>.<T>, <R><n>, <R><m>
+	{0xff20ec10, 0x25200c00, WHILELO, instArgs{arg_Pd_T, arg_Rn_sf12, arg_Rm_sf12}},                                 // WHILELO <Pd>.<T>, <R><n>, <R><m>
+	{0xff20ec10, 0x25200c10, WHILELS, instArgs{arg_Pd_T, arg_Rn_sf12, arg_Rm_sf12}},                                 // WHILELS <Pd>.<T>, <R><n>, <R><m>
+	{0xff20fc00, 0x65000000, FADD, instArgs{arg_Zd_T_fp, arg_Zn_T, arg_Zm_T}},                                       // FADD <Zd>.<T>, <Zn>.<T>, <Zm>.<T>
+	{0xff20fc00, 0x65000400, FSUB, instArgs{arg_Zd_T_fp, arg_Zn_T, arg_Zm_T}},                                       // FSUB <Zd>.<T>, <Zn>.<T>, <Zm>.<T>
+	{0xff20fc00, 0x65000800, FMUL, instArgs{arg_Zd_T_fp, arg_Zn_T, arg_Zm_T}},                                       // FMUL <Zd>.<T>, <Zn>.<T>, <Zm>.<T>
+	{0xfff0e000, 0xa400a000, LD1B, instArgs{arg_Zt_list, arg_Pg_Z, arg_mem_sve_imm4}},                               // LD1B {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>{, #<imm>, MUL VL}]
+	{0xffe0e000, 0xa4004000, LD1B, instArgs{arg_Zt_list, arg_Pg_Z, arg_mem_sve_Xm}},                                 // LD1B {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>, <Xm>{, LSL #<amount>}]
+	{0xfff0e000, 0xe400e000, ST1B, instArgs{arg_Zt_list, arg_Pg, arg_mem_sve_imm4}},                                 // ST1B {<Zt>.<T>}, <Pg>, [<Xn|SP>{, #<imm>, MUL VL}]
+	{0xffe0e000, 0xe4004000, ST1B, instArgs{arg_Zt_list, arg_Pg, arg_mem_sve_Xm}},                                   // ST1B {<Zt>.<T>}, <Pg>, [<Xn|SP>, <Xm>{, LSL #<amount>}]
+	{0xfff0e000, 0xa4a0a000, LD1H, instArgs{arg_Zt_list, arg_Pg_Z, arg_mem_sve_imm4}},                               // LD1H {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>{, #<imm>, MUL VL}]
+	{0xffe0e000, 0xa4a04000, LD1H, instArgs{arg_Zt_list, arg_Pg_Z, arg_mem_sve_Xm}},                                 // LD1H {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>, <Xm>{, LSL #<amount>}]
+	{0xfff0e000, 0xe4a0e000, ST1H, instArgs{arg_Zt_list, arg_Pg, arg_mem_sve_imm4}},                                 // ST1H {<Zt>.<T>}, <Pg>, [<Xn|SP>{, #<imm>, MUL VL}]
+	{0xffe0e000, 0xe4a04000, ST1H, instArgs{arg_Zt_list, arg_Pg, arg_mem_sve_Xm}},                                   // ST1H {<Zt>.<T>}, <Pg>, [<Xn|SP>, <Xm>{, LSL #<amount>}]
+	{0xfff0e000, 0xa540a000, LD1W, instArgs{arg_Zt_list, arg_Pg_Z, arg_mem_sve_imm4}},                               // LD1W {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>{, #<imm>, MUL VL}]
+	{0xffe0e000, 0xa5404000, LD1W, instArgs{arg_Zt_list, arg_Pg_Z, arg_mem_sve_Xm}},                                 // LD1W {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>, <Xm>{, LSL #<amount>}]
+	{0xfff0e000, 0xe540e000, ST1W, instArgs{arg_Zt_list, arg_Pg, arg_mem_sve_imm4}},                                 // ST1W {<Zt>.<T>}, <Pg>, [<Xn|SP>{, #<imm>, MUL VL}]
+	{0xffe0e000, 0xe5404000, ST1W, instArgs{arg_Zt_list, arg_Pg, arg_mem_sve_Xm}},                                   // ST1W {<Zt>.<T>}, <Pg>, [<Xn|SP>, <Xm>{, LSL #<amount>}]
+	{0xfff0e000, 0xa5e0a000, LD1D, instArgs{arg_Zt_list, arg_Pg_Z, arg_mem_sve_imm4}},                               // LD1D {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>{, #<imm>, MUL VL}]
+	{0xffe0e000, 0xa5e04000, LD1D, instArgs{arg_Zt_list, arg_Pg_Z, arg_mem_sve_Xm}},                                 // LD1D {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>, <Xm>{, LSL #<amount>}]
+	{0xfff0e000, 0xe5e0e000, ST1D, instArgs{arg_Zt_list, arg_Pg, arg_mem_sve_imm4}},                                 // ST1D {<Zt>.<T>}, <Pg>, [<Xn|SP>{, #<imm>, MUL VL}]
+	{0xffe0e000, 0xe5e04000, ST1D, instArgs{arg_Zt_list, arg_Pg, arg_mem_sve_Xm}},                                   // ST1D {<Zt>.<T>}, <Pg>, [<Xn|SP>, <Xm>{, LSL #<amount>}]
+	{0xffc0e000, 0x85804000, LDR, instArgs{arg_Zt, arg_mem_sve_imm9}},                                               // LDR <Zt>, [<Xn|SP>{, #<imm>, MUL VL}]
+	{0xffc0e010, 0x85800000, LDR, instArgs{arg_Pt, arg_mem_sve_imm9}},                                               // LDR <Pt>, [<Xn|SP>{, #<imm>, MUL VL}]
+	{0xffc0e000, 0xe5804000, STR, instArgs{arg_Zt, arg_mem_sve_imm9}},                                               // STR <Zt>, [<Xn|SP>{, #<imm>, MUL VL}]
+	{0xffc0e010, 0xe5800000, STR, instArgs{arg_Pt, arg_mem_sve_imm9}},                                               // STR <Pt>, [<Xn|SP>{, #<imm>, MUL VL}]
+}
+
+var instSyntax = [...]string{
+	"ADR <Xd>, <label>",
+	"ADRP <Xd>, <label>",
+	"MOV <Rd|SP>, <Rn|SP>",
+	"ADD <Rd|SP>, <Rn|SP>, #<imm>{, LSL #12}",
+	"CMN <Rn|SP>, #<imm>{, LSL #12}",
+	"ADDS <Rd>, <Rn|SP>, #<imm>{, LSL #12}",
+	"SUB <Rd|SP>, <Rn|SP>, #<imm>{, LSL #12}",
+	"CMP <Rn|SP>, #<imm>{, LSL #12}",
+	"SUBS <Rd>, <Rn|SP>, #<imm>{, LSL #12}",
+	"AND <Rd|SP>, <Rn>, #<imm>",
+	"MOV <Rd|SP>, #<imm>",
+	"ORR <Rd|SP>, <Rn>, #<imm>",
+	"EOR <Rd|SP>, <Rn>, #<imm>",
+	"TST <Rn>, #<imm>",
+	"ANDS <Rd>, <Rn>, #<imm>",
+	"MOV <Rd>, #<imm>",
+	"MOVN <Rd>, #<imm16>{, LSL #<shift>}",
+	"MOV <Rd>, #<imm>",
+	"MOVZ <Rd>, #<imm16>{, LSL #<shift>}",
+	"MOVK <Rd>, #<imm16>{, LSL #<shift>}",
+	"ASR <Rd>, <Rn>, #<shift>",
+	"SBFIZ <Rd>, <Rn>, #<lsb>, #<width>",
+	"SXTB <Rd>, <Wn>",
+	"SXTH <Rd>, <Wn>",
+	"SBFX <Rd>, <Rn>, #<lsb>, #<width>",
+	"SBFM <Rd>, <Rn>, #<immr>, #<imms>",
+	"BFI <Rd>, <Rn>, #<lsb>, #<width>",
+	"BFXIL <Rd>, <Rn>, #<lsb>, #<width>",
+	"BFM <Rd>, <Rn>, #<immr>, #<imms>",
+	"LSL <Rd>, <Rn>, #<shift>",
+	"LSR <Rd>, <Rn>, #<shift>",
+	"UBFIZ <Rd>, <Rn>, #<lsb>, #<width>",
+	"UXTB <Wd>, <Wn>",
+	"UXTH <Wd>, <Wn>",
+	"UBFX <Rd>, <Rn>, #<lsb>, #<width>",
+	"UBFM <Rd>, <Rn>, #<immr>, #<imms>",
+	"ASR <Rd>, <Rn>, #<shift>",
+	"SBFIZ <Rd>, <Rn>, #<lsb>, #<width>",
+	"SXTB <Rd>, <Wn>",
+	"SXTH <Rd>, <Wn>",
+	"SXTW <Xd>, <Wn>",
+	"SBFX <Rd>, <Rn>, #<lsb>, #<width>",
+	"SBFM <Rd>, <Rn>, #<immr>, #<imms>",
+	"BFI <Rd>, <Rn>, #<lsb>, #<width>",
+	"BFXIL <Rd>, <Rn>, #<lsb>, #<width>",
+	"BFM <Rd>, <Rn>, #<immr>, #<imms>",
+	"LSL <Rd>, <Rn>, #<shift>",
+	"LSR <Rd>, <Rn>, #<shift>",
+	"UBFIZ <Rd>, <Rn>, #<lsb>, #<width>",
+	"UBFX <Rd>, <Rn>, #<lsb>, #<width>",
+	"UBFM <Rd>, <Rn>, #<immr>, #<imms>",
+	"ROR <Rd>, <Rn>, #<shift>",
+	"EXTR <Rd>, <Rn>, <Rm>, #<lsb>",
+	"ROR <Rd>, <Rn>, #<shift>",
+	"EXTR <Rd>, <Rn>, <Rm>, #<lsb>",
+	"B <label>",
+	"BL <label>",
+	"B.<cond> <label>",
+	"CBZ <Rt>, <label>",
+	"CBNZ <Rt>, <label>",
+	"TBZ <R><t>, #<imm>, <label>",
+	"TBNZ <R><t>, #<imm>, <label>",
+	"SVC #<imm>",
+	"HVC #<imm>",
+	"SMC #<imm>",
+	"BRK #<imm>",
+	"HLT #<imm>",
+	"NOP",
+	"YIELD",
+	"WFE",
+	"WFI",
+	"SEV",
+	"SEVL",
+	"PACIA1716",
+	"PACIB1716",
+	"AUTIA1716",
+	"AUTIB1716",
+	"XPACLRI",
+	"PACIAZ",
+	"PACIASP",
+	"PACIBZ",
+	"PACIBSP",
+	"AUTIAZ",
+	"AUTIASP",
+	"AUTIBZ",
+	"AUTIBSP",
+	"BTI",
+	"BTI <targets>",
+	"HINT #<imm>",
+	"CLREX",
+	"CLREX #<imm>",
+	"DSB <option>",
+	"DMB <option>",
+	"ISB",
+	"ISB #<imm>",
+	"MSR <pstatefield>, #<imm>",
+	"MSR <systemreg>, <Xt>",
+	"MRS <Xt>, <systemreg>",
+	"BR <Xn>",
+	"BLR <Xn>",
+	"RET",
+	"RET <Xn>",
+	"ERET",
+	"DRPS",
+	"BRAAZ <Xn>",
+	"BRABZ <Xn>",
+	"BLRAAZ <Xn>",
+	"BLRABZ <Xn>",
+	"RETAA",
+	"RETAB",
+	"ERETAA",
+	"ERETAB",
+	"BRAA <Xn>, <Xm|SP>",
+	"BRAB <Xn>, <Xm|SP>",
+	"BLRAA <Xn>, <Xm|SP>",
+	"BLRAB <Xn>, <Xm|SP>",
+	"STXRB <Ws>, <Rt>, [<Xn|SP>]",
+	"STLXRB <Ws>, <Rt>, [<Xn|SP>]",
+	"LDXRB <Rt>, [<Xn|SP>]",
+	"LDAXRB <Rt>, [<Xn|SP>]",
+	"STLRB <Rt>, [<Xn|SP>]",
+	"LDARB <Rt>, [<Xn|SP>]",
+	"STXRH <Ws>, <Rt>, [<Xn|SP>]",
+	"STLXRH <Ws>, <Rt>, [<Xn|SP>]",
+	"LDXRH <Rt>, [<Xn|SP>]",
+	"LDAXRH <Rt>, [<Xn|SP>]",
+	"STLRH <Rt>, [<Xn|SP>]",
+	"LDARH <Rt>, [<Xn|SP>]",
+	"STXR <Ws>, <Rt>, [<Xn|SP>]",
+	"STLXR <Ws>, <Rt>, [<Xn|SP>]",
+	"LDXR <Rt>, [<Xn|SP>]",
+	"LDAXR <Rt>, [<Xn|SP>]",
+	"STLR <Rt>, [<Xn|SP>]",
+	"LDAR <Rt>, [<Xn|SP>]",
+	"STXP <Ws>, <Rt>, <Rt2>, [<Xn|SP>]",
+	"STLXP <Ws>, <Rt>, <Rt2>, [<Xn|SP>]",
+	"LDXP <Rt>, <Rt2>, [<Xn|SP>]",
+	"LDAXP <Rt>, <Rt2>, [<Xn|SP>]",
+	"CASB <Ws>, <Wt>, [<Xn|SP>{,#0}]",
+	"CASAB <Ws>, <Wt>, [<Xn|SP>{,#0}]",
+	"CASALB <Ws>, <Wt>, [<Xn|SP>{,#0}]",
+	"CASLB <Ws>, <Wt>, [<Xn|SP>{,#0}]",
+	"CASH <Ws>, <Wt>, [<Xn|SP>{,#0}]",
+	"CASAH <Ws>, <Wt>, [<Xn|SP>{,#0}]",
+	"CASALH <Ws>, <Wt>, [<Xn|SP>{,#0}]",
+	"CASLH <Ws>, <Wt>, [<Xn|SP>{,#0}]",
+	"CAS <Ws>, <Wt>, [<Xn|SP>{,#0}]",
+	"CASA <Ws>, <Wt>, [<Xn|SP>{,#0}]",
+	"CASAL <Ws>, <Wt>, [<Xn|SP>{,#0}]",
+	"CASL <Ws>, <Wt>, [<Xn|SP>{,#0}]",
+	"CASP <Ws>, <W(s+1)>, <Wt>, <W(t+1)>, [<Xn|SP>{,#0}]",
+	"CASPA <Ws>, <W(s+1)>, <Wt>, <W(t+1)>, [<Xn|SP>{,#0}]",
+	"CASPAL <Ws>, <W(s+1)>, <Wt>, <W(t+1)>, [<Xn|SP>{,#0}]",
+	"CASPL <Ws>, <W(s+1)>, <Wt>, <W(t+1)>, [<Xn|SP>{,#0}]",
+	"STADDB <Ws>, [<Xn|SP>]",
+	"STADDLB <Ws>, [<Xn|SP>]",
+	"STCLRB <Ws>, [<Xn|SP>]",
+	"STCLRLB <Ws>, [<Xn|SP>]",
+	"STEORB <Ws>, [<Xn|SP>]",
+	"STEORLB <Ws>, [<Xn|SP>]",
+	"STSETB <Ws>, [<Xn|SP>]",
+	"STSETLB <Ws>, [<Xn|SP>]",
+	"STSMAXB <Ws>, [<Xn|SP>]",
+	"STSMAXLB <Ws>, [<Xn|SP>]",
+	"STSMINB <Ws>, [<Xn|SP>]",
+	"STSMINLB <Ws>, [<Xn|SP>]",
+	"STUMAXB <Ws>, [<Xn|SP>]",
+	"STUMAXLB <Ws>, [<Xn|SP>]",
+	"STUMINB <Ws>, [<Xn|SP>]",
+	"STUMINLB <Ws>, [<Xn|SP>]",
+	"LDADDB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDADDAB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDADDALB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDADDLB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDCLRB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDCLRAB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDCLRALB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDCLRLB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDEORB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDEORAB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDEORALB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDEORLB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSETB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSETAB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSETALB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSETLB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMAXB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMAXAB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMAXALB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMAXLB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMINB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMINAB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMINALB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMINLB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMAXB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMAXAB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMAXALB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMAXLB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMINB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMINAB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMINALB <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMINLB <Ws>, <Wt>, [<Xn|SP>]",
+	"SWPB <Ws>, <Wt>, [<Xn|SP>]",
+	"SWPAB <Ws>, <Wt>, [<Xn|SP>]",
+	"SWPALB <Ws>, <Wt>, [<Xn|SP>]",
+	"SWPLB <Ws>, <Wt>, [<Xn|SP>]",
+	"STADDH <Ws>, [<Xn|SP>]",
+	"STADDLH <Ws>, [<Xn|SP>]",
+	"STCLRH <Ws>, [<Xn|SP>]",
+	"STCLRLH <Ws>, [<Xn|SP>]",
+	"STEORH <Ws>, [<Xn|SP>]",
+	"STEORLH <Ws>, [<Xn|SP>]",
+	"STSETH <Ws>, [<Xn|SP>]",
+	"STSETLH <Ws>, [<Xn|SP>]",
+	"STSMAXH <Ws>, [<Xn|SP>]",
+	"STSMAXLH <Ws>, [<Xn|SP>]",
+	"STSMINH <Ws>, [<Xn|SP>]",
+	"STSMINLH <Ws>, [<Xn|SP>]",
+	"STUMAXH <Ws>, [<Xn|SP>]",
+	"STUMAXLH <Ws>, [<Xn|SP>]",
+	"STUMINH <Ws>, [<Xn|SP>]",
+	"STUMINLH <Ws>, [<Xn|SP>]",
+	"LDADDH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDADDAH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDADDALH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDADDLH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDCLRH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDCLRAH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDCLRALH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDCLRLH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDEORH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDEORAH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDEORALH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDEORLH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSETH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSETAH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSETALH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSETLH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMAXH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMAXAH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMAXALH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMAXLH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMINH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMINAH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMINALH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMINLH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMAXH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMAXAH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMAXALH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMAXLH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMINH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMINAH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMINALH <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMINLH <Ws>, <Wt>, [<Xn|SP>]",
+	"SWPH <Ws>, <Wt>, [<Xn|SP>]",
+	"SWPAH <Ws>, <Wt>, [<Xn|SP>]",
+	"SWPALH <Ws>, <Wt>, [<Xn|SP>]",
+	"SWPLH <Ws>, <Wt>, [<Xn|SP>]",
+	"STADD <Ws>, [<Xn|SP>]",
+	"STADDL <Ws>, [<Xn|SP>]",
+	"STCLR <Ws>, [<Xn|SP>]",
+	"STCLRL <Ws>, [<Xn|SP>]",
+	"STEOR <Ws>, [<Xn|SP>]",
+	"STEORL <Ws>, [<Xn|SP>]",
+	"STSET <Ws>, [<Xn|SP>]",
+	"STSETL <Ws>, [<Xn|SP>]",
+	"STSMAX <Ws>, [<Xn|SP>]",
+	"STSMAXL <Ws>, [<Xn|SP>]",
+	"STSMIN <Ws>, [<Xn|SP>]",
+	"STSMINL <Ws>, [<Xn|SP>]",
+	"STUMAX <Ws>, [<Xn|SP>]",
+	"STUMAXL <Ws>, [<Xn|SP>]",
+	"STUMIN <Ws>, [<Xn|SP>]",
+	"STUMINL <Ws>, [<Xn|SP>]",
+	"LDADD <Ws>, <Wt>, [<Xn|SP>]",
+	"LDADDA <Ws>, <Wt>, [<Xn|SP>]",
+	"LDADDAL <Ws>, <Wt>, [<Xn|SP>]",
+	"LDADDL <Ws>, <Wt>, [<Xn|SP>]",
+	"LDCLR <Ws>, <Wt>, [<Xn|SP>]",
+	"LDCLRA <Ws>, <Wt>, [<Xn|SP>]",
+	"LDCLRAL <Ws>, <Wt>, [<Xn|SP>]",
+	"LDCLRL <Ws>, <Wt>, [<Xn|SP>]",
+	"LDEOR <Ws>, <Wt>, [<Xn|SP>]",
+	"LDEORA <Ws>, <Wt>, [<Xn|SP>]",
+	"LDEORAL <Ws>, <Wt>, [<Xn|SP>]",
+	"LDEORL <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSET <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSETA <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSETAL <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSETL <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMAX <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMAXA <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMAXAL <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMAXL <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMIN <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMINA <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMINAL <Ws>, <Wt>, [<Xn|SP>]",
+	"LDSMINL <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMAX <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMAXA <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMAXAL <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMAXL <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMIN <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMINA <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMINAL <Ws>, <Wt>, [<Xn|SP>]",
+	"LDUMINL <Ws>, <Wt>, [<Xn|SP>]",
+	"SWP <Ws>, <Wt>, [<Xn|SP>]",
+	"SWPA <Ws>, <Wt>, [<Xn|SP>]",
+	"SWPAL <Ws>, <Wt>, [<Xn|SP>]",
+	"SWPL <Ws>, <Wt>, [<Xn|SP>]",
+	"LDR <Rt>, <label>",
+	"LDRSW <Xt>, <label>",
+	"PRFM <prfop>, <label>",
+	"STNP <Rt>, <Rt2>, [<Xn|SP>{, #<imm>}]",
+	"LDNP <Rt>, <Rt2>, [<Xn|SP>{, #<imm>}]",
+	"STP <Rt>, <Rt2>, [<Xn|SP>], #<imm>",
+	"STP <Rt>, <Rt2>, [<Xn|SP>{, #<imm>}]",
+	"STP <Rt>, <Rt2>, [<Xn|SP>, #<imm>]!",
+	"LDP <Rt>, <Rt2>, [<Xn|SP>], #<imm>",
+	"LDP <Rt>, <Rt2>, [<Xn|SP>{, #<imm>}]",
+	"LDP <Rt>, <Rt2>, [<Xn|SP>, #<imm>]!",
+	"LDPSW <Rt>, <Rt2>, [<Xn|SP>], #<imm>",
+	"LDPSW <Rt>, <Rt2>, [<Xn|SP>{, #<imm>}]",
+	"LDPSW <Rt>, <Rt2>, [<Xn|SP>, #<imm>]!",
+	"STURB <Wt>, [<Xn|SP>{, #<simm>}]",
+	"STRB <Wt>, [<Xn|SP>], #<simm>",
+	"STRB <Wt>, [<Xn|SP>, #<simm>]!",
+	"STRB <Wt>, [<Xn|SP>{, #<pimm>}]",
+	"STRB <Wt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]",
+	"LDURB <Wt>, [<Xn|SP>{, #<simm>}]",
+	"LDRB <Wt>, [<Xn|SP>], #<simm>",
+	"LDRB <Wt>, [<Xn|SP>, #<simm>]!",
+	"LDRB <Wt>, [<Xn|SP>{, #<pimm>}]",
+	"LDRB <Wt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]",
+	"LDURSB <Xt>, [<Xn|SP>{, #<simm>}]",
+	"LDRSB <Xt>, [<Xn|SP>], #<simm>",
+	"LDRSB <Xt>, [<Xn|SP>, #<simm>]!",
+	"LDRSB <Xt>, [<Xn|SP>{, #<pimm>}]",
+	"LDRSB <Xt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]",
+	"LDURSB <Wt>, [<Xn|SP>{, #<simm>}]",
+	"LDRSB <Wt>, [<Xn|SP>], #<simm>",
+	"LDRSB <Wt>, [<Xn|SP>, #<simm>]!",
+	"LDRSB <Wt>, [<Xn|SP>{, #<pimm>}]",
+	"LDRSB <Wt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]",
+	"STURH <Wt>, [<Xn|SP>{, #<simm>}]",
+	"STRH <Wt>, [<Xn|SP>], #<simm>",
+	"STRH <Wt>, [<Xn|SP>, #<simm>]!",
+	"STRH <Wt>, [<Xn|SP>{, #<pimm>}]",
+	"STRH <Wt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]",
+	"LDURH <Wt>, [<Xn|SP>{, #<simm>}]",
+	"LDRH <Wt>, [<Xn|SP>], #<simm>",
+	"LDRH <Wt>, [<Xn|SP>, #<simm>]!",
+	"LDRH <Wt>, [<Xn|SP>{, #<pimm>}]",
+	"LDRH <Wt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]",
+	"LDURSH <Xt>, [<Xn|SP>{, #<simm>}]",
+	"LDRSH <Xt>, [<Xn|SP>], #<simm>",
+	"LDRSH <Xt>, [<Xn|SP>, #<simm>]!",
+	"LDRSH <Xt>, [<Xn|SP>{, #<pimm>}]",
+	"LDRSH <Xt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]",
+	"LDURSH <Wt>, [<Xn|SP>{, #<simm>}]",
+	"LDRSH <Wt>, [<Xn|SP>], #<simm>",
+	"LDRSH <Wt>, [<Xn|SP>, #<simm>]!",
+	"LDRSH <Wt>, [<Xn|SP>{, #<pimm>}]",
+	"LDRSH <Wt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]",
+	"STUR <Wt>, [<Xn|SP>{, #<simm>}]",
+	"STR <Wt>, [<Xn|SP>], #<simm>",
+	"STR <Wt>, [<Xn|SP>, #<simm>]!",
+	"STR <Wt>, [<Xn|SP>{, #<pimm>}]",
+	"STR <Wt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]",
+	"LDUR <Wt>, [<Xn|SP>{, #<simm>}]",
+	"LDR <Wt>, [<Xn|SP>], #<simm>",
+	"LDR <Wt>, [<Xn|SP>, #<simm>]!",
+	"LDR <Wt>, [<Xn|SP>{, #<pimm>}]",
+	"LDR <Wt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]",
+	"LDURSW <Xt>, [<Xn|SP>{, #<simm>}]",
+	"LDRSW <Xt>, [<Xn|SP>], #<simm>",
+	"LDRSW <Xt>, [<Xn|SP>, #<simm>]!",
+	"LDRSW <Xt>, [<Xn|SP>{, #<pimm>}]",
+	"LDRSW <Xt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]",
+	"STUR <Xt>, [<Xn|SP>{, #<simm>}]",
+	"STR <Xt>, [<Xn|SP>], #<simm>",
+	"STR <Xt>, [<Xn|SP>, #<simm>]!",
+	"STR <Xt>, [<Xn|SP>{, #<pimm>}]",
+	"STR <Xt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]",
+	"LDUR <Xt>, [<Xn|SP>{, #<simm>}]",
+	"LDR <Xt>, [<Xn|SP>], #<simm>",
+	"LDR <Xt>, [<Xn|SP>, #<simm>]!",
+	"LDR <Xt>, [<Xn|SP>{, #<pimm>}]",
+	"LDR <Xt>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]",
+	"PRFM <prfop>, [<Xn|SP>{, #<pimm>}]",
+	"PRFM <prfop>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]",
+	"LDR <St|Dt|Qt>, <label>",
+	"STNP <Ft>, <Ft2>, [<Xn|SP>{, #<imm>}]",
+	"LDNP <Ft>, <Ft2>, [<Xn|SP>{, #<imm>}]",
+	"STP <Ft>, <Ft2>, [<Xn|SP>], #<imm>",
+	"STP <Ft>, <Ft2>, [<Xn|SP>{, #<imm>}]",
+	"STP <Ft>, <Ft2>, [<Xn|SP>, #<imm>]!",
+	"LDP <Ft>, <Ft2>, [<Xn|SP>], #<imm>",
+	"LDP <Ft>, <Ft2>, [<Xn|SP>{, #<imm>}]",
+	"LDP <Ft>, <Ft2>, [<Xn|SP>, #<imm>]!",
+	"STUR <Ft>, [<Xn|SP>{, #<simm>}]",
+	"STR <Ft>, [<Xn|SP>], #<simm>",
+	"STR <Ft>, [<Xn|SP>, #<simm>]!",
+	"STR <Ft>, [<Xn|SP>{, #<pimm>}]",
+	"STR <Ft>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]",
+	"LDUR <Ft>, [<Xn|SP>{, #<simm>}]",
+	"LDR <Ft>, [<Xn|SP>], #<simm>",
+	"LDR <Ft>, [<Xn|SP>, #<simm>]!",
+	"LDR <Ft>, [<Xn|SP>{, #<pimm>}]",
+	"LDR <Ft>, [<Xn|SP>, <R><m>{, <extend> {<amount>}}]",
+	"LD1 { <Vt>.<T>, ... }, [<Xn|SP>]",
+	"LD1 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>",
+	"LD1 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>",
+	"ST1 { <Vt>.<T>, ... }, [<Xn|SP>]",
+	"ST1 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>",
+	"ST1 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>",
+	"LD1 { <Vt>.<T>, ... }, [<Xn|SP>]",
+	"LD1 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>",
+	"LD1 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>",
+	"ST1 { <Vt>.<T>, ... }, [<Xn|SP>]",
+	"ST1 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>",
+	"ST1 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>",
+	"LD1 { <Vt>.<T>, ... }, [<Xn|SP>]",
+	"LD1 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>",
+	"LD1 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>",
+	"ST1 { <Vt>.<T>, ... }, [<Xn|SP>]",
+	"ST1 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>",
+	"ST1 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>",
+	"LD1 { <Vt>.<T>, ... }, [<Xn|SP>]",
+	"LD1 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>",
+	"LD1 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>",
+	"ST1 { <Vt>.<T>, ... }, [<Xn|SP>]",
+	"ST1 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>",
+	"ST1 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>",
+	"LD2 { <Vt>.<T>, ... }, [<Xn|SP>]",
+	"LD2 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>",
+	"LD2 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>",
+	"ST2 { <Vt>.<T>, ... }, [<Xn|SP>]",
+	"ST2 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>",
+	"ST2 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>",
+	"LD3 { <Vt>.<T>, ... }, [<Xn|SP>]",
+	"LD3 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>",
+	"LD3 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>",
+	"ST3 { <Vt>.<T>, ... }, [<Xn|SP>]",
+	"ST3 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>",
+	"ST3 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>",
+	"LD4 { <Vt>.<T>, ... }, [<Xn|SP>]",
+	"LD4 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>",
+	"LD4 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>",
+	"ST4 { <Vt>.<T>, ... }, [<Xn|SP>]",
+	"ST4 { <Vt>.<T>, ... }, [<Xn|SP>], <imm>",
+	"ST4 { <Vt>.<T>, ... }, [<Xn|SP>], <Xm>",
+	"ADD <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"SUB <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"MUL <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"CMEQ <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"CMGT <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"CMGE <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"CMHI <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"CMHS <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"ADDP <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"SMAX <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"UMAX <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"SMIN <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"UMIN <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"AND <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"BIC <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"MOV <Vd>.<T>, <Vn>.<T>",
+	"ORR <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"ORN <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"EOR <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"BSL <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"BIT <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"BIF <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"FADD <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"FSUB <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"FMUL <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"FDIV <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"FMAX <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"FMIN <Vd>.<T>, <Vn>.<T>, <Vm>.<T>",
+	"CNT <Vd>.<T>, <Vn>.<T>",
+	"MVN <Vd>.<T>, <Vn>.<T>",
+	"REV64 <Vd>.<T>, <Vn>.<T>",
+	"ABS <Vd>.<T>, <Vn>.<T>",
+	"NEG <Vd>.<T>, <Vn>.<T>",
+	"ADDV <V><d>, <Vn>.<T>",
+	"SMAXV <V><d>, <Vn>.<T>",
+	"UMAXV <V><d>, <Vn>.<T>",
+	"SMINV <V><d>, <Vn>.<T>",
+	"UMINV <V><d>, <Vn>.<T>",
+	"DUP <Vd>.<T>, <Vn>.<Ts>[<index>]",
+	"DUP <Vd>.<T>, <R><n>",
+	"SMOV <R><d>, <Vn>.<Ts>[<index>]",
+	"MOV <R><d>, <Vn>.<Ts>[<index>]",
+	"UMOV <R><d>, <Vn>.<Ts>[<index>]",
+	"MOV <Vd>.<Ts>[<index>], <R><n>",
+	"INS <Vd>.<Ts>[<index>], <R><n>",
+	"MOV <Vd>.<Ts>[<index1>], <Vn>.<Ts>[<index2>]",
+	"INS <Vd>.<Ts>[<index1>], <Vn>.<Ts>[<index2>]",
+	"MOVI <Vd>.<T>, #<imm8>",
+	"MOVI <Vd>.<T>, #<imm8>{, LSL #<amount>}",
+	"MVNI <Vd>.<T>, #<imm8>{, LSL #<amount>}",
+	"MOVI <Vd>.<T>, #<imm8>{, LSL #<amount>}",
+	"MVNI <Vd>.<T>, #<imm8>{, LSL #<amount>}",
+	"MOVI <Dd>, #<imm>",
+	"MOVI <Vd>.2D, #<imm>",
+	"MOV <Rd>, <Rm>",
+	"MVN <Rd>, <Rm>{, <shift> #<amount>}",
+	"TST <Rn>, <Rm>{, <shift> #<amount>}",
+	"AND <Rd>, <Rn>, <Rm>{, <shift> #<amount>}",
+	"BIC <Rd>, <Rn>, <Rm>{, <shift> #<amount>}",
+	"ORR <Rd>, <Rn>, <Rm>{, <shift> #<amount>}",
+	"ORN <Rd>, <Rn>, <Rm>{, <shift> #<amount>}",
+	"EOR <Rd>, <Rn>, <Rm>{, <shift> #<amount>}",
+	"EON <Rd>, <Rn>, <Rm>{, <shift> #<amount>}",
+	"ANDS <Rd>, <Rn>, <Rm>{, <shift> #<amount>}",
+	"BICS <Rd>, <Rn>, <Rm>{, <shift> #<amount>}",
+	"CMN <Rn>, <Rm>{, <shift> #<amount>}",
+	"CMP <Rn>, <Rm>{, <shift> #<amount>}",
+	"NEG <Rd>, <Rm>{, <shift> #<amount>}",
+	"NEGS <Rd>, <Rm>{, <shift> #<amount>}",
+	"ADD <Rd>, <Rn>, <Rm>{, <shift> #<amount>}",
+	"ADDS <Rd>, <Rn>, <Rm>{, <shift> #<amount>}",
+	"SUB <Rd>, <Rn>, <Rm>{, <shift> #<amount>}",
+	"SUBS <Rd>, <Rn>, <Rm>{, <shift> #<amount>}",
+	"CMN <Rn|SP>, <R><m>{, <extend> {#<amount>}}",
+	"CMP <Rn|SP>, <R><m>{, <extend> {#<amount>}}",
+	"ADD <Rd|SP>, <Rn|SP>, <R><m>{, <extend> {#<amount>}}",
+	"ADDS <Rd>, <Rn|SP>, <R><m>{, <extend> {#<amount>}}",
+	"SUB <Rd|SP>, <Rn|SP>, <R><m>{, <extend> {#<amount>}}",
+	"SUBS <Rd>, <Rn|SP>, <R><m>{, <extend> {#<amount>}}",
+	"NGC <Rd>, <Rm>",
+	"NGCS <Rd>, <Rm>",
+	"ADC <Rd>, <Rn>, <Rm>",
+	"ADCS <Rd>, <Rn>, <Rm>",
+	"SBC <Rd>, <Rn>, <Rm>",
+	"SBCS <Rd>, <Rn>, <Rm>",
+	"CCMN <Rn>, <Rm>, #<nzcv>, <cond>",
+	"CCMN <Rn>, #<imm>, #<nzcv>, <cond>",
+	"CCMP <Rn>, <Rm>, #<nzcv>, <cond>",
+	"CCMP <Rn>, #<imm>, #<nzcv>, <cond>",
+	"CSEL <Rd>, <Rn>, <Rm>, <cond>",
+	"CSET <Rd>, <cond>",
+	"CINC <Rd>, <Rn>, <cond>",
+	"CSINC <Rd>, <Rn>, <Rm>, <cond>",
+	"CSETM <Rd>, <cond>",
+	"CINV <Rd>, <Rn>, <cond>",
+	"CSINV <Rd>, <Rn>, <Rm>, <cond>",
+	"CNEG <Rd>, <Rn>, <cond>",
+	"CSNEG <Rd>, <Rn>, <Rm>, <cond>",
+	"RBIT <Rd>, <Rn>",
+	"REV16 <Rd>, <Rn>",
+	"REV <Wd>, <Wn>",
+	"REV32 <Xd>, <Xn>",
+	"REV <Xd>, <Xn>",
+	"CLZ <Rd>, <Rn>",
+	"CLS <Rd>, <Rn>",
+	"PACIA <Xd>, <Xn|SP>",
+	"PACIZA <Xd>",
+	"PACIB <Xd>, <Xn|SP>",
+	"PACIZB <Xd>",
+	"PACDA <Xd>, <Xn|SP>",
+	"PACDZA <Xd>",
+	"PACDB <Xd>, <Xn|SP>",
+	"PACDZB <Xd>",
+	"AUTIA <Xd>, <Xn|SP>",
+	"AUTIZA <Xd>",
+	"AUTIB <Xd>, <Xn|SP>",
+	"AUTIZB <Xd>",
+	"AUTDA <Xd>, <Xn|SP>",
+	"AUTDZA <Xd>",
+	"AUTDB <Xd>, <Xn|SP>",
+	"AUTDZB <Xd>",
+	"XPACI <Xd>",
+	"XPACD <Xd>",
+	"UDIV <Rd>, <Rn>, <Rm>",
+	"SDIV <Rd>, <Rn>, <Rm>",
+	"LSL <Rd>, <Rn>, <Rm>",
+	"LSR <Rd>, <Rn>, <Rm>",
+	"ASR <Rd>, <Rn>, <Rm>",
+	"ROR <Rd>, <Rn>, <Rm>",
+	"MUL <Rd>, <Rn>, <Rm>",
+	"MADD <Rd>, <Rn>, <Rm>, <Ra>",
+	"MNEG <Rd>, <Rn>, <Rm>",
+	"MSUB <Rd>, <Rn>, <Rm>, <Ra>",
+	"SMULL <Xd>, <Wn>, <Wm>",
+	"SMADDL <Xd>, <Wn>, <Wm>, <Xa>",
+	"SMNEGL <Xd>, <Wn>, <Wm>",
+	"SMSUBL <Xd>, <Wn>, <Wm>, <Xa>",
+	"UMULL <Xd>, <Wn>, <Wm>",
+	"UMADDL <Xd>, <Wn>, <Wm>, <Xa>",
+	"UMNEGL <Xd>, <Wn>, <Wm>",
+	"UMSUBL <Xd>, <Wn>, <Wm>, <Xa>",
+	"SMULH <Xd>, <Xn>, <Xm>",
+	"UMULH <Xd>, <Xn>, <Xm>",
+	"PACGA <Xd>, <Xn>, <Xm|SP>",
+	"LDRAA <Xt>, [<Xn|SP>{, #<simm>}]",
+	"LDRAA <Xt>, [<Xn|SP>, #<simm>]!",
+	"LDRAB <Xt>, [<Xn|SP>{, #<simm>}]",
+	"LDRAB <Xt>, [<Xn|SP>, #<simm>]!",
+	"ADD <Zd>.<T>, <Zn>.<T>, <Zm>.<T>",
+	"SUB <Zd>.<T>, <Zn>.<T>, <Zm>.<T>",
+	"SQADD <Zd>.<T>, <Zn>.<T>, <Zm>.<T>",
+	"UQADD <Zd>.<T>, <Zn>.<T>, <Zm>.<T>",
+	"SQSUB <Zd>.<T>, <Zn>.<T>, <Zm>.<T>",
+	"UQSUB <Zd>.<T>, <Zn>.<T>, <Zm>.<T>",
+	"ADD <Zdn>.<T>, <Pg>/M, <Zdn>.<T>, <Zm>.<T>",
+	"SUB <Zdn>.<T>, <Pg>/M, <Zdn>.<T>, <Zm>.<T>",
+	"SUBR <Zdn>.<T>, <Pg>/M, <Zdn>.<T>, <Zm>.<T>",
+	"AND <Zd>.D, <Zn>.D, <Zm>.D",
+	"MOV <Zd>.D, <Zn>.D",
+	"ORR <Zd>.D, <Zn>.D, <Zm>.D",
+	"EOR <Zd>.D, <Zn>.D, <Zm>.D",
+	"BIC <Zd>.D, <Zn>.D, <Zm>.D",
+	"EOR3 <Zdn>.D, <Zdn>.D, <Zm>.D, <Zk>.D",
+	"BCAX <Zdn>.D, <Zdn>.D, <Zm>.D, <Zk>.D",
+	"BSL <Zdn>.D, <Zdn>.D, <Zm>.D, <Zk>.D",
+	"CNTB <Xd>",
+	"CNTB <Xd>, <pattern>",
+	"CNTB <Xd>, <pattern>, MUL #<imm>",
+	"INCB <Xd>",
+	"INCB <Xd>, <pattern>",
+	"INCB <Xd>, <pattern>, MUL #<imm>",
+	"DECB <Xd>",
+	"DECB <Xd>, <pattern>",
+	"DECB <Xd>, <pattern>, MUL #<imm>",
+	"CNTH <Xd>",
+	"CNTH <Xd>, <pattern>",
+	"CNTH <Xd>, <pattern>, MUL #<imm>",
+	"INCH <Xd>",
+	"INCH <Xd>, <pattern>",
+	"INCH <Xd>, <pattern>, MUL #<imm>",
+	"DECH <Xd>",
+	"DECH <Xd>, <pattern>",
+	"DECH <Xd>, <pattern>, MUL #<imm>",
+	"CNTW <Xd>",
+	"CNTW <Xd>, <pattern>",
+	"CNTW <Xd>, <pattern>, MUL #<imm>",
+	"INCW <Xd>",
+	"INCW <Xd>, <pattern>",
+	"INCW <Xd>, <pattern>, MUL #<imm>",
+	"DECW <Xd>",
+	"DECW <Xd>, <pattern>",
+	"DECW <Xd>, <pattern>, MUL #<imm>",
+	"CNTD <Xd>",
+	"CNTD <Xd>, <pattern>",
+	"CNTD <Xd>, <pattern>, MUL #<imm>",
+	"INCD <Xd>",
+	"INCD <Xd>, <pattern>",
+	"INCD <Xd>, <pattern>, MUL #<imm>",
+	"DECD <Xd>",
+	"DECD <Xd>, <pattern>",
+	"DECD <Xd>, <pattern>, MUL #<imm>",
+	"MOV <Zd>.<T>, #<imm>{, <shift>}",
+	"DUP <Zd>.<T>, #<imm>{, <shift>}",
+	"MOV <Zd>.<T>, <R><n|SP>",
+	"DUP <Zd>.<T>, <R><n|SP>",
+	"MOV <Zd>.<T>, <Pg>/M, <Zn>.<T>",
+	"SEL <Zd>.<T>, <Pg>, <Zn>.<T>, <Zm>.<T>",
+	"CMPHS <Pd>.<T>, <Pg>/Z, <Zn>.<T>, <Zm>.<T>",
+	"CMPHI <Pd>.<T>, <Pg>/Z, <Zn>.<T>, <Zm>.<T>",
+	"CMPGE <Pd>.<T>, <Pg>/Z, <Zn>.<T>, <Zm>.<T>",
+	"CMPGT <Pd>.<T>, <Pg>/Z, <Zn>.<T>, <Zm>.<T>",
+	"CMPEQ <Pd>.<T>, <Pg>/Z, <Zn>.<T>, <Zm>.<T>",
+	"CMPNE <Pd>.<T>, <Pg>/Z, <Zn>.<T>, <Zm>.<T>",
+	"PTRUE <Pd>.<T>",
+	"PTRUE <Pd>.<T>, <pattern>",
+	"PTRUES <Pd>.<T>",
+	"PTRUES <Pd>.<T>, <pattern>",
+	"PFALSE <Pd>.B",
+	"WHILELT <Pd>.<T>, <R><n>, <R><m>",
+	"WHILELE <Pd>.<T>, <R><n>, <R><m>",
+	"WHILELO <Pd>.<T>, <R><n>, <R><m>",
+	"WHILELS <Pd>.<T>, <R><n>, <R><m>",
+	"FADD <Zd>.<T>, <Zn>.<T>, <Zm>.<T>",
+	"FSUB <Zd>.<T>, <Zn>.<T>, <Zm>.<T>",
+	"FMUL <Zd>.<T>, <Zn>.<T>, <Zm>.<T>",
+	"LD1B {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>{, #<imm>, MUL VL}]",
+	"LD1B {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>, <Xm>{, LSL #<amount>}]",
+	"ST1B {<Zt>.<T>}, <Pg>, [<Xn|SP>{, #<imm>, MUL VL}]",
+	"ST1B {<Zt>.<T>}, <Pg>, [<Xn|SP>, <Xm>{, LSL #<amount>}]",
+	"LD1H {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>{, #<imm>, MUL VL}]",
+	"LD1H {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>, <Xm>{, LSL #<amount>}]",
+	"ST1H {<Zt>.<T>}, <Pg>, [<Xn|SP>{, #<imm>, MUL VL}]",
+	"ST1H {<Zt>.<T>}, <Pg>, [<Xn|SP>, <Xm>{, LSL #<amount>}]",
+	"LD1W {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>{, #<imm>, MUL VL}]",
+	"LD1W {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>, <Xm>{, LSL #<amount>}]",
+	"ST1W {<Zt>.<T>}, <Pg>, [<Xn|SP>{, #<imm>, MUL VL}]",
+	"ST1W {<Zt>.<T>}, <Pg>, [<Xn|SP>, <Xm>{, LSL #<amount>}]",
+	"LD1D {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>{, #<imm>, MUL VL}]",
+	"LD1D {<Zt>.<T>}, <Pg>/Z, [<Xn|SP>, <Xm>{, LSL #<amount>}]",
+	"ST1D {<Zt>.<T>}, <Pg>, [<Xn|SP>{, #<imm>, MUL VL}]",
+	"ST1D {<Zt>.<T>}, <Pg>, [<Xn|SP>, <Xm>{, LSL #<amount>}]",
+	"LDR <Zt>, [<Xn|SP>{, #<imm>, MUL VL}]",
+	"LDR <Pt>, [<Xn|SP>{, #<imm>, MUL VL}]",
+	"STR <Zt>, [<Xn|SP>{, #<imm>, MUL VL}]",
+	"STR <Pt>, [<Xn|SP>{, #<imm>, MUL VL}]",
+}
@@ -0,0 +1,713 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Arm64map constructs the A64 opcode map from the instruction set CSV file,
+// arm64.csv, or from ARM's Machine Readable Architecture (MRA) XML specification.
+//
+// Usage:
+//
+//	arm64map [-fmt=format] [-v] arm64.csv
+//	arm64map [-fmt=format] [-v] dir
+//
+// The directory dir is the ISA_A64 directory of an MRA release,
+// which holds one XML file for each instruction or alias.
+//
+// The known output formats are:
+//
+//	text (default) - print one line for each encoding
+//	decoder - print decoding tables for the arm64asm package
+//	csv - print lines for arm64.csv
+//	json - print every encoding in JSON form, for use by other tools
+//
+// The arm64asm tables are generated from arm64.csv (see arm64asm/Makefile).
+// Reading the XML, the decoder and csv outputs cover every encoding whose
+// operands map to an existing arm64asm argument kind (see argName),
+// so a new extension can be added by generating its lines with -fmt=csv
+// and merging them into arm64.csv. Encodings that cannot be mapped
+// are omitted; the -v flag lists them on standard error.
+package main
+
+import (
+	"bufio"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+var (
+	format  = flag.String("fmt", "text", "output format: text, decoder, csv, json")
+	verbose = flag.Bool("v", false, "report encodings omitted from decoder output")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: arm64map [-fmt=format] [-v] arm64.csv|dir\n")
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("arm64map: ")
+
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() != 1 {
+		usage()
+	}
+
+	var print func([]*Inst)
+	switch *format {
+	default:
+		log.Fatalf("unknown output format %q", *format)
+	case "text":
+		print = printText
+	case "decoder":
+		print = printDecoder
+	case "csv":
+		print = printCSV
+	case "json":
+		print = printJSON
+	}
+
+	read := readDir
+	if strings.HasSuffix(flag.Arg(0), ".csv") {
+		read = readCSV
+	}
+	insts, err := read(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	print(insts)
+}
+
+// An Inst is a single instruction encoding.
+type Inst struct {
+	Name      string   // encoding name, like ADD_32_addsub_imm
+	Op        string   // mnemonic, like ADD
+	Mask      uint32   // bits fixed by the encoding
+	Value     uint32   // values of the fixed bits
+	Syntax    string   // assembler template, like ADD <Wd|WSP>, <Wn|WSP>, #<imm>{, <shift>}
+	Fields    []Field  // named variable fields
+	Cond      []string // field constraints not expressible as mask and value, like Rn != 11111
+	Alias     bool     // encoding is a preferred alias of another instruction
+	AliasCond string   // condition under which the alias is preferred
+	Arch      []string // architecture versions or features required, like FEAT_LSE
+	Desc      string   `json:",omitempty"` // short description of the mnemonic, from arm64.csv
+
+	csv  bool     // read from arm64.csv
+	args []string // arm64asm argument kinds, if csv
+}
+
+// A Field is a named field of an instruction encoding.
+type Field struct {
+	Name  string
+	HiBit int
+	Width int
+}
+
+// Field returns the named field of inst and reports whether it exists.
+func (inst *Inst) Field(name string) (Field, bool) {
+	for _, f := range inst.Fields {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return Field{}, false
+}
+
+// XML structure of an instruction section file.
+
+type xmlSection struct {
+	XMLName xml.Name         `xml:"instructionsection"`
+	ID      string           `xml:"id,attr"`
+	Type    string           `xml:"type,attr"`
+	Docvars []xmlDocvar      `xml:"docvars>docvar"`
+	Classes []xmlClass       `xml:"classes>iclass"`
+	Arch    []xmlArchVariant `xml:"classes>classesintro>arch_variants>arch_variant"`
+}
+
+type xmlDocvar struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:"value,attr"`
+}
+
+type xmlArchVariant struct {
+	Name    string `xml:"name,attr"`
+	Feature string `xml:"feature,attr"`
+}
+
+type xmlClass struct {
+	Name      string           `xml:"name,attr"`
+	Arch      []xmlArchVariant `xml:"arch_variants>arch_variant"`
+	Diagram   xmlDiagram       `xml:"regdiagram"`
+	Encodings []xmlEncoding    `xml:"encoding"`
+}
+
+type xmlDiagram struct {
+	PSName string   `xml:"psname,attr"`
+	Boxes  []xmlBox `xml:"box"`
+}
+
+type xmlBox struct {
+	HiBit int       `xml:"hibit,attr"`
+	Width int       `xml:"width,attr"`
+	Name  string    `xml:"name,attr"`
+	Cells []xmlCell `xml:"c"`
+}
+
+type xmlCell struct {
+	Colspan int    `xml:"colspan,attr"`
+	Text    string `xml:",chardata"`
+}
+
+type xmlEncoding struct {
+	Name     string           `xml:"name,attr"`
+	Docvars  []xmlDocvar      `xml:"docvars>docvar"`
+	Arch     []xmlArchVariant `xml:"arch_variants>arch_variant"`
+	Boxes    []xmlBox         `xml:"box"`
+	Template xmlTemplate      `xml:"asmtemplate"`
+	Equiv    []xmlEquiv       `xml:"equivalent_to"`
+}
+
+type xmlTemplate struct {
+	Items []struct {
+		Text string `xml:",chardata"`
+	} `xml:",any"`
+}
+
+func (t xmlTemplate) String() string {
+	var s []string
+	for _, item := range t.Items {
+		s = append(s, item.Text)
+	}
+	return strings.Join(strings.Fields(strings.Join(s, "")), " ")
+}
+
+type xmlEquiv struct {
+	AliasCond string `xml:"aliascond"`
+}
+
+// readDir reads the instruction sections in dir and returns their encodings,
+// with aliases first so that a first-match decoder prefers them.
+func readDir(dir string) ([]*Inst, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.xml"))
+	if err != nil {
+		return nil, err
+	}
+	sort.Strings(files)
+	var aliases, insts []*Inst
+	for _, file := range files {
+		data, err := ioutil.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		list, err := parseSection(data)
+		if err != nil {
+			return nil, fmt.Errorf("parsing %s: %v", file, err)
+		}
+		for _, inst := range list {
+			if inst.Alias {
+				aliases = append(aliases, inst)
+			} else {
+				insts = append(insts, inst)
+			}
+		}
+	}
+	return append(aliases, insts...), nil
+}
+
+// readCSV reads the encodings listed in the CSV file,
+// in the format described at the top of arm64.csv.
+func readCSV(file string) ([]*Inst, error) {
+	// Skip leading # comment lines.
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	b := bufio.NewReader(f)
+	for {
+		c, err := b.ReadByte()
+		if err != nil {
+			break
+		}
+		if c == '#' {
+			b.ReadBytes('\n')
+			continue
+		}
+		b.UnreadByte()
+		break
+	}
+	r := csv.NewReader(b)
+	r.FieldsPerRecord = 6
+	table, err := r.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("parsing %s: %v", file, err)
+	}
+	var insts []*Inst
+	for _, row := range table {
+		mask, err1 := strconv.ParseUint(row[0], 0, 32)
+		value, err2 := strconv.ParseUint(row[1], 0, 32)
+		if err1 != nil || err2 != nil || value&^mask != 0 {
+			return nil, fmt.Errorf("%s: invalid mask and value %s %s", file, row[0], row[1])
+		}
+		insts = append(insts, &Inst{
+			Op:     row[2],
+			Mask:   uint32(mask),
+			Value:  uint32(value),
+			Syntax: row[4],
+			Desc:   row[5],
+			csv:    true,
+			args:   strings.Fields(row[3]),
+		})
+	}
+	return insts, nil
+}
+
+// parseSection parses a single instruction section file.
+// It returns nil for files that are not instruction sections,
+// such as the encoding index and shared pseudocode.
+func parseSection(data []byte) ([]*Inst, error) {
+	if !strings.Contains(string(data), "<instructionsection") {
+		return nil, nil
+	}
+	var sec xmlSection
+	if err := xml.Unmarshal(data, &sec); err != nil {
+		return nil, err
+	}
+	var insts []*Inst
+	for _, class := range sec.Classes {
+		for _, enc := range class.Encodings {
+			inst := &Inst{
+				Name:   enc.Name,
+				Syntax: enc.Template.String(),
+				Alias:  sec.Type == "alias",
+			}
+			if err := inst.addBoxes(class.Diagram.Boxes); err != nil {
+				return nil, fmt.Errorf("%s: %v", enc.Name, err)
+			}
+			if err := inst.addBoxes(enc.Boxes); err != nil {
+				return nil, fmt.Errorf("%s: %v", enc.Name, err)
+			}
+			if inst.Alias {
+				inst.Op = docvar(enc.Docvars, "alias_mnemonic")
+			}
+			if inst.Op == "" {
+				inst.Op = docvar(enc.Docvars, "mnemonic")
+			}
+			if inst.Op == "" {
+				inst.Op = docvar(sec.Docvars, "mnemonic")
+			}
+			if inst.Op == "" {
+				inst.Op = strings.Fields(inst.Syntax + " ?")[0]
+			}
+			// B.cond is B with a condition operand.
+			if i := strings.Index(inst.Op, "."); i >= 0 {
+				inst.Op = inst.Op[:i]
+			}
+			for _, e := range enc.Equiv {
+				inst.AliasCond = strings.Join(strings.Fields(e.AliasCond), " ")
+			}
+			for _, list := range [][]xmlArchVariant{sec.Arch, class.Arch, enc.Arch} {
+				for _, a := range list {
+					if a.Feature != "" {
+						inst.Arch = append(inst.Arch, a.Feature)
+					} else if a.Name != "" {
+						inst.Arch = append(inst.Arch, a.Name)
+					}
+				}
+			}
+			insts = append(insts, inst)
+		}
+	}
+	return insts, nil
+}
+
+func docvar(list []xmlDocvar, key string) string {
+	for _, d := range list {
+		if d.Key == key {
+			return d.Value
+		}
+	}
+	return ""
+}
+
+// addBoxes adds the fixed bits and named fields described by boxes to inst.
+// A later box for a given field replaces an earlier one, so the encoding's
+// boxes refine those of its class diagram.
+func (inst *Inst) addBoxes(boxes []xmlBox) error {
+	for _, b := range boxes {
+		width := b.Width
+		if width == 0 {
+			width = 1
+		}
+		if b.HiBit > 31 || b.HiBit-width+1 < 0 {
+			return fmt.Errorf("box %s at bit %d width %d out of range", b.Name, b.HiBit, width)
+		}
+		fieldMask := uint32(1<<uint(width)-1) << uint(b.HiBit-width+1)
+		inst.Mask &^= fieldMask
+		inst.Value &^= fieldMask
+		if b.Name != "" {
+			inst.setField(Field{b.Name, b.HiBit, width})
+		}
+
+		bit := b.HiBit
+		for _, c := range b.Cells {
+			span := c.Colspan
+			if span == 0 {
+				span = 1
+			}
+			text := strings.TrimSpace(c.Text)
+			text = strings.TrimSuffix(strings.TrimPrefix(text, "("), ")")
+			switch {
+			case len(text) == span && strings.Trim(text, "01") == "":
+				for i := 0; i < span; i++ {
+					m := uint32(1) << uint(bit-i)
+					inst.Mask |= m
+					if text[i] == '1' {
+						inst.Value |= m
+					}
+				}
+			case strings.HasPrefix(text, "!="):
+				name := b.Name
+				if name == "" {
+					name = fmt.Sprintf("bits<%d:%d>", bit, bit-span+1)
+				}
+				inst.Cond = append(inst.Cond, name+" "+text)
+			}
+			bit -= span
+		}
+	}
+	return nil
+}
+
+func (inst *Inst) setField(f Field) {
+	for i, old := range inst.Fields {
+		if old.Name == f.Name {
+			inst.Fields[i] = f
+			return
+		}
+	}
+	inst.Fields = append(inst.Fields, f)
+}
+
+// Operands returns the operands in inst's assembler template,
+// split at the commas that separate them. A post-index memory operand
+// such as [<Xn|SP>], #<simm> is kept together as a single operand,
+// and a placeholder in the mnemonic, like the <cond> in B.<cond>,
+// is returned as a leading operand.
+func (inst *Inst) Operands() []string {
+	var ops []string
+	i := strings.Index(inst.Syntax, " ")
+	if i < 0 {
+		i = len(inst.Syntax)
+	}
+	for mn := inst.Syntax[:i]; ; {
+		j := strings.Index(mn, "<")
+		k := strings.Index(mn, ">")
+		if j < 0 || k < j {
+			break
+		}
+		ops = append(ops, mn[j:k+1])
+		mn = mn[k+1:]
+	}
+	if i == len(inst.Syntax) {
+		return ops
+	}
+	depth := 0
+	start := i + 1
+	s := inst.Syntax
+	for j := start; j < len(s); j++ {
+		switch s[j] {
+		case '[', '{', '(':
+			depth++
+		case ']', '}', ')':
+			depth--
+		case ',':
+			if depth == 0 {
+				ops = append(ops, strings.TrimSpace(s[start:j]))
+				start = j + 1
+			}
+		}
+	}
+	ops = append(ops, strings.TrimSpace(s[start:]))
+	for j := 0; j+1 < len(ops); j++ {
+		if strings.HasSuffix(ops[j], "]") && strings.HasPrefix(ops[j], "[") {
+			ops[j] += ", " + ops[j+1]
+			ops = append(ops[:j+1], ops[j+2:]...)
+			break
+		}
+	}
+	return ops
+}
+
+// argName returns the arm64asm argument kind (the name of an arg_ constant
+// without its prefix) that decodes operand op of inst.
+// It returns the empty string for operands with no corresponding kind.
+func argName(inst *Inst, op string) string {
+	switch op {
+	case "<Wd>", "<Xd>":
+		return "Rd"
+	case "<Wn>", "<Xn>":
+		return "Rn"
+	case "<Wm>", "<Xm>":
+		return "Rm"
+	case "<Wa>", "<Xa>":
+		return "Ra"
+	case "<Wd|WSP>", "<Xd|SP>":
+		return "Rd_SP"
+	case "<Wn|WSP>", "<Xn|SP>":
+		return "Rn_SP"
+	case "<Ws>":
+		return "Ws"
+	case "<Wt>":
+		return "Wt"
+	case "<Xt>":
+		return "Xt"
+	case "<Xt2>":
+		return "Xt2"
+	case "#<imm>{, <shift>}":
+		return "imm12_shift"
+	case "#<imm>{, LSL #<shift>}":
+		return "imm16_hw"
+	case "<cond>":
+		if f, ok := inst.Field("cond"); ok && f.HiBit == 3 {
+			return "cond_0"
+		}
+		return "cond_12"
+	case "<label>":
+		switch {
+		case has(inst, "imm26"):
+			return "label26"
+		case has(inst, "imm14"):
+			return "label14"
+		case has(inst, "imm19"):
+			return "label19"
+		case has(inst, "immhi") && inst.Value>>31 == 1:
+			return "label_adrp"
+		case has(inst, "immhi"):
+			return "label_adr"
+		}
+	case "[<Xn|SP>]", "[<Xn|SP>{,#0}]":
+		return "mem_Xn_SP"
+	case "[<Xn|SP>{, #<pimm>}]":
+		return "mem_uimm12"
+	case "[<Xn|SP>{, #<simm>}]", "[<Xn|SP>{, #<imm>}]":
+		return memImm(inst, "offset")
+	case "[<Xn|SP>, #<simm>]!", "[<Xn|SP>, #<imm>]!":
+		return memImm(inst, "preindex")
+	case "[<Xn|SP>], #<simm>", "[<Xn|SP>], #<imm>":
+		return memImm(inst, "postindex")
+	case "[<Xn|SP>, (<Wm>|<Xm>){, <extend> {<amount>}}]":
+		return "mem_extend"
+	}
+	return ""
+}
+
+// memImm returns the argument kind for an immediate-offset memory operand
+// with the given addressing mode, which depends on the width of the offset.
+func memImm(inst *Inst, mode string) string {
+	switch {
+	case has(inst, "imm7"):
+		return "mem_simm7_" + mode
+	case has(inst, "imm9"):
+		return "mem_simm9_" + mode
+	}
+	return ""
+}
+
+func has(inst *Inst, field string) bool {
+	_, ok := inst.Field(field)
+	return ok
+}
+
+// decoderArgs returns the arm64asm argument kinds for inst's operands.
+// If an operand has no corresponding kind, decoderArgs returns the operand
+// and ok=false instead.
+// The kinds of an encoding read from arm64.csv are those listed there.
+func decoderArgs(inst *Inst) (args []string, bad string, ok bool) {
+	if inst.csv {
+		return inst.args, "", true
+	}
+	for _, op := range inst.Operands() {
+		a := argName(inst, op)
+		if a == "" {
+			return nil, op, false
+		}
+		args = append(args, a)
+	}
+	return args, "", true
+}
+
+func printText(insts []*Inst) {
+	for _, inst := range insts {
+		kind := "inst"
+		if inst.Alias {
+			kind = "alias"
+		}
+		fmt.Printf("%#08x %#08x %s %s %q", inst.Mask, inst.Value, kind, inst.Name, inst.Syntax)
+		if len(inst.Cond) > 0 {
+			fmt.Printf(" cond=%q", strings.Join(inst.Cond, "; "))
+		}
+		if inst.AliasCond != "" {
+			fmt.Printf(" when=%q", inst.AliasCond)
+		}
+		if len(inst.Arch) > 0 {
+			fmt.Printf(" arch=%s", strings.Join(inst.Arch, ","))
+		}
+		fmt.Printf("\n")
+	}
+}
+
+// An entry is an encoding to be listed in the decoder tables,
+// along with the arm64asm argument kinds for its operands.
+type entry struct {
+	inst *Inst
+	args []string
+}
+
+// decoderEntries returns the encodings in insts that the decoder tables
+// can list, omitting those whose operands or constraints it cannot express.
+func decoderEntries(insts []*Inst) []entry {
+	var entries []entry
+	omitted := 0
+	for _, inst := range insts {
+		if inst.Alias && inst.AliasCond != "" && inst.AliasCond != "Unconditionally" {
+			// The condition is stated in prose and pseudocode,
+			// so it cannot be checked by the table alone.
+			if *verbose {
+				log.Printf("omit alias %s: condition %s", inst.Name, inst.AliasCond)
+			}
+			omitted++
+			continue
+		}
+		if len(inst.Cond) > 0 {
+			if *verbose {
+				log.Printf("omit %s: constraint %s", inst.Name, strings.Join(inst.Cond, "; "))
+			}
+			omitted++
+			continue
+		}
+		args, bad, ok := decoderArgs(inst)
+		if !ok {
+			if *verbose {
+				log.Printf("omit %s: operand %s", inst.Name, bad)
+			}
+			omitted++
+			continue
+		}
+		entries = append(entries, entry{inst, args})
+	}
+	if omitted > 0 {
+		log.Printf("omitted %d of %d encodings", omitted, len(insts))
+	}
+	return entries
+}
+
+// printDecoder implements the -fmt=decoder mode,
+// printing arm64asm/tables.go.
+func printDecoder(insts []*Inst) {
+	entries := decoderEntries(insts)
+	desc := map[string]string{}
+	for _, f := range entries {
+		if desc[f.inst.Op] == "" {
+			desc[f.inst.Op] = f.inst.Desc
+		}
+	}
+	var names []string
+	for op := range desc {
+		names = append(names, op)
+	}
+	sort.Strings(names)
+
+	fmt.Printf("package arm64asm\n\n")
+	fmt.Printf("const (\n")
+	fmt.Printf("\t_ Op = iota\n")
+	for _, op := range names {
+		fmt.Printf("\t%s\n", op)
+	}
+	fmt.Printf(")\n")
+
+	fmt.Printf("\nvar opstr = [...]string{\n")
+	for _, op := range names {
+		fmt.Printf("\t%s: %q,\n", op, op)
+	}
+	fmt.Printf("}\n")
+
+	fmt.Printf("\nvar opdesc = [...]string{\n")
+	for _, op := range names {
+		fmt.Printf("\t%s: %q,\n", op, desc[op])
+	}
+	fmt.Printf("}\n")
+
+	fmt.Printf("\nvar instFormats = [...]instFormat{\n")
+	for _, f := range entries {
+		fmt.Printf("\t{%#08x, %#08x, %s, instArgs{", f.inst.Mask, f.inst.Value, f.inst.Op)
+		for i, a := range f.args {
+			if i > 0 {
+				fmt.Printf(", ")
+			}
+			fmt.Printf("arg_%s", a)
+		}
+		fmt.Printf("}}, // %s\n", f.inst.Syntax)
+	}
+	fmt.Printf("}\n")
+
+	fmt.Printf("\nvar instSyntax = [...]string{\n")
+	for _, f := range entries {
+		fmt.Printf("\t%q,\n", f.inst.Syntax)
+	}
+	fmt.Printf("}\n")
+}
+
+// printCSV implements the -fmt=csv mode, printing the lines
+// of arm64.csv for the encodings the decoder tables can list.
+func printCSV(insts []*Inst) {
+	w := csv.NewWriter(os.Stdout)
+	for _, f := range decoderEntries(insts) {
+		inst := f.inst
+		w.Write([]string{
+			fmt.Sprintf("%#08x", inst.Mask),
+			fmt.Sprintf("%#08x", inst.Value),
+			inst.Op,
+			strings.Join(f.args, " "),
+			inst.Syntax,
+			inst.Desc,
+		})
+	}
+	w.Flush()
+	if err := w.Error(); err != nil {
+		log.Fatal(err)
+	}
+}
+
+// printJSON implements the -fmt=json mode.
+// It prints an array with one object for each encoding, holding the
+// fields of Inst along with the arm64asm decoder names for its operands,
+// when they are known.
+func printJSON(insts []*Inst) {
+	type jsonInst struct {
+		*Inst
+		Args []string `json:",omitempty"`
+	}
+	var out []jsonInst
+	for _, inst := range insts {
+		args, _, _ := decoderArgs(inst)
+		out = append(out, jsonInst{inst, args})
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "\t")
+	if err := enc.Encode(out); err != nil {
+		log.Fatal(err)
+	}
+}
@@ -0,0 +1,312 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// addImm is an abridged copy of add_addsub_imm.xml from the MRA release.
+const addImm = `<?xml version="1.0" encoding="utf-8"?>
+<instructionsection id="ADD_addsub_imm" title="ADD (immediate) -- A64" type="instruction">
+  <docvars>
+    <docvar key="instr-class" value="general" />
+    <docvar key="mnemonic" value="ADD" />
+  </docvars>
+  <classes>
+    <iclass name="Not setting the condition flags" oneof="2" id="iclass_general" no_encodings="2" isa="A64">
+      <regdiagram form="32" psname="aarch64/instrs/integer/arithmetic/add-sub/immediate/ADD_32_addsub_imm" tworows="1">
+        <box hibit="31" name="sf" usename="1">
+          <c></c>
+        </box>
+        <box hibit="30" name="op" settings="1">
+          <c>0</c>
+        </box>
+        <box hibit="29" name="S" settings="1">
+          <c>0</c>
+        </box>
+        <box hibit="28" width="6" settings="6">
+          <c>1</c>
+          <c>0</c>
+          <c>0</c>
+          <c>0</c>
+          <c>1</c>
+          <c>0</c>
+        </box>
+        <box hibit="22" name="sh" usename="1">
+          <c></c>
+        </box>
+        <box hibit="21" width="12" name="imm12" usename="1">
+          <c colspan="12"></c>
+        </box>
+        <box hibit="9" width="5" name="Rn" usename="1">
+          <c colspan="5"></c>
+        </box>
+        <box hibit="4" width="5" name="Rd" usename="1">
+          <c colspan="5"></c>
+        </box>
+      </regdiagram>
+      <encoding name="ADD_32_addsub_imm" oneofinclass="2" oneof="2" label="32-bit" bitdiffs="sf == 0">
+        <docvars>
+          <docvar key="mnemonic" value="ADD" />
+        </docvars>
+        <box hibit="31" width="1" name="sf">
+          <c>0</c>
+        </box>
+        <asmtemplate><text>ADD  </text><a link="sa_wd_wsp" hover="Destination general-purpose register or WSP">&lt;Wd|WSP&gt;</a><text>, </text><a link="sa_wn_wsp">&lt;Wn|WSP&gt;</a><text>, #</text><a link="sa_imm">&lt;imm&gt;</a><text>{, </text><a link="sa_shift">&lt;shift&gt;</a><text>}</text></asmtemplate>
+      </encoding>
+      <encoding name="ADD_64_addsub_imm" oneofinclass="2" oneof="2" label="64-bit" bitdiffs="sf == 1">
+        <docvars>
+          <docvar key="mnemonic" value="ADD" />
+        </docvars>
+        <box hibit="31" width="1" name="sf">
+          <c>1</c>
+        </box>
+        <asmtemplate><text>ADD  </text><a link="sa_xd_sp">&lt;Xd|SP&gt;</a><text>, </text><a link="sa_xn_sp">&lt;Xn|SP&gt;</a><text>, #</text><a link="sa_imm">&lt;imm&gt;</a><text>{, </text><a link="sa_shift">&lt;shift&gt;</a><text>}</text></asmtemplate>
+      </encoding>
+    </iclass>
+  </classes>
+</instructionsection>
+`
+
+// bCond is an abridged copy of b_cond.xml.
+const bCond = `<?xml version="1.0" encoding="utf-8"?>
+<instructionsection id="B_only_condbranch" title="B.cond -- A64" type="instruction">
+  <classes>
+    <iclass name="19-bit signed PC-relative branch offset" oneof="1" id="iclass_general">
+      <regdiagram form="32" psname="aarch64/instrs/branch/conditional/cond/B_only_condbranch">
+        <box hibit="31" width="7" settings="7">
+          <c>0</c><c>1</c><c>0</c><c>1</c><c>0</c><c>1</c><c>0</c>
+        </box>
+        <box hibit="24" name="o1" settings="1">
+          <c>0</c>
+        </box>
+        <box hibit="23" width="19" name="imm19" usename="1">
+          <c colspan="19"></c>
+        </box>
+        <box hibit="4" name="o0" settings="1">
+          <c>0</c>
+        </box>
+        <box hibit="3" width="4" name="cond" usename="1">
+          <c colspan="4"></c>
+        </box>
+      </regdiagram>
+      <encoding name="B_only_condbranch" oneofinclass="1" oneof="1" label="">
+        <docvars>
+          <docvar key="mnemonic" value="B" />
+        </docvars>
+        <asmtemplate><text>B.</text><a link="sa_cond">&lt;cond&gt;</a><text>  </text><a link="sa_label">&lt;label&gt;</a></asmtemplate>
+      </encoding>
+    </iclass>
+  </classes>
+</instructionsection>
+`
+
+// ldaddLSE is an abridged copy of ldadd.xml, showing the architecture
+// requirement and a should-be-zero field constraint.
+const ldaddLSE = `<?xml version="1.0" encoding="utf-8"?>
+<instructionsection id="LDADD" title="LDADD, LDADDA, LDADDAL, LDADDL -- A64" type="instruction">
+  <classes>
+    <classesintro count="1">
+      <arch_variants>
+        <arch_variant name="ARMv8.1" feature="FEAT_LSE" />
+      </arch_variants>
+    </classesintro>
+    <iclass name="No offset" oneof="1" id="iclass_general">
+      <regdiagram form="32" psname="aarch64/instrs/memory/atomicops/ld/LDADD_32_memop">
+        <box hibit="31" width="2" name="size" usename="1">
+          <c>1</c><c>0</c>
+        </box>
+        <box hibit="29" width="3" settings="3">
+          <c>1</c><c>1</c><c>1</c>
+        </box>
+        <box hibit="26" name="V" settings="1">
+          <c>0</c>
+        </box>
+        <box hibit="25" width="2" settings="2">
+          <c>0</c><c>0</c>
+        </box>
+        <box hibit="23" name="A" settings="1">
+          <c>0</c>
+        </box>
+        <box hibit="22" name="R" settings="1">
+          <c>0</c>
+        </box>
+        <box hibit="21" settings="1">
+          <c>1</c>
+        </box>
+        <box hibit="20" width="5" name="Rs" usename="1">
+          <c colspan="5"></c>
+        </box>
+        <box hibit="15" name="o3" settings="1">
+          <c>0</c>
+        </box>
+        <box hibit="14" width="3" name="opc" settings="3">
+          <c>0</c><c>0</c><c>0</c>
+        </box>
+        <box hibit="11" width="2" settings="2">
+          <c>0</c><c>0</c>
+        </box>
+        <box hibit="9" width="5" name="Rn" usename="1">
+          <c colspan="5"></c>
+        </box>
+        <box hibit="4" width="5" name="Rt" usename="1">
+          <c colspan="5">!= 11111</c>
+        </box>
+      </regdiagram>
+      <encoding name="LDADD_32_memop" oneofinclass="1" oneof="1" label="32-bit LDADD">
+        <docvars>
+          <docvar key="mnemonic" value="LDADD" />
+        </docvars>
+        <asmtemplate><text>LDADD  </text><a>&lt;Ws&gt;</a><text>, </text><a>&lt;Wt&gt;</a><text>, [</text><a>&lt;Xn|SP&gt;</a><text>]</text></asmtemplate>
+      </encoding>
+    </iclass>
+  </classes>
+</instructionsection>
+`
+
+func TestParseSection(t *testing.T) {
+	insts, err := parseSection([]byte(addImm))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(insts) != 2 {
+		t.Fatalf("parsed %d encodings, want 2", len(insts))
+	}
+	want := []struct {
+		mask, value uint32
+		syntax      string
+		args        []string
+	}{
+		{0xff800000, 0x11000000, "ADD <Wd|WSP>, <Wn|WSP>, #<imm>{, <shift>}", []string{"Rd_SP", "Rn_SP", "imm12_shift"}},
+		{0xff800000, 0x91000000, "ADD <Xd|SP>, <Xn|SP>, #<imm>{, <shift>}", []string{"Rd_SP", "Rn_SP", "imm12_shift"}},
+	}
+	for i, inst := range insts {
+		w := want[i]
+		if inst.Op != "ADD" || inst.Mask != w.mask || inst.Value != w.value || inst.Syntax != w.syntax {
+			t.Errorf("%s: %s %#08x %#08x %q, want ADD %#08x %#08x %q", inst.Name, inst.Op, inst.Mask, inst.Value, inst.Syntax, w.mask, w.value, w.syntax)
+		}
+		args, bad, ok := decoderArgs(inst)
+		if !ok || !reflect.DeepEqual(args, w.args) {
+			t.Errorf("%s: decoderArgs = %v, %q, %v, want %v", inst.Name, args, bad, ok, w.args)
+		}
+		if f, ok := inst.Field("imm12"); !ok || f.HiBit != 21 || f.Width != 12 {
+			t.Errorf("%s: Field(imm12) = %v, %v", inst.Name, f, ok)
+		}
+	}
+}
+
+func TestParseCondBranch(t *testing.T) {
+	insts, err := parseSection([]byte(bCond))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(insts) != 1 {
+		t.Fatalf("parsed %d encodings, want 1", len(insts))
+	}
+	inst := insts[0]
+	if inst.Op != "B" || inst.Mask != 0xff000010 || inst.Value != 0x54000000 {
+		t.Errorf("%s: %s %#08x %#08x, want B 0xff000010 0x54000000", inst.Name, inst.Op, inst.Mask, inst.Value)
+	}
+	args, _, ok := decoderArgs(inst)
+	if want := []string{"cond_0", "label19"}; !ok || !reflect.DeepEqual(args, want) {
+		t.Errorf("decoderArgs = %v, %v, want %v", args, ok, want)
+	}
+}
+
+func TestParseConstraint(t *testing.T) {
+	insts, err := parseSection([]byte(ldaddLSE))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(insts) != 1 {
+		t.Fatalf("parsed %d encodings, want 1", len(insts))
+	}
+	inst := insts[0]
+	if inst.Mask != 0xffe0fc00 || inst.Value != 0xb8200000 {
+		t.Errorf("%s: %#08x %#08x, want 0xffe0fc00 0xb8200000", inst.Name, inst.Mask, inst.Value)
+	}
+	if want := []string{"Rt != 11111"}; !reflect.DeepEqual(inst.Cond, want) {
+		t.Errorf("Cond = %q, want %q", inst.Cond, want)
+	}
+	if want := []string{"FEAT_LSE"}; !reflect.DeepEqual(inst.Arch, want) {
+		t.Errorf("Arch = %q, want %q", inst.Arch, want)
+	}
+}
+
+func TestOperands(t *testing.T) {
+	tests := []struct {
+		syntax string
+		ops    []string
+	}{
+		{"NOP", nil},
+		{"RET {<Xn>}", []string{"{<Xn>}"}},
+		{"LDR <Xt>, [<Xn|SP>], #<simm>", []string{"<Xt>", "[<Xn|SP>], #<simm>"}},
+		{"LDR <Xt>, [<Xn|SP>, (<Wm>|<Xm>){, <extend> {<amount>}}]", []string{"<Xt>", "[<Xn|SP>, (<Wm>|<Xm>){, <extend> {<amount>}}]"}},
+		{"B.<cond> <label>", []string{"<cond>", "<label>"}},
+	}
+	for _, tt := range tests {
+		inst := &Inst{Syntax: tt.syntax}
+		if ops := inst.Operands(); !reflect.DeepEqual(ops, tt.ops) {
+			t.Errorf("Operands(%q) = %q, want %q", tt.syntax, ops, tt.ops)
+		}
+	}
+}
+
+const testCSV = `# comment
+#
+"0xff800000","0x11000000","ADD","Rd_SP Rn_SP imm12_shift","ADD <Wd|WSP>, <Wn|WSP>, #<imm>{, <shift>}","Add"
+"0xffffffff","0xd503201f","NOP","","NOP","No Operation"
+`
+
+func TestReadCSV(t *testing.T) {
+	dir, err := ioutil.TempDir("", "arm64map")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	file := filepath.Join(dir, "arm64.csv")
+	if err := ioutil.WriteFile(file, []byte(testCSV), 0666); err != nil {
+		t.Fatal(err)
+	}
+	insts, err := readCSV(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(insts) != 2 {
+		t.Fatalf("read %d encodings, want 2", len(insts))
+	}
+	want := []struct {
+		op          string
+		mask, value uint32
+		desc        string
+		args        []string
+	}{
+		{"ADD", 0xff800000, 0x11000000, "Add", []string{"Rd_SP", "Rn_SP", "imm12_shift"}},
+		{"NOP", 0xffffffff, 0xd503201f, "No Operation", nil},
+	}
+	for i, inst := range insts {
+		w := want[i]
+		if inst.Op != w.op || inst.Mask != w.mask || inst.Value != w.value || inst.Desc != w.desc {
+			t.Errorf("%s: %#08x %#08x %q, want %s %#08x %#08x %q", inst.Op, inst.Mask, inst.Value, inst.Desc, w.op, w.mask, w.value, w.desc)
+		}
+		args, bad, ok := decoderArgs(inst)
+		if !ok || len(args) != len(w.args) || len(args) > 0 && !reflect.DeepEqual(args, w.args) {
+			t.Errorf("%s: decoderArgs = %v, %q, %v, want %v", inst.Op, args, bad, ok, w.args)
+		}
+	}
+
+	bad := filepath.Join(dir, "bad.csv")
+	if err := ioutil.WriteFile(bad, []byte(`"0xff","0x100","ADD","","ADD","Add"`+"\n"), 0666); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := readCSV(bad); err == nil {
+		t.Errorf("readCSV accepted value with bits outside mask")
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import "strconv"
+
+// Helpers for the formatters, which append to a byte slice
+// instead of calling fmt, to avoid allocating for each argument.
+
+// appendHex appends v in hex with a 0x prefix, like %#x,
+// padded with zeros to at least min digits.
+func appendHex(dst []byte, v uint64, min int) []byte {
+	dst = append(dst, "0x"...)
+	var buf [16]byte
+	digits := strconv.AppendUint(buf[:0], v, 16)
+	for i := len(digits); i < min; i++ {
+		dst = append(dst, '0')
+	}
+	return append(dst, digits...)
+}
+
+// appendSignedHex appends v in hex with a sign and a 0x prefix, like %+#x.
+func appendSignedHex(dst []byte, v int64) []byte {
+	if v < 0 {
+		return appendHex(append(dst, '-'), uint64(-v), 0)
+	}
+	return appendHex(append(dst, '+'), uint64(v), 0)
+}
+
+// appendHexInt appends v in hex with a 0x prefix and a sign only if negative,
+// like %#x applied to a signed integer.
+func appendHexInt(dst []byte, v int64) []byte {
+	if v < 0 {
+		return appendHex(append(dst, '-'), uint64(-v), 0)
+	}
+	return appendHex(dst, uint64(v), 0)
+}
+
+// appendLower appends s converted to lower case.
+// Like the rest of the formatting, it assumes s is ASCII.
+func appendLower(dst []byte, s string) []byte {
+	n := len(dst)
+	return toLower(append(dst, s...), n)
+}
+
+// toLower converts b[n:] to lower case in place and returns b.
+func toLower(b []byte, n int) []byte {
+	for i := n; i < len(b); i++ {
+		if c := b[i]; 'A' <= c && c <= 'Z' {
+			b[i] = c + 'a' - 'A'
+		}
+	}
+	return b
+}
+
+// toUpper converts b[n:] to upper case in place and returns b.
+func toUpper(b []byte, n int) []byte {
+	for i := n; i < len(b); i++ {
+		if c := b[i]; 'a' <= c && c <= 'z' {
+			b[i] = c - ('a' - 'A')
+		}
+	}
+	return b
+}
+
+// appendFloat appends f, a float32 or float64 value as given by bitSize,
+// in the shortest form that represents it exactly, like %v.
+func appendFloat(dst []byte, f float64, bitSize int) []byte {
+	return strconv.AppendFloat(dst, f, 'g', -1, bitSize)
+}
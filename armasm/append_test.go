@@ -0,0 +1,69 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+)
+
+func TestAppendHex(t *testing.T) {
+	for _, v := range []int64{0, 1, -1, 0x7f, -0x80, 0x12345678, -1 << 31, 1<<32 - 1} {
+		if out, want := string(appendHexInt(nil, v)), fmt.Sprintf("%#x", v); out != want {
+			t.Errorf("appendHexInt(%d) = %q, want %q", v, out, want)
+		}
+		if out, want := string(appendSignedHex(nil, v)), fmt.Sprintf("%+#x", v); out != want {
+			t.Errorf("appendSignedHex(%d) = %q, want %q", v, out, want)
+		}
+		if v >= 0 {
+			if out, want := string(appendHex(nil, uint64(v), 8)), fmt.Sprintf("%#08x", v); out != want {
+				t.Errorf("appendHex(%d, 8) = %q, want %q", v, out, want)
+			}
+		}
+	}
+}
+
+func TestAppendCase(t *testing.T) {
+	if out := string(appendLower([]byte("x "), "APSR_nzcv")); out != "x apsr_nzcv" {
+		t.Errorf("appendLower = %q", out)
+	}
+	if out := string(toUpper([]byte("x apsr_NZCV"), 2)); out != "x APSR_NZCV" {
+		t.Errorf("toUpper = %q", out)
+	}
+}
+
+func TestFormatAllocs(t *testing.T) {
+	var insts []Inst
+	for _, x := range []uint32{
+		0xe0810002, // ADD R0, R1, R2
+		0xe7910102, // LDR R0, [R1, R2, LSL #2]
+		0xe8bd8010, // POP {R4,PC}
+		0x1a00019c, // B.NE PC+0x670
+		0xe3a004ff, // MOV R0, #0xff000000
+		0xecbd8b10, // VPOP {D8-D15}
+	} {
+		var buf [4]byte
+		binary.LittleEndian.PutUint32(buf[:], x)
+		inst, err := Decode(buf[:], ModeARM)
+		if err != nil {
+			t.Fatal(err)
+		}
+		insts = append(insts, inst)
+	}
+
+	buf := make([]byte, 0, 256)
+	for _, inst := range insts {
+		if n := testing.AllocsPerRun(100, func() { _ = inst.String() }); n > 1 {
+			t.Errorf("%v: String allocates %v times, want 1", inst, n)
+		}
+		if n := testing.AllocsPerRun(100, func() { buf = AppendGNUSyntax(buf[:0], inst) }); n > 0 {
+			t.Errorf("%v: AppendGNUSyntax allocates %v times, want 0", inst, n)
+		}
+		if n := testing.AllocsPerRun(100, func() { buf = AppendGoSyntax(buf[:0], inst, 0x1000, nil, nil) }); n > 0 {
+			t.Errorf("%v: AppendGoSyntax allocates %v times, want 0", inst, n)
+		}
+	}
+}
@@ -0,0 +1,313 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// An Arch is an ARM architecture version.
+type Arch uint8
+
+const (
+	ArchAny Arch = iota // no restriction
+	ARMv4
+	ARMv4T
+	ARMv5T
+	ARMv5TE
+	ARMv5TEJ
+	ARMv6
+	ARMv6K
+	ARMv6T2
+	ARMv7
+	ARMv6M
+	ARMv7M
+	ARMv7EM
+	ARMv8
+)
+
+var archName = [...]string{
+	ArchAny:  "any",
+	ARMv4:    "ARMv4",
+	ARMv4T:   "ARMv4T",
+	ARMv5T:   "ARMv5T",
+	ARMv5TE:  "ARMv5TE",
+	ARMv5TEJ: "ARMv5TEJ",
+	ARMv6:    "ARMv6",
+	ARMv6K:   "ARMv6K",
+	ARMv6T2:  "ARMv6T2",
+	ARMv7:    "ARMv7",
+	ARMv6M:   "ARMv6-M",
+	ARMv7M:   "ARMv7-M",
+	ARMv7EM:  "ARMv7E-M",
+	ARMv8:    "ARMv8",
+}
+
+func (a Arch) String() string {
+	if int(a) < len(archName) {
+		return archName[a]
+	}
+	return fmt.Sprintf("Arch(%d)", int(a))
+}
+
+// A Profile is an ARM architecture profile.
+type Profile uint8
+
+const (
+	ProfileAny Profile = iota // no restriction
+	ProfileA                  // application profile
+	ProfileR                  // real-time profile
+	ProfileM                  // microcontroller profile, such as the Cortex-M processors
+)
+
+var profileName = [...]string{
+	ProfileAny: "any",
+	ProfileA:   "A",
+	ProfileR:   "R",
+	ProfileM:   "M",
+}
+
+func (p Profile) String() string {
+	if int(p) < len(profileName) {
+		return profileName[p]
+	}
+	return fmt.Sprintf("Profile(%d)", int(p))
+}
+
+// An FPArch is a floating-point (VFP) architecture version.
+type FPArch uint8
+
+const (
+	FPAny      FPArch = iota // no restriction
+	FPNone                   // no floating-point unit
+	VFPv2                    // VFPv1 and VFPv2
+	VFPv3                    // VFPv3 with 32 double-precision registers
+	VFPv3D16                 // VFPv3 with 16 double-precision registers
+	VFPv4                    // VFPv4 with 32 double-precision registers
+	VFPv4D16                 // VFPv4 with 16 double-precision registers
+	FPARMv8                  // ARMv8 floating point with 32 double-precision registers
+	FPARMv8D16               // ARMv8 floating point with 16 double-precision registers
+)
+
+var fpArchName = [...]string{
+	FPAny:      "any",
+	FPNone:     "none",
+	VFPv2:      "VFPv2",
+	VFPv3:      "VFPv3",
+	VFPv3D16:   "VFPv3-D16",
+	VFPv4:      "VFPv4",
+	VFPv4D16:   "VFPv4-D16",
+	FPARMv8:    "FP-ARMv8",
+	FPARMv8D16: "FP-ARMv8-D16",
+}
+
+func (a FPArch) String() string {
+	if int(a) < len(fpArchName) {
+		return fpArchName[a]
+	}
+	return fmt.Sprintf("FPArch(%d)", int(a))
+}
+
+// A feature is an architecture extension that introduced some instructions.
+type feature uint16
+
+const (
+	featV4T  feature = 1 << iota // BX
+	featV5T                      // BLX, CLZ, BKPT
+	featV5E                      // DSP multiplies, saturating arithmetic, LDRD, STRD, PLD
+	featV5J                      // BXJ
+	featV6                       // media instructions, REV, LDREX, STREX
+	featV6K                      // byte, halfword, and doubleword exclusives, CLREX, hints
+	featV6T2                     // MOVW, MOVT, bit fields, RBIT, MLS, unprivileged halfword loads
+	featV7                       // barriers, PLI, DBG, ERET (Virtualization Extensions)
+	featARM                      // the ARM instruction set
+)
+
+// archFeatures lists the features of each architecture version.
+var archFeatures = [...]feature{
+	ARMv4:    featARM,
+	ARMv4T:   featARM | featV4T,
+	ARMv5T:   featARM | featV4T | featV5T,
+	ARMv5TE:  featARM | featV4T | featV5T | featV5E,
+	ARMv5TEJ: featARM | featV4T | featV5T | featV5E | featV5J,
+	ARMv6:    featARM | featV4T | featV5T | featV5E | featV5J | featV6,
+	ARMv6K:   featARM | featV4T | featV5T | featV5E | featV5J | featV6 | featV6K,
+	ARMv6T2:  featARM | featV4T | featV5T | featV5E | featV5J | featV6 | featV6T2,
+	ARMv7:    featARM | featV4T | featV5T | featV5E | featV5J | featV6 | featV6K | featV6T2 | featV7,
+	ARMv6M:   featV4T | featV5T | featV6 | featV7,
+	ARMv7M:   featV4T | featV5T | featV6 | featV6K | featV6T2 | featV7,
+	ARMv7EM:  featV4T | featV5T | featV5E | featV6 | featV6K | featV6T2 | featV7,
+	ARMv8:    featARM | featV4T | featV5T | featV5E | featV5J | featV6 | featV6K | featV6T2 | featV7,
+}
+
+// opFeature gives the feature that introduced each base (EQ) opcode.
+// Opcodes not listed are part of ARMv4.
+var opFeature = map[Op]feature{
+	BX_EQ: featV4T,
+
+	BLX: featV5T, BLX_EQ: featV5T, CLZ_EQ: featV5T, BKPT_EQ: featV5T,
+
+	LDRD_EQ: featV5E, STRD_EQ: featV5E,
+	PLD:     featV5E,
+	QADD_EQ: featV5E, QSUB_EQ: featV5E, QDADD_EQ: featV5E, QDSUB_EQ: featV5E,
+	SMLABB_EQ: featV5E, SMLABT_EQ: featV5E, SMLATB_EQ: featV5E, SMLATT_EQ: featV5E,
+	SMLALBB_EQ: featV5E, SMLALBT_EQ: featV5E, SMLALTB_EQ: featV5E, SMLALTT_EQ: featV5E,
+	SMLAWB_EQ: featV5E, SMLAWT_EQ: featV5E,
+	SMULBB_EQ: featV5E, SMULBT_EQ: featV5E, SMULTB_EQ: featV5E, SMULTT_EQ: featV5E,
+	SMULWB_EQ: featV5E, SMULWT_EQ: featV5E,
+
+	BXJ_EQ: featV5J,
+
+	LDREX_EQ: featV6, STREX_EQ: featV6,
+	REV_EQ: featV6, REV16_EQ: featV6, REVSH_EQ: featV6,
+	SETEND: featV6, SEL_EQ: featV6, UMAAL_EQ: featV6,
+	PKHBT_EQ: featV6, PKHTB_EQ: featV6,
+	SSAT_EQ: featV6, SSAT16_EQ: featV6, USAT_EQ: featV6, USAT16_EQ: featV6,
+	SXTAB_EQ: featV6, SXTAB16_EQ: featV6, SXTAH_EQ: featV6, SXTB_EQ: featV6, SXTB16_EQ: featV6, SXTH_EQ: featV6,
+	UXTAB_EQ: featV6, UXTAB16_EQ: featV6, UXTAH_EQ: featV6, UXTB_EQ: featV6, UXTB16_EQ: featV6, UXTH_EQ: featV6,
+	QADD16_EQ: featV6, QADD8_EQ: featV6, QASX_EQ: featV6, QSAX_EQ: featV6, QSUB16_EQ: featV6, QSUB8_EQ: featV6,
+	SADD16_EQ: featV6, SADD8_EQ: featV6, SASX_EQ: featV6, SSAX_EQ: featV6, SSUB16_EQ: featV6, SSUB8_EQ: featV6,
+	SHADD16_EQ: featV6, SHADD8_EQ: featV6, SHASX_EQ: featV6, SHSAX_EQ: featV6, SHSUB16_EQ: featV6, SHSUB8_EQ: featV6,
+	UADD16_EQ: featV6, UADD8_EQ: featV6, UASX_EQ: featV6, USAX_EQ: featV6, USUB16_EQ: featV6, USUB8_EQ: featV6,
+	UHADD16_EQ: featV6, UHADD8_EQ: featV6, UHASX_EQ: featV6, UHSAX_EQ: featV6, UHSUB16_EQ: featV6, UHSUB8_EQ: featV6,
+	UQADD16_EQ: featV6, UQADD8_EQ: featV6, UQASX_EQ: featV6, UQSAX_EQ: featV6, UQSUB16_EQ: featV6, UQSUB8_EQ: featV6,
+	USAD8_EQ: featV6, USADA8_EQ: featV6,
+	SMLAD_EQ: featV6, SMLAD_X_EQ: featV6, SMLALD_EQ: featV6, SMLALD_X_EQ: featV6,
+	SMLSD_EQ: featV6, SMLSD_X_EQ: featV6, SMLSLD_EQ: featV6, SMLSLD_X_EQ: featV6,
+	SMMLA_EQ: featV6, SMMLA_R_EQ: featV6, SMMLS_EQ: featV6, SMMLS_R_EQ: featV6, SMMUL_EQ: featV6, SMMUL_R_EQ: featV6,
+	SMUAD_EQ: featV6, SMUAD_X_EQ: featV6, SMUSD_EQ: featV6, SMUSD_X_EQ: featV6,
+
+	LDREXB_EQ: featV6K, LDREXH_EQ: featV6K, LDREXD_EQ: featV6K,
+	STREXB_EQ: featV6K, STREXH_EQ: featV6K, STREXD_EQ: featV6K,
+	CLREX: featV6K, SEV_EQ: featV6K, WFE_EQ: featV6K, WFI_EQ: featV6K, YIELD_EQ: featV6K,
+
+	MOVW_EQ: featV6T2, MOVT_EQ: featV6T2,
+	BFC_EQ: featV6T2, BFI_EQ: featV6T2, SBFX_EQ: featV6T2, UBFX_EQ: featV6T2,
+	RBIT_EQ: featV6T2, MLS_EQ: featV6T2,
+	LDRHT_EQ: featV6T2, LDRSBT_EQ: featV6T2, LDRSHT_EQ: featV6T2, STRHT_EQ: featV6T2,
+
+	DMB: featV7, DSB: featV7, ISB: featV7, PLI: featV7, PLD_W: featV7, DBG_EQ: featV7, ERET_EQ: featV7,
+}
+
+// notProfileM lists the base opcodes of the A- and R-profile instructions
+// that M-profile processors do not implement.
+var notProfileM = map[Op]bool{
+	BXJ_EQ:  true,
+	ERET_EQ: true,
+	SETEND:  true,
+}
+
+// A Decoder decodes instructions for a particular architecture,
+// rejecting the instructions that architecture does not implement.
+// The zero Decoder, like a nil *Decoder, accepts every instruction
+// that Decode does.
+//
+// Setting Profile to ProfileM rejects the encodings M-profile processors
+// do not implement even when the exact architecture version is unknown:
+// the ARM instruction set entirely, so that misaligned or corrupt
+// regions of Cortex-M firmware surface as errors rather than plausible
+// ARM code, and, in Thumb code, the A- and R-profile instructions
+// such as BXJ, SETEND, and accesses to SPSR, FPSID, and FPEXC.
+// An M-profile Arch (ARMv6M, ARMv7M, or ARMv7EM) implies ProfileM.
+type Decoder struct {
+	Arch    Arch    // architecture version
+	FP      FPArch  // floating-point architecture
+	Profile Profile // architecture profile
+}
+
+// profile returns the profile d decodes for.
+func (d *Decoder) profile() Profile {
+	switch d.Arch {
+	case ARMv6M, ARMv7M, ARMv7EM:
+		return ProfileM
+	}
+	return d.Profile
+}
+
+// Decode decodes the leading bytes in src as a single instruction,
+// as the package-level Decode does, but returns an error if the
+// instruction is not available on d's architecture.
+func (d *Decoder) Decode(src []byte, mode Mode) (Inst, error) {
+	inst, err := Decode(src, mode)
+	if err != nil || d == nil {
+		return inst, err
+	}
+	if err := d.check(inst, mode); err != nil {
+		return Inst{}, err
+	}
+	return inst, nil
+}
+
+// check returns an error if inst, decoded in mode,
+// is not available on d's architecture.
+func (d *Decoder) check(inst Inst, mode Mode) error {
+	base := inst.Op &^ 15
+	if inst.Enc>>28 == 0xF {
+		base = inst.Op // unconditional instruction
+	}
+	if d.profile() == ProfileM {
+		if mode == ModeARM {
+			return fmt.Errorf("M profile does not support ARM mode")
+		}
+		if notProfileM[base] {
+			return fmt.Errorf("%v not available on M profile", inst.Op)
+		}
+		for _, arg := range inst.Args {
+			switch arg {
+			case SPSR, FPSID, FPEXC:
+				return fmt.Errorf("%v: %v not available on M profile", inst.Op, arg)
+			}
+		}
+	}
+	if d.Arch != ArchAny && int(d.Arch) < len(archFeatures) {
+		have := archFeatures[d.Arch]
+		if mode == ModeARM && have&featARM == 0 {
+			return fmt.Errorf("%v does not support ARM mode", d.Arch)
+		}
+		if need := opFeature[base]; have&need != need {
+			return fmt.Errorf("%v not supported by %v", inst.Op, d.Arch)
+		}
+	}
+	if d.FP != FPAny && strings.HasPrefix(base.String(), "V") {
+		if err := d.checkFP(inst); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkFP returns an error if the floating-point instruction inst
+// is not available on d's floating-point architecture.
+func (d *Decoder) checkFP(inst Inst) error {
+	switch d.FP {
+	case FPNone:
+		return fmt.Errorf("%v requires floating point", inst.Op)
+	case VFPv2:
+		// VFPv3 added fixed-point conversions,
+		// VMOV immediate, and half-precision conversions.
+		name := inst.Op.String()
+		if strings.Contains(name, "_FX") || strings.HasPrefix(name, "VCVTB") || strings.HasPrefix(name, "VCVTT") {
+			return fmt.Errorf("%v not supported by %v", inst.Op, d.FP)
+		}
+		if strings.HasPrefix(name, "VMOV") {
+			for _, arg := range inst.Args {
+				if _, ok := arg.(Imm); ok {
+					return fmt.Errorf("%v immediate not supported by %v", inst.Op, d.FP)
+				}
+			}
+		}
+	case VFPv3D16, VFPv4D16, FPARMv8D16:
+		for _, arg := range inst.Args {
+			if r, ok := arg.(Reg); ok && D16 <= r && r <= D31 {
+				return fmt.Errorf("%v: %v not available on %v", inst.Op, r, d.FP)
+			}
+			if list, ok := arg.(RegRange); ok && list.First.Class() == ClassDouble && list.First.Num()+int(list.Count) > 16 {
+				return fmt.Errorf("%v: %v not available on %v", inst.Op, list, d.FP)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+var decoderTests = []struct {
+	enc  uint32
+	arch Arch
+	fp   FPArch
+	ok   bool
+}{
+	{0xe1a00001, ARMv4, FPAny, true},       // MOV R0, R1
+	{0xe1a00001, ARMv6M, FPAny, false},     // no ARM mode
+	{0xe16f0f11, ARMv4T, FPAny, false},     // CLZ R0, R1
+	{0xe16f0f11, ARMv5T, FPAny, true},      //
+	{0xfa000000, ARMv4T, FPAny, false},     // BLX PC+8
+	{0xfa000000, ARMv5TE, FPAny, true},     //
+	{0xe1d01f9f, ARMv6, FPAny, false},      // LDREXB R1, [R0]
+	{0xe1d01f9f, ARMv6K, FPAny, true},      //
+	{0xe3010234, ARMv6K, FPAny, false},     // MOVW R0, #0x1234
+	{0xe3010234, ARMv6T2, FPAny, true},     //
+	{0xf57ff05b, ARMv6T2, FPAny, false},    // DMB ISH
+	{0xf57ff05b, ARMv7, FPAny, true},       //
+	{0xee700ba1, ArchAny, FPNone, false},   // VADD.F64 D16, D16, D17
+	{0xee700ba1, ArchAny, VFPv3D16, false}, //
+	{0xee700ba1, ArchAny, VFPv3, true},     //
+	{0xeeb70a00, ArchAny, VFPv2, false},    // VMOV.F32 S0, #1.0
+	{0xeeb70a00, ArchAny, VFPv3D16, true},  //
+	{0xed2d8b10, ArchAny, VFPv3D16, true},  // VPUSH {D8-D15}
+	{0xed720b20, ArchAny, VFPv3D16, false}, // VLDMDB R2!, {D16-D31}
+	{0xed720b20, ArchAny, VFPv3, true},     //
+}
+
+func TestDecoder(t *testing.T) {
+	for _, tt := range decoderTests {
+		var src [4]byte
+		binary.LittleEndian.PutUint32(src[:], tt.enc)
+		if _, err := Decode(src[:], ModeARM); err != nil {
+			t.Errorf("Decode(%#08x): %v", tt.enc, err)
+			continue
+		}
+		d := &Decoder{Arch: tt.arch, FP: tt.fp}
+		_, err := d.Decode(src[:], ModeARM)
+		if (err == nil) != tt.ok {
+			t.Errorf("Decoder{%v, %v}.Decode(%#08x): err = %v, want ok=%v", tt.arch, tt.fp, tt.enc, err, tt.ok)
+		}
+	}
+	var d *Decoder
+	if _, err := d.Decode([]byte{0x34, 0x02, 0x01, 0xe3}, ModeARM); err != nil {
+		t.Errorf("nil Decoder: %v", err)
+	}
+}
+
+func TestDecoderProfile(t *testing.T) {
+	src := []byte{0x01, 0x00, 0x80, 0xe0} // ADD R0, R0, R1
+	for _, d := range []*Decoder{{Profile: ProfileM}, {Arch: ARMv7M}} {
+		if _, err := d.Decode(src, ModeARM); err == nil {
+			t.Errorf("Decoder{%v, %v, %v} accepted ARM code", d.Arch, d.FP, d.Profile)
+		}
+	}
+	for _, d := range []*Decoder{{Profile: ProfileA}, {Profile: ProfileR}} {
+		if _, err := d.Decode(src, ModeARM); err != nil {
+			t.Errorf("Decoder{%v, %v, %v}: %v", d.Arch, d.FP, d.Profile, err)
+		}
+	}
+
+	// The decoder does not decode Thumb code,
+	// so check the Thumb instruction restrictions directly.
+	tests := []struct {
+		inst Inst
+		ok   bool
+	}{
+		{Inst{Op: ADD, Enc: 0xe0800001, Args: Args{R0, R0, R1}}, true},
+		{Inst{Op: SETEND, Enc: 0xf1010200, Args: Args{BigEndian}}, false},
+		{Inst{Op: BXJ, Enc: 0xe12fff20, Args: Args{R0}}, false},
+		{Inst{Op: MRS, Enc: 0xe14f1000, Args: Args{R1, SPSR}}, false},
+		{Inst{Op: MRS, Enc: 0xe10f1000, Args: Args{R1, APSR}}, true},
+		{Inst{Op: VMRS, Enc: 0xeef72a10, Args: Args{R2, MVFR0}}, true},
+		{Inst{Op: VMRS, Enc: 0xeef82a10, Args: Args{R2, FPEXC}}, false},
+	}
+	d := &Decoder{Profile: ProfileM}
+	for _, tt := range tests {
+		err := d.check(tt.inst, ModeThumb)
+		if (err == nil) != tt.ok {
+			t.Errorf("Decoder{Profile: M}.check(%v): err = %v, want ok=%v", tt.inst, err, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,388 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// AssembleOptions controls Assemble.
+// The zero value, or a nil *AssembleOptions, uses the defaults.
+type AssembleOptions struct {
+	// Veneers causes a B, BL, or BLX whose target is out of range
+	// to be assembled as a long-branch veneer instead of failing:
+	// MOVW and MOVT loading the target into IP (R12), followed by
+	// BX IP, or BLX IP for BL and BLX, as Relocate does.
+	// The veneer keeps the branch's condition, requires ARMv6T2
+	// or later, and assumes that IP is free at the branch.
+	Veneers bool
+}
+
+// A RangeError reports a PC-relative target that an instruction cannot reach,
+// such as a B or BL more than 32 MB away.
+type RangeError struct {
+	Line   int         // source line number, starting at 1
+	PC     uint64      // address of the instruction
+	Op     Op          // the instruction's opcode
+	Target uint64      // the target address
+	Offset int64       // the target's offset from PC+8
+	Range  OffsetRange // the offsets Op can encode (see PCRelRange)
+}
+
+func (e *RangeError) Error() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "line %d: %v at %#x: target %#x out of range (offset %#x, limit %#x to %#x",
+		e.Line, e.Op, e.PC, e.Target, e.Offset, e.Range.Min, e.Range.Max)
+	if e.Range.Align > 1 {
+		fmt.Fprintf(&buf, ", multiple of %d", e.Range.Align)
+	}
+	buf.WriteString(")")
+	if veneerable(e.Op) {
+		buf.WriteString("; use a long-branch veneer")
+	}
+	return buf.String()
+}
+
+// Assemble assembles src, a sequence of ARM instructions and data
+// for execution starting at address pc, returning the encoded bytes.
+//
+// Each line of src holds an instruction in the syntax accepted by Parse
+// or a data directive, a label definition of the form "name:", or both,
+// as in "loop: SUB.S R0, R0, #0x1". Blank lines are ignored, as is text
+// following a semicolon outside a string.
+// A branch target or other PC-relative argument may be written
+// as a label name or as an absolute address such as 0x8000,
+// which Assemble converts to the PCRel offset from the instruction,
+// or for a literal load like LDR R0, x to the PC-based Mem [PC, #off].
+//
+// The data directives are:
+//
+//	.word x, ...   32-bit values or label addresses
+//	.short x, ...  16-bit values
+//	.byte x, ...   8-bit values
+//	.ascii "s"     the bytes of the Go string literal s, with no terminating NUL
+//	.align n       zero bytes up to the next multiple of 2^n bytes, as in the GNU assembler
+//
+// Values are written little-endian and may be given in any base
+// accepted by strconv.ParseInt, and as either signed or unsigned.
+// Instructions must be word-aligned; .align 2 restores alignment after
+// data whose size is not a multiple of 4.
+//
+// If a PC-relative target is out of range, Assemble returns a *RangeError,
+// unless opt.Veneers is set and the instruction is a B, BL, or BLX,
+// in which case it is replaced by a veneer. Otherwise errors
+// are reported with the line number at which they occur.
+//
+// Only ARM mode is supported, as with Encode.
+func Assemble(src string, pc uint64, opt *AssembleOptions) ([]byte, error) {
+	if opt == nil {
+		opt = &AssembleOptions{}
+	}
+
+	// Collect label names first, so that later lines can refer to them.
+	lines := strings.Split(src, "\n")
+	labels := make(map[string]int) // label name -> index of following item
+	for i, text := range lines {
+		if name, _, ok := cutLabel(stripComment(text)); ok {
+			if _, err := parseReg(name); err == nil {
+				return nil, fmt.Errorf("line %d: label %q is a register name", i+1, name)
+			}
+			if _, dup := labels[name]; dup {
+				return nil, fmt.Errorf("line %d: label %q redefined", i+1, name)
+			}
+			labels[name] = -1
+		}
+	}
+
+	var items []*asmItem
+	for i, text := range lines {
+		text = stripComment(text)
+		if name, rest, ok := cutLabel(text); ok {
+			labels[name] = len(items)
+			text = rest
+		}
+		if text == "" {
+			continue
+		}
+		it := &asmItem{line: i + 1, arg: -1}
+		if strings.HasPrefix(text, ".") {
+			if err := it.parseDirective(text, labels); err != nil {
+				return nil, fmt.Errorf("line %d: %v", it.line, err)
+			}
+			items = append(items, it)
+			continue
+		}
+		op, rest := text, ""
+		if j := strings.Index(text, " "); j >= 0 {
+			op, rest = text[:j], text[j+1:]
+		}
+		parts := splitArgs(rest)
+		for j, p := range parts {
+			if _, ok := labels[p]; ok {
+				it.label = p
+				parts[j] = "0x0"
+			}
+		}
+		if len(parts) > 0 {
+			text = op + " " + strings.Join(parts, ", ")
+		}
+		inst, err := Parse(text)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %v", it.line, err)
+		}
+		for j, arg := range inst.Args {
+			if l, ok := arg.(Label); ok {
+				it.arg, it.target = j, uint64(l)
+			}
+		}
+		it.inst = inst
+		items = append(items, it)
+	}
+
+	// Lay out the items, lengthening out-of-range branches into veneers
+	// until no more need lengthening. Since items only grow, this terminates.
+	var end uint64
+	labelAddr := func(name string) uint64 {
+		if k := labels[name]; k < len(items) {
+			return items[k].addr
+		}
+		return end
+	}
+	for {
+		addr := pc
+		for _, it := range items {
+			it.addr = addr
+			addr += it.size()
+		}
+		end = addr
+		changed := false
+		for _, it := range items {
+			if it.arg < 0 {
+				continue
+			}
+			if it.label != "" {
+				it.target = labelAddr(it.label)
+			}
+			r, ok := PCRelRange(it.inst.Op)
+			if !ok || it.long || inRange(r, int64(it.target)-int64(it.addr+8)) {
+				continue
+			}
+			if opt.Veneers && veneerable(it.inst.Op) {
+				it.long = true
+				changed = true
+			}
+		}
+		if !changed {
+			break
+		}
+	}
+
+	var out []byte
+	for _, it := range items {
+		if it.inst.Op == 0 {
+			if it.align > 0 {
+				out = append(out, make([]byte, it.size())...)
+				continue
+			}
+			for _, ref := range it.refs {
+				binary.LittleEndian.PutUint32(it.data[ref.off:], uint32(labelAddr(ref.label)))
+			}
+			out = append(out, it.data...)
+			continue
+		}
+		if it.addr&3 != 0 {
+			return nil, fmt.Errorf("line %d: instruction at unaligned address %#x", it.line, it.addr)
+		}
+		seq := []Inst{it.inst}
+		if it.arg >= 0 {
+			off := int64(it.target) - int64(it.addr+8)
+			r, ok := PCRelRange(it.inst.Op)
+			switch {
+			case !ok:
+				return nil, fmt.Errorf("line %d: %v does not take a PC-relative argument", it.line, it.inst.Op)
+			case it.long:
+				seq = veneer(it.inst, uint32(it.target))
+			case !inRange(r, off):
+				return nil, &RangeError{Line: it.line, PC: it.addr, Op: it.inst.Op, Target: it.target, Offset: off, Range: r}
+			case pcRelMem(it.inst.Op):
+				seq[0].Args[it.arg] = Mem{Base: PC, Mode: AddrOffset, Offset: int16(off)}
+			default:
+				seq[0].Args[it.arg] = PCRel(off)
+			}
+		}
+		for _, inst := range seq {
+			enc, err := Encode(inst)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %v", it.line, err)
+			}
+			out = append(out, enc...)
+		}
+	}
+	return out, nil
+}
+
+// An asmItem is a single instruction or data directive being assembled.
+type asmItem struct {
+	line   int
+	inst   Inst   // instruction; Op is 0 for a directive
+	arg    int    // index of PC-relative argument, or -1
+	label  string // label naming the target, if any
+	target uint64 // target address, once known
+	addr   uint64 // address of the item
+	long   bool   // assembled as a veneer
+	data   []byte // directive data
+	refs   []asmRef
+	align  uint64 // alignment for .align, or 0
+}
+
+// An asmRef is a label address to be stored in a .word directive's data.
+type asmRef struct {
+	off   int
+	label string
+}
+
+// size returns the size of it at its current address.
+func (it *asmItem) size() uint64 {
+	switch {
+	case it.inst.Op == 0 && it.align > 0:
+		return -it.addr & (it.align - 1)
+	case it.inst.Op == 0:
+		return uint64(len(it.data))
+	case it.long:
+		return 12
+	}
+	return 4
+}
+
+// parseDirective parses the data directive text into it.
+func (it *asmItem) parseDirective(text string, labels map[string]int) error {
+	name, rest := text, ""
+	if i := strings.IndexAny(text, " \t"); i >= 0 {
+		name, rest = text[:i], strings.TrimSpace(text[i+1:])
+	}
+	switch name {
+	case ".ascii":
+		s, err := strconv.Unquote(rest)
+		if err != nil {
+			return fmt.Errorf("invalid string %s", rest)
+		}
+		it.data = []byte(s)
+		return nil
+	case ".align":
+		n, err := strconv.ParseUint(rest, 0, 8)
+		if err != nil || n > 16 {
+			return fmt.Errorf("invalid alignment %q", rest)
+		}
+		it.align = 1 << n
+		return nil
+	}
+	size := map[string]int{".word": 4, ".short": 2, ".byte": 1}[name]
+	if size == 0 {
+		return fmt.Errorf("unknown directive %q", name)
+	}
+	if rest == "" {
+		return fmt.Errorf("missing value for %s", name)
+	}
+	bits := uint(8 * size)
+	for _, p := range splitArgs(rest) {
+		off := len(it.data)
+		it.data = append(it.data, make([]byte, size)...)
+		if _, ok := labels[p]; ok && size == 4 {
+			it.refs = append(it.refs, asmRef{off, p})
+			continue
+		}
+		v, err := strconv.ParseInt(p, 0, 64)
+		if err != nil || v < -1<<(bits-1) || v >= 1<<bits {
+			return fmt.Errorf("invalid %s value %q", name, p)
+		}
+		for i := 0; i < size; i++ {
+			it.data[off+i] = byte(v >> (8 * uint(i)))
+		}
+	}
+	return nil
+}
+
+// inRange reports whether r contains the 64-bit offset off.
+func inRange(r OffsetRange, off int64) bool {
+	return int64(int32(off)) == off && r.Contains(int32(off))
+}
+
+// pcRelMem reports whether the PC-relative argument of op is a Mem
+// with base PC, as in a literal load, rather than a PCRel.
+func pcRelMem(op Op) bool {
+	for i := range instFormats {
+		f := &instFormats[i]
+		if _, _, ok := opEncoding(f, op); !ok {
+			continue
+		}
+		for _, aop := range f.args {
+			switch aop {
+			case arg_label_m_12, arg_label_p_12, arg_label_pm_12, arg_label_pm_4_4:
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// veneerable reports whether Assemble can replace a B, BL, or BLX
+// with op by a long-branch veneer.
+func veneerable(op Op) bool {
+	switch op &^ 15 {
+	case B_EQ, BL_EQ, BLX_EQ:
+		return true
+	}
+	return false
+}
+
+// veneer returns the long-branch veneer for the branch inst to target,
+// always 3 instructions so that its size does not depend on the target.
+func veneer(inst Inst, target uint32) []Inst {
+	cond := inst.Op & 15
+	last := Inst{Op: BX_EQ | cond, Args: Args{R12}}
+	switch inst.Op &^ 15 {
+	case BL_EQ:
+		last.Op = BLX_EQ | cond
+	case BLX_EQ:
+		// BLX <label> always switches to Thumb.
+		target |= 1
+		last.Op = BLX_EQ | cond
+	}
+	return []Inst{
+		{Op: MOVW_EQ | cond, Args: Args{R12, Imm(target & 0xffff)}},
+		{Op: MOVT_EQ | cond, Args: Args{R12, Imm(target >> 16)}},
+		last,
+	}
+}
+
+// stripComment returns text without any comment and surrounding space.
+// A semicolon inside a double-quoted string does not start a comment.
+func stripComment(text string) string {
+	quote := false
+	for i := 0; i < len(text); i++ {
+		switch c := text[i]; {
+		case c == '"':
+			quote = !quote
+		case c == '\\' && quote:
+			i++
+		case c == ';' && !quote:
+			return strings.TrimSpace(text[:i])
+		}
+	}
+	return strings.TrimSpace(text)
+}
+
+// cutLabel splits a leading label definition "name:" from text.
+func cutLabel(text string) (name, rest string, ok bool) {
+	i := strings.Index(text, ":")
+	if i <= 0 || strings.ContainsAny(text[:i], " \t,[{#") {
+		return "", text, false
+	}
+	return text[:i], strings.TrimSpace(text[i+1:]), true
+}
@@ -0,0 +1,154 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"encoding/binary"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+var assembleTests = []struct {
+	src     string
+	pc      uint64
+	veneers bool
+	out     []uint32
+	err     string // error substring, if any
+}{
+	{
+		src: `
+			MOV R0, #0x0   ; counter
+			loop: ADD.S R0, R0, #0x1
+			B.NE loop
+			BX LR
+		`,
+		out: []uint32{0xe3a00000, 0xe2900001, 0x1afffffd, 0xe12fff1e},
+	},
+	{
+		src: `
+			B done
+			NOP
+		done:
+		`,
+		out: []uint32{0xea000000, 0xe320f000},
+	},
+	{
+		src: "BL 0x1000",
+		pc:  0x100,
+		out: []uint32{0xeb0003be},
+	},
+	{
+		src: "B 0x4000000",
+		err: "line 1: B at 0x0: target 0x4000000 out of range (offset 0x3fffff8, limit -0x2000000 to 0x1fffffc, multiple of 4); use a long-branch veneer",
+	},
+	{
+		src:     "B 0x4000000",
+		veneers: true,
+		out:     []uint32{0xe300c000, 0xe340c400, 0xe12fff1c},
+	},
+	{
+		src:     "BL.NE 0x12345678",
+		veneers: true,
+		out:     []uint32{0x1305c678, 0x1341c234, 0x112fff3c},
+	},
+	{
+		// The veneer moves the following code, so the branch to end
+		// must be laid out after it.
+		src: `
+			B.EQ 0x8000000
+			B end
+			NOP
+		end: BX LR
+		`,
+		veneers: true,
+		out:     []uint32{0x0300c000, 0x0340c800, 0x012fff1c, 0xea000000, 0xe320f000, 0xe12fff1e},
+	},
+	{
+		src: `
+			LDR PC, [PC, #-4]
+			.word 0x12345678
+		`,
+		out: []uint32{0xe51ff004, 0x12345678},
+	},
+	{
+		src: `
+			LDR R0, x
+			BX LR
+		x:	.word 1
+		`,
+		out: []uint32{0xe59f0000, 0xe12fff1e, 0x00000001},
+	},
+	{
+		src: `
+			.word 2
+			LDRB.NE R1, 0x1000
+			PLD 0x1000
+		`,
+		pc:  0x1000,
+		out: []uint32{0x00000002, 0x155f100c, 0xf55ff010},
+	},
+	{src: "LDR R0, 0x8000", err: "line 1: LDR at 0x0: target 0x8000 out of range (offset 0x7ff8, limit -0xfff to 0xfff)"},
+	{
+		src: `
+			.word reset, 0x20001000 ; vectors
+			.short 0x1234, -1
+			.byte 1, 0xff
+			.ascii "hi;\n"
+			.align 2
+		reset:
+			BX LR
+		`,
+		pc:  0x1000,
+		out: []uint32{0x1014, 0x20001000, 0xffff1234, 0x6968ff01, 0x00000a3b, 0xe12fff1e},
+	},
+	{src: ".byte 1\nNOP", err: "line 2: instruction at unaligned address 0x1"},
+	{src: ".byte 256", err: `line 1: invalid .byte value "256"`},
+	{src: "x: .short x", err: `line 1: invalid .short value "x"`},
+	{src: ".word", err: "line 1: missing value for .word"},
+	{src: ".ascii hi", err: "line 1: invalid string hi"},
+	{src: ".align 17", err: `line 1: invalid alignment "17"`},
+	{src: ".quad 1", err: `line 1: unknown directive ".quad"`},
+	{src: "NOP\nFROB R0", err: `line 2: unknown opcode "FROB"`},
+	{src: "x: NOP\nx: NOP", err: `line 2: label "x" redefined`},
+	{src: "R0: NOP", err: `line 1: label "R0" is a register name`},
+	{src: "ADD R0, R1, 0x1000", err: "line 1: ADD does not take a PC-relative argument"},
+}
+
+func TestAssemble(t *testing.T) {
+	for _, tt := range assembleTests {
+		out, err := Assemble(tt.src, tt.pc, &AssembleOptions{Veneers: tt.veneers})
+		if tt.err != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("Assemble(%q) error = %v, want %q", tt.src, err, tt.err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Assemble(%q): %v", tt.src, err)
+			continue
+		}
+		var words []uint32
+		for i := 0; i+4 <= len(out); i += 4 {
+			words = append(words, binary.LittleEndian.Uint32(out[i:]))
+		}
+		if !reflect.DeepEqual(words, tt.out) {
+			t.Errorf("Assemble(%q) = %#08x, want %#08x", tt.src, words, tt.out)
+		}
+	}
+}
+
+func TestAssembleRangeError(t *testing.T) {
+	_, err := Assemble("NOP\nBL 0x80000000", 0x1000, nil)
+	var re *RangeError
+	if !errors.As(err, &re) {
+		t.Fatalf("Assemble error = %v, want *RangeError", err)
+	}
+	want := RangeError{Line: 2, PC: 0x1004, Op: BL, Target: 0x80000000, Offset: 0x80000000 - 0x100c, Range: OffsetRange{-1 << 25, 1<<25 - 4, 4}}
+	if *re != want {
+		t.Errorf("RangeError = %+v, want %+v", *re, want)
+	}
+}
@@ -0,0 +1,139 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Armasmd serves the armasm decoder over HTTP.
+//
+// Usage:
+//
+//	armasmd [-http addr] [-max bytes]
+//
+// Armasmd listens on -http (default localhost:8080) and handles requests
+// for /decode. The instruction bytes are either the body of a POST request
+// or, for short inputs, the hex-encoded value of the hex query parameter.
+// Other query parameters are mode, either arm (the default) or thumb,
+// pc, the address at which the bytes are loaded (default 0), and trace,
+// which if set to 1 explains each undecodable ARM instruction in a
+// Trace field, as described by armasm.TraceDecode, and lists up to
+// three of the closest valid encodings in a Near field, as described
+// by armasm.NearMisses.
+// For example:
+//
+//	curl --data-binary @code.bin 'localhost:8080/decode?pc=0x8000'
+//	curl 'localhost:8080/decode?hex=0100a0e3'
+//
+// The response is a JSON array with one object per instruction, with
+// fields PC, Len, Enc, Op, Args, Text, GNU, Go, Error, Trace, and Near,
+// as described by the Inst type in rsc.io/arm/armasm/cmd/internal/instjson.
+// After an undecodable instruction, decoding resumes at the next 4-byte
+// boundary in ARM mode or 2-byte boundary in Thumb mode.
+// Requests larger than -max bytes are rejected.
+package main
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"os"
+	"strconv"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armasm/cmd/internal/instjson"
+)
+
+var (
+	httpFlag = flag.String("http", "localhost:8080", "serve HTTP on `address`")
+	maxFlag  = flag.Int64("max", 16<<20, "reject requests larger than `bytes`")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: armasmd [-http addr] [-max bytes]\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("armasmd: ")
+
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() != 0 {
+		usage()
+	}
+
+	http.Handle("/decode", &decodeHandler{max: *maxFlag})
+	log.Fatal(http.ListenAndServe(*httpFlag, nil))
+}
+
+// A decodeHandler serves /decode requests of at most max bytes.
+type decodeHandler struct {
+	max int64
+}
+
+func (h *decodeHandler) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+	mode := armasm.ModeARM
+	switch q.Get("mode") {
+	case "", "arm":
+	case "thumb":
+		mode = armasm.ModeThumb
+	default:
+		http.Error(w, fmt.Sprintf("unknown mode %q", q.Get("mode")), http.StatusBadRequest)
+		return
+	}
+	var pc uint64
+	if s := q.Get("pc"); s != "" {
+		var err error
+		pc, err = strconv.ParseUint(s, 0, 64)
+		if err != nil {
+			http.Error(w, fmt.Sprintf("invalid pc %q", s), http.StatusBadRequest)
+			return
+		}
+	}
+
+	trace := false
+	switch q.Get("trace") {
+	case "", "0":
+	case "1":
+		trace = true
+	default:
+		http.Error(w, fmt.Sprintf("invalid trace %q", q.Get("trace")), http.StatusBadRequest)
+		return
+	}
+
+	var code []byte
+	switch req.Method {
+	case "GET":
+		var err error
+		code, err = hex.DecodeString(q.Get("hex"))
+		if err != nil {
+			http.Error(w, "invalid hex: "+err.Error(), http.StatusBadRequest)
+			return
+		}
+	case "POST":
+		var err error
+		code, err = ioutil.ReadAll(http.MaxBytesReader(w, req.Body, h.max))
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusRequestEntityTooLarge)
+			return
+		}
+	default:
+		w.Header().Set("Allow", "GET, POST")
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	if int64(len(code)) > h.max {
+		http.Error(w, "request too large", http.StatusRequestEntityTooLarge)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(instjson.DecodeAll(code, mode, pc, trace)); err != nil {
+		log.Print(err)
+	}
+}
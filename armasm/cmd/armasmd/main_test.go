@@ -0,0 +1,48 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+var decodeHandlerTests = []struct {
+	method string
+	url    string
+	body   string
+	code   int
+	out    string
+}{
+	{
+		"GET", "/decode?hex=0100a0e3&pc=0x1000", "",
+		200, `[{"PC":4096,"Len":4,"Enc":3818913793,"Op":"MOV","Args":["R0","#0x1"],"Text":"MOV R0, #0x1","GNU":"mov r0, #1","Go":"MOVW $1, R0"}]`,
+	},
+	{
+		"POST", "/decode", "\x1e\xff\x2f\xe1\xff\xff\xff\xff\x01",
+		200, `[{"PC":0,"Len":4,"Enc":3778019102,"Op":"BX","Args":["LR"],"Text":"BX LR","GNU":"bx lr","Go":"BX R14"},` +
+			`{"PC":4,"Len":4,"Error":"unknown instruction"},{"PC":8,"Len":1,"Error":"truncated instruction"}]`,
+	},
+	{"GET", "/decode", "", 200, `[]`},
+	{"GET", "/decode?hex=zz", "", 400, "invalid hex: encoding/hex: invalid byte: U+007A 'z'"},
+	{"GET", "/decode?mode=x86", "", 400, `unknown mode "x86"`},
+	{"GET", "/decode?pc=q", "", 400, `invalid pc "q"`},
+	{"GET", "/decode?hex=0000000000000000000000000000000000", "", 413, "request too large"},
+	{"POST", "/decode", "00000000000000000", 413, "http: request body too large"},
+	{"PUT", "/decode", "", 405, "method not allowed"},
+}
+
+func TestDecodeHandler(t *testing.T) {
+	h := &decodeHandler{max: 16}
+	for _, tt := range decodeHandlerTests {
+		req := httptest.NewRequest(tt.method, tt.url, strings.NewReader(tt.body))
+		w := httptest.NewRecorder()
+		h.ServeHTTP(w, req)
+		if out := strings.TrimSpace(w.Body.String()); w.Code != tt.code || out != tt.out {
+			t.Errorf("%s %s: %d %s\nwant %d %s", tt.method, tt.url, w.Code, out, tt.code, tt.out)
+		}
+	}
+}
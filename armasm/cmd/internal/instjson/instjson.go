@@ -0,0 +1,84 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package instjson describes decoded instructions in the JSON form
+// served by armasmd and returned by libarmasm's armasm_decode_json.
+package instjson
+
+import "rsc.io/arm/armasm"
+
+// An Inst is the decoding of a single instruction.
+type Inst struct {
+	PC    uint64   // address of instruction
+	Len   int      // length in bytes
+	Enc   uint32   `json:",omitempty"` // encoding
+	Op    string   `json:",omitempty"` // opcode
+	Args  []string `json:",omitempty"` // arguments, in armasm syntax
+	Text  string   `json:",omitempty"` // armasm syntax
+	GNU   string   `json:",omitempty"` // GNU syntax
+	Go    string   `json:",omitempty"` // Go assembler syntax
+	Error string   `json:",omitempty"` // decoding error
+	Trace string   `json:",omitempty"` // decoder trace, for errors when tracing
+	Near  []string `json:",omitempty"` // closest valid encodings, for errors when tracing
+}
+
+// Decode decodes the instruction at the start of src, loaded at pc.
+func Decode(src []byte, mode armasm.Mode, pc uint64) (Inst, error) {
+	inst, err := armasm.Decode(src, mode)
+	if err != nil {
+		return Inst{}, err
+	}
+	in := Inst{
+		PC:   pc,
+		Len:  inst.Len,
+		Enc:  inst.Enc,
+		Op:   inst.Op.String(),
+		Text: inst.String(),
+		GNU:  armasm.GNUSyntax(inst),
+		Go:   armasm.GoSyntax(inst, pc, nil, nil),
+	}
+	for _, a := range inst.Args {
+		if a == nil {
+			break
+		}
+		in.Args = append(in.Args, a.String())
+	}
+	return in, nil
+}
+
+// DecodeAll decodes all of src, loaded at pc. After an undecodable
+// instruction, which is reported in the Error field, decoding resumes
+// at the next 4-byte boundary in ARM mode or 2-byte boundary in Thumb mode.
+// If trace is set, undecodable instructions also include a decoder trace,
+// as described by armasm.TraceDecode, and up to three of the closest
+// valid encodings, as described by armasm.NearMisses.
+// The result is never nil, so that it marshals as a JSON array.
+func DecodeAll(src []byte, mode armasm.Mode, pc uint64, trace bool) []Inst {
+	step := 4
+	if mode == armasm.ModeThumb {
+		step = 2
+	}
+	insts := []Inst{}
+	for off := 0; off < len(src); {
+		in, err := Decode(src[off:], mode, pc+uint64(off))
+		if err != nil {
+			n := step
+			if n > len(src)-off {
+				n = len(src) - off
+			}
+			in = Inst{PC: pc + uint64(off), Len: n, Error: err.Error()}
+			if trace {
+				if _, t, _ := armasm.TraceDecode(src[off:], mode); t != nil {
+					in.Trace = t.String()
+				}
+				for _, m := range armasm.NearMisses(src[off:], mode, 3) {
+					in.Near = append(in.Near, m.String())
+				}
+			}
+		}
+		insts = append(insts, in)
+		off += in.Len
+	}
+	return insts
+}
@@ -0,0 +1,36 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package instjson
+
+import (
+	"reflect"
+	"testing"
+
+	"rsc.io/arm/armasm"
+)
+
+func TestDecodeAll(t *testing.T) {
+	src := []byte{0x01, 0x00, 0xa0, 0xe3, 0xf0, 0x00, 0xf0, 0xe7, 0x00}
+	want := []Inst{
+		{PC: 0x1000, Len: 4, Enc: 0xe3a00001, Op: "MOV", Args: []string{"R0", "#0x1"}, Text: "MOV R0, #0x1", GNU: "mov r0, #1", Go: "MOVW $1, R0"},
+		{PC: 0x1004, Len: 4, Error: "unknown instruction"},
+		{PC: 0x1008, Len: 1, Error: "truncated instruction"},
+	}
+	if insts := DecodeAll(src, armasm.ModeARM, 0x1000, false); !reflect.DeepEqual(insts, want) {
+		t.Errorf("DecodeAll(% x) = %+v, want %+v", src, insts, want)
+	}
+
+	insts := DecodeAll(src, armasm.ModeARM, 0x1000, true)
+	if len(insts) != 3 || insts[1].Trace == "" || len(insts[1].Near) == 0 || len(insts[1].Near) > 3 {
+		t.Errorf("DecodeAll(% x) with trace: undecodable instruction = %+v, want Trace and 1-3 Near", src, insts[1])
+	}
+	if insts[0].Trace != "" || insts[0].Near != nil {
+		t.Errorf("DecodeAll(% x) with trace: decodable instruction = %+v, want no Trace or Near", src, insts[0])
+	}
+
+	if insts := DecodeAll(nil, armasm.ModeARM, 0, false); insts == nil || len(insts) != 0 {
+		t.Errorf("DecodeAll(nil) = %#v, want empty non-nil slice", insts)
+	}
+}
@@ -0,0 +1,23 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"encoding/json"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armasm/cmd/internal/instjson"
+)
+
+// decodeJSON decodes all of src, loaded at pc, and returns the
+// instructions as a JSON array.
+func decodeJSON(src []byte, mode armasm.Mode, pc uint64) []byte {
+	js, err := json.Marshal(instjson.DecodeAll(src, mode, pc, false))
+	if err != nil {
+		// Cannot happen: Inst contains only strings and integers.
+		panic(err)
+	}
+	return js
+}
@@ -0,0 +1,43 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"testing"
+
+	"rsc.io/arm/armasm"
+)
+
+var decodeJSONTests = []struct {
+	src  []byte
+	mode armasm.Mode
+	out  string
+}{
+	{
+		[]byte{0x01, 0x00, 0xa0, 0xe3, 0x1e, 0xff, 0x2f, 0xe1},
+		armasm.ModeARM,
+		`[{"PC":4096,"Len":4,"Enc":3818913793,"Op":"MOV","Args":["R0","#0x1"],"Text":"MOV R0, #0x1","GNU":"mov r0, #1","Go":"MOVW $1, R0"},` +
+			`{"PC":4100,"Len":4,"Enc":3778019102,"Op":"BX","Args":["LR"],"Text":"BX LR","GNU":"bx lr","Go":"BX R14"}]`,
+	},
+	{
+		[]byte{0xff, 0xff, 0xff, 0xff, 0x01, 0x02},
+		armasm.ModeARM,
+		`[{"PC":4096,"Len":4,"Error":"unknown instruction"},{"PC":4100,"Len":2,"Error":"truncated instruction"}]`,
+	},
+	{
+		nil,
+		armasm.ModeARM,
+		`[]`,
+	},
+}
+
+func TestDecodeJSON(t *testing.T) {
+	for _, tt := range decodeJSONTests {
+		out := string(decodeJSON(tt.src, tt.mode, 0x1000))
+		if out != tt.out {
+			t.Errorf("decodeJSON(% x, %v):\nhave %s\nwant %s", tt.src, tt.mode, out, tt.out)
+		}
+	}
+}
@@ -0,0 +1,117 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Libarmasm exports the armasm decoder as a C library,
+// so that tools not written in Go can use it.
+//
+// Build it with:
+//
+//	go build -buildmode=c-shared -o libarmasm.so rsc.io/arm/armasm/cmd/libarmasm
+//
+// which also writes the C declarations to libarmasm.h.
+// The library provides three functions:
+//
+//	int armasm_decode(const uint8_t *src, size_t n, int mode, uint64_t pc, armasm_inst *out);
+//	char *armasm_decode_json(const uint8_t *src, size_t n, int mode, uint64_t pc);
+//	void armasm_free(char *p);
+//
+// The mode is ARMASM_MODE_ARM or ARMASM_MODE_THUMB, and pc is the address
+// at which src is loaded, used to format PC-relative arguments.
+//
+// Armasm_decode decodes the single instruction at the start of src into *out
+// and returns its length in bytes. If src does not begin with a valid
+// instruction, it stores a description of the problem in out->error
+// and returns -1. Text that does not fit in a field of armasm_inst
+// is truncated.
+//
+// Armasm_decode_json decodes all of src and returns a JSON array
+// with one object per instruction, with fields PC, Len, Enc, Op, Args,
+// Text, GNU, Go, and Error, as described by the Inst type in
+// rsc.io/arm/armasm/cmd/internal/instjson.
+// After an undecodable instruction, decoding resumes at the next
+// 4-byte boundary in ARM mode or 2-byte boundary in Thumb mode.
+// The caller must release the result using armasm_free.
+package main
+
+/*
+#include <stdint.h>
+#include <stdlib.h>
+
+enum {
+	ARMASM_MODE_ARM = 1,
+	ARMASM_MODE_THUMB = 2,
+};
+
+typedef struct {
+	uint32_t enc;   // instruction encoding
+	int len;        // length in bytes
+	char op[32];    // opcode, such as "ADD.S.EQ"
+	char text[128]; // armasm syntax, as printed by Inst.String
+	char gnu[128];  // GNU syntax
+	char goasm[128]; // Go assembler syntax
+	char error[64]; // error, if the instruction did not decode
+} armasm_inst;
+*/
+import "C"
+
+import (
+	"unsafe"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armasm/cmd/internal/instjson"
+)
+
+func main() {}
+
+//export armasm_decode
+func armasm_decode(src *C.uint8_t, n C.size_t, mode C.int, pc C.uint64_t, out *C.armasm_inst) C.int {
+	*out = C.armasm_inst{}
+	// No instruction is longer than 4 bytes, so copy only those,
+	// keeping a caller's walk over a large buffer linear in its size.
+	if n > 4 {
+		n = 4
+	}
+	in, err := instjson.Decode(C.GoBytes(unsafe.Pointer(src), C.int(n)), armasm.Mode(mode), uint64(pc))
+	if err != nil {
+		setString(out.error[:], err.Error())
+		return -1
+	}
+	out.enc = C.uint32_t(in.Enc)
+	out.len = C.int(in.Len)
+	setString(out.op[:], in.Op)
+	setString(out.text[:], in.Text)
+	setString(out.gnu[:], in.GNU)
+	setString(out.goasm[:], in.Go)
+	return C.int(in.Len)
+}
+
+//export armasm_decode_json
+func armasm_decode_json(src *C.uint8_t, n C.size_t, mode C.int, pc C.uint64_t) *C.char {
+	// C.GoBytes takes an int length, which would truncate a large n,
+	// so decode directly from the caller's buffer instead.
+	// Nothing retains the slice after decodeJSON returns.
+	var buf []byte
+	if n > 0 {
+		buf = unsafe.Slice((*byte)(unsafe.Pointer(src)), n)
+	}
+	js := decodeJSON(buf, armasm.Mode(mode), uint64(pc))
+	return C.CString(string(js))
+}
+
+//export armasm_free
+func armasm_free(p *C.char) {
+	C.free(unsafe.Pointer(p))
+}
+
+// setString copies s into the C character array dst,
+// truncating it if necessary to leave room for the terminating NUL.
+func setString(dst []C.char, s string) {
+	if len(s) >= len(dst) {
+		s = s[:len(dst)-1]
+	}
+	for i := 0; i < len(s); i++ {
+		dst[i] = C.char(s[i])
+	}
+	dst[len(s)] = 0
+}
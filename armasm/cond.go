@@ -0,0 +1,40 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+// EvalCond reports whether the condition cond passes
+// given the flags in apsr, which holds N, Z, C, and V in bits 31 through 28.
+// The condition is the 4-bit condition field of an instruction encoding:
+// 0 for EQ, 1 for NE, and so on through 14 for AL.
+// The unconditional encoding 15 also always passes.
+func EvalCond(cond uint8, apsr uint32) bool {
+	n := apsr&(1<<31) != 0
+	z := apsr&(1<<30) != 0
+	c := apsr&(1<<29) != 0
+	v := apsr&(1<<28) != 0
+	var ok bool
+	switch cond >> 1 & 7 {
+	case 0: // EQ, NE
+		ok = z
+	case 1: // CS, CC
+		ok = c
+	case 2: // MI, PL
+		ok = n
+	case 3: // VS, VC
+		ok = v
+	case 4: // HI, LS
+		ok = c && !z
+	case 5: // GE, LT
+		ok = n == v
+	case 6: // GT, LE
+		ok = n == v && !z
+	case 7: // AL, unconditional
+		return true
+	}
+	if cond&1 != 0 {
+		ok = !ok
+	}
+	return ok
+}
@@ -0,0 +1,31 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import "testing"
+
+func TestEvalCond(t *testing.T) {
+	const N, Z, C, V = 1 << 31, 1 << 30, 1 << 29, 1 << 28
+	// For each flag setting, the conditions EQ through AL that pass,
+	// one bit per condition.
+	tests := []struct {
+		apsr uint32
+		pass uint16
+	}{
+		{0, 1<<1 | 1<<3 | 1<<5 | 1<<7 | 1<<9 | 1<<10 | 1<<12 | 1<<14},
+		{Z, 1<<0 | 1<<3 | 1<<5 | 1<<7 | 1<<9 | 1<<10 | 1<<13 | 1<<14},
+		{C, 1<<1 | 1<<2 | 1<<5 | 1<<7 | 1<<8 | 1<<10 | 1<<12 | 1<<14},
+		{N, 1<<1 | 1<<3 | 1<<4 | 1<<7 | 1<<9 | 1<<11 | 1<<13 | 1<<14},
+		{N | V, 1<<1 | 1<<3 | 1<<4 | 1<<6 | 1<<9 | 1<<10 | 1<<12 | 1<<14},
+	}
+	for _, tt := range tests {
+		for cond := uint8(0); cond < 16; cond++ {
+			want := cond == 15 || tt.pass&(1<<cond) != 0
+			if got := EvalCond(cond, tt.apsr); got != want {
+				t.Errorf("EvalCond(%d, %#x) = %v, want %v", cond, tt.apsr, got, want)
+			}
+		}
+	}
+}
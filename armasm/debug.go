@@ -0,0 +1,131 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+)
+
+var addrModeNames = [...]string{
+	AddrPostIndex: "AddrPostIndex",
+	AddrPreIndex:  "AddrPreIndex",
+	AddrOffset:    "AddrOffset",
+	AddrLDM:       "AddrLDM",
+	AddrLDM_WB:    "AddrLDM_WB",
+}
+
+var shiftGoNames = [...]string{
+	ShiftLeft:        "ShiftLeft",
+	ShiftRight:       "ShiftRight",
+	ShiftRightSigned: "ShiftRightSigned",
+	RotateRight:      "RotateRight",
+	RotateRightExt:   "RotateRightExt",
+}
+
+// DebugString returns a verbose dump of i, for debugging programs that
+// use the package: every field of the Inst, and each argument with its
+// concrete type and the raw values of its fields, as in
+//
+//	Inst{Op:LDR Enc:0xe59d0008 Len:4 Args:[Reg(R0) Mem{Base:SP Mode:AddrOffset Offset:8}]}
+//
+// Opcodes, registers, addressing modes, and shifts are shown by the names
+// of their constants in this package, like ADD_S_EQ and ShiftLeft.
+// A Mem shows only the index fields its form uses: Sign, Index, Shift,
+// and Count for a register index, or Offset for an immediate one.
+// Trailing nil arguments are omitted.
+func (i Inst) DebugString() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "Inst{Op:%s Enc:%#08x Len:%d Args:[", opGoName(i.Op), i.Enc, i.Len)
+	args := i.Args[:]
+	for len(args) > 0 && args[len(args)-1] == nil {
+		args = args[:len(args)-1]
+	}
+	for j, arg := range args {
+		if j > 0 {
+			buf.WriteString(" ")
+		}
+		buf.WriteString(argDebugString(arg))
+	}
+	buf.WriteString("]}")
+	return buf.String()
+}
+
+// argDebugString returns the dump of arg used by Inst.DebugString.
+func argDebugString(arg Arg) string {
+	switch a := arg.(type) {
+	case nil:
+		return "nil"
+	case Reg:
+		return "Reg(" + a.String() + ")"
+	case Imm:
+		return fmt.Sprintf("Imm(%#x)", uint32(a))
+	case ImmAlt:
+		return fmt.Sprintf("ImmAlt{Val:%#x Rot:%d}", a.Val, a.Rot)
+	case Float32Imm:
+		return fmt.Sprintf("Float32Imm(%v)", float32(a))
+	case Float64Imm:
+		return fmt.Sprintf("Float64Imm(%v)", float32(a))
+	case Label:
+		return fmt.Sprintf("Label(%#x)", uint64(a))
+	case PCRel:
+		return fmt.Sprintf("PCRel(%d)", int32(a))
+	case Endian:
+		switch a {
+		case LittleEndian:
+			return "Endian(LittleEndian)"
+		case BigEndian:
+			return "Endian(BigEndian)"
+		}
+		return fmt.Sprintf("Endian(%d)", uint8(a))
+	case RegList:
+		return fmt.Sprintf("RegList(%#04x %v)", uint16(a), a)
+	case RegRange:
+		return fmt.Sprintf("RegRange{First:%s Count:%d}", a.First, a.Count)
+	case RegX:
+		return fmt.Sprintf("RegX{Reg:%s Index:%d}", a.Reg, a.Index)
+	case RegShift:
+		return fmt.Sprintf("RegShift{Reg:%s Shift:%s Count:%d}", a.Reg, shiftGoName(a.Shift), a.Count)
+	case RegShiftReg:
+		return fmt.Sprintf("RegShiftReg{Reg:%s Shift:%s RegCount:%s}", a.Reg, shiftGoName(a.Shift), a.RegCount)
+	case Mem:
+		var buf bytes.Buffer
+		fmt.Fprintf(&buf, "Mem{Base:%s Mode:%s", a.Base, addrModeGoName(a.Mode))
+		if a.Sign != 0 {
+			fmt.Fprintf(&buf, " Sign:%d Index:%s Shift:%s Count:%d", a.Sign, a.Index, shiftGoName(a.Shift), a.Count)
+		}
+		if a.Sign == 0 || a.Offset != 0 {
+			fmt.Fprintf(&buf, " Offset:%d", a.Offset)
+		}
+		buf.WriteString("}")
+		return buf.String()
+	}
+	return fmt.Sprintf("%T(%v)", arg, arg)
+}
+
+// opGoName returns the name of the constant for op, like ADD_S_EQ.
+func opGoName(op Op) string {
+	if op.name() == "" {
+		return fmt.Sprintf("Op(%d)", int(op))
+	}
+	return strings.Replace(op.String(), ".", "_", -1)
+}
+
+// addrModeGoName returns the name of the constant for m, like AddrOffset.
+func addrModeGoName(m AddrMode) string {
+	if int(m) < len(addrModeNames) && addrModeNames[m] != "" {
+		return addrModeNames[m]
+	}
+	return fmt.Sprintf("AddrMode(%d)", int(m))
+}
+
+// shiftGoName returns the name of the constant for s, like ShiftLeft.
+func shiftGoName(s Shift) string {
+	if int(s) < len(shiftGoNames) {
+		return shiftGoNames[s]
+	}
+	return fmt.Sprintf("Shift(%d)", int(s))
+}
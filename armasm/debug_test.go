@@ -0,0 +1,61 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import "testing"
+
+var debugStringTests = []struct {
+	enc  uint32
+	want string
+}{
+	{0xe59d0008, "Inst{Op:LDR Enc:0xe59d0008 Len:4 Args:[Reg(R0) Mem{Base:SP Mode:AddrOffset Offset:8}]}"},
+	{0xe0910003, "Inst{Op:ADD_S Enc:0xe0910003 Len:4 Args:[Reg(R0) Reg(R1) Reg(R3)]}"},
+	{0x03a00001, "Inst{Op:MOV_EQ Enc:0x03a00001 Len:4 Args:[Reg(R0) Imm(0x1)]}"},
+	{0xe7120103, "Inst{Op:LDR Enc:0xe7120103 Len:4 Args:[Reg(R0) Mem{Base:R2 Mode:AddrOffset Sign:-1 Index:R3 Shift:ShiftLeft Count:2}]}"},
+	{0xe92d4010, "Inst{Op:PUSH Enc:0xe92d4010 Len:4 Args:[RegList(0x4010 {R4,LR})]}"},
+	{0xea000000, "Inst{Op:B Enc:0xea000000 Len:4 Args:[PCRel(0)]}"},
+	{0xe1a00061, "Inst{Op:RRX Enc:0xe1a00061 Len:4 Args:[Reg(R0) Reg(R1)]}"},
+	{0xe0810312, "Inst{Op:ADD Enc:0xe0810312 Len:4 Args:[Reg(R0) Reg(R1) RegShiftReg{Reg:R2 Shift:ShiftLeft RegCount:R3}]}"},
+	{0xecbd0b04, "Inst{Op:VPOP Enc:0xecbd0b04 Len:4 Args:[RegRange{First:D0 Count:2}]}"},
+}
+
+func TestDebugString(t *testing.T) {
+	for _, tt := range debugStringTests {
+		src := []byte{byte(tt.enc), byte(tt.enc >> 8), byte(tt.enc >> 16), byte(tt.enc >> 24)}
+		inst, err := Decode(src, ModeARM)
+		if err != nil {
+			t.Errorf("Decode(%08x): %v", tt.enc, err)
+			continue
+		}
+		if s := inst.DebugString(); s != tt.want {
+			t.Errorf("DebugString(%08x) =\n\t%s\nwant\n\t%s", tt.enc, s, tt.want)
+		}
+	}
+}
+
+func TestArgDebugString(t *testing.T) {
+	tests := []struct {
+		arg  Arg
+		want string
+	}{
+		{nil, "nil"},
+		{ImmAlt{Val: 1, Rot: 28}, "ImmAlt{Val:0x1 Rot:28}"},
+		{Float32Imm(1.5), "Float32Imm(1.5)"},
+		{Label(0x8000), "Label(0x8000)"},
+		{BigEndian, "Endian(BigEndian)"},
+		{RegX{D5, 1}, "RegX{Reg:D5 Index:1}"},
+		{RegShift{R1, RotateRightExt, 1}, "RegShift{Reg:R1 Shift:RotateRightExt Count:1}"},
+		{Mem{Base: R0, Mode: AddrMode(9)}, "Mem{Base:R0 Mode:AddrMode(9) Offset:0}"},
+	}
+	for _, tt := range tests {
+		if s := argDebugString(tt.arg); s != tt.want {
+			t.Errorf("argDebugString(%#v) = %q, want %q", tt.arg, s, tt.want)
+		}
+	}
+	inst := Inst{Op: Op(0xffff), Args: Args{R0, nil, R1}}
+	if s, want := inst.DebugString(), "Inst{Op:Op(65535) Enc:0x00000000 Len:0 Args:[Reg(R0) nil Reg(R1)]}"; s != want {
+		t.Errorf("DebugString() = %q, want %q", s, want)
+	}
+}
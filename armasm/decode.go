@@ -25,22 +25,27 @@ import (
 type instFormat struct {
 	mask     uint32
 	value    uint32
-	priority int8
-	op       Op
 	opBits   uint64
+	op       Op
+	priority int8
 	args     instArgs
 }
 
 type instArgs [4]instArg
 
+// instSyntax returns the assembly syntax and encoding bit layout
+// of instFormats[i], as written in arm.csv.
+func instSyntax(i int) (syntax, bits string) {
+	x := &instSyntaxIndex[i]
+	return instSyntaxData[x[0]:x[1]], instSyntaxData[x[2]:x[3]]
+}
+
 var (
 	errMode    = fmt.Errorf("unsupported execution mode")
 	errShort   = fmt.Errorf("truncated instruction")
 	errUnknown = fmt.Errorf("unknown instruction")
 )
 
-var decoderCover []bool
-
 // Decode decodes the leading bytes in src as a single instruction.
 func Decode(src []byte, mode Mode) (inst Inst, err error) {
 	if mode != ModeARM {
@@ -50,12 +55,45 @@ func Decode(src []byte, mode Mode) (inst Inst, err error) {
 		return Inst{}, errShort
 	}
 
-	if decoderCover == nil {
-		decoderCover = make([]bool, len(instFormats))
+	inst, i := decode(binary.LittleEndian.Uint32(src))
+	if i < 0 {
+		return Inst{}, errUnknown
 	}
+	cover(i)
+	return inst, nil
+}
 
-	x := binary.LittleEndian.Uint32(src)
+// DecodeAppend decodes the instructions in src, in order, appending them
+// to dst and returning the extended slice. Reusing dst, as in
+//
+//	insts, err = armasm.DecodeAppend(insts[:0], code, armasm.ModeARM)
+//
+// avoids allocating a new slice for each block of code.
+// If an instruction fails to decode, DecodeAppend stops and returns
+// the instructions decoded before it along with the error;
+// the failing instruction starts at the total Len of those instructions.
+func DecodeAppend(dst []Inst, src []byte, mode Mode) ([]Inst, error) {
+	for off := 0; off < len(src); {
+		inst, err := Decode(src[off:], mode)
+		if err != nil {
+			return dst, fmt.Errorf("offset %#x: %v", off, err)
+		}
+		dst = append(dst, inst)
+		off += inst.Len
+	}
+	return dst, nil
+}
+
+// decode decodes the ARM instruction x,
+// returning the instruction and the index of the
+// instFormats entry that produced it, or -1 if none did.
+func decode(x uint32) (inst Inst, matched int) {
+	return decodeTrace(x, nil)
+}
 
+// decodeTrace is decode, recording in t, if not nil,
+// each entry whose fixed bits match x and what became of it.
+func decodeTrace(x uint32, t *DecodeTrace) (inst Inst, matched int) {
 	// The instFormat table contains both conditional and unconditional instructions.
 	// Considering only the top 4 bits, the conditional instructions use mask=0, value=0,
 	// while the unconditional instructions use mask=f, value=f.
@@ -67,10 +105,20 @@ func Decode(src []byte, mode Mode) (inst Inst, err error) {
 		xNoCond &^= condMask
 	}
 	var priority int8
+	matched = -1
 Search:
 	for i := range instFormats {
 		f := &instFormats[i]
-		if xNoCond&(f.mask|condMask) != f.value || f.priority <= priority {
+		if xNoCond&(f.mask|condMask) != f.value {
+			if t != nil && x&condMask == condMask && f.value&condMask == 0 && x&^condMask&f.mask == f.value {
+				t.add(i, f.op, TraceRejected, "condition 0b1111 selects an unconditional instruction")
+			}
+			continue
+		}
+		if f.priority <= priority {
+			if t != nil {
+				t.add(i, f.op, TraceShadowed, fmt.Sprintf("entry for %v matched first", inst.Op))
+			}
 			continue
 		}
 		delta := uint32(0)
@@ -85,6 +133,9 @@ Search:
 
 		// Special case: BKPT encodes with condition but cannot have one.
 		if op&^15 == BKPT_EQ && op != BKPT {
+			if t != nil {
+				t.add(i, op, TraceRejected, "BKPT cannot be conditional")
+			}
 			continue Search
 		}
 
@@ -95,26 +146,33 @@ Search:
 			}
 			arg := decodeArg(aop, x)
 			if arg == nil { // cannot decode argument
+				if t != nil {
+					t.add(i, op, TraceRejected, fmt.Sprintf("argument %d: %s", j+1, argConstraint(aop)))
+				}
 				continue Search
 			}
 			args[j] = arg
 		}
 
-		decoderCover[i] = true
-
+		if t != nil {
+			t.override(op)
+			t.add(i, op, TraceMatched, "")
+		}
+		matched = i
 		inst = Inst{
 			Op:   op,
 			Args: args,
 			Enc:  x,
 			Len:  4,
+			Mode: ModeARM,
 		}
 		priority = f.priority
 		continue Search
 	}
-	if inst.Op != 0 {
-		return inst, nil
+	if inst.Op == 0 {
+		return Inst{}, -1
 	}
-	return Inst{}, errUnknown
+	return inst, matched
 }
 
 // An instArg describes the encoding of a single argument.
@@ -130,6 +188,7 @@ const (
 	_ instArg = iota
 	arg_APSR
 	arg_FPSCR
+	arg_SPSR
 	arg_Dn_half
 	arg_R1_0
 	arg_R1_12
@@ -190,6 +249,10 @@ const (
 	arg_satimm5
 	arg_satimm4m1
 	arg_satimm5m1
+	arg_spec_reg
+	arg_spec_reg_msr
+	arg_vlist32
+	arg_vlist64
 	arg_widthm1
 )
 
@@ -204,6 +267,35 @@ func decodeArg(aop instArg, x uint32) Arg {
 		return APSR
 	case arg_FPSCR:
 		return FPSCR
+	case arg_SPSR:
+		return SPSR
+
+	case arg_spec_reg:
+		switch (x >> 16) & (1<<4 - 1) {
+		case 0:
+			return FPSID
+		case 1:
+			return FPSCR
+		case 6:
+			return MVFR1
+		case 7:
+			return MVFR0
+		case 8:
+			return FPEXC
+		}
+		return nil
+
+	case arg_spec_reg_msr:
+		// MVFR0 and MVFR1 are read-only.
+		switch (x >> 16) & (1<<4 - 1) {
+		case 0:
+			return FPSID
+		case 1:
+			return FPSCR
+		case 8:
+			return FPEXC
+		}
+		return nil
 
 	case arg_R_0:
 		return Reg(x & (1<<4 - 1))
@@ -530,6 +622,28 @@ func decodeArg(aop instArg, x uint32) Arg {
 		Rt := (x >> 12) & (1<<4 - 1)
 		return RegList(1 << Rt)
 
+	case arg_vlist32:
+		Vd := (x >> 12) & (1<<4 - 1)
+		D := (x >> 22) & 1
+		list := RegRange{First: S0 + Reg(Vd<<1|D), Count: uint8(x)}
+		if !list.Valid() {
+			return nil
+		}
+		return list
+
+	case arg_vlist64:
+		Vd := (x >> 12) & (1<<4 - 1)
+		D := (x >> 22) & 1
+		if x&1 != 0 {
+			// FLDMX/FSTMX, deprecated
+			return nil
+		}
+		list := RegRange{First: D0 + Reg(D<<4|Vd), Count: uint8(x) >> 1}
+		if !list.Valid() {
+			return nil
+		}
+		return list
+
 	case arg_satimm4:
 		return Imm((x >> 16) & (1<<4 - 1))
 
@@ -5,7 +5,10 @@
 package armasm
 
 import (
+	"bytes"
+	"encoding/binary"
 	"encoding/hex"
+	"fmt"
 	"io/ioutil"
 	"strconv"
 	"strings"
@@ -56,7 +59,7 @@ func TestDecode(t *testing.T) {
 			case "gnu":
 				out = GNUSyntax(inst)
 			case "plan9":
-				out = plan9Syntax(inst, 0, nil, nil)
+				out = GoSyntax(inst, 0, nil, nil)
 			default:
 				t.Errorf("unknown syntax %q", syntax)
 				continue
@@ -65,5 +68,468 @@ func TestDecode(t *testing.T) {
 		if out != asm || inst.Len != size {
 			t.Errorf("Decode(%s) [%s] = %s, %d, want %s, %d", f[0], syntax, out, inst.Len, asm, size)
 		}
+		if err == nil && !hasEncoding(inst) {
+			t.Errorf("Decode(%s) = %v, but Encodings(%v) has no match", f[0], inst, inst.Op)
+		}
+		if err == nil && !bytes.Equal(inst.Bytes(binary.LittleEndian), code[:inst.Len]) {
+			t.Errorf("Decode(%s).Bytes(binary.LittleEndian) = %x, want %x", f[0], inst.Bytes(binary.LittleEndian), code[:inst.Len])
+		}
+	}
+}
+
+func TestOpDescription(t *testing.T) {
+	tests := []struct {
+		op   Op
+		desc string
+	}{
+		{ADC_S_EQ, "Add with Carry"},
+		{LDREX_EQ, "Load Register Exclusive"},
+		{VADD_EQ_F32, "Floating-point Add"},
+		{PUSH_EQ, "Push Multiple Registers"},
+		{0, ""},
+	}
+	for _, tt := range tests {
+		if desc := OpDescription(tt.op); desc != tt.desc {
+			t.Errorf("OpDescription(%v) = %q, want %q", tt.op, desc, tt.desc)
+		}
+	}
+}
+
+func TestOpString(t *testing.T) {
+	tests := []struct {
+		op  Op
+		str string
+	}{
+		{ADC_EQ, "ADC.EQ"},
+		{ADC_S_LE, "ADC.S.LE"},
+		{YIELD_ZZ, "YIELD.ZZ"},
+		{VADD_EQ_F32, "VADD.EQ.F32"},
+		{0, "Op(0)"},
+		{YIELD_ZZ + 1, fmt.Sprintf("Op(%d)", int(YIELD_ZZ+1))},
+	}
+	for _, tt := range tests {
+		if str := tt.op.String(); str != tt.str {
+			t.Errorf("Op(%d).String() = %q, want %q", int(tt.op), str, tt.str)
+		}
+	}
+}
+
+func TestNumArgs(t *testing.T) {
+	tests := []struct {
+		inst Inst
+		n    int
+	}{
+		{Inst{Op: NOP}, 0},
+		{Inst{Op: B, Args: Args{PCRel(8)}}, 1},
+		{Inst{Op: ADD, Args: Args{R0, R1, Imm(1)}}, 3},
+		{Inst{Op: MLA, Args: Args{R0, R1, R2, R3}}, 4},
+		{Inst{Op: MOV, Args: Args{R0, nil, R2}}, 1},
+	}
+	for _, tt := range tests {
+		if n := tt.inst.NumArgs(); n != tt.n {
+			t.Errorf("%v.NumArgs() = %d, want %d", tt.inst, n, tt.n)
+		}
+		args := tt.inst.ArgsSlice()
+		if len(args) != tt.n {
+			t.Errorf("%v.ArgsSlice() has %d elements, want %d", tt.inst, len(args), tt.n)
+			continue
+		}
+		for j, arg := range args {
+			if arg != tt.inst.Args[j] {
+				t.Errorf("%v.ArgsSlice()[%d] = %v, want %v", tt.inst, j, arg, tt.inst.Args[j])
+			}
+		}
+	}
+}
+
+func TestBytes(t *testing.T) {
+	tests := []struct {
+		inst  Inst
+		order binary.ByteOrder
+		b     []byte
+	}{
+		{Inst{Enc: 0xe1a00001, Len: 4}, binary.LittleEndian, []byte{0x01, 0x00, 0xa0, 0xe1}},
+		{Inst{Enc: 0xe1a00001, Len: 4}, binary.BigEndian, []byte{0xe1, 0xa0, 0x00, 0x01}},
+		{Inst{Enc: 0x4770, Len: 2}, binary.LittleEndian, []byte{0x70, 0x47}},
+		{Inst{Enc: 0x4770, Len: 2}, binary.BigEndian, []byte{0x47, 0x70}},
+		{Inst{Enc: 0xf800f000, Len: 4, Mode: ModeThumb}, binary.LittleEndian, []byte{0x00, 0xf0, 0x00, 0xf8}},
+		{Inst{Enc: 0xf800f000, Len: 4, Mode: ModeThumb}, binary.BigEndian, []byte{0xf0, 0x00, 0xf8, 0x00}},
+		{Inst{Enc: 0x030201, Len: 3}, binary.LittleEndian, []byte{0x01, 0x02, 0x03}},
+		{Inst{Enc: 0x030201, Len: 3}, binary.BigEndian, []byte{0x03, 0x02, 0x01}},
+		{Inst{Enc: 0x01, Len: 1}, binary.BigEndian, []byte{0x01}},
+		{Inst{}, binary.LittleEndian, []byte{}},
+	}
+	for _, tt := range tests {
+		if b := tt.inst.Bytes(tt.order); !bytes.Equal(b, tt.b) {
+			t.Errorf("Inst{Enc: %#x, Len: %d}.Bytes(%v) = %x, want %x", tt.inst.Enc, tt.inst.Len, tt.order, b, tt.b)
+		}
+	}
+}
+
+func TestRegClass(t *testing.T) {
+	tests := []struct {
+		r     Reg
+		class RegClass
+		num   int
+		str   string
+	}{
+		{R0, ClassGPR, 0, "R0"},
+		{R12, ClassGPR, 12, "R12"},
+		{SP, ClassSpecial, 13, "SP"},
+		{LR, ClassGPR, 14, "LR"},
+		{PC, ClassSpecial, 15, "PC"},
+		{S31, ClassSingle, 31, "S31"},
+		{D16, ClassDouble, 16, "D16"},
+		{Q15, ClassQuad, 15, "Q15"},
+		{C7, ClassCoproc, 7, "C7"},
+		{APSR_nzcv, ClassStatus, -1, "APSR_nzcv"},
+		{FPSCR, ClassStatus, -1, "FPSCR"},
+		{SPSR, ClassStatus, -1, "SPSR"},
+		{FPEXC, ClassStatus, -1, "FPEXC"},
+		{C15 + 1, ClassNone, -1, fmt.Sprintf("Reg(%d)", int(C15+1))},
+	}
+	for _, tt := range tests {
+		if class := tt.r.Class(); class != tt.class {
+			t.Errorf("%v.Class() = %v, want %v", tt.r, class, tt.class)
+		}
+		if num := tt.r.Num(); num != tt.num {
+			t.Errorf("%v.Num() = %d, want %d", tt.r, num, tt.num)
+		}
+		if str := tt.r.String(); str != tt.str {
+			t.Errorf("Reg(%d).String() = %q, want %q", int(tt.r), str, tt.str)
+		}
+	}
+	if S0+NumS-1 != S31 || D0+NumD-1 != D31 || Q0+NumQ-1 != Q15 || C0+NumC-1 != C15 || R0+NumR-1 != R15 {
+		t.Errorf("register bank sizes do not match register constants")
+	}
+}
+
+func TestRegRange(t *testing.T) {
+	tests := []struct {
+		r     RegRange
+		valid bool
+		str   string
+	}{
+		{RegRange{D8, 8}, true, "{D8-D15}"},
+		{RegRange{D16, 16}, true, "{D16-D31}"},
+		{RegRange{D0, 17}, false, "{D0-D16}"},
+		{RegRange{D30, 2}, true, "{D30-D31}"},
+		{RegRange{S0, 32}, true, "{S0-S31}"},
+		{RegRange{S31, 1}, true, "{S31}"},
+		{RegRange{S30, 3}, false, "{S30-D0}"},
+		{RegRange{S4, 0}, false, "{S4-S3}"},
+		{RegRange{R0, 2}, false, "{R0-R1}"},
+	}
+	for _, tt := range tests {
+		if valid := tt.r.Valid(); valid != tt.valid {
+			t.Errorf("%v.Valid() = %v, want %v", tt.r, valid, tt.valid)
+		}
+		if str := tt.r.String(); str != tt.str {
+			t.Errorf("RegRange{%v, %d}.String() = %q, want %q", tt.r.First, tt.r.Count, str, tt.str)
+		}
+	}
+}
+
+func TestRegListString(t *testing.T) {
+	tests := []struct {
+		list     RegList
+		str      string
+		expanded string
+		plan9    string
+	}{
+		{0, "{}", "{}", "PUSH []"},
+		{1<<4 | 1<<14, "{R4,LR}", "{R4,LR}", "PUSH [R4,R14]"},
+		{1<<0 | 1<<1 | 1<<2 | 1<<3 | 1<<5 | 1<<14, "{R0-R3,R5,LR}", "{R0,R1,R2,R3,R5,LR}", "PUSH [R0-R3,R5,R14]"},
+		{1<<4 | 1<<5, "{R4-R5}", "{R4,R5}", "PUSH [R4-R5]"},
+		{1<<12 | 1<<13 | 1<<14 | 1<<15, "{R12-PC}", "{R12,SP,LR,PC}", "PUSH [R12-R15]"},
+		{0xffff, "{R0-PC}", "{R0,R1,R2,R3,R4,R5,R6,R7,R8,R9,R10,R11,R12,SP,LR,PC}", "PUSH [R0-R15]"},
+	}
+	for _, tt := range tests {
+		if str := tt.list.String(); str != tt.str {
+			t.Errorf("RegList(%#x).String() = %q, want %q", uint16(tt.list), str, tt.str)
+		}
+		if str := tt.list.Expanded(); str != tt.expanded {
+			t.Errorf("RegList(%#x).Expanded() = %q, want %q", uint16(tt.list), str, tt.expanded)
+		}
+		inst := Inst{Op: PUSH, Args: Args{tt.list}}
+		if str := GoSyntax(inst, 0, nil, nil); str != tt.plan9 {
+			t.Errorf("GoSyntax(PUSH RegList(%#x)) = %q, want %q", uint16(tt.list), str, tt.plan9)
+		}
+	}
+}
+
+func TestPCRelTarget(t *testing.T) {
+	tests := []struct {
+		rel  PCRel
+		pc   uint64
+		mode Mode
+		want uint64
+	}{
+		{0, 0x1000, ModeARM, 0x1008},
+		{-8, 0x1000, ModeARM, 0x1000},
+		{0x100, 0x1000, ModeARM, 0x1108},
+		{0, 0x1002, ModeThumb, 0x1006},
+		{-4, 0x1002, ModeThumb, 0x1002},
+		{0x10, 0x1002 &^ 3, ModeThumb, 0x1014}, // Align(PC, 4)
+		{4, 0xfffffff8, ModeARM, 0x4},
+		{-0x20, 0x8, ModeARM, 0xfffffff0},
+		{-0x11008, 0x1000, ModeARM, 0xffff0000},
+		{4, 0x1fffffff8, ModeARM, 0x200000004},
+		{-0x20, 0x100000008, ModeARM, 0xfffffff0},
+	}
+	for _, tt := range tests {
+		if got := tt.rel.Target(tt.pc, tt.mode); got != tt.want {
+			t.Errorf("PCRel(%d).Target(%#x, %v) = %#x, want %#x", int32(tt.rel), tt.pc, tt.mode, got, tt.want)
+		}
+	}
+}
+
+func TestImmAlt(t *testing.T) {
+	tests := []struct {
+		alt       ImmAlt
+		imm       Imm
+		carry     bool // with carry in false
+		canonical bool
+	}{
+		{ImmAlt{0xff, 0}, 0xff, false, true},
+		{ImmAlt{0x04, 2}, 0x1, false, false},
+		{ImmAlt{0x01, 2}, 0x40000000, false, true},
+		{ImmAlt{0x02, 2}, 0x80000000, true, true},
+		{ImmAlt{0x08, 4}, 0x80000000, true, false},
+		{ImmAlt{0x01, 30}, 0x4, false, false},
+		{ImmAlt{0x3f, 30}, 0xfc, false, false},
+		{ImmAlt{0xff, 28}, 0xff0, false, true},
+	}
+	for _, tt := range tests {
+		if imm := tt.alt.Imm(); imm != tt.imm {
+			t.Errorf("%v.Imm() = %#x, want %#x", tt.alt, uint32(imm), uint32(tt.imm))
+		}
+		if carry := tt.alt.Carry(false); carry != tt.carry {
+			t.Errorf("%v.Carry(false) = %v, want %v", tt.alt, carry, tt.carry)
+		}
+		if carry := tt.alt.Carry(true); carry != (tt.carry || tt.alt.Rot == 0) {
+			t.Errorf("%v.Carry(true) = %v, want %v", tt.alt, carry, tt.carry || tt.alt.Rot == 0)
+		}
+		if canon := tt.alt.Canonical(); canon != tt.canonical {
+			t.Errorf("%v.Canonical() = %v, want %v", tt.alt, canon, tt.canonical)
+		}
+	}
+
+	// The decoder reports exactly the non-canonical encodings as ImmAlt.
+	for x := uint32(0); x < 1<<12; x++ {
+		alt := ImmAlt{uint8(x), uint8(x >> 8 * 2)}
+		inst, err := Decode([]byte{byte(x), byte(x >> 8), 0xa0, 0xe3}, ModeARM)
+		if err != nil {
+			t.Fatalf("MOV %v: %v", alt, err)
+		}
+		if _, isAlt := inst.Args[1].(ImmAlt); isAlt == alt.Canonical() {
+			t.Errorf("decode %v = %v, but Canonical() = %v", alt, inst, alt.Canonical())
+		}
+	}
+}
+
+func TestGoSyntaxWrapPC(t *testing.T) {
+	// 32-bit code below 4 GB refers to 32-bit addresses,
+	// including the high exception vectors.
+	symname := func(addr uint64) (string, uint64) {
+		if addr == 0xffff0000 {
+			return "vectors", addr
+		}
+		return "", 0
+	}
+	tests := []struct {
+		enc uint32
+		pc  uint64
+		out string
+	}{
+		{0x2ad3f3c5, 0x1000, "B.CS 0xff4fdf1c"},
+		{0xeaffbbfe, 0x1000, "B vectors(SB)"},
+	}
+	for _, tt := range tests {
+		var buf [4]byte
+		binary.LittleEndian.PutUint32(buf[:], tt.enc)
+		inst, err := Decode(buf[:], ModeARM)
+		if err != nil {
+			t.Errorf("Decode(%#08x): %v", tt.enc, err)
+			continue
+		}
+		if out := GoSyntax(inst, tt.pc, symname, nil); out != tt.out {
+			t.Errorf("GoSyntax(%#08x at %#x) = %q, want %q", tt.enc, tt.pc, out, tt.out)
+		}
+	}
+}
+
+func TestGoSyntaxHighPC(t *testing.T) {
+	// 32-bit code mapped above 4 GB refers to addresses above 4 GB.
+	const pc = 0x100001000
+	symname := func(addr uint64) (string, uint64) {
+		if addr == 0x100001010 {
+			return "f", addr
+		}
+		return "", 0
+	}
+	b := Inst{Op: B, Args: Args{PCRel(8)}}
+	if out := GoSyntax(b, pc, symname, nil); out != "B f(SB)" {
+		t.Errorf("GoSyntax(%v at %#x) = %q, want %q", b, uint64(pc), out, "B f(SB)")
+	}
+	b.Args[0] = PCRel(0x10)
+	if out := GoSyntax(b, pc, symname, nil); out != "B 0x100001018" {
+		t.Errorf("GoSyntax(%v at %#x) = %q, want %q", b, uint64(pc), out, "B 0x100001018")
+	}
+}
+
+func hasEncoding(inst Inst) bool {
+	for _, enc := range Encodings(inst.Op) {
+		if inst.Enc&enc.Mask == enc.Value {
+			return true
+		}
+	}
+	return false
+}
+
+func TestEncodings(t *testing.T) {
+	encs := Encodings(ADC_S_EQ)
+	if len(encs) != 3 {
+		t.Fatalf("Encodings(ADC.S.EQ) = %d encodings, want 3", len(encs))
+	}
+	enc := encs[0]
+	if enc.Mode != ModeARM || enc.Len != 4 || enc.Mask != 0xfff00000 || enc.Value != 0x02b00000 || enc.Syntax != "ADC{S}<c> <Rd>,<Rn>,#<const>" {
+		t.Errorf("Encodings(ADC.S.EQ)[0] = %+v", enc)
+	}
+	if encs := Encodings(BKPT_EQ); len(encs) != 0 {
+		t.Errorf("Encodings(BKPT.EQ) = %+v, want none", encs)
+	}
+	if encs := Encodings(0); len(encs) != 0 {
+		t.Errorf("Encodings(0) = %+v, want none", encs)
+	}
+}
+
+func TestCoverage(t *testing.T) {
+	SetCoverage(true)
+	ResetCoverage()
+	Decode([]byte{0x02, 0x00, 0xb1, 0x00}, ModeARM) // ADCS.EQ R0, R1, R2
+	SetCoverage(false)
+	Decode([]byte{0x02, 0x00, 0xb1, 0x00}, ModeARM)
+	var used []CoverageEntry
+	for _, e := range Coverage() {
+		if e.Count > 0 {
+			used = append(used, e)
+		}
+	}
+	if len(used) != 1 || used[0].Op != ADC_EQ || used[0].Count != 1 || used[0].Syntax != "ADC{S}<c> <Rd>,<Rn>,<Rm>{,<shift>}" {
+		t.Errorf("Coverage() used %+v, want one ADC entry", used)
+	}
+	ResetCoverage()
+}
+
+func TestFields(t *testing.T) {
+	inst, err := Decode([]byte{0x01, 0x00, 0x81, 0xe2}, ModeARM) // ADD R0, R1, #1
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out []string
+	for _, f := range inst.Fields() {
+		out = append(out, fmt.Sprintf("%s:%d:%d=%#x", f.Name, f.Shift, f.Width, f.Value(inst.Enc)))
+	}
+	want := "cond:28:4=0xe S:20:1=0x0 Rn:16:4=0x1 Rd:12:4=0x0 imm12:0:12=0x1"
+	if got := strings.Join(out, " "); got != want {
+		t.Errorf("Fields() = %s, want %s", got, want)
+	}
+	if f := (Inst{}).Fields(); f != nil {
+		t.Errorf("Inst{}.Fields() = %v, want nil", f)
+	}
+
+	// Every layout must describe 32 bits,
+	// with the named fields disjoint from each other.
+	for i := range instFormats {
+		_, bits := instSyntax(i)
+		fields, ok := parseBits(bits)
+		if !ok {
+			t.Errorf("%s: malformed layout", bits)
+		}
+		var mask uint32
+		for _, f := range fields {
+			if mask&f.Mask() != 0 {
+				t.Errorf("%s: field %s overlaps earlier fields", bits, f.Name)
+			}
+			mask |= f.Mask()
+		}
+	}
+}
+
+func TestDecodeAppend(t *testing.T) {
+	code := []byte{
+		0x01, 0x00, 0xa0, 0xe3, // MOV R0, #0x1
+		0x1e, 0xff, 0x2f, 0xe1, // BX LR
+		0xf0, 0x00, 0xf0, 0xe7, // undefined
+		0x00,
+	}
+	dst := make([]Inst, 1, 8)
+	dst[0] = Inst{Op: NOP}
+	insts, err := DecodeAppend(dst, code[:8], ModeARM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(insts) != 3 || insts[0].Op != NOP || insts[1].String() != "MOV R0, #0x1" || insts[2].String() != "BX LR" {
+		t.Errorf("DecodeAppend = %v", insts)
+	}
+	if &insts[0] != &dst[0] {
+		t.Errorf("DecodeAppend did not reuse dst")
+	}
+
+	insts, err = DecodeAppend(insts[:0], code, ModeARM)
+	if err == nil || err.Error() != "offset 0x8: unknown instruction" || len(insts) != 2 {
+		t.Errorf("DecodeAppend(undefined) = %v, %v, want 2 instructions and error at offset 0x8", insts, err)
+	}
+	insts, err = DecodeAppend(nil, code[:9], ModeARM)
+	if err == nil || len(insts) != 2 {
+		t.Errorf("DecodeAppend(truncated) = %v, %v, want 2 instructions and error", insts, err)
+	}
+	if insts, err := DecodeAppend(nil, nil, ModeARM); insts != nil || err != nil {
+		t.Errorf("DecodeAppend(nil) = %v, %v, want nil, nil", insts, err)
+	}
+}
+
+func TestAppendSyntax(t *testing.T) {
+	var insts []Inst
+	for _, x := range []uint32{0xe0810002, 0xe59f0008, 0xef000012, 0xe8bd8010, 0x1a00019c, 0xe7910102} {
+		var buf [4]byte
+		binary.LittleEndian.PutUint32(buf[:], x)
+		inst, err := Decode(buf[:], ModeARM)
+		if err != nil {
+			t.Fatal(err)
+		}
+		insts = append(insts, inst)
+	}
+	dst := []byte("prefix: ")
+	for _, inst := range insts {
+		if out, want := string(AppendGNUSyntax(dst, inst)), "prefix: "+GNUSyntax(inst); out != want {
+			t.Errorf("AppendGNUSyntax(%v) = %q, want %q", inst, out, want)
+		}
+		if out, want := string(AppendGoSyntax(dst, inst, 0x1000, nil, nil)), "prefix: "+GoSyntax(inst, 0x1000, nil, nil); out != want {
+			t.Errorf("AppendGoSyntax(%v) = %q, want %q", inst, out, want)
+		}
+	}
+}
+
+func TestGNUSyntaxVersion(t *testing.T) {
+	inst, err := Decode([]byte{0x08, 0x00, 0x91, 0xe5}, ModeARM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"binutils-2.24", "2.40", "binutils-2.24.51"} {
+		v, err := ParseGNUVersion(s)
+		if err != nil {
+			t.Errorf("ParseGNUVersion(%q): %v", s, err)
+			continue
+		}
+		if out, want := GNUSyntaxVersion(inst, v), GNUSyntax(inst); out != want {
+			t.Errorf("GNUSyntaxVersion(%v, %v) = %q, want %q", inst, v, out, want)
+		}
+	}
+	for _, s := range []string{"", "binutils", "2", "2.x", "gcc-2.24"} {
+		if v, err := ParseGNUVersion(s); err == nil {
+			t.Errorf("ParseGNUVersion(%q) = %v, want error", s, v)
+		}
 	}
 }
@@ -0,0 +1,202 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Encode returns the ARM encoding of inst, the inverse of Decode.
+// The Enc and Len fields of inst are ignored. Every ARM instruction
+// is 4 bytes, so the size of an instruction is known before it is encoded.
+//
+// Encode accepts the arguments that Decode produces, along with the
+// forms that Normalize maps to them: an Imm in place of an ImmAlt and a Reg
+// in place of a RegShift with LSL #0. A PCRel argument is the offset
+// from the instruction's address plus 8, as in decoded instructions;
+// PCRelRange gives the offsets each opcode can hold.
+//
+// The encoding is chosen deterministically: Encode tries the encodings
+// of inst.Op in the order listed by Encodings and returns the first that
+// decodes back to inst (compared after Normalize). Bits that the manual
+// says should be 1, written (1) in Encoding.Bits, are set; bits that
+// should be 0 are clear. For most instructions this is the encoding
+// that assemblers produce.
+//
+// The Op constants and argument types are the interface to Encode:
+// code that builds instructions should refer to opcodes by name, such as
+// ADD_EQ, and not by number, since the numeric values are generated from
+// the instruction tables and may change as instructions are added.
+// LookupOp maps an opcode's printed name back to the Op.
+func Encode(inst Inst) ([]byte, error) {
+	x, err := encode(inst)
+	if err != nil {
+		return nil, err
+	}
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, x)
+	return buf, nil
+}
+
+// encode implements Encode, returning the instruction word.
+func encode(inst Inst) (uint32, error) {
+	want := Normalize(inst)
+	var alt uint32
+	haveAlt := false
+Search:
+	for i := range instFormats {
+		f := &instFormats[i]
+		_, x, ok := opEncoding(f, inst.Op)
+		if !ok {
+			continue
+		}
+		_, bits := instSyntax(i)
+		x |= shouldBeOne(bits)
+		for j, aop := range f.args {
+			if aop == 0 {
+				for _, arg := range inst.Args[j:] {
+					if arg != nil {
+						continue Search
+					}
+				}
+				break
+			}
+			if x, ok = encodeArg(aop, inst.Args[j], x); !ok {
+				continue Search
+			}
+		}
+
+		// Check that x decodes back to inst. This catches
+		// arguments that the decoder rejects (like a POP
+		// with too few registers) and encodings claimed by
+		// a higher-priority instruction. An encoding that
+		// decodes to an equivalent instruction with a
+		// different opcode, like STMDB SP! for PUSH, is used
+		// only if there is no exact one.
+		dec, k := decode(x)
+		if k < 0 || !Normalize(dec).Equal(want) {
+			continue
+		}
+		if dec.Op == inst.Op {
+			return x, nil
+		}
+		if !haveAlt {
+			alt, haveAlt = x, true
+		}
+	}
+	if haveAlt {
+		return alt, nil
+	}
+	return 0, fmt.Errorf("cannot encode %v", inst)
+}
+
+// shouldBeOne returns the bits marked (1) in the bit layout bits,
+// which the manual says should be 1 in every encoding.
+func shouldBeOne(bits string) uint32 {
+	var x uint32
+	shift := uint(32)
+	for _, b := range strings.Split(bits, "|") {
+		width := uint(1)
+		if k := strings.Index(b, ":"); k >= 0 {
+			n, err := strconv.Atoi(b[k+1:])
+			if err != nil {
+				return 0
+			}
+			width = uint(n)
+		}
+		if width > shift {
+			return 0
+		}
+		shift -= width
+		if b == "(1)" {
+			x |= 1 << shift
+		}
+	}
+	return x
+}
+
+// LookupOp returns the Op with the given name, as printed by Op.String,
+// like "ADD.S.EQ" or "B". It reports false if there is no such Op.
+func LookupOp(name string) (Op, bool) {
+	op, ok := opsByName()[name]
+	return op, ok
+}
+
+// opsByName returns a map from each Op's printed name to the Op,
+// built on first use.
+var opsByName = sync.OnceValue(func() map[string]Op {
+	m := make(map[string]Op)
+	for op := Op(1); int(op)+1 < len(opstrIndex); op++ {
+		if name := op.name(); name != "" {
+			m[name] = op
+		}
+	}
+	return m
+})
+
+// An OffsetRange is a range of PC-relative offsets.
+type OffsetRange struct {
+	Min   int32 // smallest offset
+	Max   int32 // largest offset
+	Align int32 // offsets must be multiples of Align
+}
+
+// Contains reports whether r contains the offset off.
+func (r OffsetRange) Contains(off int32) bool {
+	return r.Min <= off && off <= r.Max && off%r.Align == 0
+}
+
+// pcRelRanges gives the offsets that each kind of PC-relative argument can hold.
+var pcRelRanges = map[instArg]OffsetRange{
+	arg_label24:      {-1 << 25, 1<<25 - 4, 4},
+	arg_label24H:     {-1 << 25, 1<<25 - 2, 2},
+	arg_label_m_12:   {-(1<<12 - 1), 0, 1},
+	arg_label_p_12:   {0, 1<<12 - 1, 1},
+	arg_label_pm_12:  {-(1<<12 - 1), 1<<12 - 1, 1},
+	arg_label_pm_4_4: {-(1<<8 - 1), 1<<8 - 1, 1},
+}
+
+// PCRelRange returns the range of offsets from the PC, the instruction's
+// address plus 8, that op can encode in a PCRel argument or a Mem argument
+// with base PC, such as the ±32 MB reach of B and BL or the ±4095 bytes of LDR (literal).
+// A code generator can use it to decide whether a branch or literal load needs a
+// longer sequence, such as a veneer. If op has several PC-relative encodings,
+// the range covers all of them. PCRelRange reports false if op has none.
+// It does not report the offsets reachable by instructions like LDR and VLDR
+// whose general base-register forms can also use PC.
+func PCRelRange(op Op) (OffsetRange, bool) {
+	var r OffsetRange
+	found := false
+	for i := range instFormats {
+		f := &instFormats[i]
+		if _, _, ok := opEncoding(f, op); !ok {
+			continue
+		}
+		for _, aop := range f.args {
+			fr, ok := pcRelRanges[aop]
+			if !ok {
+				continue
+			}
+			if !found {
+				r, found = fr, true
+				continue
+			}
+			if fr.Min < r.Min {
+				r.Min = fr.Min
+			}
+			if fr.Max > r.Max {
+				r.Max = fr.Max
+			}
+			if fr.Align < r.Align {
+				r.Align = fr.Align
+			}
+		}
+	}
+	return r, found
+}
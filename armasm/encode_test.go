@@ -0,0 +1,175 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"io/ioutil"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+// TestEncodeDecodeTxt checks that every instruction in testdata/decode.txt
+// encodes to bytes that decode to the same instruction.
+// Many of the test cases are random words with bits the decoder ignores,
+// so the encoding may differ from the original in those bits.
+func TestEncodeDecodeTxt(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/decode.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line == "" || strings.HasPrefix(line, "#") || strings.Contains(line, "error:") {
+			continue
+		}
+		i := strings.Index(line, "|")
+		code, err := hex.DecodeString(line[:i])
+		if err != nil {
+			t.Errorf("parsing %q: %v", line, err)
+			continue
+		}
+		inst, err := Decode(code, ModeARM)
+		if err != nil {
+			t.Errorf("Decode(%x): %v", code, err)
+			continue
+		}
+		enc, err := Encode(inst)
+		if err != nil {
+			t.Errorf("Encode(%v): %v", inst, err)
+			continue
+		}
+		inst2, err := Decode(enc, ModeARM)
+		if err != nil || !inst2.Equal(inst) {
+			t.Errorf("Encode(%v) [%x] = %x, decodes to %v, %v", inst, code, enc, inst2, err)
+		}
+	}
+}
+
+// TestEncodeRandom checks that encoding a decoded random instruction
+// yields an encoding that decodes to the same instruction.
+// The encoding may differ from the original in bits the decoder ignores.
+func TestEncodeRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	n := 200000
+	if testing.Short() {
+		n = 10000
+	}
+	var buf [4]byte
+	for i := 0; i < n; i++ {
+		binary.LittleEndian.PutUint32(buf[:], r.Uint32())
+		inst, err := Decode(buf[:], ModeARM)
+		if err != nil {
+			continue
+		}
+		enc, err := Encode(inst)
+		if err != nil {
+			t.Errorf("Encode(%v) [%x]: %v", inst, buf, err)
+			continue
+		}
+		inst2, err := Decode(enc, ModeARM)
+		if err != nil || !inst2.Equal(inst) {
+			t.Errorf("Encode(%v) [%x] = %x, decodes to %v, %v", inst, buf, enc, inst2, err)
+		}
+	}
+}
+
+var encodeTests = []struct {
+	inst Inst
+	enc  string // hex, or "error"
+}{
+	{Inst{Op: ADD, Args: Args{R0, R1, R2}}, "020081e0"},
+	{Inst{Op: ADD_S, Args: Args{R0, R1, Imm(0xff000000)}}, "ff0491e2"},
+	{Inst{Op: ADD_S, Args: Args{R0, R1, Imm(0x101)}}, "error"},
+	{Inst{Op: ADD_EQ, Args: Args{R0, R1, RegShift{R2, ShiftLeft, 3}}}, "82018100"},
+	{Inst{Op: ADD, Args: Args{R0, R1, S2}}, "error"},
+	{Inst{Op: ADD, Args: Args{R0, R1}}, "error"},
+	{Inst{Op: MOV, Args: Args{R0, R1}}, "0100a0e1"},
+	{Inst{Op: BX, Args: Args{LR}}, "1eff2fe1"},
+	{Inst{Op: BLX, Args: Args{R3}}, "33ff2fe1"},
+	{Inst{Op: B, Args: Args{PCRel(-8)}}, "feffffea"},
+	{Inst{Op: B, Args: Args{PCRel(2)}}, "error"},
+	{Inst{Op: BLX, Args: Args{PCRel(6)}}, "010000fb"},
+	{Inst{Op: PUSH, Args: Args{RegList(1 << 4)}}, "04402de5"},
+	{Inst{Op: PUSH, Args: Args{RegList(1<<4 | 1<<14)}}, "10402de9"},
+	{Inst{Op: POP, Args: Args{RegList(1<<4 | 1<<15)}}, "1080bde8"},
+	{Inst{Op: STMDB, Args: Args{Mem{Base: R0, Mode: AddrLDM_WB}, RegList(1<<4 | 1<<5)}}, "300020e9"},
+	{Inst{Op: STMDB, Args: Args{Mem{Base: SP, Mode: AddrLDM_WB}, RegList(1<<4 | 1<<5)}}, "30002de9"},
+	{Inst{Op: LDR, Args: Args{R0, Mem{Base: R1, Mode: AddrPreIndex, Offset: -8}}}, "080031e5"},
+	{Inst{Op: LDRD, Args: Args{R4, R5, Mem{Base: R6, Mode: AddrOffset, Offset: 8}}}, "d840c6e1"},
+	{Inst{Op: LDRD, Args: Args{R4, R6, Mem{Base: R6, Mode: AddrOffset, Offset: 8}}}, "error"},
+	{Inst{Op: VLDR, Args: Args{D5, Mem{Base: R4, Mode: AddrOffset, Offset: -16}}}, "045b14ed"},
+	{Inst{Op: VLDR, Args: Args{S5, Mem{Base: R4, Mode: AddrOffset, Offset: 16}}}, "042ad4ed"},
+	{Inst{Op: VADD_F64, Args: Args{D0, D1, D2}}, "020b31ee"},
+	{Inst{Op: VADD_F64, Args: Args{S0, S1, S2}}, "error"},
+	{Inst{Op: VMOV_F32, Args: Args{S0, Imm(0x70)}}, "000ab7ee"},
+	{Inst{Op: UXTB, Args: Args{R0, RegShift{R1, RotateRight, 8}}}, "7104efe6"},
+	{Inst{Op: UXTB, Args: Args{R0, RegShift{R1, RotateRight, 4}}}, "error"},
+	{Inst{Op: BFI, Args: Args{R0, R1, Imm(4), Imm(8)}}, "1102cbe7"},
+	{Inst{Op: BFI, Args: Args{R0, R1, Imm(30), Imm(8)}}, "error"},
+	{Inst{Op: SETEND, Args: Args{BigEndian}}, "000201f1"},
+	{Inst{Op: NOP}, "00f020e3"},
+	{Inst{Op: MRS, Args: Args{R0, APSR}}, "00000fe1"},
+	{Inst{Op: VMRS, Args: Args{APSR_nzcv, FPSCR}}, "10faf1ee"},
+	{Inst{Op: Op(0xffff)}, "error"},
+}
+
+func TestEncode(t *testing.T) {
+	for _, tt := range encodeTests {
+		enc, err := Encode(tt.inst)
+		want := tt.enc
+		if want == "error" {
+			if err == nil {
+				t.Errorf("Encode(%v) = %x, want error", tt.inst, enc)
+			}
+			continue
+		}
+		if err != nil || hex.EncodeToString(enc) != want {
+			t.Errorf("Encode(%v) = %x, %v, want %s", tt.inst, enc, err, want)
+		}
+	}
+}
+
+func TestPCRelRange(t *testing.T) {
+	tests := []struct {
+		op Op
+		r  OffsetRange
+		ok bool
+	}{
+		{B, OffsetRange{-1 << 25, 1<<25 - 4, 4}, true},
+		{BL_EQ, OffsetRange{-1 << 25, 1<<25 - 4, 4}, true},
+		{BLX, OffsetRange{-1 << 25, 1<<25 - 2, 2}, true},
+		{LDR, OffsetRange{-4095, 4095, 1}, true},
+		{LDRB_NE, OffsetRange{-4095, 4095, 1}, true},
+		{PLD, OffsetRange{-4095, 4095, 1}, true},
+		{LDRD, OffsetRange{}, false},
+		{ADD, OffsetRange{}, false},
+	}
+	for _, tt := range tests {
+		r, ok := PCRelRange(tt.op)
+		if r != tt.r || ok != tt.ok {
+			t.Errorf("PCRelRange(%v) = %+v, %v, want %+v, %v", tt.op, r, ok, tt.r, tt.ok)
+		}
+	}
+
+	r := OffsetRange{-8, 8, 4}
+	for off, want := range map[int32]bool{-12: false, -8: true, 0: true, 2: false, 8: true, 12: false} {
+		if r.Contains(off) != want {
+			t.Errorf("%+v.Contains(%d) = %v, want %v", r, off, !want, want)
+		}
+	}
+}
+
+func TestLookupOp(t *testing.T) {
+	for _, op := range []Op{ADD, ADD_S_EQ, B, VADD_EQ_F64, VMRS} {
+		if op2, ok := LookupOp(op.String()); !ok || op2 != op {
+			t.Errorf("LookupOp(%q) = %v, %v, want %v, true", op.String(), op2, ok, op)
+		}
+	}
+	if op, ok := LookupOp("XYZZY"); ok {
+		t.Errorf("LookupOp(\"XYZZY\") = %v, true, want false", op)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+// An Encoding describes one way to encode an instruction with a given Op.
+// An instruction word x uses the encoding if x&Mask == Value,
+// although the decoder may still reject particular operand values
+// (for example, PC where the manual marks its use UNPREDICTABLE)
+// or prefer a higher-priority encoding that also matches.
+type Encoding struct {
+	Mode   Mode   // execution mode
+	Len    int    // length of encoding in bytes
+	Mask   uint32 // bits fixed by the encoding
+	Value  uint32 // values of the fixed bits
+	Syntax string // assembly syntax in the ARM manual, like "ADC{S}<c> <Rd>,<Rn>,#<const>"
+	Bits   string // bit layout in the ARM manual, like "cond:4|0|0|1|0|1|0|1|S|Rn:4|Rd:4|imm12:12"
+}
+
+// Encodings returns the encodings that decode to op,
+// in decoder table order.
+// Only ARM mode encodings are known; Thumb is not yet supported.
+func Encodings(op Op) []Encoding {
+	if op.name() == "" {
+		return nil
+	}
+	var list []Encoding
+	for i := range instFormats {
+		mask, value, ok := opEncoding(&instFormats[i], op)
+		if !ok {
+			continue
+		}
+		syntax, bits := instSyntax(i)
+		list = append(list, Encoding{
+			Mode:   ModeARM,
+			Len:    4,
+			Mask:   mask,
+			Value:  value,
+			Syntax: syntax,
+			Bits:   bits,
+		})
+	}
+	return list
+}
+
+// opEncoding returns the mask and value of the encodings of op using format f,
+// with the op bits, such as the condition and S bit, fixed to those of op.
+// It reports false if f cannot encode op.
+func opEncoding(f *instFormat, op Op) (mask, value uint32, ok bool) {
+	if op < f.op {
+		return 0, 0, false
+	}
+	// The op bits hold the difference between op and f.op,
+	// such as the condition and S bit; fix them in the encoding.
+	delta := uint32(op - f.op)
+	mask, value = f.mask, f.value
+	shift := uint(0)
+	for opBits := f.opBits; opBits != 0; opBits >>= 16 {
+		n := uint(opBits & 0xFF)
+		off := uint((opBits >> 8) & 0xFF)
+		mask |= (1<<n - 1) << off
+		value |= (delta >> shift & (1<<n - 1)) << off
+		shift += n
+	}
+	if delta>>shift != 0 {
+		return 0, 0, false
+	}
+	// Conditional instructions cannot use condition 15,
+	// and BKPT encodes a condition but cannot have one.
+	if f.mask&0xf0000000 == 0 && value&0xf0000000 == 0xf0000000 {
+		return 0, 0, false
+	}
+	if op&^15 == BKPT_EQ && op != BKPT {
+		return 0, 0, false
+	}
+	return mask, value, true
+}
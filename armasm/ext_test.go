@@ -101,6 +101,8 @@ func testExtDis(
 	allowedMismatch func(text string, size int, inst *Inst, dec ExtInst) bool,
 ) {
 	start := time.Now()
+	SetCoverage(true)
+	defer SetCoverage(false)
 	ext := &ExtDis{
 		Dec:  make(chan ExtInst),
 		Arch: arch,
@@ -248,7 +250,7 @@ func disasm(syntax string, mode Mode, src []byte) (inst Inst, text string) {
 		case "gnu":
 			text = GNUSyntax(inst)
 		//case "plan9":
-		//	text = plan9Syntax(inst, 0, nil)
+		//	text = GoSyntax(inst, 0, nil)
 		default:
 			text = "error: unknown syntax " + syntax
 		}
@@ -302,8 +304,8 @@ func coverage() float64 {
 
 func decodeCoverage() float64 {
 	n := 0
-	for _, t := range decoderCover {
-		if t {
+	for _, e := range Coverage() {
+		if e.Count > 0 {
 			n++
 		}
 	}
@@ -0,0 +1,74 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"strconv"
+	"strings"
+)
+
+// A Field is a named bit field in an instruction encoding.
+type Field struct {
+	Name  string // field name in the ARM manual, like "cond", "Rn", or "imm12"
+	Shift uint   // position of the least significant bit
+	Width uint   // number of bits
+}
+
+// Mask returns the bits of an encoding occupied by f.
+func (f Field) Mask() uint32 {
+	return (1<<f.Width - 1) << f.Shift
+}
+
+// Value returns the value of f in the encoding enc.
+func (f Field) Value(enc uint32) uint32 {
+	return enc >> f.Shift & (1<<f.Width - 1)
+}
+
+// Fields returns the named bit fields of i's encoding, i.Enc,
+// from most to least significant, as laid out in the ARM manual
+// for the encoding that produced i.
+// Fixed bits, including those the manual says should be 0 or 1, are omitted.
+// Fields returns nil if i.Enc does not decode.
+func (i Inst) Fields() []Field {
+	if i.Len != 4 {
+		return nil
+	}
+	_, j := decode(i.Enc)
+	if j < 0 {
+		return nil
+	}
+	_, bits := instSyntax(j)
+	fields, _ := parseBits(bits)
+	return fields
+}
+
+// parseBits parses a bit layout like "cond:4|0|0|1|0|1|0|1|S|Rn:4|Rd:4|imm12:12",
+// in which each |-separated entry is a fixed bit, like 0 or (1),
+// or a field name, with a :width suffix for fields wider than one bit.
+// It reports false if the layout is malformed or does not total 32 bits.
+func parseBits(bits string) ([]Field, bool) {
+	var list []Field
+	shift := uint(32)
+	for _, b := range strings.Split(bits, "|") {
+		name, width := b, uint(1)
+		if k := strings.Index(b, ":"); k >= 0 {
+			n, err := strconv.Atoi(b[k+1:])
+			if err != nil {
+				return nil, false
+			}
+			name, width = b[:k], uint(n)
+		}
+		if width > shift {
+			return nil, false
+		}
+		shift -= width
+		switch name {
+		case "0", "1", "(0)", "(1)":
+			continue
+		}
+		list = append(list, Field{Name: name, Shift: shift, Width: width})
+	}
+	return list, shift == 0
+}
@@ -5,9 +5,10 @@
 package armasm
 
 import (
-	"bytes"
 	"fmt"
+	"strconv"
 	"strings"
+	"sync"
 )
 
 var saveDot = strings.NewReplacer(
@@ -21,144 +22,216 @@ var saveDot = strings.NewReplacer(
 	".32", "_dot_32",
 )
 
+// gnuOpNames returns the GNU mnemonic for each Op, indexed by Op.
+// The table is built on first use rather than at package initialization.
+var gnuOpNames = sync.OnceValue(func() []string {
+	names := make([]string, len(opstrIndex))
+	for op := range names {
+		name := Op(op).name()
+		if name == "" {
+			continue
+		}
+		name = saveDot.Replace(name)
+		name = strings.Replace(name, ".", "", -1)
+		name = strings.Replace(name, "_dot_", ".", -1)
+		names[op] = strings.ToLower(name)
+	}
+	return names
+})
+
 // GNUSyntax returns the GNU assembler syntax for the instruction, as defined by GNU binutils.
 // This form typically matches the syntax defined in the ARM Reference Manual.
 func GNUSyntax(inst Inst) string {
-	var buf bytes.Buffer
-	op := inst.Op.String()
-	op = saveDot.Replace(op)
-	op = strings.Replace(op, ".", "", -1)
-	op = strings.Replace(op, "_dot_", ".", -1)
-	op = strings.ToLower(op)
-	buf.WriteString(op)
+	return string(AppendGNUSyntax(nil, inst))
+}
+
+// A GNUVersion identifies a GNU binutils release, such as 2.24.
+type GNUVersion struct {
+	Major, Minor int
+}
+
+// ParseGNUVersion parses a binutils release name
+// like "binutils-2.24" or "2.24".
+func ParseGNUVersion(s string) (GNUVersion, error) {
+	t := strings.TrimPrefix(s, "binutils-")
+	i := strings.Index(t, ".")
+	if i < 0 {
+		return GNUVersion{}, fmt.Errorf("invalid binutils version %q", s)
+	}
+	if j := strings.Index(t[i+1:], "."); j >= 0 {
+		t = t[:i+1+j] // ignore patch level
+	}
+	major, err1 := strconv.Atoi(t[:i])
+	minor, err2 := strconv.Atoi(t[i+1:])
+	if err1 != nil || err2 != nil || major < 0 || minor < 0 {
+		return GNUVersion{}, fmt.Errorf("invalid binutils version %q", s)
+	}
+	return GNUVersion{major, minor}, nil
+}
+
+func (v GNUVersion) String() string {
+	return fmt.Sprintf("binutils-%d.%d", v.Major, v.Minor)
+}
+
+// GNUSyntaxVersion is like GNUSyntax but returns the text printed by
+// the objdump from binutils release v, for comparing against golden
+// files produced by that release. It matches arm64asm.GNUSyntaxVersion,
+// so that tools handling both instruction sets can pin one release.
+// No release-specific differences are known for the ARM instruction set,
+// so for now it returns GNUSyntax(inst) for every v; differences found
+// by comparing against a particular objdump belong here.
+func GNUSyntaxVersion(inst Inst, v GNUVersion) string {
+	return GNUSyntax(inst)
+}
+
+// AppendGNUSyntax appends the GNU assembler syntax for the instruction
+// to dst and returns the extended buffer. Reusing the buffer across calls
+// avoids allocating a string for each instruction.
+func AppendGNUSyntax(dst []byte, inst Inst) []byte {
+	if names := gnuOpNames(); int(inst.Op) < len(names) && names[inst.Op] != "" {
+		dst = append(dst, names[inst.Op]...)
+	} else {
+		dst = appendLower(dst, inst.Op.String())
+	}
 	sep := " "
 	for i, arg := range inst.Args {
 		if arg == nil {
 			break
 		}
-		text := gnuArg(&inst, i, arg)
-		if text == "" {
+		if gnuSkipArg(&inst, i) {
 			continue
 		}
-		buf.WriteString(sep)
+		dst = append(dst, sep...)
 		sep = ", "
-		buf.WriteString(text)
+		dst = appendGNUArg(dst, &inst, i, arg)
 	}
-	return buf.String()
+	return dst
 }
 
-func gnuArg(inst *Inst, argIndex int, arg Arg) string {
+// gnuSkipArg reports whether the GNU syntax omits argument argIndex of inst.
+func gnuSkipArg(inst *Inst, argIndex int) bool {
 	switch inst.Op &^ 15 {
 	case LDRD_EQ, LDREXD_EQ, STRD_EQ:
-		if argIndex == 1 {
-			// second argument in consecutive pair not printed
-			return ""
-		}
+		// second argument in consecutive pair not printed
+		return argIndex == 1
 	case STREXD_EQ:
-		if argIndex == 2 {
-			// second argument in consecutive pair not printed
-			return ""
-		}
+		// second argument in consecutive pair not printed
+		return argIndex == 2
 	}
+	return false
+}
 
+func appendGNUArg(dst []byte, inst *Inst, argIndex int, arg Arg) []byte {
 	switch arg := arg.(type) {
 	case Imm:
 		switch inst.Op &^ 15 {
 		case BKPT_EQ:
-			return fmt.Sprintf("%#04x", uint32(arg))
+			return appendHex(dst, uint64(arg), 4)
 		case SVC_EQ:
-			return fmt.Sprintf("%#08x", uint32(arg))
+			return appendHex(dst, uint64(arg), 8)
 		}
-		return fmt.Sprintf("#%d", int32(arg))
+		return strconv.AppendInt(append(dst, '#'), int64(int32(arg)), 10)
 
 	case ImmAlt:
-		return fmt.Sprintf("#%d, %d", arg.Val, arg.Rot)
+		dst = strconv.AppendUint(append(dst, '#'), uint64(arg.Val), 10)
+		return strconv.AppendUint(append(dst, ", "...), uint64(arg.Rot), 10)
 
 	case Mem:
-		R := gnuArg(inst, -1, arg.Base)
-		X := ""
-		if arg.Sign != 0 {
-			X = ""
+		base := func(dst []byte) []byte { return appendGNUArg(dst, inst, -1, arg.Base) }
+		index := func(dst []byte) []byte {
+			if arg.Sign == 0 {
+				return strconv.AppendInt(append(dst, '#'), int64(arg.Offset), 10)
+			}
 			if arg.Sign < 0 {
-				X = "-"
+				dst = append(dst, '-')
 			}
-			X += gnuArg(inst, -1, arg.Index)
+			dst = appendGNUArg(dst, inst, -1, arg.Index)
 			if arg.Shift == ShiftLeft && arg.Count == 0 {
 				// nothing
 			} else if arg.Shift == RotateRightExt {
-				X += ", rrx"
+				dst = append(dst, ", rrx"...)
 			} else {
-				X += fmt.Sprintf(", %s #%d", strings.ToLower(arg.Shift.String()), arg.Count)
+				dst = appendLower(append(dst, ", "...), arg.Shift.String())
+				dst = strconv.AppendUint(append(dst, " #"...), uint64(arg.Count), 10)
 			}
-		} else {
-			X = fmt.Sprintf("#%d", arg.Offset)
+			return dst
 		}
+		zero := arg.Sign == 0 && arg.Offset == 0
 
 		switch arg.Mode {
 		case AddrOffset:
-			if X == "#0" {
-				return fmt.Sprintf("[%s]", R)
+			dst = base(append(dst, '['))
+			if !zero {
+				dst = index(append(dst, ", "...))
 			}
-			return fmt.Sprintf("[%s, %s]", R, X)
+			return append(dst, ']')
 		case AddrPreIndex:
-			return fmt.Sprintf("[%s, %s]!", R, X)
+			dst = base(append(dst, '['))
+			return append(index(append(dst, ", "...)), "]!"...)
 		case AddrPostIndex:
-			return fmt.Sprintf("[%s], %s", R, X)
+			dst = base(append(dst, '['))
+			return index(append(dst, "], "...))
 		case AddrLDM:
-			if X == "#0" {
-				return R
+			if zero {
+				return base(dst)
 			}
 		case AddrLDM_WB:
-			if X == "#0" {
-				return R + "!"
+			if zero {
+				return append(base(dst), '!')
 			}
 		}
-		return fmt.Sprintf("[%s Mode(%d) %s]", R, int(arg.Mode), X)
+		dst = base(append(dst, '['))
+		dst = strconv.AppendInt(append(dst, " Mode("...), int64(arg.Mode), 10)
+		return append(index(append(dst, ") "...)), ']')
 
 	case PCRel:
-		return fmt.Sprintf(".%+#x", int32(arg)+4)
+		return appendSignedHex(append(dst, '.'), int64(int32(arg))+4)
 
 	case Reg:
 		switch inst.Op &^ 15 {
 		case LDREX_EQ:
 			if argIndex == 0 {
-				return fmt.Sprintf("r%d", int32(arg))
+				return strconv.AppendInt(append(dst, 'r'), int64(arg), 10)
 			}
 		}
 		switch arg {
 		case R10:
-			return "sl"
+			return append(dst, "sl"...)
 		case R11:
-			return "fp"
+			return append(dst, "fp"...)
 		case R12:
-			return "ip"
+			return append(dst, "ip"...)
 		}
 
 	case RegList:
-		var buf bytes.Buffer
-		fmt.Fprintf(&buf, "{")
+		dst = append(dst, '{')
 		sep := ""
 		for i := 0; i < 16; i++ {
 			if arg&(1<<uint(i)) != 0 {
-				fmt.Fprintf(&buf, "%s%s", sep, gnuArg(inst, -1, Reg(i)))
+				dst = append(dst, sep...)
+				dst = appendGNUArg(dst, inst, -1, Reg(i))
 				sep = ", "
 			}
 		}
-		fmt.Fprintf(&buf, "}")
-		return buf.String()
+		return append(dst, '}')
 
 	case RegShift:
+		dst = appendGNUArg(dst, inst, -1, arg.Reg)
 		if arg.Shift == ShiftLeft && arg.Count == 0 {
-			return gnuArg(inst, -1, arg.Reg)
+			return dst
 		}
 		if arg.Shift == RotateRightExt {
-			return gnuArg(inst, -1, arg.Reg) + ", rrx"
+			return append(dst, ", rrx"...)
 		}
-		return fmt.Sprintf("%s, %s #%d", gnuArg(inst, -1, arg.Reg), strings.ToLower(arg.Shift.String()), arg.Count)
+		dst = appendLower(append(dst, ", "...), arg.Shift.String())
+		return strconv.AppendUint(append(dst, " #"...), uint64(arg.Count), 10)
 
 	case RegShiftReg:
-		return fmt.Sprintf("%s, %s %s", gnuArg(inst, -1, arg.Reg), strings.ToLower(arg.Shift.String()), gnuArg(inst, -1, arg.RegCount))
+		dst = appendGNUArg(dst, inst, -1, arg.Reg)
+		dst = appendLower(append(dst, ", "...), arg.Shift.String())
+		return appendGNUArg(append(dst, ' '), inst, -1, arg.RegCount)
 
 	}
-	return strings.ToLower(arg.String())
+	n := len(dst)
+	return toLower(appendArg(dst, arg), n)
 }
@@ -0,0 +1,119 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+// ARMImmediates returns the encodings of v as an ARM modified immediate
+// constant, an 8-bit value rotated right by an even amount, in order of
+// increasing rotation. The first, if any, is the canonical encoding
+// (see ImmAlt.Canonical). ARMImmediates returns nil if v has no encoding.
+func ARMImmediates(v uint32) []ImmAlt {
+	var list []ImmAlt
+	for rot := uint(0); rot < 32; rot += 2 {
+		val := v<<rot | v>>(32-rot)
+		if val < 1<<8 {
+			list = append(list, ImmAlt{uint8(val), uint8(rot)})
+		}
+	}
+	return list
+}
+
+// ThumbImmediate returns the encoding of v as a Thumb-2 modified immediate
+// constant, the 12-bit i:imm3:imm8 field that ThumbExpandImm expands to v.
+// Unlike an ARM modified immediate, a constant has at most one such encoding.
+// ThumbImmediate reports false if v has none.
+func ThumbImmediate(v uint32) (uint16, bool) {
+	b := v & 0xff
+	switch {
+	case v == b:
+		return uint16(b), true
+	case b != 0 && v == b<<16|b:
+		return 0x100 | uint16(b), true
+	case b != 0 && v == b*0x01010101:
+		return 0x300 | uint16(b), true
+	}
+	if b := v >> 8 & 0xff; b != 0 && v == b<<24|b<<8 {
+		return 0x200 | uint16(b), true
+	}
+	// Rotated form: 1bcdefgh rotated right by 8 to 31.
+	for rot := uint(8); rot < 32; rot++ {
+		val := v<<rot | v>>(32-rot)
+		if val&^0x7f == 0x80 {
+			return uint16(rot<<7) | uint16(val&0x7f), true
+		}
+	}
+	return 0, false
+}
+
+// ThumbExpandImm returns the constant encoded by the 12-bit Thumb-2
+// modified immediate field imm12 (i:imm3:imm8). It reports false
+// if imm12 is not a valid encoding: a replicated form with a zero byte,
+// which the ARM manual marks UNPREDICTABLE, or a value that does not fit in 12 bits.
+func ThumbExpandImm(imm12 uint16) (uint32, bool) {
+	if imm12 >= 1<<12 {
+		return 0, false
+	}
+	b := uint32(imm12 & 0xff)
+	if imm12>>10 == 0 {
+		switch imm12 >> 8 {
+		case 0:
+			return b, true
+		case 1:
+			return b<<16 | b, b != 0
+		case 2:
+			return b<<24 | b<<8, b != 0
+		default:
+			return b * 0x01010101, b != 0
+		}
+	}
+	val := 0x80 | uint32(imm12&0x7f)
+	rot := uint(imm12 >> 7)
+	return val>>rot | val<<(32-rot), true
+}
+
+// MOVWImmediate returns the 16-bit immediate with which MOVW loads v,
+// reporting false if v does not fit in 16 bits.
+func MOVWImmediate(v uint32) (uint16, bool) {
+	return uint16(v), v < 1<<16
+}
+
+// A ConstEncoding is a way to load a 32-bit constant into a register
+// with a single instruction.
+type ConstEncoding struct {
+	Op    Op     // MOV_EQ, MVN_EQ, or MOVW_EQ
+	Field uint32 // the instruction's immediate field
+}
+
+// ConstEncodings returns the ways to load v into a register with a single
+// MOV, MVN, or MOVW instruction in the given mode, in that order.
+// For MOV and MVN in ARM mode, Field is the 12-bit rot/2:imm8 field
+// of each of ARMImmediates(v) or ARMImmediates(^v); in Thumb mode,
+// it is the i:imm3:imm8 field of the 32-bit MOV.W or MVN encoding,
+// from ThumbImmediate(v) or ThumbImmediate(^v).
+// For MOVW, Field is the 16-bit imm4:imm12 (or imm4:i:imm3:imm8) value,
+// which is v itself. MOVW requires ARMv6T2 or later.
+// ConstEncodings returns nil if v needs more than one instruction,
+// such as MOVW and MOVT or a literal pool load.
+func ConstEncodings(v uint32, mode Mode) []ConstEncoding {
+	var list []ConstEncoding
+	for _, op := range []Op{MOV_EQ, MVN_EQ} {
+		x := v
+		if op == MVN_EQ {
+			x = ^v
+		}
+		if mode == ModeThumb {
+			if f, ok := ThumbImmediate(x); ok {
+				list = append(list, ConstEncoding{op, uint32(f)})
+			}
+			continue
+		}
+		for _, a := range ARMImmediates(x) {
+			list = append(list, ConstEncoding{op, uint32(a.Rot/2)<<8 | uint32(a.Val)})
+		}
+	}
+	if f, ok := MOVWImmediate(v); ok {
+		list = append(list, ConstEncoding{MOVW_EQ, uint32(f)})
+	}
+	return list
+}
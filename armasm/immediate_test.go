@@ -0,0 +1,132 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"reflect"
+	"testing"
+)
+
+var armImmTests = []struct {
+	v    uint32
+	list []ImmAlt
+}{
+	{0, []ImmAlt{{0, 0}, {0, 2}, {0, 4}, {0, 6}, {0, 8}, {0, 10}, {0, 12}, {0, 14}, {0, 16}, {0, 18}, {0, 20}, {0, 22}, {0, 24}, {0, 26}, {0, 28}, {0, 30}}},
+	{0xff, []ImmAlt{{0xff, 0}}},
+	{0x10, []ImmAlt{{0x10, 0}, {0x40, 2}, {0x1, 28}, {0x4, 30}}},
+	{0xff000000, []ImmAlt{{0xff, 8}}},
+	{0xf000000f, []ImmAlt{{0xff, 4}}},
+	{0x101, nil},
+	{0x1fe, nil},
+}
+
+func TestARMImmediates(t *testing.T) {
+	for _, tt := range armImmTests {
+		list := ARMImmediates(tt.v)
+		if !reflect.DeepEqual(list, tt.list) {
+			t.Errorf("ARMImmediates(%#x) = %v, want %v", tt.v, list, tt.list)
+		}
+		for i, a := range list {
+			if uint32(a.Imm()) != tt.v {
+				t.Errorf("ARMImmediates(%#x)[%d] = %v, which is %#x", tt.v, i, a, uint32(a.Imm()))
+			}
+			if a.Canonical() != (i == 0) {
+				t.Errorf("ARMImmediates(%#x)[%d].Canonical() = %v", tt.v, i, a.Canonical())
+			}
+		}
+	}
+}
+
+var thumbImmTests = []struct {
+	v     uint32
+	imm12 uint16
+	ok    bool
+}{
+	{0, 0x000, true},
+	{0xab, 0x0ab, true},
+	{0x00ab00ab, 0x1ab, true},
+	{0xab00ab00, 0x2ab, true},
+	{0xabababab, 0x3ab, true},
+	{0x80000000, 0x400, true},
+	{0xff000000, 0x47f, true},
+	{0x000001fe, 0xfff, true},
+	{0x00000100, 0xf80, true},
+	{0x00000101, 0, false},
+	{0x00ab00ac, 0, false},
+	{0xffffffff, 0x3ff, true},
+}
+
+func TestThumbImmediate(t *testing.T) {
+	for _, tt := range thumbImmTests {
+		imm12, ok := ThumbImmediate(tt.v)
+		if imm12 != tt.imm12 || ok != tt.ok {
+			t.Errorf("ThumbImmediate(%#x) = %#x, %v, want %#x, %v", tt.v, imm12, ok, tt.imm12, tt.ok)
+		}
+	}
+
+	// Every valid field is the encoding ThumbImmediate finds for its constant.
+	for imm12 := uint16(0); imm12 < 1<<12; imm12++ {
+		v, ok := ThumbExpandImm(imm12)
+		if !ok {
+			continue
+		}
+		if f, ok := ThumbImmediate(v); !ok || f != imm12 {
+			t.Errorf("ThumbImmediate(ThumbExpandImm(%#x) = %#x) = %#x, %v", imm12, v, f, ok)
+		}
+	}
+	if _, ok := ThumbExpandImm(0x100); ok {
+		t.Errorf("ThumbExpandImm(0x100) succeeded")
+	}
+	if _, ok := ThumbExpandImm(0x1000); ok {
+		t.Errorf("ThumbExpandImm(0x1000) succeeded")
+	}
+}
+
+func TestConstEncodings(t *testing.T) {
+	tests := []struct {
+		v    uint32
+		mode Mode
+		list []ConstEncoding
+	}{
+		{0xff, ModeARM, []ConstEncoding{{MOV_EQ, 0x0ff}, {MOVW_EQ, 0xff}}},
+		{0xffffff00, ModeARM, []ConstEncoding{{MVN_EQ, 0x0ff}}},
+		{0x1234, ModeARM, []ConstEncoding{{MOVW_EQ, 0x1234}}},
+		{0x12345678, ModeARM, nil},
+		{0x00ab00ab, ModeARM, nil},
+		{0x00ab00ab, ModeThumb, []ConstEncoding{{MOV_EQ, 0x1ab}}},
+		{0xff54ff54, ModeThumb, []ConstEncoding{{MVN_EQ, 0x1ab}}},
+	}
+	for _, tt := range tests {
+		list := ConstEncodings(tt.v, tt.mode)
+		if !reflect.DeepEqual(list, tt.list) {
+			t.Errorf("ConstEncodings(%#x, %v) = %v, want %v", tt.v, tt.mode, list, tt.list)
+		}
+	}
+
+	// The ARM MOV encodings decode to MOV of the constant.
+	for _, v := range []uint32{0x10, 0x3fc, 0xf000000f} {
+		for _, e := range ConstEncodings(v, ModeARM) {
+			if e.Op != MOV_EQ {
+				continue
+			}
+			x := 0xe3a00000 | e.Field // mov r0, #const
+			inst, err := Decode([]byte{byte(x), byte(x >> 8), byte(x >> 16), byte(x >> 24)}, ModeARM)
+			if err != nil {
+				t.Errorf("decoding %#08x: %v", x, err)
+				continue
+			}
+			var got uint32
+			switch a := inst.Args[1].(type) {
+			case Imm:
+				got = uint32(a)
+			case ImmAlt:
+				got = uint32(a.Imm())
+			}
+			if inst.Op != MOV || got != v {
+				t.Errorf("%#08x decodes to %v, want MOV R0, #%#x", x, inst, v)
+			}
+		}
+	}
+}
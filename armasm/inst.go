@@ -5,8 +5,11 @@
 package armasm
 
 import (
-	"bytes"
+	"encoding/binary"
 	"fmt"
+	"sort"
+	"strconv"
+	"strings"
 )
 
 // A Mode is an instruction execution mode.
@@ -37,10 +40,37 @@ type Op uint16
 // density is high, probably at least 90%.
 
 func (op Op) String() string {
-	if op >= Op(len(opstr)) || opstr[op] == "" {
+	name := op.name()
+	if name == "" {
 		return fmt.Sprintf("Op(%d)", int(op))
 	}
-	return opstr[op]
+	return name
+}
+
+// name returns the name of op, or "" if op is unknown.
+func (op Op) name() string {
+	if int(op)+1 >= len(opstrIndex) {
+		return ""
+	}
+	return opstrData[opstrIndex[op]:opstrIndex[op+1]]
+}
+
+// OpDescription returns a short description of the opcode op,
+// such as "Add with Carry" for ADC.S.EQ, or "" if op is unknown.
+// The description ignores condition codes and other suffixes.
+func OpDescription(op Op) string {
+	name := op.name()
+	if name == "" {
+		return ""
+	}
+	if i := strings.Index(name, "."); i >= 0 {
+		name = name[:i]
+	}
+	i := sort.Search(len(opdesc), func(i int) bool { return opdesc[i].name >= name })
+	if i < len(opdesc) && opdesc[i].name == name {
+		return opdesc[i].desc
+	}
+	return ""
 }
 
 // An Inst is a single instruction.
@@ -48,24 +78,123 @@ type Inst struct {
 	Op   Op     // Opcode mnemonic
 	Enc  uint32 // Raw encoding bits.
 	Len  int    // Length of encoding in bytes.
+	Mode Mode   // Instruction set of the encoding, or 0 if unknown.
 	Args Args   // Instruction arguments, in ARM manual order.
 }
 
 func (i Inst) String() string {
-	var buf bytes.Buffer
-	buf.WriteString(i.Op.String())
+	var buf [64]byte
+	return string(i.appendTo(buf[:0]))
+}
+
+// appendTo appends the result of i.String to dst.
+func (i Inst) appendTo(dst []byte) []byte {
+	dst = append(dst, i.Op.String()...)
 	for j, arg := range i.Args {
 		if arg == nil {
 			break
 		}
 		if j == 0 {
-			buf.WriteString(" ")
+			dst = append(dst, ' ')
+		} else {
+			dst = append(dst, ", "...)
+		}
+		dst = appendArg(dst, arg)
+	}
+	return dst
+}
+
+// appendArg appends the result of arg.String to dst,
+// without allocating for the argument types defined in this package.
+func appendArg(dst []byte, arg Arg) []byte {
+	switch a := arg.(type) {
+	case Reg:
+		return append(dst, a.String()...)
+	case Imm:
+		return a.appendTo(dst)
+	case ImmAlt:
+		return a.appendTo(dst)
+	case Mem:
+		return a.appendTo(dst)
+	case PCRel:
+		return a.appendTo(dst)
+	case RegList:
+		return a.appendTo(dst)
+	case RegRange:
+		return a.appendTo(dst)
+	case RegShift:
+		return a.appendTo(dst)
+	case RegShiftReg:
+		return a.appendTo(dst)
+	case RegX:
+		return a.appendTo(dst)
+	case Endian:
+		return append(dst, a.String()...)
+	case Label:
+		return appendHex(dst, uint64(a), 0)
+	case Float32Imm:
+		return a.appendTo(dst)
+	case Float64Imm:
+		return a.appendTo(dst)
+	}
+	return append(dst, arg.String()...)
+}
+
+// Bytes returns the encoding of i as it appears in an instruction stream
+// with the given byte order: Enc written as a 4-byte word if i.Len is 4,
+// or as a 2-byte halfword if i.Len is 2.
+// A 4-byte Thumb instruction (i.Mode is ModeThumb), such as a BL pair
+// returned by DecodeThumbBL, is instead written as two halfwords,
+// the first taken from the low 16 bits of Enc.
+// ARM code is little-endian except in legacy big-endian (BE-32) images;
+// in particular, BE-8 images, which store data big-endian, store
+// instructions little-endian.
+// An Inst of another length, such as the truncated bytes yielded
+// by Instructions, is written as a Len-byte integer in the given order,
+// so the little-endian order reproduces the bytes Instructions read.
+func (i Inst) Bytes(order binary.ByteOrder) []byte {
+	if i.Len < 0 || i.Len > 4 {
+		return nil
+	}
+	b := make([]byte, i.Len)
+	switch {
+	case i.Len == 4 && i.Mode == ModeThumb:
+		order.PutUint16(b, uint16(i.Enc))
+		order.PutUint16(b[2:], uint16(i.Enc>>16))
+	case i.Len == 4:
+		order.PutUint32(b, i.Enc)
+	case i.Len == 2:
+		order.PutUint16(b, uint16(i.Enc))
+	default:
+		// Write Enc as a word and keep its low i.Len bytes,
+		// which come first in little-endian order and last in big-endian.
+		var w [4]byte
+		order.PutUint32(w[:], i.Enc)
+		if order.Uint32([]byte{1, 0, 0, 0}) == 1 {
+			copy(b, w[:i.Len])
 		} else {
-			buf.WriteString(", ")
+			copy(b, w[4-i.Len:])
 		}
-		buf.WriteString(arg.String())
 	}
-	return buf.String()
+	return b
+}
+
+// NumArgs returns the number of arguments of i:
+// the number of elements of i.Args before the first nil.
+func (i Inst) NumArgs() int {
+	for j, arg := range i.Args {
+		if arg == nil {
+			return j
+		}
+	}
+	return len(i.Args)
+}
+
+// ArgsSlice returns the arguments of i as a slice
+// holding only the first NumArgs elements of i.Args.
+func (i Inst) ArgsSlice() []Arg {
+	args := i.Args
+	return args[:i.NumArgs()]
 }
 
 // An Args holds the instruction arguments.
@@ -74,7 +203,7 @@ func (i Inst) String() string {
 type Args [4]Arg
 
 // An Arg is a single instruction argument, one of these types:
-// Endian, Imm, Mem, PCRel, Reg, RegList, RegShift, RegShiftReg.
+// Endian, Imm, Mem, PCRel, Reg, RegList, RegRange, RegShift, RegShiftReg.
 type Arg interface {
 	IsArg()
 	String() string
@@ -85,7 +214,11 @@ type Float32Imm float32
 func (Float32Imm) IsArg() {}
 
 func (f Float32Imm) String() string {
-	return fmt.Sprintf("#%v", float32(f))
+	return string(f.appendTo(nil))
+}
+
+func (f Float32Imm) appendTo(dst []byte) []byte {
+	return appendFloat(append(dst, '#'), float64(f), 32)
 }
 
 type Float64Imm float32
@@ -93,7 +226,11 @@ type Float64Imm float32
 func (Float64Imm) IsArg() {}
 
 func (f Float64Imm) String() string {
-	return fmt.Sprintf("#%v", float64(f))
+	return string(f.appendTo(nil))
+}
+
+func (f Float64Imm) appendTo(dst []byte) []byte {
+	return appendFloat(append(dst, '#'), float64(f), 64)
 }
 
 // An Imm is an integer constant.
@@ -102,7 +239,11 @@ type Imm uint32
 func (Imm) IsArg() {}
 
 func (i Imm) String() string {
-	return fmt.Sprintf("#%#x", uint32(i))
+	return string(i.appendTo(nil))
+}
+
+func (i Imm) appendTo(dst []byte) []byte {
+	return appendHex(append(dst, '#'), uint64(i), 0)
 }
 
 // A ImmAlt is an alternate encoding of an integer constant.
@@ -119,17 +260,45 @@ func (i ImmAlt) Imm() Imm {
 	return Imm(v>>r | v<<(32-r))
 }
 
+// Carry returns the carry out of the rotation, given the carry flag in:
+// bit 31 of the constant if the rotation is nonzero, and otherwise in.
+// It is the carry that a flag-setting logical instruction, such as ANDS
+// or MOVS, with i as its operand writes to the carry flag.
+func (i ImmAlt) Carry(in bool) bool {
+	if i.Rot == 0 {
+		return in
+	}
+	return i.Imm()>>31 != 0
+}
+
+// Canonical reports whether i is the canonical encoding of its constant,
+// the one with the smallest rotation, which assemblers choose and to which
+// the decoder converts Imm arguments. The other encodings of the constant,
+// which the decoder reports as ImmAlt, may differ in their effect on the
+// carry flag (see Carry).
+func (i ImmAlt) Canonical() bool {
+	val, rot, ok := encodeConst(uint32(i.Imm()))
+	return ok && val == uint32(i.Val) && rot == uint32(i.Rot)
+}
+
 func (i ImmAlt) String() string {
-	return fmt.Sprintf("#%#x, %d", i.Val, i.Rot)
+	return string(i.appendTo(nil))
+}
+
+func (i ImmAlt) appendTo(dst []byte) []byte {
+	dst = appendHex(append(dst, '#'), uint64(i.Val), 0)
+	return strconv.AppendUint(append(dst, ", "...), uint64(i.Rot), 10)
 }
 
 // A Label is a text (code) address.
-type Label uint32
+// It is 64 bits so that it can hold the address of 32-bit code
+// mapped into a 64-bit address space.
+type Label uint64
 
 func (Label) IsArg() {}
 
 func (i Label) String() string {
-	return fmt.Sprintf("%#x", uint32(i))
+	return string(appendHex(nil, uint64(i), 0))
 }
 
 // A Reg is a single register.
@@ -223,6 +392,46 @@ const (
 	APSR
 	APSR_nzcv
 	FPSCR
+	CPSR
+	SPSR
+	FPSID
+	FPEXC
+	MVFR0
+	MVFR1
+
+	Q0
+	Q1
+	Q2
+	Q3
+	Q4
+	Q5
+	Q6
+	Q7
+	Q8
+	Q9
+	Q10
+	Q11
+	Q12
+	Q13
+	Q14
+	Q15
+
+	C0
+	C1
+	C2
+	C3
+	C4
+	C5
+	C6
+	C7
+	C8
+	C9
+	C10
+	C11
+	C12
+	C13
+	C14
+	C15
 
 	SP = R13
 	LR = R14
@@ -232,6 +441,19 @@ const (
 func (Reg) IsArg() {}
 
 func (r Reg) String() string {
+	return regNames[r]
+}
+
+// regNames holds the result of String for each Reg.
+var regNames = func() (names [256]string) {
+	for r := range names {
+		names[r] = regName(Reg(r))
+	}
+	return
+}()
+
+// regName returns the name of r, for the regNames table.
+func regName(r Reg) string {
 	switch r {
 	case APSR:
 		return "APSR"
@@ -239,6 +461,18 @@ func (r Reg) String() string {
 		return "APSR_nzcv"
 	case FPSCR:
 		return "FPSCR"
+	case CPSR:
+		return "CPSR"
+	case SPSR:
+		return "SPSR"
+	case FPSID:
+		return "FPSID"
+	case FPEXC:
+		return "FPEXC"
+	case MVFR0:
+		return "MVFR0"
+	case MVFR1:
+		return "MVFR1"
 	case SP:
 		return "SP"
 	case PC:
@@ -255,9 +489,98 @@ func (r Reg) String() string {
 	if D0 <= r && r <= D31 {
 		return fmt.Sprintf("D%d", int(r-D0))
 	}
+	if Q0 <= r && r <= Q15 {
+		return fmt.Sprintf("Q%d", int(r-Q0))
+	}
+	if C0 <= r && r <= C15 {
+		return fmt.Sprintf("C%d", int(r-C0))
+	}
 	return fmt.Sprintf("Reg(%d)", int(r))
 }
 
+// The number of registers in each numbered bank.
+// The registers of a bank are consecutive Reg values,
+// so that, for example, the single-precision registers
+// are S0+Reg(n) for 0 <= n < NumS.
+const (
+	NumR = 16 // core registers R0-R15
+	NumS = 32 // single-precision registers S0-S31
+	NumD = 32 // double-precision registers D0-D31
+	NumQ = 16 // quadword registers Q0-Q15
+	NumC = 16 // coprocessor registers C0-C15
+)
+
+// A RegClass is a class of registers.
+type RegClass uint8
+
+const (
+	ClassNone    RegClass = iota // not a register
+	ClassGPR                     // general-purpose registers R0-R12 and LR
+	ClassSpecial                 // SP and PC
+	ClassStatus                  // status and system registers: APSR, CPSR, SPSR, FPSCR, FPSID, FPEXC, MVFR0, MVFR1
+	ClassSingle                  // single-precision floating-point registers S0-S31
+	ClassDouble                  // double-precision floating-point registers D0-D31
+	ClassQuad                    // quadword SIMD registers Q0-Q15
+	ClassCoproc                  // coprocessor registers C0-C15
+)
+
+var regClassNames = [...]string{
+	ClassNone:    "None",
+	ClassGPR:     "GPR",
+	ClassSpecial: "Special",
+	ClassStatus:  "Status",
+	ClassSingle:  "Single",
+	ClassDouble:  "Double",
+	ClassQuad:    "Quad",
+	ClassCoproc:  "Coproc",
+}
+
+func (c RegClass) String() string {
+	if int(c) < len(regClassNames) {
+		return regClassNames[c]
+	}
+	return fmt.Sprintf("RegClass(%d)", int(c))
+}
+
+// Class returns the class of r.
+func (r Reg) Class() RegClass {
+	switch {
+	case r == SP || r == PC:
+		return ClassSpecial
+	case R0 <= r && r <= R15:
+		return ClassGPR
+	case S0 <= r && r <= S31:
+		return ClassSingle
+	case D0 <= r && r <= D31:
+		return ClassDouble
+	case APSR <= r && r <= MVFR1:
+		return ClassStatus
+	case Q0 <= r && r <= Q15:
+		return ClassQuad
+	case C0 <= r && r <= C15:
+		return ClassCoproc
+	}
+	return ClassNone
+}
+
+// Num returns the number of r within its bank: n for Rn, Sn, Dn, Qn, or Cn.
+// It returns -1 for registers not in a numbered bank, such as APSR.
+func (r Reg) Num() int {
+	switch {
+	case R0 <= r && r <= R15:
+		return int(r - R0)
+	case S0 <= r && r <= S31:
+		return int(r - S0)
+	case D0 <= r && r <= D31:
+		return int(r - D0)
+	case Q0 <= r && r <= Q15:
+		return int(r - Q0)
+	case C0 <= r && r <= C15:
+		return int(r - C0)
+	}
+	return -1
+}
+
 // A RegX represents a fraction of a multi-value register.
 // The Index field specifies the index number,
 // but the size of the fraction is not specified.
@@ -272,7 +595,12 @@ type RegX struct {
 func (RegX) IsArg() {}
 
 func (r RegX) String() string {
-	return fmt.Sprintf("%s[%d]", r.Reg, r.Index)
+	return string(r.appendTo(nil))
+}
+
+func (r RegX) appendTo(dst []byte) []byte {
+	dst = append(append(dst, r.Reg.String()...), '[')
+	return append(strconv.AppendInt(dst, int64(r.Index), 10), ']')
 }
 
 // A RegList is a register list.
@@ -281,18 +609,103 @@ type RegList uint16
 
 func (RegList) IsArg() {}
 
+// String returns the list in the UAL form, with runs of
+// consecutive registers written as ranges, as in "{R0-R3,R5,LR}".
 func (r RegList) String() string {
-	var buf bytes.Buffer
-	fmt.Fprintf(&buf, "{")
-	sep := ""
+	return string(r.appendTo(nil))
+}
+
+func (r RegList) appendTo(dst []byte) []byte {
+	dst = appendRegListRanges(append(dst, '{'), r, regNames[:])
+	return append(dst, '}')
+}
+
+// Expanded returns the list with every register written out,
+// as in "{R0,R1,R2,R3,R5,LR}". It is the form printed by
+// String in earlier versions of this package.
+func (r RegList) Expanded() string {
+	dst := []byte("{")
 	for i := 0; i < 16; i++ {
 		if r&(1<<uint(i)) != 0 {
-			fmt.Fprintf(&buf, "%s%s", sep, Reg(i).String())
-			sep = ","
+			if len(dst) > 1 {
+				dst = append(dst, ',')
+			}
+			dst = append(dst, Reg(i).String()...)
 		}
 	}
-	fmt.Fprintf(&buf, "}")
-	return buf.String()
+	return string(append(dst, '}'))
+}
+
+// appendRegListRanges appends the registers in r, separated by commas,
+// with each run of two or more consecutive registers written as a range
+// first-last. The names, indexed by Reg, give the name of each register.
+func appendRegListRanges(dst []byte, r RegList, names []string) []byte {
+	first := true
+	for i := 0; i < 16; {
+		if r&(1<<uint(i)) == 0 {
+			i++
+			continue
+		}
+		j := i
+		for j+1 < 16 && r&(1<<uint(j+1)) != 0 {
+			j++
+		}
+		if !first {
+			dst = append(dst, ',')
+		}
+		first = false
+		dst = append(dst, names[i]...)
+		if j > i {
+			dst = append(dst, '-')
+			dst = append(dst, names[j]...)
+		}
+		i = j + 1
+	}
+	return dst
+}
+
+// A RegRange is a list of consecutive floating-point registers,
+// all single-precision or all double-precision, as used by
+// VPUSH, VPOP, VLDM, and VSTM.
+type RegRange struct {
+	First Reg   // first register: S0-S31 or D0-D31
+	Count uint8 // number of registers
+}
+
+func (RegRange) IsArg() {}
+
+// Valid reports whether r is a list that an instruction can encode:
+// a nonempty list of single-precision registers within S0-S31,
+// or of at most 16 double-precision registers within D0-D31.
+func (r RegRange) Valid() bool {
+	switch r.First.Class() {
+	case ClassSingle:
+		return r.Count >= 1 && r.First.Num()+int(r.Count) <= NumS
+	case ClassDouble:
+		return r.Count >= 1 && r.Count <= 16 && r.First.Num()+int(r.Count) <= NumD
+	}
+	return false
+}
+
+// Regs returns the registers in r.
+func (r RegRange) Regs() []Reg {
+	regs := make([]Reg, r.Count)
+	for i := range regs {
+		regs[i] = r.First + Reg(i)
+	}
+	return regs
+}
+
+func (r RegRange) String() string {
+	return string(r.appendTo(nil))
+}
+
+func (r RegRange) appendTo(dst []byte) []byte {
+	dst = append(append(dst, '{'), r.First.String()...)
+	if r.Count != 1 {
+		dst = append(append(dst, '-'), (r.First + Reg(r.Count) - 1).String()...)
+	}
+	return append(dst, '}')
 }
 
 // An Endian is the argument to the SETEND instruction.
@@ -344,7 +757,12 @@ type RegShift struct {
 func (RegShift) IsArg() {}
 
 func (r RegShift) String() string {
-	return fmt.Sprintf("%s %s #%d", r.Reg, r.Shift, r.Count)
+	return string(r.appendTo(nil))
+}
+
+func (r RegShift) appendTo(dst []byte) []byte {
+	dst = append(append(append(dst, r.Reg.String()...), ' '), r.Shift.String()...)
+	return strconv.AppendUint(append(dst, " #"...), uint64(r.Count), 10)
 }
 
 // A RegShiftReg is a register shifted by a register.
@@ -357,18 +775,50 @@ type RegShiftReg struct {
 func (RegShiftReg) IsArg() {}
 
 func (r RegShiftReg) String() string {
-	return fmt.Sprintf("%s %s %s", r.Reg, r.Shift, r.RegCount)
+	return string(r.appendTo(nil))
+}
+
+func (r RegShiftReg) appendTo(dst []byte) []byte {
+	dst = append(append(append(dst, r.Reg.String()...), ' '), r.Shift.String()...)
+	return append(append(dst, ' '), r.RegCount.String()...)
 }
 
 // A PCRel describes a memory address (usually a code label)
 // as a distance relative to the program counter.
-// TODO(rsc): Define which program counter (PC+4? PC+8? PC?).
+//
+// As in the ARM architecture manual, the program counter is the address
+// of the instruction plus 8 in ARM mode and plus 4 in Thumb mode.
+// Thumb instructions that compute data addresses (literal loads and ADR)
+// and Thumb BLX, which switches to ARM mode, instead use that value
+// rounded down to a multiple of 4, written Align(PC, 4) in the manual.
+// The Target method computes the address.
 type PCRel int32
 
 func (PCRel) IsArg() {}
 
+// Target returns the address r refers to in an instruction at address pc
+// executing in the given mode, using PC+8 in ARM mode and PC+4 in Thumb mode.
+// For the Thumb instructions that use Align(PC, 4), pass pc&^3 instead of pc.
+// For pc below 4 GB, the address wraps around at 32 bits, as it does
+// on the processor; only 32-bit code mapped above 4 GB, as in a 64-bit
+// process, refers to addresses outside the 32-bit address space.
+func (r PCRel) Target(pc uint64, mode Mode) uint64 {
+	addr := pc + 8 + uint64(int64(r))
+	if mode == ModeThumb {
+		addr -= 4
+	}
+	if pc < 1<<32 {
+		addr = uint64(uint32(addr))
+	}
+	return addr
+}
+
 func (r PCRel) String() string {
-	return fmt.Sprintf("PC%+#x", int32(r))
+	return string(r.appendTo(nil))
+}
+
+func (r PCRel) appendTo(dst []byte) []byte {
+	return appendSignedHex(append(dst, "PC"...), int64(r))
 }
 
 // An AddrMode is an ARM addressing mode.
@@ -400,39 +850,51 @@ type Mem struct {
 func (Mem) IsArg() {}
 
 func (m Mem) String() string {
-	R := m.Base.String()
-	X := ""
-	if m.Sign != 0 {
-		X = "+"
+	return string(m.appendTo(nil))
+}
+
+func (m Mem) appendTo(dst []byte) []byte {
+	index := func(dst []byte) []byte {
+		if m.Sign == 0 {
+			return strconv.AppendInt(append(dst, '#'), int64(m.Offset), 10)
+		}
 		if m.Sign < 0 {
-			X = "-"
+			dst = append(dst, '-')
+		} else {
+			dst = append(dst, '+')
 		}
-		X += m.Index.String()
+		dst = append(dst, m.Index.String()...)
 		if m.Shift != ShiftLeft || m.Count != 0 {
-			X += fmt.Sprintf(", %s #%d", m.Shift, m.Count)
+			dst = append(append(dst, ", "...), m.Shift.String()...)
+			dst = strconv.AppendUint(append(dst, " #"...), uint64(m.Count), 10)
 		}
-	} else {
-		X = fmt.Sprintf("#%d", m.Offset)
+		return dst
 	}
+	zero := m.Sign == 0 && m.Offset == 0
 
 	switch m.Mode {
 	case AddrOffset:
-		if X == "#0" {
-			return fmt.Sprintf("[%s]", R)
+		dst = append(append(dst, '['), m.Base.String()...)
+		if !zero {
+			dst = index(append(dst, ", "...))
 		}
-		return fmt.Sprintf("[%s, %s]", R, X)
+		return append(dst, ']')
 	case AddrPreIndex:
-		return fmt.Sprintf("[%s, %s]!", R, X)
+		dst = append(append(dst, '['), m.Base.String()...)
+		return append(index(append(dst, ", "...)), "]!"...)
 	case AddrPostIndex:
-		return fmt.Sprintf("[%s], %s", R, X)
+		dst = append(append(dst, '['), m.Base.String()...)
+		return index(append(dst, "], "...))
 	case AddrLDM:
-		if X == "#0" {
-			return R
+		if zero {
+			return append(dst, m.Base.String()...)
 		}
 	case AddrLDM_WB:
-		if X == "#0" {
-			return R + "!"
+		if zero {
+			return append(append(dst, m.Base.String()...), '!')
 		}
 	}
-	return fmt.Sprintf("[%s Mode(%d) %s]", R, int(m.Mode), X)
+	dst = append(append(dst, '['), m.Base.String()...)
+	dst = strconv.AppendInt(append(dst, " Mode("...), int64(m.Mode), 10)
+	return append(index(append(dst, ") "...)), ']')
 }
@@ -0,0 +1,55 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+// +build go1.23
+
+package armasm
+
+import "iter"
+
+// Instructions returns an iterator over the instructions in src,
+// decoded in the given mode, yielding each instruction with its address,
+// taking src to be loaded at address pc.
+//
+// Decoding does not stop at bytes that fail to decode. Instead each
+// undecodable unit (a word in ARM mode, a halfword in Thumb mode),
+// or the truncated bytes at the end of src, is yielded as an Inst
+// with Op 0 and with Len giving the number of bytes skipped;
+// calling Decode on the bytes at that address returns the error.
+// Thus a loop can surface errors item by item:
+//
+//	for addr, inst := range armasm.Instructions(code, armasm.ModeARM, pc) {
+//		if inst.Op == 0 {
+//			fmt.Printf("%#x: undecodable\n", addr)
+//			continue
+//		}
+//		fmt.Printf("%#x: %v\n", addr, inst)
+//	}
+func Instructions(src []byte, mode Mode, pc uint64) iter.Seq2[uint64, Inst] {
+	return func(yield func(uint64, Inst) bool) {
+		step := 4
+		if mode == ModeThumb {
+			step = 2
+		}
+		for len(src) > 0 {
+			inst, err := Decode(src, mode)
+			if err != nil {
+				n := step
+				if n > len(src) {
+					n = len(src)
+				}
+				inst = Inst{Len: n}
+				for i := n - 1; i >= 0; i-- {
+					inst.Enc = inst.Enc<<8 | uint32(src[i])
+				}
+			}
+			if !yield(pc, inst) {
+				return
+			}
+			src = src[inst.Len:]
+			pc += uint64(inst.Len)
+		}
+	}
+}
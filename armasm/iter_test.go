@@ -0,0 +1,48 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build go1.23
+// +build go1.23
+
+package armasm
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+)
+
+func TestInstructions(t *testing.T) {
+	code := []byte{
+		0x01, 0x00, 0xa0, 0xe3, // mov r0, #1
+		0xff, 0xff, 0xff, 0xff, // undecodable
+		0x1e, 0xff, 0x2f, 0xe1, // bx lr
+		0x01, 0x02, // truncated
+	}
+	var out []string
+	for addr, inst := range Instructions(code, ModeARM, 0x1000) {
+		if inst.Op == 0 {
+			out = append(out, fmt.Sprintf("%#x: error %d bytes %#x", addr, inst.Len, inst.Enc))
+			continue
+		}
+		out = append(out, fmt.Sprintf("%#x: %v", addr, inst))
+	}
+	want := `0x1000: MOV R0, #0x1
+0x1004: error 4 bytes 0xffffffff
+0x1008: BX LR
+0x100c: error 2 bytes 0x201`
+	if got := strings.Join(out, "\n"); got != want {
+		t.Errorf("Instructions:\n%s\nwant:\n%s", got, want)
+	}
+
+	// Stopping early must stop the iteration.
+	n := 0
+	for range Instructions(code, ModeARM, 0) {
+		n++
+		break
+	}
+	if n != 1 {
+		t.Errorf("break after first instruction: got %d iterations", n)
+	}
+}
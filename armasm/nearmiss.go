@@ -0,0 +1,187 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+// A NearMiss is an encoding that an undecodable instruction
+// comes close to matching, as found by NearMisses.
+type NearMiss struct {
+	Op     Op     // the opcode the encoding would decode the instruction as
+	Enc    uint32 // the instruction word
+	Mask   uint32 // bits fixed by the encoding
+	Value  uint32 // values of the fixed bits
+	Syntax string // assembly syntax in the ARM manual, like "ADC{S}<c> <Rd>,<Rn>,#<const>"
+	Bits   string // bit layout in the ARM manual, like "cond:4|0|0|1|0|1|0|1|S|Rn:4|Rd:4|imm12:12"
+	Diff   uint32 // fixed bits in which Enc differs from Value
+	Reason string // the constraint that rejected Enc, if its fixed bits match
+}
+
+// String describes the near miss in a form like
+// "looks like LDRH but bits [7:4] must be 0b1011".
+func (m NearMiss) String() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "looks like %v but ", m.Op)
+	if m.Reason != "" {
+		buf.WriteString(m.Reason)
+		return buf.String()
+	}
+	sep := ""
+	for _, r := range bitRuns(m.Diff, m.Mask) {
+		buf.WriteString(sep)
+		sep = " and "
+		hi, lo := r[0], r[1]
+		v := m.Value >> lo & (1<<(hi-lo+1) - 1)
+		switch {
+		case hi == 31 && lo == 28 && m.Enc>>28 == 0xf && v != 0xf:
+			buf.WriteString("bits [31:28] (cond) must not be 0b1111")
+		case hi == lo:
+			fmt.Fprintf(&buf, "bit %d must be %d", lo, v)
+		default:
+			fmt.Fprintf(&buf, "bits [%d:%d] must be 0b%0*b", hi, lo, int(hi-lo+1), v)
+		}
+	}
+	return buf.String()
+}
+
+// bitRuns returns the runs of contiguous bits in mask, from most to least
+// significant, that contain a bit of diff, as [hi, lo] bit positions.
+// The condition field, bits [31:28], is always a run by itself.
+func bitRuns(diff, mask uint32) [][2]uint {
+	var runs [][2]uint
+	for i := 31; i >= 0; {
+		if mask>>uint(i)&1 == 0 {
+			i--
+			continue
+		}
+		hi := i
+		for i >= 0 && mask>>uint(i)&1 != 0 && (i != 27 || hi < 28) {
+			i--
+		}
+		lo := i + 1
+		run := (uint32(1)<<uint(hi-lo+1) - 1) << uint(lo)
+		if diff&run != 0 {
+			runs = append(runs, [2]uint{uint(hi), uint(lo)})
+		}
+	}
+	return runs
+}
+
+// NearMisses diagnoses an undecodable instruction at the start of src
+// by returning up to n of the encodings it comes closest to matching,
+// for auditing corrupted code or debugging hand-written encodings.
+// An encoding whose fixed bits all match but whose operand or condition
+// constraints reject the instruction, as reported by TraceDecode,
+// is closest; the rest are ordered by the number of fixed bits that differ,
+// and then by decoder table order. A conditional encoding matches
+// an instruction with condition 0b1111, which selects the unconditional
+// instructions, in all but the condition bits.
+//
+// Encodings producing the same report are listed once. A negative n means no limit.
+// NearMisses returns nil if the instruction decodes, if src is too short,
+// or if mode is not ModeARM, the only mode the decoder supports.
+func NearMisses(src []byte, mode Mode, n int) []NearMiss {
+	_, t, err := TraceDecode(src, mode)
+	if err == nil || t == nil {
+		return nil
+	}
+	x := binary.LittleEndian.Uint32(src)
+
+	var list []NearMiss
+	rejected := make(map[int]bool) // instFormats indexes already in list
+	for _, e := range t.Entries {
+		if x>>28 == 0xf && e.Value>>28 == 0 {
+			// Rejected for its condition; report it with the others.
+			continue
+		}
+		list = append(list, NearMiss{Op: e.Op, Enc: x, Mask: e.Mask, Value: e.Value, Syntax: e.Syntax, Bits: e.Bits, Reason: e.Reason})
+		rejected[e.index] = true
+	}
+	exact := len(list)
+
+	for i := range instFormats {
+		f := &instFormats[i]
+		if rejected[i] {
+			continue
+		}
+		mask, value := f.mask, f.value
+		cond := uint32(0)
+		if value>>28 == 0 {
+			// Conditional: any condition but 0b1111 matches.
+			mask |= 0xf0000000
+			value |= x & 0xf0000000
+			if x>>28 == 0xf {
+				value &^= 0x10000000
+				cond = 0xf0000000
+			}
+		}
+		diff := (x ^ value) & mask
+		if diff == 0 && cond == 0 {
+			continue
+		}
+		syntax, bits := instSyntax(i)
+		list = append(list, NearMiss{
+			Op:     nearOp(f, x),
+			Enc:    x,
+			Mask:   mask,
+			Value:  value,
+			Syntax: syntax,
+			Bits:   bits,
+			Diff:   diff,
+		})
+	}
+	rest := list[exact:]
+	sort.SliceStable(rest, func(i, j int) bool {
+		return popcount(rest[i].Diff) < popcount(rest[j].Diff)
+	})
+
+	// Drop repeats, such as encodings differing only in bits that
+	// the instruction already matches.
+	out := list[:0]
+	seen := make(map[string]bool)
+	for _, m := range list {
+		key := m.Syntax + "\x00" + m.String()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		out = append(out, m)
+	}
+	if n >= 0 && len(out) > n {
+		out = out[:n]
+	}
+	return out
+}
+
+// nearOp returns the opcode that format f would decode x as,
+// using condition AL in place of 0b1111 for a conditional format.
+func nearOp(f *instFormat, x uint32) Op {
+	if f.value>>28 == 0 && x>>28 == 0xf {
+		x = x&^0xf0000000 | 0xe0000000
+	}
+	delta := uint32(0)
+	shift := uint(0)
+	for opBits := f.opBits; opBits != 0; opBits >>= 16 {
+		n := uint(opBits & 0xFF)
+		off := uint((opBits >> 8) & 0xFF)
+		delta |= (x >> off) & (1<<n - 1) << shift
+		shift += n
+	}
+	return f.op + Op(delta)
+}
+
+// popcount returns the number of 1 bits in x.
+func popcount(x uint32) int {
+	n := 0
+	for ; x != 0; x &= x - 1 {
+		n++
+	}
+	return n
+}
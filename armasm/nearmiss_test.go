@@ -0,0 +1,78 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+var nearMissTests = []struct {
+	enc  uint32
+	want string // a report among the first two near misses
+}{
+	{0xf3a00001, "looks like MOV but bits [31:28] (cond) must not be 0b1111"},
+	{0xec900b00, "looks like VLDMIA but argument 2: imm8 must be even"},
+	{0xe7f001f0, "looks like LDRB but bit 4 must be 0"},
+	{0xe6000010, "looks like AND but bits [27:21] must be 0b0010000"},
+}
+
+func TestNearMisses(t *testing.T) {
+	var src [4]byte
+	for _, tt := range nearMissTests {
+		binary.LittleEndian.PutUint32(src[:], tt.enc)
+		list := NearMisses(src[:], ModeARM, 2)
+		if len(list) != 2 {
+			t.Errorf("NearMisses(%08x) = %v, want 2", tt.enc, list)
+			continue
+		}
+		found := false
+		for _, m := range list {
+			if m.Enc != tt.enc {
+				t.Errorf("NearMisses(%08x): Enc = %08x", tt.enc, m.Enc)
+			}
+			if m.Reason == "" && (m.Diff == 0 || m.Diff&^m.Mask != 0 || (m.Enc^m.Value)&m.Mask != m.Diff) {
+				t.Errorf("NearMisses(%08x): %v has Diff %08x for Mask %08x Value %08x", tt.enc, m, m.Diff, m.Mask, m.Value)
+			}
+			found = found || strings.HasPrefix(m.String(), tt.want)
+		}
+		if !found {
+			t.Errorf("NearMisses(%08x) = %v, want %q", tt.enc, list, tt.want)
+		}
+		if all := NearMisses(src[:], ModeARM, -1); len(all) <= 2 {
+			t.Errorf("NearMisses(%08x, -1) returned %d entries, want more than 2", tt.enc, len(all))
+		}
+	}
+
+	binary.LittleEndian.PutUint32(src[:], 0xe3a00001)
+	if list := NearMisses(src[:], ModeARM, 5); list != nil {
+		t.Errorf("NearMisses(valid) = %v, want nil", list)
+	}
+	if list := NearMisses(src[:2], ModeARM, 5); list != nil {
+		t.Errorf("NearMisses(short) = %v, want nil", list)
+	}
+	if list := NearMisses(src[:], ModeThumb, 5); list != nil {
+		t.Errorf("NearMisses(Thumb) = %v, want nil", list)
+	}
+}
+
+func TestNearMissString(t *testing.T) {
+	tests := []struct {
+		m    NearMiss
+		want string
+	}{
+		{NearMiss{Op: LDRH, Enc: 0xe1d000f0, Mask: 0x0e5000f0, Value: 0x005000b0, Diff: 0x40}, "looks like LDRH but bits [7:4] must be 0b1011"},
+		{NearMiss{Op: LDRH, Enc: 0xe2d000b0, Mask: 0x0e5000f0, Value: 0x005000b0, Diff: 0x02000000}, "looks like LDRH but bits [27:25] must be 0b000"},
+		{NearMiss{Op: LDRH, Enc: 0xe19000b0, Mask: 0x0e5000f0, Value: 0x005000b0, Diff: 0x00400000}, "looks like LDRH but bit 22 must be 1"},
+		{NearMiss{Op: MOV, Enc: 0xf3a00001, Mask: 0xfff00000, Value: 0xe3a00000, Diff: 0x10000000}, "looks like MOV but bits [31:28] (cond) must not be 0b1111"},
+		{NearMiss{Op: PUSH, Reason: "argument 1: register list must hold at least 2 registers"}, "looks like PUSH but argument 1: register list must hold at least 2 registers"},
+	}
+	for _, tt := range tests {
+		if s := tt.m.String(); s != tt.want {
+			t.Errorf("String() = %q, want %q", s, tt.want)
+		}
+	}
+}
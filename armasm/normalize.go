@@ -0,0 +1,76 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+// Equal reports whether i and j are the same instruction:
+// whether they have the same opcode and arguments.
+// The encoding fields Enc and Len are not compared, so that
+// instructions built by hand can be compared with decoded ones.
+// Equal does not normalize its arguments; to compare instructions
+// that may use different representations of the same operation,
+// compare Normalize(i) with Normalize(j).
+func (i Inst) Equal(j Inst) bool {
+	return i.Op == j.Op && i.Args == j.Args
+}
+
+// Normalize returns inst rewritten into a canonical form, so that
+// equivalent instructions compare equal with Equal. It rewrites:
+//
+//	an ImmAlt argument to the Imm it denotes;
+//	a RegShift with LSL #0 to the plain Reg;
+//	LSL Rd, Rm, #0 to MOV Rd, Rm, keeping the condition and S bit;
+//	STMDB SP!, {regs} to PUSH {regs} and LDM SP!, {regs} to POP {regs};
+//	a Mem, clearing the fields unused by its index expression.
+//
+// The encoding fields Enc and Len are left unchanged.
+func Normalize(inst Inst) Inst {
+	for i, arg := range inst.Args {
+		switch a := arg.(type) {
+		case ImmAlt:
+			inst.Args[i] = a.Imm()
+		case RegShift:
+			if a.Shift == ShiftLeft && a.Count == 0 {
+				inst.Args[i] = a.Reg
+			}
+		case Mem:
+			if a.Sign == 0 {
+				a.Index, a.Shift, a.Count = 0, 0, 0
+			} else {
+				a.Offset = 0
+				if a.Shift == RotateRightExt {
+					a.Count = 1
+				}
+			}
+			if a.Mode == AddrLDM || a.Mode == AddrLDM_WB {
+				a.Sign, a.Index, a.Shift, a.Count, a.Offset = 0, 0, 0, 0, 0
+			}
+			inst.Args[i] = a
+		}
+	}
+
+	switch base := inst.Op &^ 15; base {
+	case LSL_EQ, LSL_S_EQ:
+		if inst.Args[2] == Imm(0) {
+			if base == LSL_EQ {
+				inst.Op = MOV_EQ | inst.Op&15
+			} else {
+				inst.Op = MOV_S_EQ | inst.Op&15
+			}
+			inst.Args[2] = nil
+		}
+	case STMDB_EQ, LDM_EQ:
+		if inst.Args[0] == (Mem{Base: SP, Mode: AddrLDM_WB}) {
+			if list, ok := inst.Args[1].(RegList); ok {
+				if base == STMDB_EQ {
+					inst.Op = PUSH_EQ | inst.Op&15
+				} else {
+					inst.Op = POP_EQ | inst.Op&15
+				}
+				inst.Args = Args{list}
+			}
+		}
+	}
+	return inst
+}
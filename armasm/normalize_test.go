@@ -0,0 +1,99 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import "testing"
+
+var normalizeTests = []struct {
+	in, out Inst
+}{
+	{
+		Inst{Op: MOV_EQ, Args: Args{R0, ImmAlt{0xff, 8}}},
+		Inst{Op: MOV_EQ, Args: Args{R0, Imm(0xff000000)}},
+	},
+	{
+		Inst{Op: ADD, Args: Args{R0, R1, RegShift{R2, ShiftLeft, 0}}},
+		Inst{Op: ADD, Args: Args{R0, R1, R2}},
+	},
+	{
+		Inst{Op: ADD, Args: Args{R0, R1, RegShift{R2, ShiftLeft, 2}}},
+		Inst{Op: ADD, Args: Args{R0, R1, RegShift{R2, ShiftLeft, 2}}},
+	},
+	{
+		Inst{Op: LSL_NE, Args: Args{R0, R1, Imm(0)}},
+		Inst{Op: MOV_NE, Args: Args{R0, R1}},
+	},
+	{
+		Inst{Op: LSL_S, Args: Args{R0, R1, Imm(0)}},
+		Inst{Op: MOV_S, Args: Args{R0, R1}},
+	},
+	{
+		Inst{Op: LSL, Args: Args{R0, R1, Imm(1)}},
+		Inst{Op: LSL, Args: Args{R0, R1, Imm(1)}},
+	},
+	{
+		Inst{Op: STMDB, Args: Args{Mem{Base: SP, Mode: AddrLDM_WB}, RegList(1<<4 | 1<<14)}},
+		Inst{Op: PUSH, Args: Args{RegList(1<<4 | 1<<14)}},
+	},
+	{
+		Inst{Op: LDM_EQ, Args: Args{Mem{Base: SP, Mode: AddrLDM_WB}, RegList(1 << 4)}},
+		Inst{Op: POP_EQ, Args: Args{RegList(1 << 4)}},
+	},
+	{
+		Inst{Op: LDM, Args: Args{Mem{Base: SP, Mode: AddrLDM}, RegList(1 << 4)}},
+		Inst{Op: LDM, Args: Args{Mem{Base: SP, Mode: AddrLDM}, RegList(1 << 4)}},
+	},
+	{
+		Inst{Op: LDR, Args: Args{R0, Mem{Base: R1, Mode: AddrOffset, Index: R2, Count: 3, Offset: 8}}},
+		Inst{Op: LDR, Args: Args{R0, Mem{Base: R1, Mode: AddrOffset, Offset: 8}}},
+	},
+	{
+		Inst{Op: LDR, Args: Args{R0, Mem{Base: R1, Mode: AddrOffset, Sign: 1, Index: R2, Offset: 8}}},
+		Inst{Op: LDR, Args: Args{R0, Mem{Base: R1, Mode: AddrOffset, Sign: 1, Index: R2}}},
+	},
+}
+
+func TestNormalize(t *testing.T) {
+	for _, tt := range normalizeTests {
+		out := Normalize(tt.in)
+		if !out.Equal(tt.out) {
+			t.Errorf("Normalize(%v) = %v, want %v", tt.in, out, tt.out)
+		}
+		if again := Normalize(out); !again.Equal(out) {
+			t.Errorf("Normalize(%v) = %v, not idempotent", out, again)
+		}
+	}
+}
+
+func TestNormalizeDecoded(t *testing.T) {
+	// STMDB SP!, {R4} decodes as STMDB, since PUSH of a single
+	// register is encoded as STR; after normalization the two agree.
+	stm, err := Decode([]byte{0x10, 0x00, 0x2d, 0xe9}, ModeARM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	str, err := Decode([]byte{0x04, 0x40, 0x2d, 0xe5}, ModeARM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stm.Equal(str) {
+		t.Fatalf("%v and %v are Equal before normalization", stm, str)
+	}
+	if !Normalize(stm).Equal(Normalize(str)) {
+		t.Errorf("Normalize(%v) = %v, Normalize(%v) = %v, want equal", stm, Normalize(stm), str, Normalize(str))
+	}
+}
+
+func TestEqual(t *testing.T) {
+	a := Inst{Op: MOV, Enc: 0xe1a00001, Len: 4, Args: Args{R0, R1}}
+	b := Inst{Op: MOV, Args: Args{R0, R1}}
+	if !a.Equal(b) {
+		t.Errorf("%v.Equal(%v) = false, want true", a, b)
+	}
+	b.Args[1] = R2
+	if a.Equal(b) {
+		t.Errorf("%v.Equal(%v) = true, want false", a, b)
+	}
+}
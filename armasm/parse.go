@@ -0,0 +1,397 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+)
+
+// Parse parses an instruction in the syntax printed by Inst.String,
+// such as "ADD.EQ R0, R1, R2 LSL #2" or "LDR R0, [PC, #8]".
+// The result has Enc and Len unset; Encode computes the encoding.
+//
+// The syntax determines each argument's type, with two exceptions.
+// A register followed by a register list, as in "LDM R0, {R1-R3}",
+// is the Mem base of a load or store multiple. An immediate is
+// an Imm if written in hex, as Imm.String does, and otherwise
+// a Float64Imm for an opcode with an F64 suffix or else a Float32Imm.
+func Parse(text string) (Inst, error) {
+	name, rest := text, ""
+	if i := strings.Index(text, " "); i >= 0 {
+		name, rest = text[:i], text[i+1:]
+	}
+	op, ok := LookupOp(name)
+	if !ok {
+		return Inst{}, fmt.Errorf("unknown opcode %q", name)
+	}
+	inst := Inst{Op: op}
+	parts := splitArgs(rest)
+	n := 0
+	for i := 0; i < len(parts); i++ {
+		if n >= len(inst.Args) {
+			return Inst{}, fmt.Errorf("too many arguments in %q", text)
+		}
+		p := parts[i]
+		var arg Arg
+		var err error
+		switch {
+		case strings.HasPrefix(p, "["):
+			// [R], X is a post-indexed Mem; X may itself be "+R, LSL #n".
+			var post string
+			if !strings.Contains(p, ",") && !strings.HasSuffix(p, "!") && i+1 < len(parts) && isIndex(parts[i+1]) {
+				post = parts[i+1]
+				i++
+				if i+1 < len(parts) && isShift(parts[i+1]) {
+					post += ", " + parts[i+1]
+					i++
+				}
+			}
+			arg, err = parseMem(p, post)
+		case strings.HasPrefix(p, "{"):
+			arg, err = parseList(p)
+		case strings.HasPrefix(p, "PC+"), strings.HasPrefix(p, "PC-"):
+			var v int64
+			v, err = strconv.ParseInt(p[2:], 0, 32)
+			arg = PCRel(v)
+		case strings.HasPrefix(p, "#0x"):
+			var v uint64
+			v, err = strconv.ParseUint(p[1:], 0, 32)
+			arg = Imm(v)
+			if i+1 < len(parts) && isDecimal(parts[i+1]) {
+				// #val, rot is an ImmAlt.
+				var rot uint64
+				if rot, err = strconv.ParseUint(parts[i+1], 10, 8); err == nil && v < 1<<8 {
+					arg = ImmAlt{Val: uint8(v), Rot: uint8(rot)}
+				} else {
+					err = fmt.Errorf("invalid alternate immediate %s, %s", p, parts[i+1])
+				}
+				i++
+			}
+		case strings.HasPrefix(p, "#"):
+			var f float64
+			if strings.HasSuffix(name, "F64") {
+				f, err = strconv.ParseFloat(p[1:], 64)
+				arg = Float64Imm(f)
+			} else {
+				f, err = strconv.ParseFloat(p[1:], 32)
+				arg = Float32Imm(f)
+			}
+		case strings.HasPrefix(p, "0x"):
+			var v uint64
+			v, err = strconv.ParseUint(p, 0, 64)
+			arg = Label(v)
+		case p == "LE":
+			arg = LittleEndian
+		case p == "BE":
+			arg = BigEndian
+		case strings.HasSuffix(p, "!"):
+			var r Reg
+			r, err = parseReg(p[:len(p)-1])
+			arg = Mem{Base: r, Mode: AddrLDM_WB}
+		case strings.HasSuffix(p, "]"):
+			arg, err = parseRegX(p)
+		case strings.Contains(p, " "):
+			arg, err = parseShift(p)
+		default:
+			arg, err = parseReg(p)
+		}
+		if err != nil {
+			return Inst{}, fmt.Errorf("parsing %q: %v", text, err)
+		}
+		inst.Args[n] = arg
+		n++
+	}
+
+	if r, ok := inst.Args[0].(Reg); ok {
+		switch inst.Args[1].(type) {
+		case RegList, RegRange:
+			inst.Args[0] = Mem{Base: r, Mode: AddrLDM}
+		}
+	}
+	return inst, nil
+}
+
+// splitArgs splits s at the commas separating arguments,
+// ignoring commas inside brackets and braces.
+func splitArgs(s string) []string {
+	if s == "" {
+		return nil
+	}
+	var parts []string
+	depth := 0
+	start := 0
+	for i := 0; i < len(s); i++ {
+		switch s[i] {
+		case '[', '{':
+			depth++
+		case ']', '}':
+			depth--
+		case ',':
+			if depth == 0 {
+				parts = append(parts, strings.TrimSpace(s[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	return append(parts, strings.TrimSpace(s[start:]))
+}
+
+// regsByName returns a map from each register's printed name
+// to the register, built on first use.
+var regsByName = sync.OnceValue(func() map[string]Reg {
+	m := make(map[string]Reg)
+	for r := 0; r < 256; r++ {
+		if s := Reg(r).String(); !strings.HasPrefix(s, "Reg(") {
+			m[s] = Reg(r)
+		}
+	}
+	return m
+})
+
+func parseReg(s string) (Reg, error) {
+	if r, ok := regsByName()[s]; ok {
+		return r, nil
+	}
+	return 0, fmt.Errorf("unknown register %q", s)
+}
+
+// parseRegX parses a scalar like "D0[1]".
+func parseRegX(s string) (Arg, error) {
+	i := strings.Index(s, "[")
+	if i < 0 {
+		return nil, fmt.Errorf("invalid argument %q", s)
+	}
+	r, err := parseReg(s[:i])
+	if err != nil {
+		return nil, err
+	}
+	x, err := strconv.Atoi(s[i+1 : len(s)-1])
+	if err != nil {
+		return nil, fmt.Errorf("invalid index in %q", s)
+	}
+	return RegX{Reg: r, Index: x}, nil
+}
+
+// parseShift parses a shifted register, "R1 LSL #2" or "R1 LSL R2".
+func parseShift(s string) (Arg, error) {
+	f := strings.Fields(s)
+	if len(f) != 3 {
+		return nil, fmt.Errorf("invalid argument %q", s)
+	}
+	r, err := parseReg(f[0])
+	if err != nil {
+		return nil, err
+	}
+	sh, ok := lookupShift(f[1])
+	if !ok {
+		return nil, fmt.Errorf("unknown shift %q", f[1])
+	}
+	if strings.HasPrefix(f[2], "#") {
+		n, err := strconv.ParseUint(f[2][1:], 10, 8)
+		if err != nil {
+			return nil, fmt.Errorf("invalid shift count in %q", s)
+		}
+		return RegShift{Reg: r, Shift: sh, Count: uint8(n)}, nil
+	}
+	rc, err := parseReg(f[2])
+	if err != nil {
+		return nil, err
+	}
+	return RegShiftReg{Reg: r, Shift: sh, RegCount: rc}, nil
+}
+
+func lookupShift(s string) (Shift, bool) {
+	for i, name := range shiftName {
+		if name == s {
+			return Shift(i), true
+		}
+	}
+	return 0, false
+}
+
+// isShift reports whether s is a shift of a Mem index, like "LSL #2".
+func isShift(s string) bool {
+	f := strings.Fields(s)
+	if len(f) != 2 || !strings.HasPrefix(f[1], "#") {
+		return false
+	}
+	_, ok := lookupShift(f[0])
+	return ok
+}
+
+// isIndex reports whether s is the index of a post-indexed Mem,
+// like "#-4" or "+R1".
+func isIndex(s string) bool {
+	return strings.HasPrefix(s, "#") && !strings.HasPrefix(s, "#0x") || strings.HasPrefix(s, "+") || strings.HasPrefix(s, "-")
+}
+
+func isDecimal(s string) bool {
+	if s == "" {
+		return false
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] < '0' || '9' < s[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// parseMem parses a memory reference like "[R0, #8]!", or, if post is
+// not empty, the post-indexed reference "[R0], post".
+func parseMem(s, post string) (Mem, error) {
+	var m Mem
+	mode := AddrOffset
+	switch {
+	case post != "":
+		mode = AddrPostIndex
+	case strings.HasSuffix(s, "]!"):
+		mode = AddrPreIndex
+		s = s[:len(s)-1]
+	}
+	if !strings.HasSuffix(s, "]") {
+		return m, fmt.Errorf("invalid memory reference %q", s)
+	}
+	inner := s[1 : len(s)-1]
+	base, x := inner, post
+	if i := strings.Index(inner, ","); i >= 0 {
+		if post != "" {
+			return m, fmt.Errorf("invalid memory reference %q", s)
+		}
+		base, x = inner[:i], strings.TrimSpace(inner[i+1:])
+	}
+	r, err := parseReg(base)
+	if err != nil {
+		return m, err
+	}
+	m.Base = r
+	m.Mode = mode
+	if x == "" {
+		if mode != AddrOffset {
+			return m, fmt.Errorf("invalid memory reference %q", s)
+		}
+		return m, nil
+	}
+	switch x[0] {
+	case '#':
+		off, err := strconv.ParseInt(x[1:], 10, 16)
+		if err != nil {
+			return m, fmt.Errorf("invalid offset in %q", s)
+		}
+		m.Offset = int16(off)
+	case '+', '-':
+		m.Sign = 1
+		if x[0] == '-' {
+			m.Sign = -1
+		}
+		x = x[1:]
+		if i := strings.Index(x, ","); i >= 0 {
+			sh := strings.TrimSpace(x[i+1:])
+			x = x[:i]
+			if !isShift(sh) {
+				return m, fmt.Errorf("invalid shift in %q", s)
+			}
+			f := strings.Fields(sh)
+			m.Shift, _ = lookupShift(f[0])
+			n, err := strconv.ParseUint(f[1][1:], 10, 8)
+			if err != nil {
+				return m, fmt.Errorf("invalid shift count in %q", s)
+			}
+			m.Count = uint8(n)
+		}
+		if m.Index, err = parseReg(x); err != nil {
+			return m, err
+		}
+	default:
+		return m, fmt.Errorf("invalid index in %q", s)
+	}
+	return m, nil
+}
+
+// parseList parses a register list: a RegList like "{R0-R3,LR}"
+// or, for floating-point registers, a RegRange like "{D0-D3}".
+func parseList(s string) (Arg, error) {
+	if !strings.HasSuffix(s, "}") {
+		return nil, fmt.Errorf("invalid register list %q", s)
+	}
+	inner := s[1 : len(s)-1]
+	var list RegList
+	if inner == "" {
+		// Decode returns an empty list for an LDM or STM with no registers.
+		return list, nil
+	}
+	var rr RegRange
+	for _, item := range strings.Split(inner, ",") {
+		lo, hi := item, item
+		if i := strings.Index(item, "-"); i >= 0 {
+			lo, hi = item[:i], item[i+1:]
+		}
+		first, err := parseReg(lo)
+		if err != nil {
+			return nil, err
+		}
+		last, err := parseReg(hi)
+		if err != nil {
+			return nil, err
+		}
+		if first > last {
+			return nil, fmt.Errorf("invalid register list %q", s)
+		}
+		if last <= R15 {
+			for r := first; r <= last; r++ {
+				list |= 1 << r
+			}
+			continue
+		}
+		if rr.Count != 0 || first.Class() != last.Class() || first.Class() != ClassSingle && first.Class() != ClassDouble {
+			return nil, fmt.Errorf("invalid register list %q", s)
+		}
+		rr = RegRange{First: first, Count: uint8(last - first + 1)}
+	}
+	if rr.Count != 0 {
+		if list != 0 {
+			return nil, fmt.Errorf("invalid register list %q", s)
+		}
+		return rr, nil
+	}
+	return list, nil
+}
+
+// CheckRoundTrip checks that inst, an instruction returned by Decode,
+// survives a round trip through its text and binary forms:
+// that Parse(inst.String()) returns inst, and that encoding that
+// result with Encode and decoding it again returns inst as well.
+// It returns an error describing the first step that fails.
+//
+// Every instruction Decode returns should pass; a failure means the
+// instruction tables, the printer, the parser, and the encoder disagree.
+// Contributors changing the tables can run CheckRoundTrip over
+// the instructions they affect, as the package's tests do over
+// random instruction words.
+func CheckRoundTrip(inst Inst) error {
+	text := inst.String()
+	p, err := Parse(text)
+	if err != nil {
+		return err
+	}
+	if !p.Equal(inst) {
+		return fmt.Errorf("Parse(%q) = %#v, want %#v", text, p.Args, inst.Args)
+	}
+	enc, err := Encode(p)
+	if err != nil {
+		return err
+	}
+	dec, err := Decode(enc, ModeARM)
+	if err != nil {
+		return fmt.Errorf("%v encodes as %x, which does not decode: %v", inst, enc, err)
+	}
+	if !dec.Equal(inst) {
+		return fmt.Errorf("%v encodes as %x, which decodes as %v", inst, enc, dec)
+	}
+	return nil
+}
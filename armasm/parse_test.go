@@ -0,0 +1,118 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"io/ioutil"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+var parseTests = []struct {
+	text string
+	inst Inst // zero Op for an error
+}{
+	{"ADD R0, R1, R2", Inst{Op: ADD, Args: Args{R0, R1, R2}}},
+	{"ADD.S.EQ R0, R1, R2 LSL #2", Inst{Op: ADD_S_EQ, Args: Args{R0, R1, RegShift{R2, ShiftLeft, 2}}}},
+	{"MOV R0, R1 ROR R2", Inst{Op: MOV, Args: Args{R0, RegShiftReg{R1, RotateRight, R2}}}},
+	{"MOV R0, #0x1", Inst{Op: MOV, Args: Args{R0, Imm(1)}}},
+	{"MOV R0, #0xff, 8", Inst{Op: MOV, Args: Args{R0, ImmAlt{0xff, 8}}}},
+	{"B.NE PC-0x8", Inst{Op: B_NE, Args: Args{PCRel(-8)}}},
+	{"LDR R0, [PC, #8]", Inst{Op: LDR, Args: Args{R0, Mem{Base: PC, Mode: AddrOffset, Offset: 8}}}},
+	{"LDR R0, [R1]", Inst{Op: LDR, Args: Args{R0, Mem{Base: R1, Mode: AddrOffset}}}},
+	{"LDR R0, [R1, #-4]!", Inst{Op: LDR, Args: Args{R0, Mem{Base: R1, Mode: AddrPreIndex, Offset: -4}}}},
+	{"LDR R0, [R1], #4", Inst{Op: LDR, Args: Args{R0, Mem{Base: R1, Mode: AddrPostIndex, Offset: 4}}}},
+	{"LDR R0, [R1, -R2, LSL #2]", Inst{Op: LDR, Args: Args{R0, Mem{Base: R1, Mode: AddrOffset, Sign: -1, Index: R2, Count: 2}}}},
+	{"STR R0, [R1], +R2, ASR #3", Inst{Op: STR, Args: Args{R0, Mem{Base: R1, Mode: AddrPostIndex, Sign: 1, Index: R2, Shift: ShiftRightSigned, Count: 3}}}},
+	{"LDM R0!, {R1-R3,PC}", Inst{Op: LDM, Args: Args{Mem{Base: R0, Mode: AddrLDM_WB}, RegList(0x800e)}}},
+	{"STMDB R0, {R4}", Inst{Op: STMDB, Args: Args{Mem{Base: R0, Mode: AddrLDM}, RegList(0x10)}}},
+	{"POP {R4,PC}", Inst{Op: POP, Args: Args{RegList(0x8010)}}},
+	{"LDMDB.LS SP!, {}", Inst{Op: LDMDB_LS, Args: Args{Mem{Base: SP, Mode: AddrLDM_WB}, RegList(0)}}},
+	{"VPUSH {D8-D15}", Inst{Op: VPUSH, Args: Args{RegRange{D8, 8}}}},
+	{"VLDMIA R0!, {S2}", Inst{Op: VLDMIA, Args: Args{Mem{Base: R0, Mode: AddrLDM_WB}, RegRange{S2, 1}}}},
+	{"VMOV R0, D1[1]", Inst{Op: VMOV, Args: Args{R0, RegX{D1, 1}}}},
+	{"VMOV.F64 D0, #1.5", Inst{Op: VMOV_F64, Args: Args{D0, Float64Imm(1.5)}}},
+	{"VMOV.F32 S0, #-0.25", Inst{Op: VMOV_F32, Args: Args{S0, Float32Imm(-0.25)}}},
+	{"SETEND BE", Inst{Op: SETEND, Args: Args{BigEndian}}},
+	{"B 0x100001000", Inst{Op: B, Args: Args{Label(0x100001000)}}},
+	{"VMRS APSR_nzcv, FPSCR", Inst{Op: VMRS, Args: Args{APSR_nzcv, FPSCR}}},
+	{"NOP", Inst{Op: NOP}},
+
+	{"FOO R0", Inst{}},
+	{"MOV R16, R0", Inst{}},
+	{"LDR R0, [R1", Inst{}},
+	{"POP {R3-R1}", Inst{}},
+	{"ADD R0, R1, R2, R3, R4", Inst{}},
+}
+
+func TestParse(t *testing.T) {
+	for _, tt := range parseTests {
+		inst, err := Parse(tt.text)
+		if tt.inst.Op == 0 {
+			if err == nil {
+				t.Errorf("Parse(%q) = %v, want error", tt.text, inst)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Parse(%q): %v", tt.text, err)
+			continue
+		}
+		if !inst.Equal(tt.inst) {
+			t.Errorf("Parse(%q) = %#v, want %#v", tt.text, inst.Args, tt.inst.Args)
+		}
+		if s := inst.String(); s != tt.text {
+			t.Errorf("Parse(%q).String() = %q", tt.text, s)
+		}
+	}
+}
+
+func TestCheckRoundTripTxt(t *testing.T) {
+	data, err := ioutil.ReadFile("testdata/decode.txt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range strings.Split(string(data), "\n") {
+		if line == "" || strings.HasPrefix(line, "#") || strings.Contains(line, "error:") {
+			continue
+		}
+		i := strings.Index(line, "|")
+		code, err := hex.DecodeString(line[:i])
+		if err != nil {
+			t.Errorf("parsing %q: %v", line, err)
+			continue
+		}
+		inst, err := Decode(code, ModeARM)
+		if err != nil {
+			t.Errorf("Decode(%x): %v", code, err)
+			continue
+		}
+		if err := CheckRoundTrip(inst); err != nil {
+			t.Errorf("CheckRoundTrip(%v) [%x]: %v", inst, code, err)
+		}
+	}
+}
+
+func TestCheckRoundTripRandom(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	n := 200000
+	if testing.Short() {
+		n = 10000
+	}
+	var buf [4]byte
+	for i := 0; i < n; i++ {
+		binary.LittleEndian.PutUint32(buf[:], r.Uint32())
+		inst, err := Decode(buf[:], ModeARM)
+		if err != nil {
+			continue
+		}
+		if err := CheckRoundTrip(inst); err != nil {
+			t.Errorf("CheckRoundTrip(%v) [%x]: %v", inst, buf, err)
+		}
+	}
+}
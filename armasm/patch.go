@@ -0,0 +1,522 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import "fmt"
+
+// Patch returns inst with argument n (counting from 0) replaced by arg
+// and re-encoded. Inst must be an ARM instruction as returned by Decode:
+// Patch starts from inst.Enc and changes only the bits holding argument n,
+// so the condition, S bit, and other arguments are preserved.
+// The result is the decoding of the new encoding, so its arguments are
+// in the decoder's canonical form: for example, patching a PCRel into
+// a branch or an Imm into a data-processing constant.
+//
+// Patch returns an error if the argument cannot be encoded: if arg has the
+// wrong type for the argument, is out of range (like a branch offset beyond
+// ±32 MB or not a multiple of 4), has no encoding (like an immediate that is not
+// an 8-bit value rotated by an even amount), or if the new encoding would decode
+// to a different instruction. Not every kind of argument can be patched;
+// fixed arguments like SP in PUSH can only be "replaced" by themselves.
+func Patch(inst Inst, n int, arg Arg) (Inst, error) {
+	if inst.Len != 4 {
+		return Inst{}, fmt.Errorf("cannot patch %d-byte instruction", inst.Len)
+	}
+	old, j := decode(inst.Enc)
+	if j < 0 || old.Op != inst.Op {
+		return Inst{}, fmt.Errorf("instruction encoding %#08x does not match %v", inst.Enc, inst.Op)
+	}
+	if n < 0 || n >= len(old.Args) || old.Args[n] == nil {
+		return Inst{}, fmt.Errorf("%v has no argument %d", inst.Op, n)
+	}
+	x, ok := encodeArg(instFormats[j].args[n], arg, inst.Enc)
+	if !ok {
+		return Inst{}, fmt.Errorf("cannot encode %v as argument %d of %v", arg, n, inst.Op)
+	}
+	want := old
+	want.Args[n] = arg
+	patched, k := decode(x)
+	if k != j || !Normalize(patched).Equal(Normalize(want)) {
+		return Inst{}, fmt.Errorf("cannot encode %v as argument %d of %v", arg, n, inst.Op)
+	}
+	return patched, nil
+}
+
+// encodeArg returns the instruction bits x with the argument
+// described by aop set to arg. It is the inverse of decodeArg.
+// It reports false if arg cannot be encoded according to aop.
+// Arguments with no bits in the encoding, like arg_SP, leave x unchanged;
+// the caller must check that the result decodes to arg.
+func encodeArg(aop instArg, arg Arg, x uint32) (uint32, bool) {
+	set := func(v uint32, shift, width uint) uint32 {
+		return x&^((1<<width-1)<<shift) | v<<shift
+	}
+	switch aop {
+	default:
+		return 0, false
+
+	case arg_APSR, arg_FPSCR, arg_SPSR, arg_SP, arg_fp_0:
+		return x, true
+
+	case arg_spec_reg:
+		switch arg {
+		case FPSID:
+			return set(0, 16, 4), true
+		case FPSCR:
+			return set(1, 16, 4), true
+		case MVFR1:
+			return set(6, 16, 4), true
+		case MVFR0:
+			return set(7, 16, 4), true
+		case FPEXC:
+			return set(8, 16, 4), true
+		}
+		return 0, false
+
+	case arg_spec_reg_msr:
+		switch arg {
+		case FPSID:
+			return set(0, 16, 4), true
+		case FPSCR:
+			return set(1, 16, 4), true
+		case FPEXC:
+			return set(8, 16, 4), true
+		}
+		return 0, false
+
+	case arg_R_0, arg_R1_0:
+		r, ok := gpr(arg)
+		return set(r, 0, 4), ok
+	case arg_R_8:
+		r, ok := gpr(arg)
+		return set(r, 8, 4), ok
+	case arg_R_12, arg_R1_12:
+		r, ok := gpr(arg)
+		return set(r, 12, 4), ok
+	case arg_R_16:
+		r, ok := gpr(arg)
+		return set(r, 16, 4), ok
+
+	case arg_R2_0:
+		// The second register of a pair, implied by the first.
+		r, ok := gpr(arg)
+		return x, ok && r == x&(1<<4-1)|1
+	case arg_R2_12:
+		r, ok := gpr(arg)
+		return x, ok && r == (x>>12)&(1<<4-1)|1
+
+	case arg_R_12_nzcv:
+		if arg == APSR_nzcv {
+			return set(15, 12, 4), true
+		}
+		r, ok := gpr(arg)
+		return set(r, 12, 4), ok && r != 15
+
+	case arg_R_16_WB:
+		m, ok := arg.(Mem)
+		if !ok || m.Mode != AddrLDM && m.Mode != AddrLDM_WB {
+			return 0, false
+		}
+		r, ok := gpr(m.Base)
+		w := uint32(0)
+		if m.Mode == AddrLDM_WB {
+			w = 1
+		}
+		x = set(r, 16, 4)
+		return set(w, 21, 1), ok
+
+	case arg_R_rotate:
+		var rs RegShift
+		switch a := arg.(type) {
+		case Reg:
+			rs = RegShift{Reg: a, Shift: RotateRight}
+		case RegShift:
+			rs = a
+		default:
+			return 0, false
+		}
+		r, ok := gpr(rs.Reg)
+		x = set(r, 0, 4)
+		return set(uint32(rs.Count)/8, 10, 2), ok && rs.Shift == RotateRight && rs.Count%8 == 0 && rs.Count < 32
+
+	case arg_R_shift_imm:
+		var rs RegShift
+		switch a := arg.(type) {
+		case Reg:
+			rs = RegShift{Reg: a}
+		case RegShift:
+			rs = a
+		default:
+			return 0, false
+		}
+		r, ok := gpr(rs.Reg)
+		bits, ok1 := encodeShift(rs.Shift, rs.Count)
+		x = set(r, 0, 4)
+		return set(bits, 5, 7), ok && ok1
+
+	case arg_R_shift_R:
+		a, ok := arg.(RegShiftReg)
+		if !ok || a.Shift > RotateRight {
+			return 0, false
+		}
+		rm, ok1 := gpr(a.Reg)
+		rs, ok2 := gpr(a.RegCount)
+		x = set(rm, 0, 4)
+		x = set(rs, 8, 4)
+		return set(uint32(a.Shift), 5, 2), ok1 && ok2
+
+	case arg_Dn_half:
+		a, ok := arg.(RegX)
+		if !ok || a.Reg.Class() != ClassDouble || a.Index&^1 != 0 {
+			return 0, false
+		}
+		d := uint32(a.Reg - D0)
+		x = set(d&15, 16, 4)
+		x = set(d>>4, 7, 1)
+		return set(uint32(a.Index), 21, 1), true
+
+	case arg_Sd, arg_Sd_Dd, arg_Dd_Sd:
+		return encodeVFPReg(aop, arg, x, 12, 22)
+	case arg_Sm, arg_Sm_Dm:
+		return encodeVFPReg(aop, arg, x, 0, 5)
+	case arg_Sn, arg_Sn_Dn:
+		return encodeVFPReg(aop, arg, x, 16, 7)
+
+	case arg_const:
+		switch a := arg.(type) {
+		case ImmAlt:
+			if a.Rot&1 != 0 || a.Rot >= 32 {
+				return 0, false
+			}
+			x = set(uint32(a.Val), 0, 8)
+			return set(uint32(a.Rot/2), 8, 4), true
+		case Imm:
+			v, rot, ok := encodeConst(uint32(a))
+			x = set(v, 0, 8)
+			return set(rot/2, 8, 4), ok
+		}
+		return 0, false
+
+	case arg_endian:
+		e, ok := arg.(Endian)
+		return set(uint32(e), 9, 1), ok && e <= 1
+
+	case arg_fbits:
+		v, ok := arg.(Imm)
+		size := int64(16) << ((x >> 7) & 1)
+		d := size - int64(int32(v))
+		x = set(uint32(d)>>1, 0, 4)
+		return set(uint32(d)&1, 5, 1), ok && 0 <= d && d < 32
+
+	case arg_imm_vfp:
+		v, ok := arg.(Imm)
+		x = set(uint32(v)>>4, 16, 4)
+		return set(uint32(v)&0xf, 0, 4), ok && v < 1<<8
+
+	case arg_lsb_width:
+		// The lsb is the preceding arg_imm5 argument, already encoded.
+		v, ok := arg.(Imm)
+		lsb := (x >> 7) & (1<<5 - 1)
+		msb := lsb + uint32(v) - 1
+		return set(msb, 16, 5), ok && v >= 1 && msb < 32
+
+	case arg_imm24:
+		v, ok := arg.(Imm)
+		return set(uint32(v), 0, 24), ok && v < 1<<24
+	case arg_imm5:
+		v, ok := arg.(Imm)
+		return set(uint32(v), 7, 5), ok && v < 32
+	case arg_imm5_32:
+		v, ok := arg.(Imm)
+		return set(uint32(v)&31, 7, 5), ok && 1 <= v && v <= 32
+	case arg_imm5_nz:
+		v, ok := arg.(Imm)
+		return set(uint32(v), 7, 5), ok && 1 <= v && v < 32
+	case arg_imm_4at16_12at0:
+		v, ok := arg.(Imm)
+		x = set(uint32(v)>>12, 16, 4)
+		return set(uint32(v)&0xfff, 0, 12), ok && v < 1<<16
+	case arg_imm_12at8_4at0:
+		v, ok := arg.(Imm)
+		x = set(uint32(v)>>4, 8, 12)
+		return set(uint32(v)&0xf, 0, 4), ok && v < 1<<16
+	case arg_option:
+		v, ok := arg.(Imm)
+		return set(uint32(v), 0, 4), ok && v < 16
+	case arg_satimm4:
+		v, ok := arg.(Imm)
+		return set(uint32(v), 16, 4), ok && v < 16
+	case arg_satimm5:
+		v, ok := arg.(Imm)
+		return set(uint32(v), 16, 5), ok && v < 32
+	case arg_satimm4m1:
+		v, ok := arg.(Imm)
+		return set(uint32(v)-1, 16, 4), ok && 1 <= v && v <= 16
+	case arg_satimm5m1, arg_widthm1:
+		v, ok := arg.(Imm)
+		return set(uint32(v)-1, 16, 5), ok && 1 <= v && v <= 32
+
+	case arg_label24:
+		d, ok := arg.(PCRel)
+		if !ok || d&3 != 0 || d < -1<<25 || d >= 1<<25 {
+			return 0, false
+		}
+		return set(uint32(d>>2)&(1<<24-1), 0, 24), true
+
+	case arg_label24H:
+		d, ok := arg.(PCRel)
+		if !ok || d&1 != 0 || d < -1<<25 || d >= 1<<25 {
+			return 0, false
+		}
+		x = set(uint32(d>>1)&1, 24, 1)
+		return set(uint32(d>>2)&(1<<24-1), 0, 24), true
+
+	case arg_label_m_12, arg_label_p_12, arg_label_pm_12:
+		m, ok := arg.(Mem)
+		if !ok || m.Base != PC || m.Mode != AddrOffset || m.Sign != 0 {
+			return 0, false
+		}
+		d, u := int32(m.Offset), uint32(1)
+		if d < 0 {
+			d, u = -d, 0
+		}
+		if d >= 1<<12 || aop == arg_label_m_12 && u == 1 && d != 0 || aop == arg_label_p_12 && u == 0 {
+			return 0, false
+		}
+		if aop == arg_label_pm_12 {
+			x = set(u, 23, 1)
+		}
+		return set(uint32(d), 0, 12), true
+
+	case arg_label_pm_4_4:
+		d, ok := arg.(PCRel)
+		u := uint32(1)
+		if d < 0 {
+			d, u = -d, 0
+		}
+		x = set(u, 23, 1)
+		x = set(uint32(d)>>4, 8, 4)
+		return set(uint32(d)&0xf, 0, 4), ok && d < 1<<8
+
+	case arg_mem_R:
+		m, ok := arg.(Mem)
+		if !ok || m.Mode != AddrOffset || m.Sign != 0 || m.Offset != 0 {
+			return 0, false
+		}
+		r, ok := gpr(m.Base)
+		return set(r, 16, 4), ok
+
+	case arg_mem_R_pm_imm12_W, arg_mem_R_pm_imm12_offset, arg_mem_R_pm_imm12_postindex:
+		m, ok := arg.(Mem)
+		if !ok || m.Sign != 0 || !memModeOK(aop, m.Mode) {
+			return 0, false
+		}
+		x, ok = encodeMemBase(aop, m, x)
+		d, u := int32(m.Offset), uint32(1)
+		if d < 0 {
+			d, u = -d, 0
+		}
+		x = set(u, 23, 1)
+		return set(uint32(d), 0, 12), ok && d < 1<<12
+
+	case arg_mem_R_pm_imm8_W, arg_mem_R_pm_imm8_postindex:
+		m, ok := arg.(Mem)
+		if !ok || m.Sign != 0 || !memModeOK(aop, m.Mode) {
+			return 0, false
+		}
+		x, ok = encodeMemBase(aop, m, x)
+		d, u := int32(m.Offset), uint32(1)
+		if d < 0 {
+			d, u = -d, 0
+		}
+		x = set(u, 23, 1)
+		x = set(uint32(d)>>4, 8, 4)
+		return set(uint32(d)&0xf, 0, 4), ok && d < 1<<8
+
+	case arg_mem_R_pm_imm8at0_offset:
+		m, ok := arg.(Mem)
+		if !ok || m.Sign != 0 || m.Mode != AddrOffset {
+			return 0, false
+		}
+		r, ok := gpr(m.Base)
+		d, u := int32(m.Offset), uint32(1)
+		if d < 0 {
+			d, u = -d, 0
+		}
+		x = set(r, 16, 4)
+		x = set(u, 23, 1)
+		return set(uint32(d)>>2, 0, 8), ok && d&3 == 0 && d < 1<<10
+
+	case arg_mem_R_pm_R_W, arg_mem_R_pm_R_postindex,
+		arg_mem_R_pm_R_shift_imm_W, arg_mem_R_pm_R_shift_imm_offset, arg_mem_R_pm_R_shift_imm_postindex:
+		m, ok := arg.(Mem)
+		if !ok || m.Sign == 0 || m.Offset != 0 || !memModeOK(aop, m.Mode) {
+			return 0, false
+		}
+		x, ok = encodeMemBase(aop, m, x)
+		rm, ok1 := gpr(m.Index)
+		u := uint32(1)
+		if m.Sign < 0 {
+			u = 0
+		}
+		x = set(u, 23, 1)
+		x = set(rm, 0, 4)
+		if aop == arg_mem_R_pm_R_W || aop == arg_mem_R_pm_R_postindex {
+			return x, ok && ok1 && m.Shift == ShiftLeft && m.Count == 0
+		}
+		bits, ok2 := encodeShift(m.Shift, m.Count)
+		return set(bits, 5, 7), ok && ok1 && ok2
+
+	case arg_registers, arg_registers2:
+		list, ok := arg.(RegList)
+		return set(uint32(list), 0, 16), ok
+
+	case arg_registers1:
+		list, ok := arg.(RegList)
+		if !ok || list == 0 || list&(list-1) != 0 {
+			return 0, false
+		}
+		r := uint32(0)
+		for list>>r != 1 {
+			r++
+		}
+		return set(r, 12, 4), true
+
+	case arg_vlist32:
+		list, ok := arg.(RegRange)
+		if !ok || !list.Valid() || list.First.Class() != ClassSingle {
+			return 0, false
+		}
+		s := uint32(list.First - S0)
+		x = set(s>>1, 12, 4)
+		x = set(s&1, 22, 1)
+		return set(uint32(list.Count), 0, 8), true
+
+	case arg_vlist64:
+		list, ok := arg.(RegRange)
+		if !ok || !list.Valid() || list.First.Class() != ClassDouble {
+			return 0, false
+		}
+		d := uint32(list.First - D0)
+		x = set(d&15, 12, 4)
+		x = set(d>>4, 22, 1)
+		return set(uint32(list.Count)*2, 0, 8), true
+	}
+}
+
+// gpr returns the number of the core register arg,
+// reporting whether arg is one of R0 through R15.
+func gpr(arg Arg) (uint32, bool) {
+	r, ok := arg.(Reg)
+	if !ok || r.Class() != ClassGPR && r.Class() != ClassSpecial {
+		return 0, false
+	}
+	return uint32(r - R0), true
+}
+
+// encodeShift returns the 7-bit imm5:type field encoding the shift
+// by a constant, the inverse of decodeShift.
+func encodeShift(typ Shift, count uint8) (uint32, bool) {
+	switch typ {
+	case ShiftLeft:
+		if count < 32 {
+			return uint32(count)<<2 | uint32(typ), true
+		}
+	case ShiftRight, ShiftRightSigned:
+		if 1 <= count && count <= 32 {
+			return uint32(count&31)<<2 | uint32(typ), true
+		}
+	case RotateRight:
+		if 1 <= count && count < 32 {
+			return uint32(count)<<2 | uint32(typ), true
+		}
+	case RotateRightExt:
+		if count == 1 {
+			return uint32(RotateRight), true
+		}
+	}
+	return 0, false
+}
+
+// encodeConst returns the 8-bit value and even rotation encoding
+// the modified immediate constant v, preferring the smallest rotation,
+// as the assembler does. It reports false if v has no such encoding.
+func encodeConst(v uint32) (val, rot uint32, ok bool) {
+	for rot = 0; rot < 32; rot += 2 {
+		val = v<<rot | v>>(32-rot)
+		if rot == 0 {
+			val = v
+		}
+		if val < 1<<8 {
+			return val, rot, true
+		}
+	}
+	return 0, 0, false
+}
+
+// encodeVFPReg sets the VFP register field of x at shift, with its extra bit at xbit,
+// to arg. Single-precision registers store the extra bit as the low bit of the
+// register number; double-precision registers store it as the high bit.
+// The _Dd, _Dm, and _Dn forms also set the sz bit to match arg's class
+// (inverted for arg_Dd_Sd); where the opcode fixes sz, a conflicting
+// register changes the decoded opcode, which the callers detect.
+func encodeVFPReg(aop instArg, arg Arg, x uint32, shift, xbit uint) (uint32, bool) {
+	r, ok := arg.(Reg)
+	if !ok {
+		return 0, false
+	}
+	var v, vx, sz uint32
+	switch r.Class() {
+	case ClassSingle:
+		n := uint32(r - S0)
+		v, vx = n>>1, n&1
+	case ClassDouble:
+		n := uint32(r - D0)
+		v, vx, sz = n&15, n>>4, 1
+	default:
+		return 0, false
+	}
+	switch aop {
+	case arg_Sd, arg_Sm, arg_Sn:
+		if sz != 0 {
+			return 0, false
+		}
+	case arg_Dd_Sd:
+		x = x&^(1<<8) | (sz^1)<<8
+	default:
+		x = x&^(1<<8) | sz<<8
+	}
+	x = x&^(15<<shift|1<<xbit) | v<<shift | vx<<xbit
+	return x, true
+}
+
+// memModeOK reports whether an addressing mode can be
+// encoded in an argument described by aop.
+func memModeOK(aop instArg, mode AddrMode) bool {
+	switch aop {
+	case arg_mem_R_pm_imm12_offset, arg_mem_R_pm_R_shift_imm_offset:
+		return mode == AddrOffset
+	case arg_mem_R_pm_imm12_postindex, arg_mem_R_pm_imm8_postindex,
+		arg_mem_R_pm_R_postindex, arg_mem_R_pm_R_shift_imm_postindex:
+		return mode == AddrPostIndex
+	}
+	return mode == AddrOffset || mode == AddrPreIndex || mode == AddrPostIndex
+}
+
+// encodeMemBase sets the base register of x to that of m and,
+// if aop is one of the general _W forms, sets the P and W bits for m's mode.
+// The other forms fix the mode, and their P and W bits, if any,
+// distinguish instructions (LDRT from LDR, for example), so they are left alone.
+func encodeMemBase(aop instArg, m Mem, x uint32) (uint32, bool) {
+	r, ok := gpr(m.Base)
+	x = x&^(15<<16) | r<<16
+	switch aop {
+	case arg_mem_R_pm_imm12_W, arg_mem_R_pm_imm8_W, arg_mem_R_pm_R_W, arg_mem_R_pm_R_shift_imm_W:
+		p := uint32(m.Mode) >> 1
+		w := uint32(m.Mode)&1 ^ 1
+		x = x&^(1<<24|1<<21) | p<<24 | w<<21
+	}
+	return x, ok
+}
@@ -0,0 +1,118 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+var patchTests = []struct {
+	enc uint32
+	n   int
+	arg Arg
+	out uint32 // 0 for error
+}{
+	// B, BL, BLX: branch offsets, keeping the condition.
+	{0xea000001, 0, PCRel(-8), 0xeafffffe},
+	{0x0b000040, 0, PCRel(0x8), 0x0b000002},
+	{0xfa000000, 0, PCRel(6), 0xfb000001},
+	{0xea000001, 0, PCRel(1<<25 - 4), 0xea7fffff},
+	{0xea000001, 0, PCRel(-1 << 25), 0xea800000},
+	{0xea000001, 0, PCRel(1 << 25), 0},
+	{0xea000001, 0, PCRel(2), 0},
+	{0xfa000000, 0, PCRel(3), 0},
+	{0xea000001, 0, Imm(4), 0},
+
+	// Data processing: constants, registers, shifts, keeping the S bit.
+	{0xe3a00001, 1, Imm(0xff000000), 0xe3a004ff},
+	{0xe3b00001, 1, Imm(0x3fc), 0xe3b00fff},
+	{0xe3a00001, 1, ImmAlt{1, 2}, 0xe3a00101},
+	{0xe3a00001, 1, Imm(0x101), 0},
+	{0xe3a00001, 0, R7, 0xe3a07001},
+	{0xe3a00001, 0, S0, 0},
+	{0xe0810002, 2, RegShift{R3, ShiftLeft, 2}, 0xe0810103},
+	{0xe0810103, 2, R2, 0xe0810002},
+	{0xe0810002, 2, RegShift{R3, ShiftRight, 32}, 0xe0810023},
+	{0xe0810002, 2, RegShift{R3, ShiftLeft, 32}, 0},
+	{0xe0810002, 2, Imm(1), 0},
+	{0xe3010234, 1, Imm(0xabcd), 0xe30a0bcd},
+	{0xe3010234, 1, Imm(0x10000), 0},
+
+	// Loads and stores.
+	{0xe5910004, 1, Mem{Base: R1, Mode: AddrOffset, Offset: -8}, 0xe5110008},
+	{0xe5910004, 1, Mem{Base: R1, Mode: AddrPreIndex, Offset: -8}, 0xe5310008},
+	{0xe5910004, 1, Mem{Base: R1, Mode: AddrPostIndex, Offset: -8}, 0xe4110008},
+	{0xe5910004, 1, Mem{Base: R1, Mode: AddrOffset, Offset: 4096}, 0},
+	{0xe4b10004, 1, Mem{Base: R1, Mode: AddrPostIndex, Offset: -4}, 0xe4310004},
+	{0xe4b10004, 1, Mem{Base: R1, Mode: AddrOffset, Offset: -4}, 0},
+	{0xe1d100b2, 1, Mem{Base: R1, Mode: AddrOffset, Offset: -255}, 0xe1510fbf},
+	{0xe1d100b2, 1, Mem{Base: R1, Mode: AddrOffset, Offset: 256}, 0},
+	{0xe7832004, 1, Mem{Base: R3, Mode: AddrOffset, Sign: -1, Index: R4, Shift: ShiftRightSigned, Count: 3}, 0xe70321c4},
+	{0xe59f0008, 1, Mem{Base: PC, Mode: AddrOffset, Offset: -4}, 0xe51f0004},
+
+	// Register lists.
+	{0xe92d4010, 0, RegList(0x40f0), 0xe92d40f0},
+	{0xed2d8b04, 0, RegRange{D8, 8}, 0xed2d8b10},
+	{0xed2d8b04, 0, RegRange{S16, 2}, 0},
+
+	// VFP registers.
+	{0xee300a81, 0, S3, 0xee701a81},
+	{0xee300a81, 0, D1, 0},
+	{0xee310b02, 0, D17, 0xee711b02},
+	{0xee310b02, 0, S3, 0},
+
+	// VFP system registers: MVFR0 and MVFR1 are read-only.
+	{0xeef61a10, 1, MVFR0, 0xeef71a10},
+	{0xeee81a10, 0, FPSID, 0xeee01a10},
+	{0xeee81a10, 0, MVFR1, 0},
+	{0xeee81a10, 0, MVFR0, 0},
+
+	// Bad argument numbers.
+	{0xe3a00001, 2, Imm(0), 0},
+	{0xe3a00001, -1, Imm(0), 0},
+}
+
+func TestPatch(t *testing.T) {
+	for _, tt := range patchTests {
+		var buf [4]byte
+		binary.LittleEndian.PutUint32(buf[:], tt.enc)
+		inst, err := Decode(buf[:], ModeARM)
+		if err != nil {
+			t.Errorf("Decode(%#08x): %v", tt.enc, err)
+			continue
+		}
+		out, err := Patch(inst, tt.n, tt.arg)
+		if tt.out == 0 {
+			if err == nil {
+				t.Errorf("Patch(%v, %d, %v) = %v (%#08x), want error", inst, tt.n, tt.arg, out, out.Enc)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Patch(%v, %d, %v): %v", inst, tt.n, tt.arg, err)
+			continue
+		}
+		if out.Enc != tt.out {
+			t.Errorf("Patch(%v, %d, %v) = %v (%#08x), want %#08x", inst, tt.n, tt.arg, out, out.Enc, tt.out)
+			continue
+		}
+		if out.Op != inst.Op || out.Len != 4 {
+			t.Errorf("Patch(%v, %d, %v) = %v, changed op or length", inst, tt.n, tt.arg, out)
+		}
+		want := inst
+		want.Args[tt.n] = tt.arg
+		if !Normalize(out).Equal(Normalize(want)) {
+			t.Errorf("Patch(%v, %d, %v) = %v, want %v", inst, tt.n, tt.arg, out, want)
+		}
+	}
+
+	if _, err := Patch(Inst{Op: MOV, Args: Args{R0, Imm(1)}, Enc: 0xe3a00001, Len: 2}, 1, Imm(2)); err == nil {
+		t.Errorf("Patch of 2-byte instruction succeeded")
+	}
+	if _, err := Patch(Inst{Op: ADD, Args: Args{R0, Imm(1)}, Enc: 0xe3a00001, Len: 4}, 1, Imm(2)); err == nil {
+		t.Errorf("Patch with mismatched Op and Enc succeeded")
+	}
+}
@@ -5,33 +5,49 @@
 package armasm
 
 import (
-	"bytes"
 	"encoding/binary"
-	"fmt"
 	"io"
-	"strings"
+	"strconv"
 )
 
-// plan9Syntax returns the Go assembler syntax for the instruction.
+// GoSyntax returns the Go assembler syntax for the instruction.
 // The syntax was originally defined by Plan 9.
 // The pc is the program counter of the instruction, used for expanding
 // PC-relative addresses into absolute ones.
 // The symname function queries the symbol table for the program
 // being disassembled. Given a target address it returns the name and base
 // address of the symbol containing the target, if any; otherwise it returns "", 0.
-// The reader r should read from the text segment using text addresses
+// The reader text should read from the text segment using text addresses
 // as offsets; it is used to display pc-relative loads as constant loads.
-func plan9Syntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text io.ReaderAt) string {
+// Both symname and text may be nil.
+func GoSyntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), text io.ReaderAt) string {
+	return string(AppendGoSyntax(nil, inst, pc, symname, text))
+}
+
+// AppendGoSyntax appends the Go assembler syntax for the instruction,
+// as returned by GoSyntax, to dst and returns the extended buffer.
+// Reusing the buffer across calls avoids allocating a string for
+// each instruction.
+func AppendGoSyntax(dst []byte, inst Inst, pc uint64, symname func(uint64) (string, uint64), text io.ReaderAt) []byte {
 	if symname == nil {
 		symname = func(uint64) (string, uint64) { return "", 0 }
 	}
 
-	var args []string
+	// The arguments are formatted into args, with arg i
+	// occupying args[ends[i-1]:ends[i]], and then appended
+	// to dst in reverse order. If arg1 is non-nil, it replaces
+	// the formatting of argument 1.
+	var argBuf [128]byte
+	var arg1Buf [64]byte
+	var endBuf [len(inst.Args)]int
+	args, ends := argBuf[:0], endBuf[:0]
+	var arg1 []byte
 	for _, a := range inst.Args {
 		if a == nil {
 			break
 		}
-		args = append(args, plan9Arg(&inst, pc, symname, a))
+		args = appendPlan9Arg(args, &inst, pc, symname, a)
+		ends = append(ends, len(args))
 	}
 
 	op := inst.Op.String()
@@ -42,25 +58,27 @@ func plan9Syntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), te
 		reg, _ := inst.Args[0].(Reg)
 		mem, _ := inst.Args[1].(Mem)
 		if inst.Op&^15 == LDR_EQ && reg == R15 && mem.Base == SP && mem.Sign == 0 && mem.Mode == AddrPostIndex {
-			return fmt.Sprintf("RET%s #%d", op[3:], mem.Offset)
+			dst = append(dst, "RET"...)
+			dst = append(dst, op[3:]...)
+			return strconv.AppendInt(append(dst, " #"...), int64(mem.Offset), 10)
 		}
 
 		// Check for PC-relative load.
 		if mem.Base == PC && mem.Sign == 0 && mem.Mode == AddrOffset && text != nil {
-			addr := uint32(pc) + 8 + uint32(mem.Offset)
+			addr := PCRel(mem.Offset).Target(pc, ModeARM)
 			buf := make([]byte, 4)
 			switch inst.Op &^ 15 {
 			case LDRB_EQ:
 				if _, err := text.ReadAt(buf[:1], int64(addr)); err != nil {
 					break
 				}
-				args[1] = fmt.Sprintf("$%#x", buf[0])
+				arg1 = appendHex(append(arg1Buf[:0], '$'), uint64(buf[0]), 0)
 
 			case LDRH_EQ:
 				if _, err := text.ReadAt(buf[:2], int64(addr)); err != nil {
 					break
 				}
-				args[1] = fmt.Sprintf("$%#x", binary.LittleEndian.Uint16(buf))
+				arg1 = appendHex(append(arg1Buf[:0], '$'), uint64(binary.LittleEndian.Uint16(buf)), 0)
 
 			case LDR_EQ:
 				if _, err := text.ReadAt(buf, int64(addr)); err != nil {
@@ -68,9 +86,9 @@ func plan9Syntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), te
 				}
 				x := binary.LittleEndian.Uint32(buf)
 				if s, base := symname(uint64(x)); s != "" && uint64(x) == base {
-					args[1] = fmt.Sprintf("$%s(SB)", s)
+					arg1 = append(append(append(arg1Buf[:0], '$'), s...), "(SB)"...)
 				} else {
-					args[1] = fmt.Sprintf("$%#x", x)
+					arg1 = appendHex(append(arg1Buf[:0], '$'), uint64(x), 0)
 				}
 			}
 		}
@@ -89,58 +107,64 @@ func plan9Syntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), te
 		case AddrPostIndex:
 			suffix = ".P"
 		}
-		off := ""
+		b := arg1Buf[:0]
 		if mem.Offset != 0 {
-			off = fmt.Sprintf("%#x", mem.Offset)
+			b = appendHexInt(b, int64(mem.Offset))
 		}
-		base := fmt.Sprintf("(R%d)", int(mem.Base))
-		index := ""
+		b = strconv.AppendInt(append(b, "(R"...), int64(mem.Base), 10)
+		b = append(b, ')')
 		if mem.Sign != 0 {
-			sign := ""
-			if mem.Sign < 0 {
-				sign = ""
-			}
-			shift := ""
+			b = strconv.AppendInt(append(b, "(R"...), int64(mem.Index), 10)
 			if mem.Count != 0 {
-				shift = fmt.Sprintf("%s%d", plan9Shift[mem.Shift], mem.Count)
+				b = append(b, plan9Shift[mem.Shift]...)
+				b = strconv.AppendInt(b, int64(mem.Count), 10)
 			}
-			index = fmt.Sprintf("(%sR%d%s)", sign, int(mem.Index), shift)
+			b = append(b, ')')
 		}
-		args[1] = off + base + index
-	}
-
-	// Reverse args, placing dest last.
-	for i, j := 0, len(args)-1; i < j; i, j = i+1, j-1 {
-		args[i], args[j] = args[j], args[i]
+		arg1 = b
 	}
 
 	switch inst.Op &^ 15 {
 	case MOV_EQ:
-		op = "MOVW" + op[3:]
-
-	case LDR_EQ:
-		op = "MOVW" + op[3:] + suffix
-	case LDRB_EQ:
-		op = "MOVB" + op[4:] + suffix
-	case LDRH_EQ:
-		op = "MOVH" + op[4:] + suffix
-
-	case STR_EQ:
-		op = "MOVW" + op[3:] + suffix
-		args[0], args[1] = args[1], args[0]
-	case STRB_EQ:
-		op = "MOVB" + op[4:] + suffix
-		args[0], args[1] = args[1], args[0]
-	case STRH_EQ:
-		op = "MOVH" + op[4:] + suffix
-		args[0], args[1] = args[1], args[0]
+		dst = append(append(dst, "MOVW"...), op[3:]...)
+	case LDR_EQ, STR_EQ:
+		dst = append(append(append(dst, "MOVW"...), op[3:]...), suffix...)
+	case LDRB_EQ, STRB_EQ:
+		dst = append(append(append(dst, "MOVB"...), op[4:]...), suffix...)
+	case LDRH_EQ, STRH_EQ:
+		dst = append(append(append(dst, "MOVH"...), op[4:]...), suffix...)
+	default:
+		dst = append(dst, op...)
 	}
 
-	if args != nil {
-		op += " " + strings.Join(args, ", ")
+	// Append args in reverse, placing dest last,
+	// except that stores keep the source first.
+	order := [len(inst.Args)]int{}
+	for i := range ends {
+		order[i] = len(ends) - 1 - i
 	}
-
-	return op
+	switch inst.Op &^ 15 {
+	case STR_EQ, STRB_EQ, STRH_EQ:
+		order[0], order[1] = order[1], order[0]
+	}
+	for i := range ends {
+		if i == 0 {
+			dst = append(dst, ' ')
+		} else {
+			dst = append(dst, ", "...)
+		}
+		j := order[i]
+		if j == 1 && arg1 != nil {
+			dst = append(dst, arg1...)
+			continue
+		}
+		start := 0
+		if j > 0 {
+			start = ends[j-1]
+		}
+		dst = append(dst, args[start:ends[j]]...)
+	}
+	return dst
 }
 
 // assembler syntax for the various shifts.
@@ -149,63 +173,50 @@ func plan9Syntax(inst Inst, pc uint64, symname func(uint64) (string, uint64), te
 // was a different operation (rotate right extended, not rotate right).
 var plan9Shift = []string{"<<", ">>", "->", "@>", "@x>"}
 
-func plan9Arg(inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg Arg) string {
+// plan9RegNames holds the names of R0 through R15.
+var plan9RegNames = func() (names [16]string) {
+	for r := range names {
+		names[r] = "R" + strconv.Itoa(r)
+	}
+	return
+}()
+
+func appendPlan9Arg(dst []byte, inst *Inst, pc uint64, symname func(uint64) (string, uint64), arg Arg) []byte {
 	switch a := arg.(type) {
 	case Endian:
 
 	case Imm:
-		return fmt.Sprintf("$%d", int(a))
+		return strconv.AppendInt(append(dst, '$'), int64(a), 10)
 
 	case Mem:
 
 	case PCRel:
-		addr := uint32(pc) + 8 + uint32(a)
-		if s, base := symname(uint64(addr)); s != "" && uint64(addr) == base {
-			return fmt.Sprintf("%s(SB)", s)
+		addr := a.Target(pc, ModeARM)
+		if s, base := symname(addr); s != "" && addr == base {
+			return append(append(dst, s...), "(SB)"...)
 		}
-		return fmt.Sprintf("%#x", addr)
+		return appendHex(dst, addr, 0)
 
 	case Reg:
 		if a < 16 {
-			return fmt.Sprintf("R%d", int(a))
+			return strconv.AppendInt(append(dst, 'R'), int64(a), 10)
 		}
 
 	case RegList:
-		var buf bytes.Buffer
-		start := -2
-		end := -2
-		fmt.Fprintf(&buf, "[")
-		flush := func() {
-			if start >= 0 {
-				if buf.Len() > 1 {
-					fmt.Fprintf(&buf, ",")
-				}
-				if start == end {
-					fmt.Fprintf(&buf, "R%d", start)
-				} else {
-					fmt.Fprintf(&buf, "R%d-R%d", start, end)
-				}
-			}
-		}
-		for i := 0; i < 16; i++ {
-			if a&(1<<uint(i)) != 0 {
-				if i == end+1 {
-					end++
-					continue
-				}
-				start = i
-				end = i
-			}
-		}
-		flush()
-		fmt.Fprintf(&buf, "]")
-		return buf.String()
+		dst = append(dst, '[')
+		dst = appendRegListRanges(dst, a, plan9RegNames[:])
+		return append(dst, ']')
 
 	case RegShift:
-		return fmt.Sprintf("R%d%s$%d", int(a.Reg), plan9Shift[a.Shift], int(a.Count))
+		dst = strconv.AppendInt(append(dst, 'R'), int64(a.Reg), 10)
+		dst = append(dst, plan9Shift[a.Shift]...)
+		return strconv.AppendInt(append(dst, '$'), int64(a.Count), 10)
 
 	case RegShiftReg:
-		return fmt.Sprintf("R%d%sR%d", int(a.Reg), plan9Shift[a.Shift], int(a.RegCount))
+		dst = strconv.AppendInt(append(dst, 'R'), int64(a.Reg), 10)
+		dst = append(dst, plan9Shift[a.Shift]...)
+		return strconv.AppendInt(append(dst, 'R'), int64(a.RegCount), 10)
 	}
-	return strings.ToUpper(arg.String())
+	n := len(dst)
+	return toUpper(appendArg(dst, arg), n)
 }
@@ -0,0 +1,104 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import "strings"
+
+// A ProbeClass says how a probe-based tracer can execute an instruction
+// that it has replaced with a breakpoint.
+type ProbeClass uint8
+
+const (
+	// ProbeSafe instructions can be copied to a scratch slot
+	// and single-stepped there unchanged.
+	ProbeSafe ProbeClass = iota
+
+	// ProbeSimulate instructions read or write PC, so executing them
+	// out of line would compute the wrong result or continue at the wrong place.
+	// The tracer must simulate them, or rewrite them with Relocate.
+	ProbeSimulate
+
+	// ProbeReject instructions cannot be probed at all.
+	ProbeReject
+)
+
+var probeClassNames = []string{
+	ProbeSafe:     "safe",
+	ProbeSimulate: "simulate",
+	ProbeReject:   "reject",
+}
+
+func (c ProbeClass) String() string {
+	if int(c) < len(probeClassNames) {
+		return probeClassNames[c]
+	}
+	return "ProbeClass(?)"
+}
+
+// ClassifyProbe reports how a kprobes- or uprobes-style tracer can handle
+// a breakpoint placed on inst, making the same decision the Linux kernel makes
+// for ARM instructions. It also returns a short reason for any class but ProbeSafe.
+//
+// Instructions are rejected if they are undefined or unpredictable,
+// if they are themselves exceptions (BKPT, SVC), or if they are
+// exclusive loads and stores, whose monitor the breakpoint exception clears,
+// so that a probed LDREX/STREX loop would never make progress.
+// Instructions reading or writing PC, including all branches,
+// must be simulated. All others are safe.
+func ClassifyProbe(inst Inst) (ProbeClass, string) {
+	name := inst.Op.String()
+	if i := strings.Index(name, "."); i >= 0 {
+		name = name[:i]
+	}
+	switch {
+	case inst.Op == 0 || name == "UNDEF":
+		return ProbeReject, "undefined instruction"
+	case name == "BKPT" || name == "SVC":
+		return ProbeReject, "generates an exception"
+	case strings.HasPrefix(name, "LDREX"), strings.HasPrefix(name, "STREX"), name == "CLREX":
+		return ProbeReject, "exclusive access"
+	}
+	for _, arg := range inst.Args {
+		if m, ok := arg.(Mem); ok && m.Base == PC && m.Mode != AddrOffset && m.Mode != AddrLDM {
+			return ProbeReject, "writeback to PC"
+		}
+	}
+
+	for _, arg := range inst.Args {
+		if _, ok := arg.(PCRel); ok {
+			return ProbeSimulate, "PC-relative branch"
+		}
+	}
+	if writesPC(inst, name) {
+		return ProbeSimulate, "writes PC"
+	}
+	if readsPC(inst) {
+		return ProbeSimulate, "reads PC"
+	}
+	return ProbeSafe, ""
+}
+
+// writesPC reports whether inst, whose unsuffixed name is name,
+// may write PC other than by a PC-relative branch.
+func writesPC(inst Inst, name string) bool {
+	switch name {
+	case "BX", "BLX", "BXJ":
+		return true
+	case "CMP", "CMN", "TST", "TEQ":
+		return false
+	}
+	switch arg := inst.Args[0].(type) {
+	case Reg:
+		return arg == PC && !strings.HasPrefix(name, "ST")
+	case Mem:
+		// LDM with the base register first.
+		if list, ok := inst.Args[1].(RegList); ok {
+			return list&(1<<15) != 0 && strings.HasPrefix(name, "LDM")
+		}
+	case RegList:
+		return arg&(1<<15) != 0 && name == "POP"
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"encoding/binary"
+	"testing"
+)
+
+var probeTests = []struct {
+	enc    uint32
+	class  ProbeClass
+	reason string
+}{
+	{0xe0810002, ProbeSafe, ""},                         // ADD R0, R1, R2
+	{0xe52de004, ProbeSafe, ""},                         // PUSH {LR}
+	{0xe320f003, ProbeSafe, ""},                         // WFI
+	{0xe1010090, ProbeSafe, ""},                         // SWP R0, R0, [R1]
+	{0xea0003fe, ProbeSimulate, "PC-relative branch"},   // B PC+0xff8
+	{0x1a00019c, ProbeSimulate, "PC-relative branch"},   // B.NE PC+0x670
+	{0xe12fff1e, ProbeSimulate, "writes PC"},            // BX LR
+	{0xe12fff3c, ProbeSimulate, "writes PC"},            // BLX R12
+	{0xe8bd8010, ProbeSimulate, "writes PC"},            // POP {R4,PC}
+	{0xe1a0f00e, ProbeSimulate, "writes PC"},            // MOV PC, LR
+	{0xe59ff000, ProbeSimulate, "writes PC"},            // LDR PC, [PC]
+	{0xe59f0008, ProbeSimulate, "reads PC"},             // LDR R0, [PC, #8]
+	{0xe1a0000f, ProbeSimulate, "reads PC"},             // MOV R0, PC
+	{0xe28f0004, ProbeSimulate, "reads PC"},             // ADD R0, PC, #0x4
+	{0xe58df000, ProbeSimulate, "reads PC"},             // STR PC, [SP]
+	{0xe89f000f, ProbeSimulate, "reads PC"},             // LDM PC, {R0-R3}
+	{0xe1920f9f, ProbeReject, "exclusive access"},       // LDREX R0, [R2]
+	{0xe1820f91, ProbeReject, "exclusive access"},       // STREX R0, R1, [R2]
+	{0xf57ff01f, ProbeReject, "exclusive access"},       // CLREX
+	{0xe1200070, ProbeReject, "generates an exception"}, // BKPT #0x0
+	{0xef000000, ProbeReject, "generates an exception"}, // SVC #0x0
+	{0xe4bfe004, ProbeReject, "writeback to PC"},        // LDRT LR, [PC], #4
+	{0xe7f000f0, ProbeReject, "undefined instruction"},
+}
+
+func TestClassifyProbe(t *testing.T) {
+	for _, tt := range probeTests {
+		var buf [4]byte
+		binary.LittleEndian.PutUint32(buf[:], tt.enc)
+		inst, _ := Decode(buf[:], ModeARM)
+		class, reason := ClassifyProbe(inst)
+		if class != tt.class || reason != tt.reason {
+			t.Errorf("ClassifyProbe(%v) = %v, %q, want %v, %q", inst, class, reason, tt.class, tt.reason)
+		}
+	}
+}
@@ -0,0 +1,180 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Relocate returns a sequence of ARM instructions equivalent to inst,
+// an ARM instruction at address oldPC, for execution at address newPC,
+// as needed when copying instructions into a trampoline for dynamic
+// instrumentation. The instructions in the result are encoded (their Enc
+// and Len fields are set) and must be placed consecutively starting at newPC.
+//
+// An instruction that does not read PC is returned unchanged.
+// A branch (B, BL, or BLX) is re-encoded with the offset to its original target
+// if the target is in range, and otherwise becomes MOVW and MOVT loading the target
+// into IP (R12) followed by BX IP or BLX IP. A load from a literal pool
+// (LDR, LDRB, LDRH, LDRSB, LDRSH, LDRD, or VLDR with base PC) is likewise
+// re-encoded if the literal is in range, and otherwise becomes MOVW and MOVT
+// loading the literal's address into the destination register (or IP,
+// for VLDR or a load into PC) followed by the load from that register.
+// An ADD or SUB computing an address from PC, as ADR does, becomes
+// MOVW and MOVT loading the address. The sequences keep inst's condition
+// and require ARMv6T2 or later for MOVW and MOVT; those using IP
+// assume, as linker veneers do, that IP is free at the instruction.
+//
+// Relocate returns an error for any other instruction that reads PC,
+// such as a PC-relative store or an instruction using PC as an index register,
+// whose behavior depends on its address in ways it cannot rewrite.
+func Relocate(inst Inst, oldPC, newPC uint64) ([]Inst, error) {
+	if inst.Len != 4 {
+		return nil, fmt.Errorf("cannot relocate %d-byte instruction", inst.Len)
+	}
+	cond := inst.Op & 15
+	oldBase := (oldPC + 8) & 0xffffffff
+	newBase := (newPC + 8) & 0xffffffff
+
+	for i, arg := range inst.Args {
+		switch arg := arg.(type) {
+		case PCRel:
+			target := uint32(oldBase + uint64(int64(arg)))
+			if out, err := Patch(inst, i, PCRel(target-uint32(newBase))); err == nil {
+				return []Inst{out}, nil
+			}
+			switch inst.Op &^ 15 {
+			case B_EQ:
+				return relocSeq(R12, target, cond, Inst{Op: BX_EQ | cond, Args: Args{R12}})
+			case BL_EQ:
+				return relocSeq(R12, target, cond, Inst{Op: BLX_EQ | cond, Args: Args{R12}})
+			case BLX_EQ:
+				// BLX <label> always switches to Thumb.
+				return relocSeq(R12, target|1, cond, Inst{Op: BLX_EQ | cond, Args: Args{R12}})
+			}
+			return nil, fmt.Errorf("cannot relocate %v: target out of range", inst)
+
+		case Mem:
+			if arg.Base != PC {
+				continue
+			}
+			if arg.Mode != AddrOffset || arg.Sign != 0 {
+				return nil, fmt.Errorf("cannot relocate %v: reads PC", inst)
+			}
+			addr := uint32(oldBase + uint64(int64(arg.Offset)))
+			if d := int64(addr) - int64(uint32(newBase)); -1<<15 <= d && d < 1<<15 {
+				m := arg
+				m.Offset = int16(d)
+				if out, err := Patch(inst, i, m); err == nil {
+					return []Inst{out}, nil
+				}
+			}
+			switch inst.Op &^ 15 {
+			case LDR_EQ, LDRB_EQ, LDRH_EQ, LDRSB_EQ, LDRSH_EQ, LDRD_EQ:
+				r, ok := inst.Args[0].(Reg)
+				if !ok || r == PC {
+					r = R12
+				}
+				load := inst
+				load.Args[i] = Mem{Base: r, Mode: AddrOffset}
+				return relocSeq(r, addr, cond, load)
+			case VLDR_EQ:
+				load := inst
+				load.Args[i] = Mem{Base: R12, Mode: AddrOffset}
+				return relocSeq(R12, addr, cond, load)
+			}
+			return nil, fmt.Errorf("cannot relocate %v: literal out of range", inst)
+		}
+	}
+
+	// ADR is ADD or SUB Rd, PC, #const.
+	switch inst.Op &^ 15 {
+	case ADD_EQ, SUB_EQ:
+		rd, _ := inst.Args[0].(Reg)
+		if inst.Args[1] == PC && rd != PC {
+			var imm Imm
+			switch a := inst.Args[2].(type) {
+			case Imm:
+				imm = a
+			case ImmAlt:
+				imm = a.Imm()
+			default:
+				return nil, fmt.Errorf("cannot relocate %v: reads PC", inst)
+			}
+			addr := uint32(oldBase) + uint32(imm)
+			if inst.Op&^15 == SUB_EQ {
+				addr = uint32(oldBase) - uint32(imm)
+			}
+			return relocSeq(rd, addr, cond)
+		}
+	}
+
+	if readsPC(inst) {
+		return nil, fmt.Errorf("cannot relocate %v: reads PC", inst)
+	}
+	return []Inst{inst}, nil
+}
+
+// relocSeq returns the encoded sequence that loads the constant v into r
+// using MOVW and, if the high half is nonzero, MOVT, with condition cond,
+// followed by the instructions in rest.
+func relocSeq(r Reg, v uint32, cond Op, rest ...Inst) ([]Inst, error) {
+	seq := []Inst{{Op: MOVW_EQ | cond, Args: Args{r, Imm(v & 0xffff)}}}
+	if v>>16 != 0 {
+		seq = append(seq, Inst{Op: MOVT_EQ | cond, Args: Args{r, Imm(v >> 16)}})
+	}
+	seq = append(seq, rest...)
+	for i := range seq {
+		x, err := encode(seq[i])
+		if err != nil {
+			return nil, err
+		}
+		seq[i].Enc, seq[i].Len = x, 4
+	}
+	return seq, nil
+}
+
+// readsPC reports whether inst reads PC as an operand,
+// other than through a PCRel or PC-based Mem argument.
+// A plain PC first argument is taken to be a destination,
+// except in comparisons, stores, and register branches
+// (BX, BXJ, and BLX), whose first argument is a source.
+func readsPC(inst Inst) bool {
+	name := inst.Op.String()
+	for i, arg := range inst.Args {
+		switch arg := arg.(type) {
+		case Reg:
+			if arg != PC {
+				continue
+			}
+			if i > 0 {
+				return true
+			}
+			for _, prefix := range []string{"CMP", "CMN", "TST", "TEQ", "ST", "BX", "BLX"} {
+				if strings.HasPrefix(name, prefix) {
+					return true
+				}
+			}
+		case RegShift:
+			if arg.Reg == PC {
+				return true
+			}
+		case RegShiftReg:
+			if arg.Reg == PC || arg.RegCount == PC {
+				return true
+			}
+		case Mem:
+			if arg.Base == PC || arg.Sign != 0 && arg.Index == PC {
+				return true
+			}
+		case RegList:
+			if arg&(1<<15) != 0 && (inst.Op&^15 == PUSH_EQ || strings.HasPrefix(name, "STM")) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -0,0 +1,87 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"encoding/binary"
+	"strings"
+	"testing"
+)
+
+var relocateTests = []struct {
+	enc   uint32
+	old   uint64
+	new   uint64
+	out   string // instructions separated by "; ", or "error"
+	first uint32 // encoding of first instruction, if nonzero
+}{
+	{0xe0810002, 0x1000, 0x8000, "ADD R0, R1, R2", 0xe0810002},
+	{0xe12fff1e, 0x1000, 0x8000, "BX LR", 0},
+	{0xe8bd8010, 0x1000, 0x8000, "POP {R4,PC}", 0},
+
+	// Branches.
+	{0xea0003fe, 0x1000, 0x3000, "B PC-0x1008", 0xeafffbfe},
+	{0x1a00019c, 0x12345000, 0x100, "MOVW.NE R12, #0x5678; MOVT.NE R12, #0x1234; BX.NE R12", 0x1305c678},
+	{0xeb0003fe, 0x1000, 0x10000000, "MOVW R12, #0x2000; BLX R12", 0xe302c000},
+	{0xfb0003fe, 0x1000, 0x10000000, "MOVW R12, #0x2003; BLX R12", 0},
+
+	// Literal loads.
+	{0xe59f0008, 0x1000, 0x1100, "LDR R0, [PC, #-248]", 0xe51f00f8},
+	{0xe59f0008, 0x1000, 0x20000000, "MOVW R0, #0x1010; LDR R0, [R0]", 0},
+	{0x059ff008, 0x10001000, 0x100, "MOVW.EQ R12, #0x1010; MOVT.EQ R12, #0x1000; LDR.EQ PC, [R12]", 0},
+	{0xe1cf20d8, 0x1000, 0x20000000, "MOVW R2, #0x1010; LDRD R2, R3, [R2]", 0},
+	{0xed9f0b02, 0x1000, 0x20000000, "MOVW R12, #0x1010; VLDR D0, [R12]", 0},
+
+	// ADR.
+	{0xe28f0004, 0x1000, 0x8000, "MOVW R0, #0x100c", 0},
+	{0xe24f0004, 0x1000, 0x8000, "MOVW R0, #0x1004", 0},
+
+	// Other reads of PC.
+	{0xe1a0000f, 0x1000, 0x8000, "error", 0}, // MOV R0, PC
+	{0xe08f0001, 0x1000, 0x8000, "error", 0}, // ADD R0, PC, R1
+	{0xe58df000, 0x1000, 0x8000, "error", 0}, // STR PC, [SP]
+	{0xe79f0001, 0x1000, 0x8000, "error", 0}, // LDR R0, [PC, R1]
+	{0xe92d8000, 0x1000, 0x8000, "error", 0}, // STMDB SP!, {PC}
+	{0xe12fff1f, 0x1000, 0x8000, "error", 0}, // BX PC
+	{0xe12fff3f, 0x1000, 0x8000, "error", 0}, // BLX PC
+	{0xe12fff2f, 0x1000, 0x8000, "error", 0}, // BXJ PC
+}
+
+func TestRelocate(t *testing.T) {
+	for _, tt := range relocateTests {
+		var buf [4]byte
+		binary.LittleEndian.PutUint32(buf[:], tt.enc)
+		inst, err := Decode(buf[:], ModeARM)
+		if err != nil {
+			t.Errorf("Decode(%#08x): %v", tt.enc, err)
+			continue
+		}
+		seq, err := Relocate(inst, tt.old, tt.new)
+		if tt.out == "error" {
+			if err == nil {
+				t.Errorf("Relocate(%v, %#x, %#x) = %v, want error", inst, tt.old, tt.new, seq)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Relocate(%v, %#x, %#x): %v", inst, tt.old, tt.new, err)
+			continue
+		}
+		var list []string
+		for _, s := range seq {
+			list = append(list, s.String())
+			binary.LittleEndian.PutUint32(buf[:], s.Enc)
+			if dec, err := Decode(buf[:], ModeARM); err != nil || !dec.Equal(s) || s.Len != 4 {
+				t.Errorf("Relocate(%v, %#x, %#x): %v has encoding %#08x, which decodes to %v, %v", inst, tt.old, tt.new, s, s.Enc, dec, err)
+			}
+		}
+		if out := strings.Join(list, "; "); out != tt.out {
+			t.Errorf("Relocate(%v, %#x, %#x) = %s, want %s", inst, tt.old, tt.new, out, tt.out)
+		}
+		if tt.first != 0 && seq[0].Enc != tt.first {
+			t.Errorf("Relocate(%v, %#x, %#x)[0].Enc = %#08x, want %#08x", inst, tt.old, tt.new, seq[0].Enc, tt.first)
+		}
+	}
+}
@@ -433,6 +433,22 @@ const (
 	EOR_S_LE
 	EOR_S
 	EOR_S_ZZ
+	ERET_EQ
+	ERET_NE
+	ERET_CS
+	ERET_CC
+	ERET_MI
+	ERET_PL
+	ERET_VS
+	ERET_VC
+	ERET_HI
+	ERET_LS
+	ERET_GE
+	ERET_LT
+	ERET_GT
+	ERET_LE
+	ERET
+	ERET_ZZ
 	ISB
 	_
 	_
@@ -4129,6 +4145,38 @@ const (
 	VDIV_LE_F64
 	VDIV_F64
 	VDIV_ZZ_F64
+	VLDMDB_EQ
+	VLDMDB_NE
+	VLDMDB_CS
+	VLDMDB_CC
+	VLDMDB_MI
+	VLDMDB_PL
+	VLDMDB_VS
+	VLDMDB_VC
+	VLDMDB_HI
+	VLDMDB_LS
+	VLDMDB_GE
+	VLDMDB_LT
+	VLDMDB_GT
+	VLDMDB_LE
+	VLDMDB
+	VLDMDB_ZZ
+	VLDMIA_EQ
+	VLDMIA_NE
+	VLDMIA_CS
+	VLDMIA_CC
+	VLDMIA_MI
+	VLDMIA_PL
+	VLDMIA_VS
+	VLDMIA_VC
+	VLDMIA_HI
+	VLDMIA_LS
+	VLDMIA_GE
+	VLDMIA_LT
+	VLDMIA_GT
+	VLDMIA_LE
+	VLDMIA
+	VLDMIA_ZZ
 	VLDR_EQ
 	VLDR_NE
 	VLDR_CS
@@ -4465,6 +4513,38 @@ const (
 	VNMUL_LE_F64
 	VNMUL_F64
 	VNMUL_ZZ_F64
+	VPOP_EQ
+	VPOP_NE
+	VPOP_CS
+	VPOP_CC
+	VPOP_MI
+	VPOP_PL
+	VPOP_VS
+	VPOP_VC
+	VPOP_HI
+	VPOP_LS
+	VPOP_GE
+	VPOP_LT
+	VPOP_GT
+	VPOP_LE
+	VPOP
+	VPOP_ZZ
+	VPUSH_EQ
+	VPUSH_NE
+	VPUSH_CS
+	VPUSH_CC
+	VPUSH_MI
+	VPUSH_PL
+	VPUSH_VS
+	VPUSH_VC
+	VPUSH_HI
+	VPUSH_LS
+	VPUSH_GE
+	VPUSH_LT
+	VPUSH_GT
+	VPUSH_LE
+	VPUSH
+	VPUSH_ZZ
 	VSQRT_EQ_F32
 	VSQRT_NE_F32
 	VSQRT_CS_F32
@@ -4497,6 +4577,38 @@ const (
 	VSQRT_LE_F64
 	VSQRT_F64
 	VSQRT_ZZ_F64
+	VSTMDB_EQ
+	VSTMDB_NE
+	VSTMDB_CS
+	VSTMDB_CC
+	VSTMDB_MI
+	VSTMDB_PL
+	VSTMDB_VS
+	VSTMDB_VC
+	VSTMDB_HI
+	VSTMDB_LS
+	VSTMDB_GE
+	VSTMDB_LT
+	VSTMDB_GT
+	VSTMDB_LE
+	VSTMDB
+	VSTMDB_ZZ
+	VSTMIA_EQ
+	VSTMIA_NE
+	VSTMIA_CS
+	VSTMIA_CC
+	VSTMIA_MI
+	VSTMIA_PL
+	VSTMIA_VS
+	VSTMIA_VC
+	VSTMIA_HI
+	VSTMIA_LS
+	VSTMIA_GE
+	VSTMIA_LT
+	VSTMIA_GT
+	VSTMIA_LE
+	VSTMIA
+	VSTMIA_ZZ
 	VSTR_EQ
 	VSTR_NE
 	VSTR_CS
@@ -4595,4854 +4707,2628 @@ const (
 	YIELD_ZZ
 )
 
-var opstr = [...]string{
-	ADC_EQ:            "ADC.EQ",
-	ADC_NE:            "ADC.NE",
-	ADC_CS:            "ADC.CS",
-	ADC_CC:            "ADC.CC",
-	ADC_MI:            "ADC.MI",
-	ADC_PL:            "ADC.PL",
-	ADC_VS:            "ADC.VS",
-	ADC_VC:            "ADC.VC",
-	ADC_HI:            "ADC.HI",
-	ADC_LS:            "ADC.LS",
-	ADC_GE:            "ADC.GE",
-	ADC_LT:            "ADC.LT",
-	ADC_GT:            "ADC.GT",
-	ADC_LE:            "ADC.LE",
-	ADC:               "ADC",
-	ADC_ZZ:            "ADC.ZZ",
-	ADC_S_EQ:          "ADC.S.EQ",
-	ADC_S_NE:          "ADC.S.NE",
-	ADC_S_CS:          "ADC.S.CS",
-	ADC_S_CC:          "ADC.S.CC",
-	ADC_S_MI:          "ADC.S.MI",
-	ADC_S_PL:          "ADC.S.PL",
-	ADC_S_VS:          "ADC.S.VS",
-	ADC_S_VC:          "ADC.S.VC",
-	ADC_S_HI:          "ADC.S.HI",
-	ADC_S_LS:          "ADC.S.LS",
-	ADC_S_GE:          "ADC.S.GE",
-	ADC_S_LT:          "ADC.S.LT",
-	ADC_S_GT:          "ADC.S.GT",
-	ADC_S_LE:          "ADC.S.LE",
-	ADC_S:             "ADC.S",
-	ADC_S_ZZ:          "ADC.S.ZZ",
-	ADD_EQ:            "ADD.EQ",
-	ADD_NE:            "ADD.NE",
-	ADD_CS:            "ADD.CS",
-	ADD_CC:            "ADD.CC",
-	ADD_MI:            "ADD.MI",
-	ADD_PL:            "ADD.PL",
-	ADD_VS:            "ADD.VS",
-	ADD_VC:            "ADD.VC",
-	ADD_HI:            "ADD.HI",
-	ADD_LS:            "ADD.LS",
-	ADD_GE:            "ADD.GE",
-	ADD_LT:            "ADD.LT",
-	ADD_GT:            "ADD.GT",
-	ADD_LE:            "ADD.LE",
-	ADD:               "ADD",
-	ADD_ZZ:            "ADD.ZZ",
-	ADD_S_EQ:          "ADD.S.EQ",
-	ADD_S_NE:          "ADD.S.NE",
-	ADD_S_CS:          "ADD.S.CS",
-	ADD_S_CC:          "ADD.S.CC",
-	ADD_S_MI:          "ADD.S.MI",
-	ADD_S_PL:          "ADD.S.PL",
-	ADD_S_VS:          "ADD.S.VS",
-	ADD_S_VC:          "ADD.S.VC",
-	ADD_S_HI:          "ADD.S.HI",
-	ADD_S_LS:          "ADD.S.LS",
-	ADD_S_GE:          "ADD.S.GE",
-	ADD_S_LT:          "ADD.S.LT",
-	ADD_S_GT:          "ADD.S.GT",
-	ADD_S_LE:          "ADD.S.LE",
-	ADD_S:             "ADD.S",
-	ADD_S_ZZ:          "ADD.S.ZZ",
-	AND_EQ:            "AND.EQ",
-	AND_NE:            "AND.NE",
-	AND_CS:            "AND.CS",
-	AND_CC:            "AND.CC",
-	AND_MI:            "AND.MI",
-	AND_PL:            "AND.PL",
-	AND_VS:            "AND.VS",
-	AND_VC:            "AND.VC",
-	AND_HI:            "AND.HI",
-	AND_LS:            "AND.LS",
-	AND_GE:            "AND.GE",
-	AND_LT:            "AND.LT",
-	AND_GT:            "AND.GT",
-	AND_LE:            "AND.LE",
-	AND:               "AND",
-	AND_ZZ:            "AND.ZZ",
-	AND_S_EQ:          "AND.S.EQ",
-	AND_S_NE:          "AND.S.NE",
-	AND_S_CS:          "AND.S.CS",
-	AND_S_CC:          "AND.S.CC",
-	AND_S_MI:          "AND.S.MI",
-	AND_S_PL:          "AND.S.PL",
-	AND_S_VS:          "AND.S.VS",
-	AND_S_VC:          "AND.S.VC",
-	AND_S_HI:          "AND.S.HI",
-	AND_S_LS:          "AND.S.LS",
-	AND_S_GE:          "AND.S.GE",
-	AND_S_LT:          "AND.S.LT",
-	AND_S_GT:          "AND.S.GT",
-	AND_S_LE:          "AND.S.LE",
-	AND_S:             "AND.S",
-	AND_S_ZZ:          "AND.S.ZZ",
-	ASR_EQ:            "ASR.EQ",
-	ASR_NE:            "ASR.NE",
-	ASR_CS:            "ASR.CS",
-	ASR_CC:            "ASR.CC",
-	ASR_MI:            "ASR.MI",
-	ASR_PL:            "ASR.PL",
-	ASR_VS:            "ASR.VS",
-	ASR_VC:            "ASR.VC",
-	ASR_HI:            "ASR.HI",
-	ASR_LS:            "ASR.LS",
-	ASR_GE:            "ASR.GE",
-	ASR_LT:            "ASR.LT",
-	ASR_GT:            "ASR.GT",
-	ASR_LE:            "ASR.LE",
-	ASR:               "ASR",
-	ASR_ZZ:            "ASR.ZZ",
-	ASR_S_EQ:          "ASR.S.EQ",
-	ASR_S_NE:          "ASR.S.NE",
-	ASR_S_CS:          "ASR.S.CS",
-	ASR_S_CC:          "ASR.S.CC",
-	ASR_S_MI:          "ASR.S.MI",
-	ASR_S_PL:          "ASR.S.PL",
-	ASR_S_VS:          "ASR.S.VS",
-	ASR_S_VC:          "ASR.S.VC",
-	ASR_S_HI:          "ASR.S.HI",
-	ASR_S_LS:          "ASR.S.LS",
-	ASR_S_GE:          "ASR.S.GE",
-	ASR_S_LT:          "ASR.S.LT",
-	ASR_S_GT:          "ASR.S.GT",
-	ASR_S_LE:          "ASR.S.LE",
-	ASR_S:             "ASR.S",
-	ASR_S_ZZ:          "ASR.S.ZZ",
-	B_EQ:              "B.EQ",
-	B_NE:              "B.NE",
-	B_CS:              "B.CS",
-	B_CC:              "B.CC",
-	B_MI:              "B.MI",
-	B_PL:              "B.PL",
-	B_VS:              "B.VS",
-	B_VC:              "B.VC",
-	B_HI:              "B.HI",
-	B_LS:              "B.LS",
-	B_GE:              "B.GE",
-	B_LT:              "B.LT",
-	B_GT:              "B.GT",
-	B_LE:              "B.LE",
-	B:                 "B",
-	B_ZZ:              "B.ZZ",
-	BFC_EQ:            "BFC.EQ",
-	BFC_NE:            "BFC.NE",
-	BFC_CS:            "BFC.CS",
-	BFC_CC:            "BFC.CC",
-	BFC_MI:            "BFC.MI",
-	BFC_PL:            "BFC.PL",
-	BFC_VS:            "BFC.VS",
-	BFC_VC:            "BFC.VC",
-	BFC_HI:            "BFC.HI",
-	BFC_LS:            "BFC.LS",
-	BFC_GE:            "BFC.GE",
-	BFC_LT:            "BFC.LT",
-	BFC_GT:            "BFC.GT",
-	BFC_LE:            "BFC.LE",
-	BFC:               "BFC",
-	BFC_ZZ:            "BFC.ZZ",
-	BFI_EQ:            "BFI.EQ",
-	BFI_NE:            "BFI.NE",
-	BFI_CS:            "BFI.CS",
-	BFI_CC:            "BFI.CC",
-	BFI_MI:            "BFI.MI",
-	BFI_PL:            "BFI.PL",
-	BFI_VS:            "BFI.VS",
-	BFI_VC:            "BFI.VC",
-	BFI_HI:            "BFI.HI",
-	BFI_LS:            "BFI.LS",
-	BFI_GE:            "BFI.GE",
-	BFI_LT:            "BFI.LT",
-	BFI_GT:            "BFI.GT",
-	BFI_LE:            "BFI.LE",
-	BFI:               "BFI",
-	BFI_ZZ:            "BFI.ZZ",
-	BIC_EQ:            "BIC.EQ",
-	BIC_NE:            "BIC.NE",
-	BIC_CS:            "BIC.CS",
-	BIC_CC:            "BIC.CC",
-	BIC_MI:            "BIC.MI",
-	BIC_PL:            "BIC.PL",
-	BIC_VS:            "BIC.VS",
-	BIC_VC:            "BIC.VC",
-	BIC_HI:            "BIC.HI",
-	BIC_LS:            "BIC.LS",
-	BIC_GE:            "BIC.GE",
-	BIC_LT:            "BIC.LT",
-	BIC_GT:            "BIC.GT",
-	BIC_LE:            "BIC.LE",
-	BIC:               "BIC",
-	BIC_ZZ:            "BIC.ZZ",
-	BIC_S_EQ:          "BIC.S.EQ",
-	BIC_S_NE:          "BIC.S.NE",
-	BIC_S_CS:          "BIC.S.CS",
-	BIC_S_CC:          "BIC.S.CC",
-	BIC_S_MI:          "BIC.S.MI",
-	BIC_S_PL:          "BIC.S.PL",
-	BIC_S_VS:          "BIC.S.VS",
-	BIC_S_VC:          "BIC.S.VC",
-	BIC_S_HI:          "BIC.S.HI",
-	BIC_S_LS:          "BIC.S.LS",
-	BIC_S_GE:          "BIC.S.GE",
-	BIC_S_LT:          "BIC.S.LT",
-	BIC_S_GT:          "BIC.S.GT",
-	BIC_S_LE:          "BIC.S.LE",
-	BIC_S:             "BIC.S",
-	BIC_S_ZZ:          "BIC.S.ZZ",
-	BKPT_EQ:           "BKPT.EQ",
-	BKPT_NE:           "BKPT.NE",
-	BKPT_CS:           "BKPT.CS",
-	BKPT_CC:           "BKPT.CC",
-	BKPT_MI:           "BKPT.MI",
-	BKPT_PL:           "BKPT.PL",
-	BKPT_VS:           "BKPT.VS",
-	BKPT_VC:           "BKPT.VC",
-	BKPT_HI:           "BKPT.HI",
-	BKPT_LS:           "BKPT.LS",
-	BKPT_GE:           "BKPT.GE",
-	BKPT_LT:           "BKPT.LT",
-	BKPT_GT:           "BKPT.GT",
-	BKPT_LE:           "BKPT.LE",
-	BKPT:              "BKPT",
-	BKPT_ZZ:           "BKPT.ZZ",
-	BL_EQ:             "BL.EQ",
-	BL_NE:             "BL.NE",
-	BL_CS:             "BL.CS",
-	BL_CC:             "BL.CC",
-	BL_MI:             "BL.MI",
-	BL_PL:             "BL.PL",
-	BL_VS:             "BL.VS",
-	BL_VC:             "BL.VC",
-	BL_HI:             "BL.HI",
-	BL_LS:             "BL.LS",
-	BL_GE:             "BL.GE",
-	BL_LT:             "BL.LT",
-	BL_GT:             "BL.GT",
-	BL_LE:             "BL.LE",
-	BL:                "BL",
-	BL_ZZ:             "BL.ZZ",
-	BLX_EQ:            "BLX.EQ",
-	BLX_NE:            "BLX.NE",
-	BLX_CS:            "BLX.CS",
-	BLX_CC:            "BLX.CC",
-	BLX_MI:            "BLX.MI",
-	BLX_PL:            "BLX.PL",
-	BLX_VS:            "BLX.VS",
-	BLX_VC:            "BLX.VC",
-	BLX_HI:            "BLX.HI",
-	BLX_LS:            "BLX.LS",
-	BLX_GE:            "BLX.GE",
-	BLX_LT:            "BLX.LT",
-	BLX_GT:            "BLX.GT",
-	BLX_LE:            "BLX.LE",
-	BLX:               "BLX",
-	BLX_ZZ:            "BLX.ZZ",
-	BX_EQ:             "BX.EQ",
-	BX_NE:             "BX.NE",
-	BX_CS:             "BX.CS",
-	BX_CC:             "BX.CC",
-	BX_MI:             "BX.MI",
-	BX_PL:             "BX.PL",
-	BX_VS:             "BX.VS",
-	BX_VC:             "BX.VC",
-	BX_HI:             "BX.HI",
-	BX_LS:             "BX.LS",
-	BX_GE:             "BX.GE",
-	BX_LT:             "BX.LT",
-	BX_GT:             "BX.GT",
-	BX_LE:             "BX.LE",
-	BX:                "BX",
-	BX_ZZ:             "BX.ZZ",
-	BXJ_EQ:            "BXJ.EQ",
-	BXJ_NE:            "BXJ.NE",
-	BXJ_CS:            "BXJ.CS",
-	BXJ_CC:            "BXJ.CC",
-	BXJ_MI:            "BXJ.MI",
-	BXJ_PL:            "BXJ.PL",
-	BXJ_VS:            "BXJ.VS",
-	BXJ_VC:            "BXJ.VC",
-	BXJ_HI:            "BXJ.HI",
-	BXJ_LS:            "BXJ.LS",
-	BXJ_GE:            "BXJ.GE",
-	BXJ_LT:            "BXJ.LT",
-	BXJ_GT:            "BXJ.GT",
-	BXJ_LE:            "BXJ.LE",
-	BXJ:               "BXJ",
-	BXJ_ZZ:            "BXJ.ZZ",
-	CLREX:             "CLREX",
-	CLZ_EQ:            "CLZ.EQ",
-	CLZ_NE:            "CLZ.NE",
-	CLZ_CS:            "CLZ.CS",
-	CLZ_CC:            "CLZ.CC",
-	CLZ_MI:            "CLZ.MI",
-	CLZ_PL:            "CLZ.PL",
-	CLZ_VS:            "CLZ.VS",
-	CLZ_VC:            "CLZ.VC",
-	CLZ_HI:            "CLZ.HI",
-	CLZ_LS:            "CLZ.LS",
-	CLZ_GE:            "CLZ.GE",
-	CLZ_LT:            "CLZ.LT",
-	CLZ_GT:            "CLZ.GT",
-	CLZ_LE:            "CLZ.LE",
-	CLZ:               "CLZ",
-	CLZ_ZZ:            "CLZ.ZZ",
-	CMN_EQ:            "CMN.EQ",
-	CMN_NE:            "CMN.NE",
-	CMN_CS:            "CMN.CS",
-	CMN_CC:            "CMN.CC",
-	CMN_MI:            "CMN.MI",
-	CMN_PL:            "CMN.PL",
-	CMN_VS:            "CMN.VS",
-	CMN_VC:            "CMN.VC",
-	CMN_HI:            "CMN.HI",
-	CMN_LS:            "CMN.LS",
-	CMN_GE:            "CMN.GE",
-	CMN_LT:            "CMN.LT",
-	CMN_GT:            "CMN.GT",
-	CMN_LE:            "CMN.LE",
-	CMN:               "CMN",
-	CMN_ZZ:            "CMN.ZZ",
-	CMP_EQ:            "CMP.EQ",
-	CMP_NE:            "CMP.NE",
-	CMP_CS:            "CMP.CS",
-	CMP_CC:            "CMP.CC",
-	CMP_MI:            "CMP.MI",
-	CMP_PL:            "CMP.PL",
-	CMP_VS:            "CMP.VS",
-	CMP_VC:            "CMP.VC",
-	CMP_HI:            "CMP.HI",
-	CMP_LS:            "CMP.LS",
-	CMP_GE:            "CMP.GE",
-	CMP_LT:            "CMP.LT",
-	CMP_GT:            "CMP.GT",
-	CMP_LE:            "CMP.LE",
-	CMP:               "CMP",
-	CMP_ZZ:            "CMP.ZZ",
-	DBG_EQ:            "DBG.EQ",
-	DBG_NE:            "DBG.NE",
-	DBG_CS:            "DBG.CS",
-	DBG_CC:            "DBG.CC",
-	DBG_MI:            "DBG.MI",
-	DBG_PL:            "DBG.PL",
-	DBG_VS:            "DBG.VS",
-	DBG_VC:            "DBG.VC",
-	DBG_HI:            "DBG.HI",
-	DBG_LS:            "DBG.LS",
-	DBG_GE:            "DBG.GE",
-	DBG_LT:            "DBG.LT",
-	DBG_GT:            "DBG.GT",
-	DBG_LE:            "DBG.LE",
-	DBG:               "DBG",
-	DBG_ZZ:            "DBG.ZZ",
-	DMB:               "DMB",
-	DSB:               "DSB",
-	EOR_EQ:            "EOR.EQ",
-	EOR_NE:            "EOR.NE",
-	EOR_CS:            "EOR.CS",
-	EOR_CC:            "EOR.CC",
-	EOR_MI:            "EOR.MI",
-	EOR_PL:            "EOR.PL",
-	EOR_VS:            "EOR.VS",
-	EOR_VC:            "EOR.VC",
-	EOR_HI:            "EOR.HI",
-	EOR_LS:            "EOR.LS",
-	EOR_GE:            "EOR.GE",
-	EOR_LT:            "EOR.LT",
-	EOR_GT:            "EOR.GT",
-	EOR_LE:            "EOR.LE",
-	EOR:               "EOR",
-	EOR_ZZ:            "EOR.ZZ",
-	EOR_S_EQ:          "EOR.S.EQ",
-	EOR_S_NE:          "EOR.S.NE",
-	EOR_S_CS:          "EOR.S.CS",
-	EOR_S_CC:          "EOR.S.CC",
-	EOR_S_MI:          "EOR.S.MI",
-	EOR_S_PL:          "EOR.S.PL",
-	EOR_S_VS:          "EOR.S.VS",
-	EOR_S_VC:          "EOR.S.VC",
-	EOR_S_HI:          "EOR.S.HI",
-	EOR_S_LS:          "EOR.S.LS",
-	EOR_S_GE:          "EOR.S.GE",
-	EOR_S_LT:          "EOR.S.LT",
-	EOR_S_GT:          "EOR.S.GT",
-	EOR_S_LE:          "EOR.S.LE",
-	EOR_S:             "EOR.S",
-	EOR_S_ZZ:          "EOR.S.ZZ",
-	ISB:               "ISB",
-	LDM_EQ:            "LDM.EQ",
-	LDM_NE:            "LDM.NE",
-	LDM_CS:            "LDM.CS",
-	LDM_CC:            "LDM.CC",
-	LDM_MI:            "LDM.MI",
-	LDM_PL:            "LDM.PL",
-	LDM_VS:            "LDM.VS",
-	LDM_VC:            "LDM.VC",
-	LDM_HI:            "LDM.HI",
-	LDM_LS:            "LDM.LS",
-	LDM_GE:            "LDM.GE",
-	LDM_LT:            "LDM.LT",
-	LDM_GT:            "LDM.GT",
-	LDM_LE:            "LDM.LE",
-	LDM:               "LDM",
-	LDM_ZZ:            "LDM.ZZ",
-	LDMDA_EQ:          "LDMDA.EQ",
-	LDMDA_NE:          "LDMDA.NE",
-	LDMDA_CS:          "LDMDA.CS",
-	LDMDA_CC:          "LDMDA.CC",
-	LDMDA_MI:          "LDMDA.MI",
-	LDMDA_PL:          "LDMDA.PL",
-	LDMDA_VS:          "LDMDA.VS",
-	LDMDA_VC:          "LDMDA.VC",
-	LDMDA_HI:          "LDMDA.HI",
-	LDMDA_LS:          "LDMDA.LS",
-	LDMDA_GE:          "LDMDA.GE",
-	LDMDA_LT:          "LDMDA.LT",
-	LDMDA_GT:          "LDMDA.GT",
-	LDMDA_LE:          "LDMDA.LE",
-	LDMDA:             "LDMDA",
-	LDMDA_ZZ:          "LDMDA.ZZ",
-	LDMDB_EQ:          "LDMDB.EQ",
-	LDMDB_NE:          "LDMDB.NE",
-	LDMDB_CS:          "LDMDB.CS",
-	LDMDB_CC:          "LDMDB.CC",
-	LDMDB_MI:          "LDMDB.MI",
-	LDMDB_PL:          "LDMDB.PL",
-	LDMDB_VS:          "LDMDB.VS",
-	LDMDB_VC:          "LDMDB.VC",
-	LDMDB_HI:          "LDMDB.HI",
-	LDMDB_LS:          "LDMDB.LS",
-	LDMDB_GE:          "LDMDB.GE",
-	LDMDB_LT:          "LDMDB.LT",
-	LDMDB_GT:          "LDMDB.GT",
-	LDMDB_LE:          "LDMDB.LE",
-	LDMDB:             "LDMDB",
-	LDMDB_ZZ:          "LDMDB.ZZ",
-	LDMIB_EQ:          "LDMIB.EQ",
-	LDMIB_NE:          "LDMIB.NE",
-	LDMIB_CS:          "LDMIB.CS",
-	LDMIB_CC:          "LDMIB.CC",
-	LDMIB_MI:          "LDMIB.MI",
-	LDMIB_PL:          "LDMIB.PL",
-	LDMIB_VS:          "LDMIB.VS",
-	LDMIB_VC:          "LDMIB.VC",
-	LDMIB_HI:          "LDMIB.HI",
-	LDMIB_LS:          "LDMIB.LS",
-	LDMIB_GE:          "LDMIB.GE",
-	LDMIB_LT:          "LDMIB.LT",
-	LDMIB_GT:          "LDMIB.GT",
-	LDMIB_LE:          "LDMIB.LE",
-	LDMIB:             "LDMIB",
-	LDMIB_ZZ:          "LDMIB.ZZ",
-	LDR_EQ:            "LDR.EQ",
-	LDR_NE:            "LDR.NE",
-	LDR_CS:            "LDR.CS",
-	LDR_CC:            "LDR.CC",
-	LDR_MI:            "LDR.MI",
-	LDR_PL:            "LDR.PL",
-	LDR_VS:            "LDR.VS",
-	LDR_VC:            "LDR.VC",
-	LDR_HI:            "LDR.HI",
-	LDR_LS:            "LDR.LS",
-	LDR_GE:            "LDR.GE",
-	LDR_LT:            "LDR.LT",
-	LDR_GT:            "LDR.GT",
-	LDR_LE:            "LDR.LE",
-	LDR:               "LDR",
-	LDR_ZZ:            "LDR.ZZ",
-	LDRB_EQ:           "LDRB.EQ",
-	LDRB_NE:           "LDRB.NE",
-	LDRB_CS:           "LDRB.CS",
-	LDRB_CC:           "LDRB.CC",
-	LDRB_MI:           "LDRB.MI",
-	LDRB_PL:           "LDRB.PL",
-	LDRB_VS:           "LDRB.VS",
-	LDRB_VC:           "LDRB.VC",
-	LDRB_HI:           "LDRB.HI",
-	LDRB_LS:           "LDRB.LS",
-	LDRB_GE:           "LDRB.GE",
-	LDRB_LT:           "LDRB.LT",
-	LDRB_GT:           "LDRB.GT",
-	LDRB_LE:           "LDRB.LE",
-	LDRB:              "LDRB",
-	LDRB_ZZ:           "LDRB.ZZ",
-	LDRBT_EQ:          "LDRBT.EQ",
-	LDRBT_NE:          "LDRBT.NE",
-	LDRBT_CS:          "LDRBT.CS",
-	LDRBT_CC:          "LDRBT.CC",
-	LDRBT_MI:          "LDRBT.MI",
-	LDRBT_PL:          "LDRBT.PL",
-	LDRBT_VS:          "LDRBT.VS",
-	LDRBT_VC:          "LDRBT.VC",
-	LDRBT_HI:          "LDRBT.HI",
-	LDRBT_LS:          "LDRBT.LS",
-	LDRBT_GE:          "LDRBT.GE",
-	LDRBT_LT:          "LDRBT.LT",
-	LDRBT_GT:          "LDRBT.GT",
-	LDRBT_LE:          "LDRBT.LE",
-	LDRBT:             "LDRBT",
-	LDRBT_ZZ:          "LDRBT.ZZ",
-	LDRD_EQ:           "LDRD.EQ",
-	LDRD_NE:           "LDRD.NE",
-	LDRD_CS:           "LDRD.CS",
-	LDRD_CC:           "LDRD.CC",
-	LDRD_MI:           "LDRD.MI",
-	LDRD_PL:           "LDRD.PL",
-	LDRD_VS:           "LDRD.VS",
-	LDRD_VC:           "LDRD.VC",
-	LDRD_HI:           "LDRD.HI",
-	LDRD_LS:           "LDRD.LS",
-	LDRD_GE:           "LDRD.GE",
-	LDRD_LT:           "LDRD.LT",
-	LDRD_GT:           "LDRD.GT",
-	LDRD_LE:           "LDRD.LE",
-	LDRD:              "LDRD",
-	LDRD_ZZ:           "LDRD.ZZ",
-	LDREX_EQ:          "LDREX.EQ",
-	LDREX_NE:          "LDREX.NE",
-	LDREX_CS:          "LDREX.CS",
-	LDREX_CC:          "LDREX.CC",
-	LDREX_MI:          "LDREX.MI",
-	LDREX_PL:          "LDREX.PL",
-	LDREX_VS:          "LDREX.VS",
-	LDREX_VC:          "LDREX.VC",
-	LDREX_HI:          "LDREX.HI",
-	LDREX_LS:          "LDREX.LS",
-	LDREX_GE:          "LDREX.GE",
-	LDREX_LT:          "LDREX.LT",
-	LDREX_GT:          "LDREX.GT",
-	LDREX_LE:          "LDREX.LE",
-	LDREX:             "LDREX",
-	LDREX_ZZ:          "LDREX.ZZ",
-	LDREXB_EQ:         "LDREXB.EQ",
-	LDREXB_NE:         "LDREXB.NE",
-	LDREXB_CS:         "LDREXB.CS",
-	LDREXB_CC:         "LDREXB.CC",
-	LDREXB_MI:         "LDREXB.MI",
-	LDREXB_PL:         "LDREXB.PL",
-	LDREXB_VS:         "LDREXB.VS",
-	LDREXB_VC:         "LDREXB.VC",
-	LDREXB_HI:         "LDREXB.HI",
-	LDREXB_LS:         "LDREXB.LS",
-	LDREXB_GE:         "LDREXB.GE",
-	LDREXB_LT:         "LDREXB.LT",
-	LDREXB_GT:         "LDREXB.GT",
-	LDREXB_LE:         "LDREXB.LE",
-	LDREXB:            "LDREXB",
-	LDREXB_ZZ:         "LDREXB.ZZ",
-	LDREXD_EQ:         "LDREXD.EQ",
-	LDREXD_NE:         "LDREXD.NE",
-	LDREXD_CS:         "LDREXD.CS",
-	LDREXD_CC:         "LDREXD.CC",
-	LDREXD_MI:         "LDREXD.MI",
-	LDREXD_PL:         "LDREXD.PL",
-	LDREXD_VS:         "LDREXD.VS",
-	LDREXD_VC:         "LDREXD.VC",
-	LDREXD_HI:         "LDREXD.HI",
-	LDREXD_LS:         "LDREXD.LS",
-	LDREXD_GE:         "LDREXD.GE",
-	LDREXD_LT:         "LDREXD.LT",
-	LDREXD_GT:         "LDREXD.GT",
-	LDREXD_LE:         "LDREXD.LE",
-	LDREXD:            "LDREXD",
-	LDREXD_ZZ:         "LDREXD.ZZ",
-	LDREXH_EQ:         "LDREXH.EQ",
-	LDREXH_NE:         "LDREXH.NE",
-	LDREXH_CS:         "LDREXH.CS",
-	LDREXH_CC:         "LDREXH.CC",
-	LDREXH_MI:         "LDREXH.MI",
-	LDREXH_PL:         "LDREXH.PL",
-	LDREXH_VS:         "LDREXH.VS",
-	LDREXH_VC:         "LDREXH.VC",
-	LDREXH_HI:         "LDREXH.HI",
-	LDREXH_LS:         "LDREXH.LS",
-	LDREXH_GE:         "LDREXH.GE",
-	LDREXH_LT:         "LDREXH.LT",
-	LDREXH_GT:         "LDREXH.GT",
-	LDREXH_LE:         "LDREXH.LE",
-	LDREXH:            "LDREXH",
-	LDREXH_ZZ:         "LDREXH.ZZ",
-	LDRH_EQ:           "LDRH.EQ",
-	LDRH_NE:           "LDRH.NE",
-	LDRH_CS:           "LDRH.CS",
-	LDRH_CC:           "LDRH.CC",
-	LDRH_MI:           "LDRH.MI",
-	LDRH_PL:           "LDRH.PL",
-	LDRH_VS:           "LDRH.VS",
-	LDRH_VC:           "LDRH.VC",
-	LDRH_HI:           "LDRH.HI",
-	LDRH_LS:           "LDRH.LS",
-	LDRH_GE:           "LDRH.GE",
-	LDRH_LT:           "LDRH.LT",
-	LDRH_GT:           "LDRH.GT",
-	LDRH_LE:           "LDRH.LE",
-	LDRH:              "LDRH",
-	LDRH_ZZ:           "LDRH.ZZ",
-	LDRHT_EQ:          "LDRHT.EQ",
-	LDRHT_NE:          "LDRHT.NE",
-	LDRHT_CS:          "LDRHT.CS",
-	LDRHT_CC:          "LDRHT.CC",
-	LDRHT_MI:          "LDRHT.MI",
-	LDRHT_PL:          "LDRHT.PL",
-	LDRHT_VS:          "LDRHT.VS",
-	LDRHT_VC:          "LDRHT.VC",
-	LDRHT_HI:          "LDRHT.HI",
-	LDRHT_LS:          "LDRHT.LS",
-	LDRHT_GE:          "LDRHT.GE",
-	LDRHT_LT:          "LDRHT.LT",
-	LDRHT_GT:          "LDRHT.GT",
-	LDRHT_LE:          "LDRHT.LE",
-	LDRHT:             "LDRHT",
-	LDRHT_ZZ:          "LDRHT.ZZ",
-	LDRSB_EQ:          "LDRSB.EQ",
-	LDRSB_NE:          "LDRSB.NE",
-	LDRSB_CS:          "LDRSB.CS",
-	LDRSB_CC:          "LDRSB.CC",
-	LDRSB_MI:          "LDRSB.MI",
-	LDRSB_PL:          "LDRSB.PL",
-	LDRSB_VS:          "LDRSB.VS",
-	LDRSB_VC:          "LDRSB.VC",
-	LDRSB_HI:          "LDRSB.HI",
-	LDRSB_LS:          "LDRSB.LS",
-	LDRSB_GE:          "LDRSB.GE",
-	LDRSB_LT:          "LDRSB.LT",
-	LDRSB_GT:          "LDRSB.GT",
-	LDRSB_LE:          "LDRSB.LE",
-	LDRSB:             "LDRSB",
-	LDRSB_ZZ:          "LDRSB.ZZ",
-	LDRSBT_EQ:         "LDRSBT.EQ",
-	LDRSBT_NE:         "LDRSBT.NE",
-	LDRSBT_CS:         "LDRSBT.CS",
-	LDRSBT_CC:         "LDRSBT.CC",
-	LDRSBT_MI:         "LDRSBT.MI",
-	LDRSBT_PL:         "LDRSBT.PL",
-	LDRSBT_VS:         "LDRSBT.VS",
-	LDRSBT_VC:         "LDRSBT.VC",
-	LDRSBT_HI:         "LDRSBT.HI",
-	LDRSBT_LS:         "LDRSBT.LS",
-	LDRSBT_GE:         "LDRSBT.GE",
-	LDRSBT_LT:         "LDRSBT.LT",
-	LDRSBT_GT:         "LDRSBT.GT",
-	LDRSBT_LE:         "LDRSBT.LE",
-	LDRSBT:            "LDRSBT",
-	LDRSBT_ZZ:         "LDRSBT.ZZ",
-	LDRSH_EQ:          "LDRSH.EQ",
-	LDRSH_NE:          "LDRSH.NE",
-	LDRSH_CS:          "LDRSH.CS",
-	LDRSH_CC:          "LDRSH.CC",
-	LDRSH_MI:          "LDRSH.MI",
-	LDRSH_PL:          "LDRSH.PL",
-	LDRSH_VS:          "LDRSH.VS",
-	LDRSH_VC:          "LDRSH.VC",
-	LDRSH_HI:          "LDRSH.HI",
-	LDRSH_LS:          "LDRSH.LS",
-	LDRSH_GE:          "LDRSH.GE",
-	LDRSH_LT:          "LDRSH.LT",
-	LDRSH_GT:          "LDRSH.GT",
-	LDRSH_LE:          "LDRSH.LE",
-	LDRSH:             "LDRSH",
-	LDRSH_ZZ:          "LDRSH.ZZ",
-	LDRSHT_EQ:         "LDRSHT.EQ",
-	LDRSHT_NE:         "LDRSHT.NE",
-	LDRSHT_CS:         "LDRSHT.CS",
-	LDRSHT_CC:         "LDRSHT.CC",
-	LDRSHT_MI:         "LDRSHT.MI",
-	LDRSHT_PL:         "LDRSHT.PL",
-	LDRSHT_VS:         "LDRSHT.VS",
-	LDRSHT_VC:         "LDRSHT.VC",
-	LDRSHT_HI:         "LDRSHT.HI",
-	LDRSHT_LS:         "LDRSHT.LS",
-	LDRSHT_GE:         "LDRSHT.GE",
-	LDRSHT_LT:         "LDRSHT.LT",
-	LDRSHT_GT:         "LDRSHT.GT",
-	LDRSHT_LE:         "LDRSHT.LE",
-	LDRSHT:            "LDRSHT",
-	LDRSHT_ZZ:         "LDRSHT.ZZ",
-	LDRT_EQ:           "LDRT.EQ",
-	LDRT_NE:           "LDRT.NE",
-	LDRT_CS:           "LDRT.CS",
-	LDRT_CC:           "LDRT.CC",
-	LDRT_MI:           "LDRT.MI",
-	LDRT_PL:           "LDRT.PL",
-	LDRT_VS:           "LDRT.VS",
-	LDRT_VC:           "LDRT.VC",
-	LDRT_HI:           "LDRT.HI",
-	LDRT_LS:           "LDRT.LS",
-	LDRT_GE:           "LDRT.GE",
-	LDRT_LT:           "LDRT.LT",
-	LDRT_GT:           "LDRT.GT",
-	LDRT_LE:           "LDRT.LE",
-	LDRT:              "LDRT",
-	LDRT_ZZ:           "LDRT.ZZ",
-	LSL_EQ:            "LSL.EQ",
-	LSL_NE:            "LSL.NE",
-	LSL_CS:            "LSL.CS",
-	LSL_CC:            "LSL.CC",
-	LSL_MI:            "LSL.MI",
-	LSL_PL:            "LSL.PL",
-	LSL_VS:            "LSL.VS",
-	LSL_VC:            "LSL.VC",
-	LSL_HI:            "LSL.HI",
-	LSL_LS:            "LSL.LS",
-	LSL_GE:            "LSL.GE",
-	LSL_LT:            "LSL.LT",
-	LSL_GT:            "LSL.GT",
-	LSL_LE:            "LSL.LE",
-	LSL:               "LSL",
-	LSL_ZZ:            "LSL.ZZ",
-	LSL_S_EQ:          "LSL.S.EQ",
-	LSL_S_NE:          "LSL.S.NE",
-	LSL_S_CS:          "LSL.S.CS",
-	LSL_S_CC:          "LSL.S.CC",
-	LSL_S_MI:          "LSL.S.MI",
-	LSL_S_PL:          "LSL.S.PL",
-	LSL_S_VS:          "LSL.S.VS",
-	LSL_S_VC:          "LSL.S.VC",
-	LSL_S_HI:          "LSL.S.HI",
-	LSL_S_LS:          "LSL.S.LS",
-	LSL_S_GE:          "LSL.S.GE",
-	LSL_S_LT:          "LSL.S.LT",
-	LSL_S_GT:          "LSL.S.GT",
-	LSL_S_LE:          "LSL.S.LE",
-	LSL_S:             "LSL.S",
-	LSL_S_ZZ:          "LSL.S.ZZ",
-	LSR_EQ:            "LSR.EQ",
-	LSR_NE:            "LSR.NE",
-	LSR_CS:            "LSR.CS",
-	LSR_CC:            "LSR.CC",
-	LSR_MI:            "LSR.MI",
-	LSR_PL:            "LSR.PL",
-	LSR_VS:            "LSR.VS",
-	LSR_VC:            "LSR.VC",
-	LSR_HI:            "LSR.HI",
-	LSR_LS:            "LSR.LS",
-	LSR_GE:            "LSR.GE",
-	LSR_LT:            "LSR.LT",
-	LSR_GT:            "LSR.GT",
-	LSR_LE:            "LSR.LE",
-	LSR:               "LSR",
-	LSR_ZZ:            "LSR.ZZ",
-	LSR_S_EQ:          "LSR.S.EQ",
-	LSR_S_NE:          "LSR.S.NE",
-	LSR_S_CS:          "LSR.S.CS",
-	LSR_S_CC:          "LSR.S.CC",
-	LSR_S_MI:          "LSR.S.MI",
-	LSR_S_PL:          "LSR.S.PL",
-	LSR_S_VS:          "LSR.S.VS",
-	LSR_S_VC:          "LSR.S.VC",
-	LSR_S_HI:          "LSR.S.HI",
-	LSR_S_LS:          "LSR.S.LS",
-	LSR_S_GE:          "LSR.S.GE",
-	LSR_S_LT:          "LSR.S.LT",
-	LSR_S_GT:          "LSR.S.GT",
-	LSR_S_LE:          "LSR.S.LE",
-	LSR_S:             "LSR.S",
-	LSR_S_ZZ:          "LSR.S.ZZ",
-	MLA_EQ:            "MLA.EQ",
-	MLA_NE:            "MLA.NE",
-	MLA_CS:            "MLA.CS",
-	MLA_CC:            "MLA.CC",
-	MLA_MI:            "MLA.MI",
-	MLA_PL:            "MLA.PL",
-	MLA_VS:            "MLA.VS",
-	MLA_VC:            "MLA.VC",
-	MLA_HI:            "MLA.HI",
-	MLA_LS:            "MLA.LS",
-	MLA_GE:            "MLA.GE",
-	MLA_LT:            "MLA.LT",
-	MLA_GT:            "MLA.GT",
-	MLA_LE:            "MLA.LE",
-	MLA:               "MLA",
-	MLA_ZZ:            "MLA.ZZ",
-	MLA_S_EQ:          "MLA.S.EQ",
-	MLA_S_NE:          "MLA.S.NE",
-	MLA_S_CS:          "MLA.S.CS",
-	MLA_S_CC:          "MLA.S.CC",
-	MLA_S_MI:          "MLA.S.MI",
-	MLA_S_PL:          "MLA.S.PL",
-	MLA_S_VS:          "MLA.S.VS",
-	MLA_S_VC:          "MLA.S.VC",
-	MLA_S_HI:          "MLA.S.HI",
-	MLA_S_LS:          "MLA.S.LS",
-	MLA_S_GE:          "MLA.S.GE",
-	MLA_S_LT:          "MLA.S.LT",
-	MLA_S_GT:          "MLA.S.GT",
-	MLA_S_LE:          "MLA.S.LE",
-	MLA_S:             "MLA.S",
-	MLA_S_ZZ:          "MLA.S.ZZ",
-	MLS_EQ:            "MLS.EQ",
-	MLS_NE:            "MLS.NE",
-	MLS_CS:            "MLS.CS",
-	MLS_CC:            "MLS.CC",
-	MLS_MI:            "MLS.MI",
-	MLS_PL:            "MLS.PL",
-	MLS_VS:            "MLS.VS",
-	MLS_VC:            "MLS.VC",
-	MLS_HI:            "MLS.HI",
-	MLS_LS:            "MLS.LS",
-	MLS_GE:            "MLS.GE",
-	MLS_LT:            "MLS.LT",
-	MLS_GT:            "MLS.GT",
-	MLS_LE:            "MLS.LE",
-	MLS:               "MLS",
-	MLS_ZZ:            "MLS.ZZ",
-	MOV_EQ:            "MOV.EQ",
-	MOV_NE:            "MOV.NE",
-	MOV_CS:            "MOV.CS",
-	MOV_CC:            "MOV.CC",
-	MOV_MI:            "MOV.MI",
-	MOV_PL:            "MOV.PL",
-	MOV_VS:            "MOV.VS",
-	MOV_VC:            "MOV.VC",
-	MOV_HI:            "MOV.HI",
-	MOV_LS:            "MOV.LS",
-	MOV_GE:            "MOV.GE",
-	MOV_LT:            "MOV.LT",
-	MOV_GT:            "MOV.GT",
-	MOV_LE:            "MOV.LE",
-	MOV:               "MOV",
-	MOV_ZZ:            "MOV.ZZ",
-	MOV_S_EQ:          "MOV.S.EQ",
-	MOV_S_NE:          "MOV.S.NE",
-	MOV_S_CS:          "MOV.S.CS",
-	MOV_S_CC:          "MOV.S.CC",
-	MOV_S_MI:          "MOV.S.MI",
-	MOV_S_PL:          "MOV.S.PL",
-	MOV_S_VS:          "MOV.S.VS",
-	MOV_S_VC:          "MOV.S.VC",
-	MOV_S_HI:          "MOV.S.HI",
-	MOV_S_LS:          "MOV.S.LS",
-	MOV_S_GE:          "MOV.S.GE",
-	MOV_S_LT:          "MOV.S.LT",
-	MOV_S_GT:          "MOV.S.GT",
-	MOV_S_LE:          "MOV.S.LE",
-	MOV_S:             "MOV.S",
-	MOV_S_ZZ:          "MOV.S.ZZ",
-	MOVT_EQ:           "MOVT.EQ",
-	MOVT_NE:           "MOVT.NE",
-	MOVT_CS:           "MOVT.CS",
-	MOVT_CC:           "MOVT.CC",
-	MOVT_MI:           "MOVT.MI",
-	MOVT_PL:           "MOVT.PL",
-	MOVT_VS:           "MOVT.VS",
-	MOVT_VC:           "MOVT.VC",
-	MOVT_HI:           "MOVT.HI",
-	MOVT_LS:           "MOVT.LS",
-	MOVT_GE:           "MOVT.GE",
-	MOVT_LT:           "MOVT.LT",
-	MOVT_GT:           "MOVT.GT",
-	MOVT_LE:           "MOVT.LE",
-	MOVT:              "MOVT",
-	MOVT_ZZ:           "MOVT.ZZ",
-	MOVW_EQ:           "MOVW.EQ",
-	MOVW_NE:           "MOVW.NE",
-	MOVW_CS:           "MOVW.CS",
-	MOVW_CC:           "MOVW.CC",
-	MOVW_MI:           "MOVW.MI",
-	MOVW_PL:           "MOVW.PL",
-	MOVW_VS:           "MOVW.VS",
-	MOVW_VC:           "MOVW.VC",
-	MOVW_HI:           "MOVW.HI",
-	MOVW_LS:           "MOVW.LS",
-	MOVW_GE:           "MOVW.GE",
-	MOVW_LT:           "MOVW.LT",
-	MOVW_GT:           "MOVW.GT",
-	MOVW_LE:           "MOVW.LE",
-	MOVW:              "MOVW",
-	MOVW_ZZ:           "MOVW.ZZ",
-	MRS_EQ:            "MRS.EQ",
-	MRS_NE:            "MRS.NE",
-	MRS_CS:            "MRS.CS",
-	MRS_CC:            "MRS.CC",
-	MRS_MI:            "MRS.MI",
-	MRS_PL:            "MRS.PL",
-	MRS_VS:            "MRS.VS",
-	MRS_VC:            "MRS.VC",
-	MRS_HI:            "MRS.HI",
-	MRS_LS:            "MRS.LS",
-	MRS_GE:            "MRS.GE",
-	MRS_LT:            "MRS.LT",
-	MRS_GT:            "MRS.GT",
-	MRS_LE:            "MRS.LE",
-	MRS:               "MRS",
-	MRS_ZZ:            "MRS.ZZ",
-	MUL_EQ:            "MUL.EQ",
-	MUL_NE:            "MUL.NE",
-	MUL_CS:            "MUL.CS",
-	MUL_CC:            "MUL.CC",
-	MUL_MI:            "MUL.MI",
-	MUL_PL:            "MUL.PL",
-	MUL_VS:            "MUL.VS",
-	MUL_VC:            "MUL.VC",
-	MUL_HI:            "MUL.HI",
-	MUL_LS:            "MUL.LS",
-	MUL_GE:            "MUL.GE",
-	MUL_LT:            "MUL.LT",
-	MUL_GT:            "MUL.GT",
-	MUL_LE:            "MUL.LE",
-	MUL:               "MUL",
-	MUL_ZZ:            "MUL.ZZ",
-	MUL_S_EQ:          "MUL.S.EQ",
-	MUL_S_NE:          "MUL.S.NE",
-	MUL_S_CS:          "MUL.S.CS",
-	MUL_S_CC:          "MUL.S.CC",
-	MUL_S_MI:          "MUL.S.MI",
-	MUL_S_PL:          "MUL.S.PL",
-	MUL_S_VS:          "MUL.S.VS",
-	MUL_S_VC:          "MUL.S.VC",
-	MUL_S_HI:          "MUL.S.HI",
-	MUL_S_LS:          "MUL.S.LS",
-	MUL_S_GE:          "MUL.S.GE",
-	MUL_S_LT:          "MUL.S.LT",
-	MUL_S_GT:          "MUL.S.GT",
-	MUL_S_LE:          "MUL.S.LE",
-	MUL_S:             "MUL.S",
-	MUL_S_ZZ:          "MUL.S.ZZ",
-	MVN_EQ:            "MVN.EQ",
-	MVN_NE:            "MVN.NE",
-	MVN_CS:            "MVN.CS",
-	MVN_CC:            "MVN.CC",
-	MVN_MI:            "MVN.MI",
-	MVN_PL:            "MVN.PL",
-	MVN_VS:            "MVN.VS",
-	MVN_VC:            "MVN.VC",
-	MVN_HI:            "MVN.HI",
-	MVN_LS:            "MVN.LS",
-	MVN_GE:            "MVN.GE",
-	MVN_LT:            "MVN.LT",
-	MVN_GT:            "MVN.GT",
-	MVN_LE:            "MVN.LE",
-	MVN:               "MVN",
-	MVN_ZZ:            "MVN.ZZ",
-	MVN_S_EQ:          "MVN.S.EQ",
-	MVN_S_NE:          "MVN.S.NE",
-	MVN_S_CS:          "MVN.S.CS",
-	MVN_S_CC:          "MVN.S.CC",
-	MVN_S_MI:          "MVN.S.MI",
-	MVN_S_PL:          "MVN.S.PL",
-	MVN_S_VS:          "MVN.S.VS",
-	MVN_S_VC:          "MVN.S.VC",
-	MVN_S_HI:          "MVN.S.HI",
-	MVN_S_LS:          "MVN.S.LS",
-	MVN_S_GE:          "MVN.S.GE",
-	MVN_S_LT:          "MVN.S.LT",
-	MVN_S_GT:          "MVN.S.GT",
-	MVN_S_LE:          "MVN.S.LE",
-	MVN_S:             "MVN.S",
-	MVN_S_ZZ:          "MVN.S.ZZ",
-	NOP_EQ:            "NOP.EQ",
-	NOP_NE:            "NOP.NE",
-	NOP_CS:            "NOP.CS",
-	NOP_CC:            "NOP.CC",
-	NOP_MI:            "NOP.MI",
-	NOP_PL:            "NOP.PL",
-	NOP_VS:            "NOP.VS",
-	NOP_VC:            "NOP.VC",
-	NOP_HI:            "NOP.HI",
-	NOP_LS:            "NOP.LS",
-	NOP_GE:            "NOP.GE",
-	NOP_LT:            "NOP.LT",
-	NOP_GT:            "NOP.GT",
-	NOP_LE:            "NOP.LE",
-	NOP:               "NOP",
-	NOP_ZZ:            "NOP.ZZ",
-	ORR_EQ:            "ORR.EQ",
-	ORR_NE:            "ORR.NE",
-	ORR_CS:            "ORR.CS",
-	ORR_CC:            "ORR.CC",
-	ORR_MI:            "ORR.MI",
-	ORR_PL:            "ORR.PL",
-	ORR_VS:            "ORR.VS",
-	ORR_VC:            "ORR.VC",
-	ORR_HI:            "ORR.HI",
-	ORR_LS:            "ORR.LS",
-	ORR_GE:            "ORR.GE",
-	ORR_LT:            "ORR.LT",
-	ORR_GT:            "ORR.GT",
-	ORR_LE:            "ORR.LE",
-	ORR:               "ORR",
-	ORR_ZZ:            "ORR.ZZ",
-	ORR_S_EQ:          "ORR.S.EQ",
-	ORR_S_NE:          "ORR.S.NE",
-	ORR_S_CS:          "ORR.S.CS",
-	ORR_S_CC:          "ORR.S.CC",
-	ORR_S_MI:          "ORR.S.MI",
-	ORR_S_PL:          "ORR.S.PL",
-	ORR_S_VS:          "ORR.S.VS",
-	ORR_S_VC:          "ORR.S.VC",
-	ORR_S_HI:          "ORR.S.HI",
-	ORR_S_LS:          "ORR.S.LS",
-	ORR_S_GE:          "ORR.S.GE",
-	ORR_S_LT:          "ORR.S.LT",
-	ORR_S_GT:          "ORR.S.GT",
-	ORR_S_LE:          "ORR.S.LE",
-	ORR_S:             "ORR.S",
-	ORR_S_ZZ:          "ORR.S.ZZ",
-	PKHBT_EQ:          "PKHBT.EQ",
-	PKHBT_NE:          "PKHBT.NE",
-	PKHBT_CS:          "PKHBT.CS",
-	PKHBT_CC:          "PKHBT.CC",
-	PKHBT_MI:          "PKHBT.MI",
-	PKHBT_PL:          "PKHBT.PL",
-	PKHBT_VS:          "PKHBT.VS",
-	PKHBT_VC:          "PKHBT.VC",
-	PKHBT_HI:          "PKHBT.HI",
-	PKHBT_LS:          "PKHBT.LS",
-	PKHBT_GE:          "PKHBT.GE",
-	PKHBT_LT:          "PKHBT.LT",
-	PKHBT_GT:          "PKHBT.GT",
-	PKHBT_LE:          "PKHBT.LE",
-	PKHBT:             "PKHBT",
-	PKHBT_ZZ:          "PKHBT.ZZ",
-	PKHTB_EQ:          "PKHTB.EQ",
-	PKHTB_NE:          "PKHTB.NE",
-	PKHTB_CS:          "PKHTB.CS",
-	PKHTB_CC:          "PKHTB.CC",
-	PKHTB_MI:          "PKHTB.MI",
-	PKHTB_PL:          "PKHTB.PL",
-	PKHTB_VS:          "PKHTB.VS",
-	PKHTB_VC:          "PKHTB.VC",
-	PKHTB_HI:          "PKHTB.HI",
-	PKHTB_LS:          "PKHTB.LS",
-	PKHTB_GE:          "PKHTB.GE",
-	PKHTB_LT:          "PKHTB.LT",
-	PKHTB_GT:          "PKHTB.GT",
-	PKHTB_LE:          "PKHTB.LE",
-	PKHTB:             "PKHTB",
-	PKHTB_ZZ:          "PKHTB.ZZ",
-	PLD_W:             "PLD.W",
-	PLD:               "PLD",
-	PLI:               "PLI",
-	POP_EQ:            "POP.EQ",
-	POP_NE:            "POP.NE",
-	POP_CS:            "POP.CS",
-	POP_CC:            "POP.CC",
-	POP_MI:            "POP.MI",
-	POP_PL:            "POP.PL",
-	POP_VS:            "POP.VS",
-	POP_VC:            "POP.VC",
-	POP_HI:            "POP.HI",
-	POP_LS:            "POP.LS",
-	POP_GE:            "POP.GE",
-	POP_LT:            "POP.LT",
-	POP_GT:            "POP.GT",
-	POP_LE:            "POP.LE",
-	POP:               "POP",
-	POP_ZZ:            "POP.ZZ",
-	PUSH_EQ:           "PUSH.EQ",
-	PUSH_NE:           "PUSH.NE",
-	PUSH_CS:           "PUSH.CS",
-	PUSH_CC:           "PUSH.CC",
-	PUSH_MI:           "PUSH.MI",
-	PUSH_PL:           "PUSH.PL",
-	PUSH_VS:           "PUSH.VS",
-	PUSH_VC:           "PUSH.VC",
-	PUSH_HI:           "PUSH.HI",
-	PUSH_LS:           "PUSH.LS",
-	PUSH_GE:           "PUSH.GE",
-	PUSH_LT:           "PUSH.LT",
-	PUSH_GT:           "PUSH.GT",
-	PUSH_LE:           "PUSH.LE",
-	PUSH:              "PUSH",
-	PUSH_ZZ:           "PUSH.ZZ",
-	QADD_EQ:           "QADD.EQ",
-	QADD_NE:           "QADD.NE",
-	QADD_CS:           "QADD.CS",
-	QADD_CC:           "QADD.CC",
-	QADD_MI:           "QADD.MI",
-	QADD_PL:           "QADD.PL",
-	QADD_VS:           "QADD.VS",
-	QADD_VC:           "QADD.VC",
-	QADD_HI:           "QADD.HI",
-	QADD_LS:           "QADD.LS",
-	QADD_GE:           "QADD.GE",
-	QADD_LT:           "QADD.LT",
-	QADD_GT:           "QADD.GT",
-	QADD_LE:           "QADD.LE",
-	QADD:              "QADD",
-	QADD_ZZ:           "QADD.ZZ",
-	QADD16_EQ:         "QADD16.EQ",
-	QADD16_NE:         "QADD16.NE",
-	QADD16_CS:         "QADD16.CS",
-	QADD16_CC:         "QADD16.CC",
-	QADD16_MI:         "QADD16.MI",
-	QADD16_PL:         "QADD16.PL",
-	QADD16_VS:         "QADD16.VS",
-	QADD16_VC:         "QADD16.VC",
-	QADD16_HI:         "QADD16.HI",
-	QADD16_LS:         "QADD16.LS",
-	QADD16_GE:         "QADD16.GE",
-	QADD16_LT:         "QADD16.LT",
-	QADD16_GT:         "QADD16.GT",
-	QADD16_LE:         "QADD16.LE",
-	QADD16:            "QADD16",
-	QADD16_ZZ:         "QADD16.ZZ",
-	QADD8_EQ:          "QADD8.EQ",
-	QADD8_NE:          "QADD8.NE",
-	QADD8_CS:          "QADD8.CS",
-	QADD8_CC:          "QADD8.CC",
-	QADD8_MI:          "QADD8.MI",
-	QADD8_PL:          "QADD8.PL",
-	QADD8_VS:          "QADD8.VS",
-	QADD8_VC:          "QADD8.VC",
-	QADD8_HI:          "QADD8.HI",
-	QADD8_LS:          "QADD8.LS",
-	QADD8_GE:          "QADD8.GE",
-	QADD8_LT:          "QADD8.LT",
-	QADD8_GT:          "QADD8.GT",
-	QADD8_LE:          "QADD8.LE",
-	QADD8:             "QADD8",
-	QADD8_ZZ:          "QADD8.ZZ",
-	QASX_EQ:           "QASX.EQ",
-	QASX_NE:           "QASX.NE",
-	QASX_CS:           "QASX.CS",
-	QASX_CC:           "QASX.CC",
-	QASX_MI:           "QASX.MI",
-	QASX_PL:           "QASX.PL",
-	QASX_VS:           "QASX.VS",
-	QASX_VC:           "QASX.VC",
-	QASX_HI:           "QASX.HI",
-	QASX_LS:           "QASX.LS",
-	QASX_GE:           "QASX.GE",
-	QASX_LT:           "QASX.LT",
-	QASX_GT:           "QASX.GT",
-	QASX_LE:           "QASX.LE",
-	QASX:              "QASX",
-	QASX_ZZ:           "QASX.ZZ",
-	QDADD_EQ:          "QDADD.EQ",
-	QDADD_NE:          "QDADD.NE",
-	QDADD_CS:          "QDADD.CS",
-	QDADD_CC:          "QDADD.CC",
-	QDADD_MI:          "QDADD.MI",
-	QDADD_PL:          "QDADD.PL",
-	QDADD_VS:          "QDADD.VS",
-	QDADD_VC:          "QDADD.VC",
-	QDADD_HI:          "QDADD.HI",
-	QDADD_LS:          "QDADD.LS",
-	QDADD_GE:          "QDADD.GE",
-	QDADD_LT:          "QDADD.LT",
-	QDADD_GT:          "QDADD.GT",
-	QDADD_LE:          "QDADD.LE",
-	QDADD:             "QDADD",
-	QDADD_ZZ:          "QDADD.ZZ",
-	QDSUB_EQ:          "QDSUB.EQ",
-	QDSUB_NE:          "QDSUB.NE",
-	QDSUB_CS:          "QDSUB.CS",
-	QDSUB_CC:          "QDSUB.CC",
-	QDSUB_MI:          "QDSUB.MI",
-	QDSUB_PL:          "QDSUB.PL",
-	QDSUB_VS:          "QDSUB.VS",
-	QDSUB_VC:          "QDSUB.VC",
-	QDSUB_HI:          "QDSUB.HI",
-	QDSUB_LS:          "QDSUB.LS",
-	QDSUB_GE:          "QDSUB.GE",
-	QDSUB_LT:          "QDSUB.LT",
-	QDSUB_GT:          "QDSUB.GT",
-	QDSUB_LE:          "QDSUB.LE",
-	QDSUB:             "QDSUB",
-	QDSUB_ZZ:          "QDSUB.ZZ",
-	QSAX_EQ:           "QSAX.EQ",
-	QSAX_NE:           "QSAX.NE",
-	QSAX_CS:           "QSAX.CS",
-	QSAX_CC:           "QSAX.CC",
-	QSAX_MI:           "QSAX.MI",
-	QSAX_PL:           "QSAX.PL",
-	QSAX_VS:           "QSAX.VS",
-	QSAX_VC:           "QSAX.VC",
-	QSAX_HI:           "QSAX.HI",
-	QSAX_LS:           "QSAX.LS",
-	QSAX_GE:           "QSAX.GE",
-	QSAX_LT:           "QSAX.LT",
-	QSAX_GT:           "QSAX.GT",
-	QSAX_LE:           "QSAX.LE",
-	QSAX:              "QSAX",
-	QSAX_ZZ:           "QSAX.ZZ",
-	QSUB_EQ:           "QSUB.EQ",
-	QSUB_NE:           "QSUB.NE",
-	QSUB_CS:           "QSUB.CS",
-	QSUB_CC:           "QSUB.CC",
-	QSUB_MI:           "QSUB.MI",
-	QSUB_PL:           "QSUB.PL",
-	QSUB_VS:           "QSUB.VS",
-	QSUB_VC:           "QSUB.VC",
-	QSUB_HI:           "QSUB.HI",
-	QSUB_LS:           "QSUB.LS",
-	QSUB_GE:           "QSUB.GE",
-	QSUB_LT:           "QSUB.LT",
-	QSUB_GT:           "QSUB.GT",
-	QSUB_LE:           "QSUB.LE",
-	QSUB:              "QSUB",
-	QSUB_ZZ:           "QSUB.ZZ",
-	QSUB16_EQ:         "QSUB16.EQ",
-	QSUB16_NE:         "QSUB16.NE",
-	QSUB16_CS:         "QSUB16.CS",
-	QSUB16_CC:         "QSUB16.CC",
-	QSUB16_MI:         "QSUB16.MI",
-	QSUB16_PL:         "QSUB16.PL",
-	QSUB16_VS:         "QSUB16.VS",
-	QSUB16_VC:         "QSUB16.VC",
-	QSUB16_HI:         "QSUB16.HI",
-	QSUB16_LS:         "QSUB16.LS",
-	QSUB16_GE:         "QSUB16.GE",
-	QSUB16_LT:         "QSUB16.LT",
-	QSUB16_GT:         "QSUB16.GT",
-	QSUB16_LE:         "QSUB16.LE",
-	QSUB16:            "QSUB16",
-	QSUB16_ZZ:         "QSUB16.ZZ",
-	QSUB8_EQ:          "QSUB8.EQ",
-	QSUB8_NE:          "QSUB8.NE",
-	QSUB8_CS:          "QSUB8.CS",
-	QSUB8_CC:          "QSUB8.CC",
-	QSUB8_MI:          "QSUB8.MI",
-	QSUB8_PL:          "QSUB8.PL",
-	QSUB8_VS:          "QSUB8.VS",
-	QSUB8_VC:          "QSUB8.VC",
-	QSUB8_HI:          "QSUB8.HI",
-	QSUB8_LS:          "QSUB8.LS",
-	QSUB8_GE:          "QSUB8.GE",
-	QSUB8_LT:          "QSUB8.LT",
-	QSUB8_GT:          "QSUB8.GT",
-	QSUB8_LE:          "QSUB8.LE",
-	QSUB8:             "QSUB8",
-	QSUB8_ZZ:          "QSUB8.ZZ",
-	RBIT_EQ:           "RBIT.EQ",
-	RBIT_NE:           "RBIT.NE",
-	RBIT_CS:           "RBIT.CS",
-	RBIT_CC:           "RBIT.CC",
-	RBIT_MI:           "RBIT.MI",
-	RBIT_PL:           "RBIT.PL",
-	RBIT_VS:           "RBIT.VS",
-	RBIT_VC:           "RBIT.VC",
-	RBIT_HI:           "RBIT.HI",
-	RBIT_LS:           "RBIT.LS",
-	RBIT_GE:           "RBIT.GE",
-	RBIT_LT:           "RBIT.LT",
-	RBIT_GT:           "RBIT.GT",
-	RBIT_LE:           "RBIT.LE",
-	RBIT:              "RBIT",
-	RBIT_ZZ:           "RBIT.ZZ",
-	REV_EQ:            "REV.EQ",
-	REV_NE:            "REV.NE",
-	REV_CS:            "REV.CS",
-	REV_CC:            "REV.CC",
-	REV_MI:            "REV.MI",
-	REV_PL:            "REV.PL",
-	REV_VS:            "REV.VS",
-	REV_VC:            "REV.VC",
-	REV_HI:            "REV.HI",
-	REV_LS:            "REV.LS",
-	REV_GE:            "REV.GE",
-	REV_LT:            "REV.LT",
-	REV_GT:            "REV.GT",
-	REV_LE:            "REV.LE",
-	REV:               "REV",
-	REV_ZZ:            "REV.ZZ",
-	REV16_EQ:          "REV16.EQ",
-	REV16_NE:          "REV16.NE",
-	REV16_CS:          "REV16.CS",
-	REV16_CC:          "REV16.CC",
-	REV16_MI:          "REV16.MI",
-	REV16_PL:          "REV16.PL",
-	REV16_VS:          "REV16.VS",
-	REV16_VC:          "REV16.VC",
-	REV16_HI:          "REV16.HI",
-	REV16_LS:          "REV16.LS",
-	REV16_GE:          "REV16.GE",
-	REV16_LT:          "REV16.LT",
-	REV16_GT:          "REV16.GT",
-	REV16_LE:          "REV16.LE",
-	REV16:             "REV16",
-	REV16_ZZ:          "REV16.ZZ",
-	REVSH_EQ:          "REVSH.EQ",
-	REVSH_NE:          "REVSH.NE",
-	REVSH_CS:          "REVSH.CS",
-	REVSH_CC:          "REVSH.CC",
-	REVSH_MI:          "REVSH.MI",
-	REVSH_PL:          "REVSH.PL",
-	REVSH_VS:          "REVSH.VS",
-	REVSH_VC:          "REVSH.VC",
-	REVSH_HI:          "REVSH.HI",
-	REVSH_LS:          "REVSH.LS",
-	REVSH_GE:          "REVSH.GE",
-	REVSH_LT:          "REVSH.LT",
-	REVSH_GT:          "REVSH.GT",
-	REVSH_LE:          "REVSH.LE",
-	REVSH:             "REVSH",
-	REVSH_ZZ:          "REVSH.ZZ",
-	ROR_EQ:            "ROR.EQ",
-	ROR_NE:            "ROR.NE",
-	ROR_CS:            "ROR.CS",
-	ROR_CC:            "ROR.CC",
-	ROR_MI:            "ROR.MI",
-	ROR_PL:            "ROR.PL",
-	ROR_VS:            "ROR.VS",
-	ROR_VC:            "ROR.VC",
-	ROR_HI:            "ROR.HI",
-	ROR_LS:            "ROR.LS",
-	ROR_GE:            "ROR.GE",
-	ROR_LT:            "ROR.LT",
-	ROR_GT:            "ROR.GT",
-	ROR_LE:            "ROR.LE",
-	ROR:               "ROR",
-	ROR_ZZ:            "ROR.ZZ",
-	ROR_S_EQ:          "ROR.S.EQ",
-	ROR_S_NE:          "ROR.S.NE",
-	ROR_S_CS:          "ROR.S.CS",
-	ROR_S_CC:          "ROR.S.CC",
-	ROR_S_MI:          "ROR.S.MI",
-	ROR_S_PL:          "ROR.S.PL",
-	ROR_S_VS:          "ROR.S.VS",
-	ROR_S_VC:          "ROR.S.VC",
-	ROR_S_HI:          "ROR.S.HI",
-	ROR_S_LS:          "ROR.S.LS",
-	ROR_S_GE:          "ROR.S.GE",
-	ROR_S_LT:          "ROR.S.LT",
-	ROR_S_GT:          "ROR.S.GT",
-	ROR_S_LE:          "ROR.S.LE",
-	ROR_S:             "ROR.S",
-	ROR_S_ZZ:          "ROR.S.ZZ",
-	RRX_EQ:            "RRX.EQ",
-	RRX_NE:            "RRX.NE",
-	RRX_CS:            "RRX.CS",
-	RRX_CC:            "RRX.CC",
-	RRX_MI:            "RRX.MI",
-	RRX_PL:            "RRX.PL",
-	RRX_VS:            "RRX.VS",
-	RRX_VC:            "RRX.VC",
-	RRX_HI:            "RRX.HI",
-	RRX_LS:            "RRX.LS",
-	RRX_GE:            "RRX.GE",
-	RRX_LT:            "RRX.LT",
-	RRX_GT:            "RRX.GT",
-	RRX_LE:            "RRX.LE",
-	RRX:               "RRX",
-	RRX_ZZ:            "RRX.ZZ",
-	RRX_S_EQ:          "RRX.S.EQ",
-	RRX_S_NE:          "RRX.S.NE",
-	RRX_S_CS:          "RRX.S.CS",
-	RRX_S_CC:          "RRX.S.CC",
-	RRX_S_MI:          "RRX.S.MI",
-	RRX_S_PL:          "RRX.S.PL",
-	RRX_S_VS:          "RRX.S.VS",
-	RRX_S_VC:          "RRX.S.VC",
-	RRX_S_HI:          "RRX.S.HI",
-	RRX_S_LS:          "RRX.S.LS",
-	RRX_S_GE:          "RRX.S.GE",
-	RRX_S_LT:          "RRX.S.LT",
-	RRX_S_GT:          "RRX.S.GT",
-	RRX_S_LE:          "RRX.S.LE",
-	RRX_S:             "RRX.S",
-	RRX_S_ZZ:          "RRX.S.ZZ",
-	RSB_EQ:            "RSB.EQ",
-	RSB_NE:            "RSB.NE",
-	RSB_CS:            "RSB.CS",
-	RSB_CC:            "RSB.CC",
-	RSB_MI:            "RSB.MI",
-	RSB_PL:            "RSB.PL",
-	RSB_VS:            "RSB.VS",
-	RSB_VC:            "RSB.VC",
-	RSB_HI:            "RSB.HI",
-	RSB_LS:            "RSB.LS",
-	RSB_GE:            "RSB.GE",
-	RSB_LT:            "RSB.LT",
-	RSB_GT:            "RSB.GT",
-	RSB_LE:            "RSB.LE",
-	RSB:               "RSB",
-	RSB_ZZ:            "RSB.ZZ",
-	RSB_S_EQ:          "RSB.S.EQ",
-	RSB_S_NE:          "RSB.S.NE",
-	RSB_S_CS:          "RSB.S.CS",
-	RSB_S_CC:          "RSB.S.CC",
-	RSB_S_MI:          "RSB.S.MI",
-	RSB_S_PL:          "RSB.S.PL",
-	RSB_S_VS:          "RSB.S.VS",
-	RSB_S_VC:          "RSB.S.VC",
-	RSB_S_HI:          "RSB.S.HI",
-	RSB_S_LS:          "RSB.S.LS",
-	RSB_S_GE:          "RSB.S.GE",
-	RSB_S_LT:          "RSB.S.LT",
-	RSB_S_GT:          "RSB.S.GT",
-	RSB_S_LE:          "RSB.S.LE",
-	RSB_S:             "RSB.S",
-	RSB_S_ZZ:          "RSB.S.ZZ",
-	RSC_EQ:            "RSC.EQ",
-	RSC_NE:            "RSC.NE",
-	RSC_CS:            "RSC.CS",
-	RSC_CC:            "RSC.CC",
-	RSC_MI:            "RSC.MI",
-	RSC_PL:            "RSC.PL",
-	RSC_VS:            "RSC.VS",
-	RSC_VC:            "RSC.VC",
-	RSC_HI:            "RSC.HI",
-	RSC_LS:            "RSC.LS",
-	RSC_GE:            "RSC.GE",
-	RSC_LT:            "RSC.LT",
-	RSC_GT:            "RSC.GT",
-	RSC_LE:            "RSC.LE",
-	RSC:               "RSC",
-	RSC_ZZ:            "RSC.ZZ",
-	RSC_S_EQ:          "RSC.S.EQ",
-	RSC_S_NE:          "RSC.S.NE",
-	RSC_S_CS:          "RSC.S.CS",
-	RSC_S_CC:          "RSC.S.CC",
-	RSC_S_MI:          "RSC.S.MI",
-	RSC_S_PL:          "RSC.S.PL",
-	RSC_S_VS:          "RSC.S.VS",
-	RSC_S_VC:          "RSC.S.VC",
-	RSC_S_HI:          "RSC.S.HI",
-	RSC_S_LS:          "RSC.S.LS",
-	RSC_S_GE:          "RSC.S.GE",
-	RSC_S_LT:          "RSC.S.LT",
-	RSC_S_GT:          "RSC.S.GT",
-	RSC_S_LE:          "RSC.S.LE",
-	RSC_S:             "RSC.S",
-	RSC_S_ZZ:          "RSC.S.ZZ",
-	SADD16_EQ:         "SADD16.EQ",
-	SADD16_NE:         "SADD16.NE",
-	SADD16_CS:         "SADD16.CS",
-	SADD16_CC:         "SADD16.CC",
-	SADD16_MI:         "SADD16.MI",
-	SADD16_PL:         "SADD16.PL",
-	SADD16_VS:         "SADD16.VS",
-	SADD16_VC:         "SADD16.VC",
-	SADD16_HI:         "SADD16.HI",
-	SADD16_LS:         "SADD16.LS",
-	SADD16_GE:         "SADD16.GE",
-	SADD16_LT:         "SADD16.LT",
-	SADD16_GT:         "SADD16.GT",
-	SADD16_LE:         "SADD16.LE",
-	SADD16:            "SADD16",
-	SADD16_ZZ:         "SADD16.ZZ",
-	SADD8_EQ:          "SADD8.EQ",
-	SADD8_NE:          "SADD8.NE",
-	SADD8_CS:          "SADD8.CS",
-	SADD8_CC:          "SADD8.CC",
-	SADD8_MI:          "SADD8.MI",
-	SADD8_PL:          "SADD8.PL",
-	SADD8_VS:          "SADD8.VS",
-	SADD8_VC:          "SADD8.VC",
-	SADD8_HI:          "SADD8.HI",
-	SADD8_LS:          "SADD8.LS",
-	SADD8_GE:          "SADD8.GE",
-	SADD8_LT:          "SADD8.LT",
-	SADD8_GT:          "SADD8.GT",
-	SADD8_LE:          "SADD8.LE",
-	SADD8:             "SADD8",
-	SADD8_ZZ:          "SADD8.ZZ",
-	SASX_EQ:           "SASX.EQ",
-	SASX_NE:           "SASX.NE",
-	SASX_CS:           "SASX.CS",
-	SASX_CC:           "SASX.CC",
-	SASX_MI:           "SASX.MI",
-	SASX_PL:           "SASX.PL",
-	SASX_VS:           "SASX.VS",
-	SASX_VC:           "SASX.VC",
-	SASX_HI:           "SASX.HI",
-	SASX_LS:           "SASX.LS",
-	SASX_GE:           "SASX.GE",
-	SASX_LT:           "SASX.LT",
-	SASX_GT:           "SASX.GT",
-	SASX_LE:           "SASX.LE",
-	SASX:              "SASX",
-	SASX_ZZ:           "SASX.ZZ",
-	SBC_EQ:            "SBC.EQ",
-	SBC_NE:            "SBC.NE",
-	SBC_CS:            "SBC.CS",
-	SBC_CC:            "SBC.CC",
-	SBC_MI:            "SBC.MI",
-	SBC_PL:            "SBC.PL",
-	SBC_VS:            "SBC.VS",
-	SBC_VC:            "SBC.VC",
-	SBC_HI:            "SBC.HI",
-	SBC_LS:            "SBC.LS",
-	SBC_GE:            "SBC.GE",
-	SBC_LT:            "SBC.LT",
-	SBC_GT:            "SBC.GT",
-	SBC_LE:            "SBC.LE",
-	SBC:               "SBC",
-	SBC_ZZ:            "SBC.ZZ",
-	SBC_S_EQ:          "SBC.S.EQ",
-	SBC_S_NE:          "SBC.S.NE",
-	SBC_S_CS:          "SBC.S.CS",
-	SBC_S_CC:          "SBC.S.CC",
-	SBC_S_MI:          "SBC.S.MI",
-	SBC_S_PL:          "SBC.S.PL",
-	SBC_S_VS:          "SBC.S.VS",
-	SBC_S_VC:          "SBC.S.VC",
-	SBC_S_HI:          "SBC.S.HI",
-	SBC_S_LS:          "SBC.S.LS",
-	SBC_S_GE:          "SBC.S.GE",
-	SBC_S_LT:          "SBC.S.LT",
-	SBC_S_GT:          "SBC.S.GT",
-	SBC_S_LE:          "SBC.S.LE",
-	SBC_S:             "SBC.S",
-	SBC_S_ZZ:          "SBC.S.ZZ",
-	SBFX_EQ:           "SBFX.EQ",
-	SBFX_NE:           "SBFX.NE",
-	SBFX_CS:           "SBFX.CS",
-	SBFX_CC:           "SBFX.CC",
-	SBFX_MI:           "SBFX.MI",
-	SBFX_PL:           "SBFX.PL",
-	SBFX_VS:           "SBFX.VS",
-	SBFX_VC:           "SBFX.VC",
-	SBFX_HI:           "SBFX.HI",
-	SBFX_LS:           "SBFX.LS",
-	SBFX_GE:           "SBFX.GE",
-	SBFX_LT:           "SBFX.LT",
-	SBFX_GT:           "SBFX.GT",
-	SBFX_LE:           "SBFX.LE",
-	SBFX:              "SBFX",
-	SBFX_ZZ:           "SBFX.ZZ",
-	SEL_EQ:            "SEL.EQ",
-	SEL_NE:            "SEL.NE",
-	SEL_CS:            "SEL.CS",
-	SEL_CC:            "SEL.CC",
-	SEL_MI:            "SEL.MI",
-	SEL_PL:            "SEL.PL",
-	SEL_VS:            "SEL.VS",
-	SEL_VC:            "SEL.VC",
-	SEL_HI:            "SEL.HI",
-	SEL_LS:            "SEL.LS",
-	SEL_GE:            "SEL.GE",
-	SEL_LT:            "SEL.LT",
-	SEL_GT:            "SEL.GT",
-	SEL_LE:            "SEL.LE",
-	SEL:               "SEL",
-	SEL_ZZ:            "SEL.ZZ",
-	SETEND:            "SETEND",
-	SEV_EQ:            "SEV.EQ",
-	SEV_NE:            "SEV.NE",
-	SEV_CS:            "SEV.CS",
-	SEV_CC:            "SEV.CC",
-	SEV_MI:            "SEV.MI",
-	SEV_PL:            "SEV.PL",
-	SEV_VS:            "SEV.VS",
-	SEV_VC:            "SEV.VC",
-	SEV_HI:            "SEV.HI",
-	SEV_LS:            "SEV.LS",
-	SEV_GE:            "SEV.GE",
-	SEV_LT:            "SEV.LT",
-	SEV_GT:            "SEV.GT",
-	SEV_LE:            "SEV.LE",
-	SEV:               "SEV",
-	SEV_ZZ:            "SEV.ZZ",
-	SHADD16_EQ:        "SHADD16.EQ",
-	SHADD16_NE:        "SHADD16.NE",
-	SHADD16_CS:        "SHADD16.CS",
-	SHADD16_CC:        "SHADD16.CC",
-	SHADD16_MI:        "SHADD16.MI",
-	SHADD16_PL:        "SHADD16.PL",
-	SHADD16_VS:        "SHADD16.VS",
-	SHADD16_VC:        "SHADD16.VC",
-	SHADD16_HI:        "SHADD16.HI",
-	SHADD16_LS:        "SHADD16.LS",
-	SHADD16_GE:        "SHADD16.GE",
-	SHADD16_LT:        "SHADD16.LT",
-	SHADD16_GT:        "SHADD16.GT",
-	SHADD16_LE:        "SHADD16.LE",
-	SHADD16:           "SHADD16",
-	SHADD16_ZZ:        "SHADD16.ZZ",
-	SHADD8_EQ:         "SHADD8.EQ",
-	SHADD8_NE:         "SHADD8.NE",
-	SHADD8_CS:         "SHADD8.CS",
-	SHADD8_CC:         "SHADD8.CC",
-	SHADD8_MI:         "SHADD8.MI",
-	SHADD8_PL:         "SHADD8.PL",
-	SHADD8_VS:         "SHADD8.VS",
-	SHADD8_VC:         "SHADD8.VC",
-	SHADD8_HI:         "SHADD8.HI",
-	SHADD8_LS:         "SHADD8.LS",
-	SHADD8_GE:         "SHADD8.GE",
-	SHADD8_LT:         "SHADD8.LT",
-	SHADD8_GT:         "SHADD8.GT",
-	SHADD8_LE:         "SHADD8.LE",
-	SHADD8:            "SHADD8",
-	SHADD8_ZZ:         "SHADD8.ZZ",
-	SHASX_EQ:          "SHASX.EQ",
-	SHASX_NE:          "SHASX.NE",
-	SHASX_CS:          "SHASX.CS",
-	SHASX_CC:          "SHASX.CC",
-	SHASX_MI:          "SHASX.MI",
-	SHASX_PL:          "SHASX.PL",
-	SHASX_VS:          "SHASX.VS",
-	SHASX_VC:          "SHASX.VC",
-	SHASX_HI:          "SHASX.HI",
-	SHASX_LS:          "SHASX.LS",
-	SHASX_GE:          "SHASX.GE",
-	SHASX_LT:          "SHASX.LT",
-	SHASX_GT:          "SHASX.GT",
-	SHASX_LE:          "SHASX.LE",
-	SHASX:             "SHASX",
-	SHASX_ZZ:          "SHASX.ZZ",
-	SHSAX_EQ:          "SHSAX.EQ",
-	SHSAX_NE:          "SHSAX.NE",
-	SHSAX_CS:          "SHSAX.CS",
-	SHSAX_CC:          "SHSAX.CC",
-	SHSAX_MI:          "SHSAX.MI",
-	SHSAX_PL:          "SHSAX.PL",
-	SHSAX_VS:          "SHSAX.VS",
-	SHSAX_VC:          "SHSAX.VC",
-	SHSAX_HI:          "SHSAX.HI",
-	SHSAX_LS:          "SHSAX.LS",
-	SHSAX_GE:          "SHSAX.GE",
-	SHSAX_LT:          "SHSAX.LT",
-	SHSAX_GT:          "SHSAX.GT",
-	SHSAX_LE:          "SHSAX.LE",
-	SHSAX:             "SHSAX",
-	SHSAX_ZZ:          "SHSAX.ZZ",
-	SHSUB16_EQ:        "SHSUB16.EQ",
-	SHSUB16_NE:        "SHSUB16.NE",
-	SHSUB16_CS:        "SHSUB16.CS",
-	SHSUB16_CC:        "SHSUB16.CC",
-	SHSUB16_MI:        "SHSUB16.MI",
-	SHSUB16_PL:        "SHSUB16.PL",
-	SHSUB16_VS:        "SHSUB16.VS",
-	SHSUB16_VC:        "SHSUB16.VC",
-	SHSUB16_HI:        "SHSUB16.HI",
-	SHSUB16_LS:        "SHSUB16.LS",
-	SHSUB16_GE:        "SHSUB16.GE",
-	SHSUB16_LT:        "SHSUB16.LT",
-	SHSUB16_GT:        "SHSUB16.GT",
-	SHSUB16_LE:        "SHSUB16.LE",
-	SHSUB16:           "SHSUB16",
-	SHSUB16_ZZ:        "SHSUB16.ZZ",
-	SHSUB8_EQ:         "SHSUB8.EQ",
-	SHSUB8_NE:         "SHSUB8.NE",
-	SHSUB8_CS:         "SHSUB8.CS",
-	SHSUB8_CC:         "SHSUB8.CC",
-	SHSUB8_MI:         "SHSUB8.MI",
-	SHSUB8_PL:         "SHSUB8.PL",
-	SHSUB8_VS:         "SHSUB8.VS",
-	SHSUB8_VC:         "SHSUB8.VC",
-	SHSUB8_HI:         "SHSUB8.HI",
-	SHSUB8_LS:         "SHSUB8.LS",
-	SHSUB8_GE:         "SHSUB8.GE",
-	SHSUB8_LT:         "SHSUB8.LT",
-	SHSUB8_GT:         "SHSUB8.GT",
-	SHSUB8_LE:         "SHSUB8.LE",
-	SHSUB8:            "SHSUB8",
-	SHSUB8_ZZ:         "SHSUB8.ZZ",
-	SMLABB_EQ:         "SMLABB.EQ",
-	SMLABB_NE:         "SMLABB.NE",
-	SMLABB_CS:         "SMLABB.CS",
-	SMLABB_CC:         "SMLABB.CC",
-	SMLABB_MI:         "SMLABB.MI",
-	SMLABB_PL:         "SMLABB.PL",
-	SMLABB_VS:         "SMLABB.VS",
-	SMLABB_VC:         "SMLABB.VC",
-	SMLABB_HI:         "SMLABB.HI",
-	SMLABB_LS:         "SMLABB.LS",
-	SMLABB_GE:         "SMLABB.GE",
-	SMLABB_LT:         "SMLABB.LT",
-	SMLABB_GT:         "SMLABB.GT",
-	SMLABB_LE:         "SMLABB.LE",
-	SMLABB:            "SMLABB",
-	SMLABB_ZZ:         "SMLABB.ZZ",
-	SMLABT_EQ:         "SMLABT.EQ",
-	SMLABT_NE:         "SMLABT.NE",
-	SMLABT_CS:         "SMLABT.CS",
-	SMLABT_CC:         "SMLABT.CC",
-	SMLABT_MI:         "SMLABT.MI",
-	SMLABT_PL:         "SMLABT.PL",
-	SMLABT_VS:         "SMLABT.VS",
-	SMLABT_VC:         "SMLABT.VC",
-	SMLABT_HI:         "SMLABT.HI",
-	SMLABT_LS:         "SMLABT.LS",
-	SMLABT_GE:         "SMLABT.GE",
-	SMLABT_LT:         "SMLABT.LT",
-	SMLABT_GT:         "SMLABT.GT",
-	SMLABT_LE:         "SMLABT.LE",
-	SMLABT:            "SMLABT",
-	SMLABT_ZZ:         "SMLABT.ZZ",
-	SMLATB_EQ:         "SMLATB.EQ",
-	SMLATB_NE:         "SMLATB.NE",
-	SMLATB_CS:         "SMLATB.CS",
-	SMLATB_CC:         "SMLATB.CC",
-	SMLATB_MI:         "SMLATB.MI",
-	SMLATB_PL:         "SMLATB.PL",
-	SMLATB_VS:         "SMLATB.VS",
-	SMLATB_VC:         "SMLATB.VC",
-	SMLATB_HI:         "SMLATB.HI",
-	SMLATB_LS:         "SMLATB.LS",
-	SMLATB_GE:         "SMLATB.GE",
-	SMLATB_LT:         "SMLATB.LT",
-	SMLATB_GT:         "SMLATB.GT",
-	SMLATB_LE:         "SMLATB.LE",
-	SMLATB:            "SMLATB",
-	SMLATB_ZZ:         "SMLATB.ZZ",
-	SMLATT_EQ:         "SMLATT.EQ",
-	SMLATT_NE:         "SMLATT.NE",
-	SMLATT_CS:         "SMLATT.CS",
-	SMLATT_CC:         "SMLATT.CC",
-	SMLATT_MI:         "SMLATT.MI",
-	SMLATT_PL:         "SMLATT.PL",
-	SMLATT_VS:         "SMLATT.VS",
-	SMLATT_VC:         "SMLATT.VC",
-	SMLATT_HI:         "SMLATT.HI",
-	SMLATT_LS:         "SMLATT.LS",
-	SMLATT_GE:         "SMLATT.GE",
-	SMLATT_LT:         "SMLATT.LT",
-	SMLATT_GT:         "SMLATT.GT",
-	SMLATT_LE:         "SMLATT.LE",
-	SMLATT:            "SMLATT",
-	SMLATT_ZZ:         "SMLATT.ZZ",
-	SMLAD_EQ:          "SMLAD.EQ",
-	SMLAD_NE:          "SMLAD.NE",
-	SMLAD_CS:          "SMLAD.CS",
-	SMLAD_CC:          "SMLAD.CC",
-	SMLAD_MI:          "SMLAD.MI",
-	SMLAD_PL:          "SMLAD.PL",
-	SMLAD_VS:          "SMLAD.VS",
-	SMLAD_VC:          "SMLAD.VC",
-	SMLAD_HI:          "SMLAD.HI",
-	SMLAD_LS:          "SMLAD.LS",
-	SMLAD_GE:          "SMLAD.GE",
-	SMLAD_LT:          "SMLAD.LT",
-	SMLAD_GT:          "SMLAD.GT",
-	SMLAD_LE:          "SMLAD.LE",
-	SMLAD:             "SMLAD",
-	SMLAD_ZZ:          "SMLAD.ZZ",
-	SMLAD_X_EQ:        "SMLAD.X.EQ",
-	SMLAD_X_NE:        "SMLAD.X.NE",
-	SMLAD_X_CS:        "SMLAD.X.CS",
-	SMLAD_X_CC:        "SMLAD.X.CC",
-	SMLAD_X_MI:        "SMLAD.X.MI",
-	SMLAD_X_PL:        "SMLAD.X.PL",
-	SMLAD_X_VS:        "SMLAD.X.VS",
-	SMLAD_X_VC:        "SMLAD.X.VC",
-	SMLAD_X_HI:        "SMLAD.X.HI",
-	SMLAD_X_LS:        "SMLAD.X.LS",
-	SMLAD_X_GE:        "SMLAD.X.GE",
-	SMLAD_X_LT:        "SMLAD.X.LT",
-	SMLAD_X_GT:        "SMLAD.X.GT",
-	SMLAD_X_LE:        "SMLAD.X.LE",
-	SMLAD_X:           "SMLAD.X",
-	SMLAD_X_ZZ:        "SMLAD.X.ZZ",
-	SMLAL_EQ:          "SMLAL.EQ",
-	SMLAL_NE:          "SMLAL.NE",
-	SMLAL_CS:          "SMLAL.CS",
-	SMLAL_CC:          "SMLAL.CC",
-	SMLAL_MI:          "SMLAL.MI",
-	SMLAL_PL:          "SMLAL.PL",
-	SMLAL_VS:          "SMLAL.VS",
-	SMLAL_VC:          "SMLAL.VC",
-	SMLAL_HI:          "SMLAL.HI",
-	SMLAL_LS:          "SMLAL.LS",
-	SMLAL_GE:          "SMLAL.GE",
-	SMLAL_LT:          "SMLAL.LT",
-	SMLAL_GT:          "SMLAL.GT",
-	SMLAL_LE:          "SMLAL.LE",
-	SMLAL:             "SMLAL",
-	SMLAL_ZZ:          "SMLAL.ZZ",
-	SMLAL_S_EQ:        "SMLAL.S.EQ",
-	SMLAL_S_NE:        "SMLAL.S.NE",
-	SMLAL_S_CS:        "SMLAL.S.CS",
-	SMLAL_S_CC:        "SMLAL.S.CC",
-	SMLAL_S_MI:        "SMLAL.S.MI",
-	SMLAL_S_PL:        "SMLAL.S.PL",
-	SMLAL_S_VS:        "SMLAL.S.VS",
-	SMLAL_S_VC:        "SMLAL.S.VC",
-	SMLAL_S_HI:        "SMLAL.S.HI",
-	SMLAL_S_LS:        "SMLAL.S.LS",
-	SMLAL_S_GE:        "SMLAL.S.GE",
-	SMLAL_S_LT:        "SMLAL.S.LT",
-	SMLAL_S_GT:        "SMLAL.S.GT",
-	SMLAL_S_LE:        "SMLAL.S.LE",
-	SMLAL_S:           "SMLAL.S",
-	SMLAL_S_ZZ:        "SMLAL.S.ZZ",
-	SMLALBB_EQ:        "SMLALBB.EQ",
-	SMLALBB_NE:        "SMLALBB.NE",
-	SMLALBB_CS:        "SMLALBB.CS",
-	SMLALBB_CC:        "SMLALBB.CC",
-	SMLALBB_MI:        "SMLALBB.MI",
-	SMLALBB_PL:        "SMLALBB.PL",
-	SMLALBB_VS:        "SMLALBB.VS",
-	SMLALBB_VC:        "SMLALBB.VC",
-	SMLALBB_HI:        "SMLALBB.HI",
-	SMLALBB_LS:        "SMLALBB.LS",
-	SMLALBB_GE:        "SMLALBB.GE",
-	SMLALBB_LT:        "SMLALBB.LT",
-	SMLALBB_GT:        "SMLALBB.GT",
-	SMLALBB_LE:        "SMLALBB.LE",
-	SMLALBB:           "SMLALBB",
-	SMLALBB_ZZ:        "SMLALBB.ZZ",
-	SMLALBT_EQ:        "SMLALBT.EQ",
-	SMLALBT_NE:        "SMLALBT.NE",
-	SMLALBT_CS:        "SMLALBT.CS",
-	SMLALBT_CC:        "SMLALBT.CC",
-	SMLALBT_MI:        "SMLALBT.MI",
-	SMLALBT_PL:        "SMLALBT.PL",
-	SMLALBT_VS:        "SMLALBT.VS",
-	SMLALBT_VC:        "SMLALBT.VC",
-	SMLALBT_HI:        "SMLALBT.HI",
-	SMLALBT_LS:        "SMLALBT.LS",
-	SMLALBT_GE:        "SMLALBT.GE",
-	SMLALBT_LT:        "SMLALBT.LT",
-	SMLALBT_GT:        "SMLALBT.GT",
-	SMLALBT_LE:        "SMLALBT.LE",
-	SMLALBT:           "SMLALBT",
-	SMLALBT_ZZ:        "SMLALBT.ZZ",
-	SMLALTB_EQ:        "SMLALTB.EQ",
-	SMLALTB_NE:        "SMLALTB.NE",
-	SMLALTB_CS:        "SMLALTB.CS",
-	SMLALTB_CC:        "SMLALTB.CC",
-	SMLALTB_MI:        "SMLALTB.MI",
-	SMLALTB_PL:        "SMLALTB.PL",
-	SMLALTB_VS:        "SMLALTB.VS",
-	SMLALTB_VC:        "SMLALTB.VC",
-	SMLALTB_HI:        "SMLALTB.HI",
-	SMLALTB_LS:        "SMLALTB.LS",
-	SMLALTB_GE:        "SMLALTB.GE",
-	SMLALTB_LT:        "SMLALTB.LT",
-	SMLALTB_GT:        "SMLALTB.GT",
-	SMLALTB_LE:        "SMLALTB.LE",
-	SMLALTB:           "SMLALTB",
-	SMLALTB_ZZ:        "SMLALTB.ZZ",
-	SMLALTT_EQ:        "SMLALTT.EQ",
-	SMLALTT_NE:        "SMLALTT.NE",
-	SMLALTT_CS:        "SMLALTT.CS",
-	SMLALTT_CC:        "SMLALTT.CC",
-	SMLALTT_MI:        "SMLALTT.MI",
-	SMLALTT_PL:        "SMLALTT.PL",
-	SMLALTT_VS:        "SMLALTT.VS",
-	SMLALTT_VC:        "SMLALTT.VC",
-	SMLALTT_HI:        "SMLALTT.HI",
-	SMLALTT_LS:        "SMLALTT.LS",
-	SMLALTT_GE:        "SMLALTT.GE",
-	SMLALTT_LT:        "SMLALTT.LT",
-	SMLALTT_GT:        "SMLALTT.GT",
-	SMLALTT_LE:        "SMLALTT.LE",
-	SMLALTT:           "SMLALTT",
-	SMLALTT_ZZ:        "SMLALTT.ZZ",
-	SMLALD_EQ:         "SMLALD.EQ",
-	SMLALD_NE:         "SMLALD.NE",
-	SMLALD_CS:         "SMLALD.CS",
-	SMLALD_CC:         "SMLALD.CC",
-	SMLALD_MI:         "SMLALD.MI",
-	SMLALD_PL:         "SMLALD.PL",
-	SMLALD_VS:         "SMLALD.VS",
-	SMLALD_VC:         "SMLALD.VC",
-	SMLALD_HI:         "SMLALD.HI",
-	SMLALD_LS:         "SMLALD.LS",
-	SMLALD_GE:         "SMLALD.GE",
-	SMLALD_LT:         "SMLALD.LT",
-	SMLALD_GT:         "SMLALD.GT",
-	SMLALD_LE:         "SMLALD.LE",
-	SMLALD:            "SMLALD",
-	SMLALD_ZZ:         "SMLALD.ZZ",
-	SMLALD_X_EQ:       "SMLALD.X.EQ",
-	SMLALD_X_NE:       "SMLALD.X.NE",
-	SMLALD_X_CS:       "SMLALD.X.CS",
-	SMLALD_X_CC:       "SMLALD.X.CC",
-	SMLALD_X_MI:       "SMLALD.X.MI",
-	SMLALD_X_PL:       "SMLALD.X.PL",
-	SMLALD_X_VS:       "SMLALD.X.VS",
-	SMLALD_X_VC:       "SMLALD.X.VC",
-	SMLALD_X_HI:       "SMLALD.X.HI",
-	SMLALD_X_LS:       "SMLALD.X.LS",
-	SMLALD_X_GE:       "SMLALD.X.GE",
-	SMLALD_X_LT:       "SMLALD.X.LT",
-	SMLALD_X_GT:       "SMLALD.X.GT",
-	SMLALD_X_LE:       "SMLALD.X.LE",
-	SMLALD_X:          "SMLALD.X",
-	SMLALD_X_ZZ:       "SMLALD.X.ZZ",
-	SMLAWB_EQ:         "SMLAWB.EQ",
-	SMLAWB_NE:         "SMLAWB.NE",
-	SMLAWB_CS:         "SMLAWB.CS",
-	SMLAWB_CC:         "SMLAWB.CC",
-	SMLAWB_MI:         "SMLAWB.MI",
-	SMLAWB_PL:         "SMLAWB.PL",
-	SMLAWB_VS:         "SMLAWB.VS",
-	SMLAWB_VC:         "SMLAWB.VC",
-	SMLAWB_HI:         "SMLAWB.HI",
-	SMLAWB_LS:         "SMLAWB.LS",
-	SMLAWB_GE:         "SMLAWB.GE",
-	SMLAWB_LT:         "SMLAWB.LT",
-	SMLAWB_GT:         "SMLAWB.GT",
-	SMLAWB_LE:         "SMLAWB.LE",
-	SMLAWB:            "SMLAWB",
-	SMLAWB_ZZ:         "SMLAWB.ZZ",
-	SMLAWT_EQ:         "SMLAWT.EQ",
-	SMLAWT_NE:         "SMLAWT.NE",
-	SMLAWT_CS:         "SMLAWT.CS",
-	SMLAWT_CC:         "SMLAWT.CC",
-	SMLAWT_MI:         "SMLAWT.MI",
-	SMLAWT_PL:         "SMLAWT.PL",
-	SMLAWT_VS:         "SMLAWT.VS",
-	SMLAWT_VC:         "SMLAWT.VC",
-	SMLAWT_HI:         "SMLAWT.HI",
-	SMLAWT_LS:         "SMLAWT.LS",
-	SMLAWT_GE:         "SMLAWT.GE",
-	SMLAWT_LT:         "SMLAWT.LT",
-	SMLAWT_GT:         "SMLAWT.GT",
-	SMLAWT_LE:         "SMLAWT.LE",
-	SMLAWT:            "SMLAWT",
-	SMLAWT_ZZ:         "SMLAWT.ZZ",
-	SMLSD_EQ:          "SMLSD.EQ",
-	SMLSD_NE:          "SMLSD.NE",
-	SMLSD_CS:          "SMLSD.CS",
-	SMLSD_CC:          "SMLSD.CC",
-	SMLSD_MI:          "SMLSD.MI",
-	SMLSD_PL:          "SMLSD.PL",
-	SMLSD_VS:          "SMLSD.VS",
-	SMLSD_VC:          "SMLSD.VC",
-	SMLSD_HI:          "SMLSD.HI",
-	SMLSD_LS:          "SMLSD.LS",
-	SMLSD_GE:          "SMLSD.GE",
-	SMLSD_LT:          "SMLSD.LT",
-	SMLSD_GT:          "SMLSD.GT",
-	SMLSD_LE:          "SMLSD.LE",
-	SMLSD:             "SMLSD",
-	SMLSD_ZZ:          "SMLSD.ZZ",
-	SMLSD_X_EQ:        "SMLSD.X.EQ",
-	SMLSD_X_NE:        "SMLSD.X.NE",
-	SMLSD_X_CS:        "SMLSD.X.CS",
-	SMLSD_X_CC:        "SMLSD.X.CC",
-	SMLSD_X_MI:        "SMLSD.X.MI",
-	SMLSD_X_PL:        "SMLSD.X.PL",
-	SMLSD_X_VS:        "SMLSD.X.VS",
-	SMLSD_X_VC:        "SMLSD.X.VC",
-	SMLSD_X_HI:        "SMLSD.X.HI",
-	SMLSD_X_LS:        "SMLSD.X.LS",
-	SMLSD_X_GE:        "SMLSD.X.GE",
-	SMLSD_X_LT:        "SMLSD.X.LT",
-	SMLSD_X_GT:        "SMLSD.X.GT",
-	SMLSD_X_LE:        "SMLSD.X.LE",
-	SMLSD_X:           "SMLSD.X",
-	SMLSD_X_ZZ:        "SMLSD.X.ZZ",
-	SMLSLD_EQ:         "SMLSLD.EQ",
-	SMLSLD_NE:         "SMLSLD.NE",
-	SMLSLD_CS:         "SMLSLD.CS",
-	SMLSLD_CC:         "SMLSLD.CC",
-	SMLSLD_MI:         "SMLSLD.MI",
-	SMLSLD_PL:         "SMLSLD.PL",
-	SMLSLD_VS:         "SMLSLD.VS",
-	SMLSLD_VC:         "SMLSLD.VC",
-	SMLSLD_HI:         "SMLSLD.HI",
-	SMLSLD_LS:         "SMLSLD.LS",
-	SMLSLD_GE:         "SMLSLD.GE",
-	SMLSLD_LT:         "SMLSLD.LT",
-	SMLSLD_GT:         "SMLSLD.GT",
-	SMLSLD_LE:         "SMLSLD.LE",
-	SMLSLD:            "SMLSLD",
-	SMLSLD_ZZ:         "SMLSLD.ZZ",
-	SMLSLD_X_EQ:       "SMLSLD.X.EQ",
-	SMLSLD_X_NE:       "SMLSLD.X.NE",
-	SMLSLD_X_CS:       "SMLSLD.X.CS",
-	SMLSLD_X_CC:       "SMLSLD.X.CC",
-	SMLSLD_X_MI:       "SMLSLD.X.MI",
-	SMLSLD_X_PL:       "SMLSLD.X.PL",
-	SMLSLD_X_VS:       "SMLSLD.X.VS",
-	SMLSLD_X_VC:       "SMLSLD.X.VC",
-	SMLSLD_X_HI:       "SMLSLD.X.HI",
-	SMLSLD_X_LS:       "SMLSLD.X.LS",
-	SMLSLD_X_GE:       "SMLSLD.X.GE",
-	SMLSLD_X_LT:       "SMLSLD.X.LT",
-	SMLSLD_X_GT:       "SMLSLD.X.GT",
-	SMLSLD_X_LE:       "SMLSLD.X.LE",
-	SMLSLD_X:          "SMLSLD.X",
-	SMLSLD_X_ZZ:       "SMLSLD.X.ZZ",
-	SMMLA_EQ:          "SMMLA.EQ",
-	SMMLA_NE:          "SMMLA.NE",
-	SMMLA_CS:          "SMMLA.CS",
-	SMMLA_CC:          "SMMLA.CC",
-	SMMLA_MI:          "SMMLA.MI",
-	SMMLA_PL:          "SMMLA.PL",
-	SMMLA_VS:          "SMMLA.VS",
-	SMMLA_VC:          "SMMLA.VC",
-	SMMLA_HI:          "SMMLA.HI",
-	SMMLA_LS:          "SMMLA.LS",
-	SMMLA_GE:          "SMMLA.GE",
-	SMMLA_LT:          "SMMLA.LT",
-	SMMLA_GT:          "SMMLA.GT",
-	SMMLA_LE:          "SMMLA.LE",
-	SMMLA:             "SMMLA",
-	SMMLA_ZZ:          "SMMLA.ZZ",
-	SMMLA_R_EQ:        "SMMLA.R.EQ",
-	SMMLA_R_NE:        "SMMLA.R.NE",
-	SMMLA_R_CS:        "SMMLA.R.CS",
-	SMMLA_R_CC:        "SMMLA.R.CC",
-	SMMLA_R_MI:        "SMMLA.R.MI",
-	SMMLA_R_PL:        "SMMLA.R.PL",
-	SMMLA_R_VS:        "SMMLA.R.VS",
-	SMMLA_R_VC:        "SMMLA.R.VC",
-	SMMLA_R_HI:        "SMMLA.R.HI",
-	SMMLA_R_LS:        "SMMLA.R.LS",
-	SMMLA_R_GE:        "SMMLA.R.GE",
-	SMMLA_R_LT:        "SMMLA.R.LT",
-	SMMLA_R_GT:        "SMMLA.R.GT",
-	SMMLA_R_LE:        "SMMLA.R.LE",
-	SMMLA_R:           "SMMLA.R",
-	SMMLA_R_ZZ:        "SMMLA.R.ZZ",
-	SMMLS_EQ:          "SMMLS.EQ",
-	SMMLS_NE:          "SMMLS.NE",
-	SMMLS_CS:          "SMMLS.CS",
-	SMMLS_CC:          "SMMLS.CC",
-	SMMLS_MI:          "SMMLS.MI",
-	SMMLS_PL:          "SMMLS.PL",
-	SMMLS_VS:          "SMMLS.VS",
-	SMMLS_VC:          "SMMLS.VC",
-	SMMLS_HI:          "SMMLS.HI",
-	SMMLS_LS:          "SMMLS.LS",
-	SMMLS_GE:          "SMMLS.GE",
-	SMMLS_LT:          "SMMLS.LT",
-	SMMLS_GT:          "SMMLS.GT",
-	SMMLS_LE:          "SMMLS.LE",
-	SMMLS:             "SMMLS",
-	SMMLS_ZZ:          "SMMLS.ZZ",
-	SMMLS_R_EQ:        "SMMLS.R.EQ",
-	SMMLS_R_NE:        "SMMLS.R.NE",
-	SMMLS_R_CS:        "SMMLS.R.CS",
-	SMMLS_R_CC:        "SMMLS.R.CC",
-	SMMLS_R_MI:        "SMMLS.R.MI",
-	SMMLS_R_PL:        "SMMLS.R.PL",
-	SMMLS_R_VS:        "SMMLS.R.VS",
-	SMMLS_R_VC:        "SMMLS.R.VC",
-	SMMLS_R_HI:        "SMMLS.R.HI",
-	SMMLS_R_LS:        "SMMLS.R.LS",
-	SMMLS_R_GE:        "SMMLS.R.GE",
-	SMMLS_R_LT:        "SMMLS.R.LT",
-	SMMLS_R_GT:        "SMMLS.R.GT",
-	SMMLS_R_LE:        "SMMLS.R.LE",
-	SMMLS_R:           "SMMLS.R",
-	SMMLS_R_ZZ:        "SMMLS.R.ZZ",
-	SMMUL_EQ:          "SMMUL.EQ",
-	SMMUL_NE:          "SMMUL.NE",
-	SMMUL_CS:          "SMMUL.CS",
-	SMMUL_CC:          "SMMUL.CC",
-	SMMUL_MI:          "SMMUL.MI",
-	SMMUL_PL:          "SMMUL.PL",
-	SMMUL_VS:          "SMMUL.VS",
-	SMMUL_VC:          "SMMUL.VC",
-	SMMUL_HI:          "SMMUL.HI",
-	SMMUL_LS:          "SMMUL.LS",
-	SMMUL_GE:          "SMMUL.GE",
-	SMMUL_LT:          "SMMUL.LT",
-	SMMUL_GT:          "SMMUL.GT",
-	SMMUL_LE:          "SMMUL.LE",
-	SMMUL:             "SMMUL",
-	SMMUL_ZZ:          "SMMUL.ZZ",
-	SMMUL_R_EQ:        "SMMUL.R.EQ",
-	SMMUL_R_NE:        "SMMUL.R.NE",
-	SMMUL_R_CS:        "SMMUL.R.CS",
-	SMMUL_R_CC:        "SMMUL.R.CC",
-	SMMUL_R_MI:        "SMMUL.R.MI",
-	SMMUL_R_PL:        "SMMUL.R.PL",
-	SMMUL_R_VS:        "SMMUL.R.VS",
-	SMMUL_R_VC:        "SMMUL.R.VC",
-	SMMUL_R_HI:        "SMMUL.R.HI",
-	SMMUL_R_LS:        "SMMUL.R.LS",
-	SMMUL_R_GE:        "SMMUL.R.GE",
-	SMMUL_R_LT:        "SMMUL.R.LT",
-	SMMUL_R_GT:        "SMMUL.R.GT",
-	SMMUL_R_LE:        "SMMUL.R.LE",
-	SMMUL_R:           "SMMUL.R",
-	SMMUL_R_ZZ:        "SMMUL.R.ZZ",
-	SMUAD_EQ:          "SMUAD.EQ",
-	SMUAD_NE:          "SMUAD.NE",
-	SMUAD_CS:          "SMUAD.CS",
-	SMUAD_CC:          "SMUAD.CC",
-	SMUAD_MI:          "SMUAD.MI",
-	SMUAD_PL:          "SMUAD.PL",
-	SMUAD_VS:          "SMUAD.VS",
-	SMUAD_VC:          "SMUAD.VC",
-	SMUAD_HI:          "SMUAD.HI",
-	SMUAD_LS:          "SMUAD.LS",
-	SMUAD_GE:          "SMUAD.GE",
-	SMUAD_LT:          "SMUAD.LT",
-	SMUAD_GT:          "SMUAD.GT",
-	SMUAD_LE:          "SMUAD.LE",
-	SMUAD:             "SMUAD",
-	SMUAD_ZZ:          "SMUAD.ZZ",
-	SMUAD_X_EQ:        "SMUAD.X.EQ",
-	SMUAD_X_NE:        "SMUAD.X.NE",
-	SMUAD_X_CS:        "SMUAD.X.CS",
-	SMUAD_X_CC:        "SMUAD.X.CC",
-	SMUAD_X_MI:        "SMUAD.X.MI",
-	SMUAD_X_PL:        "SMUAD.X.PL",
-	SMUAD_X_VS:        "SMUAD.X.VS",
-	SMUAD_X_VC:        "SMUAD.X.VC",
-	SMUAD_X_HI:        "SMUAD.X.HI",
-	SMUAD_X_LS:        "SMUAD.X.LS",
-	SMUAD_X_GE:        "SMUAD.X.GE",
-	SMUAD_X_LT:        "SMUAD.X.LT",
-	SMUAD_X_GT:        "SMUAD.X.GT",
-	SMUAD_X_LE:        "SMUAD.X.LE",
-	SMUAD_X:           "SMUAD.X",
-	SMUAD_X_ZZ:        "SMUAD.X.ZZ",
-	SMULBB_EQ:         "SMULBB.EQ",
-	SMULBB_NE:         "SMULBB.NE",
-	SMULBB_CS:         "SMULBB.CS",
-	SMULBB_CC:         "SMULBB.CC",
-	SMULBB_MI:         "SMULBB.MI",
-	SMULBB_PL:         "SMULBB.PL",
-	SMULBB_VS:         "SMULBB.VS",
-	SMULBB_VC:         "SMULBB.VC",
-	SMULBB_HI:         "SMULBB.HI",
-	SMULBB_LS:         "SMULBB.LS",
-	SMULBB_GE:         "SMULBB.GE",
-	SMULBB_LT:         "SMULBB.LT",
-	SMULBB_GT:         "SMULBB.GT",
-	SMULBB_LE:         "SMULBB.LE",
-	SMULBB:            "SMULBB",
-	SMULBB_ZZ:         "SMULBB.ZZ",
-	SMULBT_EQ:         "SMULBT.EQ",
-	SMULBT_NE:         "SMULBT.NE",
-	SMULBT_CS:         "SMULBT.CS",
-	SMULBT_CC:         "SMULBT.CC",
-	SMULBT_MI:         "SMULBT.MI",
-	SMULBT_PL:         "SMULBT.PL",
-	SMULBT_VS:         "SMULBT.VS",
-	SMULBT_VC:         "SMULBT.VC",
-	SMULBT_HI:         "SMULBT.HI",
-	SMULBT_LS:         "SMULBT.LS",
-	SMULBT_GE:         "SMULBT.GE",
-	SMULBT_LT:         "SMULBT.LT",
-	SMULBT_GT:         "SMULBT.GT",
-	SMULBT_LE:         "SMULBT.LE",
-	SMULBT:            "SMULBT",
-	SMULBT_ZZ:         "SMULBT.ZZ",
-	SMULTB_EQ:         "SMULTB.EQ",
-	SMULTB_NE:         "SMULTB.NE",
-	SMULTB_CS:         "SMULTB.CS",
-	SMULTB_CC:         "SMULTB.CC",
-	SMULTB_MI:         "SMULTB.MI",
-	SMULTB_PL:         "SMULTB.PL",
-	SMULTB_VS:         "SMULTB.VS",
-	SMULTB_VC:         "SMULTB.VC",
-	SMULTB_HI:         "SMULTB.HI",
-	SMULTB_LS:         "SMULTB.LS",
-	SMULTB_GE:         "SMULTB.GE",
-	SMULTB_LT:         "SMULTB.LT",
-	SMULTB_GT:         "SMULTB.GT",
-	SMULTB_LE:         "SMULTB.LE",
-	SMULTB:            "SMULTB",
-	SMULTB_ZZ:         "SMULTB.ZZ",
-	SMULTT_EQ:         "SMULTT.EQ",
-	SMULTT_NE:         "SMULTT.NE",
-	SMULTT_CS:         "SMULTT.CS",
-	SMULTT_CC:         "SMULTT.CC",
-	SMULTT_MI:         "SMULTT.MI",
-	SMULTT_PL:         "SMULTT.PL",
-	SMULTT_VS:         "SMULTT.VS",
-	SMULTT_VC:         "SMULTT.VC",
-	SMULTT_HI:         "SMULTT.HI",
-	SMULTT_LS:         "SMULTT.LS",
-	SMULTT_GE:         "SMULTT.GE",
-	SMULTT_LT:         "SMULTT.LT",
-	SMULTT_GT:         "SMULTT.GT",
-	SMULTT_LE:         "SMULTT.LE",
-	SMULTT:            "SMULTT",
-	SMULTT_ZZ:         "SMULTT.ZZ",
-	SMULL_EQ:          "SMULL.EQ",
-	SMULL_NE:          "SMULL.NE",
-	SMULL_CS:          "SMULL.CS",
-	SMULL_CC:          "SMULL.CC",
-	SMULL_MI:          "SMULL.MI",
-	SMULL_PL:          "SMULL.PL",
-	SMULL_VS:          "SMULL.VS",
-	SMULL_VC:          "SMULL.VC",
-	SMULL_HI:          "SMULL.HI",
-	SMULL_LS:          "SMULL.LS",
-	SMULL_GE:          "SMULL.GE",
-	SMULL_LT:          "SMULL.LT",
-	SMULL_GT:          "SMULL.GT",
-	SMULL_LE:          "SMULL.LE",
-	SMULL:             "SMULL",
-	SMULL_ZZ:          "SMULL.ZZ",
-	SMULL_S_EQ:        "SMULL.S.EQ",
-	SMULL_S_NE:        "SMULL.S.NE",
-	SMULL_S_CS:        "SMULL.S.CS",
-	SMULL_S_CC:        "SMULL.S.CC",
-	SMULL_S_MI:        "SMULL.S.MI",
-	SMULL_S_PL:        "SMULL.S.PL",
-	SMULL_S_VS:        "SMULL.S.VS",
-	SMULL_S_VC:        "SMULL.S.VC",
-	SMULL_S_HI:        "SMULL.S.HI",
-	SMULL_S_LS:        "SMULL.S.LS",
-	SMULL_S_GE:        "SMULL.S.GE",
-	SMULL_S_LT:        "SMULL.S.LT",
-	SMULL_S_GT:        "SMULL.S.GT",
-	SMULL_S_LE:        "SMULL.S.LE",
-	SMULL_S:           "SMULL.S",
-	SMULL_S_ZZ:        "SMULL.S.ZZ",
-	SMULWB_EQ:         "SMULWB.EQ",
-	SMULWB_NE:         "SMULWB.NE",
-	SMULWB_CS:         "SMULWB.CS",
-	SMULWB_CC:         "SMULWB.CC",
-	SMULWB_MI:         "SMULWB.MI",
-	SMULWB_PL:         "SMULWB.PL",
-	SMULWB_VS:         "SMULWB.VS",
-	SMULWB_VC:         "SMULWB.VC",
-	SMULWB_HI:         "SMULWB.HI",
-	SMULWB_LS:         "SMULWB.LS",
-	SMULWB_GE:         "SMULWB.GE",
-	SMULWB_LT:         "SMULWB.LT",
-	SMULWB_GT:         "SMULWB.GT",
-	SMULWB_LE:         "SMULWB.LE",
-	SMULWB:            "SMULWB",
-	SMULWB_ZZ:         "SMULWB.ZZ",
-	SMULWT_EQ:         "SMULWT.EQ",
-	SMULWT_NE:         "SMULWT.NE",
-	SMULWT_CS:         "SMULWT.CS",
-	SMULWT_CC:         "SMULWT.CC",
-	SMULWT_MI:         "SMULWT.MI",
-	SMULWT_PL:         "SMULWT.PL",
-	SMULWT_VS:         "SMULWT.VS",
-	SMULWT_VC:         "SMULWT.VC",
-	SMULWT_HI:         "SMULWT.HI",
-	SMULWT_LS:         "SMULWT.LS",
-	SMULWT_GE:         "SMULWT.GE",
-	SMULWT_LT:         "SMULWT.LT",
-	SMULWT_GT:         "SMULWT.GT",
-	SMULWT_LE:         "SMULWT.LE",
-	SMULWT:            "SMULWT",
-	SMULWT_ZZ:         "SMULWT.ZZ",
-	SMUSD_EQ:          "SMUSD.EQ",
-	SMUSD_NE:          "SMUSD.NE",
-	SMUSD_CS:          "SMUSD.CS",
-	SMUSD_CC:          "SMUSD.CC",
-	SMUSD_MI:          "SMUSD.MI",
-	SMUSD_PL:          "SMUSD.PL",
-	SMUSD_VS:          "SMUSD.VS",
-	SMUSD_VC:          "SMUSD.VC",
-	SMUSD_HI:          "SMUSD.HI",
-	SMUSD_LS:          "SMUSD.LS",
-	SMUSD_GE:          "SMUSD.GE",
-	SMUSD_LT:          "SMUSD.LT",
-	SMUSD_GT:          "SMUSD.GT",
-	SMUSD_LE:          "SMUSD.LE",
-	SMUSD:             "SMUSD",
-	SMUSD_ZZ:          "SMUSD.ZZ",
-	SMUSD_X_EQ:        "SMUSD.X.EQ",
-	SMUSD_X_NE:        "SMUSD.X.NE",
-	SMUSD_X_CS:        "SMUSD.X.CS",
-	SMUSD_X_CC:        "SMUSD.X.CC",
-	SMUSD_X_MI:        "SMUSD.X.MI",
-	SMUSD_X_PL:        "SMUSD.X.PL",
-	SMUSD_X_VS:        "SMUSD.X.VS",
-	SMUSD_X_VC:        "SMUSD.X.VC",
-	SMUSD_X_HI:        "SMUSD.X.HI",
-	SMUSD_X_LS:        "SMUSD.X.LS",
-	SMUSD_X_GE:        "SMUSD.X.GE",
-	SMUSD_X_LT:        "SMUSD.X.LT",
-	SMUSD_X_GT:        "SMUSD.X.GT",
-	SMUSD_X_LE:        "SMUSD.X.LE",
-	SMUSD_X:           "SMUSD.X",
-	SMUSD_X_ZZ:        "SMUSD.X.ZZ",
-	SSAT_EQ:           "SSAT.EQ",
-	SSAT_NE:           "SSAT.NE",
-	SSAT_CS:           "SSAT.CS",
-	SSAT_CC:           "SSAT.CC",
-	SSAT_MI:           "SSAT.MI",
-	SSAT_PL:           "SSAT.PL",
-	SSAT_VS:           "SSAT.VS",
-	SSAT_VC:           "SSAT.VC",
-	SSAT_HI:           "SSAT.HI",
-	SSAT_LS:           "SSAT.LS",
-	SSAT_GE:           "SSAT.GE",
-	SSAT_LT:           "SSAT.LT",
-	SSAT_GT:           "SSAT.GT",
-	SSAT_LE:           "SSAT.LE",
-	SSAT:              "SSAT",
-	SSAT_ZZ:           "SSAT.ZZ",
-	SSAT16_EQ:         "SSAT16.EQ",
-	SSAT16_NE:         "SSAT16.NE",
-	SSAT16_CS:         "SSAT16.CS",
-	SSAT16_CC:         "SSAT16.CC",
-	SSAT16_MI:         "SSAT16.MI",
-	SSAT16_PL:         "SSAT16.PL",
-	SSAT16_VS:         "SSAT16.VS",
-	SSAT16_VC:         "SSAT16.VC",
-	SSAT16_HI:         "SSAT16.HI",
-	SSAT16_LS:         "SSAT16.LS",
-	SSAT16_GE:         "SSAT16.GE",
-	SSAT16_LT:         "SSAT16.LT",
-	SSAT16_GT:         "SSAT16.GT",
-	SSAT16_LE:         "SSAT16.LE",
-	SSAT16:            "SSAT16",
-	SSAT16_ZZ:         "SSAT16.ZZ",
-	SSAX_EQ:           "SSAX.EQ",
-	SSAX_NE:           "SSAX.NE",
-	SSAX_CS:           "SSAX.CS",
-	SSAX_CC:           "SSAX.CC",
-	SSAX_MI:           "SSAX.MI",
-	SSAX_PL:           "SSAX.PL",
-	SSAX_VS:           "SSAX.VS",
-	SSAX_VC:           "SSAX.VC",
-	SSAX_HI:           "SSAX.HI",
-	SSAX_LS:           "SSAX.LS",
-	SSAX_GE:           "SSAX.GE",
-	SSAX_LT:           "SSAX.LT",
-	SSAX_GT:           "SSAX.GT",
-	SSAX_LE:           "SSAX.LE",
-	SSAX:              "SSAX",
-	SSAX_ZZ:           "SSAX.ZZ",
-	SSUB16_EQ:         "SSUB16.EQ",
-	SSUB16_NE:         "SSUB16.NE",
-	SSUB16_CS:         "SSUB16.CS",
-	SSUB16_CC:         "SSUB16.CC",
-	SSUB16_MI:         "SSUB16.MI",
-	SSUB16_PL:         "SSUB16.PL",
-	SSUB16_VS:         "SSUB16.VS",
-	SSUB16_VC:         "SSUB16.VC",
-	SSUB16_HI:         "SSUB16.HI",
-	SSUB16_LS:         "SSUB16.LS",
-	SSUB16_GE:         "SSUB16.GE",
-	SSUB16_LT:         "SSUB16.LT",
-	SSUB16_GT:         "SSUB16.GT",
-	SSUB16_LE:         "SSUB16.LE",
-	SSUB16:            "SSUB16",
-	SSUB16_ZZ:         "SSUB16.ZZ",
-	SSUB8_EQ:          "SSUB8.EQ",
-	SSUB8_NE:          "SSUB8.NE",
-	SSUB8_CS:          "SSUB8.CS",
-	SSUB8_CC:          "SSUB8.CC",
-	SSUB8_MI:          "SSUB8.MI",
-	SSUB8_PL:          "SSUB8.PL",
-	SSUB8_VS:          "SSUB8.VS",
-	SSUB8_VC:          "SSUB8.VC",
-	SSUB8_HI:          "SSUB8.HI",
-	SSUB8_LS:          "SSUB8.LS",
-	SSUB8_GE:          "SSUB8.GE",
-	SSUB8_LT:          "SSUB8.LT",
-	SSUB8_GT:          "SSUB8.GT",
-	SSUB8_LE:          "SSUB8.LE",
-	SSUB8:             "SSUB8",
-	SSUB8_ZZ:          "SSUB8.ZZ",
-	STM_EQ:            "STM.EQ",
-	STM_NE:            "STM.NE",
-	STM_CS:            "STM.CS",
-	STM_CC:            "STM.CC",
-	STM_MI:            "STM.MI",
-	STM_PL:            "STM.PL",
-	STM_VS:            "STM.VS",
-	STM_VC:            "STM.VC",
-	STM_HI:            "STM.HI",
-	STM_LS:            "STM.LS",
-	STM_GE:            "STM.GE",
-	STM_LT:            "STM.LT",
-	STM_GT:            "STM.GT",
-	STM_LE:            "STM.LE",
-	STM:               "STM",
-	STM_ZZ:            "STM.ZZ",
-	STMDA_EQ:          "STMDA.EQ",
-	STMDA_NE:          "STMDA.NE",
-	STMDA_CS:          "STMDA.CS",
-	STMDA_CC:          "STMDA.CC",
-	STMDA_MI:          "STMDA.MI",
-	STMDA_PL:          "STMDA.PL",
-	STMDA_VS:          "STMDA.VS",
-	STMDA_VC:          "STMDA.VC",
-	STMDA_HI:          "STMDA.HI",
-	STMDA_LS:          "STMDA.LS",
-	STMDA_GE:          "STMDA.GE",
-	STMDA_LT:          "STMDA.LT",
-	STMDA_GT:          "STMDA.GT",
-	STMDA_LE:          "STMDA.LE",
-	STMDA:             "STMDA",
-	STMDA_ZZ:          "STMDA.ZZ",
-	STMDB_EQ:          "STMDB.EQ",
-	STMDB_NE:          "STMDB.NE",
-	STMDB_CS:          "STMDB.CS",
-	STMDB_CC:          "STMDB.CC",
-	STMDB_MI:          "STMDB.MI",
-	STMDB_PL:          "STMDB.PL",
-	STMDB_VS:          "STMDB.VS",
-	STMDB_VC:          "STMDB.VC",
-	STMDB_HI:          "STMDB.HI",
-	STMDB_LS:          "STMDB.LS",
-	STMDB_GE:          "STMDB.GE",
-	STMDB_LT:          "STMDB.LT",
-	STMDB_GT:          "STMDB.GT",
-	STMDB_LE:          "STMDB.LE",
-	STMDB:             "STMDB",
-	STMDB_ZZ:          "STMDB.ZZ",
-	STMIB_EQ:          "STMIB.EQ",
-	STMIB_NE:          "STMIB.NE",
-	STMIB_CS:          "STMIB.CS",
-	STMIB_CC:          "STMIB.CC",
-	STMIB_MI:          "STMIB.MI",
-	STMIB_PL:          "STMIB.PL",
-	STMIB_VS:          "STMIB.VS",
-	STMIB_VC:          "STMIB.VC",
-	STMIB_HI:          "STMIB.HI",
-	STMIB_LS:          "STMIB.LS",
-	STMIB_GE:          "STMIB.GE",
-	STMIB_LT:          "STMIB.LT",
-	STMIB_GT:          "STMIB.GT",
-	STMIB_LE:          "STMIB.LE",
-	STMIB:             "STMIB",
-	STMIB_ZZ:          "STMIB.ZZ",
-	STR_EQ:            "STR.EQ",
-	STR_NE:            "STR.NE",
-	STR_CS:            "STR.CS",
-	STR_CC:            "STR.CC",
-	STR_MI:            "STR.MI",
-	STR_PL:            "STR.PL",
-	STR_VS:            "STR.VS",
-	STR_VC:            "STR.VC",
-	STR_HI:            "STR.HI",
-	STR_LS:            "STR.LS",
-	STR_GE:            "STR.GE",
-	STR_LT:            "STR.LT",
-	STR_GT:            "STR.GT",
-	STR_LE:            "STR.LE",
-	STR:               "STR",
-	STR_ZZ:            "STR.ZZ",
-	STRB_EQ:           "STRB.EQ",
-	STRB_NE:           "STRB.NE",
-	STRB_CS:           "STRB.CS",
-	STRB_CC:           "STRB.CC",
-	STRB_MI:           "STRB.MI",
-	STRB_PL:           "STRB.PL",
-	STRB_VS:           "STRB.VS",
-	STRB_VC:           "STRB.VC",
-	STRB_HI:           "STRB.HI",
-	STRB_LS:           "STRB.LS",
-	STRB_GE:           "STRB.GE",
-	STRB_LT:           "STRB.LT",
-	STRB_GT:           "STRB.GT",
-	STRB_LE:           "STRB.LE",
-	STRB:              "STRB",
-	STRB_ZZ:           "STRB.ZZ",
-	STRBT_EQ:          "STRBT.EQ",
-	STRBT_NE:          "STRBT.NE",
-	STRBT_CS:          "STRBT.CS",
-	STRBT_CC:          "STRBT.CC",
-	STRBT_MI:          "STRBT.MI",
-	STRBT_PL:          "STRBT.PL",
-	STRBT_VS:          "STRBT.VS",
-	STRBT_VC:          "STRBT.VC",
-	STRBT_HI:          "STRBT.HI",
-	STRBT_LS:          "STRBT.LS",
-	STRBT_GE:          "STRBT.GE",
-	STRBT_LT:          "STRBT.LT",
-	STRBT_GT:          "STRBT.GT",
-	STRBT_LE:          "STRBT.LE",
-	STRBT:             "STRBT",
-	STRBT_ZZ:          "STRBT.ZZ",
-	STRD_EQ:           "STRD.EQ",
-	STRD_NE:           "STRD.NE",
-	STRD_CS:           "STRD.CS",
-	STRD_CC:           "STRD.CC",
-	STRD_MI:           "STRD.MI",
-	STRD_PL:           "STRD.PL",
-	STRD_VS:           "STRD.VS",
-	STRD_VC:           "STRD.VC",
-	STRD_HI:           "STRD.HI",
-	STRD_LS:           "STRD.LS",
-	STRD_GE:           "STRD.GE",
-	STRD_LT:           "STRD.LT",
-	STRD_GT:           "STRD.GT",
-	STRD_LE:           "STRD.LE",
-	STRD:              "STRD",
-	STRD_ZZ:           "STRD.ZZ",
-	STREX_EQ:          "STREX.EQ",
-	STREX_NE:          "STREX.NE",
-	STREX_CS:          "STREX.CS",
-	STREX_CC:          "STREX.CC",
-	STREX_MI:          "STREX.MI",
-	STREX_PL:          "STREX.PL",
-	STREX_VS:          "STREX.VS",
-	STREX_VC:          "STREX.VC",
-	STREX_HI:          "STREX.HI",
-	STREX_LS:          "STREX.LS",
-	STREX_GE:          "STREX.GE",
-	STREX_LT:          "STREX.LT",
-	STREX_GT:          "STREX.GT",
-	STREX_LE:          "STREX.LE",
-	STREX:             "STREX",
-	STREX_ZZ:          "STREX.ZZ",
-	STREXB_EQ:         "STREXB.EQ",
-	STREXB_NE:         "STREXB.NE",
-	STREXB_CS:         "STREXB.CS",
-	STREXB_CC:         "STREXB.CC",
-	STREXB_MI:         "STREXB.MI",
-	STREXB_PL:         "STREXB.PL",
-	STREXB_VS:         "STREXB.VS",
-	STREXB_VC:         "STREXB.VC",
-	STREXB_HI:         "STREXB.HI",
-	STREXB_LS:         "STREXB.LS",
-	STREXB_GE:         "STREXB.GE",
-	STREXB_LT:         "STREXB.LT",
-	STREXB_GT:         "STREXB.GT",
-	STREXB_LE:         "STREXB.LE",
-	STREXB:            "STREXB",
-	STREXB_ZZ:         "STREXB.ZZ",
-	STREXD_EQ:         "STREXD.EQ",
-	STREXD_NE:         "STREXD.NE",
-	STREXD_CS:         "STREXD.CS",
-	STREXD_CC:         "STREXD.CC",
-	STREXD_MI:         "STREXD.MI",
-	STREXD_PL:         "STREXD.PL",
-	STREXD_VS:         "STREXD.VS",
-	STREXD_VC:         "STREXD.VC",
-	STREXD_HI:         "STREXD.HI",
-	STREXD_LS:         "STREXD.LS",
-	STREXD_GE:         "STREXD.GE",
-	STREXD_LT:         "STREXD.LT",
-	STREXD_GT:         "STREXD.GT",
-	STREXD_LE:         "STREXD.LE",
-	STREXD:            "STREXD",
-	STREXD_ZZ:         "STREXD.ZZ",
-	STREXH_EQ:         "STREXH.EQ",
-	STREXH_NE:         "STREXH.NE",
-	STREXH_CS:         "STREXH.CS",
-	STREXH_CC:         "STREXH.CC",
-	STREXH_MI:         "STREXH.MI",
-	STREXH_PL:         "STREXH.PL",
-	STREXH_VS:         "STREXH.VS",
-	STREXH_VC:         "STREXH.VC",
-	STREXH_HI:         "STREXH.HI",
-	STREXH_LS:         "STREXH.LS",
-	STREXH_GE:         "STREXH.GE",
-	STREXH_LT:         "STREXH.LT",
-	STREXH_GT:         "STREXH.GT",
-	STREXH_LE:         "STREXH.LE",
-	STREXH:            "STREXH",
-	STREXH_ZZ:         "STREXH.ZZ",
-	STRH_EQ:           "STRH.EQ",
-	STRH_NE:           "STRH.NE",
-	STRH_CS:           "STRH.CS",
-	STRH_CC:           "STRH.CC",
-	STRH_MI:           "STRH.MI",
-	STRH_PL:           "STRH.PL",
-	STRH_VS:           "STRH.VS",
-	STRH_VC:           "STRH.VC",
-	STRH_HI:           "STRH.HI",
-	STRH_LS:           "STRH.LS",
-	STRH_GE:           "STRH.GE",
-	STRH_LT:           "STRH.LT",
-	STRH_GT:           "STRH.GT",
-	STRH_LE:           "STRH.LE",
-	STRH:              "STRH",
-	STRH_ZZ:           "STRH.ZZ",
-	STRHT_EQ:          "STRHT.EQ",
-	STRHT_NE:          "STRHT.NE",
-	STRHT_CS:          "STRHT.CS",
-	STRHT_CC:          "STRHT.CC",
-	STRHT_MI:          "STRHT.MI",
-	STRHT_PL:          "STRHT.PL",
-	STRHT_VS:          "STRHT.VS",
-	STRHT_VC:          "STRHT.VC",
-	STRHT_HI:          "STRHT.HI",
-	STRHT_LS:          "STRHT.LS",
-	STRHT_GE:          "STRHT.GE",
-	STRHT_LT:          "STRHT.LT",
-	STRHT_GT:          "STRHT.GT",
-	STRHT_LE:          "STRHT.LE",
-	STRHT:             "STRHT",
-	STRHT_ZZ:          "STRHT.ZZ",
-	STRT_EQ:           "STRT.EQ",
-	STRT_NE:           "STRT.NE",
-	STRT_CS:           "STRT.CS",
-	STRT_CC:           "STRT.CC",
-	STRT_MI:           "STRT.MI",
-	STRT_PL:           "STRT.PL",
-	STRT_VS:           "STRT.VS",
-	STRT_VC:           "STRT.VC",
-	STRT_HI:           "STRT.HI",
-	STRT_LS:           "STRT.LS",
-	STRT_GE:           "STRT.GE",
-	STRT_LT:           "STRT.LT",
-	STRT_GT:           "STRT.GT",
-	STRT_LE:           "STRT.LE",
-	STRT:              "STRT",
-	STRT_ZZ:           "STRT.ZZ",
-	SUB_EQ:            "SUB.EQ",
-	SUB_NE:            "SUB.NE",
-	SUB_CS:            "SUB.CS",
-	SUB_CC:            "SUB.CC",
-	SUB_MI:            "SUB.MI",
-	SUB_PL:            "SUB.PL",
-	SUB_VS:            "SUB.VS",
-	SUB_VC:            "SUB.VC",
-	SUB_HI:            "SUB.HI",
-	SUB_LS:            "SUB.LS",
-	SUB_GE:            "SUB.GE",
-	SUB_LT:            "SUB.LT",
-	SUB_GT:            "SUB.GT",
-	SUB_LE:            "SUB.LE",
-	SUB:               "SUB",
-	SUB_ZZ:            "SUB.ZZ",
-	SUB_S_EQ:          "SUB.S.EQ",
-	SUB_S_NE:          "SUB.S.NE",
-	SUB_S_CS:          "SUB.S.CS",
-	SUB_S_CC:          "SUB.S.CC",
-	SUB_S_MI:          "SUB.S.MI",
-	SUB_S_PL:          "SUB.S.PL",
-	SUB_S_VS:          "SUB.S.VS",
-	SUB_S_VC:          "SUB.S.VC",
-	SUB_S_HI:          "SUB.S.HI",
-	SUB_S_LS:          "SUB.S.LS",
-	SUB_S_GE:          "SUB.S.GE",
-	SUB_S_LT:          "SUB.S.LT",
-	SUB_S_GT:          "SUB.S.GT",
-	SUB_S_LE:          "SUB.S.LE",
-	SUB_S:             "SUB.S",
-	SUB_S_ZZ:          "SUB.S.ZZ",
-	SVC_EQ:            "SVC.EQ",
-	SVC_NE:            "SVC.NE",
-	SVC_CS:            "SVC.CS",
-	SVC_CC:            "SVC.CC",
-	SVC_MI:            "SVC.MI",
-	SVC_PL:            "SVC.PL",
-	SVC_VS:            "SVC.VS",
-	SVC_VC:            "SVC.VC",
-	SVC_HI:            "SVC.HI",
-	SVC_LS:            "SVC.LS",
-	SVC_GE:            "SVC.GE",
-	SVC_LT:            "SVC.LT",
-	SVC_GT:            "SVC.GT",
-	SVC_LE:            "SVC.LE",
-	SVC:               "SVC",
-	SVC_ZZ:            "SVC.ZZ",
-	SWP_EQ:            "SWP.EQ",
-	SWP_NE:            "SWP.NE",
-	SWP_CS:            "SWP.CS",
-	SWP_CC:            "SWP.CC",
-	SWP_MI:            "SWP.MI",
-	SWP_PL:            "SWP.PL",
-	SWP_VS:            "SWP.VS",
-	SWP_VC:            "SWP.VC",
-	SWP_HI:            "SWP.HI",
-	SWP_LS:            "SWP.LS",
-	SWP_GE:            "SWP.GE",
-	SWP_LT:            "SWP.LT",
-	SWP_GT:            "SWP.GT",
-	SWP_LE:            "SWP.LE",
-	SWP:               "SWP",
-	SWP_ZZ:            "SWP.ZZ",
-	SWP_B_EQ:          "SWP.B.EQ",
-	SWP_B_NE:          "SWP.B.NE",
-	SWP_B_CS:          "SWP.B.CS",
-	SWP_B_CC:          "SWP.B.CC",
-	SWP_B_MI:          "SWP.B.MI",
-	SWP_B_PL:          "SWP.B.PL",
-	SWP_B_VS:          "SWP.B.VS",
-	SWP_B_VC:          "SWP.B.VC",
-	SWP_B_HI:          "SWP.B.HI",
-	SWP_B_LS:          "SWP.B.LS",
-	SWP_B_GE:          "SWP.B.GE",
-	SWP_B_LT:          "SWP.B.LT",
-	SWP_B_GT:          "SWP.B.GT",
-	SWP_B_LE:          "SWP.B.LE",
-	SWP_B:             "SWP.B",
-	SWP_B_ZZ:          "SWP.B.ZZ",
-	SXTAB_EQ:          "SXTAB.EQ",
-	SXTAB_NE:          "SXTAB.NE",
-	SXTAB_CS:          "SXTAB.CS",
-	SXTAB_CC:          "SXTAB.CC",
-	SXTAB_MI:          "SXTAB.MI",
-	SXTAB_PL:          "SXTAB.PL",
-	SXTAB_VS:          "SXTAB.VS",
-	SXTAB_VC:          "SXTAB.VC",
-	SXTAB_HI:          "SXTAB.HI",
-	SXTAB_LS:          "SXTAB.LS",
-	SXTAB_GE:          "SXTAB.GE",
-	SXTAB_LT:          "SXTAB.LT",
-	SXTAB_GT:          "SXTAB.GT",
-	SXTAB_LE:          "SXTAB.LE",
-	SXTAB:             "SXTAB",
-	SXTAB_ZZ:          "SXTAB.ZZ",
-	SXTAB16_EQ:        "SXTAB16.EQ",
-	SXTAB16_NE:        "SXTAB16.NE",
-	SXTAB16_CS:        "SXTAB16.CS",
-	SXTAB16_CC:        "SXTAB16.CC",
-	SXTAB16_MI:        "SXTAB16.MI",
-	SXTAB16_PL:        "SXTAB16.PL",
-	SXTAB16_VS:        "SXTAB16.VS",
-	SXTAB16_VC:        "SXTAB16.VC",
-	SXTAB16_HI:        "SXTAB16.HI",
-	SXTAB16_LS:        "SXTAB16.LS",
-	SXTAB16_GE:        "SXTAB16.GE",
-	SXTAB16_LT:        "SXTAB16.LT",
-	SXTAB16_GT:        "SXTAB16.GT",
-	SXTAB16_LE:        "SXTAB16.LE",
-	SXTAB16:           "SXTAB16",
-	SXTAB16_ZZ:        "SXTAB16.ZZ",
-	SXTAH_EQ:          "SXTAH.EQ",
-	SXTAH_NE:          "SXTAH.NE",
-	SXTAH_CS:          "SXTAH.CS",
-	SXTAH_CC:          "SXTAH.CC",
-	SXTAH_MI:          "SXTAH.MI",
-	SXTAH_PL:          "SXTAH.PL",
-	SXTAH_VS:          "SXTAH.VS",
-	SXTAH_VC:          "SXTAH.VC",
-	SXTAH_HI:          "SXTAH.HI",
-	SXTAH_LS:          "SXTAH.LS",
-	SXTAH_GE:          "SXTAH.GE",
-	SXTAH_LT:          "SXTAH.LT",
-	SXTAH_GT:          "SXTAH.GT",
-	SXTAH_LE:          "SXTAH.LE",
-	SXTAH:             "SXTAH",
-	SXTAH_ZZ:          "SXTAH.ZZ",
-	SXTB_EQ:           "SXTB.EQ",
-	SXTB_NE:           "SXTB.NE",
-	SXTB_CS:           "SXTB.CS",
-	SXTB_CC:           "SXTB.CC",
-	SXTB_MI:           "SXTB.MI",
-	SXTB_PL:           "SXTB.PL",
-	SXTB_VS:           "SXTB.VS",
-	SXTB_VC:           "SXTB.VC",
-	SXTB_HI:           "SXTB.HI",
-	SXTB_LS:           "SXTB.LS",
-	SXTB_GE:           "SXTB.GE",
-	SXTB_LT:           "SXTB.LT",
-	SXTB_GT:           "SXTB.GT",
-	SXTB_LE:           "SXTB.LE",
-	SXTB:              "SXTB",
-	SXTB_ZZ:           "SXTB.ZZ",
-	SXTB16_EQ:         "SXTB16.EQ",
-	SXTB16_NE:         "SXTB16.NE",
-	SXTB16_CS:         "SXTB16.CS",
-	SXTB16_CC:         "SXTB16.CC",
-	SXTB16_MI:         "SXTB16.MI",
-	SXTB16_PL:         "SXTB16.PL",
-	SXTB16_VS:         "SXTB16.VS",
-	SXTB16_VC:         "SXTB16.VC",
-	SXTB16_HI:         "SXTB16.HI",
-	SXTB16_LS:         "SXTB16.LS",
-	SXTB16_GE:         "SXTB16.GE",
-	SXTB16_LT:         "SXTB16.LT",
-	SXTB16_GT:         "SXTB16.GT",
-	SXTB16_LE:         "SXTB16.LE",
-	SXTB16:            "SXTB16",
-	SXTB16_ZZ:         "SXTB16.ZZ",
-	SXTH_EQ:           "SXTH.EQ",
-	SXTH_NE:           "SXTH.NE",
-	SXTH_CS:           "SXTH.CS",
-	SXTH_CC:           "SXTH.CC",
-	SXTH_MI:           "SXTH.MI",
-	SXTH_PL:           "SXTH.PL",
-	SXTH_VS:           "SXTH.VS",
-	SXTH_VC:           "SXTH.VC",
-	SXTH_HI:           "SXTH.HI",
-	SXTH_LS:           "SXTH.LS",
-	SXTH_GE:           "SXTH.GE",
-	SXTH_LT:           "SXTH.LT",
-	SXTH_GT:           "SXTH.GT",
-	SXTH_LE:           "SXTH.LE",
-	SXTH:              "SXTH",
-	SXTH_ZZ:           "SXTH.ZZ",
-	TEQ_EQ:            "TEQ.EQ",
-	TEQ_NE:            "TEQ.NE",
-	TEQ_CS:            "TEQ.CS",
-	TEQ_CC:            "TEQ.CC",
-	TEQ_MI:            "TEQ.MI",
-	TEQ_PL:            "TEQ.PL",
-	TEQ_VS:            "TEQ.VS",
-	TEQ_VC:            "TEQ.VC",
-	TEQ_HI:            "TEQ.HI",
-	TEQ_LS:            "TEQ.LS",
-	TEQ_GE:            "TEQ.GE",
-	TEQ_LT:            "TEQ.LT",
-	TEQ_GT:            "TEQ.GT",
-	TEQ_LE:            "TEQ.LE",
-	TEQ:               "TEQ",
-	TEQ_ZZ:            "TEQ.ZZ",
-	TST_EQ:            "TST.EQ",
-	TST_NE:            "TST.NE",
-	TST_CS:            "TST.CS",
-	TST_CC:            "TST.CC",
-	TST_MI:            "TST.MI",
-	TST_PL:            "TST.PL",
-	TST_VS:            "TST.VS",
-	TST_VC:            "TST.VC",
-	TST_HI:            "TST.HI",
-	TST_LS:            "TST.LS",
-	TST_GE:            "TST.GE",
-	TST_LT:            "TST.LT",
-	TST_GT:            "TST.GT",
-	TST_LE:            "TST.LE",
-	TST:               "TST",
-	TST_ZZ:            "TST.ZZ",
-	UADD16_EQ:         "UADD16.EQ",
-	UADD16_NE:         "UADD16.NE",
-	UADD16_CS:         "UADD16.CS",
-	UADD16_CC:         "UADD16.CC",
-	UADD16_MI:         "UADD16.MI",
-	UADD16_PL:         "UADD16.PL",
-	UADD16_VS:         "UADD16.VS",
-	UADD16_VC:         "UADD16.VC",
-	UADD16_HI:         "UADD16.HI",
-	UADD16_LS:         "UADD16.LS",
-	UADD16_GE:         "UADD16.GE",
-	UADD16_LT:         "UADD16.LT",
-	UADD16_GT:         "UADD16.GT",
-	UADD16_LE:         "UADD16.LE",
-	UADD16:            "UADD16",
-	UADD16_ZZ:         "UADD16.ZZ",
-	UADD8_EQ:          "UADD8.EQ",
-	UADD8_NE:          "UADD8.NE",
-	UADD8_CS:          "UADD8.CS",
-	UADD8_CC:          "UADD8.CC",
-	UADD8_MI:          "UADD8.MI",
-	UADD8_PL:          "UADD8.PL",
-	UADD8_VS:          "UADD8.VS",
-	UADD8_VC:          "UADD8.VC",
-	UADD8_HI:          "UADD8.HI",
-	UADD8_LS:          "UADD8.LS",
-	UADD8_GE:          "UADD8.GE",
-	UADD8_LT:          "UADD8.LT",
-	UADD8_GT:          "UADD8.GT",
-	UADD8_LE:          "UADD8.LE",
-	UADD8:             "UADD8",
-	UADD8_ZZ:          "UADD8.ZZ",
-	UASX_EQ:           "UASX.EQ",
-	UASX_NE:           "UASX.NE",
-	UASX_CS:           "UASX.CS",
-	UASX_CC:           "UASX.CC",
-	UASX_MI:           "UASX.MI",
-	UASX_PL:           "UASX.PL",
-	UASX_VS:           "UASX.VS",
-	UASX_VC:           "UASX.VC",
-	UASX_HI:           "UASX.HI",
-	UASX_LS:           "UASX.LS",
-	UASX_GE:           "UASX.GE",
-	UASX_LT:           "UASX.LT",
-	UASX_GT:           "UASX.GT",
-	UASX_LE:           "UASX.LE",
-	UASX:              "UASX",
-	UASX_ZZ:           "UASX.ZZ",
-	UBFX_EQ:           "UBFX.EQ",
-	UBFX_NE:           "UBFX.NE",
-	UBFX_CS:           "UBFX.CS",
-	UBFX_CC:           "UBFX.CC",
-	UBFX_MI:           "UBFX.MI",
-	UBFX_PL:           "UBFX.PL",
-	UBFX_VS:           "UBFX.VS",
-	UBFX_VC:           "UBFX.VC",
-	UBFX_HI:           "UBFX.HI",
-	UBFX_LS:           "UBFX.LS",
-	UBFX_GE:           "UBFX.GE",
-	UBFX_LT:           "UBFX.LT",
-	UBFX_GT:           "UBFX.GT",
-	UBFX_LE:           "UBFX.LE",
-	UBFX:              "UBFX",
-	UBFX_ZZ:           "UBFX.ZZ",
-	UHADD16_EQ:        "UHADD16.EQ",
-	UHADD16_NE:        "UHADD16.NE",
-	UHADD16_CS:        "UHADD16.CS",
-	UHADD16_CC:        "UHADD16.CC",
-	UHADD16_MI:        "UHADD16.MI",
-	UHADD16_PL:        "UHADD16.PL",
-	UHADD16_VS:        "UHADD16.VS",
-	UHADD16_VC:        "UHADD16.VC",
-	UHADD16_HI:        "UHADD16.HI",
-	UHADD16_LS:        "UHADD16.LS",
-	UHADD16_GE:        "UHADD16.GE",
-	UHADD16_LT:        "UHADD16.LT",
-	UHADD16_GT:        "UHADD16.GT",
-	UHADD16_LE:        "UHADD16.LE",
-	UHADD16:           "UHADD16",
-	UHADD16_ZZ:        "UHADD16.ZZ",
-	UHADD8_EQ:         "UHADD8.EQ",
-	UHADD8_NE:         "UHADD8.NE",
-	UHADD8_CS:         "UHADD8.CS",
-	UHADD8_CC:         "UHADD8.CC",
-	UHADD8_MI:         "UHADD8.MI",
-	UHADD8_PL:         "UHADD8.PL",
-	UHADD8_VS:         "UHADD8.VS",
-	UHADD8_VC:         "UHADD8.VC",
-	UHADD8_HI:         "UHADD8.HI",
-	UHADD8_LS:         "UHADD8.LS",
-	UHADD8_GE:         "UHADD8.GE",
-	UHADD8_LT:         "UHADD8.LT",
-	UHADD8_GT:         "UHADD8.GT",
-	UHADD8_LE:         "UHADD8.LE",
-	UHADD8:            "UHADD8",
-	UHADD8_ZZ:         "UHADD8.ZZ",
-	UHASX_EQ:          "UHASX.EQ",
-	UHASX_NE:          "UHASX.NE",
-	UHASX_CS:          "UHASX.CS",
-	UHASX_CC:          "UHASX.CC",
-	UHASX_MI:          "UHASX.MI",
-	UHASX_PL:          "UHASX.PL",
-	UHASX_VS:          "UHASX.VS",
-	UHASX_VC:          "UHASX.VC",
-	UHASX_HI:          "UHASX.HI",
-	UHASX_LS:          "UHASX.LS",
-	UHASX_GE:          "UHASX.GE",
-	UHASX_LT:          "UHASX.LT",
-	UHASX_GT:          "UHASX.GT",
-	UHASX_LE:          "UHASX.LE",
-	UHASX:             "UHASX",
-	UHASX_ZZ:          "UHASX.ZZ",
-	UHSAX_EQ:          "UHSAX.EQ",
-	UHSAX_NE:          "UHSAX.NE",
-	UHSAX_CS:          "UHSAX.CS",
-	UHSAX_CC:          "UHSAX.CC",
-	UHSAX_MI:          "UHSAX.MI",
-	UHSAX_PL:          "UHSAX.PL",
-	UHSAX_VS:          "UHSAX.VS",
-	UHSAX_VC:          "UHSAX.VC",
-	UHSAX_HI:          "UHSAX.HI",
-	UHSAX_LS:          "UHSAX.LS",
-	UHSAX_GE:          "UHSAX.GE",
-	UHSAX_LT:          "UHSAX.LT",
-	UHSAX_GT:          "UHSAX.GT",
-	UHSAX_LE:          "UHSAX.LE",
-	UHSAX:             "UHSAX",
-	UHSAX_ZZ:          "UHSAX.ZZ",
-	UHSUB16_EQ:        "UHSUB16.EQ",
-	UHSUB16_NE:        "UHSUB16.NE",
-	UHSUB16_CS:        "UHSUB16.CS",
-	UHSUB16_CC:        "UHSUB16.CC",
-	UHSUB16_MI:        "UHSUB16.MI",
-	UHSUB16_PL:        "UHSUB16.PL",
-	UHSUB16_VS:        "UHSUB16.VS",
-	UHSUB16_VC:        "UHSUB16.VC",
-	UHSUB16_HI:        "UHSUB16.HI",
-	UHSUB16_LS:        "UHSUB16.LS",
-	UHSUB16_GE:        "UHSUB16.GE",
-	UHSUB16_LT:        "UHSUB16.LT",
-	UHSUB16_GT:        "UHSUB16.GT",
-	UHSUB16_LE:        "UHSUB16.LE",
-	UHSUB16:           "UHSUB16",
-	UHSUB16_ZZ:        "UHSUB16.ZZ",
-	UHSUB8_EQ:         "UHSUB8.EQ",
-	UHSUB8_NE:         "UHSUB8.NE",
-	UHSUB8_CS:         "UHSUB8.CS",
-	UHSUB8_CC:         "UHSUB8.CC",
-	UHSUB8_MI:         "UHSUB8.MI",
-	UHSUB8_PL:         "UHSUB8.PL",
-	UHSUB8_VS:         "UHSUB8.VS",
-	UHSUB8_VC:         "UHSUB8.VC",
-	UHSUB8_HI:         "UHSUB8.HI",
-	UHSUB8_LS:         "UHSUB8.LS",
-	UHSUB8_GE:         "UHSUB8.GE",
-	UHSUB8_LT:         "UHSUB8.LT",
-	UHSUB8_GT:         "UHSUB8.GT",
-	UHSUB8_LE:         "UHSUB8.LE",
-	UHSUB8:            "UHSUB8",
-	UHSUB8_ZZ:         "UHSUB8.ZZ",
-	UMAAL_EQ:          "UMAAL.EQ",
-	UMAAL_NE:          "UMAAL.NE",
-	UMAAL_CS:          "UMAAL.CS",
-	UMAAL_CC:          "UMAAL.CC",
-	UMAAL_MI:          "UMAAL.MI",
-	UMAAL_PL:          "UMAAL.PL",
-	UMAAL_VS:          "UMAAL.VS",
-	UMAAL_VC:          "UMAAL.VC",
-	UMAAL_HI:          "UMAAL.HI",
-	UMAAL_LS:          "UMAAL.LS",
-	UMAAL_GE:          "UMAAL.GE",
-	UMAAL_LT:          "UMAAL.LT",
-	UMAAL_GT:          "UMAAL.GT",
-	UMAAL_LE:          "UMAAL.LE",
-	UMAAL:             "UMAAL",
-	UMAAL_ZZ:          "UMAAL.ZZ",
-	UMLAL_EQ:          "UMLAL.EQ",
-	UMLAL_NE:          "UMLAL.NE",
-	UMLAL_CS:          "UMLAL.CS",
-	UMLAL_CC:          "UMLAL.CC",
-	UMLAL_MI:          "UMLAL.MI",
-	UMLAL_PL:          "UMLAL.PL",
-	UMLAL_VS:          "UMLAL.VS",
-	UMLAL_VC:          "UMLAL.VC",
-	UMLAL_HI:          "UMLAL.HI",
-	UMLAL_LS:          "UMLAL.LS",
-	UMLAL_GE:          "UMLAL.GE",
-	UMLAL_LT:          "UMLAL.LT",
-	UMLAL_GT:          "UMLAL.GT",
-	UMLAL_LE:          "UMLAL.LE",
-	UMLAL:             "UMLAL",
-	UMLAL_ZZ:          "UMLAL.ZZ",
-	UMLAL_S_EQ:        "UMLAL.S.EQ",
-	UMLAL_S_NE:        "UMLAL.S.NE",
-	UMLAL_S_CS:        "UMLAL.S.CS",
-	UMLAL_S_CC:        "UMLAL.S.CC",
-	UMLAL_S_MI:        "UMLAL.S.MI",
-	UMLAL_S_PL:        "UMLAL.S.PL",
-	UMLAL_S_VS:        "UMLAL.S.VS",
-	UMLAL_S_VC:        "UMLAL.S.VC",
-	UMLAL_S_HI:        "UMLAL.S.HI",
-	UMLAL_S_LS:        "UMLAL.S.LS",
-	UMLAL_S_GE:        "UMLAL.S.GE",
-	UMLAL_S_LT:        "UMLAL.S.LT",
-	UMLAL_S_GT:        "UMLAL.S.GT",
-	UMLAL_S_LE:        "UMLAL.S.LE",
-	UMLAL_S:           "UMLAL.S",
-	UMLAL_S_ZZ:        "UMLAL.S.ZZ",
-	UMULL_EQ:          "UMULL.EQ",
-	UMULL_NE:          "UMULL.NE",
-	UMULL_CS:          "UMULL.CS",
-	UMULL_CC:          "UMULL.CC",
-	UMULL_MI:          "UMULL.MI",
-	UMULL_PL:          "UMULL.PL",
-	UMULL_VS:          "UMULL.VS",
-	UMULL_VC:          "UMULL.VC",
-	UMULL_HI:          "UMULL.HI",
-	UMULL_LS:          "UMULL.LS",
-	UMULL_GE:          "UMULL.GE",
-	UMULL_LT:          "UMULL.LT",
-	UMULL_GT:          "UMULL.GT",
-	UMULL_LE:          "UMULL.LE",
-	UMULL:             "UMULL",
-	UMULL_ZZ:          "UMULL.ZZ",
-	UMULL_S_EQ:        "UMULL.S.EQ",
-	UMULL_S_NE:        "UMULL.S.NE",
-	UMULL_S_CS:        "UMULL.S.CS",
-	UMULL_S_CC:        "UMULL.S.CC",
-	UMULL_S_MI:        "UMULL.S.MI",
-	UMULL_S_PL:        "UMULL.S.PL",
-	UMULL_S_VS:        "UMULL.S.VS",
-	UMULL_S_VC:        "UMULL.S.VC",
-	UMULL_S_HI:        "UMULL.S.HI",
-	UMULL_S_LS:        "UMULL.S.LS",
-	UMULL_S_GE:        "UMULL.S.GE",
-	UMULL_S_LT:        "UMULL.S.LT",
-	UMULL_S_GT:        "UMULL.S.GT",
-	UMULL_S_LE:        "UMULL.S.LE",
-	UMULL_S:           "UMULL.S",
-	UMULL_S_ZZ:        "UMULL.S.ZZ",
-	UNDEF:             "UNDEF",
-	UQADD16_EQ:        "UQADD16.EQ",
-	UQADD16_NE:        "UQADD16.NE",
-	UQADD16_CS:        "UQADD16.CS",
-	UQADD16_CC:        "UQADD16.CC",
-	UQADD16_MI:        "UQADD16.MI",
-	UQADD16_PL:        "UQADD16.PL",
-	UQADD16_VS:        "UQADD16.VS",
-	UQADD16_VC:        "UQADD16.VC",
-	UQADD16_HI:        "UQADD16.HI",
-	UQADD16_LS:        "UQADD16.LS",
-	UQADD16_GE:        "UQADD16.GE",
-	UQADD16_LT:        "UQADD16.LT",
-	UQADD16_GT:        "UQADD16.GT",
-	UQADD16_LE:        "UQADD16.LE",
-	UQADD16:           "UQADD16",
-	UQADD16_ZZ:        "UQADD16.ZZ",
-	UQADD8_EQ:         "UQADD8.EQ",
-	UQADD8_NE:         "UQADD8.NE",
-	UQADD8_CS:         "UQADD8.CS",
-	UQADD8_CC:         "UQADD8.CC",
-	UQADD8_MI:         "UQADD8.MI",
-	UQADD8_PL:         "UQADD8.PL",
-	UQADD8_VS:         "UQADD8.VS",
-	UQADD8_VC:         "UQADD8.VC",
-	UQADD8_HI:         "UQADD8.HI",
-	UQADD8_LS:         "UQADD8.LS",
-	UQADD8_GE:         "UQADD8.GE",
-	UQADD8_LT:         "UQADD8.LT",
-	UQADD8_GT:         "UQADD8.GT",
-	UQADD8_LE:         "UQADD8.LE",
-	UQADD8:            "UQADD8",
-	UQADD8_ZZ:         "UQADD8.ZZ",
-	UQASX_EQ:          "UQASX.EQ",
-	UQASX_NE:          "UQASX.NE",
-	UQASX_CS:          "UQASX.CS",
-	UQASX_CC:          "UQASX.CC",
-	UQASX_MI:          "UQASX.MI",
-	UQASX_PL:          "UQASX.PL",
-	UQASX_VS:          "UQASX.VS",
-	UQASX_VC:          "UQASX.VC",
-	UQASX_HI:          "UQASX.HI",
-	UQASX_LS:          "UQASX.LS",
-	UQASX_GE:          "UQASX.GE",
-	UQASX_LT:          "UQASX.LT",
-	UQASX_GT:          "UQASX.GT",
-	UQASX_LE:          "UQASX.LE",
-	UQASX:             "UQASX",
-	UQASX_ZZ:          "UQASX.ZZ",
-	UQSAX_EQ:          "UQSAX.EQ",
-	UQSAX_NE:          "UQSAX.NE",
-	UQSAX_CS:          "UQSAX.CS",
-	UQSAX_CC:          "UQSAX.CC",
-	UQSAX_MI:          "UQSAX.MI",
-	UQSAX_PL:          "UQSAX.PL",
-	UQSAX_VS:          "UQSAX.VS",
-	UQSAX_VC:          "UQSAX.VC",
-	UQSAX_HI:          "UQSAX.HI",
-	UQSAX_LS:          "UQSAX.LS",
-	UQSAX_GE:          "UQSAX.GE",
-	UQSAX_LT:          "UQSAX.LT",
-	UQSAX_GT:          "UQSAX.GT",
-	UQSAX_LE:          "UQSAX.LE",
-	UQSAX:             "UQSAX",
-	UQSAX_ZZ:          "UQSAX.ZZ",
-	UQSUB16_EQ:        "UQSUB16.EQ",
-	UQSUB16_NE:        "UQSUB16.NE",
-	UQSUB16_CS:        "UQSUB16.CS",
-	UQSUB16_CC:        "UQSUB16.CC",
-	UQSUB16_MI:        "UQSUB16.MI",
-	UQSUB16_PL:        "UQSUB16.PL",
-	UQSUB16_VS:        "UQSUB16.VS",
-	UQSUB16_VC:        "UQSUB16.VC",
-	UQSUB16_HI:        "UQSUB16.HI",
-	UQSUB16_LS:        "UQSUB16.LS",
-	UQSUB16_GE:        "UQSUB16.GE",
-	UQSUB16_LT:        "UQSUB16.LT",
-	UQSUB16_GT:        "UQSUB16.GT",
-	UQSUB16_LE:        "UQSUB16.LE",
-	UQSUB16:           "UQSUB16",
-	UQSUB16_ZZ:        "UQSUB16.ZZ",
-	UQSUB8_EQ:         "UQSUB8.EQ",
-	UQSUB8_NE:         "UQSUB8.NE",
-	UQSUB8_CS:         "UQSUB8.CS",
-	UQSUB8_CC:         "UQSUB8.CC",
-	UQSUB8_MI:         "UQSUB8.MI",
-	UQSUB8_PL:         "UQSUB8.PL",
-	UQSUB8_VS:         "UQSUB8.VS",
-	UQSUB8_VC:         "UQSUB8.VC",
-	UQSUB8_HI:         "UQSUB8.HI",
-	UQSUB8_LS:         "UQSUB8.LS",
-	UQSUB8_GE:         "UQSUB8.GE",
-	UQSUB8_LT:         "UQSUB8.LT",
-	UQSUB8_GT:         "UQSUB8.GT",
-	UQSUB8_LE:         "UQSUB8.LE",
-	UQSUB8:            "UQSUB8",
-	UQSUB8_ZZ:         "UQSUB8.ZZ",
-	USAD8_EQ:          "USAD8.EQ",
-	USAD8_NE:          "USAD8.NE",
-	USAD8_CS:          "USAD8.CS",
-	USAD8_CC:          "USAD8.CC",
-	USAD8_MI:          "USAD8.MI",
-	USAD8_PL:          "USAD8.PL",
-	USAD8_VS:          "USAD8.VS",
-	USAD8_VC:          "USAD8.VC",
-	USAD8_HI:          "USAD8.HI",
-	USAD8_LS:          "USAD8.LS",
-	USAD8_GE:          "USAD8.GE",
-	USAD8_LT:          "USAD8.LT",
-	USAD8_GT:          "USAD8.GT",
-	USAD8_LE:          "USAD8.LE",
-	USAD8:             "USAD8",
-	USAD8_ZZ:          "USAD8.ZZ",
-	USADA8_EQ:         "USADA8.EQ",
-	USADA8_NE:         "USADA8.NE",
-	USADA8_CS:         "USADA8.CS",
-	USADA8_CC:         "USADA8.CC",
-	USADA8_MI:         "USADA8.MI",
-	USADA8_PL:         "USADA8.PL",
-	USADA8_VS:         "USADA8.VS",
-	USADA8_VC:         "USADA8.VC",
-	USADA8_HI:         "USADA8.HI",
-	USADA8_LS:         "USADA8.LS",
-	USADA8_GE:         "USADA8.GE",
-	USADA8_LT:         "USADA8.LT",
-	USADA8_GT:         "USADA8.GT",
-	USADA8_LE:         "USADA8.LE",
-	USADA8:            "USADA8",
-	USADA8_ZZ:         "USADA8.ZZ",
-	USAT_EQ:           "USAT.EQ",
-	USAT_NE:           "USAT.NE",
-	USAT_CS:           "USAT.CS",
-	USAT_CC:           "USAT.CC",
-	USAT_MI:           "USAT.MI",
-	USAT_PL:           "USAT.PL",
-	USAT_VS:           "USAT.VS",
-	USAT_VC:           "USAT.VC",
-	USAT_HI:           "USAT.HI",
-	USAT_LS:           "USAT.LS",
-	USAT_GE:           "USAT.GE",
-	USAT_LT:           "USAT.LT",
-	USAT_GT:           "USAT.GT",
-	USAT_LE:           "USAT.LE",
-	USAT:              "USAT",
-	USAT_ZZ:           "USAT.ZZ",
-	USAT16_EQ:         "USAT16.EQ",
-	USAT16_NE:         "USAT16.NE",
-	USAT16_CS:         "USAT16.CS",
-	USAT16_CC:         "USAT16.CC",
-	USAT16_MI:         "USAT16.MI",
-	USAT16_PL:         "USAT16.PL",
-	USAT16_VS:         "USAT16.VS",
-	USAT16_VC:         "USAT16.VC",
-	USAT16_HI:         "USAT16.HI",
-	USAT16_LS:         "USAT16.LS",
-	USAT16_GE:         "USAT16.GE",
-	USAT16_LT:         "USAT16.LT",
-	USAT16_GT:         "USAT16.GT",
-	USAT16_LE:         "USAT16.LE",
-	USAT16:            "USAT16",
-	USAT16_ZZ:         "USAT16.ZZ",
-	USAX_EQ:           "USAX.EQ",
-	USAX_NE:           "USAX.NE",
-	USAX_CS:           "USAX.CS",
-	USAX_CC:           "USAX.CC",
-	USAX_MI:           "USAX.MI",
-	USAX_PL:           "USAX.PL",
-	USAX_VS:           "USAX.VS",
-	USAX_VC:           "USAX.VC",
-	USAX_HI:           "USAX.HI",
-	USAX_LS:           "USAX.LS",
-	USAX_GE:           "USAX.GE",
-	USAX_LT:           "USAX.LT",
-	USAX_GT:           "USAX.GT",
-	USAX_LE:           "USAX.LE",
-	USAX:              "USAX",
-	USAX_ZZ:           "USAX.ZZ",
-	USUB16_EQ:         "USUB16.EQ",
-	USUB16_NE:         "USUB16.NE",
-	USUB16_CS:         "USUB16.CS",
-	USUB16_CC:         "USUB16.CC",
-	USUB16_MI:         "USUB16.MI",
-	USUB16_PL:         "USUB16.PL",
-	USUB16_VS:         "USUB16.VS",
-	USUB16_VC:         "USUB16.VC",
-	USUB16_HI:         "USUB16.HI",
-	USUB16_LS:         "USUB16.LS",
-	USUB16_GE:         "USUB16.GE",
-	USUB16_LT:         "USUB16.LT",
-	USUB16_GT:         "USUB16.GT",
-	USUB16_LE:         "USUB16.LE",
-	USUB16:            "USUB16",
-	USUB16_ZZ:         "USUB16.ZZ",
-	USUB8_EQ:          "USUB8.EQ",
-	USUB8_NE:          "USUB8.NE",
-	USUB8_CS:          "USUB8.CS",
-	USUB8_CC:          "USUB8.CC",
-	USUB8_MI:          "USUB8.MI",
-	USUB8_PL:          "USUB8.PL",
-	USUB8_VS:          "USUB8.VS",
-	USUB8_VC:          "USUB8.VC",
-	USUB8_HI:          "USUB8.HI",
-	USUB8_LS:          "USUB8.LS",
-	USUB8_GE:          "USUB8.GE",
-	USUB8_LT:          "USUB8.LT",
-	USUB8_GT:          "USUB8.GT",
-	USUB8_LE:          "USUB8.LE",
-	USUB8:             "USUB8",
-	USUB8_ZZ:          "USUB8.ZZ",
-	UXTAB_EQ:          "UXTAB.EQ",
-	UXTAB_NE:          "UXTAB.NE",
-	UXTAB_CS:          "UXTAB.CS",
-	UXTAB_CC:          "UXTAB.CC",
-	UXTAB_MI:          "UXTAB.MI",
-	UXTAB_PL:          "UXTAB.PL",
-	UXTAB_VS:          "UXTAB.VS",
-	UXTAB_VC:          "UXTAB.VC",
-	UXTAB_HI:          "UXTAB.HI",
-	UXTAB_LS:          "UXTAB.LS",
-	UXTAB_GE:          "UXTAB.GE",
-	UXTAB_LT:          "UXTAB.LT",
-	UXTAB_GT:          "UXTAB.GT",
-	UXTAB_LE:          "UXTAB.LE",
-	UXTAB:             "UXTAB",
-	UXTAB_ZZ:          "UXTAB.ZZ",
-	UXTAB16_EQ:        "UXTAB16.EQ",
-	UXTAB16_NE:        "UXTAB16.NE",
-	UXTAB16_CS:        "UXTAB16.CS",
-	UXTAB16_CC:        "UXTAB16.CC",
-	UXTAB16_MI:        "UXTAB16.MI",
-	UXTAB16_PL:        "UXTAB16.PL",
-	UXTAB16_VS:        "UXTAB16.VS",
-	UXTAB16_VC:        "UXTAB16.VC",
-	UXTAB16_HI:        "UXTAB16.HI",
-	UXTAB16_LS:        "UXTAB16.LS",
-	UXTAB16_GE:        "UXTAB16.GE",
-	UXTAB16_LT:        "UXTAB16.LT",
-	UXTAB16_GT:        "UXTAB16.GT",
-	UXTAB16_LE:        "UXTAB16.LE",
-	UXTAB16:           "UXTAB16",
-	UXTAB16_ZZ:        "UXTAB16.ZZ",
-	UXTAH_EQ:          "UXTAH.EQ",
-	UXTAH_NE:          "UXTAH.NE",
-	UXTAH_CS:          "UXTAH.CS",
-	UXTAH_CC:          "UXTAH.CC",
-	UXTAH_MI:          "UXTAH.MI",
-	UXTAH_PL:          "UXTAH.PL",
-	UXTAH_VS:          "UXTAH.VS",
-	UXTAH_VC:          "UXTAH.VC",
-	UXTAH_HI:          "UXTAH.HI",
-	UXTAH_LS:          "UXTAH.LS",
-	UXTAH_GE:          "UXTAH.GE",
-	UXTAH_LT:          "UXTAH.LT",
-	UXTAH_GT:          "UXTAH.GT",
-	UXTAH_LE:          "UXTAH.LE",
-	UXTAH:             "UXTAH",
-	UXTAH_ZZ:          "UXTAH.ZZ",
-	UXTB_EQ:           "UXTB.EQ",
-	UXTB_NE:           "UXTB.NE",
-	UXTB_CS:           "UXTB.CS",
-	UXTB_CC:           "UXTB.CC",
-	UXTB_MI:           "UXTB.MI",
-	UXTB_PL:           "UXTB.PL",
-	UXTB_VS:           "UXTB.VS",
-	UXTB_VC:           "UXTB.VC",
-	UXTB_HI:           "UXTB.HI",
-	UXTB_LS:           "UXTB.LS",
-	UXTB_GE:           "UXTB.GE",
-	UXTB_LT:           "UXTB.LT",
-	UXTB_GT:           "UXTB.GT",
-	UXTB_LE:           "UXTB.LE",
-	UXTB:              "UXTB",
-	UXTB_ZZ:           "UXTB.ZZ",
-	UXTB16_EQ:         "UXTB16.EQ",
-	UXTB16_NE:         "UXTB16.NE",
-	UXTB16_CS:         "UXTB16.CS",
-	UXTB16_CC:         "UXTB16.CC",
-	UXTB16_MI:         "UXTB16.MI",
-	UXTB16_PL:         "UXTB16.PL",
-	UXTB16_VS:         "UXTB16.VS",
-	UXTB16_VC:         "UXTB16.VC",
-	UXTB16_HI:         "UXTB16.HI",
-	UXTB16_LS:         "UXTB16.LS",
-	UXTB16_GE:         "UXTB16.GE",
-	UXTB16_LT:         "UXTB16.LT",
-	UXTB16_GT:         "UXTB16.GT",
-	UXTB16_LE:         "UXTB16.LE",
-	UXTB16:            "UXTB16",
-	UXTB16_ZZ:         "UXTB16.ZZ",
-	UXTH_EQ:           "UXTH.EQ",
-	UXTH_NE:           "UXTH.NE",
-	UXTH_CS:           "UXTH.CS",
-	UXTH_CC:           "UXTH.CC",
-	UXTH_MI:           "UXTH.MI",
-	UXTH_PL:           "UXTH.PL",
-	UXTH_VS:           "UXTH.VS",
-	UXTH_VC:           "UXTH.VC",
-	UXTH_HI:           "UXTH.HI",
-	UXTH_LS:           "UXTH.LS",
-	UXTH_GE:           "UXTH.GE",
-	UXTH_LT:           "UXTH.LT",
-	UXTH_GT:           "UXTH.GT",
-	UXTH_LE:           "UXTH.LE",
-	UXTH:              "UXTH",
-	UXTH_ZZ:           "UXTH.ZZ",
-	VABS_EQ_F32:       "VABS.EQ.F32",
-	VABS_NE_F32:       "VABS.NE.F32",
-	VABS_CS_F32:       "VABS.CS.F32",
-	VABS_CC_F32:       "VABS.CC.F32",
-	VABS_MI_F32:       "VABS.MI.F32",
-	VABS_PL_F32:       "VABS.PL.F32",
-	VABS_VS_F32:       "VABS.VS.F32",
-	VABS_VC_F32:       "VABS.VC.F32",
-	VABS_HI_F32:       "VABS.HI.F32",
-	VABS_LS_F32:       "VABS.LS.F32",
-	VABS_GE_F32:       "VABS.GE.F32",
-	VABS_LT_F32:       "VABS.LT.F32",
-	VABS_GT_F32:       "VABS.GT.F32",
-	VABS_LE_F32:       "VABS.LE.F32",
-	VABS_F32:          "VABS.F32",
-	VABS_ZZ_F32:       "VABS.ZZ.F32",
-	VABS_EQ_F64:       "VABS.EQ.F64",
-	VABS_NE_F64:       "VABS.NE.F64",
-	VABS_CS_F64:       "VABS.CS.F64",
-	VABS_CC_F64:       "VABS.CC.F64",
-	VABS_MI_F64:       "VABS.MI.F64",
-	VABS_PL_F64:       "VABS.PL.F64",
-	VABS_VS_F64:       "VABS.VS.F64",
-	VABS_VC_F64:       "VABS.VC.F64",
-	VABS_HI_F64:       "VABS.HI.F64",
-	VABS_LS_F64:       "VABS.LS.F64",
-	VABS_GE_F64:       "VABS.GE.F64",
-	VABS_LT_F64:       "VABS.LT.F64",
-	VABS_GT_F64:       "VABS.GT.F64",
-	VABS_LE_F64:       "VABS.LE.F64",
-	VABS_F64:          "VABS.F64",
-	VABS_ZZ_F64:       "VABS.ZZ.F64",
-	VADD_EQ_F32:       "VADD.EQ.F32",
-	VADD_NE_F32:       "VADD.NE.F32",
-	VADD_CS_F32:       "VADD.CS.F32",
-	VADD_CC_F32:       "VADD.CC.F32",
-	VADD_MI_F32:       "VADD.MI.F32",
-	VADD_PL_F32:       "VADD.PL.F32",
-	VADD_VS_F32:       "VADD.VS.F32",
-	VADD_VC_F32:       "VADD.VC.F32",
-	VADD_HI_F32:       "VADD.HI.F32",
-	VADD_LS_F32:       "VADD.LS.F32",
-	VADD_GE_F32:       "VADD.GE.F32",
-	VADD_LT_F32:       "VADD.LT.F32",
-	VADD_GT_F32:       "VADD.GT.F32",
-	VADD_LE_F32:       "VADD.LE.F32",
-	VADD_F32:          "VADD.F32",
-	VADD_ZZ_F32:       "VADD.ZZ.F32",
-	VADD_EQ_F64:       "VADD.EQ.F64",
-	VADD_NE_F64:       "VADD.NE.F64",
-	VADD_CS_F64:       "VADD.CS.F64",
-	VADD_CC_F64:       "VADD.CC.F64",
-	VADD_MI_F64:       "VADD.MI.F64",
-	VADD_PL_F64:       "VADD.PL.F64",
-	VADD_VS_F64:       "VADD.VS.F64",
-	VADD_VC_F64:       "VADD.VC.F64",
-	VADD_HI_F64:       "VADD.HI.F64",
-	VADD_LS_F64:       "VADD.LS.F64",
-	VADD_GE_F64:       "VADD.GE.F64",
-	VADD_LT_F64:       "VADD.LT.F64",
-	VADD_GT_F64:       "VADD.GT.F64",
-	VADD_LE_F64:       "VADD.LE.F64",
-	VADD_F64:          "VADD.F64",
-	VADD_ZZ_F64:       "VADD.ZZ.F64",
-	VCMP_EQ_F32:       "VCMP.EQ.F32",
-	VCMP_NE_F32:       "VCMP.NE.F32",
-	VCMP_CS_F32:       "VCMP.CS.F32",
-	VCMP_CC_F32:       "VCMP.CC.F32",
-	VCMP_MI_F32:       "VCMP.MI.F32",
-	VCMP_PL_F32:       "VCMP.PL.F32",
-	VCMP_VS_F32:       "VCMP.VS.F32",
-	VCMP_VC_F32:       "VCMP.VC.F32",
-	VCMP_HI_F32:       "VCMP.HI.F32",
-	VCMP_LS_F32:       "VCMP.LS.F32",
-	VCMP_GE_F32:       "VCMP.GE.F32",
-	VCMP_LT_F32:       "VCMP.LT.F32",
-	VCMP_GT_F32:       "VCMP.GT.F32",
-	VCMP_LE_F32:       "VCMP.LE.F32",
-	VCMP_F32:          "VCMP.F32",
-	VCMP_ZZ_F32:       "VCMP.ZZ.F32",
-	VCMP_EQ_F64:       "VCMP.EQ.F64",
-	VCMP_NE_F64:       "VCMP.NE.F64",
-	VCMP_CS_F64:       "VCMP.CS.F64",
-	VCMP_CC_F64:       "VCMP.CC.F64",
-	VCMP_MI_F64:       "VCMP.MI.F64",
-	VCMP_PL_F64:       "VCMP.PL.F64",
-	VCMP_VS_F64:       "VCMP.VS.F64",
-	VCMP_VC_F64:       "VCMP.VC.F64",
-	VCMP_HI_F64:       "VCMP.HI.F64",
-	VCMP_LS_F64:       "VCMP.LS.F64",
-	VCMP_GE_F64:       "VCMP.GE.F64",
-	VCMP_LT_F64:       "VCMP.LT.F64",
-	VCMP_GT_F64:       "VCMP.GT.F64",
-	VCMP_LE_F64:       "VCMP.LE.F64",
-	VCMP_F64:          "VCMP.F64",
-	VCMP_ZZ_F64:       "VCMP.ZZ.F64",
-	VCMP_E_EQ_F32:     "VCMP.E.EQ.F32",
-	VCMP_E_NE_F32:     "VCMP.E.NE.F32",
-	VCMP_E_CS_F32:     "VCMP.E.CS.F32",
-	VCMP_E_CC_F32:     "VCMP.E.CC.F32",
-	VCMP_E_MI_F32:     "VCMP.E.MI.F32",
-	VCMP_E_PL_F32:     "VCMP.E.PL.F32",
-	VCMP_E_VS_F32:     "VCMP.E.VS.F32",
-	VCMP_E_VC_F32:     "VCMP.E.VC.F32",
-	VCMP_E_HI_F32:     "VCMP.E.HI.F32",
-	VCMP_E_LS_F32:     "VCMP.E.LS.F32",
-	VCMP_E_GE_F32:     "VCMP.E.GE.F32",
-	VCMP_E_LT_F32:     "VCMP.E.LT.F32",
-	VCMP_E_GT_F32:     "VCMP.E.GT.F32",
-	VCMP_E_LE_F32:     "VCMP.E.LE.F32",
-	VCMP_E_F32:        "VCMP.E.F32",
-	VCMP_E_ZZ_F32:     "VCMP.E.ZZ.F32",
-	VCMP_E_EQ_F64:     "VCMP.E.EQ.F64",
-	VCMP_E_NE_F64:     "VCMP.E.NE.F64",
-	VCMP_E_CS_F64:     "VCMP.E.CS.F64",
-	VCMP_E_CC_F64:     "VCMP.E.CC.F64",
-	VCMP_E_MI_F64:     "VCMP.E.MI.F64",
-	VCMP_E_PL_F64:     "VCMP.E.PL.F64",
-	VCMP_E_VS_F64:     "VCMP.E.VS.F64",
-	VCMP_E_VC_F64:     "VCMP.E.VC.F64",
-	VCMP_E_HI_F64:     "VCMP.E.HI.F64",
-	VCMP_E_LS_F64:     "VCMP.E.LS.F64",
-	VCMP_E_GE_F64:     "VCMP.E.GE.F64",
-	VCMP_E_LT_F64:     "VCMP.E.LT.F64",
-	VCMP_E_GT_F64:     "VCMP.E.GT.F64",
-	VCMP_E_LE_F64:     "VCMP.E.LE.F64",
-	VCMP_E_F64:        "VCMP.E.F64",
-	VCMP_E_ZZ_F64:     "VCMP.E.ZZ.F64",
-	VCVT_EQ_F32_FXS16: "VCVT.EQ.F32.FXS16",
-	VCVT_NE_F32_FXS16: "VCVT.NE.F32.FXS16",
-	VCVT_CS_F32_FXS16: "VCVT.CS.F32.FXS16",
-	VCVT_CC_F32_FXS16: "VCVT.CC.F32.FXS16",
-	VCVT_MI_F32_FXS16: "VCVT.MI.F32.FXS16",
-	VCVT_PL_F32_FXS16: "VCVT.PL.F32.FXS16",
-	VCVT_VS_F32_FXS16: "VCVT.VS.F32.FXS16",
-	VCVT_VC_F32_FXS16: "VCVT.VC.F32.FXS16",
-	VCVT_HI_F32_FXS16: "VCVT.HI.F32.FXS16",
-	VCVT_LS_F32_FXS16: "VCVT.LS.F32.FXS16",
-	VCVT_GE_F32_FXS16: "VCVT.GE.F32.FXS16",
-	VCVT_LT_F32_FXS16: "VCVT.LT.F32.FXS16",
-	VCVT_GT_F32_FXS16: "VCVT.GT.F32.FXS16",
-	VCVT_LE_F32_FXS16: "VCVT.LE.F32.FXS16",
-	VCVT_F32_FXS16:    "VCVT.F32.FXS16",
-	VCVT_ZZ_F32_FXS16: "VCVT.ZZ.F32.FXS16",
-	VCVT_EQ_F32_FXS32: "VCVT.EQ.F32.FXS32",
-	VCVT_NE_F32_FXS32: "VCVT.NE.F32.FXS32",
-	VCVT_CS_F32_FXS32: "VCVT.CS.F32.FXS32",
-	VCVT_CC_F32_FXS32: "VCVT.CC.F32.FXS32",
-	VCVT_MI_F32_FXS32: "VCVT.MI.F32.FXS32",
-	VCVT_PL_F32_FXS32: "VCVT.PL.F32.FXS32",
-	VCVT_VS_F32_FXS32: "VCVT.VS.F32.FXS32",
-	VCVT_VC_F32_FXS32: "VCVT.VC.F32.FXS32",
-	VCVT_HI_F32_FXS32: "VCVT.HI.F32.FXS32",
-	VCVT_LS_F32_FXS32: "VCVT.LS.F32.FXS32",
-	VCVT_GE_F32_FXS32: "VCVT.GE.F32.FXS32",
-	VCVT_LT_F32_FXS32: "VCVT.LT.F32.FXS32",
-	VCVT_GT_F32_FXS32: "VCVT.GT.F32.FXS32",
-	VCVT_LE_F32_FXS32: "VCVT.LE.F32.FXS32",
-	VCVT_F32_FXS32:    "VCVT.F32.FXS32",
-	VCVT_ZZ_F32_FXS32: "VCVT.ZZ.F32.FXS32",
-	VCVT_EQ_F32_FXU16: "VCVT.EQ.F32.FXU16",
-	VCVT_NE_F32_FXU16: "VCVT.NE.F32.FXU16",
-	VCVT_CS_F32_FXU16: "VCVT.CS.F32.FXU16",
-	VCVT_CC_F32_FXU16: "VCVT.CC.F32.FXU16",
-	VCVT_MI_F32_FXU16: "VCVT.MI.F32.FXU16",
-	VCVT_PL_F32_FXU16: "VCVT.PL.F32.FXU16",
-	VCVT_VS_F32_FXU16: "VCVT.VS.F32.FXU16",
-	VCVT_VC_F32_FXU16: "VCVT.VC.F32.FXU16",
-	VCVT_HI_F32_FXU16: "VCVT.HI.F32.FXU16",
-	VCVT_LS_F32_FXU16: "VCVT.LS.F32.FXU16",
-	VCVT_GE_F32_FXU16: "VCVT.GE.F32.FXU16",
-	VCVT_LT_F32_FXU16: "VCVT.LT.F32.FXU16",
-	VCVT_GT_F32_FXU16: "VCVT.GT.F32.FXU16",
-	VCVT_LE_F32_FXU16: "VCVT.LE.F32.FXU16",
-	VCVT_F32_FXU16:    "VCVT.F32.FXU16",
-	VCVT_ZZ_F32_FXU16: "VCVT.ZZ.F32.FXU16",
-	VCVT_EQ_F32_FXU32: "VCVT.EQ.F32.FXU32",
-	VCVT_NE_F32_FXU32: "VCVT.NE.F32.FXU32",
-	VCVT_CS_F32_FXU32: "VCVT.CS.F32.FXU32",
-	VCVT_CC_F32_FXU32: "VCVT.CC.F32.FXU32",
-	VCVT_MI_F32_FXU32: "VCVT.MI.F32.FXU32",
-	VCVT_PL_F32_FXU32: "VCVT.PL.F32.FXU32",
-	VCVT_VS_F32_FXU32: "VCVT.VS.F32.FXU32",
-	VCVT_VC_F32_FXU32: "VCVT.VC.F32.FXU32",
-	VCVT_HI_F32_FXU32: "VCVT.HI.F32.FXU32",
-	VCVT_LS_F32_FXU32: "VCVT.LS.F32.FXU32",
-	VCVT_GE_F32_FXU32: "VCVT.GE.F32.FXU32",
-	VCVT_LT_F32_FXU32: "VCVT.LT.F32.FXU32",
-	VCVT_GT_F32_FXU32: "VCVT.GT.F32.FXU32",
-	VCVT_LE_F32_FXU32: "VCVT.LE.F32.FXU32",
-	VCVT_F32_FXU32:    "VCVT.F32.FXU32",
-	VCVT_ZZ_F32_FXU32: "VCVT.ZZ.F32.FXU32",
-	VCVT_EQ_F64_FXS16: "VCVT.EQ.F64.FXS16",
-	VCVT_NE_F64_FXS16: "VCVT.NE.F64.FXS16",
-	VCVT_CS_F64_FXS16: "VCVT.CS.F64.FXS16",
-	VCVT_CC_F64_FXS16: "VCVT.CC.F64.FXS16",
-	VCVT_MI_F64_FXS16: "VCVT.MI.F64.FXS16",
-	VCVT_PL_F64_FXS16: "VCVT.PL.F64.FXS16",
-	VCVT_VS_F64_FXS16: "VCVT.VS.F64.FXS16",
-	VCVT_VC_F64_FXS16: "VCVT.VC.F64.FXS16",
-	VCVT_HI_F64_FXS16: "VCVT.HI.F64.FXS16",
-	VCVT_LS_F64_FXS16: "VCVT.LS.F64.FXS16",
-	VCVT_GE_F64_FXS16: "VCVT.GE.F64.FXS16",
-	VCVT_LT_F64_FXS16: "VCVT.LT.F64.FXS16",
-	VCVT_GT_F64_FXS16: "VCVT.GT.F64.FXS16",
-	VCVT_LE_F64_FXS16: "VCVT.LE.F64.FXS16",
-	VCVT_F64_FXS16:    "VCVT.F64.FXS16",
-	VCVT_ZZ_F64_FXS16: "VCVT.ZZ.F64.FXS16",
-	VCVT_EQ_F64_FXS32: "VCVT.EQ.F64.FXS32",
-	VCVT_NE_F64_FXS32: "VCVT.NE.F64.FXS32",
-	VCVT_CS_F64_FXS32: "VCVT.CS.F64.FXS32",
-	VCVT_CC_F64_FXS32: "VCVT.CC.F64.FXS32",
-	VCVT_MI_F64_FXS32: "VCVT.MI.F64.FXS32",
-	VCVT_PL_F64_FXS32: "VCVT.PL.F64.FXS32",
-	VCVT_VS_F64_FXS32: "VCVT.VS.F64.FXS32",
-	VCVT_VC_F64_FXS32: "VCVT.VC.F64.FXS32",
-	VCVT_HI_F64_FXS32: "VCVT.HI.F64.FXS32",
-	VCVT_LS_F64_FXS32: "VCVT.LS.F64.FXS32",
-	VCVT_GE_F64_FXS32: "VCVT.GE.F64.FXS32",
-	VCVT_LT_F64_FXS32: "VCVT.LT.F64.FXS32",
-	VCVT_GT_F64_FXS32: "VCVT.GT.F64.FXS32",
-	VCVT_LE_F64_FXS32: "VCVT.LE.F64.FXS32",
-	VCVT_F64_FXS32:    "VCVT.F64.FXS32",
-	VCVT_ZZ_F64_FXS32: "VCVT.ZZ.F64.FXS32",
-	VCVT_EQ_F64_FXU16: "VCVT.EQ.F64.FXU16",
-	VCVT_NE_F64_FXU16: "VCVT.NE.F64.FXU16",
-	VCVT_CS_F64_FXU16: "VCVT.CS.F64.FXU16",
-	VCVT_CC_F64_FXU16: "VCVT.CC.F64.FXU16",
-	VCVT_MI_F64_FXU16: "VCVT.MI.F64.FXU16",
-	VCVT_PL_F64_FXU16: "VCVT.PL.F64.FXU16",
-	VCVT_VS_F64_FXU16: "VCVT.VS.F64.FXU16",
-	VCVT_VC_F64_FXU16: "VCVT.VC.F64.FXU16",
-	VCVT_HI_F64_FXU16: "VCVT.HI.F64.FXU16",
-	VCVT_LS_F64_FXU16: "VCVT.LS.F64.FXU16",
-	VCVT_GE_F64_FXU16: "VCVT.GE.F64.FXU16",
-	VCVT_LT_F64_FXU16: "VCVT.LT.F64.FXU16",
-	VCVT_GT_F64_FXU16: "VCVT.GT.F64.FXU16",
-	VCVT_LE_F64_FXU16: "VCVT.LE.F64.FXU16",
-	VCVT_F64_FXU16:    "VCVT.F64.FXU16",
-	VCVT_ZZ_F64_FXU16: "VCVT.ZZ.F64.FXU16",
-	VCVT_EQ_F64_FXU32: "VCVT.EQ.F64.FXU32",
-	VCVT_NE_F64_FXU32: "VCVT.NE.F64.FXU32",
-	VCVT_CS_F64_FXU32: "VCVT.CS.F64.FXU32",
-	VCVT_CC_F64_FXU32: "VCVT.CC.F64.FXU32",
-	VCVT_MI_F64_FXU32: "VCVT.MI.F64.FXU32",
-	VCVT_PL_F64_FXU32: "VCVT.PL.F64.FXU32",
-	VCVT_VS_F64_FXU32: "VCVT.VS.F64.FXU32",
-	VCVT_VC_F64_FXU32: "VCVT.VC.F64.FXU32",
-	VCVT_HI_F64_FXU32: "VCVT.HI.F64.FXU32",
-	VCVT_LS_F64_FXU32: "VCVT.LS.F64.FXU32",
-	VCVT_GE_F64_FXU32: "VCVT.GE.F64.FXU32",
-	VCVT_LT_F64_FXU32: "VCVT.LT.F64.FXU32",
-	VCVT_GT_F64_FXU32: "VCVT.GT.F64.FXU32",
-	VCVT_LE_F64_FXU32: "VCVT.LE.F64.FXU32",
-	VCVT_F64_FXU32:    "VCVT.F64.FXU32",
-	VCVT_ZZ_F64_FXU32: "VCVT.ZZ.F64.FXU32",
-	VCVT_EQ_F32_U32:   "VCVT.EQ.F32.U32",
-	VCVT_NE_F32_U32:   "VCVT.NE.F32.U32",
-	VCVT_CS_F32_U32:   "VCVT.CS.F32.U32",
-	VCVT_CC_F32_U32:   "VCVT.CC.F32.U32",
-	VCVT_MI_F32_U32:   "VCVT.MI.F32.U32",
-	VCVT_PL_F32_U32:   "VCVT.PL.F32.U32",
-	VCVT_VS_F32_U32:   "VCVT.VS.F32.U32",
-	VCVT_VC_F32_U32:   "VCVT.VC.F32.U32",
-	VCVT_HI_F32_U32:   "VCVT.HI.F32.U32",
-	VCVT_LS_F32_U32:   "VCVT.LS.F32.U32",
-	VCVT_GE_F32_U32:   "VCVT.GE.F32.U32",
-	VCVT_LT_F32_U32:   "VCVT.LT.F32.U32",
-	VCVT_GT_F32_U32:   "VCVT.GT.F32.U32",
-	VCVT_LE_F32_U32:   "VCVT.LE.F32.U32",
-	VCVT_F32_U32:      "VCVT.F32.U32",
-	VCVT_ZZ_F32_U32:   "VCVT.ZZ.F32.U32",
-	VCVT_EQ_F32_S32:   "VCVT.EQ.F32.S32",
-	VCVT_NE_F32_S32:   "VCVT.NE.F32.S32",
-	VCVT_CS_F32_S32:   "VCVT.CS.F32.S32",
-	VCVT_CC_F32_S32:   "VCVT.CC.F32.S32",
-	VCVT_MI_F32_S32:   "VCVT.MI.F32.S32",
-	VCVT_PL_F32_S32:   "VCVT.PL.F32.S32",
-	VCVT_VS_F32_S32:   "VCVT.VS.F32.S32",
-	VCVT_VC_F32_S32:   "VCVT.VC.F32.S32",
-	VCVT_HI_F32_S32:   "VCVT.HI.F32.S32",
-	VCVT_LS_F32_S32:   "VCVT.LS.F32.S32",
-	VCVT_GE_F32_S32:   "VCVT.GE.F32.S32",
-	VCVT_LT_F32_S32:   "VCVT.LT.F32.S32",
-	VCVT_GT_F32_S32:   "VCVT.GT.F32.S32",
-	VCVT_LE_F32_S32:   "VCVT.LE.F32.S32",
-	VCVT_F32_S32:      "VCVT.F32.S32",
-	VCVT_ZZ_F32_S32:   "VCVT.ZZ.F32.S32",
-	VCVT_EQ_F64_U32:   "VCVT.EQ.F64.U32",
-	VCVT_NE_F64_U32:   "VCVT.NE.F64.U32",
-	VCVT_CS_F64_U32:   "VCVT.CS.F64.U32",
-	VCVT_CC_F64_U32:   "VCVT.CC.F64.U32",
-	VCVT_MI_F64_U32:   "VCVT.MI.F64.U32",
-	VCVT_PL_F64_U32:   "VCVT.PL.F64.U32",
-	VCVT_VS_F64_U32:   "VCVT.VS.F64.U32",
-	VCVT_VC_F64_U32:   "VCVT.VC.F64.U32",
-	VCVT_HI_F64_U32:   "VCVT.HI.F64.U32",
-	VCVT_LS_F64_U32:   "VCVT.LS.F64.U32",
-	VCVT_GE_F64_U32:   "VCVT.GE.F64.U32",
-	VCVT_LT_F64_U32:   "VCVT.LT.F64.U32",
-	VCVT_GT_F64_U32:   "VCVT.GT.F64.U32",
-	VCVT_LE_F64_U32:   "VCVT.LE.F64.U32",
-	VCVT_F64_U32:      "VCVT.F64.U32",
-	VCVT_ZZ_F64_U32:   "VCVT.ZZ.F64.U32",
-	VCVT_EQ_F64_S32:   "VCVT.EQ.F64.S32",
-	VCVT_NE_F64_S32:   "VCVT.NE.F64.S32",
-	VCVT_CS_F64_S32:   "VCVT.CS.F64.S32",
-	VCVT_CC_F64_S32:   "VCVT.CC.F64.S32",
-	VCVT_MI_F64_S32:   "VCVT.MI.F64.S32",
-	VCVT_PL_F64_S32:   "VCVT.PL.F64.S32",
-	VCVT_VS_F64_S32:   "VCVT.VS.F64.S32",
-	VCVT_VC_F64_S32:   "VCVT.VC.F64.S32",
-	VCVT_HI_F64_S32:   "VCVT.HI.F64.S32",
-	VCVT_LS_F64_S32:   "VCVT.LS.F64.S32",
-	VCVT_GE_F64_S32:   "VCVT.GE.F64.S32",
-	VCVT_LT_F64_S32:   "VCVT.LT.F64.S32",
-	VCVT_GT_F64_S32:   "VCVT.GT.F64.S32",
-	VCVT_LE_F64_S32:   "VCVT.LE.F64.S32",
-	VCVT_F64_S32:      "VCVT.F64.S32",
-	VCVT_ZZ_F64_S32:   "VCVT.ZZ.F64.S32",
-	VCVT_EQ_F64_F32:   "VCVT.EQ.F64.F32",
-	VCVT_NE_F64_F32:   "VCVT.NE.F64.F32",
-	VCVT_CS_F64_F32:   "VCVT.CS.F64.F32",
-	VCVT_CC_F64_F32:   "VCVT.CC.F64.F32",
-	VCVT_MI_F64_F32:   "VCVT.MI.F64.F32",
-	VCVT_PL_F64_F32:   "VCVT.PL.F64.F32",
-	VCVT_VS_F64_F32:   "VCVT.VS.F64.F32",
-	VCVT_VC_F64_F32:   "VCVT.VC.F64.F32",
-	VCVT_HI_F64_F32:   "VCVT.HI.F64.F32",
-	VCVT_LS_F64_F32:   "VCVT.LS.F64.F32",
-	VCVT_GE_F64_F32:   "VCVT.GE.F64.F32",
-	VCVT_LT_F64_F32:   "VCVT.LT.F64.F32",
-	VCVT_GT_F64_F32:   "VCVT.GT.F64.F32",
-	VCVT_LE_F64_F32:   "VCVT.LE.F64.F32",
-	VCVT_F64_F32:      "VCVT.F64.F32",
-	VCVT_ZZ_F64_F32:   "VCVT.ZZ.F64.F32",
-	VCVT_EQ_F32_F64:   "VCVT.EQ.F32.F64",
-	VCVT_NE_F32_F64:   "VCVT.NE.F32.F64",
-	VCVT_CS_F32_F64:   "VCVT.CS.F32.F64",
-	VCVT_CC_F32_F64:   "VCVT.CC.F32.F64",
-	VCVT_MI_F32_F64:   "VCVT.MI.F32.F64",
-	VCVT_PL_F32_F64:   "VCVT.PL.F32.F64",
-	VCVT_VS_F32_F64:   "VCVT.VS.F32.F64",
-	VCVT_VC_F32_F64:   "VCVT.VC.F32.F64",
-	VCVT_HI_F32_F64:   "VCVT.HI.F32.F64",
-	VCVT_LS_F32_F64:   "VCVT.LS.F32.F64",
-	VCVT_GE_F32_F64:   "VCVT.GE.F32.F64",
-	VCVT_LT_F32_F64:   "VCVT.LT.F32.F64",
-	VCVT_GT_F32_F64:   "VCVT.GT.F32.F64",
-	VCVT_LE_F32_F64:   "VCVT.LE.F32.F64",
-	VCVT_F32_F64:      "VCVT.F32.F64",
-	VCVT_ZZ_F32_F64:   "VCVT.ZZ.F32.F64",
-	VCVT_EQ_FXS16_F32: "VCVT.EQ.FXS16.F32",
-	VCVT_NE_FXS16_F32: "VCVT.NE.FXS16.F32",
-	VCVT_CS_FXS16_F32: "VCVT.CS.FXS16.F32",
-	VCVT_CC_FXS16_F32: "VCVT.CC.FXS16.F32",
-	VCVT_MI_FXS16_F32: "VCVT.MI.FXS16.F32",
-	VCVT_PL_FXS16_F32: "VCVT.PL.FXS16.F32",
-	VCVT_VS_FXS16_F32: "VCVT.VS.FXS16.F32",
-	VCVT_VC_FXS16_F32: "VCVT.VC.FXS16.F32",
-	VCVT_HI_FXS16_F32: "VCVT.HI.FXS16.F32",
-	VCVT_LS_FXS16_F32: "VCVT.LS.FXS16.F32",
-	VCVT_GE_FXS16_F32: "VCVT.GE.FXS16.F32",
-	VCVT_LT_FXS16_F32: "VCVT.LT.FXS16.F32",
-	VCVT_GT_FXS16_F32: "VCVT.GT.FXS16.F32",
-	VCVT_LE_FXS16_F32: "VCVT.LE.FXS16.F32",
-	VCVT_FXS16_F32:    "VCVT.FXS16.F32",
-	VCVT_ZZ_FXS16_F32: "VCVT.ZZ.FXS16.F32",
-	VCVT_EQ_FXS16_F64: "VCVT.EQ.FXS16.F64",
-	VCVT_NE_FXS16_F64: "VCVT.NE.FXS16.F64",
-	VCVT_CS_FXS16_F64: "VCVT.CS.FXS16.F64",
-	VCVT_CC_FXS16_F64: "VCVT.CC.FXS16.F64",
-	VCVT_MI_FXS16_F64: "VCVT.MI.FXS16.F64",
-	VCVT_PL_FXS16_F64: "VCVT.PL.FXS16.F64",
-	VCVT_VS_FXS16_F64: "VCVT.VS.FXS16.F64",
-	VCVT_VC_FXS16_F64: "VCVT.VC.FXS16.F64",
-	VCVT_HI_FXS16_F64: "VCVT.HI.FXS16.F64",
-	VCVT_LS_FXS16_F64: "VCVT.LS.FXS16.F64",
-	VCVT_GE_FXS16_F64: "VCVT.GE.FXS16.F64",
-	VCVT_LT_FXS16_F64: "VCVT.LT.FXS16.F64",
-	VCVT_GT_FXS16_F64: "VCVT.GT.FXS16.F64",
-	VCVT_LE_FXS16_F64: "VCVT.LE.FXS16.F64",
-	VCVT_FXS16_F64:    "VCVT.FXS16.F64",
-	VCVT_ZZ_FXS16_F64: "VCVT.ZZ.FXS16.F64",
-	VCVT_EQ_FXS32_F32: "VCVT.EQ.FXS32.F32",
-	VCVT_NE_FXS32_F32: "VCVT.NE.FXS32.F32",
-	VCVT_CS_FXS32_F32: "VCVT.CS.FXS32.F32",
-	VCVT_CC_FXS32_F32: "VCVT.CC.FXS32.F32",
-	VCVT_MI_FXS32_F32: "VCVT.MI.FXS32.F32",
-	VCVT_PL_FXS32_F32: "VCVT.PL.FXS32.F32",
-	VCVT_VS_FXS32_F32: "VCVT.VS.FXS32.F32",
-	VCVT_VC_FXS32_F32: "VCVT.VC.FXS32.F32",
-	VCVT_HI_FXS32_F32: "VCVT.HI.FXS32.F32",
-	VCVT_LS_FXS32_F32: "VCVT.LS.FXS32.F32",
-	VCVT_GE_FXS32_F32: "VCVT.GE.FXS32.F32",
-	VCVT_LT_FXS32_F32: "VCVT.LT.FXS32.F32",
-	VCVT_GT_FXS32_F32: "VCVT.GT.FXS32.F32",
-	VCVT_LE_FXS32_F32: "VCVT.LE.FXS32.F32",
-	VCVT_FXS32_F32:    "VCVT.FXS32.F32",
-	VCVT_ZZ_FXS32_F32: "VCVT.ZZ.FXS32.F32",
-	VCVT_EQ_FXS32_F64: "VCVT.EQ.FXS32.F64",
-	VCVT_NE_FXS32_F64: "VCVT.NE.FXS32.F64",
-	VCVT_CS_FXS32_F64: "VCVT.CS.FXS32.F64",
-	VCVT_CC_FXS32_F64: "VCVT.CC.FXS32.F64",
-	VCVT_MI_FXS32_F64: "VCVT.MI.FXS32.F64",
-	VCVT_PL_FXS32_F64: "VCVT.PL.FXS32.F64",
-	VCVT_VS_FXS32_F64: "VCVT.VS.FXS32.F64",
-	VCVT_VC_FXS32_F64: "VCVT.VC.FXS32.F64",
-	VCVT_HI_FXS32_F64: "VCVT.HI.FXS32.F64",
-	VCVT_LS_FXS32_F64: "VCVT.LS.FXS32.F64",
-	VCVT_GE_FXS32_F64: "VCVT.GE.FXS32.F64",
-	VCVT_LT_FXS32_F64: "VCVT.LT.FXS32.F64",
-	VCVT_GT_FXS32_F64: "VCVT.GT.FXS32.F64",
-	VCVT_LE_FXS32_F64: "VCVT.LE.FXS32.F64",
-	VCVT_FXS32_F64:    "VCVT.FXS32.F64",
-	VCVT_ZZ_FXS32_F64: "VCVT.ZZ.FXS32.F64",
-	VCVT_EQ_FXU16_F32: "VCVT.EQ.FXU16.F32",
-	VCVT_NE_FXU16_F32: "VCVT.NE.FXU16.F32",
-	VCVT_CS_FXU16_F32: "VCVT.CS.FXU16.F32",
-	VCVT_CC_FXU16_F32: "VCVT.CC.FXU16.F32",
-	VCVT_MI_FXU16_F32: "VCVT.MI.FXU16.F32",
-	VCVT_PL_FXU16_F32: "VCVT.PL.FXU16.F32",
-	VCVT_VS_FXU16_F32: "VCVT.VS.FXU16.F32",
-	VCVT_VC_FXU16_F32: "VCVT.VC.FXU16.F32",
-	VCVT_HI_FXU16_F32: "VCVT.HI.FXU16.F32",
-	VCVT_LS_FXU16_F32: "VCVT.LS.FXU16.F32",
-	VCVT_GE_FXU16_F32: "VCVT.GE.FXU16.F32",
-	VCVT_LT_FXU16_F32: "VCVT.LT.FXU16.F32",
-	VCVT_GT_FXU16_F32: "VCVT.GT.FXU16.F32",
-	VCVT_LE_FXU16_F32: "VCVT.LE.FXU16.F32",
-	VCVT_FXU16_F32:    "VCVT.FXU16.F32",
-	VCVT_ZZ_FXU16_F32: "VCVT.ZZ.FXU16.F32",
-	VCVT_EQ_FXU16_F64: "VCVT.EQ.FXU16.F64",
-	VCVT_NE_FXU16_F64: "VCVT.NE.FXU16.F64",
-	VCVT_CS_FXU16_F64: "VCVT.CS.FXU16.F64",
-	VCVT_CC_FXU16_F64: "VCVT.CC.FXU16.F64",
-	VCVT_MI_FXU16_F64: "VCVT.MI.FXU16.F64",
-	VCVT_PL_FXU16_F64: "VCVT.PL.FXU16.F64",
-	VCVT_VS_FXU16_F64: "VCVT.VS.FXU16.F64",
-	VCVT_VC_FXU16_F64: "VCVT.VC.FXU16.F64",
-	VCVT_HI_FXU16_F64: "VCVT.HI.FXU16.F64",
-	VCVT_LS_FXU16_F64: "VCVT.LS.FXU16.F64",
-	VCVT_GE_FXU16_F64: "VCVT.GE.FXU16.F64",
-	VCVT_LT_FXU16_F64: "VCVT.LT.FXU16.F64",
-	VCVT_GT_FXU16_F64: "VCVT.GT.FXU16.F64",
-	VCVT_LE_FXU16_F64: "VCVT.LE.FXU16.F64",
-	VCVT_FXU16_F64:    "VCVT.FXU16.F64",
-	VCVT_ZZ_FXU16_F64: "VCVT.ZZ.FXU16.F64",
-	VCVT_EQ_FXU32_F32: "VCVT.EQ.FXU32.F32",
-	VCVT_NE_FXU32_F32: "VCVT.NE.FXU32.F32",
-	VCVT_CS_FXU32_F32: "VCVT.CS.FXU32.F32",
-	VCVT_CC_FXU32_F32: "VCVT.CC.FXU32.F32",
-	VCVT_MI_FXU32_F32: "VCVT.MI.FXU32.F32",
-	VCVT_PL_FXU32_F32: "VCVT.PL.FXU32.F32",
-	VCVT_VS_FXU32_F32: "VCVT.VS.FXU32.F32",
-	VCVT_VC_FXU32_F32: "VCVT.VC.FXU32.F32",
-	VCVT_HI_FXU32_F32: "VCVT.HI.FXU32.F32",
-	VCVT_LS_FXU32_F32: "VCVT.LS.FXU32.F32",
-	VCVT_GE_FXU32_F32: "VCVT.GE.FXU32.F32",
-	VCVT_LT_FXU32_F32: "VCVT.LT.FXU32.F32",
-	VCVT_GT_FXU32_F32: "VCVT.GT.FXU32.F32",
-	VCVT_LE_FXU32_F32: "VCVT.LE.FXU32.F32",
-	VCVT_FXU32_F32:    "VCVT.FXU32.F32",
-	VCVT_ZZ_FXU32_F32: "VCVT.ZZ.FXU32.F32",
-	VCVT_EQ_FXU32_F64: "VCVT.EQ.FXU32.F64",
-	VCVT_NE_FXU32_F64: "VCVT.NE.FXU32.F64",
-	VCVT_CS_FXU32_F64: "VCVT.CS.FXU32.F64",
-	VCVT_CC_FXU32_F64: "VCVT.CC.FXU32.F64",
-	VCVT_MI_FXU32_F64: "VCVT.MI.FXU32.F64",
-	VCVT_PL_FXU32_F64: "VCVT.PL.FXU32.F64",
-	VCVT_VS_FXU32_F64: "VCVT.VS.FXU32.F64",
-	VCVT_VC_FXU32_F64: "VCVT.VC.FXU32.F64",
-	VCVT_HI_FXU32_F64: "VCVT.HI.FXU32.F64",
-	VCVT_LS_FXU32_F64: "VCVT.LS.FXU32.F64",
-	VCVT_GE_FXU32_F64: "VCVT.GE.FXU32.F64",
-	VCVT_LT_FXU32_F64: "VCVT.LT.FXU32.F64",
-	VCVT_GT_FXU32_F64: "VCVT.GT.FXU32.F64",
-	VCVT_LE_FXU32_F64: "VCVT.LE.FXU32.F64",
-	VCVT_FXU32_F64:    "VCVT.FXU32.F64",
-	VCVT_ZZ_FXU32_F64: "VCVT.ZZ.FXU32.F64",
-	VCVTB_EQ_F32_F16:  "VCVTB.EQ.F32.F16",
-	VCVTB_NE_F32_F16:  "VCVTB.NE.F32.F16",
-	VCVTB_CS_F32_F16:  "VCVTB.CS.F32.F16",
-	VCVTB_CC_F32_F16:  "VCVTB.CC.F32.F16",
-	VCVTB_MI_F32_F16:  "VCVTB.MI.F32.F16",
-	VCVTB_PL_F32_F16:  "VCVTB.PL.F32.F16",
-	VCVTB_VS_F32_F16:  "VCVTB.VS.F32.F16",
-	VCVTB_VC_F32_F16:  "VCVTB.VC.F32.F16",
-	VCVTB_HI_F32_F16:  "VCVTB.HI.F32.F16",
-	VCVTB_LS_F32_F16:  "VCVTB.LS.F32.F16",
-	VCVTB_GE_F32_F16:  "VCVTB.GE.F32.F16",
-	VCVTB_LT_F32_F16:  "VCVTB.LT.F32.F16",
-	VCVTB_GT_F32_F16:  "VCVTB.GT.F32.F16",
-	VCVTB_LE_F32_F16:  "VCVTB.LE.F32.F16",
-	VCVTB_F32_F16:     "VCVTB.F32.F16",
-	VCVTB_ZZ_F32_F16:  "VCVTB.ZZ.F32.F16",
-	VCVTB_EQ_F16_F32:  "VCVTB.EQ.F16.F32",
-	VCVTB_NE_F16_F32:  "VCVTB.NE.F16.F32",
-	VCVTB_CS_F16_F32:  "VCVTB.CS.F16.F32",
-	VCVTB_CC_F16_F32:  "VCVTB.CC.F16.F32",
-	VCVTB_MI_F16_F32:  "VCVTB.MI.F16.F32",
-	VCVTB_PL_F16_F32:  "VCVTB.PL.F16.F32",
-	VCVTB_VS_F16_F32:  "VCVTB.VS.F16.F32",
-	VCVTB_VC_F16_F32:  "VCVTB.VC.F16.F32",
-	VCVTB_HI_F16_F32:  "VCVTB.HI.F16.F32",
-	VCVTB_LS_F16_F32:  "VCVTB.LS.F16.F32",
-	VCVTB_GE_F16_F32:  "VCVTB.GE.F16.F32",
-	VCVTB_LT_F16_F32:  "VCVTB.LT.F16.F32",
-	VCVTB_GT_F16_F32:  "VCVTB.GT.F16.F32",
-	VCVTB_LE_F16_F32:  "VCVTB.LE.F16.F32",
-	VCVTB_F16_F32:     "VCVTB.F16.F32",
-	VCVTB_ZZ_F16_F32:  "VCVTB.ZZ.F16.F32",
-	VCVTT_EQ_F32_F16:  "VCVTT.EQ.F32.F16",
-	VCVTT_NE_F32_F16:  "VCVTT.NE.F32.F16",
-	VCVTT_CS_F32_F16:  "VCVTT.CS.F32.F16",
-	VCVTT_CC_F32_F16:  "VCVTT.CC.F32.F16",
-	VCVTT_MI_F32_F16:  "VCVTT.MI.F32.F16",
-	VCVTT_PL_F32_F16:  "VCVTT.PL.F32.F16",
-	VCVTT_VS_F32_F16:  "VCVTT.VS.F32.F16",
-	VCVTT_VC_F32_F16:  "VCVTT.VC.F32.F16",
-	VCVTT_HI_F32_F16:  "VCVTT.HI.F32.F16",
-	VCVTT_LS_F32_F16:  "VCVTT.LS.F32.F16",
-	VCVTT_GE_F32_F16:  "VCVTT.GE.F32.F16",
-	VCVTT_LT_F32_F16:  "VCVTT.LT.F32.F16",
-	VCVTT_GT_F32_F16:  "VCVTT.GT.F32.F16",
-	VCVTT_LE_F32_F16:  "VCVTT.LE.F32.F16",
-	VCVTT_F32_F16:     "VCVTT.F32.F16",
-	VCVTT_ZZ_F32_F16:  "VCVTT.ZZ.F32.F16",
-	VCVTT_EQ_F16_F32:  "VCVTT.EQ.F16.F32",
-	VCVTT_NE_F16_F32:  "VCVTT.NE.F16.F32",
-	VCVTT_CS_F16_F32:  "VCVTT.CS.F16.F32",
-	VCVTT_CC_F16_F32:  "VCVTT.CC.F16.F32",
-	VCVTT_MI_F16_F32:  "VCVTT.MI.F16.F32",
-	VCVTT_PL_F16_F32:  "VCVTT.PL.F16.F32",
-	VCVTT_VS_F16_F32:  "VCVTT.VS.F16.F32",
-	VCVTT_VC_F16_F32:  "VCVTT.VC.F16.F32",
-	VCVTT_HI_F16_F32:  "VCVTT.HI.F16.F32",
-	VCVTT_LS_F16_F32:  "VCVTT.LS.F16.F32",
-	VCVTT_GE_F16_F32:  "VCVTT.GE.F16.F32",
-	VCVTT_LT_F16_F32:  "VCVTT.LT.F16.F32",
-	VCVTT_GT_F16_F32:  "VCVTT.GT.F16.F32",
-	VCVTT_LE_F16_F32:  "VCVTT.LE.F16.F32",
-	VCVTT_F16_F32:     "VCVTT.F16.F32",
-	VCVTT_ZZ_F16_F32:  "VCVTT.ZZ.F16.F32",
-	VCVTR_EQ_U32_F32:  "VCVTR.EQ.U32.F32",
-	VCVTR_NE_U32_F32:  "VCVTR.NE.U32.F32",
-	VCVTR_CS_U32_F32:  "VCVTR.CS.U32.F32",
-	VCVTR_CC_U32_F32:  "VCVTR.CC.U32.F32",
-	VCVTR_MI_U32_F32:  "VCVTR.MI.U32.F32",
-	VCVTR_PL_U32_F32:  "VCVTR.PL.U32.F32",
-	VCVTR_VS_U32_F32:  "VCVTR.VS.U32.F32",
-	VCVTR_VC_U32_F32:  "VCVTR.VC.U32.F32",
-	VCVTR_HI_U32_F32:  "VCVTR.HI.U32.F32",
-	VCVTR_LS_U32_F32:  "VCVTR.LS.U32.F32",
-	VCVTR_GE_U32_F32:  "VCVTR.GE.U32.F32",
-	VCVTR_LT_U32_F32:  "VCVTR.LT.U32.F32",
-	VCVTR_GT_U32_F32:  "VCVTR.GT.U32.F32",
-	VCVTR_LE_U32_F32:  "VCVTR.LE.U32.F32",
-	VCVTR_U32_F32:     "VCVTR.U32.F32",
-	VCVTR_ZZ_U32_F32:  "VCVTR.ZZ.U32.F32",
-	VCVTR_EQ_U32_F64:  "VCVTR.EQ.U32.F64",
-	VCVTR_NE_U32_F64:  "VCVTR.NE.U32.F64",
-	VCVTR_CS_U32_F64:  "VCVTR.CS.U32.F64",
-	VCVTR_CC_U32_F64:  "VCVTR.CC.U32.F64",
-	VCVTR_MI_U32_F64:  "VCVTR.MI.U32.F64",
-	VCVTR_PL_U32_F64:  "VCVTR.PL.U32.F64",
-	VCVTR_VS_U32_F64:  "VCVTR.VS.U32.F64",
-	VCVTR_VC_U32_F64:  "VCVTR.VC.U32.F64",
-	VCVTR_HI_U32_F64:  "VCVTR.HI.U32.F64",
-	VCVTR_LS_U32_F64:  "VCVTR.LS.U32.F64",
-	VCVTR_GE_U32_F64:  "VCVTR.GE.U32.F64",
-	VCVTR_LT_U32_F64:  "VCVTR.LT.U32.F64",
-	VCVTR_GT_U32_F64:  "VCVTR.GT.U32.F64",
-	VCVTR_LE_U32_F64:  "VCVTR.LE.U32.F64",
-	VCVTR_U32_F64:     "VCVTR.U32.F64",
-	VCVTR_ZZ_U32_F64:  "VCVTR.ZZ.U32.F64",
-	VCVTR_EQ_S32_F32:  "VCVTR.EQ.S32.F32",
-	VCVTR_NE_S32_F32:  "VCVTR.NE.S32.F32",
-	VCVTR_CS_S32_F32:  "VCVTR.CS.S32.F32",
-	VCVTR_CC_S32_F32:  "VCVTR.CC.S32.F32",
-	VCVTR_MI_S32_F32:  "VCVTR.MI.S32.F32",
-	VCVTR_PL_S32_F32:  "VCVTR.PL.S32.F32",
-	VCVTR_VS_S32_F32:  "VCVTR.VS.S32.F32",
-	VCVTR_VC_S32_F32:  "VCVTR.VC.S32.F32",
-	VCVTR_HI_S32_F32:  "VCVTR.HI.S32.F32",
-	VCVTR_LS_S32_F32:  "VCVTR.LS.S32.F32",
-	VCVTR_GE_S32_F32:  "VCVTR.GE.S32.F32",
-	VCVTR_LT_S32_F32:  "VCVTR.LT.S32.F32",
-	VCVTR_GT_S32_F32:  "VCVTR.GT.S32.F32",
-	VCVTR_LE_S32_F32:  "VCVTR.LE.S32.F32",
-	VCVTR_S32_F32:     "VCVTR.S32.F32",
-	VCVTR_ZZ_S32_F32:  "VCVTR.ZZ.S32.F32",
-	VCVTR_EQ_S32_F64:  "VCVTR.EQ.S32.F64",
-	VCVTR_NE_S32_F64:  "VCVTR.NE.S32.F64",
-	VCVTR_CS_S32_F64:  "VCVTR.CS.S32.F64",
-	VCVTR_CC_S32_F64:  "VCVTR.CC.S32.F64",
-	VCVTR_MI_S32_F64:  "VCVTR.MI.S32.F64",
-	VCVTR_PL_S32_F64:  "VCVTR.PL.S32.F64",
-	VCVTR_VS_S32_F64:  "VCVTR.VS.S32.F64",
-	VCVTR_VC_S32_F64:  "VCVTR.VC.S32.F64",
-	VCVTR_HI_S32_F64:  "VCVTR.HI.S32.F64",
-	VCVTR_LS_S32_F64:  "VCVTR.LS.S32.F64",
-	VCVTR_GE_S32_F64:  "VCVTR.GE.S32.F64",
-	VCVTR_LT_S32_F64:  "VCVTR.LT.S32.F64",
-	VCVTR_GT_S32_F64:  "VCVTR.GT.S32.F64",
-	VCVTR_LE_S32_F64:  "VCVTR.LE.S32.F64",
-	VCVTR_S32_F64:     "VCVTR.S32.F64",
-	VCVTR_ZZ_S32_F64:  "VCVTR.ZZ.S32.F64",
-	VCVT_EQ_U32_F32:   "VCVT.EQ.U32.F32",
-	VCVT_NE_U32_F32:   "VCVT.NE.U32.F32",
-	VCVT_CS_U32_F32:   "VCVT.CS.U32.F32",
-	VCVT_CC_U32_F32:   "VCVT.CC.U32.F32",
-	VCVT_MI_U32_F32:   "VCVT.MI.U32.F32",
-	VCVT_PL_U32_F32:   "VCVT.PL.U32.F32",
-	VCVT_VS_U32_F32:   "VCVT.VS.U32.F32",
-	VCVT_VC_U32_F32:   "VCVT.VC.U32.F32",
-	VCVT_HI_U32_F32:   "VCVT.HI.U32.F32",
-	VCVT_LS_U32_F32:   "VCVT.LS.U32.F32",
-	VCVT_GE_U32_F32:   "VCVT.GE.U32.F32",
-	VCVT_LT_U32_F32:   "VCVT.LT.U32.F32",
-	VCVT_GT_U32_F32:   "VCVT.GT.U32.F32",
-	VCVT_LE_U32_F32:   "VCVT.LE.U32.F32",
-	VCVT_U32_F32:      "VCVT.U32.F32",
-	VCVT_ZZ_U32_F32:   "VCVT.ZZ.U32.F32",
-	VCVT_EQ_U32_F64:   "VCVT.EQ.U32.F64",
-	VCVT_NE_U32_F64:   "VCVT.NE.U32.F64",
-	VCVT_CS_U32_F64:   "VCVT.CS.U32.F64",
-	VCVT_CC_U32_F64:   "VCVT.CC.U32.F64",
-	VCVT_MI_U32_F64:   "VCVT.MI.U32.F64",
-	VCVT_PL_U32_F64:   "VCVT.PL.U32.F64",
-	VCVT_VS_U32_F64:   "VCVT.VS.U32.F64",
-	VCVT_VC_U32_F64:   "VCVT.VC.U32.F64",
-	VCVT_HI_U32_F64:   "VCVT.HI.U32.F64",
-	VCVT_LS_U32_F64:   "VCVT.LS.U32.F64",
-	VCVT_GE_U32_F64:   "VCVT.GE.U32.F64",
-	VCVT_LT_U32_F64:   "VCVT.LT.U32.F64",
-	VCVT_GT_U32_F64:   "VCVT.GT.U32.F64",
-	VCVT_LE_U32_F64:   "VCVT.LE.U32.F64",
-	VCVT_U32_F64:      "VCVT.U32.F64",
-	VCVT_ZZ_U32_F64:   "VCVT.ZZ.U32.F64",
-	VCVT_EQ_S32_F32:   "VCVT.EQ.S32.F32",
-	VCVT_NE_S32_F32:   "VCVT.NE.S32.F32",
-	VCVT_CS_S32_F32:   "VCVT.CS.S32.F32",
-	VCVT_CC_S32_F32:   "VCVT.CC.S32.F32",
-	VCVT_MI_S32_F32:   "VCVT.MI.S32.F32",
-	VCVT_PL_S32_F32:   "VCVT.PL.S32.F32",
-	VCVT_VS_S32_F32:   "VCVT.VS.S32.F32",
-	VCVT_VC_S32_F32:   "VCVT.VC.S32.F32",
-	VCVT_HI_S32_F32:   "VCVT.HI.S32.F32",
-	VCVT_LS_S32_F32:   "VCVT.LS.S32.F32",
-	VCVT_GE_S32_F32:   "VCVT.GE.S32.F32",
-	VCVT_LT_S32_F32:   "VCVT.LT.S32.F32",
-	VCVT_GT_S32_F32:   "VCVT.GT.S32.F32",
-	VCVT_LE_S32_F32:   "VCVT.LE.S32.F32",
-	VCVT_S32_F32:      "VCVT.S32.F32",
-	VCVT_ZZ_S32_F32:   "VCVT.ZZ.S32.F32",
-	VCVT_EQ_S32_F64:   "VCVT.EQ.S32.F64",
-	VCVT_NE_S32_F64:   "VCVT.NE.S32.F64",
-	VCVT_CS_S32_F64:   "VCVT.CS.S32.F64",
-	VCVT_CC_S32_F64:   "VCVT.CC.S32.F64",
-	VCVT_MI_S32_F64:   "VCVT.MI.S32.F64",
-	VCVT_PL_S32_F64:   "VCVT.PL.S32.F64",
-	VCVT_VS_S32_F64:   "VCVT.VS.S32.F64",
-	VCVT_VC_S32_F64:   "VCVT.VC.S32.F64",
-	VCVT_HI_S32_F64:   "VCVT.HI.S32.F64",
-	VCVT_LS_S32_F64:   "VCVT.LS.S32.F64",
-	VCVT_GE_S32_F64:   "VCVT.GE.S32.F64",
-	VCVT_LT_S32_F64:   "VCVT.LT.S32.F64",
-	VCVT_GT_S32_F64:   "VCVT.GT.S32.F64",
-	VCVT_LE_S32_F64:   "VCVT.LE.S32.F64",
-	VCVT_S32_F64:      "VCVT.S32.F64",
-	VCVT_ZZ_S32_F64:   "VCVT.ZZ.S32.F64",
-	VDIV_EQ_F32:       "VDIV.EQ.F32",
-	VDIV_NE_F32:       "VDIV.NE.F32",
-	VDIV_CS_F32:       "VDIV.CS.F32",
-	VDIV_CC_F32:       "VDIV.CC.F32",
-	VDIV_MI_F32:       "VDIV.MI.F32",
-	VDIV_PL_F32:       "VDIV.PL.F32",
-	VDIV_VS_F32:       "VDIV.VS.F32",
-	VDIV_VC_F32:       "VDIV.VC.F32",
-	VDIV_HI_F32:       "VDIV.HI.F32",
-	VDIV_LS_F32:       "VDIV.LS.F32",
-	VDIV_GE_F32:       "VDIV.GE.F32",
-	VDIV_LT_F32:       "VDIV.LT.F32",
-	VDIV_GT_F32:       "VDIV.GT.F32",
-	VDIV_LE_F32:       "VDIV.LE.F32",
-	VDIV_F32:          "VDIV.F32",
-	VDIV_ZZ_F32:       "VDIV.ZZ.F32",
-	VDIV_EQ_F64:       "VDIV.EQ.F64",
-	VDIV_NE_F64:       "VDIV.NE.F64",
-	VDIV_CS_F64:       "VDIV.CS.F64",
-	VDIV_CC_F64:       "VDIV.CC.F64",
-	VDIV_MI_F64:       "VDIV.MI.F64",
-	VDIV_PL_F64:       "VDIV.PL.F64",
-	VDIV_VS_F64:       "VDIV.VS.F64",
-	VDIV_VC_F64:       "VDIV.VC.F64",
-	VDIV_HI_F64:       "VDIV.HI.F64",
-	VDIV_LS_F64:       "VDIV.LS.F64",
-	VDIV_GE_F64:       "VDIV.GE.F64",
-	VDIV_LT_F64:       "VDIV.LT.F64",
-	VDIV_GT_F64:       "VDIV.GT.F64",
-	VDIV_LE_F64:       "VDIV.LE.F64",
-	VDIV_F64:          "VDIV.F64",
-	VDIV_ZZ_F64:       "VDIV.ZZ.F64",
-	VLDR_EQ:           "VLDR.EQ",
-	VLDR_NE:           "VLDR.NE",
-	VLDR_CS:           "VLDR.CS",
-	VLDR_CC:           "VLDR.CC",
-	VLDR_MI:           "VLDR.MI",
-	VLDR_PL:           "VLDR.PL",
-	VLDR_VS:           "VLDR.VS",
-	VLDR_VC:           "VLDR.VC",
-	VLDR_HI:           "VLDR.HI",
-	VLDR_LS:           "VLDR.LS",
-	VLDR_GE:           "VLDR.GE",
-	VLDR_LT:           "VLDR.LT",
-	VLDR_GT:           "VLDR.GT",
-	VLDR_LE:           "VLDR.LE",
-	VLDR:              "VLDR",
-	VLDR_ZZ:           "VLDR.ZZ",
-	VMLA_EQ_F32:       "VMLA.EQ.F32",
-	VMLA_NE_F32:       "VMLA.NE.F32",
-	VMLA_CS_F32:       "VMLA.CS.F32",
-	VMLA_CC_F32:       "VMLA.CC.F32",
-	VMLA_MI_F32:       "VMLA.MI.F32",
-	VMLA_PL_F32:       "VMLA.PL.F32",
-	VMLA_VS_F32:       "VMLA.VS.F32",
-	VMLA_VC_F32:       "VMLA.VC.F32",
-	VMLA_HI_F32:       "VMLA.HI.F32",
-	VMLA_LS_F32:       "VMLA.LS.F32",
-	VMLA_GE_F32:       "VMLA.GE.F32",
-	VMLA_LT_F32:       "VMLA.LT.F32",
-	VMLA_GT_F32:       "VMLA.GT.F32",
-	VMLA_LE_F32:       "VMLA.LE.F32",
-	VMLA_F32:          "VMLA.F32",
-	VMLA_ZZ_F32:       "VMLA.ZZ.F32",
-	VMLA_EQ_F64:       "VMLA.EQ.F64",
-	VMLA_NE_F64:       "VMLA.NE.F64",
-	VMLA_CS_F64:       "VMLA.CS.F64",
-	VMLA_CC_F64:       "VMLA.CC.F64",
-	VMLA_MI_F64:       "VMLA.MI.F64",
-	VMLA_PL_F64:       "VMLA.PL.F64",
-	VMLA_VS_F64:       "VMLA.VS.F64",
-	VMLA_VC_F64:       "VMLA.VC.F64",
-	VMLA_HI_F64:       "VMLA.HI.F64",
-	VMLA_LS_F64:       "VMLA.LS.F64",
-	VMLA_GE_F64:       "VMLA.GE.F64",
-	VMLA_LT_F64:       "VMLA.LT.F64",
-	VMLA_GT_F64:       "VMLA.GT.F64",
-	VMLA_LE_F64:       "VMLA.LE.F64",
-	VMLA_F64:          "VMLA.F64",
-	VMLA_ZZ_F64:       "VMLA.ZZ.F64",
-	VMLS_EQ_F32:       "VMLS.EQ.F32",
-	VMLS_NE_F32:       "VMLS.NE.F32",
-	VMLS_CS_F32:       "VMLS.CS.F32",
-	VMLS_CC_F32:       "VMLS.CC.F32",
-	VMLS_MI_F32:       "VMLS.MI.F32",
-	VMLS_PL_F32:       "VMLS.PL.F32",
-	VMLS_VS_F32:       "VMLS.VS.F32",
-	VMLS_VC_F32:       "VMLS.VC.F32",
-	VMLS_HI_F32:       "VMLS.HI.F32",
-	VMLS_LS_F32:       "VMLS.LS.F32",
-	VMLS_GE_F32:       "VMLS.GE.F32",
-	VMLS_LT_F32:       "VMLS.LT.F32",
-	VMLS_GT_F32:       "VMLS.GT.F32",
-	VMLS_LE_F32:       "VMLS.LE.F32",
-	VMLS_F32:          "VMLS.F32",
-	VMLS_ZZ_F32:       "VMLS.ZZ.F32",
-	VMLS_EQ_F64:       "VMLS.EQ.F64",
-	VMLS_NE_F64:       "VMLS.NE.F64",
-	VMLS_CS_F64:       "VMLS.CS.F64",
-	VMLS_CC_F64:       "VMLS.CC.F64",
-	VMLS_MI_F64:       "VMLS.MI.F64",
-	VMLS_PL_F64:       "VMLS.PL.F64",
-	VMLS_VS_F64:       "VMLS.VS.F64",
-	VMLS_VC_F64:       "VMLS.VC.F64",
-	VMLS_HI_F64:       "VMLS.HI.F64",
-	VMLS_LS_F64:       "VMLS.LS.F64",
-	VMLS_GE_F64:       "VMLS.GE.F64",
-	VMLS_LT_F64:       "VMLS.LT.F64",
-	VMLS_GT_F64:       "VMLS.GT.F64",
-	VMLS_LE_F64:       "VMLS.LE.F64",
-	VMLS_F64:          "VMLS.F64",
-	VMLS_ZZ_F64:       "VMLS.ZZ.F64",
-	VMOV_EQ:           "VMOV.EQ",
-	VMOV_NE:           "VMOV.NE",
-	VMOV_CS:           "VMOV.CS",
-	VMOV_CC:           "VMOV.CC",
-	VMOV_MI:           "VMOV.MI",
-	VMOV_PL:           "VMOV.PL",
-	VMOV_VS:           "VMOV.VS",
-	VMOV_VC:           "VMOV.VC",
-	VMOV_HI:           "VMOV.HI",
-	VMOV_LS:           "VMOV.LS",
-	VMOV_GE:           "VMOV.GE",
-	VMOV_LT:           "VMOV.LT",
-	VMOV_GT:           "VMOV.GT",
-	VMOV_LE:           "VMOV.LE",
-	VMOV:              "VMOV",
-	VMOV_ZZ:           "VMOV.ZZ",
-	VMOV_EQ_32:        "VMOV.EQ.32",
-	VMOV_NE_32:        "VMOV.NE.32",
-	VMOV_CS_32:        "VMOV.CS.32",
-	VMOV_CC_32:        "VMOV.CC.32",
-	VMOV_MI_32:        "VMOV.MI.32",
-	VMOV_PL_32:        "VMOV.PL.32",
-	VMOV_VS_32:        "VMOV.VS.32",
-	VMOV_VC_32:        "VMOV.VC.32",
-	VMOV_HI_32:        "VMOV.HI.32",
-	VMOV_LS_32:        "VMOV.LS.32",
-	VMOV_GE_32:        "VMOV.GE.32",
-	VMOV_LT_32:        "VMOV.LT.32",
-	VMOV_GT_32:        "VMOV.GT.32",
-	VMOV_LE_32:        "VMOV.LE.32",
-	VMOV_32:           "VMOV.32",
-	VMOV_ZZ_32:        "VMOV.ZZ.32",
-	VMOV_EQ_F32:       "VMOV.EQ.F32",
-	VMOV_NE_F32:       "VMOV.NE.F32",
-	VMOV_CS_F32:       "VMOV.CS.F32",
-	VMOV_CC_F32:       "VMOV.CC.F32",
-	VMOV_MI_F32:       "VMOV.MI.F32",
-	VMOV_PL_F32:       "VMOV.PL.F32",
-	VMOV_VS_F32:       "VMOV.VS.F32",
-	VMOV_VC_F32:       "VMOV.VC.F32",
-	VMOV_HI_F32:       "VMOV.HI.F32",
-	VMOV_LS_F32:       "VMOV.LS.F32",
-	VMOV_GE_F32:       "VMOV.GE.F32",
-	VMOV_LT_F32:       "VMOV.LT.F32",
-	VMOV_GT_F32:       "VMOV.GT.F32",
-	VMOV_LE_F32:       "VMOV.LE.F32",
-	VMOV_F32:          "VMOV.F32",
-	VMOV_ZZ_F32:       "VMOV.ZZ.F32",
-	VMOV_EQ_F64:       "VMOV.EQ.F64",
-	VMOV_NE_F64:       "VMOV.NE.F64",
-	VMOV_CS_F64:       "VMOV.CS.F64",
-	VMOV_CC_F64:       "VMOV.CC.F64",
-	VMOV_MI_F64:       "VMOV.MI.F64",
-	VMOV_PL_F64:       "VMOV.PL.F64",
-	VMOV_VS_F64:       "VMOV.VS.F64",
-	VMOV_VC_F64:       "VMOV.VC.F64",
-	VMOV_HI_F64:       "VMOV.HI.F64",
-	VMOV_LS_F64:       "VMOV.LS.F64",
-	VMOV_GE_F64:       "VMOV.GE.F64",
-	VMOV_LT_F64:       "VMOV.LT.F64",
-	VMOV_GT_F64:       "VMOV.GT.F64",
-	VMOV_LE_F64:       "VMOV.LE.F64",
-	VMOV_F64:          "VMOV.F64",
-	VMOV_ZZ_F64:       "VMOV.ZZ.F64",
-	VMRS_EQ:           "VMRS.EQ",
-	VMRS_NE:           "VMRS.NE",
-	VMRS_CS:           "VMRS.CS",
-	VMRS_CC:           "VMRS.CC",
-	VMRS_MI:           "VMRS.MI",
-	VMRS_PL:           "VMRS.PL",
-	VMRS_VS:           "VMRS.VS",
-	VMRS_VC:           "VMRS.VC",
-	VMRS_HI:           "VMRS.HI",
-	VMRS_LS:           "VMRS.LS",
-	VMRS_GE:           "VMRS.GE",
-	VMRS_LT:           "VMRS.LT",
-	VMRS_GT:           "VMRS.GT",
-	VMRS_LE:           "VMRS.LE",
-	VMRS:              "VMRS",
-	VMRS_ZZ:           "VMRS.ZZ",
-	VMSR_EQ:           "VMSR.EQ",
-	VMSR_NE:           "VMSR.NE",
-	VMSR_CS:           "VMSR.CS",
-	VMSR_CC:           "VMSR.CC",
-	VMSR_MI:           "VMSR.MI",
-	VMSR_PL:           "VMSR.PL",
-	VMSR_VS:           "VMSR.VS",
-	VMSR_VC:           "VMSR.VC",
-	VMSR_HI:           "VMSR.HI",
-	VMSR_LS:           "VMSR.LS",
-	VMSR_GE:           "VMSR.GE",
-	VMSR_LT:           "VMSR.LT",
-	VMSR_GT:           "VMSR.GT",
-	VMSR_LE:           "VMSR.LE",
-	VMSR:              "VMSR",
-	VMSR_ZZ:           "VMSR.ZZ",
-	VMUL_EQ_F32:       "VMUL.EQ.F32",
-	VMUL_NE_F32:       "VMUL.NE.F32",
-	VMUL_CS_F32:       "VMUL.CS.F32",
-	VMUL_CC_F32:       "VMUL.CC.F32",
-	VMUL_MI_F32:       "VMUL.MI.F32",
-	VMUL_PL_F32:       "VMUL.PL.F32",
-	VMUL_VS_F32:       "VMUL.VS.F32",
-	VMUL_VC_F32:       "VMUL.VC.F32",
-	VMUL_HI_F32:       "VMUL.HI.F32",
-	VMUL_LS_F32:       "VMUL.LS.F32",
-	VMUL_GE_F32:       "VMUL.GE.F32",
-	VMUL_LT_F32:       "VMUL.LT.F32",
-	VMUL_GT_F32:       "VMUL.GT.F32",
-	VMUL_LE_F32:       "VMUL.LE.F32",
-	VMUL_F32:          "VMUL.F32",
-	VMUL_ZZ_F32:       "VMUL.ZZ.F32",
-	VMUL_EQ_F64:       "VMUL.EQ.F64",
-	VMUL_NE_F64:       "VMUL.NE.F64",
-	VMUL_CS_F64:       "VMUL.CS.F64",
-	VMUL_CC_F64:       "VMUL.CC.F64",
-	VMUL_MI_F64:       "VMUL.MI.F64",
-	VMUL_PL_F64:       "VMUL.PL.F64",
-	VMUL_VS_F64:       "VMUL.VS.F64",
-	VMUL_VC_F64:       "VMUL.VC.F64",
-	VMUL_HI_F64:       "VMUL.HI.F64",
-	VMUL_LS_F64:       "VMUL.LS.F64",
-	VMUL_GE_F64:       "VMUL.GE.F64",
-	VMUL_LT_F64:       "VMUL.LT.F64",
-	VMUL_GT_F64:       "VMUL.GT.F64",
-	VMUL_LE_F64:       "VMUL.LE.F64",
-	VMUL_F64:          "VMUL.F64",
-	VMUL_ZZ_F64:       "VMUL.ZZ.F64",
-	VNEG_EQ_F32:       "VNEG.EQ.F32",
-	VNEG_NE_F32:       "VNEG.NE.F32",
-	VNEG_CS_F32:       "VNEG.CS.F32",
-	VNEG_CC_F32:       "VNEG.CC.F32",
-	VNEG_MI_F32:       "VNEG.MI.F32",
-	VNEG_PL_F32:       "VNEG.PL.F32",
-	VNEG_VS_F32:       "VNEG.VS.F32",
-	VNEG_VC_F32:       "VNEG.VC.F32",
-	VNEG_HI_F32:       "VNEG.HI.F32",
-	VNEG_LS_F32:       "VNEG.LS.F32",
-	VNEG_GE_F32:       "VNEG.GE.F32",
-	VNEG_LT_F32:       "VNEG.LT.F32",
-	VNEG_GT_F32:       "VNEG.GT.F32",
-	VNEG_LE_F32:       "VNEG.LE.F32",
-	VNEG_F32:          "VNEG.F32",
-	VNEG_ZZ_F32:       "VNEG.ZZ.F32",
-	VNEG_EQ_F64:       "VNEG.EQ.F64",
-	VNEG_NE_F64:       "VNEG.NE.F64",
-	VNEG_CS_F64:       "VNEG.CS.F64",
-	VNEG_CC_F64:       "VNEG.CC.F64",
-	VNEG_MI_F64:       "VNEG.MI.F64",
-	VNEG_PL_F64:       "VNEG.PL.F64",
-	VNEG_VS_F64:       "VNEG.VS.F64",
-	VNEG_VC_F64:       "VNEG.VC.F64",
-	VNEG_HI_F64:       "VNEG.HI.F64",
-	VNEG_LS_F64:       "VNEG.LS.F64",
-	VNEG_GE_F64:       "VNEG.GE.F64",
-	VNEG_LT_F64:       "VNEG.LT.F64",
-	VNEG_GT_F64:       "VNEG.GT.F64",
-	VNEG_LE_F64:       "VNEG.LE.F64",
-	VNEG_F64:          "VNEG.F64",
-	VNEG_ZZ_F64:       "VNEG.ZZ.F64",
-	VNMLS_EQ_F32:      "VNMLS.EQ.F32",
-	VNMLS_NE_F32:      "VNMLS.NE.F32",
-	VNMLS_CS_F32:      "VNMLS.CS.F32",
-	VNMLS_CC_F32:      "VNMLS.CC.F32",
-	VNMLS_MI_F32:      "VNMLS.MI.F32",
-	VNMLS_PL_F32:      "VNMLS.PL.F32",
-	VNMLS_VS_F32:      "VNMLS.VS.F32",
-	VNMLS_VC_F32:      "VNMLS.VC.F32",
-	VNMLS_HI_F32:      "VNMLS.HI.F32",
-	VNMLS_LS_F32:      "VNMLS.LS.F32",
-	VNMLS_GE_F32:      "VNMLS.GE.F32",
-	VNMLS_LT_F32:      "VNMLS.LT.F32",
-	VNMLS_GT_F32:      "VNMLS.GT.F32",
-	VNMLS_LE_F32:      "VNMLS.LE.F32",
-	VNMLS_F32:         "VNMLS.F32",
-	VNMLS_ZZ_F32:      "VNMLS.ZZ.F32",
-	VNMLS_EQ_F64:      "VNMLS.EQ.F64",
-	VNMLS_NE_F64:      "VNMLS.NE.F64",
-	VNMLS_CS_F64:      "VNMLS.CS.F64",
-	VNMLS_CC_F64:      "VNMLS.CC.F64",
-	VNMLS_MI_F64:      "VNMLS.MI.F64",
-	VNMLS_PL_F64:      "VNMLS.PL.F64",
-	VNMLS_VS_F64:      "VNMLS.VS.F64",
-	VNMLS_VC_F64:      "VNMLS.VC.F64",
-	VNMLS_HI_F64:      "VNMLS.HI.F64",
-	VNMLS_LS_F64:      "VNMLS.LS.F64",
-	VNMLS_GE_F64:      "VNMLS.GE.F64",
-	VNMLS_LT_F64:      "VNMLS.LT.F64",
-	VNMLS_GT_F64:      "VNMLS.GT.F64",
-	VNMLS_LE_F64:      "VNMLS.LE.F64",
-	VNMLS_F64:         "VNMLS.F64",
-	VNMLS_ZZ_F64:      "VNMLS.ZZ.F64",
-	VNMLA_EQ_F32:      "VNMLA.EQ.F32",
-	VNMLA_NE_F32:      "VNMLA.NE.F32",
-	VNMLA_CS_F32:      "VNMLA.CS.F32",
-	VNMLA_CC_F32:      "VNMLA.CC.F32",
-	VNMLA_MI_F32:      "VNMLA.MI.F32",
-	VNMLA_PL_F32:      "VNMLA.PL.F32",
-	VNMLA_VS_F32:      "VNMLA.VS.F32",
-	VNMLA_VC_F32:      "VNMLA.VC.F32",
-	VNMLA_HI_F32:      "VNMLA.HI.F32",
-	VNMLA_LS_F32:      "VNMLA.LS.F32",
-	VNMLA_GE_F32:      "VNMLA.GE.F32",
-	VNMLA_LT_F32:      "VNMLA.LT.F32",
-	VNMLA_GT_F32:      "VNMLA.GT.F32",
-	VNMLA_LE_F32:      "VNMLA.LE.F32",
-	VNMLA_F32:         "VNMLA.F32",
-	VNMLA_ZZ_F32:      "VNMLA.ZZ.F32",
-	VNMLA_EQ_F64:      "VNMLA.EQ.F64",
-	VNMLA_NE_F64:      "VNMLA.NE.F64",
-	VNMLA_CS_F64:      "VNMLA.CS.F64",
-	VNMLA_CC_F64:      "VNMLA.CC.F64",
-	VNMLA_MI_F64:      "VNMLA.MI.F64",
-	VNMLA_PL_F64:      "VNMLA.PL.F64",
-	VNMLA_VS_F64:      "VNMLA.VS.F64",
-	VNMLA_VC_F64:      "VNMLA.VC.F64",
-	VNMLA_HI_F64:      "VNMLA.HI.F64",
-	VNMLA_LS_F64:      "VNMLA.LS.F64",
-	VNMLA_GE_F64:      "VNMLA.GE.F64",
-	VNMLA_LT_F64:      "VNMLA.LT.F64",
-	VNMLA_GT_F64:      "VNMLA.GT.F64",
-	VNMLA_LE_F64:      "VNMLA.LE.F64",
-	VNMLA_F64:         "VNMLA.F64",
-	VNMLA_ZZ_F64:      "VNMLA.ZZ.F64",
-	VNMUL_EQ_F32:      "VNMUL.EQ.F32",
-	VNMUL_NE_F32:      "VNMUL.NE.F32",
-	VNMUL_CS_F32:      "VNMUL.CS.F32",
-	VNMUL_CC_F32:      "VNMUL.CC.F32",
-	VNMUL_MI_F32:      "VNMUL.MI.F32",
-	VNMUL_PL_F32:      "VNMUL.PL.F32",
-	VNMUL_VS_F32:      "VNMUL.VS.F32",
-	VNMUL_VC_F32:      "VNMUL.VC.F32",
-	VNMUL_HI_F32:      "VNMUL.HI.F32",
-	VNMUL_LS_F32:      "VNMUL.LS.F32",
-	VNMUL_GE_F32:      "VNMUL.GE.F32",
-	VNMUL_LT_F32:      "VNMUL.LT.F32",
-	VNMUL_GT_F32:      "VNMUL.GT.F32",
-	VNMUL_LE_F32:      "VNMUL.LE.F32",
-	VNMUL_F32:         "VNMUL.F32",
-	VNMUL_ZZ_F32:      "VNMUL.ZZ.F32",
-	VNMUL_EQ_F64:      "VNMUL.EQ.F64",
-	VNMUL_NE_F64:      "VNMUL.NE.F64",
-	VNMUL_CS_F64:      "VNMUL.CS.F64",
-	VNMUL_CC_F64:      "VNMUL.CC.F64",
-	VNMUL_MI_F64:      "VNMUL.MI.F64",
-	VNMUL_PL_F64:      "VNMUL.PL.F64",
-	VNMUL_VS_F64:      "VNMUL.VS.F64",
-	VNMUL_VC_F64:      "VNMUL.VC.F64",
-	VNMUL_HI_F64:      "VNMUL.HI.F64",
-	VNMUL_LS_F64:      "VNMUL.LS.F64",
-	VNMUL_GE_F64:      "VNMUL.GE.F64",
-	VNMUL_LT_F64:      "VNMUL.LT.F64",
-	VNMUL_GT_F64:      "VNMUL.GT.F64",
-	VNMUL_LE_F64:      "VNMUL.LE.F64",
-	VNMUL_F64:         "VNMUL.F64",
-	VNMUL_ZZ_F64:      "VNMUL.ZZ.F64",
-	VSQRT_EQ_F32:      "VSQRT.EQ.F32",
-	VSQRT_NE_F32:      "VSQRT.NE.F32",
-	VSQRT_CS_F32:      "VSQRT.CS.F32",
-	VSQRT_CC_F32:      "VSQRT.CC.F32",
-	VSQRT_MI_F32:      "VSQRT.MI.F32",
-	VSQRT_PL_F32:      "VSQRT.PL.F32",
-	VSQRT_VS_F32:      "VSQRT.VS.F32",
-	VSQRT_VC_F32:      "VSQRT.VC.F32",
-	VSQRT_HI_F32:      "VSQRT.HI.F32",
-	VSQRT_LS_F32:      "VSQRT.LS.F32",
-	VSQRT_GE_F32:      "VSQRT.GE.F32",
-	VSQRT_LT_F32:      "VSQRT.LT.F32",
-	VSQRT_GT_F32:      "VSQRT.GT.F32",
-	VSQRT_LE_F32:      "VSQRT.LE.F32",
-	VSQRT_F32:         "VSQRT.F32",
-	VSQRT_ZZ_F32:      "VSQRT.ZZ.F32",
-	VSQRT_EQ_F64:      "VSQRT.EQ.F64",
-	VSQRT_NE_F64:      "VSQRT.NE.F64",
-	VSQRT_CS_F64:      "VSQRT.CS.F64",
-	VSQRT_CC_F64:      "VSQRT.CC.F64",
-	VSQRT_MI_F64:      "VSQRT.MI.F64",
-	VSQRT_PL_F64:      "VSQRT.PL.F64",
-	VSQRT_VS_F64:      "VSQRT.VS.F64",
-	VSQRT_VC_F64:      "VSQRT.VC.F64",
-	VSQRT_HI_F64:      "VSQRT.HI.F64",
-	VSQRT_LS_F64:      "VSQRT.LS.F64",
-	VSQRT_GE_F64:      "VSQRT.GE.F64",
-	VSQRT_LT_F64:      "VSQRT.LT.F64",
-	VSQRT_GT_F64:      "VSQRT.GT.F64",
-	VSQRT_LE_F64:      "VSQRT.LE.F64",
-	VSQRT_F64:         "VSQRT.F64",
-	VSQRT_ZZ_F64:      "VSQRT.ZZ.F64",
-	VSTR_EQ:           "VSTR.EQ",
-	VSTR_NE:           "VSTR.NE",
-	VSTR_CS:           "VSTR.CS",
-	VSTR_CC:           "VSTR.CC",
-	VSTR_MI:           "VSTR.MI",
-	VSTR_PL:           "VSTR.PL",
-	VSTR_VS:           "VSTR.VS",
-	VSTR_VC:           "VSTR.VC",
-	VSTR_HI:           "VSTR.HI",
-	VSTR_LS:           "VSTR.LS",
-	VSTR_GE:           "VSTR.GE",
-	VSTR_LT:           "VSTR.LT",
-	VSTR_GT:           "VSTR.GT",
-	VSTR_LE:           "VSTR.LE",
-	VSTR:              "VSTR",
-	VSTR_ZZ:           "VSTR.ZZ",
-	VSUB_EQ_F32:       "VSUB.EQ.F32",
-	VSUB_NE_F32:       "VSUB.NE.F32",
-	VSUB_CS_F32:       "VSUB.CS.F32",
-	VSUB_CC_F32:       "VSUB.CC.F32",
-	VSUB_MI_F32:       "VSUB.MI.F32",
-	VSUB_PL_F32:       "VSUB.PL.F32",
-	VSUB_VS_F32:       "VSUB.VS.F32",
-	VSUB_VC_F32:       "VSUB.VC.F32",
-	VSUB_HI_F32:       "VSUB.HI.F32",
-	VSUB_LS_F32:       "VSUB.LS.F32",
-	VSUB_GE_F32:       "VSUB.GE.F32",
-	VSUB_LT_F32:       "VSUB.LT.F32",
-	VSUB_GT_F32:       "VSUB.GT.F32",
-	VSUB_LE_F32:       "VSUB.LE.F32",
-	VSUB_F32:          "VSUB.F32",
-	VSUB_ZZ_F32:       "VSUB.ZZ.F32",
-	VSUB_EQ_F64:       "VSUB.EQ.F64",
-	VSUB_NE_F64:       "VSUB.NE.F64",
-	VSUB_CS_F64:       "VSUB.CS.F64",
-	VSUB_CC_F64:       "VSUB.CC.F64",
-	VSUB_MI_F64:       "VSUB.MI.F64",
-	VSUB_PL_F64:       "VSUB.PL.F64",
-	VSUB_VS_F64:       "VSUB.VS.F64",
-	VSUB_VC_F64:       "VSUB.VC.F64",
-	VSUB_HI_F64:       "VSUB.HI.F64",
-	VSUB_LS_F64:       "VSUB.LS.F64",
-	VSUB_GE_F64:       "VSUB.GE.F64",
-	VSUB_LT_F64:       "VSUB.LT.F64",
-	VSUB_GT_F64:       "VSUB.GT.F64",
-	VSUB_LE_F64:       "VSUB.LE.F64",
-	VSUB_F64:          "VSUB.F64",
-	VSUB_ZZ_F64:       "VSUB.ZZ.F64",
-	WFE_EQ:            "WFE.EQ",
-	WFE_NE:            "WFE.NE",
-	WFE_CS:            "WFE.CS",
-	WFE_CC:            "WFE.CC",
-	WFE_MI:            "WFE.MI",
-	WFE_PL:            "WFE.PL",
-	WFE_VS:            "WFE.VS",
-	WFE_VC:            "WFE.VC",
-	WFE_HI:            "WFE.HI",
-	WFE_LS:            "WFE.LS",
-	WFE_GE:            "WFE.GE",
-	WFE_LT:            "WFE.LT",
-	WFE_GT:            "WFE.GT",
-	WFE_LE:            "WFE.LE",
-	WFE:               "WFE",
-	WFE_ZZ:            "WFE.ZZ",
-	WFI_EQ:            "WFI.EQ",
-	WFI_NE:            "WFI.NE",
-	WFI_CS:            "WFI.CS",
-	WFI_CC:            "WFI.CC",
-	WFI_MI:            "WFI.MI",
-	WFI_PL:            "WFI.PL",
-	WFI_VS:            "WFI.VS",
-	WFI_VC:            "WFI.VC",
-	WFI_HI:            "WFI.HI",
-	WFI_LS:            "WFI.LS",
-	WFI_GE:            "WFI.GE",
-	WFI_LT:            "WFI.LT",
-	WFI_GT:            "WFI.GT",
-	WFI_LE:            "WFI.LE",
-	WFI:               "WFI",
-	WFI_ZZ:            "WFI.ZZ",
-	YIELD_EQ:          "YIELD.EQ",
-	YIELD_NE:          "YIELD.NE",
-	YIELD_CS:          "YIELD.CS",
-	YIELD_CC:          "YIELD.CC",
-	YIELD_MI:          "YIELD.MI",
-	YIELD_PL:          "YIELD.PL",
-	YIELD_VS:          "YIELD.VS",
-	YIELD_VC:          "YIELD.VC",
-	YIELD_HI:          "YIELD.HI",
-	YIELD_LS:          "YIELD.LS",
-	YIELD_GE:          "YIELD.GE",
-	YIELD_LT:          "YIELD.LT",
-	YIELD_GT:          "YIELD.GT",
-	YIELD_LE:          "YIELD.LE",
-	YIELD:             "YIELD",
-	YIELD_ZZ:          "YIELD.ZZ",
+// opstrData holds the opcode names, concatenated in opcode order.
+// The name of op is opstrData[opstrIndex[op]:opstrIndex[op+1]].
+const opstrData = "" +
+	"ADC.EQADC.NEADC.CSADC.CCADC.MIADC.PLADC.VSADC.VCADC.HIADC.LS" +
+	"ADC.GEADC.LTADC.GTADC.LEADCADC.ZZADC.S.EQADC.S.NEADC.S.CS" +
+	"ADC.S.CCADC.S.MIADC.S.PLADC.S.VSADC.S.VCADC.S.HIADC.S.LSADC.S.GE" +
+	"ADC.S.LTADC.S.GTADC.S.LEADC.SADC.S.ZZADD.EQADD.NEADD.CSADD.CC" +
+	"ADD.MIADD.PLADD.VSADD.VCADD.HIADD.LSADD.GEADD.LTADD.GTADD.LEADD" +
+	"ADD.ZZADD.S.EQADD.S.NEADD.S.CSADD.S.CCADD.S.MIADD.S.PLADD.S.VS" +
+	"ADD.S.VCADD.S.HIADD.S.LSADD.S.GEADD.S.LTADD.S.GTADD.S.LEADD.S" +
+	"ADD.S.ZZAND.EQAND.NEAND.CSAND.CCAND.MIAND.PLAND.VSAND.VCAND.HI" +
+	"AND.LSAND.GEAND.LTAND.GTAND.LEANDAND.ZZAND.S.EQAND.S.NEAND.S.CS" +
+	"AND.S.CCAND.S.MIAND.S.PLAND.S.VSAND.S.VCAND.S.HIAND.S.LSAND.S.GE" +
+	"AND.S.LTAND.S.GTAND.S.LEAND.SAND.S.ZZASR.EQASR.NEASR.CSASR.CC" +
+	"ASR.MIASR.PLASR.VSASR.VCASR.HIASR.LSASR.GEASR.LTASR.GTASR.LEASR" +
+	"ASR.ZZASR.S.EQASR.S.NEASR.S.CSASR.S.CCASR.S.MIASR.S.PLASR.S.VS" +
+	"ASR.S.VCASR.S.HIASR.S.LSASR.S.GEASR.S.LTASR.S.GTASR.S.LEASR.S" +
+	"ASR.S.ZZB.EQB.NEB.CSB.CCB.MIB.PLB.VSB.VCB.HIB.LSB.GEB.LTB.GTB.LE" +
+	"BB.ZZBFC.EQBFC.NEBFC.CSBFC.CCBFC.MIBFC.PLBFC.VSBFC.VCBFC.HI" +
+	"BFC.LSBFC.GEBFC.LTBFC.GTBFC.LEBFCBFC.ZZBFI.EQBFI.NEBFI.CSBFI.CC" +
+	"BFI.MIBFI.PLBFI.VSBFI.VCBFI.HIBFI.LSBFI.GEBFI.LTBFI.GTBFI.LEBFI" +
+	"BFI.ZZBIC.EQBIC.NEBIC.CSBIC.CCBIC.MIBIC.PLBIC.VSBIC.VCBIC.HI" +
+	"BIC.LSBIC.GEBIC.LTBIC.GTBIC.LEBICBIC.ZZBIC.S.EQBIC.S.NEBIC.S.CS" +
+	"BIC.S.CCBIC.S.MIBIC.S.PLBIC.S.VSBIC.S.VCBIC.S.HIBIC.S.LSBIC.S.GE" +
+	"BIC.S.LTBIC.S.GTBIC.S.LEBIC.SBIC.S.ZZBKPT.EQBKPT.NEBKPT.CS" +
+	"BKPT.CCBKPT.MIBKPT.PLBKPT.VSBKPT.VCBKPT.HIBKPT.LSBKPT.GEBKPT.LT" +
+	"BKPT.GTBKPT.LEBKPTBKPT.ZZBL.EQBL.NEBL.CSBL.CCBL.MIBL.PLBL.VS" +
+	"BL.VCBL.HIBL.LSBL.GEBL.LTBL.GTBL.LEBLBL.ZZBLX.EQBLX.NEBLX.CS" +
+	"BLX.CCBLX.MIBLX.PLBLX.VSBLX.VCBLX.HIBLX.LSBLX.GEBLX.LTBLX.GT" +
+	"BLX.LEBLXBLX.ZZBX.EQBX.NEBX.CSBX.CCBX.MIBX.PLBX.VSBX.VCBX.HI" +
+	"BX.LSBX.GEBX.LTBX.GTBX.LEBXBX.ZZBXJ.EQBXJ.NEBXJ.CSBXJ.CCBXJ.MI" +
+	"BXJ.PLBXJ.VSBXJ.VCBXJ.HIBXJ.LSBXJ.GEBXJ.LTBXJ.GTBXJ.LEBXJBXJ.ZZ" +
+	"CLREXCLZ.EQCLZ.NECLZ.CSCLZ.CCCLZ.MICLZ.PLCLZ.VSCLZ.VCCLZ.HI" +
+	"CLZ.LSCLZ.GECLZ.LTCLZ.GTCLZ.LECLZCLZ.ZZCMN.EQCMN.NECMN.CSCMN.CC" +
+	"CMN.MICMN.PLCMN.VSCMN.VCCMN.HICMN.LSCMN.GECMN.LTCMN.GTCMN.LECMN" +
+	"CMN.ZZCMP.EQCMP.NECMP.CSCMP.CCCMP.MICMP.PLCMP.VSCMP.VCCMP.HI" +
+	"CMP.LSCMP.GECMP.LTCMP.GTCMP.LECMPCMP.ZZDBG.EQDBG.NEDBG.CSDBG.CC" +
+	"DBG.MIDBG.PLDBG.VSDBG.VCDBG.HIDBG.LSDBG.GEDBG.LTDBG.GTDBG.LEDBG" +
+	"DBG.ZZDMBDSBEOR.EQEOR.NEEOR.CSEOR.CCEOR.MIEOR.PLEOR.VSEOR.VC" +
+	"EOR.HIEOR.LSEOR.GEEOR.LTEOR.GTEOR.LEEOREOR.ZZEOR.S.EQEOR.S.NE" +
+	"EOR.S.CSEOR.S.CCEOR.S.MIEOR.S.PLEOR.S.VSEOR.S.VCEOR.S.HIEOR.S.LS" +
+	"EOR.S.GEEOR.S.LTEOR.S.GTEOR.S.LEEOR.SEOR.S.ZZERET.EQERET.NE" +
+	"ERET.CSERET.CCERET.MIERET.PLERET.VSERET.VCERET.HIERET.LSERET.GE" +
+	"ERET.LTERET.GTERET.LEERETERET.ZZISBLDM.EQLDM.NELDM.CSLDM.CC" +
+	"LDM.MILDM.PLLDM.VSLDM.VCLDM.HILDM.LSLDM.GELDM.LTLDM.GTLDM.LELDM" +
+	"LDM.ZZLDMDA.EQLDMDA.NELDMDA.CSLDMDA.CCLDMDA.MILDMDA.PLLDMDA.VS" +
+	"LDMDA.VCLDMDA.HILDMDA.LSLDMDA.GELDMDA.LTLDMDA.GTLDMDA.LELDMDA" +
+	"LDMDA.ZZLDMDB.EQLDMDB.NELDMDB.CSLDMDB.CCLDMDB.MILDMDB.PLLDMDB.VS" +
+	"LDMDB.VCLDMDB.HILDMDB.LSLDMDB.GELDMDB.LTLDMDB.GTLDMDB.LELDMDB" +
+	"LDMDB.ZZLDMIB.EQLDMIB.NELDMIB.CSLDMIB.CCLDMIB.MILDMIB.PLLDMIB.VS" +
+	"LDMIB.VCLDMIB.HILDMIB.LSLDMIB.GELDMIB.LTLDMIB.GTLDMIB.LELDMIB" +
+	"LDMIB.ZZLDR.EQLDR.NELDR.CSLDR.CCLDR.MILDR.PLLDR.VSLDR.VCLDR.HI" +
+	"LDR.LSLDR.GELDR.LTLDR.GTLDR.LELDRLDR.ZZLDRB.EQLDRB.NELDRB.CS" +
+	"LDRB.CCLDRB.MILDRB.PLLDRB.VSLDRB.VCLDRB.HILDRB.LSLDRB.GELDRB.LT" +
+	"LDRB.GTLDRB.LELDRBLDRB.ZZLDRBT.EQLDRBT.NELDRBT.CSLDRBT.CC" +
+	"LDRBT.MILDRBT.PLLDRBT.VSLDRBT.VCLDRBT.HILDRBT.LSLDRBT.GELDRBT.LT" +
+	"LDRBT.GTLDRBT.LELDRBTLDRBT.ZZLDRD.EQLDRD.NELDRD.CSLDRD.CCLDRD.MI" +
+	"LDRD.PLLDRD.VSLDRD.VCLDRD.HILDRD.LSLDRD.GELDRD.LTLDRD.GTLDRD.LE" +
+	"LDRDLDRD.ZZLDREX.EQLDREX.NELDREX.CSLDREX.CCLDREX.MILDREX.PL" +
+	"LDREX.VSLDREX.VCLDREX.HILDREX.LSLDREX.GELDREX.LTLDREX.GTLDREX.LE" +
+	"LDREXLDREX.ZZLDREXB.EQLDREXB.NELDREXB.CSLDREXB.CCLDREXB.MI" +
+	"LDREXB.PLLDREXB.VSLDREXB.VCLDREXB.HILDREXB.LSLDREXB.GELDREXB.LT" +
+	"LDREXB.GTLDREXB.LELDREXBLDREXB.ZZLDREXD.EQLDREXD.NELDREXD.CS" +
+	"LDREXD.CCLDREXD.MILDREXD.PLLDREXD.VSLDREXD.VCLDREXD.HILDREXD.LS" +
+	"LDREXD.GELDREXD.LTLDREXD.GTLDREXD.LELDREXDLDREXD.ZZLDREXH.EQ" +
+	"LDREXH.NELDREXH.CSLDREXH.CCLDREXH.MILDREXH.PLLDREXH.VSLDREXH.VC" +
+	"LDREXH.HILDREXH.LSLDREXH.GELDREXH.LTLDREXH.GTLDREXH.LELDREXH" +
+	"LDREXH.ZZLDRH.EQLDRH.NELDRH.CSLDRH.CCLDRH.MILDRH.PLLDRH.VS" +
+	"LDRH.VCLDRH.HILDRH.LSLDRH.GELDRH.LTLDRH.GTLDRH.LELDRHLDRH.ZZ" +
+	"LDRHT.EQLDRHT.NELDRHT.CSLDRHT.CCLDRHT.MILDRHT.PLLDRHT.VSLDRHT.VC" +
+	"LDRHT.HILDRHT.LSLDRHT.GELDRHT.LTLDRHT.GTLDRHT.LELDRHTLDRHT.ZZ" +
+	"LDRSB.EQLDRSB.NELDRSB.CSLDRSB.CCLDRSB.MILDRSB.PLLDRSB.VSLDRSB.VC" +
+	"LDRSB.HILDRSB.LSLDRSB.GELDRSB.LTLDRSB.GTLDRSB.LELDRSBLDRSB.ZZ" +
+	"LDRSBT.EQLDRSBT.NELDRSBT.CSLDRSBT.CCLDRSBT.MILDRSBT.PLLDRSBT.VS" +
+	"LDRSBT.VCLDRSBT.HILDRSBT.LSLDRSBT.GELDRSBT.LTLDRSBT.GTLDRSBT.LE" +
+	"LDRSBTLDRSBT.ZZLDRSH.EQLDRSH.NELDRSH.CSLDRSH.CCLDRSH.MILDRSH.PL" +
+	"LDRSH.VSLDRSH.VCLDRSH.HILDRSH.LSLDRSH.GELDRSH.LTLDRSH.GTLDRSH.LE" +
+	"LDRSHLDRSH.ZZLDRSHT.EQLDRSHT.NELDRSHT.CSLDRSHT.CCLDRSHT.MI" +
+	"LDRSHT.PLLDRSHT.VSLDRSHT.VCLDRSHT.HILDRSHT.LSLDRSHT.GELDRSHT.LT" +
+	"LDRSHT.GTLDRSHT.LELDRSHTLDRSHT.ZZLDRT.EQLDRT.NELDRT.CSLDRT.CC" +
+	"LDRT.MILDRT.PLLDRT.VSLDRT.VCLDRT.HILDRT.LSLDRT.GELDRT.LTLDRT.GT" +
+	"LDRT.LELDRTLDRT.ZZLSL.EQLSL.NELSL.CSLSL.CCLSL.MILSL.PLLSL.VS" +
+	"LSL.VCLSL.HILSL.LSLSL.GELSL.LTLSL.GTLSL.LELSLLSL.ZZLSL.S.EQ" +
+	"LSL.S.NELSL.S.CSLSL.S.CCLSL.S.MILSL.S.PLLSL.S.VSLSL.S.VCLSL.S.HI" +
+	"LSL.S.LSLSL.S.GELSL.S.LTLSL.S.GTLSL.S.LELSL.SLSL.S.ZZLSR.EQ" +
+	"LSR.NELSR.CSLSR.CCLSR.MILSR.PLLSR.VSLSR.VCLSR.HILSR.LSLSR.GE" +
+	"LSR.LTLSR.GTLSR.LELSRLSR.ZZLSR.S.EQLSR.S.NELSR.S.CSLSR.S.CC" +
+	"LSR.S.MILSR.S.PLLSR.S.VSLSR.S.VCLSR.S.HILSR.S.LSLSR.S.GELSR.S.LT" +
+	"LSR.S.GTLSR.S.LELSR.SLSR.S.ZZMLA.EQMLA.NEMLA.CSMLA.CCMLA.MI" +
+	"MLA.PLMLA.VSMLA.VCMLA.HIMLA.LSMLA.GEMLA.LTMLA.GTMLA.LEMLAMLA.ZZ" +
+	"MLA.S.EQMLA.S.NEMLA.S.CSMLA.S.CCMLA.S.MIMLA.S.PLMLA.S.VSMLA.S.VC" +
+	"MLA.S.HIMLA.S.LSMLA.S.GEMLA.S.LTMLA.S.GTMLA.S.LEMLA.SMLA.S.ZZ" +
+	"MLS.EQMLS.NEMLS.CSMLS.CCMLS.MIMLS.PLMLS.VSMLS.VCMLS.HIMLS.LS" +
+	"MLS.GEMLS.LTMLS.GTMLS.LEMLSMLS.ZZMOV.EQMOV.NEMOV.CSMOV.CCMOV.MI" +
+	"MOV.PLMOV.VSMOV.VCMOV.HIMOV.LSMOV.GEMOV.LTMOV.GTMOV.LEMOVMOV.ZZ" +
+	"MOV.S.EQMOV.S.NEMOV.S.CSMOV.S.CCMOV.S.MIMOV.S.PLMOV.S.VSMOV.S.VC" +
+	"MOV.S.HIMOV.S.LSMOV.S.GEMOV.S.LTMOV.S.GTMOV.S.LEMOV.SMOV.S.ZZ" +
+	"MOVT.EQMOVT.NEMOVT.CSMOVT.CCMOVT.MIMOVT.PLMOVT.VSMOVT.VCMOVT.HI" +
+	"MOVT.LSMOVT.GEMOVT.LTMOVT.GTMOVT.LEMOVTMOVT.ZZMOVW.EQMOVW.NE" +
+	"MOVW.CSMOVW.CCMOVW.MIMOVW.PLMOVW.VSMOVW.VCMOVW.HIMOVW.LSMOVW.GE" +
+	"MOVW.LTMOVW.GTMOVW.LEMOVWMOVW.ZZMRS.EQMRS.NEMRS.CSMRS.CCMRS.MI" +
+	"MRS.PLMRS.VSMRS.VCMRS.HIMRS.LSMRS.GEMRS.LTMRS.GTMRS.LEMRSMRS.ZZ" +
+	"MUL.EQMUL.NEMUL.CSMUL.CCMUL.MIMUL.PLMUL.VSMUL.VCMUL.HIMUL.LS" +
+	"MUL.GEMUL.LTMUL.GTMUL.LEMULMUL.ZZMUL.S.EQMUL.S.NEMUL.S.CS" +
+	"MUL.S.CCMUL.S.MIMUL.S.PLMUL.S.VSMUL.S.VCMUL.S.HIMUL.S.LSMUL.S.GE" +
+	"MUL.S.LTMUL.S.GTMUL.S.LEMUL.SMUL.S.ZZMVN.EQMVN.NEMVN.CSMVN.CC" +
+	"MVN.MIMVN.PLMVN.VSMVN.VCMVN.HIMVN.LSMVN.GEMVN.LTMVN.GTMVN.LEMVN" +
+	"MVN.ZZMVN.S.EQMVN.S.NEMVN.S.CSMVN.S.CCMVN.S.MIMVN.S.PLMVN.S.VS" +
+	"MVN.S.VCMVN.S.HIMVN.S.LSMVN.S.GEMVN.S.LTMVN.S.GTMVN.S.LEMVN.S" +
+	"MVN.S.ZZNOP.EQNOP.NENOP.CSNOP.CCNOP.MINOP.PLNOP.VSNOP.VCNOP.HI" +
+	"NOP.LSNOP.GENOP.LTNOP.GTNOP.LENOPNOP.ZZORR.EQORR.NEORR.CSORR.CC" +
+	"ORR.MIORR.PLORR.VSORR.VCORR.HIORR.LSORR.GEORR.LTORR.GTORR.LEORR" +
+	"ORR.ZZORR.S.EQORR.S.NEORR.S.CSORR.S.CCORR.S.MIORR.S.PLORR.S.VS" +
+	"ORR.S.VCORR.S.HIORR.S.LSORR.S.GEORR.S.LTORR.S.GTORR.S.LEORR.S" +
+	"ORR.S.ZZPKHBT.EQPKHBT.NEPKHBT.CSPKHBT.CCPKHBT.MIPKHBT.PLPKHBT.VS" +
+	"PKHBT.VCPKHBT.HIPKHBT.LSPKHBT.GEPKHBT.LTPKHBT.GTPKHBT.LEPKHBT" +
+	"PKHBT.ZZPKHTB.EQPKHTB.NEPKHTB.CSPKHTB.CCPKHTB.MIPKHTB.PLPKHTB.VS" +
+	"PKHTB.VCPKHTB.HIPKHTB.LSPKHTB.GEPKHTB.LTPKHTB.GTPKHTB.LEPKHTB" +
+	"PKHTB.ZZPLD.WPLDPLIPOP.EQPOP.NEPOP.CSPOP.CCPOP.MIPOP.PLPOP.VS" +
+	"POP.VCPOP.HIPOP.LSPOP.GEPOP.LTPOP.GTPOP.LEPOPPOP.ZZPUSH.EQ" +
+	"PUSH.NEPUSH.CSPUSH.CCPUSH.MIPUSH.PLPUSH.VSPUSH.VCPUSH.HIPUSH.LS" +
+	"PUSH.GEPUSH.LTPUSH.GTPUSH.LEPUSHPUSH.ZZQADD.EQQADD.NEQADD.CS" +
+	"QADD.CCQADD.MIQADD.PLQADD.VSQADD.VCQADD.HIQADD.LSQADD.GEQADD.LT" +
+	"QADD.GTQADD.LEQADDQADD.ZZQADD16.EQQADD16.NEQADD16.CSQADD16.CC" +
+	"QADD16.MIQADD16.PLQADD16.VSQADD16.VCQADD16.HIQADD16.LSQADD16.GE" +
+	"QADD16.LTQADD16.GTQADD16.LEQADD16QADD16.ZZQADD8.EQQADD8.NE" +
+	"QADD8.CSQADD8.CCQADD8.MIQADD8.PLQADD8.VSQADD8.VCQADD8.HIQADD8.LS" +
+	"QADD8.GEQADD8.LTQADD8.GTQADD8.LEQADD8QADD8.ZZQASX.EQQASX.NE" +
+	"QASX.CSQASX.CCQASX.MIQASX.PLQASX.VSQASX.VCQASX.HIQASX.LSQASX.GE" +
+	"QASX.LTQASX.GTQASX.LEQASXQASX.ZZQDADD.EQQDADD.NEQDADD.CSQDADD.CC" +
+	"QDADD.MIQDADD.PLQDADD.VSQDADD.VCQDADD.HIQDADD.LSQDADD.GEQDADD.LT" +
+	"QDADD.GTQDADD.LEQDADDQDADD.ZZQDSUB.EQQDSUB.NEQDSUB.CSQDSUB.CC" +
+	"QDSUB.MIQDSUB.PLQDSUB.VSQDSUB.VCQDSUB.HIQDSUB.LSQDSUB.GEQDSUB.LT" +
+	"QDSUB.GTQDSUB.LEQDSUBQDSUB.ZZQSAX.EQQSAX.NEQSAX.CSQSAX.CCQSAX.MI" +
+	"QSAX.PLQSAX.VSQSAX.VCQSAX.HIQSAX.LSQSAX.GEQSAX.LTQSAX.GTQSAX.LE" +
+	"QSAXQSAX.ZZQSUB.EQQSUB.NEQSUB.CSQSUB.CCQSUB.MIQSUB.PLQSUB.VS" +
+	"QSUB.VCQSUB.HIQSUB.LSQSUB.GEQSUB.LTQSUB.GTQSUB.LEQSUBQSUB.ZZ" +
+	"QSUB16.EQQSUB16.NEQSUB16.CSQSUB16.CCQSUB16.MIQSUB16.PLQSUB16.VS" +
+	"QSUB16.VCQSUB16.HIQSUB16.LSQSUB16.GEQSUB16.LTQSUB16.GTQSUB16.LE" +
+	"QSUB16QSUB16.ZZQSUB8.EQQSUB8.NEQSUB8.CSQSUB8.CCQSUB8.MIQSUB8.PL" +
+	"QSUB8.VSQSUB8.VCQSUB8.HIQSUB8.LSQSUB8.GEQSUB8.LTQSUB8.GTQSUB8.LE" +
+	"QSUB8QSUB8.ZZRBIT.EQRBIT.NERBIT.CSRBIT.CCRBIT.MIRBIT.PLRBIT.VS" +
+	"RBIT.VCRBIT.HIRBIT.LSRBIT.GERBIT.LTRBIT.GTRBIT.LERBITRBIT.ZZ" +
+	"REV.EQREV.NEREV.CSREV.CCREV.MIREV.PLREV.VSREV.VCREV.HIREV.LS" +
+	"REV.GEREV.LTREV.GTREV.LEREVREV.ZZREV16.EQREV16.NEREV16.CS" +
+	"REV16.CCREV16.MIREV16.PLREV16.VSREV16.VCREV16.HIREV16.LSREV16.GE" +
+	"REV16.LTREV16.GTREV16.LEREV16REV16.ZZREVSH.EQREVSH.NEREVSH.CS" +
+	"REVSH.CCREVSH.MIREVSH.PLREVSH.VSREVSH.VCREVSH.HIREVSH.LSREVSH.GE" +
+	"REVSH.LTREVSH.GTREVSH.LEREVSHREVSH.ZZROR.EQROR.NEROR.CSROR.CC" +
+	"ROR.MIROR.PLROR.VSROR.VCROR.HIROR.LSROR.GEROR.LTROR.GTROR.LEROR" +
+	"ROR.ZZROR.S.EQROR.S.NEROR.S.CSROR.S.CCROR.S.MIROR.S.PLROR.S.VS" +
+	"ROR.S.VCROR.S.HIROR.S.LSROR.S.GEROR.S.LTROR.S.GTROR.S.LEROR.S" +
+	"ROR.S.ZZRRX.EQRRX.NERRX.CSRRX.CCRRX.MIRRX.PLRRX.VSRRX.VCRRX.HI" +
+	"RRX.LSRRX.GERRX.LTRRX.GTRRX.LERRXRRX.ZZRRX.S.EQRRX.S.NERRX.S.CS" +
+	"RRX.S.CCRRX.S.MIRRX.S.PLRRX.S.VSRRX.S.VCRRX.S.HIRRX.S.LSRRX.S.GE" +
+	"RRX.S.LTRRX.S.GTRRX.S.LERRX.SRRX.S.ZZRSB.EQRSB.NERSB.CSRSB.CC" +
+	"RSB.MIRSB.PLRSB.VSRSB.VCRSB.HIRSB.LSRSB.GERSB.LTRSB.GTRSB.LERSB" +
+	"RSB.ZZRSB.S.EQRSB.S.NERSB.S.CSRSB.S.CCRSB.S.MIRSB.S.PLRSB.S.VS" +
+	"RSB.S.VCRSB.S.HIRSB.S.LSRSB.S.GERSB.S.LTRSB.S.GTRSB.S.LERSB.S" +
+	"RSB.S.ZZRSC.EQRSC.NERSC.CSRSC.CCRSC.MIRSC.PLRSC.VSRSC.VCRSC.HI" +
+	"RSC.LSRSC.GERSC.LTRSC.GTRSC.LERSCRSC.ZZRSC.S.EQRSC.S.NERSC.S.CS" +
+	"RSC.S.CCRSC.S.MIRSC.S.PLRSC.S.VSRSC.S.VCRSC.S.HIRSC.S.LSRSC.S.GE" +
+	"RSC.S.LTRSC.S.GTRSC.S.LERSC.SRSC.S.ZZSADD16.EQSADD16.NESADD16.CS" +
+	"SADD16.CCSADD16.MISADD16.PLSADD16.VSSADD16.VCSADD16.HISADD16.LS" +
+	"SADD16.GESADD16.LTSADD16.GTSADD16.LESADD16SADD16.ZZSADD8.EQ" +
+	"SADD8.NESADD8.CSSADD8.CCSADD8.MISADD8.PLSADD8.VSSADD8.VCSADD8.HI" +
+	"SADD8.LSSADD8.GESADD8.LTSADD8.GTSADD8.LESADD8SADD8.ZZSASX.EQ" +
+	"SASX.NESASX.CSSASX.CCSASX.MISASX.PLSASX.VSSASX.VCSASX.HISASX.LS" +
+	"SASX.GESASX.LTSASX.GTSASX.LESASXSASX.ZZSBC.EQSBC.NESBC.CSSBC.CC" +
+	"SBC.MISBC.PLSBC.VSSBC.VCSBC.HISBC.LSSBC.GESBC.LTSBC.GTSBC.LESBC" +
+	"SBC.ZZSBC.S.EQSBC.S.NESBC.S.CSSBC.S.CCSBC.S.MISBC.S.PLSBC.S.VS" +
+	"SBC.S.VCSBC.S.HISBC.S.LSSBC.S.GESBC.S.LTSBC.S.GTSBC.S.LESBC.S" +
+	"SBC.S.ZZSBFX.EQSBFX.NESBFX.CSSBFX.CCSBFX.MISBFX.PLSBFX.VSSBFX.VC" +
+	"SBFX.HISBFX.LSSBFX.GESBFX.LTSBFX.GTSBFX.LESBFXSBFX.ZZSEL.EQ" +
+	"SEL.NESEL.CSSEL.CCSEL.MISEL.PLSEL.VSSEL.VCSEL.HISEL.LSSEL.GE" +
+	"SEL.LTSEL.GTSEL.LESELSEL.ZZSETENDSEV.EQSEV.NESEV.CSSEV.CCSEV.MI" +
+	"SEV.PLSEV.VSSEV.VCSEV.HISEV.LSSEV.GESEV.LTSEV.GTSEV.LESEVSEV.ZZ" +
+	"SHADD16.EQSHADD16.NESHADD16.CSSHADD16.CCSHADD16.MISHADD16.PL" +
+	"SHADD16.VSSHADD16.VCSHADD16.HISHADD16.LSSHADD16.GESHADD16.LT" +
+	"SHADD16.GTSHADD16.LESHADD16SHADD16.ZZSHADD8.EQSHADD8.NESHADD8.CS" +
+	"SHADD8.CCSHADD8.MISHADD8.PLSHADD8.VSSHADD8.VCSHADD8.HISHADD8.LS" +
+	"SHADD8.GESHADD8.LTSHADD8.GTSHADD8.LESHADD8SHADD8.ZZSHASX.EQ" +
+	"SHASX.NESHASX.CSSHASX.CCSHASX.MISHASX.PLSHASX.VSSHASX.VCSHASX.HI" +
+	"SHASX.LSSHASX.GESHASX.LTSHASX.GTSHASX.LESHASXSHASX.ZZSHSAX.EQ" +
+	"SHSAX.NESHSAX.CSSHSAX.CCSHSAX.MISHSAX.PLSHSAX.VSSHSAX.VCSHSAX.HI" +
+	"SHSAX.LSSHSAX.GESHSAX.LTSHSAX.GTSHSAX.LESHSAXSHSAX.ZZSHSUB16.EQ" +
+	"SHSUB16.NESHSUB16.CSSHSUB16.CCSHSUB16.MISHSUB16.PLSHSUB16.VS" +
+	"SHSUB16.VCSHSUB16.HISHSUB16.LSSHSUB16.GESHSUB16.LTSHSUB16.GT" +
+	"SHSUB16.LESHSUB16SHSUB16.ZZSHSUB8.EQSHSUB8.NESHSUB8.CSSHSUB8.CC" +
+	"SHSUB8.MISHSUB8.PLSHSUB8.VSSHSUB8.VCSHSUB8.HISHSUB8.LSSHSUB8.GE" +
+	"SHSUB8.LTSHSUB8.GTSHSUB8.LESHSUB8SHSUB8.ZZSMLABB.EQSMLABB.NE" +
+	"SMLABB.CSSMLABB.CCSMLABB.MISMLABB.PLSMLABB.VSSMLABB.VCSMLABB.HI" +
+	"SMLABB.LSSMLABB.GESMLABB.LTSMLABB.GTSMLABB.LESMLABBSMLABB.ZZ" +
+	"SMLABT.EQSMLABT.NESMLABT.CSSMLABT.CCSMLABT.MISMLABT.PLSMLABT.VS" +
+	"SMLABT.VCSMLABT.HISMLABT.LSSMLABT.GESMLABT.LTSMLABT.GTSMLABT.LE" +
+	"SMLABTSMLABT.ZZSMLATB.EQSMLATB.NESMLATB.CSSMLATB.CCSMLATB.MI" +
+	"SMLATB.PLSMLATB.VSSMLATB.VCSMLATB.HISMLATB.LSSMLATB.GESMLATB.LT" +
+	"SMLATB.GTSMLATB.LESMLATBSMLATB.ZZSMLATT.EQSMLATT.NESMLATT.CS" +
+	"SMLATT.CCSMLATT.MISMLATT.PLSMLATT.VSSMLATT.VCSMLATT.HISMLATT.LS" +
+	"SMLATT.GESMLATT.LTSMLATT.GTSMLATT.LESMLATTSMLATT.ZZSMLAD.EQ" +
+	"SMLAD.NESMLAD.CSSMLAD.CCSMLAD.MISMLAD.PLSMLAD.VSSMLAD.VCSMLAD.HI" +
+	"SMLAD.LSSMLAD.GESMLAD.LTSMLAD.GTSMLAD.LESMLADSMLAD.ZZSMLAD.X.EQ" +
+	"SMLAD.X.NESMLAD.X.CSSMLAD.X.CCSMLAD.X.MISMLAD.X.PLSMLAD.X.VS" +
+	"SMLAD.X.VCSMLAD.X.HISMLAD.X.LSSMLAD.X.GESMLAD.X.LTSMLAD.X.GT" +
+	"SMLAD.X.LESMLAD.XSMLAD.X.ZZSMLAL.EQSMLAL.NESMLAL.CSSMLAL.CC" +
+	"SMLAL.MISMLAL.PLSMLAL.VSSMLAL.VCSMLAL.HISMLAL.LSSMLAL.GESMLAL.LT" +
+	"SMLAL.GTSMLAL.LESMLALSMLAL.ZZSMLAL.S.EQSMLAL.S.NESMLAL.S.CS" +
+	"SMLAL.S.CCSMLAL.S.MISMLAL.S.PLSMLAL.S.VSSMLAL.S.VCSMLAL.S.HI" +
+	"SMLAL.S.LSSMLAL.S.GESMLAL.S.LTSMLAL.S.GTSMLAL.S.LESMLAL.S" +
+	"SMLAL.S.ZZSMLALBB.EQSMLALBB.NESMLALBB.CSSMLALBB.CCSMLALBB.MI" +
+	"SMLALBB.PLSMLALBB.VSSMLALBB.VCSMLALBB.HISMLALBB.LSSMLALBB.GE" +
+	"SMLALBB.LTSMLALBB.GTSMLALBB.LESMLALBBSMLALBB.ZZSMLALBT.EQ" +
+	"SMLALBT.NESMLALBT.CSSMLALBT.CCSMLALBT.MISMLALBT.PLSMLALBT.VS" +
+	"SMLALBT.VCSMLALBT.HISMLALBT.LSSMLALBT.GESMLALBT.LTSMLALBT.GT" +
+	"SMLALBT.LESMLALBTSMLALBT.ZZSMLALTB.EQSMLALTB.NESMLALTB.CS" +
+	"SMLALTB.CCSMLALTB.MISMLALTB.PLSMLALTB.VSSMLALTB.VCSMLALTB.HI" +
+	"SMLALTB.LSSMLALTB.GESMLALTB.LTSMLALTB.GTSMLALTB.LESMLALTB" +
+	"SMLALTB.ZZSMLALTT.EQSMLALTT.NESMLALTT.CSSMLALTT.CCSMLALTT.MI" +
+	"SMLALTT.PLSMLALTT.VSSMLALTT.VCSMLALTT.HISMLALTT.LSSMLALTT.GE" +
+	"SMLALTT.LTSMLALTT.GTSMLALTT.LESMLALTTSMLALTT.ZZSMLALD.EQ" +
+	"SMLALD.NESMLALD.CSSMLALD.CCSMLALD.MISMLALD.PLSMLALD.VSSMLALD.VC" +
+	"SMLALD.HISMLALD.LSSMLALD.GESMLALD.LTSMLALD.GTSMLALD.LESMLALD" +
+	"SMLALD.ZZSMLALD.X.EQSMLALD.X.NESMLALD.X.CSSMLALD.X.CCSMLALD.X.MI" +
+	"SMLALD.X.PLSMLALD.X.VSSMLALD.X.VCSMLALD.X.HISMLALD.X.LS" +
+	"SMLALD.X.GESMLALD.X.LTSMLALD.X.GTSMLALD.X.LESMLALD.XSMLALD.X.ZZ" +
+	"SMLAWB.EQSMLAWB.NESMLAWB.CSSMLAWB.CCSMLAWB.MISMLAWB.PLSMLAWB.VS" +
+	"SMLAWB.VCSMLAWB.HISMLAWB.LSSMLAWB.GESMLAWB.LTSMLAWB.GTSMLAWB.LE" +
+	"SMLAWBSMLAWB.ZZSMLAWT.EQSMLAWT.NESMLAWT.CSSMLAWT.CCSMLAWT.MI" +
+	"SMLAWT.PLSMLAWT.VSSMLAWT.VCSMLAWT.HISMLAWT.LSSMLAWT.GESMLAWT.LT" +
+	"SMLAWT.GTSMLAWT.LESMLAWTSMLAWT.ZZSMLSD.EQSMLSD.NESMLSD.CS" +
+	"SMLSD.CCSMLSD.MISMLSD.PLSMLSD.VSSMLSD.VCSMLSD.HISMLSD.LSSMLSD.GE" +
+	"SMLSD.LTSMLSD.GTSMLSD.LESMLSDSMLSD.ZZSMLSD.X.EQSMLSD.X.NE" +
+	"SMLSD.X.CSSMLSD.X.CCSMLSD.X.MISMLSD.X.PLSMLSD.X.VSSMLSD.X.VC" +
+	"SMLSD.X.HISMLSD.X.LSSMLSD.X.GESMLSD.X.LTSMLSD.X.GTSMLSD.X.LE" +
+	"SMLSD.XSMLSD.X.ZZSMLSLD.EQSMLSLD.NESMLSLD.CSSMLSLD.CCSMLSLD.MI" +
+	"SMLSLD.PLSMLSLD.VSSMLSLD.VCSMLSLD.HISMLSLD.LSSMLSLD.GESMLSLD.LT" +
+	"SMLSLD.GTSMLSLD.LESMLSLDSMLSLD.ZZSMLSLD.X.EQSMLSLD.X.NE" +
+	"SMLSLD.X.CSSMLSLD.X.CCSMLSLD.X.MISMLSLD.X.PLSMLSLD.X.VS" +
+	"SMLSLD.X.VCSMLSLD.X.HISMLSLD.X.LSSMLSLD.X.GESMLSLD.X.LT" +
+	"SMLSLD.X.GTSMLSLD.X.LESMLSLD.XSMLSLD.X.ZZSMMLA.EQSMMLA.NE" +
+	"SMMLA.CSSMMLA.CCSMMLA.MISMMLA.PLSMMLA.VSSMMLA.VCSMMLA.HISMMLA.LS" +
+	"SMMLA.GESMMLA.LTSMMLA.GTSMMLA.LESMMLASMMLA.ZZSMMLA.R.EQ" +
+	"SMMLA.R.NESMMLA.R.CSSMMLA.R.CCSMMLA.R.MISMMLA.R.PLSMMLA.R.VS" +
+	"SMMLA.R.VCSMMLA.R.HISMMLA.R.LSSMMLA.R.GESMMLA.R.LTSMMLA.R.GT" +
+	"SMMLA.R.LESMMLA.RSMMLA.R.ZZSMMLS.EQSMMLS.NESMMLS.CSSMMLS.CC" +
+	"SMMLS.MISMMLS.PLSMMLS.VSSMMLS.VCSMMLS.HISMMLS.LSSMMLS.GESMMLS.LT" +
+	"SMMLS.GTSMMLS.LESMMLSSMMLS.ZZSMMLS.R.EQSMMLS.R.NESMMLS.R.CS" +
+	"SMMLS.R.CCSMMLS.R.MISMMLS.R.PLSMMLS.R.VSSMMLS.R.VCSMMLS.R.HI" +
+	"SMMLS.R.LSSMMLS.R.GESMMLS.R.LTSMMLS.R.GTSMMLS.R.LESMMLS.R" +
+	"SMMLS.R.ZZSMMUL.EQSMMUL.NESMMUL.CSSMMUL.CCSMMUL.MISMMUL.PL" +
+	"SMMUL.VSSMMUL.VCSMMUL.HISMMUL.LSSMMUL.GESMMUL.LTSMMUL.GTSMMUL.LE" +
+	"SMMULSMMUL.ZZSMMUL.R.EQSMMUL.R.NESMMUL.R.CSSMMUL.R.CCSMMUL.R.MI" +
+	"SMMUL.R.PLSMMUL.R.VSSMMUL.R.VCSMMUL.R.HISMMUL.R.LSSMMUL.R.GE" +
+	"SMMUL.R.LTSMMUL.R.GTSMMUL.R.LESMMUL.RSMMUL.R.ZZSMUAD.EQSMUAD.NE" +
+	"SMUAD.CSSMUAD.CCSMUAD.MISMUAD.PLSMUAD.VSSMUAD.VCSMUAD.HISMUAD.LS" +
+	"SMUAD.GESMUAD.LTSMUAD.GTSMUAD.LESMUADSMUAD.ZZSMUAD.X.EQ" +
+	"SMUAD.X.NESMUAD.X.CSSMUAD.X.CCSMUAD.X.MISMUAD.X.PLSMUAD.X.VS" +
+	"SMUAD.X.VCSMUAD.X.HISMUAD.X.LSSMUAD.X.GESMUAD.X.LTSMUAD.X.GT" +
+	"SMUAD.X.LESMUAD.XSMUAD.X.ZZSMULBB.EQSMULBB.NESMULBB.CSSMULBB.CC" +
+	"SMULBB.MISMULBB.PLSMULBB.VSSMULBB.VCSMULBB.HISMULBB.LSSMULBB.GE" +
+	"SMULBB.LTSMULBB.GTSMULBB.LESMULBBSMULBB.ZZSMULBT.EQSMULBT.NE" +
+	"SMULBT.CSSMULBT.CCSMULBT.MISMULBT.PLSMULBT.VSSMULBT.VCSMULBT.HI" +
+	"SMULBT.LSSMULBT.GESMULBT.LTSMULBT.GTSMULBT.LESMULBTSMULBT.ZZ" +
+	"SMULTB.EQSMULTB.NESMULTB.CSSMULTB.CCSMULTB.MISMULTB.PLSMULTB.VS" +
+	"SMULTB.VCSMULTB.HISMULTB.LSSMULTB.GESMULTB.LTSMULTB.GTSMULTB.LE" +
+	"SMULTBSMULTB.ZZSMULTT.EQSMULTT.NESMULTT.CSSMULTT.CCSMULTT.MI" +
+	"SMULTT.PLSMULTT.VSSMULTT.VCSMULTT.HISMULTT.LSSMULTT.GESMULTT.LT" +
+	"SMULTT.GTSMULTT.LESMULTTSMULTT.ZZSMULL.EQSMULL.NESMULL.CS" +
+	"SMULL.CCSMULL.MISMULL.PLSMULL.VSSMULL.VCSMULL.HISMULL.LSSMULL.GE" +
+	"SMULL.LTSMULL.GTSMULL.LESMULLSMULL.ZZSMULL.S.EQSMULL.S.NE" +
+	"SMULL.S.CSSMULL.S.CCSMULL.S.MISMULL.S.PLSMULL.S.VSSMULL.S.VC" +
+	"SMULL.S.HISMULL.S.LSSMULL.S.GESMULL.S.LTSMULL.S.GTSMULL.S.LE" +
+	"SMULL.SSMULL.S.ZZSMULWB.EQSMULWB.NESMULWB.CSSMULWB.CCSMULWB.MI" +
+	"SMULWB.PLSMULWB.VSSMULWB.VCSMULWB.HISMULWB.LSSMULWB.GESMULWB.LT" +
+	"SMULWB.GTSMULWB.LESMULWBSMULWB.ZZSMULWT.EQSMULWT.NESMULWT.CS" +
+	"SMULWT.CCSMULWT.MISMULWT.PLSMULWT.VSSMULWT.VCSMULWT.HISMULWT.LS" +
+	"SMULWT.GESMULWT.LTSMULWT.GTSMULWT.LESMULWTSMULWT.ZZSMUSD.EQ" +
+	"SMUSD.NESMUSD.CSSMUSD.CCSMUSD.MISMUSD.PLSMUSD.VSSMUSD.VCSMUSD.HI" +
+	"SMUSD.LSSMUSD.GESMUSD.LTSMUSD.GTSMUSD.LESMUSDSMUSD.ZZSMUSD.X.EQ" +
+	"SMUSD.X.NESMUSD.X.CSSMUSD.X.CCSMUSD.X.MISMUSD.X.PLSMUSD.X.VS" +
+	"SMUSD.X.VCSMUSD.X.HISMUSD.X.LSSMUSD.X.GESMUSD.X.LTSMUSD.X.GT" +
+	"SMUSD.X.LESMUSD.XSMUSD.X.ZZSSAT.EQSSAT.NESSAT.CSSSAT.CCSSAT.MI" +
+	"SSAT.PLSSAT.VSSSAT.VCSSAT.HISSAT.LSSSAT.GESSAT.LTSSAT.GTSSAT.LE" +
+	"SSATSSAT.ZZSSAT16.EQSSAT16.NESSAT16.CSSSAT16.CCSSAT16.MI" +
+	"SSAT16.PLSSAT16.VSSSAT16.VCSSAT16.HISSAT16.LSSSAT16.GESSAT16.LT" +
+	"SSAT16.GTSSAT16.LESSAT16SSAT16.ZZSSAX.EQSSAX.NESSAX.CSSSAX.CC" +
+	"SSAX.MISSAX.PLSSAX.VSSSAX.VCSSAX.HISSAX.LSSSAX.GESSAX.LTSSAX.GT" +
+	"SSAX.LESSAXSSAX.ZZSSUB16.EQSSUB16.NESSUB16.CSSSUB16.CCSSUB16.MI" +
+	"SSUB16.PLSSUB16.VSSSUB16.VCSSUB16.HISSUB16.LSSSUB16.GESSUB16.LT" +
+	"SSUB16.GTSSUB16.LESSUB16SSUB16.ZZSSUB8.EQSSUB8.NESSUB8.CS" +
+	"SSUB8.CCSSUB8.MISSUB8.PLSSUB8.VSSSUB8.VCSSUB8.HISSUB8.LSSSUB8.GE" +
+	"SSUB8.LTSSUB8.GTSSUB8.LESSUB8SSUB8.ZZSTM.EQSTM.NESTM.CSSTM.CC" +
+	"STM.MISTM.PLSTM.VSSTM.VCSTM.HISTM.LSSTM.GESTM.LTSTM.GTSTM.LESTM" +
+	"STM.ZZSTMDA.EQSTMDA.NESTMDA.CSSTMDA.CCSTMDA.MISTMDA.PLSTMDA.VS" +
+	"STMDA.VCSTMDA.HISTMDA.LSSTMDA.GESTMDA.LTSTMDA.GTSTMDA.LESTMDA" +
+	"STMDA.ZZSTMDB.EQSTMDB.NESTMDB.CSSTMDB.CCSTMDB.MISTMDB.PLSTMDB.VS" +
+	"STMDB.VCSTMDB.HISTMDB.LSSTMDB.GESTMDB.LTSTMDB.GTSTMDB.LESTMDB" +
+	"STMDB.ZZSTMIB.EQSTMIB.NESTMIB.CSSTMIB.CCSTMIB.MISTMIB.PLSTMIB.VS" +
+	"STMIB.VCSTMIB.HISTMIB.LSSTMIB.GESTMIB.LTSTMIB.GTSTMIB.LESTMIB" +
+	"STMIB.ZZSTR.EQSTR.NESTR.CSSTR.CCSTR.MISTR.PLSTR.VSSTR.VCSTR.HI" +
+	"STR.LSSTR.GESTR.LTSTR.GTSTR.LESTRSTR.ZZSTRB.EQSTRB.NESTRB.CS" +
+	"STRB.CCSTRB.MISTRB.PLSTRB.VSSTRB.VCSTRB.HISTRB.LSSTRB.GESTRB.LT" +
+	"STRB.GTSTRB.LESTRBSTRB.ZZSTRBT.EQSTRBT.NESTRBT.CSSTRBT.CC" +
+	"STRBT.MISTRBT.PLSTRBT.VSSTRBT.VCSTRBT.HISTRBT.LSSTRBT.GESTRBT.LT" +
+	"STRBT.GTSTRBT.LESTRBTSTRBT.ZZSTRD.EQSTRD.NESTRD.CSSTRD.CCSTRD.MI" +
+	"STRD.PLSTRD.VSSTRD.VCSTRD.HISTRD.LSSTRD.GESTRD.LTSTRD.GTSTRD.LE" +
+	"STRDSTRD.ZZSTREX.EQSTREX.NESTREX.CSSTREX.CCSTREX.MISTREX.PL" +
+	"STREX.VSSTREX.VCSTREX.HISTREX.LSSTREX.GESTREX.LTSTREX.GTSTREX.LE" +
+	"STREXSTREX.ZZSTREXB.EQSTREXB.NESTREXB.CSSTREXB.CCSTREXB.MI" +
+	"STREXB.PLSTREXB.VSSTREXB.VCSTREXB.HISTREXB.LSSTREXB.GESTREXB.LT" +
+	"STREXB.GTSTREXB.LESTREXBSTREXB.ZZSTREXD.EQSTREXD.NESTREXD.CS" +
+	"STREXD.CCSTREXD.MISTREXD.PLSTREXD.VSSTREXD.VCSTREXD.HISTREXD.LS" +
+	"STREXD.GESTREXD.LTSTREXD.GTSTREXD.LESTREXDSTREXD.ZZSTREXH.EQ" +
+	"STREXH.NESTREXH.CSSTREXH.CCSTREXH.MISTREXH.PLSTREXH.VSSTREXH.VC" +
+	"STREXH.HISTREXH.LSSTREXH.GESTREXH.LTSTREXH.GTSTREXH.LESTREXH" +
+	"STREXH.ZZSTRH.EQSTRH.NESTRH.CSSTRH.CCSTRH.MISTRH.PLSTRH.VS" +
+	"STRH.VCSTRH.HISTRH.LSSTRH.GESTRH.LTSTRH.GTSTRH.LESTRHSTRH.ZZ" +
+	"STRHT.EQSTRHT.NESTRHT.CSSTRHT.CCSTRHT.MISTRHT.PLSTRHT.VSSTRHT.VC" +
+	"STRHT.HISTRHT.LSSTRHT.GESTRHT.LTSTRHT.GTSTRHT.LESTRHTSTRHT.ZZ" +
+	"STRT.EQSTRT.NESTRT.CSSTRT.CCSTRT.MISTRT.PLSTRT.VSSTRT.VCSTRT.HI" +
+	"STRT.LSSTRT.GESTRT.LTSTRT.GTSTRT.LESTRTSTRT.ZZSUB.EQSUB.NESUB.CS" +
+	"SUB.CCSUB.MISUB.PLSUB.VSSUB.VCSUB.HISUB.LSSUB.GESUB.LTSUB.GT" +
+	"SUB.LESUBSUB.ZZSUB.S.EQSUB.S.NESUB.S.CSSUB.S.CCSUB.S.MISUB.S.PL" +
+	"SUB.S.VSSUB.S.VCSUB.S.HISUB.S.LSSUB.S.GESUB.S.LTSUB.S.GTSUB.S.LE" +
+	"SUB.SSUB.S.ZZSVC.EQSVC.NESVC.CSSVC.CCSVC.MISVC.PLSVC.VSSVC.VC" +
+	"SVC.HISVC.LSSVC.GESVC.LTSVC.GTSVC.LESVCSVC.ZZSWP.EQSWP.NESWP.CS" +
+	"SWP.CCSWP.MISWP.PLSWP.VSSWP.VCSWP.HISWP.LSSWP.GESWP.LTSWP.GT" +
+	"SWP.LESWPSWP.ZZSWP.B.EQSWP.B.NESWP.B.CSSWP.B.CCSWP.B.MISWP.B.PL" +
+	"SWP.B.VSSWP.B.VCSWP.B.HISWP.B.LSSWP.B.GESWP.B.LTSWP.B.GTSWP.B.LE" +
+	"SWP.BSWP.B.ZZSXTAB.EQSXTAB.NESXTAB.CSSXTAB.CCSXTAB.MISXTAB.PL" +
+	"SXTAB.VSSXTAB.VCSXTAB.HISXTAB.LSSXTAB.GESXTAB.LTSXTAB.GTSXTAB.LE" +
+	"SXTABSXTAB.ZZSXTAB16.EQSXTAB16.NESXTAB16.CSSXTAB16.CCSXTAB16.MI" +
+	"SXTAB16.PLSXTAB16.VSSXTAB16.VCSXTAB16.HISXTAB16.LSSXTAB16.GE" +
+	"SXTAB16.LTSXTAB16.GTSXTAB16.LESXTAB16SXTAB16.ZZSXTAH.EQSXTAH.NE" +
+	"SXTAH.CSSXTAH.CCSXTAH.MISXTAH.PLSXTAH.VSSXTAH.VCSXTAH.HISXTAH.LS" +
+	"SXTAH.GESXTAH.LTSXTAH.GTSXTAH.LESXTAHSXTAH.ZZSXTB.EQSXTB.NE" +
+	"SXTB.CSSXTB.CCSXTB.MISXTB.PLSXTB.VSSXTB.VCSXTB.HISXTB.LSSXTB.GE" +
+	"SXTB.LTSXTB.GTSXTB.LESXTBSXTB.ZZSXTB16.EQSXTB16.NESXTB16.CS" +
+	"SXTB16.CCSXTB16.MISXTB16.PLSXTB16.VSSXTB16.VCSXTB16.HISXTB16.LS" +
+	"SXTB16.GESXTB16.LTSXTB16.GTSXTB16.LESXTB16SXTB16.ZZSXTH.EQ" +
+	"SXTH.NESXTH.CSSXTH.CCSXTH.MISXTH.PLSXTH.VSSXTH.VCSXTH.HISXTH.LS" +
+	"SXTH.GESXTH.LTSXTH.GTSXTH.LESXTHSXTH.ZZTEQ.EQTEQ.NETEQ.CSTEQ.CC" +
+	"TEQ.MITEQ.PLTEQ.VSTEQ.VCTEQ.HITEQ.LSTEQ.GETEQ.LTTEQ.GTTEQ.LETEQ" +
+	"TEQ.ZZTST.EQTST.NETST.CSTST.CCTST.MITST.PLTST.VSTST.VCTST.HI" +
+	"TST.LSTST.GETST.LTTST.GTTST.LETSTTST.ZZUADD16.EQUADD16.NE" +
+	"UADD16.CSUADD16.CCUADD16.MIUADD16.PLUADD16.VSUADD16.VCUADD16.HI" +
+	"UADD16.LSUADD16.GEUADD16.LTUADD16.GTUADD16.LEUADD16UADD16.ZZ" +
+	"UADD8.EQUADD8.NEUADD8.CSUADD8.CCUADD8.MIUADD8.PLUADD8.VSUADD8.VC" +
+	"UADD8.HIUADD8.LSUADD8.GEUADD8.LTUADD8.GTUADD8.LEUADD8UADD8.ZZ" +
+	"UASX.EQUASX.NEUASX.CSUASX.CCUASX.MIUASX.PLUASX.VSUASX.VCUASX.HI" +
+	"UASX.LSUASX.GEUASX.LTUASX.GTUASX.LEUASXUASX.ZZUBFX.EQUBFX.NE" +
+	"UBFX.CSUBFX.CCUBFX.MIUBFX.PLUBFX.VSUBFX.VCUBFX.HIUBFX.LSUBFX.GE" +
+	"UBFX.LTUBFX.GTUBFX.LEUBFXUBFX.ZZUHADD16.EQUHADD16.NEUHADD16.CS" +
+	"UHADD16.CCUHADD16.MIUHADD16.PLUHADD16.VSUHADD16.VCUHADD16.HI" +
+	"UHADD16.LSUHADD16.GEUHADD16.LTUHADD16.GTUHADD16.LEUHADD16" +
+	"UHADD16.ZZUHADD8.EQUHADD8.NEUHADD8.CSUHADD8.CCUHADD8.MIUHADD8.PL" +
+	"UHADD8.VSUHADD8.VCUHADD8.HIUHADD8.LSUHADD8.GEUHADD8.LTUHADD8.GT" +
+	"UHADD8.LEUHADD8UHADD8.ZZUHASX.EQUHASX.NEUHASX.CSUHASX.CCUHASX.MI" +
+	"UHASX.PLUHASX.VSUHASX.VCUHASX.HIUHASX.LSUHASX.GEUHASX.LTUHASX.GT" +
+	"UHASX.LEUHASXUHASX.ZZUHSAX.EQUHSAX.NEUHSAX.CSUHSAX.CCUHSAX.MI" +
+	"UHSAX.PLUHSAX.VSUHSAX.VCUHSAX.HIUHSAX.LSUHSAX.GEUHSAX.LTUHSAX.GT" +
+	"UHSAX.LEUHSAXUHSAX.ZZUHSUB16.EQUHSUB16.NEUHSUB16.CSUHSUB16.CC" +
+	"UHSUB16.MIUHSUB16.PLUHSUB16.VSUHSUB16.VCUHSUB16.HIUHSUB16.LS" +
+	"UHSUB16.GEUHSUB16.LTUHSUB16.GTUHSUB16.LEUHSUB16UHSUB16.ZZ" +
+	"UHSUB8.EQUHSUB8.NEUHSUB8.CSUHSUB8.CCUHSUB8.MIUHSUB8.PLUHSUB8.VS" +
+	"UHSUB8.VCUHSUB8.HIUHSUB8.LSUHSUB8.GEUHSUB8.LTUHSUB8.GTUHSUB8.LE" +
+	"UHSUB8UHSUB8.ZZUMAAL.EQUMAAL.NEUMAAL.CSUMAAL.CCUMAAL.MIUMAAL.PL" +
+	"UMAAL.VSUMAAL.VCUMAAL.HIUMAAL.LSUMAAL.GEUMAAL.LTUMAAL.GTUMAAL.LE" +
+	"UMAALUMAAL.ZZUMLAL.EQUMLAL.NEUMLAL.CSUMLAL.CCUMLAL.MIUMLAL.PL" +
+	"UMLAL.VSUMLAL.VCUMLAL.HIUMLAL.LSUMLAL.GEUMLAL.LTUMLAL.GTUMLAL.LE" +
+	"UMLALUMLAL.ZZUMLAL.S.EQUMLAL.S.NEUMLAL.S.CSUMLAL.S.CCUMLAL.S.MI" +
+	"UMLAL.S.PLUMLAL.S.VSUMLAL.S.VCUMLAL.S.HIUMLAL.S.LSUMLAL.S.GE" +
+	"UMLAL.S.LTUMLAL.S.GTUMLAL.S.LEUMLAL.SUMLAL.S.ZZUMULL.EQUMULL.NE" +
+	"UMULL.CSUMULL.CCUMULL.MIUMULL.PLUMULL.VSUMULL.VCUMULL.HIUMULL.LS" +
+	"UMULL.GEUMULL.LTUMULL.GTUMULL.LEUMULLUMULL.ZZUMULL.S.EQ" +
+	"UMULL.S.NEUMULL.S.CSUMULL.S.CCUMULL.S.MIUMULL.S.PLUMULL.S.VS" +
+	"UMULL.S.VCUMULL.S.HIUMULL.S.LSUMULL.S.GEUMULL.S.LTUMULL.S.GT" +
+	"UMULL.S.LEUMULL.SUMULL.S.ZZUNDEFUQADD16.EQUQADD16.NEUQADD16.CS" +
+	"UQADD16.CCUQADD16.MIUQADD16.PLUQADD16.VSUQADD16.VCUQADD16.HI" +
+	"UQADD16.LSUQADD16.GEUQADD16.LTUQADD16.GTUQADD16.LEUQADD16" +
+	"UQADD16.ZZUQADD8.EQUQADD8.NEUQADD8.CSUQADD8.CCUQADD8.MIUQADD8.PL" +
+	"UQADD8.VSUQADD8.VCUQADD8.HIUQADD8.LSUQADD8.GEUQADD8.LTUQADD8.GT" +
+	"UQADD8.LEUQADD8UQADD8.ZZUQASX.EQUQASX.NEUQASX.CSUQASX.CCUQASX.MI" +
+	"UQASX.PLUQASX.VSUQASX.VCUQASX.HIUQASX.LSUQASX.GEUQASX.LTUQASX.GT" +
+	"UQASX.LEUQASXUQASX.ZZUQSAX.EQUQSAX.NEUQSAX.CSUQSAX.CCUQSAX.MI" +
+	"UQSAX.PLUQSAX.VSUQSAX.VCUQSAX.HIUQSAX.LSUQSAX.GEUQSAX.LTUQSAX.GT" +
+	"UQSAX.LEUQSAXUQSAX.ZZUQSUB16.EQUQSUB16.NEUQSUB16.CSUQSUB16.CC" +
+	"UQSUB16.MIUQSUB16.PLUQSUB16.VSUQSUB16.VCUQSUB16.HIUQSUB16.LS" +
+	"UQSUB16.GEUQSUB16.LTUQSUB16.GTUQSUB16.LEUQSUB16UQSUB16.ZZ" +
+	"UQSUB8.EQUQSUB8.NEUQSUB8.CSUQSUB8.CCUQSUB8.MIUQSUB8.PLUQSUB8.VS" +
+	"UQSUB8.VCUQSUB8.HIUQSUB8.LSUQSUB8.GEUQSUB8.LTUQSUB8.GTUQSUB8.LE" +
+	"UQSUB8UQSUB8.ZZUSAD8.EQUSAD8.NEUSAD8.CSUSAD8.CCUSAD8.MIUSAD8.PL" +
+	"USAD8.VSUSAD8.VCUSAD8.HIUSAD8.LSUSAD8.GEUSAD8.LTUSAD8.GTUSAD8.LE" +
+	"USAD8USAD8.ZZUSADA8.EQUSADA8.NEUSADA8.CSUSADA8.CCUSADA8.MI" +
+	"USADA8.PLUSADA8.VSUSADA8.VCUSADA8.HIUSADA8.LSUSADA8.GEUSADA8.LT" +
+	"USADA8.GTUSADA8.LEUSADA8USADA8.ZZUSAT.EQUSAT.NEUSAT.CSUSAT.CC" +
+	"USAT.MIUSAT.PLUSAT.VSUSAT.VCUSAT.HIUSAT.LSUSAT.GEUSAT.LTUSAT.GT" +
+	"USAT.LEUSATUSAT.ZZUSAT16.EQUSAT16.NEUSAT16.CSUSAT16.CCUSAT16.MI" +
+	"USAT16.PLUSAT16.VSUSAT16.VCUSAT16.HIUSAT16.LSUSAT16.GEUSAT16.LT" +
+	"USAT16.GTUSAT16.LEUSAT16USAT16.ZZUSAX.EQUSAX.NEUSAX.CSUSAX.CC" +
+	"USAX.MIUSAX.PLUSAX.VSUSAX.VCUSAX.HIUSAX.LSUSAX.GEUSAX.LTUSAX.GT" +
+	"USAX.LEUSAXUSAX.ZZUSUB16.EQUSUB16.NEUSUB16.CSUSUB16.CCUSUB16.MI" +
+	"USUB16.PLUSUB16.VSUSUB16.VCUSUB16.HIUSUB16.LSUSUB16.GEUSUB16.LT" +
+	"USUB16.GTUSUB16.LEUSUB16USUB16.ZZUSUB8.EQUSUB8.NEUSUB8.CS" +
+	"USUB8.CCUSUB8.MIUSUB8.PLUSUB8.VSUSUB8.VCUSUB8.HIUSUB8.LSUSUB8.GE" +
+	"USUB8.LTUSUB8.GTUSUB8.LEUSUB8USUB8.ZZUXTAB.EQUXTAB.NEUXTAB.CS" +
+	"UXTAB.CCUXTAB.MIUXTAB.PLUXTAB.VSUXTAB.VCUXTAB.HIUXTAB.LSUXTAB.GE" +
+	"UXTAB.LTUXTAB.GTUXTAB.LEUXTABUXTAB.ZZUXTAB16.EQUXTAB16.NE" +
+	"UXTAB16.CSUXTAB16.CCUXTAB16.MIUXTAB16.PLUXTAB16.VSUXTAB16.VC" +
+	"UXTAB16.HIUXTAB16.LSUXTAB16.GEUXTAB16.LTUXTAB16.GTUXTAB16.LE" +
+	"UXTAB16UXTAB16.ZZUXTAH.EQUXTAH.NEUXTAH.CSUXTAH.CCUXTAH.MI" +
+	"UXTAH.PLUXTAH.VSUXTAH.VCUXTAH.HIUXTAH.LSUXTAH.GEUXTAH.LTUXTAH.GT" +
+	"UXTAH.LEUXTAHUXTAH.ZZUXTB.EQUXTB.NEUXTB.CSUXTB.CCUXTB.MIUXTB.PL" +
+	"UXTB.VSUXTB.VCUXTB.HIUXTB.LSUXTB.GEUXTB.LTUXTB.GTUXTB.LEUXTB" +
+	"UXTB.ZZUXTB16.EQUXTB16.NEUXTB16.CSUXTB16.CCUXTB16.MIUXTB16.PL" +
+	"UXTB16.VSUXTB16.VCUXTB16.HIUXTB16.LSUXTB16.GEUXTB16.LTUXTB16.GT" +
+	"UXTB16.LEUXTB16UXTB16.ZZUXTH.EQUXTH.NEUXTH.CSUXTH.CCUXTH.MI" +
+	"UXTH.PLUXTH.VSUXTH.VCUXTH.HIUXTH.LSUXTH.GEUXTH.LTUXTH.GTUXTH.LE" +
+	"UXTHUXTH.ZZVABS.EQ.F32VABS.NE.F32VABS.CS.F32VABS.CC.F32" +
+	"VABS.MI.F32VABS.PL.F32VABS.VS.F32VABS.VC.F32VABS.HI.F32" +
+	"VABS.LS.F32VABS.GE.F32VABS.LT.F32VABS.GT.F32VABS.LE.F32VABS.F32" +
+	"VABS.ZZ.F32VABS.EQ.F64VABS.NE.F64VABS.CS.F64VABS.CC.F64" +
+	"VABS.MI.F64VABS.PL.F64VABS.VS.F64VABS.VC.F64VABS.HI.F64" +
+	"VABS.LS.F64VABS.GE.F64VABS.LT.F64VABS.GT.F64VABS.LE.F64VABS.F64" +
+	"VABS.ZZ.F64VADD.EQ.F32VADD.NE.F32VADD.CS.F32VADD.CC.F32" +
+	"VADD.MI.F32VADD.PL.F32VADD.VS.F32VADD.VC.F32VADD.HI.F32" +
+	"VADD.LS.F32VADD.GE.F32VADD.LT.F32VADD.GT.F32VADD.LE.F32VADD.F32" +
+	"VADD.ZZ.F32VADD.EQ.F64VADD.NE.F64VADD.CS.F64VADD.CC.F64" +
+	"VADD.MI.F64VADD.PL.F64VADD.VS.F64VADD.VC.F64VADD.HI.F64" +
+	"VADD.LS.F64VADD.GE.F64VADD.LT.F64VADD.GT.F64VADD.LE.F64VADD.F64" +
+	"VADD.ZZ.F64VCMP.EQ.F32VCMP.NE.F32VCMP.CS.F32VCMP.CC.F32" +
+	"VCMP.MI.F32VCMP.PL.F32VCMP.VS.F32VCMP.VC.F32VCMP.HI.F32" +
+	"VCMP.LS.F32VCMP.GE.F32VCMP.LT.F32VCMP.GT.F32VCMP.LE.F32VCMP.F32" +
+	"VCMP.ZZ.F32VCMP.EQ.F64VCMP.NE.F64VCMP.CS.F64VCMP.CC.F64" +
+	"VCMP.MI.F64VCMP.PL.F64VCMP.VS.F64VCMP.VC.F64VCMP.HI.F64" +
+	"VCMP.LS.F64VCMP.GE.F64VCMP.LT.F64VCMP.GT.F64VCMP.LE.F64VCMP.F64" +
+	"VCMP.ZZ.F64VCMP.E.EQ.F32VCMP.E.NE.F32VCMP.E.CS.F32VCMP.E.CC.F32" +
+	"VCMP.E.MI.F32VCMP.E.PL.F32VCMP.E.VS.F32VCMP.E.VC.F32" +
+	"VCMP.E.HI.F32VCMP.E.LS.F32VCMP.E.GE.F32VCMP.E.LT.F32" +
+	"VCMP.E.GT.F32VCMP.E.LE.F32VCMP.E.F32VCMP.E.ZZ.F32VCMP.E.EQ.F64" +
+	"VCMP.E.NE.F64VCMP.E.CS.F64VCMP.E.CC.F64VCMP.E.MI.F64" +
+	"VCMP.E.PL.F64VCMP.E.VS.F64VCMP.E.VC.F64VCMP.E.HI.F64" +
+	"VCMP.E.LS.F64VCMP.E.GE.F64VCMP.E.LT.F64VCMP.E.GT.F64" +
+	"VCMP.E.LE.F64VCMP.E.F64VCMP.E.ZZ.F64VCVT.EQ.F32.FXS16" +
+	"VCVT.NE.F32.FXS16VCVT.CS.F32.FXS16VCVT.CC.F32.FXS16" +
+	"VCVT.MI.F32.FXS16VCVT.PL.F32.FXS16VCVT.VS.F32.FXS16" +
+	"VCVT.VC.F32.FXS16VCVT.HI.F32.FXS16VCVT.LS.F32.FXS16" +
+	"VCVT.GE.F32.FXS16VCVT.LT.F32.FXS16VCVT.GT.F32.FXS16" +
+	"VCVT.LE.F32.FXS16VCVT.F32.FXS16VCVT.ZZ.F32.FXS16" +
+	"VCVT.EQ.F32.FXS32VCVT.NE.F32.FXS32VCVT.CS.F32.FXS32" +
+	"VCVT.CC.F32.FXS32VCVT.MI.F32.FXS32VCVT.PL.F32.FXS32" +
+	"VCVT.VS.F32.FXS32VCVT.VC.F32.FXS32VCVT.HI.F32.FXS32" +
+	"VCVT.LS.F32.FXS32VCVT.GE.F32.FXS32VCVT.LT.F32.FXS32" +
+	"VCVT.GT.F32.FXS32VCVT.LE.F32.FXS32VCVT.F32.FXS32" +
+	"VCVT.ZZ.F32.FXS32VCVT.EQ.F32.FXU16VCVT.NE.F32.FXU16" +
+	"VCVT.CS.F32.FXU16VCVT.CC.F32.FXU16VCVT.MI.F32.FXU16" +
+	"VCVT.PL.F32.FXU16VCVT.VS.F32.FXU16VCVT.VC.F32.FXU16" +
+	"VCVT.HI.F32.FXU16VCVT.LS.F32.FXU16VCVT.GE.F32.FXU16" +
+	"VCVT.LT.F32.FXU16VCVT.GT.F32.FXU16VCVT.LE.F32.FXU16" +
+	"VCVT.F32.FXU16VCVT.ZZ.F32.FXU16VCVT.EQ.F32.FXU32" +
+	"VCVT.NE.F32.FXU32VCVT.CS.F32.FXU32VCVT.CC.F32.FXU32" +
+	"VCVT.MI.F32.FXU32VCVT.PL.F32.FXU32VCVT.VS.F32.FXU32" +
+	"VCVT.VC.F32.FXU32VCVT.HI.F32.FXU32VCVT.LS.F32.FXU32" +
+	"VCVT.GE.F32.FXU32VCVT.LT.F32.FXU32VCVT.GT.F32.FXU32" +
+	"VCVT.LE.F32.FXU32VCVT.F32.FXU32VCVT.ZZ.F32.FXU32" +
+	"VCVT.EQ.F64.FXS16VCVT.NE.F64.FXS16VCVT.CS.F64.FXS16" +
+	"VCVT.CC.F64.FXS16VCVT.MI.F64.FXS16VCVT.PL.F64.FXS16" +
+	"VCVT.VS.F64.FXS16VCVT.VC.F64.FXS16VCVT.HI.F64.FXS16" +
+	"VCVT.LS.F64.FXS16VCVT.GE.F64.FXS16VCVT.LT.F64.FXS16" +
+	"VCVT.GT.F64.FXS16VCVT.LE.F64.FXS16VCVT.F64.FXS16" +
+	"VCVT.ZZ.F64.FXS16VCVT.EQ.F64.FXS32VCVT.NE.F64.FXS32" +
+	"VCVT.CS.F64.FXS32VCVT.CC.F64.FXS32VCVT.MI.F64.FXS32" +
+	"VCVT.PL.F64.FXS32VCVT.VS.F64.FXS32VCVT.VC.F64.FXS32" +
+	"VCVT.HI.F64.FXS32VCVT.LS.F64.FXS32VCVT.GE.F64.FXS32" +
+	"VCVT.LT.F64.FXS32VCVT.GT.F64.FXS32VCVT.LE.F64.FXS32" +
+	"VCVT.F64.FXS32VCVT.ZZ.F64.FXS32VCVT.EQ.F64.FXU16" +
+	"VCVT.NE.F64.FXU16VCVT.CS.F64.FXU16VCVT.CC.F64.FXU16" +
+	"VCVT.MI.F64.FXU16VCVT.PL.F64.FXU16VCVT.VS.F64.FXU16" +
+	"VCVT.VC.F64.FXU16VCVT.HI.F64.FXU16VCVT.LS.F64.FXU16" +
+	"VCVT.GE.F64.FXU16VCVT.LT.F64.FXU16VCVT.GT.F64.FXU16" +
+	"VCVT.LE.F64.FXU16VCVT.F64.FXU16VCVT.ZZ.F64.FXU16" +
+	"VCVT.EQ.F64.FXU32VCVT.NE.F64.FXU32VCVT.CS.F64.FXU32" +
+	"VCVT.CC.F64.FXU32VCVT.MI.F64.FXU32VCVT.PL.F64.FXU32" +
+	"VCVT.VS.F64.FXU32VCVT.VC.F64.FXU32VCVT.HI.F64.FXU32" +
+	"VCVT.LS.F64.FXU32VCVT.GE.F64.FXU32VCVT.LT.F64.FXU32" +
+	"VCVT.GT.F64.FXU32VCVT.LE.F64.FXU32VCVT.F64.FXU32" +
+	"VCVT.ZZ.F64.FXU32VCVT.EQ.F32.U32VCVT.NE.F32.U32VCVT.CS.F32.U32" +
+	"VCVT.CC.F32.U32VCVT.MI.F32.U32VCVT.PL.F32.U32VCVT.VS.F32.U32" +
+	"VCVT.VC.F32.U32VCVT.HI.F32.U32VCVT.LS.F32.U32VCVT.GE.F32.U32" +
+	"VCVT.LT.F32.U32VCVT.GT.F32.U32VCVT.LE.F32.U32VCVT.F32.U32" +
+	"VCVT.ZZ.F32.U32VCVT.EQ.F32.S32VCVT.NE.F32.S32VCVT.CS.F32.S32" +
+	"VCVT.CC.F32.S32VCVT.MI.F32.S32VCVT.PL.F32.S32VCVT.VS.F32.S32" +
+	"VCVT.VC.F32.S32VCVT.HI.F32.S32VCVT.LS.F32.S32VCVT.GE.F32.S32" +
+	"VCVT.LT.F32.S32VCVT.GT.F32.S32VCVT.LE.F32.S32VCVT.F32.S32" +
+	"VCVT.ZZ.F32.S32VCVT.EQ.F64.U32VCVT.NE.F64.U32VCVT.CS.F64.U32" +
+	"VCVT.CC.F64.U32VCVT.MI.F64.U32VCVT.PL.F64.U32VCVT.VS.F64.U32" +
+	"VCVT.VC.F64.U32VCVT.HI.F64.U32VCVT.LS.F64.U32VCVT.GE.F64.U32" +
+	"VCVT.LT.F64.U32VCVT.GT.F64.U32VCVT.LE.F64.U32VCVT.F64.U32" +
+	"VCVT.ZZ.F64.U32VCVT.EQ.F64.S32VCVT.NE.F64.S32VCVT.CS.F64.S32" +
+	"VCVT.CC.F64.S32VCVT.MI.F64.S32VCVT.PL.F64.S32VCVT.VS.F64.S32" +
+	"VCVT.VC.F64.S32VCVT.HI.F64.S32VCVT.LS.F64.S32VCVT.GE.F64.S32" +
+	"VCVT.LT.F64.S32VCVT.GT.F64.S32VCVT.LE.F64.S32VCVT.F64.S32" +
+	"VCVT.ZZ.F64.S32VCVT.EQ.F64.F32VCVT.NE.F64.F32VCVT.CS.F64.F32" +
+	"VCVT.CC.F64.F32VCVT.MI.F64.F32VCVT.PL.F64.F32VCVT.VS.F64.F32" +
+	"VCVT.VC.F64.F32VCVT.HI.F64.F32VCVT.LS.F64.F32VCVT.GE.F64.F32" +
+	"VCVT.LT.F64.F32VCVT.GT.F64.F32VCVT.LE.F64.F32VCVT.F64.F32" +
+	"VCVT.ZZ.F64.F32VCVT.EQ.F32.F64VCVT.NE.F32.F64VCVT.CS.F32.F64" +
+	"VCVT.CC.F32.F64VCVT.MI.F32.F64VCVT.PL.F32.F64VCVT.VS.F32.F64" +
+	"VCVT.VC.F32.F64VCVT.HI.F32.F64VCVT.LS.F32.F64VCVT.GE.F32.F64" +
+	"VCVT.LT.F32.F64VCVT.GT.F32.F64VCVT.LE.F32.F64VCVT.F32.F64" +
+	"VCVT.ZZ.F32.F64VCVT.EQ.FXS16.F32VCVT.NE.FXS16.F32" +
+	"VCVT.CS.FXS16.F32VCVT.CC.FXS16.F32VCVT.MI.FXS16.F32" +
+	"VCVT.PL.FXS16.F32VCVT.VS.FXS16.F32VCVT.VC.FXS16.F32" +
+	"VCVT.HI.FXS16.F32VCVT.LS.FXS16.F32VCVT.GE.FXS16.F32" +
+	"VCVT.LT.FXS16.F32VCVT.GT.FXS16.F32VCVT.LE.FXS16.F32" +
+	"VCVT.FXS16.F32VCVT.ZZ.FXS16.F32VCVT.EQ.FXS16.F64" +
+	"VCVT.NE.FXS16.F64VCVT.CS.FXS16.F64VCVT.CC.FXS16.F64" +
+	"VCVT.MI.FXS16.F64VCVT.PL.FXS16.F64VCVT.VS.FXS16.F64" +
+	"VCVT.VC.FXS16.F64VCVT.HI.FXS16.F64VCVT.LS.FXS16.F64" +
+	"VCVT.GE.FXS16.F64VCVT.LT.FXS16.F64VCVT.GT.FXS16.F64" +
+	"VCVT.LE.FXS16.F64VCVT.FXS16.F64VCVT.ZZ.FXS16.F64" +
+	"VCVT.EQ.FXS32.F32VCVT.NE.FXS32.F32VCVT.CS.FXS32.F32" +
+	"VCVT.CC.FXS32.F32VCVT.MI.FXS32.F32VCVT.PL.FXS32.F32" +
+	"VCVT.VS.FXS32.F32VCVT.VC.FXS32.F32VCVT.HI.FXS32.F32" +
+	"VCVT.LS.FXS32.F32VCVT.GE.FXS32.F32VCVT.LT.FXS32.F32" +
+	"VCVT.GT.FXS32.F32VCVT.LE.FXS32.F32VCVT.FXS32.F32" +
+	"VCVT.ZZ.FXS32.F32VCVT.EQ.FXS32.F64VCVT.NE.FXS32.F64" +
+	"VCVT.CS.FXS32.F64VCVT.CC.FXS32.F64VCVT.MI.FXS32.F64" +
+	"VCVT.PL.FXS32.F64VCVT.VS.FXS32.F64VCVT.VC.FXS32.F64" +
+	"VCVT.HI.FXS32.F64VCVT.LS.FXS32.F64VCVT.GE.FXS32.F64" +
+	"VCVT.LT.FXS32.F64VCVT.GT.FXS32.F64VCVT.LE.FXS32.F64" +
+	"VCVT.FXS32.F64VCVT.ZZ.FXS32.F64VCVT.EQ.FXU16.F32" +
+	"VCVT.NE.FXU16.F32VCVT.CS.FXU16.F32VCVT.CC.FXU16.F32" +
+	"VCVT.MI.FXU16.F32VCVT.PL.FXU16.F32VCVT.VS.FXU16.F32" +
+	"VCVT.VC.FXU16.F32VCVT.HI.FXU16.F32VCVT.LS.FXU16.F32" +
+	"VCVT.GE.FXU16.F32VCVT.LT.FXU16.F32VCVT.GT.FXU16.F32" +
+	"VCVT.LE.FXU16.F32VCVT.FXU16.F32VCVT.ZZ.FXU16.F32" +
+	"VCVT.EQ.FXU16.F64VCVT.NE.FXU16.F64VCVT.CS.FXU16.F64" +
+	"VCVT.CC.FXU16.F64VCVT.MI.FXU16.F64VCVT.PL.FXU16.F64" +
+	"VCVT.VS.FXU16.F64VCVT.VC.FXU16.F64VCVT.HI.FXU16.F64" +
+	"VCVT.LS.FXU16.F64VCVT.GE.FXU16.F64VCVT.LT.FXU16.F64" +
+	"VCVT.GT.FXU16.F64VCVT.LE.FXU16.F64VCVT.FXU16.F64" +
+	"VCVT.ZZ.FXU16.F64VCVT.EQ.FXU32.F32VCVT.NE.FXU32.F32" +
+	"VCVT.CS.FXU32.F32VCVT.CC.FXU32.F32VCVT.MI.FXU32.F32" +
+	"VCVT.PL.FXU32.F32VCVT.VS.FXU32.F32VCVT.VC.FXU32.F32" +
+	"VCVT.HI.FXU32.F32VCVT.LS.FXU32.F32VCVT.GE.FXU32.F32" +
+	"VCVT.LT.FXU32.F32VCVT.GT.FXU32.F32VCVT.LE.FXU32.F32" +
+	"VCVT.FXU32.F32VCVT.ZZ.FXU32.F32VCVT.EQ.FXU32.F64" +
+	"VCVT.NE.FXU32.F64VCVT.CS.FXU32.F64VCVT.CC.FXU32.F64" +
+	"VCVT.MI.FXU32.F64VCVT.PL.FXU32.F64VCVT.VS.FXU32.F64" +
+	"VCVT.VC.FXU32.F64VCVT.HI.FXU32.F64VCVT.LS.FXU32.F64" +
+	"VCVT.GE.FXU32.F64VCVT.LT.FXU32.F64VCVT.GT.FXU32.F64" +
+	"VCVT.LE.FXU32.F64VCVT.FXU32.F64VCVT.ZZ.FXU32.F64VCVTB.EQ.F32.F16" +
+	"VCVTB.NE.F32.F16VCVTB.CS.F32.F16VCVTB.CC.F32.F16VCVTB.MI.F32.F16" +
+	"VCVTB.PL.F32.F16VCVTB.VS.F32.F16VCVTB.VC.F32.F16VCVTB.HI.F32.F16" +
+	"VCVTB.LS.F32.F16VCVTB.GE.F32.F16VCVTB.LT.F32.F16VCVTB.GT.F32.F16" +
+	"VCVTB.LE.F32.F16VCVTB.F32.F16VCVTB.ZZ.F32.F16VCVTB.EQ.F16.F32" +
+	"VCVTB.NE.F16.F32VCVTB.CS.F16.F32VCVTB.CC.F16.F32VCVTB.MI.F16.F32" +
+	"VCVTB.PL.F16.F32VCVTB.VS.F16.F32VCVTB.VC.F16.F32VCVTB.HI.F16.F32" +
+	"VCVTB.LS.F16.F32VCVTB.GE.F16.F32VCVTB.LT.F16.F32VCVTB.GT.F16.F32" +
+	"VCVTB.LE.F16.F32VCVTB.F16.F32VCVTB.ZZ.F16.F32VCVTT.EQ.F32.F16" +
+	"VCVTT.NE.F32.F16VCVTT.CS.F32.F16VCVTT.CC.F32.F16VCVTT.MI.F32.F16" +
+	"VCVTT.PL.F32.F16VCVTT.VS.F32.F16VCVTT.VC.F32.F16VCVTT.HI.F32.F16" +
+	"VCVTT.LS.F32.F16VCVTT.GE.F32.F16VCVTT.LT.F32.F16VCVTT.GT.F32.F16" +
+	"VCVTT.LE.F32.F16VCVTT.F32.F16VCVTT.ZZ.F32.F16VCVTT.EQ.F16.F32" +
+	"VCVTT.NE.F16.F32VCVTT.CS.F16.F32VCVTT.CC.F16.F32VCVTT.MI.F16.F32" +
+	"VCVTT.PL.F16.F32VCVTT.VS.F16.F32VCVTT.VC.F16.F32VCVTT.HI.F16.F32" +
+	"VCVTT.LS.F16.F32VCVTT.GE.F16.F32VCVTT.LT.F16.F32VCVTT.GT.F16.F32" +
+	"VCVTT.LE.F16.F32VCVTT.F16.F32VCVTT.ZZ.F16.F32VCVTR.EQ.U32.F32" +
+	"VCVTR.NE.U32.F32VCVTR.CS.U32.F32VCVTR.CC.U32.F32VCVTR.MI.U32.F32" +
+	"VCVTR.PL.U32.F32VCVTR.VS.U32.F32VCVTR.VC.U32.F32VCVTR.HI.U32.F32" +
+	"VCVTR.LS.U32.F32VCVTR.GE.U32.F32VCVTR.LT.U32.F32VCVTR.GT.U32.F32" +
+	"VCVTR.LE.U32.F32VCVTR.U32.F32VCVTR.ZZ.U32.F32VCVTR.EQ.U32.F64" +
+	"VCVTR.NE.U32.F64VCVTR.CS.U32.F64VCVTR.CC.U32.F64VCVTR.MI.U32.F64" +
+	"VCVTR.PL.U32.F64VCVTR.VS.U32.F64VCVTR.VC.U32.F64VCVTR.HI.U32.F64" +
+	"VCVTR.LS.U32.F64VCVTR.GE.U32.F64VCVTR.LT.U32.F64VCVTR.GT.U32.F64" +
+	"VCVTR.LE.U32.F64VCVTR.U32.F64VCVTR.ZZ.U32.F64VCVTR.EQ.S32.F32" +
+	"VCVTR.NE.S32.F32VCVTR.CS.S32.F32VCVTR.CC.S32.F32VCVTR.MI.S32.F32" +
+	"VCVTR.PL.S32.F32VCVTR.VS.S32.F32VCVTR.VC.S32.F32VCVTR.HI.S32.F32" +
+	"VCVTR.LS.S32.F32VCVTR.GE.S32.F32VCVTR.LT.S32.F32VCVTR.GT.S32.F32" +
+	"VCVTR.LE.S32.F32VCVTR.S32.F32VCVTR.ZZ.S32.F32VCVTR.EQ.S32.F64" +
+	"VCVTR.NE.S32.F64VCVTR.CS.S32.F64VCVTR.CC.S32.F64VCVTR.MI.S32.F64" +
+	"VCVTR.PL.S32.F64VCVTR.VS.S32.F64VCVTR.VC.S32.F64VCVTR.HI.S32.F64" +
+	"VCVTR.LS.S32.F64VCVTR.GE.S32.F64VCVTR.LT.S32.F64VCVTR.GT.S32.F64" +
+	"VCVTR.LE.S32.F64VCVTR.S32.F64VCVTR.ZZ.S32.F64VCVT.EQ.U32.F32" +
+	"VCVT.NE.U32.F32VCVT.CS.U32.F32VCVT.CC.U32.F32VCVT.MI.U32.F32" +
+	"VCVT.PL.U32.F32VCVT.VS.U32.F32VCVT.VC.U32.F32VCVT.HI.U32.F32" +
+	"VCVT.LS.U32.F32VCVT.GE.U32.F32VCVT.LT.U32.F32VCVT.GT.U32.F32" +
+	"VCVT.LE.U32.F32VCVT.U32.F32VCVT.ZZ.U32.F32VCVT.EQ.U32.F64" +
+	"VCVT.NE.U32.F64VCVT.CS.U32.F64VCVT.CC.U32.F64VCVT.MI.U32.F64" +
+	"VCVT.PL.U32.F64VCVT.VS.U32.F64VCVT.VC.U32.F64VCVT.HI.U32.F64" +
+	"VCVT.LS.U32.F64VCVT.GE.U32.F64VCVT.LT.U32.F64VCVT.GT.U32.F64" +
+	"VCVT.LE.U32.F64VCVT.U32.F64VCVT.ZZ.U32.F64VCVT.EQ.S32.F32" +
+	"VCVT.NE.S32.F32VCVT.CS.S32.F32VCVT.CC.S32.F32VCVT.MI.S32.F32" +
+	"VCVT.PL.S32.F32VCVT.VS.S32.F32VCVT.VC.S32.F32VCVT.HI.S32.F32" +
+	"VCVT.LS.S32.F32VCVT.GE.S32.F32VCVT.LT.S32.F32VCVT.GT.S32.F32" +
+	"VCVT.LE.S32.F32VCVT.S32.F32VCVT.ZZ.S32.F32VCVT.EQ.S32.F64" +
+	"VCVT.NE.S32.F64VCVT.CS.S32.F64VCVT.CC.S32.F64VCVT.MI.S32.F64" +
+	"VCVT.PL.S32.F64VCVT.VS.S32.F64VCVT.VC.S32.F64VCVT.HI.S32.F64" +
+	"VCVT.LS.S32.F64VCVT.GE.S32.F64VCVT.LT.S32.F64VCVT.GT.S32.F64" +
+	"VCVT.LE.S32.F64VCVT.S32.F64VCVT.ZZ.S32.F64VDIV.EQ.F32VDIV.NE.F32" +
+	"VDIV.CS.F32VDIV.CC.F32VDIV.MI.F32VDIV.PL.F32VDIV.VS.F32" +
+	"VDIV.VC.F32VDIV.HI.F32VDIV.LS.F32VDIV.GE.F32VDIV.LT.F32" +
+	"VDIV.GT.F32VDIV.LE.F32VDIV.F32VDIV.ZZ.F32VDIV.EQ.F64VDIV.NE.F64" +
+	"VDIV.CS.F64VDIV.CC.F64VDIV.MI.F64VDIV.PL.F64VDIV.VS.F64" +
+	"VDIV.VC.F64VDIV.HI.F64VDIV.LS.F64VDIV.GE.F64VDIV.LT.F64" +
+	"VDIV.GT.F64VDIV.LE.F64VDIV.F64VDIV.ZZ.F64VLDMDB.EQVLDMDB.NE" +
+	"VLDMDB.CSVLDMDB.CCVLDMDB.MIVLDMDB.PLVLDMDB.VSVLDMDB.VCVLDMDB.HI" +
+	"VLDMDB.LSVLDMDB.GEVLDMDB.LTVLDMDB.GTVLDMDB.LEVLDMDBVLDMDB.ZZ" +
+	"VLDMIA.EQVLDMIA.NEVLDMIA.CSVLDMIA.CCVLDMIA.MIVLDMIA.PLVLDMIA.VS" +
+	"VLDMIA.VCVLDMIA.HIVLDMIA.LSVLDMIA.GEVLDMIA.LTVLDMIA.GTVLDMIA.LE" +
+	"VLDMIAVLDMIA.ZZVLDR.EQVLDR.NEVLDR.CSVLDR.CCVLDR.MIVLDR.PLVLDR.VS" +
+	"VLDR.VCVLDR.HIVLDR.LSVLDR.GEVLDR.LTVLDR.GTVLDR.LEVLDRVLDR.ZZ" +
+	"VMLA.EQ.F32VMLA.NE.F32VMLA.CS.F32VMLA.CC.F32VMLA.MI.F32" +
+	"VMLA.PL.F32VMLA.VS.F32VMLA.VC.F32VMLA.HI.F32VMLA.LS.F32" +
+	"VMLA.GE.F32VMLA.LT.F32VMLA.GT.F32VMLA.LE.F32VMLA.F32VMLA.ZZ.F32" +
+	"VMLA.EQ.F64VMLA.NE.F64VMLA.CS.F64VMLA.CC.F64VMLA.MI.F64" +
+	"VMLA.PL.F64VMLA.VS.F64VMLA.VC.F64VMLA.HI.F64VMLA.LS.F64" +
+	"VMLA.GE.F64VMLA.LT.F64VMLA.GT.F64VMLA.LE.F64VMLA.F64VMLA.ZZ.F64" +
+	"VMLS.EQ.F32VMLS.NE.F32VMLS.CS.F32VMLS.CC.F32VMLS.MI.F32" +
+	"VMLS.PL.F32VMLS.VS.F32VMLS.VC.F32VMLS.HI.F32VMLS.LS.F32" +
+	"VMLS.GE.F32VMLS.LT.F32VMLS.GT.F32VMLS.LE.F32VMLS.F32VMLS.ZZ.F32" +
+	"VMLS.EQ.F64VMLS.NE.F64VMLS.CS.F64VMLS.CC.F64VMLS.MI.F64" +
+	"VMLS.PL.F64VMLS.VS.F64VMLS.VC.F64VMLS.HI.F64VMLS.LS.F64" +
+	"VMLS.GE.F64VMLS.LT.F64VMLS.GT.F64VMLS.LE.F64VMLS.F64VMLS.ZZ.F64" +
+	"VMOV.EQVMOV.NEVMOV.CSVMOV.CCVMOV.MIVMOV.PLVMOV.VSVMOV.VCVMOV.HI" +
+	"VMOV.LSVMOV.GEVMOV.LTVMOV.GTVMOV.LEVMOVVMOV.ZZVMOV.EQ.32" +
+	"VMOV.NE.32VMOV.CS.32VMOV.CC.32VMOV.MI.32VMOV.PL.32VMOV.VS.32" +
+	"VMOV.VC.32VMOV.HI.32VMOV.LS.32VMOV.GE.32VMOV.LT.32VMOV.GT.32" +
+	"VMOV.LE.32VMOV.32VMOV.ZZ.32VMOV.EQ.F32VMOV.NE.F32VMOV.CS.F32" +
+	"VMOV.CC.F32VMOV.MI.F32VMOV.PL.F32VMOV.VS.F32VMOV.VC.F32" +
+	"VMOV.HI.F32VMOV.LS.F32VMOV.GE.F32VMOV.LT.F32VMOV.GT.F32" +
+	"VMOV.LE.F32VMOV.F32VMOV.ZZ.F32VMOV.EQ.F64VMOV.NE.F64VMOV.CS.F64" +
+	"VMOV.CC.F64VMOV.MI.F64VMOV.PL.F64VMOV.VS.F64VMOV.VC.F64" +
+	"VMOV.HI.F64VMOV.LS.F64VMOV.GE.F64VMOV.LT.F64VMOV.GT.F64" +
+	"VMOV.LE.F64VMOV.F64VMOV.ZZ.F64VMRS.EQVMRS.NEVMRS.CSVMRS.CC" +
+	"VMRS.MIVMRS.PLVMRS.VSVMRS.VCVMRS.HIVMRS.LSVMRS.GEVMRS.LTVMRS.GT" +
+	"VMRS.LEVMRSVMRS.ZZVMSR.EQVMSR.NEVMSR.CSVMSR.CCVMSR.MIVMSR.PL" +
+	"VMSR.VSVMSR.VCVMSR.HIVMSR.LSVMSR.GEVMSR.LTVMSR.GTVMSR.LEVMSR" +
+	"VMSR.ZZVMUL.EQ.F32VMUL.NE.F32VMUL.CS.F32VMUL.CC.F32VMUL.MI.F32" +
+	"VMUL.PL.F32VMUL.VS.F32VMUL.VC.F32VMUL.HI.F32VMUL.LS.F32" +
+	"VMUL.GE.F32VMUL.LT.F32VMUL.GT.F32VMUL.LE.F32VMUL.F32VMUL.ZZ.F32" +
+	"VMUL.EQ.F64VMUL.NE.F64VMUL.CS.F64VMUL.CC.F64VMUL.MI.F64" +
+	"VMUL.PL.F64VMUL.VS.F64VMUL.VC.F64VMUL.HI.F64VMUL.LS.F64" +
+	"VMUL.GE.F64VMUL.LT.F64VMUL.GT.F64VMUL.LE.F64VMUL.F64VMUL.ZZ.F64" +
+	"VNEG.EQ.F32VNEG.NE.F32VNEG.CS.F32VNEG.CC.F32VNEG.MI.F32" +
+	"VNEG.PL.F32VNEG.VS.F32VNEG.VC.F32VNEG.HI.F32VNEG.LS.F32" +
+	"VNEG.GE.F32VNEG.LT.F32VNEG.GT.F32VNEG.LE.F32VNEG.F32VNEG.ZZ.F32" +
+	"VNEG.EQ.F64VNEG.NE.F64VNEG.CS.F64VNEG.CC.F64VNEG.MI.F64" +
+	"VNEG.PL.F64VNEG.VS.F64VNEG.VC.F64VNEG.HI.F64VNEG.LS.F64" +
+	"VNEG.GE.F64VNEG.LT.F64VNEG.GT.F64VNEG.LE.F64VNEG.F64VNEG.ZZ.F64" +
+	"VNMLS.EQ.F32VNMLS.NE.F32VNMLS.CS.F32VNMLS.CC.F32VNMLS.MI.F32" +
+	"VNMLS.PL.F32VNMLS.VS.F32VNMLS.VC.F32VNMLS.HI.F32VNMLS.LS.F32" +
+	"VNMLS.GE.F32VNMLS.LT.F32VNMLS.GT.F32VNMLS.LE.F32VNMLS.F32" +
+	"VNMLS.ZZ.F32VNMLS.EQ.F64VNMLS.NE.F64VNMLS.CS.F64VNMLS.CC.F64" +
+	"VNMLS.MI.F64VNMLS.PL.F64VNMLS.VS.F64VNMLS.VC.F64VNMLS.HI.F64" +
+	"VNMLS.LS.F64VNMLS.GE.F64VNMLS.LT.F64VNMLS.GT.F64VNMLS.LE.F64" +
+	"VNMLS.F64VNMLS.ZZ.F64VNMLA.EQ.F32VNMLA.NE.F32VNMLA.CS.F32" +
+	"VNMLA.CC.F32VNMLA.MI.F32VNMLA.PL.F32VNMLA.VS.F32VNMLA.VC.F32" +
+	"VNMLA.HI.F32VNMLA.LS.F32VNMLA.GE.F32VNMLA.LT.F32VNMLA.GT.F32" +
+	"VNMLA.LE.F32VNMLA.F32VNMLA.ZZ.F32VNMLA.EQ.F64VNMLA.NE.F64" +
+	"VNMLA.CS.F64VNMLA.CC.F64VNMLA.MI.F64VNMLA.PL.F64VNMLA.VS.F64" +
+	"VNMLA.VC.F64VNMLA.HI.F64VNMLA.LS.F64VNMLA.GE.F64VNMLA.LT.F64" +
+	"VNMLA.GT.F64VNMLA.LE.F64VNMLA.F64VNMLA.ZZ.F64VNMUL.EQ.F32" +
+	"VNMUL.NE.F32VNMUL.CS.F32VNMUL.CC.F32VNMUL.MI.F32VNMUL.PL.F32" +
+	"VNMUL.VS.F32VNMUL.VC.F32VNMUL.HI.F32VNMUL.LS.F32VNMUL.GE.F32" +
+	"VNMUL.LT.F32VNMUL.GT.F32VNMUL.LE.F32VNMUL.F32VNMUL.ZZ.F32" +
+	"VNMUL.EQ.F64VNMUL.NE.F64VNMUL.CS.F64VNMUL.CC.F64VNMUL.MI.F64" +
+	"VNMUL.PL.F64VNMUL.VS.F64VNMUL.VC.F64VNMUL.HI.F64VNMUL.LS.F64" +
+	"VNMUL.GE.F64VNMUL.LT.F64VNMUL.GT.F64VNMUL.LE.F64VNMUL.F64" +
+	"VNMUL.ZZ.F64VPOP.EQVPOP.NEVPOP.CSVPOP.CCVPOP.MIVPOP.PLVPOP.VS" +
+	"VPOP.VCVPOP.HIVPOP.LSVPOP.GEVPOP.LTVPOP.GTVPOP.LEVPOPVPOP.ZZ" +
+	"VPUSH.EQVPUSH.NEVPUSH.CSVPUSH.CCVPUSH.MIVPUSH.PLVPUSH.VSVPUSH.VC" +
+	"VPUSH.HIVPUSH.LSVPUSH.GEVPUSH.LTVPUSH.GTVPUSH.LEVPUSHVPUSH.ZZ" +
+	"VSQRT.EQ.F32VSQRT.NE.F32VSQRT.CS.F32VSQRT.CC.F32VSQRT.MI.F32" +
+	"VSQRT.PL.F32VSQRT.VS.F32VSQRT.VC.F32VSQRT.HI.F32VSQRT.LS.F32" +
+	"VSQRT.GE.F32VSQRT.LT.F32VSQRT.GT.F32VSQRT.LE.F32VSQRT.F32" +
+	"VSQRT.ZZ.F32VSQRT.EQ.F64VSQRT.NE.F64VSQRT.CS.F64VSQRT.CC.F64" +
+	"VSQRT.MI.F64VSQRT.PL.F64VSQRT.VS.F64VSQRT.VC.F64VSQRT.HI.F64" +
+	"VSQRT.LS.F64VSQRT.GE.F64VSQRT.LT.F64VSQRT.GT.F64VSQRT.LE.F64" +
+	"VSQRT.F64VSQRT.ZZ.F64VSTMDB.EQVSTMDB.NEVSTMDB.CSVSTMDB.CC" +
+	"VSTMDB.MIVSTMDB.PLVSTMDB.VSVSTMDB.VCVSTMDB.HIVSTMDB.LSVSTMDB.GE" +
+	"VSTMDB.LTVSTMDB.GTVSTMDB.LEVSTMDBVSTMDB.ZZVSTMIA.EQVSTMIA.NE" +
+	"VSTMIA.CSVSTMIA.CCVSTMIA.MIVSTMIA.PLVSTMIA.VSVSTMIA.VCVSTMIA.HI" +
+	"VSTMIA.LSVSTMIA.GEVSTMIA.LTVSTMIA.GTVSTMIA.LEVSTMIAVSTMIA.ZZ" +
+	"VSTR.EQVSTR.NEVSTR.CSVSTR.CCVSTR.MIVSTR.PLVSTR.VSVSTR.VCVSTR.HI" +
+	"VSTR.LSVSTR.GEVSTR.LTVSTR.GTVSTR.LEVSTRVSTR.ZZVSUB.EQ.F32" +
+	"VSUB.NE.F32VSUB.CS.F32VSUB.CC.F32VSUB.MI.F32VSUB.PL.F32" +
+	"VSUB.VS.F32VSUB.VC.F32VSUB.HI.F32VSUB.LS.F32VSUB.GE.F32" +
+	"VSUB.LT.F32VSUB.GT.F32VSUB.LE.F32VSUB.F32VSUB.ZZ.F32VSUB.EQ.F64" +
+	"VSUB.NE.F64VSUB.CS.F64VSUB.CC.F64VSUB.MI.F64VSUB.PL.F64" +
+	"VSUB.VS.F64VSUB.VC.F64VSUB.HI.F64VSUB.LS.F64VSUB.GE.F64" +
+	"VSUB.LT.F64VSUB.GT.F64VSUB.LE.F64VSUB.F64VSUB.ZZ.F64WFE.EQWFE.NE" +
+	"WFE.CSWFE.CCWFE.MIWFE.PLWFE.VSWFE.VCWFE.HIWFE.LSWFE.GEWFE.LT" +
+	"WFE.GTWFE.LEWFEWFE.ZZWFI.EQWFI.NEWFI.CSWFI.CCWFI.MIWFI.PLWFI.VS" +
+	"WFI.VCWFI.HIWFI.LSWFI.GEWFI.LTWFI.GTWFI.LEWFIWFI.ZZYIELD.EQ" +
+	"YIELD.NEYIELD.CSYIELD.CCYIELD.MIYIELD.PLYIELD.VSYIELD.VCYIELD.HI" +
+	"YIELD.LSYIELD.GEYIELD.LTYIELD.GTYIELD.LEYIELDYIELD.ZZ"
+
+var opstrIndex = [...]uint16{
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 6, 12, 18, 24, 30, 36, 42,
+	48, 54, 60, 66, 72, 78, 84, 87, 93, 101, 109, 117,
+	125, 133, 141, 149, 157, 165, 173, 181, 189, 197, 205, 210,
+	218, 224, 230, 236, 242, 248, 254, 260, 266, 272, 278, 284,
+	290, 296, 302, 305, 311, 319, 327, 335, 343, 351, 359, 367,
+	375, 383, 391, 399, 407, 415, 423, 428, 436, 442, 448, 454,
+	460, 466, 472, 478, 484, 490, 496, 502, 508, 514, 520, 523,
+	529, 537, 545, 553, 561, 569, 577, 585, 593, 601, 609, 617,
+	625, 633, 641, 646, 654, 660, 666, 672, 678, 684, 690, 696,
+	702, 708, 714, 720, 726, 732, 738, 741, 747, 755, 763, 771,
+	779, 787, 795, 803, 811, 819, 827, 835, 843, 851, 859, 864,
+	872, 876, 880, 884, 888, 892, 896, 900, 904, 908, 912, 916,
+	920, 924, 928, 929, 933, 939, 945, 951, 957, 963, 969, 975,
+	981, 987, 993, 999, 1005, 1011, 1017, 1020, 1026, 1032, 1038, 1044,
+	1050, 1056, 1062, 1068, 1074, 1080, 1086, 1092, 1098, 1104, 1110, 1113,
+	1119, 1125, 1131, 1137, 1143, 1149, 1155, 1161, 1167, 1173, 1179, 1185,
+	1191, 1197, 1203, 1206, 1212, 1220, 1228, 1236, 1244, 1252, 1260, 1268,
+	1276, 1284, 1292, 1300, 1308, 1316, 1324, 1329, 1337, 1344, 1351, 1358,
+	1365, 1372, 1379, 1386, 1393, 1400, 1407, 1414, 1421, 1428, 1435, 1439,
+	1446, 1451, 1456, 1461, 1466, 1471, 1476, 1481, 1486, 1491, 1496, 1501,
+	1506, 1511, 1516, 1518, 1523, 1529, 1535, 1541, 1547, 1553, 1559, 1565,
+	1571, 1577, 1583, 1589, 1595, 1601, 1607, 1610, 1616, 1621, 1626, 1631,
+	1636, 1641, 1646, 1651, 1656, 1661, 1666, 1671, 1676, 1681, 1686, 1688,
+	1693, 1699, 1705, 1711, 1717, 1723, 1729, 1735, 1741, 1747, 1753, 1759,
+	1765, 1771, 1777, 1780, 1786, 1791, 1791, 1791, 1791, 1791, 1791, 1791,
+	1791, 1791, 1791, 1791, 1791, 1791, 1791, 1791, 1791, 1797, 1803, 1809,
+	1815, 1821, 1827, 1833, 1839, 1845, 1851, 1857, 1863, 1869, 1875, 1878,
+	1884, 1890, 1896, 1902, 1908, 1914, 1920, 1926, 1932, 1938, 1944, 1950,
+	1956, 1962, 1968, 1971, 1977, 1983, 1989, 1995, 2001, 2007, 2013, 2019,
+	2025, 2031, 2037, 2043, 2049, 2055, 2061, 2064, 2070, 2076, 2082, 2088,
+	2094, 2100, 2106, 2112, 2118, 2124, 2130, 2136, 2142, 2148, 2154, 2157,
+	2163, 2166, 2169, 2169, 2169, 2169, 2169, 2169, 2169, 2169, 2169, 2169,
+	2169, 2169, 2169, 2169, 2169, 2175, 2181, 2187, 2193, 2199, 2205, 2211,
+	2217, 2223, 2229, 2235, 2241, 2247, 2253, 2256, 2262, 2270, 2278, 2286,
+	2294, 2302, 2310, 2318, 2326, 2334, 2342, 2350, 2358, 2366, 2374, 2379,
+	2387, 2394, 2401, 2408, 2415, 2422, 2429, 2436, 2443, 2450, 2457, 2464,
+	2471, 2478, 2485, 2489, 2496, 2499, 2499, 2499, 2499, 2499, 2499, 2499,
+	2499, 2499, 2499, 2499, 2499, 2499, 2499, 2499, 2499, 2505, 2511, 2517,
+	2523, 2529, 2535, 2541, 2547, 2553, 2559, 2565, 2571, 2577, 2583, 2586,
+	2592, 2600, 2608, 2616, 2624, 2632, 2640, 2648, 2656, 2664, 2672, 2680,
+	2688, 2696, 2704, 2709, 2717, 2725, 2733, 2741, 2749, 2757, 2765, 2773,
+	2781, 2789, 2797, 2805, 2813, 2821, 2829, 2834, 2842, 2850, 2858, 2866,
+	2874, 2882, 2890, 2898, 2906, 2914, 2922, 2930, 2938, 2946, 2954, 2959,
+	2967, 2973, 2979, 2985, 2991, 2997, 3003, 3009, 3015, 3021, 3027, 3033,
+	3039, 3045, 3051, 3054, 3060, 3067, 3074, 3081, 3088, 3095, 3102, 3109,
+	3116, 3123, 3130, 3137, 3144, 3151, 3158, 3162, 3169, 3177, 3185, 3193,
+	3201, 3209, 3217, 3225, 3233, 3241, 3249, 3257, 3265, 3273, 3281, 3286,
+	3294, 3301, 3308, 3315, 3322, 3329, 3336, 3343, 3350, 3357, 3364, 3371,
+	3378, 3385, 3392, 3396, 3403, 3411, 3419, 3427, 3435, 3443, 3451, 3459,
+	3467, 3475, 3483, 3491, 3499, 3507, 3515, 3520, 3528, 3537, 3546, 3555,
+	3564, 3573, 3582, 3591, 3600, 3609, 3618, 3627, 3636, 3645, 3654, 3660,
+	3669, 3678, 3687, 3696, 3705, 3714, 3723, 3732, 3741, 3750, 3759, 3768,
+	3777, 3786, 3795, 3801, 3810, 3819, 3828, 3837, 3846, 3855, 3864, 3873,
+	3882, 3891, 3900, 3909, 3918, 3927, 3936, 3942, 3951, 3958, 3965, 3972,
+	3979, 3986, 3993, 4000, 4007, 4014, 4021, 4028, 4035, 4042, 4049, 4053,
+	4060, 4068, 4076, 4084, 4092, 4100, 4108, 4116, 4124, 4132, 4140, 4148,
+	4156, 4164, 4172, 4177, 4185, 4193, 4201, 4209, 4217, 4225, 4233, 4241,
+	4249, 4257, 4265, 4273, 4281, 4289, 4297, 4302, 4310, 4319, 4328, 4337,
+	4346, 4355, 4364, 4373, 4382, 4391, 4400, 4409, 4418, 4427, 4436, 4442,
+	4451, 4459, 4467, 4475, 4483, 4491, 4499, 4507, 4515, 4523, 4531, 4539,
+	4547, 4555, 4563, 4568, 4576, 4585, 4594, 4603, 4612, 4621, 4630, 4639,
+	4648, 4657, 4666, 4675, 4684, 4693, 4702, 4708, 4717, 4724, 4731, 4738,
+	4745, 4752, 4759, 4766, 4773, 4780, 4787, 4794, 4801, 4808, 4815, 4819,
+	4826, 4832, 4838, 4844, 4850, 4856, 4862, 4868, 4874, 4880, 4886, 4892,
+	4898, 4904, 4910, 4913, 4919, 4927, 4935, 4943, 4951, 4959, 4967, 4975,
+	4983, 4991, 4999, 5007, 5015, 5023, 5031, 5036, 5044, 5050, 5056, 5062,
+	5068, 5074, 5080, 5086, 5092, 5098, 5104, 5110, 5116, 5122, 5128, 5131,
+	5137, 5145, 5153, 5161, 5169, 5177, 5185, 5193, 5201, 5209, 5217, 5225,
+	5233, 5241, 5249, 5254, 5262, 5268, 5274, 5280, 5286, 5292, 5298, 5304,
+	5310, 5316, 5322, 5328, 5334, 5340, 5346, 5349, 5355, 5363, 5371, 5379,
+	5387, 5395, 5403, 5411, 5419, 5427, 5435, 5443, 5451, 5459, 5467, 5472,
+	5480, 5486, 5492, 5498, 5504, 5510, 5516, 5522, 5528, 5534, 5540, 5546,
+	5552, 5558, 5564, 5567, 5573, 5579, 5585, 5591, 5597, 5603, 5609, 5615,
+	5621, 5627, 5633, 5639, 5645, 5651, 5657, 5660, 5666, 5674, 5682, 5690,
+	5698, 5706, 5714, 5722, 5730, 5738, 5746, 5754, 5762, 5770, 5778, 5783,
+	5791, 5798, 5805, 5812, 5819, 5826, 5833, 5840, 5847, 5854, 5861, 5868,
+	5875, 5882, 5889, 5893, 5900, 5907, 5914, 5921, 5928, 5935, 5942, 5949,
+	5956, 5963, 5970, 5977, 5984, 5991, 5998, 6002, 6009, 6015, 6021, 6027,
+	6033, 6039, 6045, 6051, 6057, 6063, 6069, 6075, 6081, 6087, 6093, 6096,
+	6102, 6108, 6114, 6120, 6126, 6132, 6138, 6144, 6150, 6156, 6162, 6168,
+	6174, 6180, 6186, 6189, 6195, 6203, 6211, 6219, 6227, 6235, 6243, 6251,
+	6259, 6267, 6275, 6283, 6291, 6299, 6307, 6312, 6320, 6326, 6332, 6338,
+	6344, 6350, 6356, 6362, 6368, 6374, 6380, 6386, 6392, 6398, 6404, 6407,
+	6413, 6421, 6429, 6437, 6445, 6453, 6461, 6469, 6477, 6485, 6493, 6501,
+	6509, 6517, 6525, 6530, 6538, 6544, 6550, 6556, 6562, 6568, 6574, 6580,
+	6586, 6592, 6598, 6604, 6610, 6616, 6622, 6625, 6631, 6637, 6643, 6649,
+	6655, 6661, 6667, 6673, 6679, 6685, 6691, 6697, 6703, 6709, 6715, 6718,
+	6724, 6732, 6740, 6748, 6756, 6764, 6772, 6780, 6788, 6796, 6804, 6812,
+	6820, 6828, 6836, 6841, 6849, 6857, 6865, 6873, 6881, 6889, 6897, 6905,
+	6913, 6921, 6929, 6937, 6945, 6953, 6961, 6966, 6974, 6982, 6990, 6998,
+	7006, 7014, 7022, 7030, 7038, 7046, 7054, 7062, 7070, 7078, 7086, 7091,
+	7099, 7104, 7107, 7110, 7110, 7110, 7110, 7110, 7110, 7110, 7110, 7110,
+	7110, 7110, 7110, 7110, 7110, 7116, 7122, 7128, 7134, 7140, 7146, 7152,
+	7158, 7164, 7170, 7176, 7182, 7188, 7194, 7197, 7203, 7210, 7217, 7224,
+	7231, 7238, 7245, 7252, 7259, 7266, 7273, 7280, 7287, 7294, 7301, 7305,
+	7312, 7319, 7326, 7333, 7340, 7347, 7354, 7361, 7368, 7375, 7382, 7389,
+	7396, 7403, 7410, 7414, 7421, 7430, 7439, 7448, 7457, 7466, 7475, 7484,
+	7493, 7502, 7511, 7520, 7529, 7538, 7547, 7553, 7562, 7570, 7578, 7586,
+	7594, 7602, 7610, 7618, 7626, 7634, 7642, 7650, 7658, 7666, 7674, 7679,
+	7687, 7694, 7701, 7708, 7715, 7722, 7729, 7736, 7743, 7750, 7757, 7764,
+	7771, 7778, 7785, 7789, 7796, 7804, 7812, 7820, 7828, 7836, 7844, 7852,
+	7860, 7868, 7876, 7884, 7892, 7900, 7908, 7913, 7921, 7929, 7937, 7945,
+	7953, 7961, 7969, 7977, 7985, 7993, 8001, 8009, 8017, 8025, 8033, 8038,
+	8046, 8053, 8060, 8067, 8074, 8081, 8088, 8095, 8102, 8109, 8116, 8123,
+	8130, 8137, 8144, 8148, 8155, 8162, 8169, 8176, 8183, 8190, 8197, 8204,
+	8211, 8218, 8225, 8232, 8239, 8246, 8253, 8257, 8264, 8273, 8282, 8291,
+	8300, 8309, 8318, 8327, 8336, 8345, 8354, 8363, 8372, 8381, 8390, 8396,
+	8405, 8413, 8421, 8429, 8437, 8445, 8453, 8461, 8469, 8477, 8485, 8493,
+	8501, 8509, 8517, 8522, 8530, 8537, 8544, 8551, 8558, 8565, 8572, 8579,
+	8586, 8593, 8600, 8607, 8614, 8621, 8628, 8632, 8639, 8645, 8651, 8657,
+	8663, 8669, 8675, 8681, 8687, 8693, 8699, 8705, 8711, 8717, 8723, 8726,
+	8732, 8740, 8748, 8756, 8764, 8772, 8780, 8788, 8796, 8804, 8812, 8820,
+	8828, 8836, 8844, 8849, 8857, 8865, 8873, 8881, 8889, 8897, 8905, 8913,
+	8921, 8929, 8937, 8945, 8953, 8961, 8969, 8974, 8982, 8988, 8994, 9000,
+	9006, 9012, 9018, 9024, 9030, 9036, 9042, 9048, 9054, 9060, 9066, 9069,
+	9075, 9083, 9091, 9099, 9107, 9115, 9123, 9131, 9139, 9147, 9155, 9163,
+	9171, 9179, 9187, 9192, 9200, 9206, 9212, 9218, 9224, 9230, 9236, 9242,
+	9248, 9254, 9260, 9266, 9272, 9278, 9284, 9287, 9293, 9301, 9309, 9317,
+	9325, 9333, 9341, 9349, 9357, 9365, 9373, 9381, 9389, 9397, 9405, 9410,
+	9418, 9424, 9430, 9436, 9442, 9448, 9454, 9460, 9466, 9472, 9478, 9484,
+	9490, 9496, 9502, 9505, 9511, 9519, 9527, 9535, 9543, 9551, 9559, 9567,
+	9575, 9583, 9591, 9599, 9607, 9615, 9623, 9628, 9636, 9642, 9648, 9654,
+	9660, 9666, 9672, 9678, 9684, 9690, 9696, 9702, 9708, 9714, 9720, 9723,
+	9729, 9737, 9745, 9753, 9761, 9769, 9777, 9785, 9793, 9801, 9809, 9817,
+	9825, 9833, 9841, 9846, 9854, 9863, 9872, 9881, 9890, 9899, 9908, 9917,
+	9926, 9935, 9944, 9953, 9962, 9971, 9980, 9986, 9995, 10003, 10011, 10019,
+	10027, 10035, 10043, 10051, 10059, 10067, 10075, 10083, 10091, 10099, 10107, 10112,
+	10120, 10127, 10134, 10141, 10148, 10155, 10162, 10169, 10176, 10183, 10190, 10197,
+	10204, 10211, 10218, 10222, 10229, 10235, 10241, 10247, 10253, 10259, 10265, 10271,
+	10277, 10283, 10289, 10295, 10301, 10307, 10313, 10316, 10322, 10330, 10338, 10346,
+	10354, 10362, 10370, 10378, 10386, 10394, 10402, 10410, 10418, 10426, 10434, 10439,
+	10447, 10454, 10461, 10468, 10475, 10482, 10489, 10496, 10503, 10510, 10517, 10524,
+	10531, 10538, 10545, 10549, 10556, 10562, 10568, 10574, 10580, 10586, 10592, 10598,
+	10604, 10610, 10616, 10622, 10628, 10634, 10640, 10643, 10649, 10655, 10655, 10655,
+	10655, 10655, 10655, 10655, 10655, 10655, 10655, 10655, 10655, 10655, 10655, 10655,
+	10655, 10661, 10667, 10673, 10679, 10685, 10691, 10697, 10703, 10709, 10715, 10721,
+	10727, 10733, 10739, 10742, 10748, 10758, 10768, 10778, 10788, 10798, 10808, 10818,
+	10828, 10838, 10848, 10858, 10868, 10878, 10888, 10895, 10905, 10914, 10923, 10932,
+	10941, 10950, 10959, 10968, 10977, 10986, 10995, 11004, 11013, 11022, 11031, 11037,
+	11046, 11054, 11062, 11070, 11078, 11086, 11094, 11102, 11110, 11118, 11126, 11134,
+	11142, 11150, 11158, 11163, 11171, 11179, 11187, 11195, 11203, 11211, 11219, 11227,
+	11235, 11243, 11251, 11259, 11267, 11275, 11283, 11288, 11296, 11306, 11316, 11326,
+	11336, 11346, 11356, 11366, 11376, 11386, 11396, 11406, 11416, 11426, 11436, 11443,
+	11453, 11462, 11471, 11480, 11489, 11498, 11507, 11516, 11525, 11534, 11543, 11552,
+	11561, 11570, 11579, 11585, 11594, 11603, 11612, 11621, 11630, 11639, 11648, 11657,
+	11666, 11675, 11684, 11693, 11702, 11711, 11720, 11726, 11735, 11744, 11753, 11762,
+	11771, 11780, 11789, 11798, 11807, 11816, 11825, 11834, 11843, 11852, 11861, 11867,
+	11876, 11885, 11894, 11903, 11912, 11921, 11930, 11939, 11948, 11957, 11966, 11975,
+	11984, 11993, 12002, 12008, 12017, 12026, 12035, 12044, 12053, 12062, 12071, 12080,
+	12089, 12098, 12107, 12116, 12125, 12134, 12143, 12149, 12158, 12166, 12174, 12182,
+	12190, 12198, 12206, 12214, 12222, 12230, 12238, 12246, 12254, 12262, 12270, 12275,
+	12283, 12293, 12303, 12313, 12323, 12333, 12343, 12353, 12363, 12373, 12383, 12393,
+	12403, 12413, 12423, 12430, 12440, 12448, 12456, 12464, 12472, 12480, 12488, 12496,
+	12504, 12512, 12520, 12528, 12536, 12544, 12552, 12557, 12565, 12575, 12585, 12595,
+	12605, 12615, 12625, 12635, 12645, 12655, 12665, 12675, 12685, 12695, 12705, 12712,
+	12722, 12732, 12742, 12752, 12762, 12772, 12782, 12792, 12802, 12812, 12822, 12832,
+	12842, 12852, 12862, 12869, 12879, 12889, 12899, 12909, 12919, 12929, 12939, 12949,
+	12959, 12969, 12979, 12989, 12999, 13009, 13019, 13026, 13036, 13046, 13056, 13066,
+	13076, 13086, 13096, 13106, 13116, 13126, 13136, 13146, 13156, 13166, 13176, 13183,
+	13193, 13203, 13213, 13223, 13233, 13243, 13253, 13263, 13273, 13283, 13293, 13303,
+	13313, 13323, 13333, 13340, 13350, 13359, 13368, 13377, 13386, 13395, 13404, 13413,
+	13422, 13431, 13440, 13449, 13458, 13467, 13476, 13482, 13491, 13502, 13513, 13524,
+	13535, 13546, 13557, 13568, 13579, 13590, 13601, 13612, 13623, 13634, 13645, 13653,
+	13664, 13673, 13682, 13691, 13700, 13709, 13718, 13727, 13736, 13745, 13754, 13763,
+	13772, 13781, 13790, 13796, 13805, 13814, 13823, 13832, 13841, 13850, 13859, 13868,
+	13877, 13886, 13895, 13904, 13913, 13922, 13931, 13937, 13946, 13954, 13962, 13970,
+	13978, 13986, 13994, 14002, 14010, 14018, 14026, 14034, 14042, 14050, 14058, 14063,
+	14071, 14081, 14091, 14101, 14111, 14121, 14131, 14141, 14151, 14161, 14171, 14181,
+	14191, 14201, 14211, 14218, 14228, 14237, 14246, 14255, 14264, 14273, 14282, 14291,
+	14300, 14309, 14318, 14327, 14336, 14345, 14354, 14360, 14369, 14380, 14391, 14402,
+	14413, 14424, 14435, 14446, 14457, 14468, 14479, 14490, 14501, 14512, 14523, 14531,
+	14542, 14550, 14558, 14566, 14574, 14582, 14590, 14598, 14606, 14614, 14622, 14630,
+	14638, 14646, 14654, 14659, 14667, 14677, 14687, 14697, 14707, 14717, 14727, 14737,
+	14747, 14757, 14767, 14777, 14787, 14797, 14807, 14814, 14824, 14832, 14840, 14848,
+	14856, 14864, 14872, 14880, 14888, 14896, 14904, 14912, 14920, 14928, 14936, 14941,
+	14949, 14959, 14969, 14979, 14989, 14999, 15009, 15019, 15029, 15039, 15049, 15059,
+	15069, 15079, 15089, 15096, 15106, 15114, 15122, 15130, 15138, 15146, 15154, 15162,
+	15170, 15178, 15186, 15194, 15202, 15210, 15218, 15223, 15231, 15241, 15251, 15261,
+	15271, 15281, 15291, 15301, 15311, 15321, 15331, 15341, 15351, 15361, 15371, 15378,
+	15388, 15396, 15404, 15412, 15420, 15428, 15436, 15444, 15452, 15460, 15468, 15476,
+	15484, 15492, 15500, 15505, 15513, 15523, 15533, 15543, 15553, 15563, 15573, 15583,
+	15593, 15603, 15613, 15623, 15633, 15643, 15653, 15660, 15670, 15679, 15688, 15697,
+	15706, 15715, 15724, 15733, 15742, 15751, 15760, 15769, 15778, 15787, 15796, 15802,
+	15811, 15820, 15829, 15838, 15847, 15856, 15865, 15874, 15883, 15892, 15901, 15910,
+	15919, 15928, 15937, 15943, 15952, 15961, 15970, 15979, 15988, 15997, 16006, 16015,
+	16024, 16033, 16042, 16051, 16060, 16069, 16078, 16084, 16093, 16102, 16111, 16120,
+	16129, 16138, 16147, 16156, 16165, 16174, 16183, 16192, 16201, 16210, 16219, 16225,
+	16234, 16242, 16250, 16258, 16266, 16274, 16282, 16290, 16298, 16306, 16314, 16322,
+	16330, 16338, 16346, 16351, 16359, 16369, 16379, 16389, 16399, 16409, 16419, 16429,
+	16439, 16449, 16459, 16469, 16479, 16489, 16499, 16506, 16516, 16525, 16534, 16543,
+	16552, 16561, 16570, 16579, 16588, 16597, 16606, 16615, 16624, 16633, 16642, 16648,
+	16657, 16666, 16675, 16684, 16693, 16702, 16711, 16720, 16729, 16738, 16747, 16756,
+	16765, 16774, 16783, 16789, 16798, 16806, 16814, 16822, 16830, 16838, 16846, 16854,
+	16862, 16870, 16878, 16886, 16894, 16902, 16910, 16915, 16923, 16933, 16943, 16953,
+	16963, 16973, 16983, 16993, 17003, 17013, 17023, 17033, 17043, 17053, 17063, 17070,
+	17080, 17087, 17094, 17101, 17108, 17115, 17122, 17129, 17136, 17143, 17150, 17157,
+	17164, 17171, 17178, 17182, 17189, 17198, 17207, 17216, 17225, 17234, 17243, 17252,
+	17261, 17270, 17279, 17288, 17297, 17306, 17315, 17321, 17330, 17337, 17344, 17351,
+	17358, 17365, 17372, 17379, 17386, 17393, 17400, 17407, 17414, 17421, 17428, 17432,
+	17439, 17448, 17457, 17466, 17475, 17484, 17493, 17502, 17511, 17520, 17529, 17538,
+	17547, 17556, 17565, 17571, 17580, 17588, 17596, 17604, 17612, 17620, 17628, 17636,
+	17644, 17652, 17660, 17668, 17676, 17684, 17692, 17697, 17705, 17711, 17717, 17723,
+	17729, 17735, 17741, 17747, 17753, 17759, 17765, 17771, 17777, 17783, 17789, 17792,
+	17798, 17806, 17814, 17822, 17830, 17838, 17846, 17854, 17862, 17870, 17878, 17886,
+	17894, 17902, 17910, 17915, 17923, 17931, 17939, 17947, 17955, 17963, 17971, 17979,
+	17987, 17995, 18003, 18011, 18019, 18027, 18035, 18040, 18048, 18056, 18064, 18072,
+	18080, 18088, 18096, 18104, 18112, 18120, 18128, 18136, 18144, 18152, 18160, 18165,
+	18173, 18179, 18185, 18191, 18197, 18203, 18209, 18215, 18221, 18227, 18233, 18239,
+	18245, 18251, 18257, 18260, 18266, 18273, 18280, 18287, 18294, 18301, 18308, 18315,
+	18322, 18329, 18336, 18343, 18350, 18357, 18364, 18368, 18375, 18383, 18391, 18399,
+	18407, 18415, 18423, 18431, 18439, 18447, 18455, 18463, 18471, 18479, 18487, 18492,
+	18500, 18507, 18514, 18521, 18528, 18535, 18542, 18549, 18556, 18563, 18570, 18577,
+	18584, 18591, 18598, 18602, 18609, 18617, 18625, 18633, 18641, 18649, 18657, 18665,
+	18673, 18681, 18689, 18697, 18705, 18713, 18721, 18726, 18734, 18743, 18752, 18761,
+	18770, 18779, 18788, 18797, 18806, 18815, 18824, 18833, 18842, 18851, 18860, 18866,
+	18875, 18884, 18893, 18902, 18911, 18920, 18929, 18938, 18947, 18956, 18965, 18974,
+	18983, 18992, 19001, 19007, 19016, 19025, 19034, 19043, 19052, 19061, 19070, 19079,
+	19088, 19097, 19106, 19115, 19124, 19133, 19142, 19148, 19157, 19164, 19171, 19178,
+	19185, 19192, 19199, 19206, 19213, 19220, 19227, 19234, 19241, 19248, 19255, 19259,
+	19266, 19274, 19282, 19290, 19298, 19306, 19314, 19322, 19330, 19338, 19346, 19354,
+	19362, 19370, 19378, 19383, 19391, 19398, 19405, 19412, 19419, 19426, 19433, 19440,
+	19447, 19454, 19461, 19468, 19475, 19482, 19489, 19493, 19500, 19506, 19512, 19518,
+	19524, 19530, 19536, 19542, 19548, 19554, 19560, 19566, 19572, 19578, 19584, 19587,
+	19593, 19601, 19609, 19617, 19625, 19633, 19641, 19649, 19657, 19665, 19673, 19681,
+	19689, 19697, 19705, 19710, 19718, 19724, 19730, 19736, 19742, 19748, 19754, 19760,
+	19766, 19772, 19778, 19784, 19790, 19796, 19802, 19805, 19811, 19817, 19823, 19829,
+	19835, 19841, 19847, 19853, 19859, 19865, 19871, 19877, 19883, 19889, 19895, 19898,
+	19904, 19912, 19920, 19928, 19936, 19944, 19952, 19960, 19968, 19976, 19984, 19992,
+	20000, 20008, 20016, 20021, 20029, 20037, 20045, 20053, 20061, 20069, 20077, 20085,
+	20093, 20101, 20109, 20117, 20125, 20133, 20141, 20146, 20154, 20164, 20174, 20184,
+	20194, 20204, 20214, 20224, 20234, 20244, 20254, 20264, 20274, 20284, 20294, 20301,
+	20311, 20319, 20327, 20335, 20343, 20351, 20359, 20367, 20375, 20383, 20391, 20399,
+	20407, 20415, 20423, 20428, 20436, 20443, 20450, 20457, 20464, 20471, 20478, 20485,
+	20492, 20499, 20506, 20513, 20520, 20527, 20534, 20538, 20545, 20554, 20563, 20572,
+	20581, 20590, 20599, 20608, 20617, 20626, 20635, 20644, 20653, 20662, 20671, 20677,
+	20686, 20693, 20700, 20707, 20714, 20721, 20728, 20735, 20742, 20749, 20756, 20763,
+	20770, 20777, 20784, 20788, 20795, 20801, 20807, 20813, 20819, 20825, 20831, 20837,
+	20843, 20849, 20855, 20861, 20867, 20873, 20879, 20882, 20888, 20894, 20900, 20906,
+	20912, 20918, 20924, 20930, 20936, 20942, 20948, 20954, 20960, 20966, 20972, 20975,
+	20981, 20990, 20999, 21008, 21017, 21026, 21035, 21044, 21053, 21062, 21071, 21080,
+	21089, 21098, 21107, 21113, 21122, 21130, 21138, 21146, 21154, 21162, 21170, 21178,
+	21186, 21194, 21202, 21210, 21218, 21226, 21234, 21239, 21247, 21254, 21261, 21268,
+	21275, 21282, 21289, 21296, 21303, 21310, 21317, 21324, 21331, 21338, 21345, 21349,
+	21356, 21363, 21370, 21377, 21384, 21391, 21398, 21405, 21412, 21419, 21426, 21433,
+	21440, 21447, 21454, 21458, 21465, 21475, 21485, 21495, 21505, 21515, 21525, 21535,
+	21545, 21555, 21565, 21575, 21585, 21595, 21605, 21612, 21622, 21631, 21640, 21649,
+	21658, 21667, 21676, 21685, 21694, 21703, 21712, 21721, 21730, 21739, 21748, 21754,
+	21763, 21771, 21779, 21787, 21795, 21803, 21811, 21819, 21827, 21835, 21843, 21851,
+	21859, 21867, 21875, 21880, 21888, 21896, 21904, 21912, 21920, 21928, 21936, 21944,
+	21952, 21960, 21968, 21976, 21984, 21992, 22000, 22005, 22013, 22023, 22033, 22043,
+	22053, 22063, 22073, 22083, 22093, 22103, 22113, 22123, 22133, 22143, 22153, 22160,
+	22170, 22179, 22188, 22197, 22206, 22215, 22224, 22233, 22242, 22251, 22260, 22269,
+	22278, 22287, 22296, 22302, 22311, 22319, 22327, 22335, 22343, 22351, 22359, 22367,
+	22375, 22383, 22391, 22399, 22407, 22415, 22423, 22428, 22436, 22444, 22452, 22460,
+	22468, 22476, 22484, 22492, 22500, 22508, 22516, 22524, 22532, 22540, 22548, 22553,
+	22561, 22571, 22581, 22591, 22601, 22611, 22621, 22631, 22641, 22651, 22661, 22671,
+	22681, 22691, 22701, 22708, 22718, 22726, 22734, 22742, 22750, 22758, 22766, 22774,
+	22782, 22790, 22798, 22806, 22814, 22822, 22830, 22835, 22843, 22853, 22863, 22873,
+	22883, 22893, 22903, 22913, 22923, 22933, 22943, 22953, 22963, 22973, 22983, 22990,
+	23000, 23005, 23005, 23005, 23005, 23005, 23005, 23005, 23005, 23005, 23005, 23005,
+	23005, 23005, 23005, 23005, 23005, 23015, 23025, 23035, 23045, 23055, 23065, 23075,
+	23085, 23095, 23105, 23115, 23125, 23135, 23145, 23152, 23162, 23171, 23180, 23189,
+	23198, 23207, 23216, 23225, 23234, 23243, 23252, 23261, 23270, 23279, 23288, 23294,
+	23303, 23311, 23319, 23327, 23335, 23343, 23351, 23359, 23367, 23375, 23383, 23391,
+	23399, 23407, 23415, 23420, 23428, 23436, 23444, 23452, 23460, 23468, 23476, 23484,
+	23492, 23500, 23508, 23516, 23524, 23532, 23540, 23545, 23553, 23563, 23573, 23583,
+	23593, 23603, 23613, 23623, 23633, 23643, 23653, 23663, 23673, 23683, 23693, 23700,
+	23710, 23719, 23728, 23737, 23746, 23755, 23764, 23773, 23782, 23791, 23800, 23809,
+	23818, 23827, 23836, 23842, 23851, 23859, 23867, 23875, 23883, 23891, 23899, 23907,
+	23915, 23923, 23931, 23939, 23947, 23955, 23963, 23968, 23976, 23985, 23994, 24003,
+	24012, 24021, 24030, 24039, 24048, 24057, 24066, 24075, 24084, 24093, 24102, 24108,
+	24117, 24124, 24131, 24138, 24145, 24152, 24159, 24166, 24173, 24180, 24187, 24194,
+	24201, 24208, 24215, 24219, 24226, 24235, 24244, 24253, 24262, 24271, 24280, 24289,
+	24298, 24307, 24316, 24325, 24334, 24343, 24352, 24358, 24367, 24374, 24381, 24388,
+	24395, 24402, 24409, 24416, 24423, 24430, 24437, 24444, 24451, 24458, 24465, 24469,
+	24476, 24485, 24494, 24503, 24512, 24521, 24530, 24539, 24548, 24557, 24566, 24575,
+	24584, 24593, 24602, 24608, 24617, 24625, 24633, 24641, 24649, 24657, 24665, 24673,
+	24681, 24689, 24697, 24705, 24713, 24721, 24729, 24734, 24742, 24750, 24758, 24766,
+	24774, 24782, 24790, 24798, 24806, 24814, 24822, 24830, 24838, 24846, 24854, 24859,
+	24867, 24877, 24887, 24897, 24907, 24917, 24927, 24937, 24947, 24957, 24967, 24977,
+	24987, 24997, 25007, 25014, 25024, 25032, 25040, 25048, 25056, 25064, 25072, 25080,
+	25088, 25096, 25104, 25112, 25120, 25128, 25136, 25141, 25149, 25156, 25163, 25170,
+	25177, 25184, 25191, 25198, 25205, 25212, 25219, 25226, 25233, 25240, 25247, 25251,
+	25258, 25267, 25276, 25285, 25294, 25303, 25312, 25321, 25330, 25339, 25348, 25357,
+	25366, 25375, 25384, 25390, 25399, 25406, 25413, 25420, 25427, 25434, 25441, 25448,
+	25455, 25462, 25469, 25476, 25483, 25490, 25497, 25501, 25508, 25519, 25530, 25541,
+	25552, 25563, 25574, 25585, 25596, 25607, 25618, 25629, 25640, 25651, 25662, 25670,
+	25681, 25692, 25703, 25714, 25725, 25736, 25747, 25758, 25769, 25780, 25791, 25802,
+	25813, 25824, 25835, 25843, 25854, 25865, 25876, 25887, 25898, 25909, 25920, 25931,
+	25942, 25953, 25964, 25975, 25986, 25997, 26008, 26016, 26027, 26038, 26049, 26060,
+	26071, 26082, 26093, 26104, 26115, 26126, 26137, 26148, 26159, 26170, 26181, 26189,
+	26200, 26211, 26222, 26233, 26244, 26255, 26266, 26277, 26288, 26299, 26310, 26321,
+	26332, 26343, 26354, 26362, 26373, 26384, 26395, 26406, 26417, 26428, 26439, 26450,
+	26461, 26472, 26483, 26494, 26505, 26516, 26527, 26535, 26546, 26559, 26572, 26585,
+	26598, 26611, 26624, 26637, 26650, 26663, 26676, 26689, 26702, 26715, 26728, 26738,
+	26751, 26764, 26777, 26790, 26803, 26816, 26829, 26842, 26855, 26868, 26881, 26894,
+	26907, 26920, 26933, 26943, 26956, 26973, 26990, 27007, 27024, 27041, 27058, 27075,
+	27092, 27109, 27126, 27143, 27160, 27177, 27194, 27208, 27225, 27242, 27259, 27276,
+	27293, 27310, 27327, 27344, 27361, 27378, 27395, 27412, 27429, 27446, 27463, 27477,
+	27494, 27511, 27528, 27545, 27562, 27579, 27596, 27613, 27630, 27647, 27664, 27681,
+	27698, 27715, 27732, 27746, 27763, 27780, 27797, 27814, 27831, 27848, 27865, 27882,
+	27899, 27916, 27933, 27950, 27967, 27984, 28001, 28015, 28032, 28049, 28066, 28083,
+	28100, 28117, 28134, 28151, 28168, 28185, 28202, 28219, 28236, 28253, 28270, 28284,
+	28301, 28318, 28335, 28352, 28369, 28386, 28403, 28420, 28437, 28454, 28471, 28488,
+	28505, 28522, 28539, 28553, 28570, 28587, 28604, 28621, 28638, 28655, 28672, 28689,
+	28706, 28723, 28740, 28757, 28774, 28791, 28808, 28822, 28839, 28856, 28873, 28890,
+	28907, 28924, 28941, 28958, 28975, 28992, 29009, 29026, 29043, 29060, 29077, 29091,
+	29108, 29123, 29138, 29153, 29168, 29183, 29198, 29213, 29228, 29243, 29258, 29273,
+	29288, 29303, 29318, 29330, 29345, 29360, 29375, 29390, 29405, 29420, 29435, 29450,
+	29465, 29480, 29495, 29510, 29525, 29540, 29555, 29567, 29582, 29597, 29612, 29627,
+	29642, 29657, 29672, 29687, 29702, 29717, 29732, 29747, 29762, 29777, 29792, 29804,
+	29819, 29834, 29849, 29864, 29879, 29894, 29909, 29924, 29939, 29954, 29969, 29984,
+	29999, 30014, 30029, 30041, 30056, 30071, 30086, 30101, 30116, 30131, 30146, 30161,
+	30176, 30191, 30206, 30221, 30236, 30251, 30266, 30278, 30293, 30308, 30323, 30338,
+	30353, 30368, 30383, 30398, 30413, 30428, 30443, 30458, 30473, 30488, 30503, 30515,
+	30530, 30547, 30564, 30581, 30598, 30615, 30632, 30649, 30666, 30683, 30700, 30717,
+	30734, 30751, 30768, 30782, 30799, 30816, 30833, 30850, 30867, 30884, 30901, 30918,
+	30935, 30952, 30969, 30986, 31003, 31020, 31037, 31051, 31068, 31085, 31102, 31119,
+	31136, 31153, 31170, 31187, 31204, 31221, 31238, 31255, 31272, 31289, 31306, 31320,
+	31337, 31354, 31371, 31388, 31405, 31422, 31439, 31456, 31473, 31490, 31507, 31524,
+	31541, 31558, 31575, 31589, 31606, 31623, 31640, 31657, 31674, 31691, 31708, 31725,
+	31742, 31759, 31776, 31793, 31810, 31827, 31844, 31858, 31875, 31892, 31909, 31926,
+	31943, 31960, 31977, 31994, 32011, 32028, 32045, 32062, 32079, 32096, 32113, 32127,
+	32144, 32161, 32178, 32195, 32212, 32229, 32246, 32263, 32280, 32297, 32314, 32331,
+	32348, 32365, 32382, 32396, 32413, 32430, 32447, 32464, 32481, 32498, 32515, 32532,
+	32549, 32566, 32583, 32600, 32617, 32634, 32651, 32665, 32682, 32698, 32714, 32730,
+	32746, 32762, 32778, 32794, 32810, 32826, 32842, 32858, 32874, 32890, 32906, 32919,
+	32935, 32951, 32967, 32983, 32999, 33015, 33031, 33047, 33063, 33079, 33095, 33111,
+	33127, 33143, 33159, 33172, 33188, 33204, 33220, 33236, 33252, 33268, 33284, 33300,
+	33316, 33332, 33348, 33364, 33380, 33396, 33412, 33425, 33441, 33457, 33473, 33489,
+	33505, 33521, 33537, 33553, 33569, 33585, 33601, 33617, 33633, 33649, 33665, 33678,
+	33694, 33710, 33726, 33742, 33758, 33774, 33790, 33806, 33822, 33838, 33854, 33870,
+	33886, 33902, 33918, 33931, 33947, 33963, 33979, 33995, 34011, 34027, 34043, 34059,
+	34075, 34091, 34107, 34123, 34139, 34155, 34171, 34184, 34200, 34216, 34232, 34248,
+	34264, 34280, 34296, 34312, 34328, 34344, 34360, 34376, 34392, 34408, 34424, 34437,
+	34453, 34469, 34485, 34501, 34517, 34533, 34549, 34565, 34581, 34597, 34613, 34629,
+	34645, 34661, 34677, 34690, 34706, 34721, 34736, 34751, 34766, 34781, 34796, 34811,
+	34826, 34841, 34856, 34871, 34886, 34901, 34916, 34928, 34943, 34958, 34973, 34988,
+	35003, 35018, 35033, 35048, 35063, 35078, 35093, 35108, 35123, 35138, 35153, 35165,
+	35180, 35195, 35210, 35225, 35240, 35255, 35270, 35285, 35300, 35315, 35330, 35345,
+	35360, 35375, 35390, 35402, 35417, 35432, 35447, 35462, 35477, 35492, 35507, 35522,
+	35537, 35552, 35567, 35582, 35597, 35612, 35627, 35639, 35654, 35665, 35676, 35687,
+	35698, 35709, 35720, 35731, 35742, 35753, 35764, 35775, 35786, 35797, 35808, 35816,
+	35827, 35838, 35849, 35860, 35871, 35882, 35893, 35904, 35915, 35926, 35937, 35948,
+	35959, 35970, 35981, 35989, 36000, 36009, 36018, 36027, 36036, 36045, 36054, 36063,
+	36072, 36081, 36090, 36099, 36108, 36117, 36126, 36132, 36141, 36150, 36159, 36168,
+	36177, 36186, 36195, 36204, 36213, 36222, 36231, 36240, 36249, 36258, 36267, 36273,
+	36282, 36289, 36296, 36303, 36310, 36317, 36324, 36331, 36338, 36345, 36352, 36359,
+	36366, 36373, 36380, 36384, 36391, 36402, 36413, 36424, 36435, 36446, 36457, 36468,
+	36479, 36490, 36501, 36512, 36523, 36534, 36545, 36553, 36564, 36575, 36586, 36597,
+	36608, 36619, 36630, 36641, 36652, 36663, 36674, 36685, 36696, 36707, 36718, 36726,
+	36737, 36748, 36759, 36770, 36781, 36792, 36803, 36814, 36825, 36836, 36847, 36858,
+	36869, 36880, 36891, 36899, 36910, 36921, 36932, 36943, 36954, 36965, 36976, 36987,
+	36998, 37009, 37020, 37031, 37042, 37053, 37064, 37072, 37083, 37090, 37097, 37104,
+	37111, 37118, 37125, 37132, 37139, 37146, 37153, 37160, 37167, 37174, 37181, 37185,
+	37192, 37202, 37212, 37222, 37232, 37242, 37252, 37262, 37272, 37282, 37292, 37302,
+	37312, 37322, 37332, 37339, 37349, 37360, 37371, 37382, 37393, 37404, 37415, 37426,
+	37437, 37448, 37459, 37470, 37481, 37492, 37503, 37511, 37522, 37533, 37544, 37555,
+	37566, 37577, 37588, 37599, 37610, 37621, 37632, 37643, 37654, 37665, 37676, 37684,
+	37695, 37702, 37709, 37716, 37723, 37730, 37737, 37744, 37751, 37758, 37765, 37772,
+	37779, 37786, 37793, 37797, 37804, 37811, 37818, 37825, 37832, 37839, 37846, 37853,
+	37860, 37867, 37874, 37881, 37888, 37895, 37902, 37906, 37913, 37924, 37935, 37946,
+	37957, 37968, 37979, 37990, 38001, 38012, 38023, 38034, 38045, 38056, 38067, 38075,
+	38086, 38097, 38108, 38119, 38130, 38141, 38152, 38163, 38174, 38185, 38196, 38207,
+	38218, 38229, 38240, 38248, 38259, 38270, 38281, 38292, 38303, 38314, 38325, 38336,
+	38347, 38358, 38369, 38380, 38391, 38402, 38413, 38421, 38432, 38443, 38454, 38465,
+	38476, 38487, 38498, 38509, 38520, 38531, 38542, 38553, 38564, 38575, 38586, 38594,
+	38605, 38617, 38629, 38641, 38653, 38665, 38677, 38689, 38701, 38713, 38725, 38737,
+	38749, 38761, 38773, 38782, 38794, 38806, 38818, 38830, 38842, 38854, 38866, 38878,
+	38890, 38902, 38914, 38926, 38938, 38950, 38962, 38971, 38983, 38995, 39007, 39019,
+	39031, 39043, 39055, 39067, 39079, 39091, 39103, 39115, 39127, 39139, 39151, 39160,
+	39172, 39184, 39196, 39208, 39220, 39232, 39244, 39256, 39268, 39280, 39292, 39304,
+	39316, 39328, 39340, 39349, 39361, 39373, 39385, 39397, 39409, 39421, 39433, 39445,
+	39457, 39469, 39481, 39493, 39505, 39517, 39529, 39538, 39550, 39562, 39574, 39586,
+	39598, 39610, 39622, 39634, 39646, 39658, 39670, 39682, 39694, 39706, 39718, 39727,
+	39739, 39746, 39753, 39760, 39767, 39774, 39781, 39788, 39795, 39802, 39809, 39816,
+	39823, 39830, 39837, 39841, 39848, 39856, 39864, 39872, 39880, 39888, 39896, 39904,
+	39912, 39920, 39928, 39936, 39944, 39952, 39960, 39965, 39973, 39985, 39997, 40009,
+	40021, 40033, 40045, 40057, 40069, 40081, 40093, 40105, 40117, 40129, 40141, 40150,
+	40162, 40174, 40186, 40198, 40210, 40222, 40234, 40246, 40258, 40270, 40282, 40294,
+	40306, 40318, 40330, 40339, 40351, 40360, 40369, 40378, 40387, 40396, 40405, 40414,
+	40423, 40432, 40441, 40450, 40459, 40468, 40477, 40483, 40492, 40501, 40510, 40519,
+	40528, 40537, 40546, 40555, 40564, 40573, 40582, 40591, 40600, 40609, 40618, 40624,
+	40633, 40640, 40647, 40654, 40661, 40668, 40675, 40682, 40689, 40696, 40703, 40710,
+	40717, 40724, 40731, 40735, 40742, 40753, 40764, 40775, 40786, 40797, 40808, 40819,
+	40830, 40841, 40852, 40863, 40874, 40885, 40896, 40904, 40915, 40926, 40937, 40948,
+	40959, 40970, 40981, 40992, 41003, 41014, 41025, 41036, 41047, 41058, 41069, 41077,
+	41088, 41094, 41100, 41106, 41112, 41118, 41124, 41130, 41136, 41142, 41148, 41154,
+	41160, 41166, 41172, 41175, 41181, 41187, 41193, 41199, 41205, 41211, 41217, 41223,
+	41229, 41235, 41241, 41247, 41253, 41259, 41265, 41268, 41274, 41282, 41290, 41298,
+	41306, 41314, 41322, 41330, 41338, 41346, 41354, 41362, 41370, 41378, 41386, 41391, 41399,
+}
+
+var opdesc = [...]struct{ name, desc string }{
+	{"ADC", "Add with Carry"},
+	{"ADD", "Add"},
+	{"AND", "Bitwise AND"},
+	{"ASR", "Arithmetic Shift Right"},
+	{"B", "Branch"},
+	{"BFC", "Bit Field Clear"},
+	{"BFI", "Bit Field Insert"},
+	{"BIC", "Bitwise Bit Clear"},
+	{"BKPT", "Breakpoint"},
+	{"BL", "Branch with Link"},
+	{"BLX", "Branch with Link and Exchange"},
+	{"BX", "Branch and Exchange"},
+	{"BXJ", "Branch and Exchange Jazelle"},
+	{"CLREX", "Clear-Exclusive"},
+	{"CLZ", "Count Leading Zeros"},
+	{"CMN", "Compare Negative"},
+	{"CMP", "Compare"},
+	{"DBG", "Debug Hint"},
+	{"DMB", "Data Memory Barrier"},
+	{"DSB", "Data Synchronization Barrier"},
+	{"EOR", "Bitwise Exclusive OR"},
+	{"ERET", "Exception Return"},
+	{"ISB", "Instruction Synchronization Barrier"},
+	{"LDM", "Load Multiple (Increment After)"},
+	{"LDMDA", "Load Multiple Decrement After"},
+	{"LDMDB", "Load Multiple Decrement Before"},
+	{"LDMIB", "Load Multiple Increment Before"},
+	{"LDR", "Load Register"},
+	{"LDRB", "Load Register Byte"},
+	{"LDRBT", "Load Register Byte Unprivileged"},
+	{"LDRD", "Load Register Dual"},
+	{"LDREX", "Load Register Exclusive"},
+	{"LDREXB", "Load Register Exclusive Byte"},
+	{"LDREXD", "Load Register Exclusive Doubleword"},
+	{"LDREXH", "Load Register Exclusive Halfword"},
+	{"LDRH", "Load Register Halfword"},
+	{"LDRHT", "Load Register Halfword Unprivileged"},
+	{"LDRSB", "Load Register Signed Byte"},
+	{"LDRSBT", "Load Register Signed Byte Unprivileged"},
+	{"LDRSH", "Load Register Signed Halfword"},
+	{"LDRSHT", "Load Register Signed Halfword Unprivileged"},
+	{"LDRT", "Load Register Unprivileged"},
+	{"LSL", "Logical Shift Left"},
+	{"LSR", "Logical Shift Right"},
+	{"MLA", "Multiply Accumulate"},
+	{"MLS", "Multiply and Subtract"},
+	{"MOV", "Move"},
+	{"MOVT", "Move Top"},
+	{"MOVW", "Move Wide"},
+	{"MRS", "Move to Register from Special Register"},
+	{"MUL", "Multiply"},
+	{"MVN", "Bitwise NOT"},
+	{"NOP", "No Operation"},
+	{"ORR", "Bitwise OR"},
+	{"PKHBT", "Pack Halfword Bottom Top"},
+	{"PKHTB", "Pack Halfword Top Bottom"},
+	{"PLD", "Preload Data"},
+	{"PLI", "Preload Instruction"},
+	{"POP", "Pop Multiple Registers"},
+	{"PUSH", "Push Multiple Registers"},
+	{"QADD", "Saturating Add"},
+	{"QADD16", "Saturating Add 16"},
+	{"QADD8", "Saturating Add 8"},
+	{"QASX", "Saturating Add and Subtract with Exchange"},
+	{"QDADD", "Saturating Double and Add"},
+	{"QDSUB", "Saturating Double and Subtract"},
+	{"QSAX", "Saturating Subtract and Add with Exchange"},
+	{"QSUB", "Saturating Subtract"},
+	{"QSUB16", "Saturating Subtract 16"},
+	{"QSUB8", "Saturating Subtract 8"},
+	{"RBIT", "Reverse Bits"},
+	{"REV", "Byte-Reverse Word"},
+	{"REV16", "Byte-Reverse Packed Halfword"},
+	{"REVSH", "Byte-Reverse Signed Halfword"},
+	{"ROR", "Rotate Right"},
+	{"RRX", "Rotate Right with Extend"},
+	{"RSB", "Reverse Subtract"},
+	{"RSC", "Reverse Subtract with Carry"},
+	{"SADD16", "Signed Add 16"},
+	{"SADD8", "Signed Add 8"},
+	{"SASX", "Signed Add and Subtract with Exchange"},
+	{"SBC", "Subtract with Carry"},
+	{"SBFX", "Signed Bit Field Extract"},
+	{"SEL", "Select Bytes"},
+	{"SETEND", "Set Endianness"},
+	{"SEV", "Send Event"},
+	{"SHADD16", "Signed Halving Add 16"},
+	{"SHADD8", "Signed Halving Add 8"},
+	{"SHASX", "Signed Halving Add and Subtract with Exchange"},
+	{"SHSAX", "Signed Halving Subtract and Add with Exchange"},
+	{"SHSUB16", "Signed Halving Subtract 16"},
+	{"SHSUB8", "Signed Halving Subtract 8"},
+	{"SMLABB", "Signed Multiply Accumulate (halfwords)"},
+	{"SMLABT", "Signed Multiply Accumulate (halfwords)"},
+	{"SMLAD", "Signed Multiply Accumulate Dual"},
+	{"SMLAL", "Signed Multiply Accumulate Long"},
+	{"SMLALBB", "Signed Multiply Accumulate Long (halfwords)"},
+	{"SMLALBT", "Signed Multiply Accumulate Long (halfwords)"},
+	{"SMLALD", "Signed Multiply Accumulate Long Dual"},
+	{"SMLALTB", "Signed Multiply Accumulate Long (halfwords)"},
+	{"SMLALTT", "Signed Multiply Accumulate Long (halfwords)"},
+	{"SMLATB", "Signed Multiply Accumulate (halfwords)"},
+	{"SMLATT", "Signed Multiply Accumulate (halfwords)"},
+	{"SMLAWB", "Signed Multiply Accumulate (word by halfword)"},
+	{"SMLAWT", "Signed Multiply Accumulate (word by halfword)"},
+	{"SMLSD", "Signed Multiply Subtract Dual"},
+	{"SMLSLD", "Signed Multiply Subtract Long Dual"},
+	{"SMMLA", "Signed Most Significant Word Multiply Accumulate"},
+	{"SMMLS", "Signed Most Significant Word Multiply Subtract"},
+	{"SMMUL", "Signed Most Significant Word Multiply"},
+	{"SMUAD", "Signed Dual Multiply Add"},
+	{"SMULBB", "Signed Multiply (halfwords)"},
+	{"SMULBT", "Signed Multiply (halfwords)"},
+	{"SMULL", "Signed Multiply Long"},
+	{"SMULTB", "Signed Multiply (halfwords)"},
+	{"SMULTT", "Signed Multiply (halfwords)"},
+	{"SMULWB", "Signed Multiply (word by halfword)"},
+	{"SMULWT", "Signed Multiply (word by halfword)"},
+	{"SMUSD", "Signed Dual Multiply Subtract"},
+	{"SSAT", "Signed Saturate"},
+	{"SSAT16", "Signed Saturate 16"},
+	{"SSAX", "Signed Subtract and Add with Exchange"},
+	{"SSUB16", "Signed Subtract 16"},
+	{"SSUB8", "Signed Subtract 8"},
+	{"STM", "Store Multiple (Increment After)"},
+	{"STMDA", "Store Multiple Decrement After"},
+	{"STMDB", "Store Multiple Decrement Before"},
+	{"STMIB", "Store Multiple Increment Before"},
+	{"STR", "Store Register"},
+	{"STRB", "Store Register Byte"},
+	{"STRBT", "Store Register Byte Unprivileged"},
+	{"STRD", "Store Register Dual"},
+	{"STREX", "Store Register Exclusive"},
+	{"STREXB", "Store Register Exclusive Byte"},
+	{"STREXD", "Store Register Exclusive Doubleword"},
+	{"STREXH", "Store Register Exclusive Halfword"},
+	{"STRH", "Store Register Halfword"},
+	{"STRHT", "Store Register Halfword Unprivileged"},
+	{"STRT", "Store Register Unprivileged"},
+	{"SUB", "Subtract"},
+	{"SVC", "Supervisor Call"},
+	{"SWP", "Swap"},
+	{"SXTAB", "Signed Extend and Add Byte"},
+	{"SXTAB16", "Signed Extend and Add Byte 16"},
+	{"SXTAH", "Signed Extend and Add Halfword"},
+	{"SXTB", "Signed Extend Byte"},
+	{"SXTB16", "Signed Extend Byte 16"},
+	{"SXTH", "Signed Extend Halfword"},
+	{"TEQ", "Test Equivalence"},
+	{"TST", "Test"},
+	{"UADD16", "Unsigned Add 16"},
+	{"UADD8", "Unsigned Add 8"},
+	{"UASX", "Unsigned Add and Subtract with Exchange"},
+	{"UBFX", "Unsigned Bit Field Extract"},
+	{"UHADD16", "Unsigned Halving Add 16"},
+	{"UHADD8", "Unsigned Halving Add 8"},
+	{"UHASX", "Unsigned Halving Add and Subtract with Exchange"},
+	{"UHSAX", "Unsigned Halving Subtract and Add with Exchange"},
+	{"UHSUB16", "Unsigned Halving Subtract 16"},
+	{"UHSUB8", "Unsigned Halving Subtract 8"},
+	{"UMAAL", "Unsigned Multiply Accumulate Accumulate Long"},
+	{"UMLAL", "Unsigned Multiply Accumulate Long"},
+	{"UMULL", "Unsigned Multiply Long"},
+	{"UNDEF", "Permanently Undefined"},
+	{"UQADD16", "Unsigned Saturating Add 16"},
+	{"UQADD8", "Unsigned Saturating Add 8"},
+	{"UQASX", "Unsigned Saturating Add and Subtract with Exchange"},
+	{"UQSAX", "Unsigned Saturating Subtract and Add with Exchange"},
+	{"UQSUB16", "Unsigned Saturating Subtract 16"},
+	{"UQSUB8", "Unsigned Saturating Subtract 8"},
+	{"USAD8", "Unsigned Sum of Absolute Differences"},
+	{"USADA8", "Unsigned Sum of Absolute Differences and Accumulate"},
+	{"USAT", "Unsigned Saturate"},
+	{"USAT16", "Unsigned Saturate 16"},
+	{"USAX", "Unsigned Subtract and Add with Exchange"},
+	{"USUB16", "Unsigned Subtract 16"},
+	{"USUB8", "Unsigned Subtract 8"},
+	{"UXTAB", "Unsigned Extend and Add Byte"},
+	{"UXTAB16", "Unsigned Extend and Add Byte 16"},
+	{"UXTAH", "Unsigned Extend and Add Halfword"},
+	{"UXTB", "Unsigned Extend Byte"},
+	{"UXTB16", "Unsigned Extend Byte 16"},
+	{"UXTH", "Unsigned Extend Halfword"},
+	{"VABS", "Floating-point Absolute"},
+	{"VADD", "Floating-point Add"},
+	{"VCMP", "Floating-point Compare"},
+	{"VCVT", "Floating-point Convert"},
+	{"VCVTB", "Convert to or from Half-precision Bottom"},
+	{"VCVTR", "Convert Floating-point to Integer with Rounding Mode"},
+	{"VCVTT", "Convert to or from Half-precision Top"},
+	{"VDIV", "Floating-point Divide"},
+	{"VLDMDB", "Load Multiple Floating-point Registers Decrement Before"},
+	{"VLDMIA", "Load Multiple Floating-point Registers Increment After"},
+	{"VLDR", "Load Floating-point Register"},
+	{"VMLA", "Floating-point Multiply Accumulate"},
+	{"VMLS", "Floating-point Multiply Subtract"},
+	{"VMOV", "Floating-point Move"},
+	{"VMRS", "Move to Register from Floating-point Special Register"},
+	{"VMSR", "Move to Floating-point Special Register from Register"},
+	{"VMUL", "Floating-point Multiply"},
+	{"VNEG", "Floating-point Negate"},
+	{"VNMLA", "Floating-point Negate Multiply Accumulate"},
+	{"VNMLS", "Floating-point Negate Multiply Subtract"},
+	{"VNMUL", "Floating-point Negate Multiply"},
+	{"VPOP", "Pop Floating-point Registers"},
+	{"VPUSH", "Push Floating-point Registers"},
+	{"VSQRT", "Floating-point Square Root"},
+	{"VSTMDB", "Store Multiple Floating-point Registers Decrement Before"},
+	{"VSTMIA", "Store Multiple Floating-point Registers Increment After"},
+	{"VSTR", "Store Floating-point Register"},
+	{"VSUB", "Floating-point Subtract"},
+	{"WFE", "Wait For Event"},
+	{"WFI", "Wait For Interrupt"},
+	{"YIELD", "Yield"},
 }
 
 var instFormats = [...]instFormat{
-	{0x0fe00000, 0x02a00000, 2, ADC_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_const}},                      // ADC{S}<c> <Rd>,<Rn>,#<const> cond:4|0|0|1|0|1|0|1|S|Rn:4|Rd:4|imm12:12
-	{0x0fe00090, 0x00a00010, 4, ADC_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_shift_R}},                  // ADC{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|0|1|0|1|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4
-	{0x0fe00010, 0x00a00000, 2, ADC_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},                // ADC{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} cond:4|0|0|0|0|1|0|1|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4
-	{0x0fe00000, 0x02800000, 2, ADD_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_const}},                      // ADD{S}<c> <Rd>,<Rn>,#<const> cond:4|0|0|1|0|1|0|0|S|Rn:4|Rd:4|imm12:12
-	{0x0fe00090, 0x00800010, 4, ADD_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_shift_R}},                  // ADD{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|0|1|0|0|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4
-	{0x0fe00010, 0x00800000, 2, ADD_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},                // ADD{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} cond:4|0|0|0|0|1|0|0|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4
-	{0x0fef0000, 0x028d0000, 2, ADD_EQ, 0x14011c04, instArgs{arg_R_12, arg_SP, arg_const}},                        // ADD{S}<c> <Rd>,SP,#<const> cond:4|0|0|1|0|1|0|0|S|1|1|0|1|Rd:4|imm12:12
-	{0x0fef0010, 0x008d0000, 2, ADD_EQ, 0x14011c04, instArgs{arg_R_12, arg_SP, arg_R_shift_imm}},                  // ADD{S}<c> <Rd>,SP,<Rm>{,<shift>} cond:4|0|0|0|0|1|0|0|S|1|1|0|1|Rd:4|imm5:5|type:2|0|Rm:4
-	{0x0fe00000, 0x02000000, 2, AND_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_const}},                      // AND{S}<c> <Rd>,<Rn>,#<const> cond:4|0|0|1|0|0|0|0|S|Rn:4|Rd:4|imm12:12
-	{0x0fe00090, 0x00000010, 4, AND_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_shift_R}},                  // AND{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|0|0|0|0|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4
-	{0x0fe00010, 0x00000000, 2, AND_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},                // AND{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} cond:4|0|0|0|0|0|0|0|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4
-	{0x0fef0070, 0x01a00040, 4, ASR_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_0, arg_imm5_32}},                     // ASR{S}<c> <Rd>,<Rm>,#<imm5_32> cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|imm5:5|1|0|0|Rm:4
-	{0x0fef00f0, 0x01a00050, 4, ASR_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_0, arg_R_8}},                         // ASR{S}<c> <Rd>,<Rn>,<Rm> cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|Rm:4|0|1|0|1|Rn:4
-	{0x0f000000, 0x0a000000, 4, B_EQ, 0x1c04, instArgs{arg_label24}},                                              // B<c> <label24> cond:4|1|0|1|0|imm24:24
-	{0x0fe0007f, 0x07c0001f, 4, BFC_EQ, 0x1c04, instArgs{arg_R_12, arg_imm5, arg_lsb_width}},                      // BFC<c> <Rd>,#<lsb>,#<width> cond:4|0|1|1|1|1|1|0|msb:5|Rd:4|lsb:5|0|0|1|1|1|1|1
-	{0x0fe00070, 0x07c00010, 2, BFI_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0, arg_imm5, arg_lsb_width}},             // BFI<c> <Rd>,<Rn>,#<lsb>,#<width> cond:4|0|1|1|1|1|1|0|msb:5|Rd:4|lsb:5|0|0|1|Rn:4
-	{0x0fe00000, 0x03c00000, 2, BIC_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_const}},                      // BIC{S}<c> <Rd>,<Rn>,#<const> cond:4|0|0|1|1|1|1|0|S|Rn:4|Rd:4|imm12:12
-	{0x0fe00090, 0x01c00010, 4, BIC_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_shift_R}},                  // BIC{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|1|1|1|0|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4
-	{0x0fe00010, 0x01c00000, 2, BIC_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},                // BIC{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} cond:4|0|0|0|1|1|1|0|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4
-	{0x0ff000f0, 0x01200070, 4, BKPT_EQ, 0x1c04, instArgs{arg_imm_12at8_4at0}},                                    // BKPT<c> #<imm12+4> cond:4|0|0|0|1|0|0|1|0|imm12:12|0|1|1|1|imm4:4
-	{0x0f000000, 0x0b000000, 4, BL_EQ, 0x1c04, instArgs{arg_label24}},                                             // BL<c> <label24> cond:4|1|0|1|1|imm24:24
-	{0xfe000000, 0xfa000000, 4, BLX, 0x0, instArgs{arg_label24H}},                                                 // BLX <label24H> 1|1|1|1|1|0|1|H|imm24:24
-	{0x0ffffff0, 0x012fff30, 4, BLX_EQ, 0x1c04, instArgs{arg_R_0}},                                                // BLX<c> <Rm> cond:4|0|0|0|1|0|0|1|0|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
-	{0x0ff000f0, 0x012fff30, 3, BLX_EQ, 0x1c04, instArgs{arg_R_0}},                                                // BLX<c> <Rm> cond:4|0|0|0|1|0|0|1|0|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
-	{0x0ffffff0, 0x012fff10, 4, BX_EQ, 0x1c04, instArgs{arg_R_0}},                                                 // BX<c> <Rm> cond:4|0|0|0|1|0|0|1|0|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
-	{0x0ff000f0, 0x012fff10, 3, BX_EQ, 0x1c04, instArgs{arg_R_0}},                                                 // BX<c> <Rm> cond:4|0|0|0|1|0|0|1|0|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
-	{0x0ffffff0, 0x012fff20, 4, BXJ_EQ, 0x1c04, instArgs{arg_R_0}},                                                // BXJ<c> <Rm> cond:4|0|0|0|1|0|0|1|0|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|0|0|1|0|Rm:4
-	{0x0ff000f0, 0x012fff20, 3, BXJ_EQ, 0x1c04, instArgs{arg_R_0}},                                                // BXJ<c> <Rm> cond:4|0|0|0|1|0|0|1|0|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|0|0|1|0|Rm:4
-	{0xffffffff, 0xf57ff01f, 4, CLREX, 0x0, instArgs{}},                                                           // CLREX 1|1|1|1|0|1|0|1|0|1|1|1|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|1|(1)|(1)|(1)|(1)
-	{0xfff000f0, 0xf57ff01f, 3, CLREX, 0x0, instArgs{}},                                                           // CLREX 1|1|1|1|0|1|0|1|0|1|1|1|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|1|(1)|(1)|(1)|(1)
-	{0x0fff0ff0, 0x016f0f10, 4, CLZ_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0}},                                      // CLZ<c> <Rd>,<Rm> cond:4|0|0|0|1|0|1|1|0|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
-	{0x0ff000f0, 0x016f0f10, 3, CLZ_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0}},                                      // CLZ<c> <Rd>,<Rm> cond:4|0|0|0|1|0|1|1|0|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
-	{0x0ff0f000, 0x03700000, 4, CMN_EQ, 0x1c04, instArgs{arg_R_16, arg_const}},                                    // CMN<c> <Rn>,#<const> cond:4|0|0|1|1|0|1|1|1|Rn:4|(0)|(0)|(0)|(0)|imm12:12
-	{0x0ff00000, 0x03700000, 3, CMN_EQ, 0x1c04, instArgs{arg_R_16, arg_const}},                                    // CMN<c> <Rn>,#<const> cond:4|0|0|1|1|0|1|1|1|Rn:4|(0)|(0)|(0)|(0)|imm12:12
-	{0x0ff0f090, 0x01700010, 4, CMN_EQ, 0x1c04, instArgs{arg_R_16, arg_R_shift_R}},                                // CMN<c> <Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|1|0|1|1|1|Rn:4|(0)|(0)|(0)|(0)|Rs:4|0|type:2|1|Rm:4
-	{0x0ff00090, 0x01700010, 3, CMN_EQ, 0x1c04, instArgs{arg_R_16, arg_R_shift_R}},                                // CMN<c> <Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|1|0|1|1|1|Rn:4|(0)|(0)|(0)|(0)|Rs:4|0|type:2|1|Rm:4
-	{0x0ff0f010, 0x01700000, 4, CMN_EQ, 0x1c04, instArgs{arg_R_16, arg_R_shift_imm}},                              // CMN<c> <Rn>,<Rm>{,<shift>} cond:4|0|0|0|1|0|1|1|1|Rn:4|(0)|(0)|(0)|(0)|imm5:5|type:2|0|Rm:4
-	{0x0ff00010, 0x01700000, 3, CMN_EQ, 0x1c04, instArgs{arg_R_16, arg_R_shift_imm}},                              // CMN<c> <Rn>,<Rm>{,<shift>} cond:4|0|0|0|1|0|1|1|1|Rn:4|(0)|(0)|(0)|(0)|imm5:5|type:2|0|Rm:4
-	{0x0ff0f000, 0x03500000, 4, CMP_EQ, 0x1c04, instArgs{arg_R_16, arg_const}},                                    // CMP<c> <Rn>,#<const> cond:4|0|0|1|1|0|1|0|1|Rn:4|(0)|(0)|(0)|(0)|imm12:12
-	{0x0ff00000, 0x03500000, 3, CMP_EQ, 0x1c04, instArgs{arg_R_16, arg_const}},                                    // CMP<c> <Rn>,#<const> cond:4|0|0|1|1|0|1|0|1|Rn:4|(0)|(0)|(0)|(0)|imm12:12
-	{0x0ff0f090, 0x01500010, 4, CMP_EQ, 0x1c04, instArgs{arg_R_16, arg_R_shift_R}},                                // CMP<c> <Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|1|0|1|0|1|Rn:4|(0)|(0)|(0)|(0)|Rs:4|0|type:2|1|Rm:4
-	{0x0ff00090, 0x01500010, 3, CMP_EQ, 0x1c04, instArgs{arg_R_16, arg_R_shift_R}},                                // CMP<c> <Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|1|0|1|0|1|Rn:4|(0)|(0)|(0)|(0)|Rs:4|0|type:2|1|Rm:4
-	{0x0ff0f010, 0x01500000, 4, CMP_EQ, 0x1c04, instArgs{arg_R_16, arg_R_shift_imm}},                              // CMP<c> <Rn>,<Rm>{,<shift>} cond:4|0|0|0|1|0|1|0|1|Rn:4|(0)|(0)|(0)|(0)|imm5:5|type:2|0|Rm:4
-	{0x0ff00010, 0x01500000, 3, CMP_EQ, 0x1c04, instArgs{arg_R_16, arg_R_shift_imm}},                              // CMP<c> <Rn>,<Rm>{,<shift>} cond:4|0|0|0|1|0|1|0|1|Rn:4|(0)|(0)|(0)|(0)|imm5:5|type:2|0|Rm:4
-	{0x0ffffff0, 0x0320f0f0, 4, DBG_EQ, 0x1c04, instArgs{arg_option}},                                             // DBG<c> #<option> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|1|1|1|1|option:4
-	{0x0fff00f0, 0x0320f0f0, 3, DBG_EQ, 0x1c04, instArgs{arg_option}},                                             // DBG<c> #<option> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|1|1|1|1|option:4
-	{0xfffffff0, 0xf57ff050, 4, DMB, 0x0, instArgs{arg_option}},                                                   // DMB #<option> 1|1|1|1|0|1|0|1|0|1|1|1|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|1|0|1|option:4
-	{0xfff000f0, 0xf57ff050, 3, DMB, 0x0, instArgs{arg_option}},                                                   // DMB #<option> 1|1|1|1|0|1|0|1|0|1|1|1|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|1|0|1|option:4
-	{0xfffffff0, 0xf57ff040, 4, DSB, 0x0, instArgs{arg_option}},                                                   // DSB #<option> 1|1|1|1|0|1|0|1|0|1|1|1|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|1|0|0|option:4
-	{0xfff000f0, 0xf57ff040, 3, DSB, 0x0, instArgs{arg_option}},                                                   // DSB #<option> 1|1|1|1|0|1|0|1|0|1|1|1|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|1|0|0|option:4
-	{0x0fe00000, 0x02200000, 2, EOR_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_const}},                      // EOR{S}<c> <Rd>,<Rn>,#<const> cond:4|0|0|1|0|0|0|1|S|Rn:4|Rd:4|imm12:12
-	{0x0fe00090, 0x00200010, 4, EOR_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_shift_R}},                  // EOR{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|0|0|0|1|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4
-	{0x0fe00010, 0x00200000, 2, EOR_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},                // EOR{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} cond:4|0|0|0|0|0|0|1|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4
-	{0xfffffff0, 0xf57ff060, 4, ISB, 0x0, instArgs{arg_option}},                                                   // ISB #<option> 1|1|1|1|0|1|0|1|0|1|1|1|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|1|1|0|option:4
-	{0xfff000f0, 0xf57ff060, 3, ISB, 0x0, instArgs{arg_option}},                                                   // ISB #<option> 1|1|1|1|0|1|0|1|0|1|1|1|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|1|1|0|option:4
-	{0x0fd00000, 0x08900000, 2, LDM_EQ, 0x1c04, instArgs{arg_R_16_WB, arg_registers}},                             // LDM<c> <Rn>{!},<registers> cond:4|1|0|0|0|1|0|W|1|Rn:4|register_list:16
-	{0x0fd00000, 0x08100000, 4, LDMDA_EQ, 0x1c04, instArgs{arg_R_16_WB, arg_registers}},                           // LDMDA<c> <Rn>{!},<registers> cond:4|1|0|0|0|0|0|W|1|Rn:4|register_list:16
-	{0x0fd00000, 0x09100000, 4, LDMDB_EQ, 0x1c04, instArgs{arg_R_16_WB, arg_registers}},                           // LDMDB<c> <Rn>{!},<registers> cond:4|1|0|0|1|0|0|W|1|Rn:4|register_list:16
-	{0x0fd00000, 0x09900000, 4, LDMIB_EQ, 0x1c04, instArgs{arg_R_16_WB, arg_registers}},                           // LDMIB<c> <Rn>{!},<registers> cond:4|1|0|0|1|1|0|W|1|Rn:4|register_list:16
-	{0x0f7f0000, 0x051f0000, 4, LDR_EQ, 0x1c04, instArgs{arg_R_12, arg_label_pm_12}},                              // LDR<c> <Rt>,<label+/-12> cond:4|0|1|0|(1)|U|0|(0)|1|1|1|1|1|Rt:4|imm12:12
-	{0x0e5f0000, 0x051f0000, 3, LDR_EQ, 0x1c04, instArgs{arg_R_12, arg_label_pm_12}},                              // LDR<c> <Rt>,<label+/-12> cond:4|0|1|0|(1)|U|0|(0)|1|1|1|1|1|Rt:4|imm12:12
-	{0x0e500010, 0x06100000, 2, LDR_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_R_shift_imm_W}},                   // LDR<c> <Rt>,[<Rn>,+/-<Rm>{, <shift>}]{!} cond:4|0|1|1|P|U|0|W|1|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4
-	{0x0e500000, 0x04100000, 2, LDR_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_imm12_W}},                         // LDR<c> <Rt>,[<Rn>{,#+/-<imm12>}]{!} cond:4|0|1|0|P|U|0|W|1|Rn:4|Rt:4|imm12:12
-	{0x0f7f0000, 0x055f0000, 4, LDRB_EQ, 0x1c04, instArgs{arg_R_12, arg_label_pm_12}},                             // LDRB<c> <Rt>,<label+/-12> cond:4|0|1|0|(1)|U|1|(0)|1|1|1|1|1|Rt:4|imm12:12
-	{0x0e5f0000, 0x055f0000, 3, LDRB_EQ, 0x1c04, instArgs{arg_R_12, arg_label_pm_12}},                             // LDRB<c> <Rt>,<label+/-12> cond:4|0|1|0|(1)|U|1|(0)|1|1|1|1|1|Rt:4|imm12:12
-	{0x0e500010, 0x06500000, 2, LDRB_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_R_shift_imm_W}},                  // LDRB<c> <Rt>,[<Rn>,+/-<Rm>{, <shift>}]{!} cond:4|0|1|1|P|U|1|W|1|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4
-	{0x0e500000, 0x04500000, 2, LDRB_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_imm12_W}},                        // LDRB<c> <Rt>,[<Rn>{,#+/-<imm12>}]{!} cond:4|0|1|0|P|U|1|W|1|Rn:4|Rt:4|imm12:12
-	{0x0f700000, 0x04700000, 4, LDRBT_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_imm12_postindex}},               // LDRBT<c> <Rt>,[<Rn>],#+/-<imm12> cond:4|0|1|0|0|U|1|1|1|Rn:4|Rt:4|imm12:12
-	{0x0f700010, 0x06700000, 4, LDRBT_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_R_shift_imm_postindex}},         // LDRBT<c> <Rt>,[<Rn>],+/-<Rm>{, <shift>} cond:4|0|1|1|0|U|1|1|1|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4
-	{0x0e500ff0, 0x000000d0, 4, LDRD_EQ, 0x1c04, instArgs{arg_R1_12, arg_R2_12, arg_mem_R_pm_R_W}},                // LDRD<c> <Rt1>,<Rt2>,[<Rn>,+/-<Rm>]{!} cond:4|0|0|0|P|U|0|W|0|Rn:4|Rt:4|(0)|(0)|(0)|(0)|1|1|0|1|Rm:4
-	{0x0e5000f0, 0x000000d0, 3, LDRD_EQ, 0x1c04, instArgs{arg_R1_12, arg_R2_12, arg_mem_R_pm_R_W}},                // LDRD<c> <Rt1>,<Rt2>,[<Rn>,+/-<Rm>]{!} cond:4|0|0|0|P|U|0|W|0|Rn:4|Rt:4|(0)|(0)|(0)|(0)|1|1|0|1|Rm:4
-	{0x0e5000f0, 0x004000d0, 2, LDRD_EQ, 0x1c04, instArgs{arg_R1_12, arg_R2_12, arg_mem_R_pm_imm8_W}},             // LDRD<c> <Rt1>,<Rt2>,[<Rn>{,#+/-<imm8>}]{!} cond:4|0|0|0|P|U|1|W|0|Rn:4|Rt:4|imm4H:4|1|1|0|1|imm4L:4
-	{0x0ff00fff, 0x01900f9f, 4, LDREX_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R}},                                  // LDREX<c> <Rt>,[<Rn>] cond:4|0|0|0|1|1|0|0|1|Rn:4|Rt:4|(1)|(1)|(1)|(1)|1|0|0|1|(1)|(1)|(1)|(1)
-	{0x0ff000f0, 0x01900f9f, 3, LDREX_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R}},                                  // LDREX<c> <Rt>,[<Rn>] cond:4|0|0|0|1|1|0|0|1|Rn:4|Rt:4|(1)|(1)|(1)|(1)|1|0|0|1|(1)|(1)|(1)|(1)
-	{0x0ff00fff, 0x01d00f9f, 4, LDREXB_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R}},                                 // LDREXB<c> <Rt>, [<Rn>] cond:4|0|0|0|1|1|1|0|1|Rn:4|Rt:4|(1)|(1)|(1)|(1)|1|0|0|1|(1)|(1)|(1)|(1)
-	{0x0ff000f0, 0x01d00f9f, 3, LDREXB_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R}},                                 // LDREXB<c> <Rt>, [<Rn>] cond:4|0|0|0|1|1|1|0|1|Rn:4|Rt:4|(1)|(1)|(1)|(1)|1|0|0|1|(1)|(1)|(1)|(1)
-	{0x0ff00fff, 0x01b00f9f, 4, LDREXD_EQ, 0x1c04, instArgs{arg_R1_12, arg_R2_12, arg_mem_R}},                     // LDREXD<c> <Rt1>,<Rt2>,[<Rn>] cond:4|0|0|0|1|1|0|1|1|Rn:4|Rt:4|(1)|(1)|(1)|(1)|1|0|0|1|(1)|(1)|(1)|(1)
-	{0x0ff000f0, 0x01b00f9f, 3, LDREXD_EQ, 0x1c04, instArgs{arg_R1_12, arg_R2_12, arg_mem_R}},                     // LDREXD<c> <Rt1>,<Rt2>,[<Rn>] cond:4|0|0|0|1|1|0|1|1|Rn:4|Rt:4|(1)|(1)|(1)|(1)|1|0|0|1|(1)|(1)|(1)|(1)
-	{0x0ff00fff, 0x01f00f9f, 4, LDREXH_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R}},                                 // LDREXH<c> <Rt>, [<Rn>] cond:4|0|0|0|1|1|1|1|1|Rn:4|Rt:4|(1)|(1)|(1)|(1)|1|0|0|1|(1)|(1)|(1)|(1)
-	{0x0ff000f0, 0x01f00f9f, 3, LDREXH_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R}},                                 // LDREXH<c> <Rt>, [<Rn>] cond:4|0|0|0|1|1|1|1|1|Rn:4|Rt:4|(1)|(1)|(1)|(1)|1|0|0|1|(1)|(1)|(1)|(1)
-	{0x0e500ff0, 0x001000b0, 2, LDRH_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_R_W}},                            // LDRH<c> <Rt>,[<Rn>,+/-<Rm>]{!} cond:4|0|0|0|P|U|0|W|1|Rn:4|Rt:4|0|0|0|0|1|0|1|1|Rm:4
-	{0x0e5000f0, 0x005000b0, 2, LDRH_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_imm8_W}},                         // LDRH<c> <Rt>,[<Rn>{,#+/-<imm8>}]{!} cond:4|0|0|0|P|U|1|W|1|Rn:4|Rt:4|imm4H:4|1|0|1|1|imm4L:4
-	{0x0f7000f0, 0x007000b0, 4, LDRHT_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_imm8_postindex}},                // LDRHT<c> <Rt>, [<Rn>] {,#+/-<imm8>} cond:4|0|0|0|0|U|1|1|1|Rn:4|Rt:4|imm4H:4|1|0|1|1|imm4L:4
-	{0x0f700ff0, 0x003000b0, 4, LDRHT_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_R_postindex}},                   // LDRHT<c> <Rt>, [<Rn>], +/-<Rm> cond:4|0|0|0|0|U|0|1|1|Rn:4|Rt:4|0|0|0|0|1|0|1|1|Rm:4
-	{0x0e500ff0, 0x001000d0, 2, LDRSB_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_R_W}},                           // LDRSB<c> <Rt>,[<Rn>,+/-<Rm>]{!} cond:4|0|0|0|P|U|0|W|1|Rn:4|Rt:4|0|0|0|0|1|1|0|1|Rm:4
-	{0x0e5000f0, 0x005000d0, 2, LDRSB_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_imm8_W}},                        // LDRSB<c> <Rt>,[<Rn>{,#+/-<imm8>}]{!} cond:4|0|0|0|P|U|1|W|1|Rn:4|Rt:4|imm4H:4|1|1|0|1|imm4L:4
-	{0x0f7000f0, 0x007000d0, 4, LDRSBT_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_imm8_postindex}},               // LDRSBT<c> <Rt>, [<Rn>] {,#+/-<imm8>} cond:4|0|0|0|0|U|1|1|1|Rn:4|Rt:4|imm4H:4|1|1|0|1|imm4L:4
-	{0x0f700ff0, 0x003000d0, 4, LDRSBT_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_R_postindex}},                  // LDRSBT<c> <Rt>, [<Rn>], +/-<Rm> cond:4|0|0|0|0|U|0|1|1|Rn:4|Rt:4|0|0|0|0|1|1|0|1|Rm:4
-	{0x0e500ff0, 0x001000f0, 2, LDRSH_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_R_W}},                           // LDRSH<c> <Rt>,[<Rn>,+/-<Rm>]{!} cond:4|0|0|0|P|U|0|W|1|Rn:4|Rt:4|0|0|0|0|1|1|1|1|Rm:4
-	{0x0e5000f0, 0x005000f0, 2, LDRSH_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_imm8_W}},                        // LDRSH<c> <Rt>,[<Rn>{,#+/-<imm8>}]{!} cond:4|0|0|0|P|U|1|W|1|Rn:4|Rt:4|imm4H:4|1|1|1|1|imm4L:4
-	{0x0f7000f0, 0x007000f0, 4, LDRSHT_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_imm8_postindex}},               // LDRSHT<c> <Rt>, [<Rn>] {,#+/-<imm8>} cond:4|0|0|0|0|U|1|1|1|Rn:4|Rt:4|imm4H:4|1|1|1|1|imm4L:4
-	{0x0f700ff0, 0x003000f0, 4, LDRSHT_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_R_postindex}},                  // LDRSHT<c> <Rt>, [<Rn>], +/-<Rm> cond:4|0|0|0|0|U|0|1|1|Rn:4|Rt:4|0|0|0|0|1|1|1|1|Rm:4
-	{0x0f700000, 0x04300000, 4, LDRT_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_imm12_postindex}},                // LDRT<c> <Rt>, [<Rn>] {,#+/-<imm12>} cond:4|0|1|0|0|U|0|1|1|Rn:4|Rt:4|imm12:12
-	{0x0f700010, 0x06300000, 4, LDRT_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_R_shift_imm_postindex}},          // LDRT<c> <Rt>,[<Rn>],+/-<Rm>{, <shift>} cond:4|0|1|1|0|U|0|1|1|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4
-	{0x0fef0070, 0x01a00000, 2, LSL_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_0, arg_imm5_nz}},                     // LSL{S}<c> <Rd>,<Rm>,#<imm5_nz> cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|imm5:5|0|0|0|Rm:4
-	{0x0fef00f0, 0x01a00010, 4, LSL_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_0, arg_R_8}},                         // LSL{S}<c> <Rd>,<Rn>,<Rm> cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|Rm:4|0|0|0|1|Rn:4
-	{0x0fef0070, 0x01a00020, 4, LSR_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_0, arg_imm5_32}},                     // LSR{S}<c> <Rd>,<Rm>,#<imm5_32> cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|imm5:5|0|1|0|Rm:4
-	{0x0fef00f0, 0x01a00030, 4, LSR_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_0, arg_R_8}},                         // LSR{S}<c> <Rd>,<Rn>,<Rm> cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|Rm:4|0|0|1|1|Rn:4
-	{0x0fe000f0, 0x00200090, 4, MLA_EQ, 0x14011c04, instArgs{arg_R_16, arg_R_0, arg_R_8, arg_R_12}},               // MLA{S}<c> <Rd>,<Rn>,<Rm>,<Ra> cond:4|0|0|0|0|0|0|1|S|Rd:4|Ra:4|Rm:4|1|0|0|1|Rn:4
-	{0x0ff000f0, 0x00600090, 4, MLS_EQ, 0x1c04, instArgs{arg_R_16, arg_R_0, arg_R_8, arg_R_12}},                   // MLS<c> <Rd>,<Rn>,<Rm>,<Ra> cond:4|0|0|0|0|0|1|1|0|Rd:4|Ra:4|Rm:4|1|0|0|1|Rn:4
-	{0x0ff00000, 0x03400000, 4, MOVT_EQ, 0x1c04, instArgs{arg_R_12, arg_imm_4at16_12at0}},                         // MOVT<c> <Rd>,#<imm12+4> cond:4|0|0|1|1|0|1|0|0|imm4:4|Rd:4|imm12:12
-	{0x0ff00000, 0x03000000, 4, MOVW_EQ, 0x1c04, instArgs{arg_R_12, arg_imm_4at16_12at0}},                         // MOVW<c> <Rd>,#<imm12+4> cond:4|0|0|1|1|0|0|0|0|imm4:4|Rd:4|imm12:12
-	{0x0fef0000, 0x03a00000, 2, MOV_EQ, 0x14011c04, instArgs{arg_R_12, arg_const}},                                // MOV{S}<c> <Rd>,#<const> cond:4|0|0|1|1|1|0|1|S|0|0|0|0|Rd:4|imm12:12
-	{0x0fef0ff0, 0x01a00000, 2, MOV_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_0}},                                  // MOV{S}<c> <Rd>,<Rm> cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|0|0|0|0|0|0|0|0|Rm:4
-	{0x0fff0fff, 0x010f0000, 4, MRS_EQ, 0x1c04, instArgs{arg_R_12, arg_APSR}},                                     // MRS<c> <Rd>,APSR cond:4|0|0|0|1|0|0|0|0|(1)|(1)|(1)|(1)|Rd:4|(0)|(0)|(0)|(0)|0|0|0|0|(0)|(0)|(0)|(0)
-	{0x0ff000f0, 0x010f0000, 3, MRS_EQ, 0x1c04, instArgs{arg_R_12, arg_APSR}},                                     // MRS<c> <Rd>,APSR cond:4|0|0|0|1|0|0|0|0|(1)|(1)|(1)|(1)|Rd:4|(0)|(0)|(0)|(0)|0|0|0|0|(0)|(0)|(0)|(0)
-	{0x0fe0f0f0, 0x00000090, 4, MUL_EQ, 0x14011c04, instArgs{arg_R_16, arg_R_0, arg_R_8}},                         // MUL{S}<c> <Rd>,<Rn>,<Rm> cond:4|0|0|0|0|0|0|0|S|Rd:4|(0)|(0)|(0)|(0)|Rm:4|1|0|0|1|Rn:4
-	{0x0fe000f0, 0x00000090, 3, MUL_EQ, 0x14011c04, instArgs{arg_R_16, arg_R_0, arg_R_8}},                         // MUL{S}<c> <Rd>,<Rn>,<Rm> cond:4|0|0|0|0|0|0|0|S|Rd:4|(0)|(0)|(0)|(0)|Rm:4|1|0|0|1|Rn:4
-	{0x0fef0000, 0x03e00000, 2, MVN_EQ, 0x14011c04, instArgs{arg_R_12, arg_const}},                                // MVN{S}<c> <Rd>,#<const> cond:4|0|0|1|1|1|1|1|S|(0)|(0)|(0)|(0)|Rd:4|imm12:12
-	{0x0fe00000, 0x03e00000, 1, MVN_EQ, 0x14011c04, instArgs{arg_R_12, arg_const}},                                // MVN{S}<c> <Rd>,#<const> cond:4|0|0|1|1|1|1|1|S|(0)|(0)|(0)|(0)|Rd:4|imm12:12
-	{0x0fef0090, 0x01e00010, 4, MVN_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_shift_R}},                            // MVN{S}<c> <Rd>,<Rm>,<type> <Rs> cond:4|0|0|0|1|1|1|1|S|(0)|(0)|(0)|(0)|Rd:4|Rs:4|0|type:2|1|Rm:4
-	{0x0fe00090, 0x01e00010, 3, MVN_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_shift_R}},                            // MVN{S}<c> <Rd>,<Rm>,<type> <Rs> cond:4|0|0|0|1|1|1|1|S|(0)|(0)|(0)|(0)|Rd:4|Rs:4|0|type:2|1|Rm:4
-	{0x0fef0010, 0x01e00000, 2, MVN_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_shift_imm}},                          // MVN{S}<c> <Rd>,<Rm>{,<shift>} cond:4|0|0|0|1|1|1|1|S|(0)|(0)|(0)|(0)|Rd:4|imm5:5|type:2|0|Rm:4
-	{0x0fe00010, 0x01e00000, 1, MVN_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_shift_imm}},                          // MVN{S}<c> <Rd>,<Rm>{,<shift>} cond:4|0|0|0|1|1|1|1|S|(0)|(0)|(0)|(0)|Rd:4|imm5:5|type:2|0|Rm:4
-	{0x0fffffff, 0x0320f000, 4, NOP_EQ, 0x1c04, instArgs{}},                                                       // NOP<c> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|0|0|0
-	{0x0fff00ff, 0x0320f000, 3, NOP_EQ, 0x1c04, instArgs{}},                                                       // NOP<c> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|0|0|0
-	{0x0fe00000, 0x03800000, 2, ORR_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_const}},                      // ORR{S}<c> <Rd>,<Rn>,#<const> cond:4|0|0|1|1|1|0|0|S|Rn:4|Rd:4|imm12:12
-	{0x0fe00090, 0x01800010, 4, ORR_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_shift_R}},                  // ORR{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|1|1|0|0|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4
-	{0x0fe00010, 0x01800000, 2, ORR_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},                // ORR{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} cond:4|0|0|0|1|1|0|0|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4
-	{0x0ff00030, 0x06800010, 4, PKHBT_EQ, 0x6011c04, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},               // PKH<BT,TB><c> <Rd>,<Rn>,<Rm>{,LSL #<imm5>} cond:4|0|1|1|0|1|0|0|0|Rn:4|Rd:4|imm5:5|tb|0|1|Rm:4
-	{0xff7ff000, 0xf55ff000, 4, PLD, 0x0, instArgs{arg_label_pm_12}},                                              // PLD <label+/-12> 1|1|1|1|0|1|0|1|U|(1)|0|1|1|1|1|1|(1)|(1)|(1)|(1)|imm12:12
-	{0xff3f0000, 0xf55ff000, 3, PLD, 0x0, instArgs{arg_label_pm_12}},                                              // PLD <label+/-12> 1|1|1|1|0|1|0|1|U|(1)|0|1|1|1|1|1|(1)|(1)|(1)|(1)|imm12:12
-	{0xff30f000, 0xf510f000, 2, PLD_W, 0x1601, instArgs{arg_mem_R_pm_imm12_offset}},                               // PLD{W} [<Rn>,#+/-<imm12>] 1|1|1|1|0|1|0|1|U|R|0|1|Rn:4|(1)|(1)|(1)|(1)|imm12:12
-	{0xff300000, 0xf510f000, 1, PLD_W, 0x1601, instArgs{arg_mem_R_pm_imm12_offset}},                               // PLD{W} [<Rn>,#+/-<imm12>] 1|1|1|1|0|1|0|1|U|R|0|1|Rn:4|(1)|(1)|(1)|(1)|imm12:12
-	{0xff30f010, 0xf710f000, 4, PLD_W, 0x1601, instArgs{arg_mem_R_pm_R_shift_imm_offset}},                         // PLD{W} [<Rn>,+/-<Rm>{, <shift>}] 1|1|1|1|0|1|1|1|U|R|0|1|Rn:4|(1)|(1)|(1)|(1)|imm5:5|type:2|0|Rm:4
-	{0xff300010, 0xf710f000, 3, PLD_W, 0x1601, instArgs{arg_mem_R_pm_R_shift_imm_offset}},                         // PLD{W} [<Rn>,+/-<Rm>{, <shift>}] 1|1|1|1|0|1|1|1|U|R|0|1|Rn:4|(1)|(1)|(1)|(1)|imm5:5|type:2|0|Rm:4
-	{0xff70f000, 0xf450f000, 4, PLI, 0x0, instArgs{arg_mem_R_pm_imm12_offset}},                                    // PLI [<Rn>,#+/-<imm12>] 1|1|1|1|0|1|0|0|U|1|0|1|Rn:4|(1)|(1)|(1)|(1)|imm12:12
-	{0xff700000, 0xf450f000, 3, PLI, 0x0, instArgs{arg_mem_R_pm_imm12_offset}},                                    // PLI [<Rn>,#+/-<imm12>] 1|1|1|1|0|1|0|0|U|1|0|1|Rn:4|(1)|(1)|(1)|(1)|imm12:12
-	{0xff70f010, 0xf650f000, 4, PLI, 0x0, instArgs{arg_mem_R_pm_R_shift_imm_offset}},                              // PLI [<Rn>,+/-<Rm>{, <shift>}] 1|1|1|1|0|1|1|0|U|1|0|1|Rn:4|(1)|(1)|(1)|(1)|imm5:5|type:2|0|Rm:4
-	{0xff700010, 0xf650f000, 3, PLI, 0x0, instArgs{arg_mem_R_pm_R_shift_imm_offset}},                              // PLI [<Rn>,+/-<Rm>{, <shift>}] 1|1|1|1|0|1|1|0|U|1|0|1|Rn:4|(1)|(1)|(1)|(1)|imm5:5|type:2|0|Rm:4
-	{0x0fff0000, 0x08bd0000, 4, POP_EQ, 0x1c04, instArgs{arg_registers2}},                                         // POP<c> <registers2> cond:4|1|0|0|0|1|0|1|1|1|1|0|1|register_list:16
-	{0x0fff0fff, 0x049d0004, 4, POP_EQ, 0x1c04, instArgs{arg_registers1}},                                         // POP<c> <registers1> cond:4|0|1|0|0|1|0|0|1|1|1|0|1|Rt:4|0|0|0|0|0|0|0|0|0|1|0|0
-	{0x0fff0000, 0x092d0000, 4, PUSH_EQ, 0x1c04, instArgs{arg_registers2}},                                        // PUSH<c> <registers2> cond:4|1|0|0|1|0|0|1|0|1|1|0|1|register_list:16
-	{0x0fff0fff, 0x052d0004, 4, PUSH_EQ, 0x1c04, instArgs{arg_registers1}},                                        // PUSH<c> <registers1> cond:4|0|1|0|1|0|0|1|0|1|1|0|1|Rt:4|0|0|0|0|0|0|0|0|0|1|0|0
-	{0x0ff00ff0, 0x06200f10, 4, QADD16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // QADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
-	{0x0ff000f0, 0x06200f10, 3, QADD16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // QADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
-	{0x0ff00ff0, 0x06200f90, 4, QADD8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // QADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
-	{0x0ff000f0, 0x06200f90, 3, QADD8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // QADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
-	{0x0ff00ff0, 0x01000050, 4, QADD_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0, arg_R_16}},                           // QADD<c> <Rd>,<Rm>,<Rn> cond:4|0|0|0|1|0|0|0|0|Rn:4|Rd:4|(0)|(0)|(0)|(0)|0|1|0|1|Rm:4
-	{0x0ff000f0, 0x01000050, 3, QADD_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0, arg_R_16}},                           // QADD<c> <Rd>,<Rm>,<Rn> cond:4|0|0|0|1|0|0|0|0|Rn:4|Rd:4|(0)|(0)|(0)|(0)|0|1|0|1|Rm:4
-	{0x0ff00ff0, 0x06200f30, 4, QASX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // QASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
-	{0x0ff000f0, 0x06200f30, 3, QASX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // QASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
-	{0x0ff00ff0, 0x01400050, 4, QDADD_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0, arg_R_16}},                          // QDADD<c> <Rd>,<Rm>,<Rn> cond:4|0|0|0|1|0|1|0|0|Rn:4|Rd:4|(0)|(0)|(0)|(0)|0|1|0|1|Rm:4
-	{0x0ff000f0, 0x01400050, 3, QDADD_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0, arg_R_16}},                          // QDADD<c> <Rd>,<Rm>,<Rn> cond:4|0|0|0|1|0|1|0|0|Rn:4|Rd:4|(0)|(0)|(0)|(0)|0|1|0|1|Rm:4
-	{0x0ff00ff0, 0x01600050, 4, QDSUB_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0, arg_R_16}},                          // QDSUB<c> <Rd>,<Rm>,<Rn> cond:4|0|0|0|1|0|1|1|0|Rn:4|Rd:4|0|0|0|0|0|1|0|1|Rm:4
-	{0x0ff00ff0, 0x06200f50, 4, QSAX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // QSAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
-	{0x0ff000f0, 0x06200f50, 3, QSAX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // QSAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
-	{0x0ff00ff0, 0x06200f70, 4, QSUB16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // QSUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
-	{0x0ff000f0, 0x06200f70, 3, QSUB16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // QSUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
-	{0x0ff00ff0, 0x06200ff0, 4, QSUB8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // QSUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
-	{0x0ff000f0, 0x06200ff0, 3, QSUB8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // QSUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
-	{0x0ff00ff0, 0x01200050, 4, QSUB_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0, arg_R_16}},                           // QSUB<c> <Rd>,<Rm>,<Rn> cond:4|0|0|0|1|0|0|1|0|Rn:4|Rd:4|0|0|0|0|0|1|0|1|Rm:4
-	{0x0fff0ff0, 0x06ff0f30, 4, RBIT_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0}},                                     // RBIT<c> <Rd>,<Rm> cond:4|0|1|1|0|1|1|1|1|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
-	{0x0ff000f0, 0x06ff0f30, 3, RBIT_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0}},                                     // RBIT<c> <Rd>,<Rm> cond:4|0|1|1|0|1|1|1|1|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
-	{0x0fff0ff0, 0x06bf0fb0, 4, REV16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0}},                                    // REV16<c> <Rd>,<Rm> cond:4|0|1|1|0|1|0|1|1|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|1|0|1|1|Rm:4
-	{0x0ff000f0, 0x06bf0fb0, 3, REV16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0}},                                    // REV16<c> <Rd>,<Rm> cond:4|0|1|1|0|1|0|1|1|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|1|0|1|1|Rm:4
-	{0x0fff0ff0, 0x06bf0f30, 4, REV_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0}},                                      // REV<c> <Rd>,<Rm> cond:4|0|1|1|0|1|0|1|1|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
-	{0x0ff000f0, 0x06bf0f30, 3, REV_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0}},                                      // REV<c> <Rd>,<Rm> cond:4|0|1|1|0|1|0|1|1|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
-	{0x0fff0ff0, 0x06ff0fb0, 4, REVSH_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0}},                                    // REVSH<c> <Rd>,<Rm> cond:4|0|1|1|0|1|1|1|1|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|1|0|1|1|Rm:4
-	{0x0ff000f0, 0x06ff0fb0, 3, REVSH_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0}},                                    // REVSH<c> <Rd>,<Rm> cond:4|0|1|1|0|1|1|1|1|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|1|0|1|1|Rm:4
-	{0x0fef0070, 0x01a00060, 2, ROR_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_0, arg_imm5}},                        // ROR{S}<c> <Rd>,<Rm>,#<imm5> cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|imm5:5|1|1|0|Rm:4
-	{0x0fef00f0, 0x01a00070, 4, ROR_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_0, arg_R_8}},                         // ROR{S}<c> <Rd>,<Rn>,<Rm> cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|Rm:4|0|1|1|1|Rn:4
-	{0x0fef0ff0, 0x01a00060, 4, RRX_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_0}},                                  // RRX{S}<c> <Rd>,<Rm> cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|0|0|0|0|0|1|1|0|Rm:4
-	{0x0fe00000, 0x02600000, 2, RSB_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_const}},                      // RSB{S}<c> <Rd>,<Rn>,#<const> cond:4|0|0|1|0|0|1|1|S|Rn:4|Rd:4|imm12:12
-	{0x0fe00090, 0x00600010, 4, RSB_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_shift_R}},                  // RSB{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|0|0|1|1|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4
-	{0x0fe00010, 0x00600000, 2, RSB_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},                // RSB{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} cond:4|0|0|0|0|0|1|1|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4
-	{0x0fe00000, 0x02e00000, 2, RSC_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_const}},                      // RSC{S}<c> <Rd>,<Rn>,#<const> cond:4|0|0|1|0|1|1|1|S|Rn:4|Rd:4|imm12:12
-	{0x0fe00090, 0x00e00010, 4, RSC_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_shift_R}},                  // RSC{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|0|1|1|1|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4
-	{0x0fe00010, 0x00e00000, 2, RSC_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},                // RSC{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} cond:4|0|0|0|0|1|1|1|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4
-	{0x0ff00ff0, 0x06100f10, 4, SADD16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // SADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
-	{0x0ff000f0, 0x06100f10, 3, SADD16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // SADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
-	{0x0ff00ff0, 0x06100f90, 4, SADD8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // SADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
-	{0x0ff000f0, 0x06100f90, 3, SADD8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // SADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
-	{0x0ff00ff0, 0x06100f30, 4, SASX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // SASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
-	{0x0ff000f0, 0x06100f30, 3, SASX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // SASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
-	{0x0fe00000, 0x02c00000, 2, SBC_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_const}},                      // SBC{S}<c> <Rd>,<Rn>,#<const> cond:4|0|0|1|0|1|1|0|S|Rn:4|Rd:4|imm12:12
-	{0x0fe00090, 0x00c00010, 4, SBC_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_shift_R}},                  // SBC{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|0|1|1|0|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4
-	{0x0fe00010, 0x00c00000, 2, SBC_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},                // SBC{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} cond:4|0|0|0|0|1|1|0|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4
-	{0x0fe00070, 0x07a00050, 4, SBFX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0, arg_imm5, arg_widthm1}},              // SBFX<c> <Rd>,<Rn>,#<lsb>,#<widthm1> cond:4|0|1|1|1|1|0|1|widthm1:5|Rd:4|lsb:5|1|0|1|Rn:4
-	{0x0ff00ff0, 0x06800fb0, 4, SEL_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                            // SEL<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|1|0|0|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|1|1|Rm:4
-	{0x0ff000f0, 0x06800fb0, 3, SEL_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                            // SEL<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|1|0|0|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|1|1|Rm:4
-	{0xfffffdff, 0xf1010000, 4, SETEND, 0x0, instArgs{arg_endian}},                                                // SETEND <endian_specifier> 1|1|1|1|0|0|0|1|0|0|0|0|0|0|0|1|0|0|0|0|0|0|E|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)
-	{0xfffffc00, 0xf1010000, 3, SETEND, 0x0, instArgs{arg_endian}},                                                // SETEND <endian_specifier> 1|1|1|1|0|0|0|1|0|0|0|0|0|0|0|1|0|0|0|0|0|0|E|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)
-	{0x0fffffff, 0x0320f004, 4, SEV_EQ, 0x1c04, instArgs{}},                                                       // SEV<c> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|1|0|0
-	{0x0fff00ff, 0x0320f004, 3, SEV_EQ, 0x1c04, instArgs{}},                                                       // SEV<c> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|1|0|0
-	{0x0ff00ff0, 0x06300f10, 4, SHADD16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // SHADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
-	{0x0ff000f0, 0x06300f10, 3, SHADD16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // SHADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
-	{0x0ff00ff0, 0x06300f90, 4, SHADD8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // SHADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
-	{0x0ff000f0, 0x06300f90, 3, SHADD8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // SHADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
-	{0x0ff00ff0, 0x06300f30, 4, SHASX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // SHASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
-	{0x0ff000f0, 0x06300f30, 3, SHASX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // SHASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
-	{0x0ff00ff0, 0x06300f50, 4, SHSAX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // SHSAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
-	{0x0ff000f0, 0x06300f50, 3, SHSAX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // SHSAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
-	{0x0ff00ff0, 0x06300f70, 4, SHSUB16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // SHSUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
-	{0x0ff000f0, 0x06300f70, 3, SHSUB16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // SHSUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
-	{0x0ff00ff0, 0x06300ff0, 4, SHSUB8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // SHSUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
-	{0x0ff000f0, 0x06300ff0, 3, SHSUB8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // SHSUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
-	{0x0ff00090, 0x01000080, 4, SMLABB_EQ, 0x50106011c04, instArgs{arg_R_16, arg_R_0, arg_R_8, arg_R_12}},         // SMLA<x><y><c> <Rd>,<Rn>,<Rm>,<Ra> cond:4|0|0|0|1|0|0|0|0|Rd:4|Ra:4|Rm:4|1|M|N|0|Rn:4
-	{0x0ff000d0, 0x07000010, 2, SMLAD_EQ, 0x5011c04, instArgs{arg_R_16, arg_R_0, arg_R_8, arg_R_12}},              // SMLAD{X}<c> <Rd>,<Rn>,<Rm>,<Ra> cond:4|0|1|1|1|0|0|0|0|Rd:4|Ra:4|Rm:4|0|0|M|1|Rn:4
-	{0x0ff00090, 0x01400080, 4, SMLALBB_EQ, 0x50106011c04, instArgs{arg_R_12, arg_R_16, arg_R_0, arg_R_8}},        // SMLAL<x><y><c> <RdLo>,<RdHi>,<Rn>,<Rm> cond:4|0|0|0|1|0|1|0|0|RdHi:4|RdLo:4|Rm:4|1|M|N|0|Rn:4
-	{0x0ff000d0, 0x07400010, 4, SMLALD_EQ, 0x5011c04, instArgs{arg_R_12, arg_R_16, arg_R_0, arg_R_8}},             // SMLALD{X}<c> <RdLo>,<RdHi>,<Rn>,<Rm> cond:4|0|1|1|1|0|1|0|0|RdHi:4|RdLo:4|Rm:4|0|0|M|1|Rn:4
-	{0x0fe000f0, 0x00e00090, 4, SMLAL_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_0, arg_R_8}},             // SMLAL{S}<c> <RdLo>,<RdHi>,<Rn>,<Rm> cond:4|0|0|0|0|1|1|1|S|RdHi:4|RdLo:4|Rm:4|1|0|0|1|Rn:4
-	{0x0ff000b0, 0x01200080, 4, SMLAWB_EQ, 0x6011c04, instArgs{arg_R_16, arg_R_0, arg_R_8, arg_R_12}},             // SMLAW<y><c> <Rd>,<Rn>,<Rm>,<Ra> cond:4|0|0|0|1|0|0|1|0|Rd:4|Ra:4|Rm:4|1|M|0|0|Rn:4
-	{0x0ff000d0, 0x07000050, 2, SMLSD_EQ, 0x5011c04, instArgs{arg_R_16, arg_R_0, arg_R_8, arg_R_12}},              // SMLSD{X}<c> <Rd>,<Rn>,<Rm>,<Ra> cond:4|0|1|1|1|0|0|0|0|Rd:4|Ra:4|Rm:4|0|1|M|1|Rn:4
-	{0x0ff000d0, 0x07400050, 4, SMLSLD_EQ, 0x5011c04, instArgs{arg_R_12, arg_R_16, arg_R_0, arg_R_8}},             // SMLSLD{X}<c> <RdLo>,<RdHi>,<Rn>,<Rm> cond:4|0|1|1|1|0|1|0|0|RdHi:4|RdLo:4|Rm:4|0|1|M|1|Rn:4
-	{0x0ff000d0, 0x07500010, 2, SMMLA_EQ, 0x5011c04, instArgs{arg_R_16, arg_R_0, arg_R_8, arg_R_12}},              // SMMLA{R}<c> <Rd>,<Rn>,<Rm>,<Ra> cond:4|0|1|1|1|0|1|0|1|Rd:4|Ra:4|Rm:4|0|0|R|1|Rn:4
-	{0x0ff000d0, 0x075000d0, 4, SMMLS_EQ, 0x5011c04, instArgs{arg_R_16, arg_R_0, arg_R_8, arg_R_12}},              // SMMLS{R}<c> <Rd>,<Rn>,<Rm>,<Ra> cond:4|0|1|1|1|0|1|0|1|Rd:4|Ra:4|Rm:4|1|1|R|1|Rn:4
-	{0x0ff0f0d0, 0x0750f010, 4, SMMUL_EQ, 0x5011c04, instArgs{arg_R_16, arg_R_0, arg_R_8}},                        // SMMUL{R}<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|1|0|1|0|1|Rd:4|1|1|1|1|Rm:4|0|0|R|1|Rn:4
-	{0x0ff0f0d0, 0x0700f010, 4, SMUAD_EQ, 0x5011c04, instArgs{arg_R_16, arg_R_0, arg_R_8}},                        // SMUAD{X}<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|1|0|0|0|0|Rd:4|1|1|1|1|Rm:4|0|0|M|1|Rn:4
-	{0x0ff0f090, 0x01600080, 4, SMULBB_EQ, 0x50106011c04, instArgs{arg_R_16, arg_R_0, arg_R_8}},                   // SMUL<x><y><c> <Rd>,<Rn>,<Rm> cond:4|0|0|0|1|0|1|1|0|Rd:4|0|0|0|0|Rm:4|1|M|N|0|Rn:4
-	{0x0fe000f0, 0x00c00090, 4, SMULL_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_0, arg_R_8}},             // SMULL{S}<c> <RdLo>,<RdHi>,<Rn>,<Rm> cond:4|0|0|0|0|1|1|0|S|RdHi:4|RdLo:4|Rm:4|1|0|0|1|Rn:4
-	{0x0ff0f0b0, 0x012000a0, 4, SMULWB_EQ, 0x6011c04, instArgs{arg_R_16, arg_R_0, arg_R_8}},                       // SMULW<y><c> <Rd>,<Rn>,<Rm> cond:4|0|0|0|1|0|0|1|0|Rd:4|0|0|0|0|Rm:4|1|M|1|0|Rn:4
-	{0x0ff0f0d0, 0x0700f050, 4, SMUSD_EQ, 0x5011c04, instArgs{arg_R_16, arg_R_0, arg_R_8}},                        // SMUSD{X}<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|1|0|0|0|0|Rd:4|1|1|1|1|Rm:4|0|1|M|1|Rn:4
-	{0x0ff00ff0, 0x06a00f30, 4, SSAT16_EQ, 0x1c04, instArgs{arg_R_12, arg_satimm4m1, arg_R_0}},                    // SSAT16<c> <Rd>,#<sat_imm4m1>,<Rn> cond:4|0|1|1|0|1|0|1|0|sat_imm:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rn:4
-	{0x0ff000f0, 0x06a00f30, 3, SSAT16_EQ, 0x1c04, instArgs{arg_R_12, arg_satimm4m1, arg_R_0}},                    // SSAT16<c> <Rd>,#<sat_imm4m1>,<Rn> cond:4|0|1|1|0|1|0|1|0|sat_imm:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rn:4
-	{0x0fe00030, 0x06a00010, 4, SSAT_EQ, 0x1c04, instArgs{arg_R_12, arg_satimm5m1, arg_R_shift_imm}},              // SSAT<c> <Rd>,#<sat_imm5m1>,<Rn>{,<shift>} cond:4|0|1|1|0|1|0|1|sat_imm:5|Rd:4|imm5:5|sh|0|1|Rn:4
-	{0x0ff00ff0, 0x06100f50, 4, SSAX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // SSAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
-	{0x0ff000f0, 0x06100f50, 3, SSAX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // SSAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
-	{0x0ff00ff0, 0x06100f70, 4, SSUB16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // SSUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
-	{0x0ff000f0, 0x06100f70, 3, SSUB16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // SSUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
-	{0x0ff00ff0, 0x06100ff0, 4, SSUB8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // SSUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
-	{0x0ff000f0, 0x06100ff0, 3, SSUB8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // SSUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
-	{0x0fd00000, 0x08800000, 4, STM_EQ, 0x1c04, instArgs{arg_R_16_WB, arg_registers}},                             // STM<c> <Rn>{!},<registers> cond:4|1|0|0|0|1|0|W|0|Rn:4|register_list:16
-	{0x0fd00000, 0x08000000, 4, STMDA_EQ, 0x1c04, instArgs{arg_R_16_WB, arg_registers}},                           // STMDA<c> <Rn>{!},<registers> cond:4|1|0|0|0|0|0|W|0|Rn:4|register_list:16
-	{0x0fd00000, 0x09000000, 2, STMDB_EQ, 0x1c04, instArgs{arg_R_16_WB, arg_registers}},                           // STMDB<c> <Rn>{!},<registers> cond:4|1|0|0|1|0|0|W|0|Rn:4|register_list:16
-	{0x0fd00000, 0x09800000, 4, STMIB_EQ, 0x1c04, instArgs{arg_R_16_WB, arg_registers}},                           // STMIB<c> <Rn>{!},<registers> cond:4|1|0|0|1|1|0|W|0|Rn:4|register_list:16
-	{0x0e500018, 0x06000000, 2, STR_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_R_shift_imm_W}},                   // STR<c> <Rt>,[<Rn>,+/-<Rm>{, <shift>}]{!} cond:4|0|1|1|P|U|0|W|0|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4
-	{0x0e500000, 0x04000000, 2, STR_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_imm12_W}},                         // STR<c> <Rt>,[<Rn>{,#+/-<imm12>}]{!} cond:4|0|1|0|P|U|0|W|0|Rn:4|Rt:4|imm12:12
-	{0x0e500010, 0x06400000, 2, STRB_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_R_shift_imm_W}},                  // STRB<c> <Rt>,[<Rn>,+/-<Rm>{, <shift>}]{!} cond:4|0|1|1|P|U|1|W|0|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4
-	{0x0e500000, 0x04400000, 2, STRB_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_imm12_W}},                        // STRB<c> <Rt>,[<Rn>{,#+/-<imm12>}]{!} cond:4|0|1|0|P|U|1|W|0|Rn:4|Rt:4|imm12:12
-	{0x0f700000, 0x04600000, 4, STRBT_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_imm12_postindex}},               // STRBT<c> <Rt>,[<Rn>],#+/-<imm12> cond:4|0|1|0|0|U|1|1|0|Rn:4|Rt:4|imm12:12
-	{0x0f700010, 0x06600000, 4, STRBT_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_R_shift_imm_postindex}},         // STRBT<c> <Rt>,[<Rn>],+/-<Rm>{, <shift>} cond:4|0|1|1|0|U|1|1|0|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4
-	{0x0e500ff0, 0x000000f0, 4, STRD_EQ, 0x1c04, instArgs{arg_R1_12, arg_R2_12, arg_mem_R_pm_R_W}},                // STRD<c> <Rt1>,<Rt2>,[<Rn>,+/-<Rm>]{!} cond:4|0|0|0|P|U|0|W|0|Rn:4|Rt:4|(0)|(0)|(0)|(0)|1|1|1|1|Rm:4
-	{0x0e5000f0, 0x000000f0, 3, STRD_EQ, 0x1c04, instArgs{arg_R1_12, arg_R2_12, arg_mem_R_pm_R_W}},                // STRD<c> <Rt1>,<Rt2>,[<Rn>,+/-<Rm>]{!} cond:4|0|0|0|P|U|0|W|0|Rn:4|Rt:4|(0)|(0)|(0)|(0)|1|1|1|1|Rm:4
-	{0x0e5000f0, 0x004000f0, 4, STRD_EQ, 0x1c04, instArgs{arg_R1_12, arg_R2_12, arg_mem_R_pm_imm8_W}},             // STRD<c> <Rt1>,<Rt2>,[<Rn>{,#+/-<imm8>}]{!} cond:4|0|0|0|P|U|1|W|0|Rn:4|Rt:4|imm4H:4|1|1|1|1|imm4L:4
-	{0x0ff00ff0, 0x01800f90, 4, STREX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0, arg_mem_R}},                         // STREX<c> <Rd>,<Rt>,[<Rn>] cond:4|0|0|0|1|1|0|0|0|Rn:4|Rd:4|1|1|1|1|1|0|0|1|Rt:4
-	{0x0ff00ff0, 0x01c00f90, 4, STREXB_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0, arg_mem_R}},                        // STREXB<c> <Rd>,<Rt>,[<Rn>] cond:4|0|0|0|1|1|1|0|0|Rn:4|Rd:4|1|1|1|1|1|0|0|1|Rt:4
-	{0x0ff00ff0, 0x01a00f90, 4, STREXD_EQ, 0x1c04, instArgs{arg_R_12, arg_R1_0, arg_R2_0, arg_mem_R}},             // STREXD<c> <Rd>,<Rt1>,<Rt2>,[<Rn>] cond:4|0|0|0|1|1|0|1|0|Rn:4|Rd:4|1|1|1|1|1|0|0|1|Rt:4
-	{0x0ff00ff0, 0x01e00f90, 4, STREXH_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0, arg_mem_R}},                        // STREXH<c> <Rd>,<Rt>,[<Rn>] cond:4|0|0|0|1|1|1|1|0|Rn:4|Rd:4|1|1|1|1|1|0|0|1|Rt:4
-	{0x0e500ff0, 0x000000b0, 2, STRH_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_R_W}},                            // STRH<c> <Rt>,[<Rn>,+/-<Rm>]{!} cond:4|0|0|0|P|U|0|W|0|Rn:4|Rt:4|0|0|0|0|1|0|1|1|Rm:4
-	{0x0e5000f0, 0x004000b0, 2, STRH_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_imm8_W}},                         // STRH<c> <Rt>,[<Rn>{,#+/-<imm8>}]{!} cond:4|0|0|0|P|U|1|W|0|Rn:4|Rt:4|imm4H:4|1|0|1|1|imm4L:4
-	{0x0f7000f0, 0x006000b0, 4, STRHT_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_imm8_postindex}},                // STRHT<c> <Rt>, [<Rn>] {,#+/-<imm8>} cond:4|0|0|0|0|U|1|1|0|Rn:4|Rt:4|imm4H:4|1|0|1|1|imm4L:4
-	{0x0f700ff0, 0x002000b0, 4, STRHT_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_R_postindex}},                   // STRHT<c> <Rt>, [<Rn>], +/-<Rm> cond:4|0|0|0|0|U|0|1|0|Rn:4|Rt:4|0|0|0|0|1|0|1|1|Rm:4
-	{0x0f700000, 0x04200000, 4, STRT_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_imm12_postindex}},                // STRT<c> <Rt>, [<Rn>] {,#+/-<imm12>} cond:4|0|1|0|0|U|0|1|0|Rn:4|Rt:4|imm12:12
-	{0x0f700010, 0x06200000, 4, STRT_EQ, 0x1c04, instArgs{arg_R_12, arg_mem_R_pm_R_shift_imm_postindex}},          // STRT<c> <Rt>,[<Rn>],+/-<Rm>{, <shift>} cond:4|0|1|1|0|U|0|1|0|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4
-	{0x0fe00000, 0x02400000, 2, SUB_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_const}},                      // SUB{S}<c> <Rd>,<Rn>,#<const> cond:4|0|0|1|0|0|1|0|S|Rn:4|Rd:4|imm12:12
-	{0x0fe00090, 0x00400010, 4, SUB_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_shift_R}},                  // SUB{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|0|0|1|0|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4
-	{0x0fe00010, 0x00400000, 2, SUB_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},                // SUB{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} cond:4|0|0|0|0|0|1|0|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4
-	{0x0fef0000, 0x024d0000, 2, SUB_EQ, 0x14011c04, instArgs{arg_R_12, arg_SP, arg_const}},                        // SUB{S}<c> <Rd>,SP,#<const> cond:4|0|0|1|0|0|1|0|S|1|1|0|1|Rd:4|imm12:12
-	{0x0fef0010, 0x004d0000, 2, SUB_EQ, 0x14011c04, instArgs{arg_R_12, arg_SP, arg_R_shift_imm}},                  // SUB{S}<c> <Rd>,SP,<Rm>{,<shift>} cond:4|0|0|0|0|0|1|0|S|1|1|0|1|Rd:4|imm5:5|type:2|0|Rm:4
-	{0x0f000000, 0x0f000000, 4, SVC_EQ, 0x1c04, instArgs{arg_imm24}},                                              // SVC<c> #<imm24> cond:4|1|1|1|1|imm24:24
-	{0x0fb00ff0, 0x01000090, 4, SWP_EQ, 0x16011c04, instArgs{arg_R_12, arg_R_0, arg_mem_R}},                       // SWP{B}<c> <Rt>,<Rm>,[<Rn>] cond:4|0|0|0|1|0|B|0|0|Rn:4|Rt:4|0|0|0|0|1|0|0|1|Rm:4
-	{0x0ff003f0, 0x06800070, 2, SXTAB16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_rotate}},                   // SXTAB16<c> <Rd>,<Rn>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|0|0|0|Rn:4|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
-	{0x0ff003f0, 0x06a00070, 2, SXTAB_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_rotate}},                     // SXTAB<c> <Rd>,<Rn>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|0|1|0|Rn:4|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
-	{0x0ff003f0, 0x06b00070, 2, SXTAH_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_rotate}},                     // SXTAH<c> <Rd>,<Rn>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|0|1|1|Rn:4|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
-	{0x0fff03f0, 0x068f0070, 4, SXTB16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_rotate}},                              // SXTB16<c> <Rd>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|0|0|0|1|1|1|1|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
-	{0x0fff03f0, 0x06af0070, 4, SXTB_EQ, 0x1c04, instArgs{arg_R_12, arg_R_rotate}},                                // SXTB<c> <Rd>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|0|1|0|1|1|1|1|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
-	{0x0fff03f0, 0x06bf0070, 4, SXTH_EQ, 0x1c04, instArgs{arg_R_12, arg_R_rotate}},                                // SXTH<c> <Rd>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|0|1|1|1|1|1|1|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
-	{0x0ff0f000, 0x03300000, 4, TEQ_EQ, 0x1c04, instArgs{arg_R_16, arg_const}},                                    // TEQ<c> <Rn>,#<const> cond:4|0|0|1|1|0|0|1|1|Rn:4|(0)|(0)|(0)|(0)|imm12:12
-	{0x0ff00000, 0x03300000, 3, TEQ_EQ, 0x1c04, instArgs{arg_R_16, arg_const}},                                    // TEQ<c> <Rn>,#<const> cond:4|0|0|1|1|0|0|1|1|Rn:4|(0)|(0)|(0)|(0)|imm12:12
-	{0x0ff0f090, 0x01300010, 4, TEQ_EQ, 0x1c04, instArgs{arg_R_16, arg_R_shift_R}},                                // TEQ<c> <Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|1|0|0|1|1|Rn:4|(0)|(0)|(0)|(0)|Rs:4|0|type:2|1|Rm:4
-	{0x0ff00090, 0x01300010, 3, TEQ_EQ, 0x1c04, instArgs{arg_R_16, arg_R_shift_R}},                                // TEQ<c> <Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|1|0|0|1|1|Rn:4|(0)|(0)|(0)|(0)|Rs:4|0|type:2|1|Rm:4
-	{0x0ff0f010, 0x01300000, 4, TEQ_EQ, 0x1c04, instArgs{arg_R_16, arg_R_shift_imm}},                              // TEQ<c> <Rn>,<Rm>{,<shift>} cond:4|0|0|0|1|0|0|1|1|Rn:4|(0)|(0)|(0)|(0)|imm5:5|type:2|0|Rm:4
-	{0x0ff00010, 0x01300000, 3, TEQ_EQ, 0x1c04, instArgs{arg_R_16, arg_R_shift_imm}},                              // TEQ<c> <Rn>,<Rm>{,<shift>} cond:4|0|0|0|1|0|0|1|1|Rn:4|(0)|(0)|(0)|(0)|imm5:5|type:2|0|Rm:4
-	{0x0ff0f000, 0x03100000, 4, TST_EQ, 0x1c04, instArgs{arg_R_16, arg_const}},                                    // TST<c> <Rn>,#<const> cond:4|0|0|1|1|0|0|0|1|Rn:4|(0)|(0)|(0)|(0)|imm12:12
-	{0x0ff00000, 0x03100000, 3, TST_EQ, 0x1c04, instArgs{arg_R_16, arg_const}},                                    // TST<c> <Rn>,#<const> cond:4|0|0|1|1|0|0|0|1|Rn:4|(0)|(0)|(0)|(0)|imm12:12
-	{0x0ff0f090, 0x01100010, 4, TST_EQ, 0x1c04, instArgs{arg_R_16, arg_R_shift_R}},                                // TST<c> <Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|1|0|0|0|1|Rn:4|(0)|(0)|(0)|(0)|Rs:4|0|type:2|1|Rm:4
-	{0x0ff00090, 0x01100010, 3, TST_EQ, 0x1c04, instArgs{arg_R_16, arg_R_shift_R}},                                // TST<c> <Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|1|0|0|0|1|Rn:4|(0)|(0)|(0)|(0)|Rs:4|0|type:2|1|Rm:4
-	{0x0ff0f010, 0x01100000, 4, TST_EQ, 0x1c04, instArgs{arg_R_16, arg_R_shift_imm}},                              // TST<c> <Rn>,<Rm>{,<shift>} cond:4|0|0|0|1|0|0|0|1|Rn:4|(0)|(0)|(0)|(0)|imm5:5|type:2|0|Rm:4
-	{0x0ff00010, 0x01100000, 3, TST_EQ, 0x1c04, instArgs{arg_R_16, arg_R_shift_imm}},                              // TST<c> <Rn>,<Rm>{,<shift>} cond:4|0|0|0|1|0|0|0|1|Rn:4|(0)|(0)|(0)|(0)|imm5:5|type:2|0|Rm:4
-	{0x0ff00ff0, 0x06500f10, 4, UADD16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // UADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
-	{0x0ff000f0, 0x06500f10, 3, UADD16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // UADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
-	{0x0ff00ff0, 0x06500f90, 4, UADD8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // UADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
-	{0x0ff000f0, 0x06500f90, 3, UADD8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // UADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
-	{0x0ff00ff0, 0x06500f30, 4, UASX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // UASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
-	{0x0ff000f0, 0x06500f30, 3, UASX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // UASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
-	{0x0fe00070, 0x07e00050, 4, UBFX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_0, arg_imm5, arg_widthm1}},              // UBFX<c> <Rd>,<Rn>,#<lsb>,#<widthm1> cond:4|0|1|1|1|1|1|1|widthm1:5|Rd:4|lsb:5|1|0|1|Rn:4
-	{0x0ff00ff0, 0x06700f10, 4, UHADD16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // UHADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
-	{0x0ff000f0, 0x06700f10, 3, UHADD16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // UHADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
-	{0x0ff00ff0, 0x06700f90, 4, UHADD8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // UHADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
-	{0x0ff000f0, 0x06700f90, 3, UHADD8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // UHADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
-	{0x0ff00ff0, 0x06700f30, 4, UHASX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // UHASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
-	{0x0ff000f0, 0x06700f30, 3, UHASX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // UHASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
-	{0x0ff00ff0, 0x06700f50, 4, UHSAX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // UHSAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
-	{0x0ff000f0, 0x06700f50, 3, UHSAX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // UHSAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
-	{0x0ff00ff0, 0x06700f70, 4, UHSUB16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // UHSUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
-	{0x0ff000f0, 0x06700f70, 3, UHSUB16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // UHSUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
-	{0x0ff00ff0, 0x06700ff0, 4, UHSUB8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // UHSUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
-	{0x0ff000f0, 0x06700ff0, 3, UHSUB8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // UHSUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
-	{0x0ff000f0, 0x00400090, 4, UMAAL_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0, arg_R_8}},                 // UMAAL<c> <RdLo>,<RdHi>,<Rn>,<Rm> cond:4|0|0|0|0|0|1|0|0|RdHi:4|RdLo:4|Rm:4|1|0|0|1|Rn:4
-	{0x0fe000f0, 0x00a00090, 4, UMLAL_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_0, arg_R_8}},             // UMLAL{S}<c> <RdLo>,<RdHi>,<Rn>,<Rm> cond:4|0|0|0|0|1|0|1|S|RdHi:4|RdLo:4|Rm:4|1|0|0|1|Rn:4
-	{0x0fe000f0, 0x00800090, 4, UMULL_EQ, 0x14011c04, instArgs{arg_R_12, arg_R_16, arg_R_0, arg_R_8}},             // UMULL{S}<c> <RdLo>,<RdHi>,<Rn>,<Rm> cond:4|0|0|0|0|1|0|0|S|RdHi:4|RdLo:4|Rm:4|1|0|0|1|Rn:4
-	{0x0ff00ff0, 0x06600f10, 4, UQADD16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // UQADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
-	{0x0ff000f0, 0x06600f10, 3, UQADD16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // UQADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
-	{0x0ff00ff0, 0x06600f90, 4, UQADD8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // UQADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
-	{0x0ff000f0, 0x06600f90, 3, UQADD8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // UQADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
-	{0x0ff00ff0, 0x06600f30, 4, UQASX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // UQASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
-	{0x0ff000f0, 0x06600f30, 3, UQASX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // UQASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
-	{0x0ff00ff0, 0x06600f50, 4, UQSAX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // UQSAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
-	{0x0ff000f0, 0x06600f50, 3, UQSAX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // UQSAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
-	{0x0ff00ff0, 0x06600f70, 4, UQSUB16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // UQSUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
-	{0x0ff000f0, 0x06600f70, 3, UQSUB16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // UQSUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
-	{0x0ff00ff0, 0x06600ff0, 4, UQSUB8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // UQSUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
-	{0x0ff000f0, 0x06600ff0, 3, UQSUB8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // UQSUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
-	{0x0ff0f0f0, 0x0780f010, 4, USAD8_EQ, 0x1c04, instArgs{arg_R_16, arg_R_0, arg_R_8}},                           // USAD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|1|1|0|0|0|Rd:4|1|1|1|1|Rm:4|0|0|0|1|Rn:4
-	{0x0ff000f0, 0x07800010, 2, USADA8_EQ, 0x1c04, instArgs{arg_R_16, arg_R_0, arg_R_8, arg_R_12}},                // USADA8<c> <Rd>,<Rn>,<Rm>,<Ra> cond:4|0|1|1|1|1|0|0|0|Rd:4|Ra:4|Rm:4|0|0|0|1|Rn:4
-	{0x0ff00ff0, 0x06e00f30, 4, USAT16_EQ, 0x1c04, instArgs{arg_R_12, arg_satimm4, arg_R_0}},                      // USAT16<c> <Rd>,#<sat_imm4>,<Rn> cond:4|0|1|1|0|1|1|1|0|sat_imm:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rn:4
-	{0x0ff000f0, 0x06e00f30, 3, USAT16_EQ, 0x1c04, instArgs{arg_R_12, arg_satimm4, arg_R_0}},                      // USAT16<c> <Rd>,#<sat_imm4>,<Rn> cond:4|0|1|1|0|1|1|1|0|sat_imm:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rn:4
-	{0x0fe00030, 0x06e00010, 4, USAT_EQ, 0x1c04, instArgs{arg_R_12, arg_satimm5, arg_R_shift_imm}},                // USAT<c> <Rd>,#<sat_imm5>,<Rn>{,<shift>} cond:4|0|1|1|0|1|1|1|sat_imm:5|Rd:4|imm5:5|sh|0|1|Rn:4
-	{0x0ff00ff0, 0x06500f50, 4, USAX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // USAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
-	{0x0ff000f0, 0x06500f50, 3, USAX_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // USAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
-	{0x0ff00ff0, 0x06500f70, 4, USUB16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // USUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
-	{0x0ff000f0, 0x06500f70, 3, USUB16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // USUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
-	{0x0ff00ff0, 0x06500ff0, 4, USUB8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // USUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
-	{0x0ff000f0, 0x06500ff0, 3, USUB8_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // USUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
-	{0x0ff003f0, 0x06c00070, 2, UXTAB16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_rotate}},                   // UXTAB16<c> <Rd>,<Rn>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|1|0|0|Rn:4|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
-	{0x0ff003f0, 0x06e00070, 2, UXTAB_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_rotate}},                     // UXTAB<c> <Rd>,<Rn>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|1|1|0|Rn:4|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
-	{0x0ff003f0, 0x06f00070, 2, UXTAH_EQ, 0x1c04, instArgs{arg_R_12, arg_R_16, arg_R_rotate}},                     // UXTAH<c> <Rd>,<Rn>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|1|1|1|Rn:4|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
-	{0x0fff03f0, 0x06cf0070, 4, UXTB16_EQ, 0x1c04, instArgs{arg_R_12, arg_R_rotate}},                              // UXTB16<c> <Rd>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|1|0|0|1|1|1|1|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
-	{0x0fff03f0, 0x06ef0070, 4, UXTB_EQ, 0x1c04, instArgs{arg_R_12, arg_R_rotate}},                                // UXTB<c> <Rd>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|1|1|0|1|1|1|1|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
-	{0x0fff03f0, 0x06ff0070, 4, UXTH_EQ, 0x1c04, instArgs{arg_R_12, arg_R_rotate}},                                // UXTH<c> <Rd>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|1|1|1|1|1|1|1|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
-	{0x0fb00e10, 0x0e000a00, 4, VMLA_EQ_F32, 0x60108011c04, instArgs{arg_Sd_Dd, arg_Sn_Dn, arg_Sm_Dm}},            // V<MLA,MLS><c>.F<32,64> <Sd,Dd>, <Sn,Dn>, <Sm,Dm> cond:4|1|1|1|0|0|D|0|0|Vn:4|Vd:4|1|0|1|sz|N|op|M|0|Vm:4
-	{0x0fbf0ed0, 0x0eb00ac0, 4, VABS_EQ_F32, 0x8011c04, instArgs{arg_Sd_Dd, arg_Sm_Dm}},                           // VABS<c>.F<32,64> <Sd,Dd>, <Sm,Dm> cond:4|1|1|1|0|1|D|1|1|0|0|0|0|Vd:4|1|0|1|sz|1|1|M|0|Vm:4
-	{0x0fb00e50, 0x0e300a00, 4, VADD_EQ_F32, 0x8011c04, instArgs{arg_Sd_Dd, arg_Sn_Dn, arg_Sm_Dm}},                // VADD<c>.F<32,64> <Sd,Dd>, <Sn,Dn>, <Sm,Dm> cond:4|1|1|1|0|0|D|1|1|Vn:4|Vd:4|1|0|1|sz|N|0|M|0|Vm:4
-	{0x0fbf0e7f, 0x0eb50a40, 4, VCMP_EQ_F32, 0x70108011c04, instArgs{arg_Sd_Dd, arg_fp_0}},                        // VCMP{E}<c>.F<32,64> <Sd,Dd>, #0.0 cond:4|1|1|1|0|1|D|1|1|0|1|0|1|Vd:4|1|0|1|sz|E|1|0|0|(0)|(0)|(0)|(0)
-	{0x0fbf0e70, 0x0eb50a40, 3, VCMP_EQ_F32, 0x70108011c04, instArgs{arg_Sd_Dd, arg_fp_0}},                        // VCMP{E}<c>.F<32,64> <Sd,Dd>, #0.0 cond:4|1|1|1|0|1|D|1|1|0|1|0|1|Vd:4|1|0|1|sz|E|1|0|0|(0)|(0)|(0)|(0)
-	{0x0fbf0e50, 0x0eb40a40, 4, VCMP_EQ_F32, 0x70108011c04, instArgs{arg_Sd_Dd, arg_Sm_Dm}},                       // VCMP{E}<c>.F<32,64> <Sd,Dd>, <Sm,Dm> cond:4|1|1|1|0|1|D|1|1|0|1|0|0|Vd:4|1|0|1|sz|E|1|M|0|Vm:4
-	{0x0fbe0e50, 0x0eba0a40, 4, VCVT_EQ_F32_FXS16, 0x801100107011c04, instArgs{arg_Sd_Dd, arg_Sd_Dd, arg_fbits}},  // VCVT<c>.F<32,64>.FX<S,U><16,32> <Sd,Dd>, <Sd,Dd>, #<fbits> cond:4|1|1|1|0|1|D|1|1|1|0|1|U|Vd:4|1|0|1|sz|sx|1|i|0|imm4:4
-	{0x0fbe0e50, 0x0ebe0a40, 4, VCVT_EQ_FXS16_F32, 0x1001070108011c04, instArgs{arg_Sd_Dd, arg_Sd_Dd, arg_fbits}}, // VCVT<c>.FX<S,U><16,32>.F<32,64> <Sd,Dd>, <Sd,Dd>, #<fbits> cond:4|1|1|1|0|1|D|1|1|1|1|1|U|Vd:4|1|0|1|sz|sx|1|i|0|imm4:4
-	{0x0fbf0ed0, 0x0eb70ac0, 4, VCVT_EQ_F64_F32, 0x8011c04, instArgs{arg_Dd_Sd, arg_Sm_Dm}},                       // VCVT<c>.<F64.F32,F32.F64> <Dd,Sd>, <Sm,Dm> cond:4|1|1|1|0|1|D|1|1|0|1|1|1|Vd:4|1|0|1|sz|1|1|M|0|Vm:4
-	{0x0fbe0f50, 0x0eb20a40, 4, VCVTB_EQ_F32_F16, 0x70110011c04, instArgs{arg_Sd, arg_Sm}},                        // VCVT<B,T><c>.<F32.F16,F16.F32> <Sd>, <Sm> cond:4|1|1|1|0|1|D|1|1|0|0|1|op|Vd:4|1|0|1|0|T|1|M|0|Vm:4
-	{0x0fbf0e50, 0x0eb80a40, 4, VCVT_EQ_F32_U32, 0x80107011c04, instArgs{arg_Sd_Dd, arg_Sm}},                      // VCVT<c>.F<32,64>.<U,S>32 <Sd,Dd>, <Sm> cond:4|1|1|1|0|1|D|1|1|1|0|0|0|Vd:4|1|0|1|sz|op|1|M|0|Vm:4
-	{0x0fbe0e50, 0x0ebc0a40, 4, VCVTR_EQ_U32_F32, 0x701100108011c04, instArgs{arg_Sd, arg_Sm_Dm}},                 // VCVT<R,><c>.<U,S>32.F<32,64> <Sd>, <Sm,Dm> cond:4|1|1|1|0|1|D|1|1|1|1|0|signed|Vd:4|1|0|1|sz|op|1|M|0|Vm:4
-	{0x0fb00e50, 0x0e800a00, 4, VDIV_EQ_F32, 0x8011c04, instArgs{arg_Sd_Dd, arg_Sn_Dn, arg_Sm_Dm}},                // VDIV<c>.F<32,64> <Sd,Dd>, <Sn,Dn>, <Sm,Dm> cond:4|1|1|1|0|1|D|0|0|Vn:4|Vd:4|1|0|1|sz|N|0|M|0|Vm:4
-	{0x0f300e00, 0x0d100a00, 4, VLDR_EQ, 0x1c04, instArgs{arg_Sd_Dd, arg_mem_R_pm_imm8at0_offset}},                // VLDR<c> <Sd,Dd>, [<Rn>{,#+/-<imm8>}] cond:4|1|1|0|1|U|D|0|1|Rn:4|Vd:4|1|0|1|sz|imm8:8
-	{0x0ff00f7f, 0x0e000a10, 4, VMOV_EQ, 0x1c04, instArgs{arg_Sn, arg_R_12}},                                      // VMOV<c> <Sn>, <Rt> cond:4|1|1|1|0|0|0|0|0|Vn:4|Rt:4|1|0|1|0|N|0|0|1|0|0|0|0
-	{0x0ff00f7f, 0x0e100a10, 4, VMOV_EQ, 0x1c04, instArgs{arg_R_12, arg_Sn}},                                      // VMOV<c> <Rt>, <Sn> cond:4|1|1|1|0|0|0|0|1|Vn:4|Rt:4|1|0|1|0|N|0|0|1|0|0|0|0
-	{0x0fd00f7f, 0x0e100b10, 4, VMOV_EQ_32, 0x1c04, instArgs{arg_R_12, arg_Dn_half}},                              // VMOV<c>.32 <Rt>, <Dn[x]> cond:4|1|1|1|0|0|0|opc1|1|Vn:4|Rt:4|1|0|1|1|N|0|0|1|0|0|0|0
-	{0x0fd00f7f, 0x0e000b10, 4, VMOV_EQ_32, 0x1c04, instArgs{arg_Dn_half, arg_R_12}},                              // VMOV<c>.32 <Dd[x]>, <Rt> cond:4|1|1|1|0|0|0|opc1|0|Vd:4|Rt:4|1|0|1|1|D|0|0|1|0|0|0|0
-	{0x0fb00ef0, 0x0eb00a00, 4, VMOV_EQ_F32, 0x8011c04, instArgs{arg_Sd_Dd, arg_imm_vfp}},                         // VMOV<c>.F<32,64> <Sd,Dd>, #<imm_vfp> cond:4|1|1|1|0|1|D|1|1|imm4H:4|Vd:4|1|0|1|sz|0|0|0|0|imm4L:4
-	{0x0fbf0ed0, 0x0eb00a40, 4, VMOV_EQ_F32, 0x8011c04, instArgs{arg_Sd_Dd, arg_Sm_Dm}},                           // VMOV<c>.F<32,64> <Sd,Dd>, <Sm,Dm> cond:4|1|1|1|0|1|D|1|1|0|0|0|0|Vd:4|1|0|1|sz|0|1|M|0|Vm:4
-	{0x0fff0fff, 0x0ef10a10, 4, VMRS_EQ, 0x1c04, instArgs{arg_R_12_nzcv, arg_FPSCR}},                              // VMRS<c> <Rt_nzcv>, FPSCR cond:4|1|1|1|0|1|1|1|1|0|0|0|1|Rt:4|1|0|1|0|0|0|0|1|0|0|0|0
-	{0x0fff0fff, 0x0ee10a10, 4, VMSR_EQ, 0x1c04, instArgs{arg_FPSCR, arg_R_12}},                                   // VMSR<c> FPSCR, <Rt> cond:4|1|1|1|0|1|1|1|0|0|0|0|1|Rt:4|1|0|1|0|0|0|0|1|0|0|0|0
-	{0x0fb00e50, 0x0e200a00, 4, VMUL_EQ_F32, 0x8011c04, instArgs{arg_Sd_Dd, arg_Sn_Dn, arg_Sm_Dm}},                // VMUL<c>.F<32,64> <Sd,Dd>, <Sn,Dn>, <Sm,Dm> cond:4|1|1|1|0|0|D|1|0|Vn:4|Vd:4|1|0|1|sz|N|0|M|0|Vm:4
-	{0x0fbf0ed0, 0x0eb10a40, 4, VNEG_EQ_F32, 0x8011c04, instArgs{arg_Sd_Dd, arg_Sm_Dm}},                           // VNEG<c>.F<32,64> <Sd,Dd>, <Sm,Dm> cond:4|1|1|1|0|1|D|1|1|0|0|0|1|Vd:4|1|0|1|sz|0|1|M|0|Vm:4
-	{0x0fb00e10, 0x0e100a00, 4, VNMLS_EQ_F32, 0x60108011c04, instArgs{arg_Sd_Dd, arg_Sn_Dn, arg_Sm_Dm}},           // VN<MLS,MLA><c>.F<32,64> <Sd,Dd>, <Sn,Dn>, <Sm,Dm> cond:4|1|1|1|0|0|D|0|1|Vn:4|Vd:4|1|0|1|sz|N|op|M|0|Vm:4
-	{0x0fb00e50, 0x0e200a40, 4, VNMUL_EQ_F32, 0x8011c04, instArgs{arg_Sd_Dd, arg_Sn_Dn, arg_Sm_Dm}},               // VNMUL<c>.F<32,64> <Sd,Dd>, <Sn,Dn>, <Sm,Dm> cond:4|1|1|1|0|0|D|1|0|Vn:4|Vd:4|1|0|1|sz|N|1|M|0|Vm:4
-	{0x0fbf0ed0, 0x0eb10ac0, 4, VSQRT_EQ_F32, 0x8011c04, instArgs{arg_Sd_Dd, arg_Sm_Dm}},                          // VSQRT<c>.F<32,64> <Sd,Dd>, <Sm,Dm> cond:4|1|1|1|0|1|D|1|1|0|0|0|1|Vd:4|1|0|1|sz|1|1|M|0|Vm:4
-	{0x0f300e00, 0x0d000a00, 4, VSTR_EQ, 0x1c04, instArgs{arg_Sd_Dd, arg_mem_R_pm_imm8at0_offset}},                // VSTR<c> <Sd,Dd>, [<Rn>{,#+/-<imm8>}] cond:4|1|1|0|1|U|D|0|0|Rn:4|Vd:4|1|0|1|sz|imm8:8
-	{0x0fb00e50, 0x0e300a40, 4, VSUB_EQ_F32, 0x8011c04, instArgs{arg_Sd_Dd, arg_Sn_Dn, arg_Sm_Dm}},                // VSUB<c>.F<32,64> <Sd,Dd>, <Sn,Dn>, <Sm,Dm> cond:4|1|1|1|0|0|D|1|1|Vn:4|Vd:4|1|0|1|sz|N|1|M|0|Vm:4
-	{0x0fffffff, 0x0320f002, 4, WFE_EQ, 0x1c04, instArgs{}},                                                       // WFE<c> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|0|1|0
-	{0x0fff00ff, 0x0320f002, 3, WFE_EQ, 0x1c04, instArgs{}},                                                       // WFE<c> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|0|1|0
-	{0x0fffffff, 0x0320f003, 4, WFI_EQ, 0x1c04, instArgs{}},                                                       // WFI<c> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|0|1|1
-	{0x0fff00ff, 0x0320f003, 3, WFI_EQ, 0x1c04, instArgs{}},                                                       // WFI<c> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|0|1|1
-	{0x0fffffff, 0x0320f001, 4, YIELD_EQ, 0x1c04, instArgs{}},                                                     // YIELD<c> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|0|0|1
-	{0x0fff00ff, 0x0320f001, 3, YIELD_EQ, 0x1c04, instArgs{}},                                                     // YIELD<c> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|0|0|1
-	{0xffffffff, 0xf7fabcfd, 4, UNDEF, 0x0, instArgs{}},                                                           // UNDEF 1|1|1|1|0|1|1|1|1|1|1|1|1|0|1|0|1|0|1|1|1|1|0|0|1|1|1|1|1|1|0|1
+	{0x0fe00000, 0x02a00000, 0x14011c04, ADC_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_const}},                      // ADC{S}<c> <Rd>,<Rn>,#<const> cond:4|0|0|1|0|1|0|1|S|Rn:4|Rd:4|imm12:12
+	{0x0fe00090, 0x00a00010, 0x14011c04, ADC_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_shift_R}},                  // ADC{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|0|1|0|1|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4
+	{0x0fe00010, 0x00a00000, 0x14011c04, ADC_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},                // ADC{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} cond:4|0|0|0|0|1|0|1|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4
+	{0x0fe00000, 0x02800000, 0x14011c04, ADD_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_const}},                      // ADD{S}<c> <Rd>,<Rn>,#<const> cond:4|0|0|1|0|1|0|0|S|Rn:4|Rd:4|imm12:12
+	{0x0fe00090, 0x00800010, 0x14011c04, ADD_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_shift_R}},                  // ADD{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|0|1|0|0|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4
+	{0x0fe00010, 0x00800000, 0x14011c04, ADD_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},                // ADD{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} cond:4|0|0|0|0|1|0|0|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4
+	{0x0fef0000, 0x028d0000, 0x14011c04, ADD_EQ, 2, instArgs{arg_R_12, arg_SP, arg_const}},                        // ADD{S}<c> <Rd>,SP,#<const> cond:4|0|0|1|0|1|0|0|S|1|1|0|1|Rd:4|imm12:12
+	{0x0fef0010, 0x008d0000, 0x14011c04, ADD_EQ, 2, instArgs{arg_R_12, arg_SP, arg_R_shift_imm}},                  // ADD{S}<c> <Rd>,SP,<Rm>{,<shift>} cond:4|0|0|0|0|1|0|0|S|1|1|0|1|Rd:4|imm5:5|type:2|0|Rm:4
+	{0x0fe00000, 0x02000000, 0x14011c04, AND_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_const}},                      // AND{S}<c> <Rd>,<Rn>,#<const> cond:4|0|0|1|0|0|0|0|S|Rn:4|Rd:4|imm12:12
+	{0x0fe00090, 0x00000010, 0x14011c04, AND_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_shift_R}},                  // AND{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|0|0|0|0|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4
+	{0x0fe00010, 0x00000000, 0x14011c04, AND_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},                // AND{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} cond:4|0|0|0|0|0|0|0|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4
+	{0x0fef0070, 0x01a00040, 0x14011c04, ASR_EQ, 4, instArgs{arg_R_12, arg_R_0, arg_imm5_32}},                     // ASR{S}<c> <Rd>,<Rm>,#<imm5_32> cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|imm5:5|1|0|0|Rm:4
+	{0x0fef00f0, 0x01a00050, 0x14011c04, ASR_EQ, 4, instArgs{arg_R_12, arg_R_0, arg_R_8}},                         // ASR{S}<c> <Rd>,<Rn>,<Rm> cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|Rm:4|0|1|0|1|Rn:4
+	{0x0f000000, 0x0a000000, 0x1c04, B_EQ, 4, instArgs{arg_label24}},                                              // B<c> <label24> cond:4|1|0|1|0|imm24:24
+	{0x0fe0007f, 0x07c0001f, 0x1c04, BFC_EQ, 4, instArgs{arg_R_12, arg_imm5, arg_lsb_width}},                      // BFC<c> <Rd>,#<lsb>,#<width> cond:4|0|1|1|1|1|1|0|msb:5|Rd:4|lsb:5|0|0|1|1|1|1|1
+	{0x0fe00070, 0x07c00010, 0x1c04, BFI_EQ, 2, instArgs{arg_R_12, arg_R_0, arg_imm5, arg_lsb_width}},             // BFI<c> <Rd>,<Rn>,#<lsb>,#<width> cond:4|0|1|1|1|1|1|0|msb:5|Rd:4|lsb:5|0|0|1|Rn:4
+	{0x0fe00000, 0x03c00000, 0x14011c04, BIC_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_const}},                      // BIC{S}<c> <Rd>,<Rn>,#<const> cond:4|0|0|1|1|1|1|0|S|Rn:4|Rd:4|imm12:12
+	{0x0fe00090, 0x01c00010, 0x14011c04, BIC_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_shift_R}},                  // BIC{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|1|1|1|0|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4
+	{0x0fe00010, 0x01c00000, 0x14011c04, BIC_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},                // BIC{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} cond:4|0|0|0|1|1|1|0|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4
+	{0x0ff000f0, 0x01200070, 0x1c04, BKPT_EQ, 4, instArgs{arg_imm_12at8_4at0}},                                    // BKPT<c> #<imm12+4> cond:4|0|0|0|1|0|0|1|0|imm12:12|0|1|1|1|imm4:4
+	{0x0f000000, 0x0b000000, 0x1c04, BL_EQ, 4, instArgs{arg_label24}},                                             // BL<c> <label24> cond:4|1|0|1|1|imm24:24
+	{0xfe000000, 0xfa000000, 0x0, BLX, 4, instArgs{arg_label24H}},                                                 // BLX <label24H> 1|1|1|1|1|0|1|H|imm24:24
+	{0x0ffffff0, 0x012fff30, 0x1c04, BLX_EQ, 4, instArgs{arg_R_0}},                                                // BLX<c> <Rm> cond:4|0|0|0|1|0|0|1|0|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
+	{0x0ff000f0, 0x012fff30, 0x1c04, BLX_EQ, 3, instArgs{arg_R_0}},                                                // BLX<c> <Rm> cond:4|0|0|0|1|0|0|1|0|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
+	{0x0ffffff0, 0x012fff10, 0x1c04, BX_EQ, 4, instArgs{arg_R_0}},                                                 // BX<c> <Rm> cond:4|0|0|0|1|0|0|1|0|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
+	{0x0ff000f0, 0x012fff10, 0x1c04, BX_EQ, 3, instArgs{arg_R_0}},                                                 // BX<c> <Rm> cond:4|0|0|0|1|0|0|1|0|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
+	{0x0ffffff0, 0x012fff20, 0x1c04, BXJ_EQ, 4, instArgs{arg_R_0}},                                                // BXJ<c> <Rm> cond:4|0|0|0|1|0|0|1|0|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|0|0|1|0|Rm:4
+	{0x0ff000f0, 0x012fff20, 0x1c04, BXJ_EQ, 3, instArgs{arg_R_0}},                                                // BXJ<c> <Rm> cond:4|0|0|0|1|0|0|1|0|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|0|0|1|0|Rm:4
+	{0xffffffff, 0xf57ff01f, 0x0, CLREX, 4, instArgs{}},                                                           // CLREX 1|1|1|1|0|1|0|1|0|1|1|1|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|1|(1)|(1)|(1)|(1)
+	{0xfff000f0, 0xf57ff01f, 0x0, CLREX, 3, instArgs{}},                                                           // CLREX 1|1|1|1|0|1|0|1|0|1|1|1|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|1|(1)|(1)|(1)|(1)
+	{0x0fff0ff0, 0x016f0f10, 0x1c04, CLZ_EQ, 4, instArgs{arg_R_12, arg_R_0}},                                      // CLZ<c> <Rd>,<Rm> cond:4|0|0|0|1|0|1|1|0|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
+	{0x0ff000f0, 0x016f0f10, 0x1c04, CLZ_EQ, 3, instArgs{arg_R_12, arg_R_0}},                                      // CLZ<c> <Rd>,<Rm> cond:4|0|0|0|1|0|1|1|0|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
+	{0x0ff0f000, 0x03700000, 0x1c04, CMN_EQ, 4, instArgs{arg_R_16, arg_const}},                                    // CMN<c> <Rn>,#<const> cond:4|0|0|1|1|0|1|1|1|Rn:4|(0)|(0)|(0)|(0)|imm12:12
+	{0x0ff00000, 0x03700000, 0x1c04, CMN_EQ, 3, instArgs{arg_R_16, arg_const}},                                    // CMN<c> <Rn>,#<const> cond:4|0|0|1|1|0|1|1|1|Rn:4|(0)|(0)|(0)|(0)|imm12:12
+	{0x0ff0f090, 0x01700010, 0x1c04, CMN_EQ, 4, instArgs{arg_R_16, arg_R_shift_R}},                                // CMN<c> <Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|1|0|1|1|1|Rn:4|(0)|(0)|(0)|(0)|Rs:4|0|type:2|1|Rm:4
+	{0x0ff00090, 0x01700010, 0x1c04, CMN_EQ, 3, instArgs{arg_R_16, arg_R_shift_R}},                                // CMN<c> <Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|1|0|1|1|1|Rn:4|(0)|(0)|(0)|(0)|Rs:4|0|type:2|1|Rm:4
+	{0x0ff0f010, 0x01700000, 0x1c04, CMN_EQ, 4, instArgs{arg_R_16, arg_R_shift_imm}},                              // CMN<c> <Rn>,<Rm>{,<shift>} cond:4|0|0|0|1|0|1|1|1|Rn:4|(0)|(0)|(0)|(0)|imm5:5|type:2|0|Rm:4
+	{0x0ff00010, 0x01700000, 0x1c04, CMN_EQ, 3, instArgs{arg_R_16, arg_R_shift_imm}},                              // CMN<c> <Rn>,<Rm>{,<shift>} cond:4|0|0|0|1|0|1|1|1|Rn:4|(0)|(0)|(0)|(0)|imm5:5|type:2|0|Rm:4
+	{0x0ff0f000, 0x03500000, 0x1c04, CMP_EQ, 4, instArgs{arg_R_16, arg_const}},                                    // CMP<c> <Rn>,#<const> cond:4|0|0|1|1|0|1|0|1|Rn:4|(0)|(0)|(0)|(0)|imm12:12
+	{0x0ff00000, 0x03500000, 0x1c04, CMP_EQ, 3, instArgs{arg_R_16, arg_const}},                                    // CMP<c> <Rn>,#<const> cond:4|0|0|1|1|0|1|0|1|Rn:4|(0)|(0)|(0)|(0)|imm12:12
+	{0x0ff0f090, 0x01500010, 0x1c04, CMP_EQ, 4, instArgs{arg_R_16, arg_R_shift_R}},                                // CMP<c> <Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|1|0|1|0|1|Rn:4|(0)|(0)|(0)|(0)|Rs:4|0|type:2|1|Rm:4
+	{0x0ff00090, 0x01500010, 0x1c04, CMP_EQ, 3, instArgs{arg_R_16, arg_R_shift_R}},                                // CMP<c> <Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|1|0|1|0|1|Rn:4|(0)|(0)|(0)|(0)|Rs:4|0|type:2|1|Rm:4
+	{0x0ff0f010, 0x01500000, 0x1c04, CMP_EQ, 4, instArgs{arg_R_16, arg_R_shift_imm}},                              // CMP<c> <Rn>,<Rm>{,<shift>} cond:4|0|0|0|1|0|1|0|1|Rn:4|(0)|(0)|(0)|(0)|imm5:5|type:2|0|Rm:4
+	{0x0ff00010, 0x01500000, 0x1c04, CMP_EQ, 3, instArgs{arg_R_16, arg_R_shift_imm}},                              // CMP<c> <Rn>,<Rm>{,<shift>} cond:4|0|0|0|1|0|1|0|1|Rn:4|(0)|(0)|(0)|(0)|imm5:5|type:2|0|Rm:4
+	{0x0ffffff0, 0x0320f0f0, 0x1c04, DBG_EQ, 4, instArgs{arg_option}},                                             // DBG<c> #<option> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|1|1|1|1|option:4
+	{0x0fff00f0, 0x0320f0f0, 0x1c04, DBG_EQ, 3, instArgs{arg_option}},                                             // DBG<c> #<option> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|1|1|1|1|option:4
+	{0xfffffff0, 0xf57ff050, 0x0, DMB, 4, instArgs{arg_option}},                                                   // DMB #<option> 1|1|1|1|0|1|0|1|0|1|1|1|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|1|0|1|option:4
+	{0xfff000f0, 0xf57ff050, 0x0, DMB, 3, instArgs{arg_option}},                                                   // DMB #<option> 1|1|1|1|0|1|0|1|0|1|1|1|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|1|0|1|option:4
+	{0xfffffff0, 0xf57ff040, 0x0, DSB, 4, instArgs{arg_option}},                                                   // DSB #<option> 1|1|1|1|0|1|0|1|0|1|1|1|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|1|0|0|option:4
+	{0xfff000f0, 0xf57ff040, 0x0, DSB, 3, instArgs{arg_option}},                                                   // DSB #<option> 1|1|1|1|0|1|0|1|0|1|1|1|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|1|0|0|option:4
+	{0x0fe00000, 0x02200000, 0x14011c04, EOR_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_const}},                      // EOR{S}<c> <Rd>,<Rn>,#<const> cond:4|0|0|1|0|0|0|1|S|Rn:4|Rd:4|imm12:12
+	{0x0fe00090, 0x00200010, 0x14011c04, EOR_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_shift_R}},                  // EOR{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|0|0|0|1|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4
+	{0x0fe00010, 0x00200000, 0x14011c04, EOR_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},                // EOR{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} cond:4|0|0|0|0|0|0|1|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4
+	{0x0fffffff, 0x0160006e, 0x1c04, ERET_EQ, 4, instArgs{}},                                                      // ERET<c> cond:4|0|0|0|1|0|1|1|0|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)|0|1|1|0|1|1|1|0
+	{0x0ff000ff, 0x0160006e, 0x1c04, ERET_EQ, 3, instArgs{}},                                                      // ERET<c> cond:4|0|0|0|1|0|1|1|0|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)|0|1|1|0|1|1|1|0
+	{0xfffffff0, 0xf57ff060, 0x0, ISB, 4, instArgs{arg_option}},                                                   // ISB #<option> 1|1|1|1|0|1|0|1|0|1|1|1|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|1|1|0|option:4
+	{0xfff000f0, 0xf57ff060, 0x0, ISB, 3, instArgs{arg_option}},                                                   // ISB #<option> 1|1|1|1|0|1|0|1|0|1|1|1|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|1|1|0|option:4
+	{0x0fd00000, 0x08900000, 0x1c04, LDM_EQ, 2, instArgs{arg_R_16_WB, arg_registers}},                             // LDM<c> <Rn>{!},<registers> cond:4|1|0|0|0|1|0|W|1|Rn:4|register_list:16
+	{0x0fd00000, 0x08100000, 0x1c04, LDMDA_EQ, 4, instArgs{arg_R_16_WB, arg_registers}},                           // LDMDA<c> <Rn>{!},<registers> cond:4|1|0|0|0|0|0|W|1|Rn:4|register_list:16
+	{0x0fd00000, 0x09100000, 0x1c04, LDMDB_EQ, 4, instArgs{arg_R_16_WB, arg_registers}},                           // LDMDB<c> <Rn>{!},<registers> cond:4|1|0|0|1|0|0|W|1|Rn:4|register_list:16
+	{0x0fd00000, 0x09900000, 0x1c04, LDMIB_EQ, 4, instArgs{arg_R_16_WB, arg_registers}},                           // LDMIB<c> <Rn>{!},<registers> cond:4|1|0|0|1|1|0|W|1|Rn:4|register_list:16
+	{0x0f7f0000, 0x051f0000, 0x1c04, LDR_EQ, 4, instArgs{arg_R_12, arg_label_pm_12}},                              // LDR<c> <Rt>,<label+/-12> cond:4|0|1|0|(1)|U|0|(0)|1|1|1|1|1|Rt:4|imm12:12
+	{0x0e5f0000, 0x051f0000, 0x1c04, LDR_EQ, 3, instArgs{arg_R_12, arg_label_pm_12}},                              // LDR<c> <Rt>,<label+/-12> cond:4|0|1|0|(1)|U|0|(0)|1|1|1|1|1|Rt:4|imm12:12
+	{0x0e500010, 0x06100000, 0x1c04, LDR_EQ, 2, instArgs{arg_R_12, arg_mem_R_pm_R_shift_imm_W}},                   // LDR<c> <Rt>,[<Rn>,+/-<Rm>{, <shift>}]{!} cond:4|0|1|1|P|U|0|W|1|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4
+	{0x0e500000, 0x04100000, 0x1c04, LDR_EQ, 2, instArgs{arg_R_12, arg_mem_R_pm_imm12_W}},                         // LDR<c> <Rt>,[<Rn>{,#+/-<imm12>}]{!} cond:4|0|1|0|P|U|0|W|1|Rn:4|Rt:4|imm12:12
+	{0x0f7f0000, 0x055f0000, 0x1c04, LDRB_EQ, 4, instArgs{arg_R_12, arg_label_pm_12}},                             // LDRB<c> <Rt>,<label+/-12> cond:4|0|1|0|(1)|U|1|(0)|1|1|1|1|1|Rt:4|imm12:12
+	{0x0e5f0000, 0x055f0000, 0x1c04, LDRB_EQ, 3, instArgs{arg_R_12, arg_label_pm_12}},                             // LDRB<c> <Rt>,<label+/-12> cond:4|0|1|0|(1)|U|1|(0)|1|1|1|1|1|Rt:4|imm12:12
+	{0x0e500010, 0x06500000, 0x1c04, LDRB_EQ, 2, instArgs{arg_R_12, arg_mem_R_pm_R_shift_imm_W}},                  // LDRB<c> <Rt>,[<Rn>,+/-<Rm>{, <shift>}]{!} cond:4|0|1|1|P|U|1|W|1|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4
+	{0x0e500000, 0x04500000, 0x1c04, LDRB_EQ, 2, instArgs{arg_R_12, arg_mem_R_pm_imm12_W}},                        // LDRB<c> <Rt>,[<Rn>{,#+/-<imm12>}]{!} cond:4|0|1|0|P|U|1|W|1|Rn:4|Rt:4|imm12:12
+	{0x0f700000, 0x04700000, 0x1c04, LDRBT_EQ, 4, instArgs{arg_R_12, arg_mem_R_pm_imm12_postindex}},               // LDRBT<c> <Rt>,[<Rn>],#+/-<imm12> cond:4|0|1|0|0|U|1|1|1|Rn:4|Rt:4|imm12:12
+	{0x0f700010, 0x06700000, 0x1c04, LDRBT_EQ, 4, instArgs{arg_R_12, arg_mem_R_pm_R_shift_imm_postindex}},         // LDRBT<c> <Rt>,[<Rn>],+/-<Rm>{, <shift>} cond:4|0|1|1|0|U|1|1|1|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4
+	{0x0e500ff0, 0x000000d0, 0x1c04, LDRD_EQ, 4, instArgs{arg_R1_12, arg_R2_12, arg_mem_R_pm_R_W}},                // LDRD<c> <Rt1>,<Rt2>,[<Rn>,+/-<Rm>]{!} cond:4|0|0|0|P|U|0|W|0|Rn:4|Rt:4|(0)|(0)|(0)|(0)|1|1|0|1|Rm:4
+	{0x0e5000f0, 0x000000d0, 0x1c04, LDRD_EQ, 3, instArgs{arg_R1_12, arg_R2_12, arg_mem_R_pm_R_W}},                // LDRD<c> <Rt1>,<Rt2>,[<Rn>,+/-<Rm>]{!} cond:4|0|0|0|P|U|0|W|0|Rn:4|Rt:4|(0)|(0)|(0)|(0)|1|1|0|1|Rm:4
+	{0x0e5000f0, 0x004000d0, 0x1c04, LDRD_EQ, 2, instArgs{arg_R1_12, arg_R2_12, arg_mem_R_pm_imm8_W}},             // LDRD<c> <Rt1>,<Rt2>,[<Rn>{,#+/-<imm8>}]{!} cond:4|0|0|0|P|U|1|W|0|Rn:4|Rt:4|imm4H:4|1|1|0|1|imm4L:4
+	{0x0ff00fff, 0x01900f9f, 0x1c04, LDREX_EQ, 4, instArgs{arg_R_12, arg_mem_R}},                                  // LDREX<c> <Rt>,[<Rn>] cond:4|0|0|0|1|1|0|0|1|Rn:4|Rt:4|(1)|(1)|(1)|(1)|1|0|0|1|(1)|(1)|(1)|(1)
+	{0x0ff000f0, 0x01900f9f, 0x1c04, LDREX_EQ, 3, instArgs{arg_R_12, arg_mem_R}},                                  // LDREX<c> <Rt>,[<Rn>] cond:4|0|0|0|1|1|0|0|1|Rn:4|Rt:4|(1)|(1)|(1)|(1)|1|0|0|1|(1)|(1)|(1)|(1)
+	{0x0ff00fff, 0x01d00f9f, 0x1c04, LDREXB_EQ, 4, instArgs{arg_R_12, arg_mem_R}},                                 // LDREXB<c> <Rt>, [<Rn>] cond:4|0|0|0|1|1|1|0|1|Rn:4|Rt:4|(1)|(1)|(1)|(1)|1|0|0|1|(1)|(1)|(1)|(1)
+	{0x0ff000f0, 0x01d00f9f, 0x1c04, LDREXB_EQ, 3, instArgs{arg_R_12, arg_mem_R}},                                 // LDREXB<c> <Rt>, [<Rn>] cond:4|0|0|0|1|1|1|0|1|Rn:4|Rt:4|(1)|(1)|(1)|(1)|1|0|0|1|(1)|(1)|(1)|(1)
+	{0x0ff00fff, 0x01b00f9f, 0x1c04, LDREXD_EQ, 4, instArgs{arg_R1_12, arg_R2_12, arg_mem_R}},                     // LDREXD<c> <Rt1>,<Rt2>,[<Rn>] cond:4|0|0|0|1|1|0|1|1|Rn:4|Rt:4|(1)|(1)|(1)|(1)|1|0|0|1|(1)|(1)|(1)|(1)
+	{0x0ff000f0, 0x01b00f9f, 0x1c04, LDREXD_EQ, 3, instArgs{arg_R1_12, arg_R2_12, arg_mem_R}},                     // LDREXD<c> <Rt1>,<Rt2>,[<Rn>] cond:4|0|0|0|1|1|0|1|1|Rn:4|Rt:4|(1)|(1)|(1)|(1)|1|0|0|1|(1)|(1)|(1)|(1)
+	{0x0ff00fff, 0x01f00f9f, 0x1c04, LDREXH_EQ, 4, instArgs{arg_R_12, arg_mem_R}},                                 // LDREXH<c> <Rt>, [<Rn>] cond:4|0|0|0|1|1|1|1|1|Rn:4|Rt:4|(1)|(1)|(1)|(1)|1|0|0|1|(1)|(1)|(1)|(1)
+	{0x0ff000f0, 0x01f00f9f, 0x1c04, LDREXH_EQ, 3, instArgs{arg_R_12, arg_mem_R}},                                 // LDREXH<c> <Rt>, [<Rn>] cond:4|0|0|0|1|1|1|1|1|Rn:4|Rt:4|(1)|(1)|(1)|(1)|1|0|0|1|(1)|(1)|(1)|(1)
+	{0x0e500ff0, 0x001000b0, 0x1c04, LDRH_EQ, 2, instArgs{arg_R_12, arg_mem_R_pm_R_W}},                            // LDRH<c> <Rt>,[<Rn>,+/-<Rm>]{!} cond:4|0|0|0|P|U|0|W|1|Rn:4|Rt:4|0|0|0|0|1|0|1|1|Rm:4
+	{0x0e5000f0, 0x005000b0, 0x1c04, LDRH_EQ, 2, instArgs{arg_R_12, arg_mem_R_pm_imm8_W}},                         // LDRH<c> <Rt>,[<Rn>{,#+/-<imm8>}]{!} cond:4|0|0|0|P|U|1|W|1|Rn:4|Rt:4|imm4H:4|1|0|1|1|imm4L:4
+	{0x0f7000f0, 0x007000b0, 0x1c04, LDRHT_EQ, 4, instArgs{arg_R_12, arg_mem_R_pm_imm8_postindex}},                // LDRHT<c> <Rt>, [<Rn>] {,#+/-<imm8>} cond:4|0|0|0|0|U|1|1|1|Rn:4|Rt:4|imm4H:4|1|0|1|1|imm4L:4
+	{0x0f700ff0, 0x003000b0, 0x1c04, LDRHT_EQ, 4, instArgs{arg_R_12, arg_mem_R_pm_R_postindex}},                   // LDRHT<c> <Rt>, [<Rn>], +/-<Rm> cond:4|0|0|0|0|U|0|1|1|Rn:4|Rt:4|0|0|0|0|1|0|1|1|Rm:4
+	{0x0e500ff0, 0x001000d0, 0x1c04, LDRSB_EQ, 2, instArgs{arg_R_12, arg_mem_R_pm_R_W}},                           // LDRSB<c> <Rt>,[<Rn>,+/-<Rm>]{!} cond:4|0|0|0|P|U|0|W|1|Rn:4|Rt:4|0|0|0|0|1|1|0|1|Rm:4
+	{0x0e5000f0, 0x005000d0, 0x1c04, LDRSB_EQ, 2, instArgs{arg_R_12, arg_mem_R_pm_imm8_W}},                        // LDRSB<c> <Rt>,[<Rn>{,#+/-<imm8>}]{!} cond:4|0|0|0|P|U|1|W|1|Rn:4|Rt:4|imm4H:4|1|1|0|1|imm4L:4
+	{0x0f7000f0, 0x007000d0, 0x1c04, LDRSBT_EQ, 4, instArgs{arg_R_12, arg_mem_R_pm_imm8_postindex}},               // LDRSBT<c> <Rt>, [<Rn>] {,#+/-<imm8>} cond:4|0|0|0|0|U|1|1|1|Rn:4|Rt:4|imm4H:4|1|1|0|1|imm4L:4
+	{0x0f700ff0, 0x003000d0, 0x1c04, LDRSBT_EQ, 4, instArgs{arg_R_12, arg_mem_R_pm_R_postindex}},                  // LDRSBT<c> <Rt>, [<Rn>], +/-<Rm> cond:4|0|0|0|0|U|0|1|1|Rn:4|Rt:4|0|0|0|0|1|1|0|1|Rm:4
+	{0x0e500ff0, 0x001000f0, 0x1c04, LDRSH_EQ, 2, instArgs{arg_R_12, arg_mem_R_pm_R_W}},                           // LDRSH<c> <Rt>,[<Rn>,+/-<Rm>]{!} cond:4|0|0|0|P|U|0|W|1|Rn:4|Rt:4|0|0|0|0|1|1|1|1|Rm:4
+	{0x0e5000f0, 0x005000f0, 0x1c04, LDRSH_EQ, 2, instArgs{arg_R_12, arg_mem_R_pm_imm8_W}},                        // LDRSH<c> <Rt>,[<Rn>{,#+/-<imm8>}]{!} cond:4|0|0|0|P|U|1|W|1|Rn:4|Rt:4|imm4H:4|1|1|1|1|imm4L:4
+	{0x0f7000f0, 0x007000f0, 0x1c04, LDRSHT_EQ, 4, instArgs{arg_R_12, arg_mem_R_pm_imm8_postindex}},               // LDRSHT<c> <Rt>, [<Rn>] {,#+/-<imm8>} cond:4|0|0|0|0|U|1|1|1|Rn:4|Rt:4|imm4H:4|1|1|1|1|imm4L:4
+	{0x0f700ff0, 0x003000f0, 0x1c04, LDRSHT_EQ, 4, instArgs{arg_R_12, arg_mem_R_pm_R_postindex}},                  // LDRSHT<c> <Rt>, [<Rn>], +/-<Rm> cond:4|0|0|0|0|U|0|1|1|Rn:4|Rt:4|0|0|0|0|1|1|1|1|Rm:4
+	{0x0f700000, 0x04300000, 0x1c04, LDRT_EQ, 4, instArgs{arg_R_12, arg_mem_R_pm_imm12_postindex}},                // LDRT<c> <Rt>, [<Rn>] {,#+/-<imm12>} cond:4|0|1|0|0|U|0|1|1|Rn:4|Rt:4|imm12:12
+	{0x0f700010, 0x06300000, 0x1c04, LDRT_EQ, 4, instArgs{arg_R_12, arg_mem_R_pm_R_shift_imm_postindex}},          // LDRT<c> <Rt>,[<Rn>],+/-<Rm>{, <shift>} cond:4|0|1|1|0|U|0|1|1|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4
+	{0x0fef0070, 0x01a00000, 0x14011c04, LSL_EQ, 2, instArgs{arg_R_12, arg_R_0, arg_imm5_nz}},                     // LSL{S}<c> <Rd>,<Rm>,#<imm5_nz> cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|imm5:5|0|0|0|Rm:4
+	{0x0fef00f0, 0x01a00010, 0x14011c04, LSL_EQ, 4, instArgs{arg_R_12, arg_R_0, arg_R_8}},                         // LSL{S}<c> <Rd>,<Rn>,<Rm> cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|Rm:4|0|0|0|1|Rn:4
+	{0x0fef0070, 0x01a00020, 0x14011c04, LSR_EQ, 4, instArgs{arg_R_12, arg_R_0, arg_imm5_32}},                     // LSR{S}<c> <Rd>,<Rm>,#<imm5_32> cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|imm5:5|0|1|0|Rm:4
+	{0x0fef00f0, 0x01a00030, 0x14011c04, LSR_EQ, 4, instArgs{arg_R_12, arg_R_0, arg_R_8}},                         // LSR{S}<c> <Rd>,<Rn>,<Rm> cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|Rm:4|0|0|1|1|Rn:4
+	{0x0fe000f0, 0x00200090, 0x14011c04, MLA_EQ, 4, instArgs{arg_R_16, arg_R_0, arg_R_8, arg_R_12}},               // MLA{S}<c> <Rd>,<Rn>,<Rm>,<Ra> cond:4|0|0|0|0|0|0|1|S|Rd:4|Ra:4|Rm:4|1|0|0|1|Rn:4
+	{0x0ff000f0, 0x00600090, 0x1c04, MLS_EQ, 4, instArgs{arg_R_16, arg_R_0, arg_R_8, arg_R_12}},                   // MLS<c> <Rd>,<Rn>,<Rm>,<Ra> cond:4|0|0|0|0|0|1|1|0|Rd:4|Ra:4|Rm:4|1|0|0|1|Rn:4
+	{0x0ff00000, 0x03400000, 0x1c04, MOVT_EQ, 4, instArgs{arg_R_12, arg_imm_4at16_12at0}},                         // MOVT<c> <Rd>,#<imm12+4> cond:4|0|0|1|1|0|1|0|0|imm4:4|Rd:4|imm12:12
+	{0x0ff00000, 0x03000000, 0x1c04, MOVW_EQ, 4, instArgs{arg_R_12, arg_imm_4at16_12at0}},                         // MOVW<c> <Rd>,#<imm12+4> cond:4|0|0|1|1|0|0|0|0|imm4:4|Rd:4|imm12:12
+	{0x0fef0000, 0x03a00000, 0x14011c04, MOV_EQ, 2, instArgs{arg_R_12, arg_const}},                                // MOV{S}<c> <Rd>,#<const> cond:4|0|0|1|1|1|0|1|S|0|0|0|0|Rd:4|imm12:12
+	{0x0fef0ff0, 0x01a00000, 0x14011c04, MOV_EQ, 2, instArgs{arg_R_12, arg_R_0}},                                  // MOV{S}<c> <Rd>,<Rm> cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|0|0|0|0|0|0|0|0|Rm:4
+	{0x0fff0fff, 0x010f0000, 0x1c04, MRS_EQ, 4, instArgs{arg_R_12, arg_APSR}},                                     // MRS<c> <Rd>,APSR cond:4|0|0|0|1|0|0|0|0|(1)|(1)|(1)|(1)|Rd:4|(0)|(0)|(0)|(0)|0|0|0|0|(0)|(0)|(0)|(0)
+	{0x0ff000f0, 0x010f0000, 0x1c04, MRS_EQ, 3, instArgs{arg_R_12, arg_APSR}},                                     // MRS<c> <Rd>,APSR cond:4|0|0|0|1|0|0|0|0|(1)|(1)|(1)|(1)|Rd:4|(0)|(0)|(0)|(0)|0|0|0|0|(0)|(0)|(0)|(0)
+	{0x0fff0fff, 0x014f0000, 0x1c04, MRS_EQ, 4, instArgs{arg_R_12, arg_SPSR}},                                     // MRS<c> <Rd>,SPSR cond:4|0|0|0|1|0|1|0|0|(1)|(1)|(1)|(1)|Rd:4|(0)|(0)|(0)|(0)|0|0|0|0|(0)|(0)|(0)|(0)
+	{0x0ff000f0, 0x014f0000, 0x1c04, MRS_EQ, 3, instArgs{arg_R_12, arg_SPSR}},                                     // MRS<c> <Rd>,SPSR cond:4|0|0|0|1|0|1|0|0|(1)|(1)|(1)|(1)|Rd:4|(0)|(0)|(0)|(0)|0|0|0|0|(0)|(0)|(0)|(0)
+	{0x0fe0f0f0, 0x00000090, 0x14011c04, MUL_EQ, 4, instArgs{arg_R_16, arg_R_0, arg_R_8}},                         // MUL{S}<c> <Rd>,<Rn>,<Rm> cond:4|0|0|0|0|0|0|0|S|Rd:4|(0)|(0)|(0)|(0)|Rm:4|1|0|0|1|Rn:4
+	{0x0fe000f0, 0x00000090, 0x14011c04, MUL_EQ, 3, instArgs{arg_R_16, arg_R_0, arg_R_8}},                         // MUL{S}<c> <Rd>,<Rn>,<Rm> cond:4|0|0|0|0|0|0|0|S|Rd:4|(0)|(0)|(0)|(0)|Rm:4|1|0|0|1|Rn:4
+	{0x0fef0000, 0x03e00000, 0x14011c04, MVN_EQ, 2, instArgs{arg_R_12, arg_const}},                                // MVN{S}<c> <Rd>,#<const> cond:4|0|0|1|1|1|1|1|S|(0)|(0)|(0)|(0)|Rd:4|imm12:12
+	{0x0fe00000, 0x03e00000, 0x14011c04, MVN_EQ, 1, instArgs{arg_R_12, arg_const}},                                // MVN{S}<c> <Rd>,#<const> cond:4|0|0|1|1|1|1|1|S|(0)|(0)|(0)|(0)|Rd:4|imm12:12
+	{0x0fef0090, 0x01e00010, 0x14011c04, MVN_EQ, 4, instArgs{arg_R_12, arg_R_shift_R}},                            // MVN{S}<c> <Rd>,<Rm>,<type> <Rs> cond:4|0|0|0|1|1|1|1|S|(0)|(0)|(0)|(0)|Rd:4|Rs:4|0|type:2|1|Rm:4
+	{0x0fe00090, 0x01e00010, 0x14011c04, MVN_EQ, 3, instArgs{arg_R_12, arg_R_shift_R}},                            // MVN{S}<c> <Rd>,<Rm>,<type> <Rs> cond:4|0|0|0|1|1|1|1|S|(0)|(0)|(0)|(0)|Rd:4|Rs:4|0|type:2|1|Rm:4
+	{0x0fef0010, 0x01e00000, 0x14011c04, MVN_EQ, 2, instArgs{arg_R_12, arg_R_shift_imm}},                          // MVN{S}<c> <Rd>,<Rm>{,<shift>} cond:4|0|0|0|1|1|1|1|S|(0)|(0)|(0)|(0)|Rd:4|imm5:5|type:2|0|Rm:4
+	{0x0fe00010, 0x01e00000, 0x14011c04, MVN_EQ, 1, instArgs{arg_R_12, arg_R_shift_imm}},                          // MVN{S}<c> <Rd>,<Rm>{,<shift>} cond:4|0|0|0|1|1|1|1|S|(0)|(0)|(0)|(0)|Rd:4|imm5:5|type:2|0|Rm:4
+	{0x0fffffff, 0x0320f000, 0x1c04, NOP_EQ, 4, instArgs{}},                                                       // NOP<c> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|0|0|0
+	{0x0fff00ff, 0x0320f000, 0x1c04, NOP_EQ, 3, instArgs{}},                                                       // NOP<c> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|0|0|0
+	{0x0fe00000, 0x03800000, 0x14011c04, ORR_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_const}},                      // ORR{S}<c> <Rd>,<Rn>,#<const> cond:4|0|0|1|1|1|0|0|S|Rn:4|Rd:4|imm12:12
+	{0x0fe00090, 0x01800010, 0x14011c04, ORR_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_shift_R}},                  // ORR{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|1|1|0|0|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4
+	{0x0fe00010, 0x01800000, 0x14011c04, ORR_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},                // ORR{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} cond:4|0|0|0|1|1|0|0|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4
+	{0x0ff00030, 0x06800010, 0x6011c04, PKHBT_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},               // PKH<BT,TB><c> <Rd>,<Rn>,<Rm>{,LSL #<imm5>} cond:4|0|1|1|0|1|0|0|0|Rn:4|Rd:4|imm5:5|tb|0|1|Rm:4
+	{0xff7ff000, 0xf55ff000, 0x0, PLD, 4, instArgs{arg_label_pm_12}},                                              // PLD <label+/-12> 1|1|1|1|0|1|0|1|U|(1)|0|1|1|1|1|1|(1)|(1)|(1)|(1)|imm12:12
+	{0xff3f0000, 0xf55ff000, 0x0, PLD, 3, instArgs{arg_label_pm_12}},                                              // PLD <label+/-12> 1|1|1|1|0|1|0|1|U|(1)|0|1|1|1|1|1|(1)|(1)|(1)|(1)|imm12:12
+	{0xff30f000, 0xf510f000, 0x1601, PLD_W, 2, instArgs{arg_mem_R_pm_imm12_offset}},                               // PLD{W} [<Rn>,#+/-<imm12>] 1|1|1|1|0|1|0|1|U|R|0|1|Rn:4|(1)|(1)|(1)|(1)|imm12:12
+	{0xff300000, 0xf510f000, 0x1601, PLD_W, 1, instArgs{arg_mem_R_pm_imm12_offset}},                               // PLD{W} [<Rn>,#+/-<imm12>] 1|1|1|1|0|1|0|1|U|R|0|1|Rn:4|(1)|(1)|(1)|(1)|imm12:12
+	{0xff30f010, 0xf710f000, 0x1601, PLD_W, 4, instArgs{arg_mem_R_pm_R_shift_imm_offset}},                         // PLD{W} [<Rn>,+/-<Rm>{, <shift>}] 1|1|1|1|0|1|1|1|U|R|0|1|Rn:4|(1)|(1)|(1)|(1)|imm5:5|type:2|0|Rm:4
+	{0xff300010, 0xf710f000, 0x1601, PLD_W, 3, instArgs{arg_mem_R_pm_R_shift_imm_offset}},                         // PLD{W} [<Rn>,+/-<Rm>{, <shift>}] 1|1|1|1|0|1|1|1|U|R|0|1|Rn:4|(1)|(1)|(1)|(1)|imm5:5|type:2|0|Rm:4
+	{0xff70f000, 0xf450f000, 0x0, PLI, 4, instArgs{arg_mem_R_pm_imm12_offset}},                                    // PLI [<Rn>,#+/-<imm12>] 1|1|1|1|0|1|0|0|U|1|0|1|Rn:4|(1)|(1)|(1)|(1)|imm12:12
+	{0xff700000, 0xf450f000, 0x0, PLI, 3, instArgs{arg_mem_R_pm_imm12_offset}},                                    // PLI [<Rn>,#+/-<imm12>] 1|1|1|1|0|1|0|0|U|1|0|1|Rn:4|(1)|(1)|(1)|(1)|imm12:12
+	{0xff70f010, 0xf650f000, 0x0, PLI, 4, instArgs{arg_mem_R_pm_R_shift_imm_offset}},                              // PLI [<Rn>,+/-<Rm>{, <shift>}] 1|1|1|1|0|1|1|0|U|1|0|1|Rn:4|(1)|(1)|(1)|(1)|imm5:5|type:2|0|Rm:4
+	{0xff700010, 0xf650f000, 0x0, PLI, 3, instArgs{arg_mem_R_pm_R_shift_imm_offset}},                              // PLI [<Rn>,+/-<Rm>{, <shift>}] 1|1|1|1|0|1|1|0|U|1|0|1|Rn:4|(1)|(1)|(1)|(1)|imm5:5|type:2|0|Rm:4
+	{0x0fff0000, 0x08bd0000, 0x1c04, POP_EQ, 4, instArgs{arg_registers2}},                                         // POP<c> <registers2> cond:4|1|0|0|0|1|0|1|1|1|1|0|1|register_list:16
+	{0x0fff0fff, 0x049d0004, 0x1c04, POP_EQ, 4, instArgs{arg_registers1}},                                         // POP<c> <registers1> cond:4|0|1|0|0|1|0|0|1|1|1|0|1|Rt:4|0|0|0|0|0|0|0|0|0|1|0|0
+	{0x0fff0000, 0x092d0000, 0x1c04, PUSH_EQ, 4, instArgs{arg_registers2}},                                        // PUSH<c> <registers2> cond:4|1|0|0|1|0|0|1|0|1|1|0|1|register_list:16
+	{0x0fff0fff, 0x052d0004, 0x1c04, PUSH_EQ, 4, instArgs{arg_registers1}},                                        // PUSH<c> <registers1> cond:4|0|1|0|1|0|0|1|0|1|1|0|1|Rt:4|0|0|0|0|0|0|0|0|0|1|0|0
+	{0x0ff00ff0, 0x06200f10, 0x1c04, QADD16_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // QADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
+	{0x0ff000f0, 0x06200f10, 0x1c04, QADD16_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // QADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
+	{0x0ff00ff0, 0x06200f90, 0x1c04, QADD8_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // QADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
+	{0x0ff000f0, 0x06200f90, 0x1c04, QADD8_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // QADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
+	{0x0ff00ff0, 0x01000050, 0x1c04, QADD_EQ, 4, instArgs{arg_R_12, arg_R_0, arg_R_16}},                           // QADD<c> <Rd>,<Rm>,<Rn> cond:4|0|0|0|1|0|0|0|0|Rn:4|Rd:4|(0)|(0)|(0)|(0)|0|1|0|1|Rm:4
+	{0x0ff000f0, 0x01000050, 0x1c04, QADD_EQ, 3, instArgs{arg_R_12, arg_R_0, arg_R_16}},                           // QADD<c> <Rd>,<Rm>,<Rn> cond:4|0|0|0|1|0|0|0|0|Rn:4|Rd:4|(0)|(0)|(0)|(0)|0|1|0|1|Rm:4
+	{0x0ff00ff0, 0x06200f30, 0x1c04, QASX_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // QASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
+	{0x0ff000f0, 0x06200f30, 0x1c04, QASX_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // QASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
+	{0x0ff00ff0, 0x01400050, 0x1c04, QDADD_EQ, 4, instArgs{arg_R_12, arg_R_0, arg_R_16}},                          // QDADD<c> <Rd>,<Rm>,<Rn> cond:4|0|0|0|1|0|1|0|0|Rn:4|Rd:4|(0)|(0)|(0)|(0)|0|1|0|1|Rm:4
+	{0x0ff000f0, 0x01400050, 0x1c04, QDADD_EQ, 3, instArgs{arg_R_12, arg_R_0, arg_R_16}},                          // QDADD<c> <Rd>,<Rm>,<Rn> cond:4|0|0|0|1|0|1|0|0|Rn:4|Rd:4|(0)|(0)|(0)|(0)|0|1|0|1|Rm:4
+	{0x0ff00ff0, 0x01600050, 0x1c04, QDSUB_EQ, 4, instArgs{arg_R_12, arg_R_0, arg_R_16}},                          // QDSUB<c> <Rd>,<Rm>,<Rn> cond:4|0|0|0|1|0|1|1|0|Rn:4|Rd:4|0|0|0|0|0|1|0|1|Rm:4
+	{0x0ff00ff0, 0x06200f50, 0x1c04, QSAX_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // QSAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
+	{0x0ff000f0, 0x06200f50, 0x1c04, QSAX_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // QSAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
+	{0x0ff00ff0, 0x06200f70, 0x1c04, QSUB16_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // QSUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
+	{0x0ff000f0, 0x06200f70, 0x1c04, QSUB16_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // QSUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
+	{0x0ff00ff0, 0x06200ff0, 0x1c04, QSUB8_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // QSUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
+	{0x0ff000f0, 0x06200ff0, 0x1c04, QSUB8_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // QSUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
+	{0x0ff00ff0, 0x01200050, 0x1c04, QSUB_EQ, 4, instArgs{arg_R_12, arg_R_0, arg_R_16}},                           // QSUB<c> <Rd>,<Rm>,<Rn> cond:4|0|0|0|1|0|0|1|0|Rn:4|Rd:4|0|0|0|0|0|1|0|1|Rm:4
+	{0x0fff0ff0, 0x06ff0f30, 0x1c04, RBIT_EQ, 4, instArgs{arg_R_12, arg_R_0}},                                     // RBIT<c> <Rd>,<Rm> cond:4|0|1|1|0|1|1|1|1|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
+	{0x0ff000f0, 0x06ff0f30, 0x1c04, RBIT_EQ, 3, instArgs{arg_R_12, arg_R_0}},                                     // RBIT<c> <Rd>,<Rm> cond:4|0|1|1|0|1|1|1|1|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
+	{0x0fff0ff0, 0x06bf0fb0, 0x1c04, REV16_EQ, 4, instArgs{arg_R_12, arg_R_0}},                                    // REV16<c> <Rd>,<Rm> cond:4|0|1|1|0|1|0|1|1|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|1|0|1|1|Rm:4
+	{0x0ff000f0, 0x06bf0fb0, 0x1c04, REV16_EQ, 3, instArgs{arg_R_12, arg_R_0}},                                    // REV16<c> <Rd>,<Rm> cond:4|0|1|1|0|1|0|1|1|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|1|0|1|1|Rm:4
+	{0x0fff0ff0, 0x06bf0f30, 0x1c04, REV_EQ, 4, instArgs{arg_R_12, arg_R_0}},                                      // REV<c> <Rd>,<Rm> cond:4|0|1|1|0|1|0|1|1|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
+	{0x0ff000f0, 0x06bf0f30, 0x1c04, REV_EQ, 3, instArgs{arg_R_12, arg_R_0}},                                      // REV<c> <Rd>,<Rm> cond:4|0|1|1|0|1|0|1|1|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
+	{0x0fff0ff0, 0x06ff0fb0, 0x1c04, REVSH_EQ, 4, instArgs{arg_R_12, arg_R_0}},                                    // REVSH<c> <Rd>,<Rm> cond:4|0|1|1|0|1|1|1|1|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|1|0|1|1|Rm:4
+	{0x0ff000f0, 0x06ff0fb0, 0x1c04, REVSH_EQ, 3, instArgs{arg_R_12, arg_R_0}},                                    // REVSH<c> <Rd>,<Rm> cond:4|0|1|1|0|1|1|1|1|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|1|0|1|1|Rm:4
+	{0x0fef0070, 0x01a00060, 0x14011c04, ROR_EQ, 2, instArgs{arg_R_12, arg_R_0, arg_imm5}},                        // ROR{S}<c> <Rd>,<Rm>,#<imm5> cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|imm5:5|1|1|0|Rm:4
+	{0x0fef00f0, 0x01a00070, 0x14011c04, ROR_EQ, 4, instArgs{arg_R_12, arg_R_0, arg_R_8}},                         // ROR{S}<c> <Rd>,<Rn>,<Rm> cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|Rm:4|0|1|1|1|Rn:4
+	{0x0fef0ff0, 0x01a00060, 0x14011c04, RRX_EQ, 4, instArgs{arg_R_12, arg_R_0}},                                  // RRX{S}<c> <Rd>,<Rm> cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|0|0|0|0|0|1|1|0|Rm:4
+	{0x0fe00000, 0x02600000, 0x14011c04, RSB_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_const}},                      // RSB{S}<c> <Rd>,<Rn>,#<const> cond:4|0|0|1|0|0|1|1|S|Rn:4|Rd:4|imm12:12
+	{0x0fe00090, 0x00600010, 0x14011c04, RSB_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_shift_R}},                  // RSB{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|0|0|1|1|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4
+	{0x0fe00010, 0x00600000, 0x14011c04, RSB_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},                // RSB{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} cond:4|0|0|0|0|0|1|1|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4
+	{0x0fe00000, 0x02e00000, 0x14011c04, RSC_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_const}},                      // RSC{S}<c> <Rd>,<Rn>,#<const> cond:4|0|0|1|0|1|1|1|S|Rn:4|Rd:4|imm12:12
+	{0x0fe00090, 0x00e00010, 0x14011c04, RSC_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_shift_R}},                  // RSC{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|0|1|1|1|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4
+	{0x0fe00010, 0x00e00000, 0x14011c04, RSC_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},                // RSC{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} cond:4|0|0|0|0|1|1|1|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4
+	{0x0ff00ff0, 0x06100f10, 0x1c04, SADD16_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // SADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
+	{0x0ff000f0, 0x06100f10, 0x1c04, SADD16_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // SADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
+	{0x0ff00ff0, 0x06100f90, 0x1c04, SADD8_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // SADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
+	{0x0ff000f0, 0x06100f90, 0x1c04, SADD8_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // SADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
+	{0x0ff00ff0, 0x06100f30, 0x1c04, SASX_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // SASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
+	{0x0ff000f0, 0x06100f30, 0x1c04, SASX_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // SASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
+	{0x0fe00000, 0x02c00000, 0x14011c04, SBC_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_const}},                      // SBC{S}<c> <Rd>,<Rn>,#<const> cond:4|0|0|1|0|1|1|0|S|Rn:4|Rd:4|imm12:12
+	{0x0fe00090, 0x00c00010, 0x14011c04, SBC_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_shift_R}},                  // SBC{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|0|1|1|0|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4
+	{0x0fe00010, 0x00c00000, 0x14011c04, SBC_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},                // SBC{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} cond:4|0|0|0|0|1|1|0|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4
+	{0x0fe00070, 0x07a00050, 0x1c04, SBFX_EQ, 4, instArgs{arg_R_12, arg_R_0, arg_imm5, arg_widthm1}},              // SBFX<c> <Rd>,<Rn>,#<lsb>,#<widthm1> cond:4|0|1|1|1|1|0|1|widthm1:5|Rd:4|lsb:5|1|0|1|Rn:4
+	{0x0ff00ff0, 0x06800fb0, 0x1c04, SEL_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                            // SEL<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|1|0|0|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|1|1|Rm:4
+	{0x0ff000f0, 0x06800fb0, 0x1c04, SEL_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                            // SEL<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|1|0|0|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|1|1|Rm:4
+	{0xfffffdff, 0xf1010000, 0x0, SETEND, 4, instArgs{arg_endian}},                                                // SETEND <endian_specifier> 1|1|1|1|0|0|0|1|0|0|0|0|0|0|0|1|0|0|0|0|0|0|E|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)
+	{0xfffffc00, 0xf1010000, 0x0, SETEND, 3, instArgs{arg_endian}},                                                // SETEND <endian_specifier> 1|1|1|1|0|0|0|1|0|0|0|0|0|0|0|1|0|0|0|0|0|0|E|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)
+	{0x0fffffff, 0x0320f004, 0x1c04, SEV_EQ, 4, instArgs{}},                                                       // SEV<c> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|1|0|0
+	{0x0fff00ff, 0x0320f004, 0x1c04, SEV_EQ, 3, instArgs{}},                                                       // SEV<c> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|1|0|0
+	{0x0ff00ff0, 0x06300f10, 0x1c04, SHADD16_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // SHADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
+	{0x0ff000f0, 0x06300f10, 0x1c04, SHADD16_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // SHADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
+	{0x0ff00ff0, 0x06300f90, 0x1c04, SHADD8_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // SHADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
+	{0x0ff000f0, 0x06300f90, 0x1c04, SHADD8_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // SHADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
+	{0x0ff00ff0, 0x06300f30, 0x1c04, SHASX_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // SHASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
+	{0x0ff000f0, 0x06300f30, 0x1c04, SHASX_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // SHASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
+	{0x0ff00ff0, 0x06300f50, 0x1c04, SHSAX_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // SHSAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
+	{0x0ff000f0, 0x06300f50, 0x1c04, SHSAX_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // SHSAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
+	{0x0ff00ff0, 0x06300f70, 0x1c04, SHSUB16_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // SHSUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
+	{0x0ff000f0, 0x06300f70, 0x1c04, SHSUB16_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // SHSUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
+	{0x0ff00ff0, 0x06300ff0, 0x1c04, SHSUB8_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // SHSUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
+	{0x0ff000f0, 0x06300ff0, 0x1c04, SHSUB8_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // SHSUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
+	{0x0ff00090, 0x01000080, 0x50106011c04, SMLABB_EQ, 4, instArgs{arg_R_16, arg_R_0, arg_R_8, arg_R_12}},         // SMLA<x><y><c> <Rd>,<Rn>,<Rm>,<Ra> cond:4|0|0|0|1|0|0|0|0|Rd:4|Ra:4|Rm:4|1|M|N|0|Rn:4
+	{0x0ff000d0, 0x07000010, 0x5011c04, SMLAD_EQ, 2, instArgs{arg_R_16, arg_R_0, arg_R_8, arg_R_12}},              // SMLAD{X}<c> <Rd>,<Rn>,<Rm>,<Ra> cond:4|0|1|1|1|0|0|0|0|Rd:4|Ra:4|Rm:4|0|0|M|1|Rn:4
+	{0x0ff00090, 0x01400080, 0x50106011c04, SMLALBB_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0, arg_R_8}},        // SMLAL<x><y><c> <RdLo>,<RdHi>,<Rn>,<Rm> cond:4|0|0|0|1|0|1|0|0|RdHi:4|RdLo:4|Rm:4|1|M|N|0|Rn:4
+	{0x0ff000d0, 0x07400010, 0x5011c04, SMLALD_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0, arg_R_8}},             // SMLALD{X}<c> <RdLo>,<RdHi>,<Rn>,<Rm> cond:4|0|1|1|1|0|1|0|0|RdHi:4|RdLo:4|Rm:4|0|0|M|1|Rn:4
+	{0x0fe000f0, 0x00e00090, 0x14011c04, SMLAL_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0, arg_R_8}},             // SMLAL{S}<c> <RdLo>,<RdHi>,<Rn>,<Rm> cond:4|0|0|0|0|1|1|1|S|RdHi:4|RdLo:4|Rm:4|1|0|0|1|Rn:4
+	{0x0ff000b0, 0x01200080, 0x6011c04, SMLAWB_EQ, 4, instArgs{arg_R_16, arg_R_0, arg_R_8, arg_R_12}},             // SMLAW<y><c> <Rd>,<Rn>,<Rm>,<Ra> cond:4|0|0|0|1|0|0|1|0|Rd:4|Ra:4|Rm:4|1|M|0|0|Rn:4
+	{0x0ff000d0, 0x07000050, 0x5011c04, SMLSD_EQ, 2, instArgs{arg_R_16, arg_R_0, arg_R_8, arg_R_12}},              // SMLSD{X}<c> <Rd>,<Rn>,<Rm>,<Ra> cond:4|0|1|1|1|0|0|0|0|Rd:4|Ra:4|Rm:4|0|1|M|1|Rn:4
+	{0x0ff000d0, 0x07400050, 0x5011c04, SMLSLD_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0, arg_R_8}},             // SMLSLD{X}<c> <RdLo>,<RdHi>,<Rn>,<Rm> cond:4|0|1|1|1|0|1|0|0|RdHi:4|RdLo:4|Rm:4|0|1|M|1|Rn:4
+	{0x0ff000d0, 0x07500010, 0x5011c04, SMMLA_EQ, 2, instArgs{arg_R_16, arg_R_0, arg_R_8, arg_R_12}},              // SMMLA{R}<c> <Rd>,<Rn>,<Rm>,<Ra> cond:4|0|1|1|1|0|1|0|1|Rd:4|Ra:4|Rm:4|0|0|R|1|Rn:4
+	{0x0ff000d0, 0x075000d0, 0x5011c04, SMMLS_EQ, 4, instArgs{arg_R_16, arg_R_0, arg_R_8, arg_R_12}},              // SMMLS{R}<c> <Rd>,<Rn>,<Rm>,<Ra> cond:4|0|1|1|1|0|1|0|1|Rd:4|Ra:4|Rm:4|1|1|R|1|Rn:4
+	{0x0ff0f0d0, 0x0750f010, 0x5011c04, SMMUL_EQ, 4, instArgs{arg_R_16, arg_R_0, arg_R_8}},                        // SMMUL{R}<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|1|0|1|0|1|Rd:4|1|1|1|1|Rm:4|0|0|R|1|Rn:4
+	{0x0ff0f0d0, 0x0700f010, 0x5011c04, SMUAD_EQ, 4, instArgs{arg_R_16, arg_R_0, arg_R_8}},                        // SMUAD{X}<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|1|0|0|0|0|Rd:4|1|1|1|1|Rm:4|0|0|M|1|Rn:4
+	{0x0ff0f090, 0x01600080, 0x50106011c04, SMULBB_EQ, 4, instArgs{arg_R_16, arg_R_0, arg_R_8}},                   // SMUL<x><y><c> <Rd>,<Rn>,<Rm> cond:4|0|0|0|1|0|1|1|0|Rd:4|0|0|0|0|Rm:4|1|M|N|0|Rn:4
+	{0x0fe000f0, 0x00c00090, 0x14011c04, SMULL_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0, arg_R_8}},             // SMULL{S}<c> <RdLo>,<RdHi>,<Rn>,<Rm> cond:4|0|0|0|0|1|1|0|S|RdHi:4|RdLo:4|Rm:4|1|0|0|1|Rn:4
+	{0x0ff0f0b0, 0x012000a0, 0x6011c04, SMULWB_EQ, 4, instArgs{arg_R_16, arg_R_0, arg_R_8}},                       // SMULW<y><c> <Rd>,<Rn>,<Rm> cond:4|0|0|0|1|0|0|1|0|Rd:4|0|0|0|0|Rm:4|1|M|1|0|Rn:4
+	{0x0ff0f0d0, 0x0700f050, 0x5011c04, SMUSD_EQ, 4, instArgs{arg_R_16, arg_R_0, arg_R_8}},                        // SMUSD{X}<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|1|0|0|0|0|Rd:4|1|1|1|1|Rm:4|0|1|M|1|Rn:4
+	{0x0ff00ff0, 0x06a00f30, 0x1c04, SSAT16_EQ, 4, instArgs{arg_R_12, arg_satimm4m1, arg_R_0}},                    // SSAT16<c> <Rd>,#<sat_imm4m1>,<Rn> cond:4|0|1|1|0|1|0|1|0|sat_imm:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rn:4
+	{0x0ff000f0, 0x06a00f30, 0x1c04, SSAT16_EQ, 3, instArgs{arg_R_12, arg_satimm4m1, arg_R_0}},                    // SSAT16<c> <Rd>,#<sat_imm4m1>,<Rn> cond:4|0|1|1|0|1|0|1|0|sat_imm:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rn:4
+	{0x0fe00030, 0x06a00010, 0x1c04, SSAT_EQ, 4, instArgs{arg_R_12, arg_satimm5m1, arg_R_shift_imm}},              // SSAT<c> <Rd>,#<sat_imm5m1>,<Rn>{,<shift>} cond:4|0|1|1|0|1|0|1|sat_imm:5|Rd:4|imm5:5|sh|0|1|Rn:4
+	{0x0ff00ff0, 0x06100f50, 0x1c04, SSAX_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // SSAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
+	{0x0ff000f0, 0x06100f50, 0x1c04, SSAX_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // SSAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
+	{0x0ff00ff0, 0x06100f70, 0x1c04, SSUB16_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // SSUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
+	{0x0ff000f0, 0x06100f70, 0x1c04, SSUB16_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // SSUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
+	{0x0ff00ff0, 0x06100ff0, 0x1c04, SSUB8_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // SSUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
+	{0x0ff000f0, 0x06100ff0, 0x1c04, SSUB8_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // SSUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
+	{0x0fd00000, 0x08800000, 0x1c04, STM_EQ, 4, instArgs{arg_R_16_WB, arg_registers}},                             // STM<c> <Rn>{!},<registers> cond:4|1|0|0|0|1|0|W|0|Rn:4|register_list:16
+	{0x0fd00000, 0x08000000, 0x1c04, STMDA_EQ, 4, instArgs{arg_R_16_WB, arg_registers}},                           // STMDA<c> <Rn>{!},<registers> cond:4|1|0|0|0|0|0|W|0|Rn:4|register_list:16
+	{0x0fd00000, 0x09000000, 0x1c04, STMDB_EQ, 2, instArgs{arg_R_16_WB, arg_registers}},                           // STMDB<c> <Rn>{!},<registers> cond:4|1|0|0|1|0|0|W|0|Rn:4|register_list:16
+	{0x0fd00000, 0x09800000, 0x1c04, STMIB_EQ, 4, instArgs{arg_R_16_WB, arg_registers}},                           // STMIB<c> <Rn>{!},<registers> cond:4|1|0|0|1|1|0|W|0|Rn:4|register_list:16
+	{0x0e500018, 0x06000000, 0x1c04, STR_EQ, 2, instArgs{arg_R_12, arg_mem_R_pm_R_shift_imm_W}},                   // STR<c> <Rt>,[<Rn>,+/-<Rm>{, <shift>}]{!} cond:4|0|1|1|P|U|0|W|0|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4
+	{0x0e500000, 0x04000000, 0x1c04, STR_EQ, 2, instArgs{arg_R_12, arg_mem_R_pm_imm12_W}},                         // STR<c> <Rt>,[<Rn>{,#+/-<imm12>}]{!} cond:4|0|1|0|P|U|0|W|0|Rn:4|Rt:4|imm12:12
+	{0x0e500010, 0x06400000, 0x1c04, STRB_EQ, 2, instArgs{arg_R_12, arg_mem_R_pm_R_shift_imm_W}},                  // STRB<c> <Rt>,[<Rn>,+/-<Rm>{, <shift>}]{!} cond:4|0|1|1|P|U|1|W|0|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4
+	{0x0e500000, 0x04400000, 0x1c04, STRB_EQ, 2, instArgs{arg_R_12, arg_mem_R_pm_imm12_W}},                        // STRB<c> <Rt>,[<Rn>{,#+/-<imm12>}]{!} cond:4|0|1|0|P|U|1|W|0|Rn:4|Rt:4|imm12:12
+	{0x0f700000, 0x04600000, 0x1c04, STRBT_EQ, 4, instArgs{arg_R_12, arg_mem_R_pm_imm12_postindex}},               // STRBT<c> <Rt>,[<Rn>],#+/-<imm12> cond:4|0|1|0|0|U|1|1|0|Rn:4|Rt:4|imm12:12
+	{0x0f700010, 0x06600000, 0x1c04, STRBT_EQ, 4, instArgs{arg_R_12, arg_mem_R_pm_R_shift_imm_postindex}},         // STRBT<c> <Rt>,[<Rn>],+/-<Rm>{, <shift>} cond:4|0|1|1|0|U|1|1|0|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4
+	{0x0e500ff0, 0x000000f0, 0x1c04, STRD_EQ, 4, instArgs{arg_R1_12, arg_R2_12, arg_mem_R_pm_R_W}},                // STRD<c> <Rt1>,<Rt2>,[<Rn>,+/-<Rm>]{!} cond:4|0|0|0|P|U|0|W|0|Rn:4|Rt:4|(0)|(0)|(0)|(0)|1|1|1|1|Rm:4
+	{0x0e5000f0, 0x000000f0, 0x1c04, STRD_EQ, 3, instArgs{arg_R1_12, arg_R2_12, arg_mem_R_pm_R_W}},                // STRD<c> <Rt1>,<Rt2>,[<Rn>,+/-<Rm>]{!} cond:4|0|0|0|P|U|0|W|0|Rn:4|Rt:4|(0)|(0)|(0)|(0)|1|1|1|1|Rm:4
+	{0x0e5000f0, 0x004000f0, 0x1c04, STRD_EQ, 4, instArgs{arg_R1_12, arg_R2_12, arg_mem_R_pm_imm8_W}},             // STRD<c> <Rt1>,<Rt2>,[<Rn>{,#+/-<imm8>}]{!} cond:4|0|0|0|P|U|1|W|0|Rn:4|Rt:4|imm4H:4|1|1|1|1|imm4L:4
+	{0x0ff00ff0, 0x01800f90, 0x1c04, STREX_EQ, 4, instArgs{arg_R_12, arg_R_0, arg_mem_R}},                         // STREX<c> <Rd>,<Rt>,[<Rn>] cond:4|0|0|0|1|1|0|0|0|Rn:4|Rd:4|1|1|1|1|1|0|0|1|Rt:4
+	{0x0ff00ff0, 0x01c00f90, 0x1c04, STREXB_EQ, 4, instArgs{arg_R_12, arg_R_0, arg_mem_R}},                        // STREXB<c> <Rd>,<Rt>,[<Rn>] cond:4|0|0|0|1|1|1|0|0|Rn:4|Rd:4|1|1|1|1|1|0|0|1|Rt:4
+	{0x0ff00ff0, 0x01a00f90, 0x1c04, STREXD_EQ, 4, instArgs{arg_R_12, arg_R1_0, arg_R2_0, arg_mem_R}},             // STREXD<c> <Rd>,<Rt1>,<Rt2>,[<Rn>] cond:4|0|0|0|1|1|0|1|0|Rn:4|Rd:4|1|1|1|1|1|0|0|1|Rt:4
+	{0x0ff00ff0, 0x01e00f90, 0x1c04, STREXH_EQ, 4, instArgs{arg_R_12, arg_R_0, arg_mem_R}},                        // STREXH<c> <Rd>,<Rt>,[<Rn>] cond:4|0|0|0|1|1|1|1|0|Rn:4|Rd:4|1|1|1|1|1|0|0|1|Rt:4
+	{0x0e500ff0, 0x000000b0, 0x1c04, STRH_EQ, 2, instArgs{arg_R_12, arg_mem_R_pm_R_W}},                            // STRH<c> <Rt>,[<Rn>,+/-<Rm>]{!} cond:4|0|0|0|P|U|0|W|0|Rn:4|Rt:4|0|0|0|0|1|0|1|1|Rm:4
+	{0x0e5000f0, 0x004000b0, 0x1c04, STRH_EQ, 2, instArgs{arg_R_12, arg_mem_R_pm_imm8_W}},                         // STRH<c> <Rt>,[<Rn>{,#+/-<imm8>}]{!} cond:4|0|0|0|P|U|1|W|0|Rn:4|Rt:4|imm4H:4|1|0|1|1|imm4L:4
+	{0x0f7000f0, 0x006000b0, 0x1c04, STRHT_EQ, 4, instArgs{arg_R_12, arg_mem_R_pm_imm8_postindex}},                // STRHT<c> <Rt>, [<Rn>] {,#+/-<imm8>} cond:4|0|0|0|0|U|1|1|0|Rn:4|Rt:4|imm4H:4|1|0|1|1|imm4L:4
+	{0x0f700ff0, 0x002000b0, 0x1c04, STRHT_EQ, 4, instArgs{arg_R_12, arg_mem_R_pm_R_postindex}},                   // STRHT<c> <Rt>, [<Rn>], +/-<Rm> cond:4|0|0|0|0|U|0|1|0|Rn:4|Rt:4|0|0|0|0|1|0|1|1|Rm:4
+	{0x0f700000, 0x04200000, 0x1c04, STRT_EQ, 4, instArgs{arg_R_12, arg_mem_R_pm_imm12_postindex}},                // STRT<c> <Rt>, [<Rn>] {,#+/-<imm12>} cond:4|0|1|0|0|U|0|1|0|Rn:4|Rt:4|imm12:12
+	{0x0f700010, 0x06200000, 0x1c04, STRT_EQ, 4, instArgs{arg_R_12, arg_mem_R_pm_R_shift_imm_postindex}},          // STRT<c> <Rt>,[<Rn>],+/-<Rm>{, <shift>} cond:4|0|1|1|0|U|0|1|0|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4
+	{0x0fe00000, 0x02400000, 0x14011c04, SUB_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_const}},                      // SUB{S}<c> <Rd>,<Rn>,#<const> cond:4|0|0|1|0|0|1|0|S|Rn:4|Rd:4|imm12:12
+	{0x0fe00090, 0x00400010, 0x14011c04, SUB_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_shift_R}},                  // SUB{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|0|0|1|0|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4
+	{0x0fe00010, 0x00400000, 0x14011c04, SUB_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_R_shift_imm}},                // SUB{S}<c> <Rd>,<Rn>,<Rm>{,<shift>} cond:4|0|0|0|0|0|1|0|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4
+	{0x0fef0000, 0x024d0000, 0x14011c04, SUB_EQ, 2, instArgs{arg_R_12, arg_SP, arg_const}},                        // SUB{S}<c> <Rd>,SP,#<const> cond:4|0|0|1|0|0|1|0|S|1|1|0|1|Rd:4|imm12:12
+	{0x0fef0010, 0x004d0000, 0x14011c04, SUB_EQ, 2, instArgs{arg_R_12, arg_SP, arg_R_shift_imm}},                  // SUB{S}<c> <Rd>,SP,<Rm>{,<shift>} cond:4|0|0|0|0|0|1|0|S|1|1|0|1|Rd:4|imm5:5|type:2|0|Rm:4
+	{0x0f000000, 0x0f000000, 0x1c04, SVC_EQ, 4, instArgs{arg_imm24}},                                              // SVC<c> #<imm24> cond:4|1|1|1|1|imm24:24
+	{0x0fb00ff0, 0x01000090, 0x16011c04, SWP_EQ, 4, instArgs{arg_R_12, arg_R_0, arg_mem_R}},                       // SWP{B}<c> <Rt>,<Rm>,[<Rn>] cond:4|0|0|0|1|0|B|0|0|Rn:4|Rt:4|0|0|0|0|1|0|0|1|Rm:4
+	{0x0ff003f0, 0x06800070, 0x1c04, SXTAB16_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_R_rotate}},                   // SXTAB16<c> <Rd>,<Rn>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|0|0|0|Rn:4|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
+	{0x0ff003f0, 0x06a00070, 0x1c04, SXTAB_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_R_rotate}},                     // SXTAB<c> <Rd>,<Rn>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|0|1|0|Rn:4|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
+	{0x0ff003f0, 0x06b00070, 0x1c04, SXTAH_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_R_rotate}},                     // SXTAH<c> <Rd>,<Rn>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|0|1|1|Rn:4|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
+	{0x0fff03f0, 0x068f0070, 0x1c04, SXTB16_EQ, 4, instArgs{arg_R_12, arg_R_rotate}},                              // SXTB16<c> <Rd>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|0|0|0|1|1|1|1|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
+	{0x0fff03f0, 0x06af0070, 0x1c04, SXTB_EQ, 4, instArgs{arg_R_12, arg_R_rotate}},                                // SXTB<c> <Rd>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|0|1|0|1|1|1|1|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
+	{0x0fff03f0, 0x06bf0070, 0x1c04, SXTH_EQ, 4, instArgs{arg_R_12, arg_R_rotate}},                                // SXTH<c> <Rd>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|0|1|1|1|1|1|1|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
+	{0x0ff0f000, 0x03300000, 0x1c04, TEQ_EQ, 4, instArgs{arg_R_16, arg_const}},                                    // TEQ<c> <Rn>,#<const> cond:4|0|0|1|1|0|0|1|1|Rn:4|(0)|(0)|(0)|(0)|imm12:12
+	{0x0ff00000, 0x03300000, 0x1c04, TEQ_EQ, 3, instArgs{arg_R_16, arg_const}},                                    // TEQ<c> <Rn>,#<const> cond:4|0|0|1|1|0|0|1|1|Rn:4|(0)|(0)|(0)|(0)|imm12:12
+	{0x0ff0f090, 0x01300010, 0x1c04, TEQ_EQ, 4, instArgs{arg_R_16, arg_R_shift_R}},                                // TEQ<c> <Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|1|0|0|1|1|Rn:4|(0)|(0)|(0)|(0)|Rs:4|0|type:2|1|Rm:4
+	{0x0ff00090, 0x01300010, 0x1c04, TEQ_EQ, 3, instArgs{arg_R_16, arg_R_shift_R}},                                // TEQ<c> <Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|1|0|0|1|1|Rn:4|(0)|(0)|(0)|(0)|Rs:4|0|type:2|1|Rm:4
+	{0x0ff0f010, 0x01300000, 0x1c04, TEQ_EQ, 4, instArgs{arg_R_16, arg_R_shift_imm}},                              // TEQ<c> <Rn>,<Rm>{,<shift>} cond:4|0|0|0|1|0|0|1|1|Rn:4|(0)|(0)|(0)|(0)|imm5:5|type:2|0|Rm:4
+	{0x0ff00010, 0x01300000, 0x1c04, TEQ_EQ, 3, instArgs{arg_R_16, arg_R_shift_imm}},                              // TEQ<c> <Rn>,<Rm>{,<shift>} cond:4|0|0|0|1|0|0|1|1|Rn:4|(0)|(0)|(0)|(0)|imm5:5|type:2|0|Rm:4
+	{0x0ff0f000, 0x03100000, 0x1c04, TST_EQ, 4, instArgs{arg_R_16, arg_const}},                                    // TST<c> <Rn>,#<const> cond:4|0|0|1|1|0|0|0|1|Rn:4|(0)|(0)|(0)|(0)|imm12:12
+	{0x0ff00000, 0x03100000, 0x1c04, TST_EQ, 3, instArgs{arg_R_16, arg_const}},                                    // TST<c> <Rn>,#<const> cond:4|0|0|1|1|0|0|0|1|Rn:4|(0)|(0)|(0)|(0)|imm12:12
+	{0x0ff0f090, 0x01100010, 0x1c04, TST_EQ, 4, instArgs{arg_R_16, arg_R_shift_R}},                                // TST<c> <Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|1|0|0|0|1|Rn:4|(0)|(0)|(0)|(0)|Rs:4|0|type:2|1|Rm:4
+	{0x0ff00090, 0x01100010, 0x1c04, TST_EQ, 3, instArgs{arg_R_16, arg_R_shift_R}},                                // TST<c> <Rn>,<Rm>,<type> <Rs> cond:4|0|0|0|1|0|0|0|1|Rn:4|(0)|(0)|(0)|(0)|Rs:4|0|type:2|1|Rm:4
+	{0x0ff0f010, 0x01100000, 0x1c04, TST_EQ, 4, instArgs{arg_R_16, arg_R_shift_imm}},                              // TST<c> <Rn>,<Rm>{,<shift>} cond:4|0|0|0|1|0|0|0|1|Rn:4|(0)|(0)|(0)|(0)|imm5:5|type:2|0|Rm:4
+	{0x0ff00010, 0x01100000, 0x1c04, TST_EQ, 3, instArgs{arg_R_16, arg_R_shift_imm}},                              // TST<c> <Rn>,<Rm>{,<shift>} cond:4|0|0|0|1|0|0|0|1|Rn:4|(0)|(0)|(0)|(0)|imm5:5|type:2|0|Rm:4
+	{0x0ff00ff0, 0x06500f10, 0x1c04, UADD16_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // UADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
+	{0x0ff000f0, 0x06500f10, 0x1c04, UADD16_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // UADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
+	{0x0ff00ff0, 0x06500f90, 0x1c04, UADD8_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // UADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
+	{0x0ff000f0, 0x06500f90, 0x1c04, UADD8_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // UADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
+	{0x0ff00ff0, 0x06500f30, 0x1c04, UASX_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // UASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
+	{0x0ff000f0, 0x06500f30, 0x1c04, UASX_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // UASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
+	{0x0fe00070, 0x07e00050, 0x1c04, UBFX_EQ, 4, instArgs{arg_R_12, arg_R_0, arg_imm5, arg_widthm1}},              // UBFX<c> <Rd>,<Rn>,#<lsb>,#<widthm1> cond:4|0|1|1|1|1|1|1|widthm1:5|Rd:4|lsb:5|1|0|1|Rn:4
+	{0x0ff00ff0, 0x06700f10, 0x1c04, UHADD16_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // UHADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
+	{0x0ff000f0, 0x06700f10, 0x1c04, UHADD16_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // UHADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
+	{0x0ff00ff0, 0x06700f90, 0x1c04, UHADD8_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // UHADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
+	{0x0ff000f0, 0x06700f90, 0x1c04, UHADD8_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // UHADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
+	{0x0ff00ff0, 0x06700f30, 0x1c04, UHASX_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // UHASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
+	{0x0ff000f0, 0x06700f30, 0x1c04, UHASX_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // UHASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
+	{0x0ff00ff0, 0x06700f50, 0x1c04, UHSAX_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // UHSAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
+	{0x0ff000f0, 0x06700f50, 0x1c04, UHSAX_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // UHSAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
+	{0x0ff00ff0, 0x06700f70, 0x1c04, UHSUB16_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // UHSUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
+	{0x0ff000f0, 0x06700f70, 0x1c04, UHSUB16_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // UHSUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
+	{0x0ff00ff0, 0x06700ff0, 0x1c04, UHSUB8_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // UHSUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
+	{0x0ff000f0, 0x06700ff0, 0x1c04, UHSUB8_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // UHSUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
+	{0x0ff000f0, 0x00400090, 0x1c04, UMAAL_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0, arg_R_8}},                 // UMAAL<c> <RdLo>,<RdHi>,<Rn>,<Rm> cond:4|0|0|0|0|0|1|0|0|RdHi:4|RdLo:4|Rm:4|1|0|0|1|Rn:4
+	{0x0fe000f0, 0x00a00090, 0x14011c04, UMLAL_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0, arg_R_8}},             // UMLAL{S}<c> <RdLo>,<RdHi>,<Rn>,<Rm> cond:4|0|0|0|0|1|0|1|S|RdHi:4|RdLo:4|Rm:4|1|0|0|1|Rn:4
+	{0x0fe000f0, 0x00800090, 0x14011c04, UMULL_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0, arg_R_8}},             // UMULL{S}<c> <RdLo>,<RdHi>,<Rn>,<Rm> cond:4|0|0|0|0|1|0|0|S|RdHi:4|RdLo:4|Rm:4|1|0|0|1|Rn:4
+	{0x0ff00ff0, 0x06600f10, 0x1c04, UQADD16_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // UQADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
+	{0x0ff000f0, 0x06600f10, 0x1c04, UQADD16_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // UQADD16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4
+	{0x0ff00ff0, 0x06600f90, 0x1c04, UQADD8_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // UQADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
+	{0x0ff000f0, 0x06600f90, 0x1c04, UQADD8_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // UQADD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4
+	{0x0ff00ff0, 0x06600f30, 0x1c04, UQASX_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // UQASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
+	{0x0ff000f0, 0x06600f30, 0x1c04, UQASX_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // UQASX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4
+	{0x0ff00ff0, 0x06600f50, 0x1c04, UQSAX_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // UQSAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
+	{0x0ff000f0, 0x06600f50, 0x1c04, UQSAX_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // UQSAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
+	{0x0ff00ff0, 0x06600f70, 0x1c04, UQSUB16_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // UQSUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
+	{0x0ff000f0, 0x06600f70, 0x1c04, UQSUB16_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                        // UQSUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
+	{0x0ff00ff0, 0x06600ff0, 0x1c04, UQSUB8_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // UQSUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
+	{0x0ff000f0, 0x06600ff0, 0x1c04, UQSUB8_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // UQSUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
+	{0x0ff0f0f0, 0x0780f010, 0x1c04, USAD8_EQ, 4, instArgs{arg_R_16, arg_R_0, arg_R_8}},                           // USAD8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|1|1|0|0|0|Rd:4|1|1|1|1|Rm:4|0|0|0|1|Rn:4
+	{0x0ff000f0, 0x07800010, 0x1c04, USADA8_EQ, 2, instArgs{arg_R_16, arg_R_0, arg_R_8, arg_R_12}},                // USADA8<c> <Rd>,<Rn>,<Rm>,<Ra> cond:4|0|1|1|1|1|0|0|0|Rd:4|Ra:4|Rm:4|0|0|0|1|Rn:4
+	{0x0ff00ff0, 0x06e00f30, 0x1c04, USAT16_EQ, 4, instArgs{arg_R_12, arg_satimm4, arg_R_0}},                      // USAT16<c> <Rd>,#<sat_imm4>,<Rn> cond:4|0|1|1|0|1|1|1|0|sat_imm:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rn:4
+	{0x0ff000f0, 0x06e00f30, 0x1c04, USAT16_EQ, 3, instArgs{arg_R_12, arg_satimm4, arg_R_0}},                      // USAT16<c> <Rd>,#<sat_imm4>,<Rn> cond:4|0|1|1|0|1|1|1|0|sat_imm:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rn:4
+	{0x0fe00030, 0x06e00010, 0x1c04, USAT_EQ, 4, instArgs{arg_R_12, arg_satimm5, arg_R_shift_imm}},                // USAT<c> <Rd>,#<sat_imm5>,<Rn>{,<shift>} cond:4|0|1|1|0|1|1|1|sat_imm:5|Rd:4|imm5:5|sh|0|1|Rn:4
+	{0x0ff00ff0, 0x06500f50, 0x1c04, USAX_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // USAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
+	{0x0ff000f0, 0x06500f50, 0x1c04, USAX_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                           // USAX<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4
+	{0x0ff00ff0, 0x06500f70, 0x1c04, USUB16_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // USUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
+	{0x0ff000f0, 0x06500f70, 0x1c04, USUB16_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                         // USUB16<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4
+	{0x0ff00ff0, 0x06500ff0, 0x1c04, USUB8_EQ, 4, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // USUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
+	{0x0ff000f0, 0x06500ff0, 0x1c04, USUB8_EQ, 3, instArgs{arg_R_12, arg_R_16, arg_R_0}},                          // USUB8<c> <Rd>,<Rn>,<Rm> cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4
+	{0x0ff003f0, 0x06c00070, 0x1c04, UXTAB16_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_R_rotate}},                   // UXTAB16<c> <Rd>,<Rn>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|1|0|0|Rn:4|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
+	{0x0ff003f0, 0x06e00070, 0x1c04, UXTAB_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_R_rotate}},                     // UXTAB<c> <Rd>,<Rn>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|1|1|0|Rn:4|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
+	{0x0ff003f0, 0x06f00070, 0x1c04, UXTAH_EQ, 2, instArgs{arg_R_12, arg_R_16, arg_R_rotate}},                     // UXTAH<c> <Rd>,<Rn>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|1|1|1|Rn:4|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
+	{0x0fff03f0, 0x06cf0070, 0x1c04, UXTB16_EQ, 4, instArgs{arg_R_12, arg_R_rotate}},                              // UXTB16<c> <Rd>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|1|0|0|1|1|1|1|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
+	{0x0fff03f0, 0x06ef0070, 0x1c04, UXTB_EQ, 4, instArgs{arg_R_12, arg_R_rotate}},                                // UXTB<c> <Rd>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|1|1|0|1|1|1|1|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
+	{0x0fff03f0, 0x06ff0070, 0x1c04, UXTH_EQ, 4, instArgs{arg_R_12, arg_R_rotate}},                                // UXTH<c> <Rd>,<Rm>{,<rotation>} cond:4|0|1|1|0|1|1|1|1|1|1|1|1|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4
+	{0x0fb00e10, 0x0e000a00, 0x60108011c04, VMLA_EQ_F32, 4, instArgs{arg_Sd_Dd, arg_Sn_Dn, arg_Sm_Dm}},            // V<MLA,MLS><c>.F<32,64> <Sd,Dd>, <Sn,Dn>, <Sm,Dm> cond:4|1|1|1|0|0|D|0|0|Vn:4|Vd:4|1|0|1|sz|N|op|M|0|Vm:4
+	{0x0fbf0ed0, 0x0eb00ac0, 0x8011c04, VABS_EQ_F32, 4, instArgs{arg_Sd_Dd, arg_Sm_Dm}},                           // VABS<c>.F<32,64> <Sd,Dd>, <Sm,Dm> cond:4|1|1|1|0|1|D|1|1|0|0|0|0|Vd:4|1|0|1|sz|1|1|M|0|Vm:4
+	{0x0fb00e50, 0x0e300a00, 0x8011c04, VADD_EQ_F32, 4, instArgs{arg_Sd_Dd, arg_Sn_Dn, arg_Sm_Dm}},                // VADD<c>.F<32,64> <Sd,Dd>, <Sn,Dn>, <Sm,Dm> cond:4|1|1|1|0|0|D|1|1|Vn:4|Vd:4|1|0|1|sz|N|0|M|0|Vm:4
+	{0x0fbf0e7f, 0x0eb50a40, 0x70108011c04, VCMP_EQ_F32, 4, instArgs{arg_Sd_Dd, arg_fp_0}},                        // VCMP{E}<c>.F<32,64> <Sd,Dd>, #0.0 cond:4|1|1|1|0|1|D|1|1|0|1|0|1|Vd:4|1|0|1|sz|E|1|0|0|(0)|(0)|(0)|(0)
+	{0x0fbf0e70, 0x0eb50a40, 0x70108011c04, VCMP_EQ_F32, 3, instArgs{arg_Sd_Dd, arg_fp_0}},                        // VCMP{E}<c>.F<32,64> <Sd,Dd>, #0.0 cond:4|1|1|1|0|1|D|1|1|0|1|0|1|Vd:4|1|0|1|sz|E|1|0|0|(0)|(0)|(0)|(0)
+	{0x0fbf0e50, 0x0eb40a40, 0x70108011c04, VCMP_EQ_F32, 4, instArgs{arg_Sd_Dd, arg_Sm_Dm}},                       // VCMP{E}<c>.F<32,64> <Sd,Dd>, <Sm,Dm> cond:4|1|1|1|0|1|D|1|1|0|1|0|0|Vd:4|1|0|1|sz|E|1|M|0|Vm:4
+	{0x0fbe0e50, 0x0eba0a40, 0x801100107011c04, VCVT_EQ_F32_FXS16, 4, instArgs{arg_Sd_Dd, arg_Sd_Dd, arg_fbits}},  // VCVT<c>.F<32,64>.FX<S,U><16,32> <Sd,Dd>, <Sd,Dd>, #<fbits> cond:4|1|1|1|0|1|D|1|1|1|0|1|U|Vd:4|1|0|1|sz|sx|1|i|0|imm4:4
+	{0x0fbe0e50, 0x0ebe0a40, 0x1001070108011c04, VCVT_EQ_FXS16_F32, 4, instArgs{arg_Sd_Dd, arg_Sd_Dd, arg_fbits}}, // VCVT<c>.FX<S,U><16,32>.F<32,64> <Sd,Dd>, <Sd,Dd>, #<fbits> cond:4|1|1|1|0|1|D|1|1|1|1|1|U|Vd:4|1|0|1|sz|sx|1|i|0|imm4:4
+	{0x0fbf0ed0, 0x0eb70ac0, 0x8011c04, VCVT_EQ_F64_F32, 4, instArgs{arg_Dd_Sd, arg_Sm_Dm}},                       // VCVT<c>.<F64.F32,F32.F64> <Dd,Sd>, <Sm,Dm> cond:4|1|1|1|0|1|D|1|1|0|1|1|1|Vd:4|1|0|1|sz|1|1|M|0|Vm:4
+	{0x0fbe0f50, 0x0eb20a40, 0x70110011c04, VCVTB_EQ_F32_F16, 4, instArgs{arg_Sd, arg_Sm}},                        // VCVT<B,T><c>.<F32.F16,F16.F32> <Sd>, <Sm> cond:4|1|1|1|0|1|D|1|1|0|0|1|op|Vd:4|1|0|1|0|T|1|M|0|Vm:4
+	{0x0fbf0e50, 0x0eb80a40, 0x80107011c04, VCVT_EQ_F32_U32, 4, instArgs{arg_Sd_Dd, arg_Sm}},                      // VCVT<c>.F<32,64>.<U,S>32 <Sd,Dd>, <Sm> cond:4|1|1|1|0|1|D|1|1|1|0|0|0|Vd:4|1|0|1|sz|op|1|M|0|Vm:4
+	{0x0fbe0e50, 0x0ebc0a40, 0x701100108011c04, VCVTR_EQ_U32_F32, 4, instArgs{arg_Sd, arg_Sm_Dm}},                 // VCVT<R,><c>.<U,S>32.F<32,64> <Sd>, <Sm,Dm> cond:4|1|1|1|0|1|D|1|1|1|1|0|signed|Vd:4|1|0|1|sz|op|1|M|0|Vm:4
+	{0x0fb00e50, 0x0e800a00, 0x8011c04, VDIV_EQ_F32, 4, instArgs{arg_Sd_Dd, arg_Sn_Dn, arg_Sm_Dm}},                // VDIV<c>.F<32,64> <Sd,Dd>, <Sn,Dn>, <Sm,Dm> cond:4|1|1|1|0|1|D|0|0|Vn:4|Vd:4|1|0|1|sz|N|0|M|0|Vm:4
+	{0x0f900f00, 0x0c900a00, 0x1c04, VLDMIA_EQ, 2, instArgs{arg_R_16_WB, arg_vlist32}},                            // VLDMIA<c> <Rn>{!}, <vlist32> cond:4|1|1|0|0|1|D|W|1|Rn:4|Vd:4|1|0|1|0|imm8:8
+	{0x0f900f00, 0x0c900b00, 0x1c04, VLDMIA_EQ, 2, instArgs{arg_R_16_WB, arg_vlist64}},                            // VLDMIA<c> <Rn>{!}, <vlist64> cond:4|1|1|0|0|1|D|W|1|Rn:4|Vd:4|1|0|1|1|imm8:8
+	{0x0fb00f00, 0x0d300a00, 0x1c04, VLDMDB_EQ, 4, instArgs{arg_R_16_WB, arg_vlist32}},                            // VLDMDB<c> <Rn>{!}, <vlist32> cond:4|1|1|0|1|0|D|W|1|Rn:4|Vd:4|1|0|1|0|imm8:8
+	{0x0fb00f00, 0x0d300b00, 0x1c04, VLDMDB_EQ, 4, instArgs{arg_R_16_WB, arg_vlist64}},                            // VLDMDB<c> <Rn>{!}, <vlist64> cond:4|1|1|0|1|0|D|W|1|Rn:4|Vd:4|1|0|1|1|imm8:8
+	{0x0f300e00, 0x0d100a00, 0x1c04, VLDR_EQ, 4, instArgs{arg_Sd_Dd, arg_mem_R_pm_imm8at0_offset}},                // VLDR<c> <Sd,Dd>, [<Rn>{,#+/-<imm8>}] cond:4|1|1|0|1|U|D|0|1|Rn:4|Vd:4|1|0|1|sz|imm8:8
+	{0x0ff00f7f, 0x0e000a10, 0x1c04, VMOV_EQ, 4, instArgs{arg_Sn, arg_R_12}},                                      // VMOV<c> <Sn>, <Rt> cond:4|1|1|1|0|0|0|0|0|Vn:4|Rt:4|1|0|1|0|N|0|0|1|0|0|0|0
+	{0x0ff00f7f, 0x0e100a10, 0x1c04, VMOV_EQ, 4, instArgs{arg_R_12, arg_Sn}},                                      // VMOV<c> <Rt>, <Sn> cond:4|1|1|1|0|0|0|0|1|Vn:4|Rt:4|1|0|1|0|N|0|0|1|0|0|0|0
+	{0x0fd00f7f, 0x0e100b10, 0x1c04, VMOV_EQ_32, 4, instArgs{arg_R_12, arg_Dn_half}},                              // VMOV<c>.32 <Rt>, <Dn[x]> cond:4|1|1|1|0|0|0|opc1|1|Vn:4|Rt:4|1|0|1|1|N|0|0|1|0|0|0|0
+	{0x0fd00f7f, 0x0e000b10, 0x1c04, VMOV_EQ_32, 4, instArgs{arg_Dn_half, arg_R_12}},                              // VMOV<c>.32 <Dd[x]>, <Rt> cond:4|1|1|1|0|0|0|opc1|0|Vd:4|Rt:4|1|0|1|1|D|0|0|1|0|0|0|0
+	{0x0fb00ef0, 0x0eb00a00, 0x8011c04, VMOV_EQ_F32, 4, instArgs{arg_Sd_Dd, arg_imm_vfp}},                         // VMOV<c>.F<32,64> <Sd,Dd>, #<imm_vfp> cond:4|1|1|1|0|1|D|1|1|imm4H:4|Vd:4|1|0|1|sz|0|0|0|0|imm4L:4
+	{0x0fbf0ed0, 0x0eb00a40, 0x8011c04, VMOV_EQ_F32, 4, instArgs{arg_Sd_Dd, arg_Sm_Dm}},                           // VMOV<c>.F<32,64> <Sd,Dd>, <Sm,Dm> cond:4|1|1|1|0|1|D|1|1|0|0|0|0|Vd:4|1|0|1|sz|0|1|M|0|Vm:4
+	{0x0fff0fff, 0x0ef10a10, 0x1c04, VMRS_EQ, 4, instArgs{arg_R_12_nzcv, arg_FPSCR}},                              // VMRS<c> <Rt_nzcv>, FPSCR cond:4|1|1|1|0|1|1|1|1|0|0|0|1|Rt:4|1|0|1|0|0|0|0|1|0|0|0|0
+	{0x0ff00fff, 0x0ef00a10, 0x1c04, VMRS_EQ, 4, instArgs{arg_R_12, arg_spec_reg}},                                // VMRS<c> <Rt>, <spec_reg> cond:4|1|1|1|0|1|1|1|1|reg:4|Rt:4|1|0|1|0|0|0|0|1|0|0|0|0
+	{0x0fff0fff, 0x0ee10a10, 0x1c04, VMSR_EQ, 4, instArgs{arg_FPSCR, arg_R_12}},                                   // VMSR<c> FPSCR, <Rt> cond:4|1|1|1|0|1|1|1|0|0|0|0|1|Rt:4|1|0|1|0|0|0|0|1|0|0|0|0
+	{0x0ff00fff, 0x0ee00a10, 0x1c04, VMSR_EQ, 4, instArgs{arg_spec_reg_msr, arg_R_12}},                            // VMSR<c> <spec_reg_msr>, <Rt> cond:4|1|1|1|0|1|1|1|0|reg:4|Rt:4|1|0|1|0|0|0|0|1|0|0|0|0
+	{0x0fb00e50, 0x0e200a00, 0x8011c04, VMUL_EQ_F32, 4, instArgs{arg_Sd_Dd, arg_Sn_Dn, arg_Sm_Dm}},                // VMUL<c>.F<32,64> <Sd,Dd>, <Sn,Dn>, <Sm,Dm> cond:4|1|1|1|0|0|D|1|0|Vn:4|Vd:4|1|0|1|sz|N|0|M|0|Vm:4
+	{0x0fbf0ed0, 0x0eb10a40, 0x8011c04, VNEG_EQ_F32, 4, instArgs{arg_Sd_Dd, arg_Sm_Dm}},                           // VNEG<c>.F<32,64> <Sd,Dd>, <Sm,Dm> cond:4|1|1|1|0|1|D|1|1|0|0|0|1|Vd:4|1|0|1|sz|0|1|M|0|Vm:4
+	{0x0fb00e10, 0x0e100a00, 0x60108011c04, VNMLS_EQ_F32, 4, instArgs{arg_Sd_Dd, arg_Sn_Dn, arg_Sm_Dm}},           // VN<MLS,MLA><c>.F<32,64> <Sd,Dd>, <Sn,Dn>, <Sm,Dm> cond:4|1|1|1|0|0|D|0|1|Vn:4|Vd:4|1|0|1|sz|N|op|M|0|Vm:4
+	{0x0fb00e50, 0x0e200a40, 0x8011c04, VNMUL_EQ_F32, 4, instArgs{arg_Sd_Dd, arg_Sn_Dn, arg_Sm_Dm}},               // VNMUL<c>.F<32,64> <Sd,Dd>, <Sn,Dn>, <Sm,Dm> cond:4|1|1|1|0|0|D|1|0|Vn:4|Vd:4|1|0|1|sz|N|1|M|0|Vm:4
+	{0x0fbf0f00, 0x0cbd0a00, 0x1c04, VPOP_EQ, 4, instArgs{arg_vlist32}},                                           // VPOP<c> <vlist32> cond:4|1|1|0|0|1|D|1|1|1|1|0|1|Vd:4|1|0|1|0|imm8:8
+	{0x0fbf0f00, 0x0cbd0b00, 0x1c04, VPOP_EQ, 4, instArgs{arg_vlist64}},                                           // VPOP<c> <vlist64> cond:4|1|1|0|0|1|D|1|1|1|1|0|1|Vd:4|1|0|1|1|imm8:8
+	{0x0fbf0f00, 0x0d2d0a00, 0x1c04, VPUSH_EQ, 4, instArgs{arg_vlist32}},                                          // VPUSH<c> <vlist32> cond:4|1|1|0|1|0|D|1|0|1|1|0|1|Vd:4|1|0|1|0|imm8:8
+	{0x0fbf0f00, 0x0d2d0b00, 0x1c04, VPUSH_EQ, 4, instArgs{arg_vlist64}},                                          // VPUSH<c> <vlist64> cond:4|1|1|0|1|0|D|1|0|1|1|0|1|Vd:4|1|0|1|1|imm8:8
+	{0x0fbf0ed0, 0x0eb10ac0, 0x8011c04, VSQRT_EQ_F32, 4, instArgs{arg_Sd_Dd, arg_Sm_Dm}},                          // VSQRT<c>.F<32,64> <Sd,Dd>, <Sm,Dm> cond:4|1|1|1|0|1|D|1|1|0|0|0|1|Vd:4|1|0|1|sz|1|1|M|0|Vm:4
+	{0x0f900f00, 0x0c800a00, 0x1c04, VSTMIA_EQ, 4, instArgs{arg_R_16_WB, arg_vlist32}},                            // VSTMIA<c> <Rn>{!}, <vlist32> cond:4|1|1|0|0|1|D|W|0|Rn:4|Vd:4|1|0|1|0|imm8:8
+	{0x0f900f00, 0x0c800b00, 0x1c04, VSTMIA_EQ, 4, instArgs{arg_R_16_WB, arg_vlist64}},                            // VSTMIA<c> <Rn>{!}, <vlist64> cond:4|1|1|0|0|1|D|W|0|Rn:4|Vd:4|1|0|1|1|imm8:8
+	{0x0fb00f00, 0x0d200a00, 0x1c04, VSTMDB_EQ, 2, instArgs{arg_R_16_WB, arg_vlist32}},                            // VSTMDB<c> <Rn>{!}, <vlist32> cond:4|1|1|0|1|0|D|W|0|Rn:4|Vd:4|1|0|1|0|imm8:8
+	{0x0fb00f00, 0x0d200b00, 0x1c04, VSTMDB_EQ, 2, instArgs{arg_R_16_WB, arg_vlist64}},                            // VSTMDB<c> <Rn>{!}, <vlist64> cond:4|1|1|0|1|0|D|W|0|Rn:4|Vd:4|1|0|1|1|imm8:8
+	{0x0f300e00, 0x0d000a00, 0x1c04, VSTR_EQ, 4, instArgs{arg_Sd_Dd, arg_mem_R_pm_imm8at0_offset}},                // VSTR<c> <Sd,Dd>, [<Rn>{,#+/-<imm8>}] cond:4|1|1|0|1|U|D|0|0|Rn:4|Vd:4|1|0|1|sz|imm8:8
+	{0x0fb00e50, 0x0e300a40, 0x8011c04, VSUB_EQ_F32, 4, instArgs{arg_Sd_Dd, arg_Sn_Dn, arg_Sm_Dm}},                // VSUB<c>.F<32,64> <Sd,Dd>, <Sn,Dn>, <Sm,Dm> cond:4|1|1|1|0|0|D|1|1|Vn:4|Vd:4|1|0|1|sz|N|1|M|0|Vm:4
+	{0x0fffffff, 0x0320f002, 0x1c04, WFE_EQ, 4, instArgs{}},                                                       // WFE<c> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|0|1|0
+	{0x0fff00ff, 0x0320f002, 0x1c04, WFE_EQ, 3, instArgs{}},                                                       // WFE<c> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|0|1|0
+	{0x0fffffff, 0x0320f003, 0x1c04, WFI_EQ, 4, instArgs{}},                                                       // WFI<c> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|0|1|1
+	{0x0fff00ff, 0x0320f003, 0x1c04, WFI_EQ, 3, instArgs{}},                                                       // WFI<c> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|0|1|1
+	{0x0fffffff, 0x0320f001, 0x1c04, YIELD_EQ, 4, instArgs{}},                                                     // YIELD<c> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|0|0|1
+	{0x0fff00ff, 0x0320f001, 0x1c04, YIELD_EQ, 3, instArgs{}},                                                     // YIELD<c> cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|0|0|1
+	{0xffffffff, 0xf7fabcfd, 0x0, UNDEF, 4, instArgs{}},                                                           // UNDEF 1|1|1|1|0|1|1|1|1|1|1|1|1|0|1|0|1|0|1|1|1|1|0|0|1|1|1|1|1|1|0|1
+}
+
+// instSyntaxData holds the assembly syntax and encoding bit layout
+// of the instFormats entries. The syntax of entry i is
+// instSyntaxData[x[0]:x[1]] and its layout is instSyntaxData[x[2]:x[3]],
+// where x is instSyntaxIndex[i].
+const instSyntaxData = "" +
+	"ADC{S}<c> <Rd>,<Rn>,#<const>" +
+	"cond:4|0|0|1|0|1|0|1|S|Rn:4|Rd:4|imm12:12" +
+	"ADC{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs>" +
+	"cond:4|0|0|0|0|1|0|1|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4" +
+	"ADC{S}<c> <Rd>,<Rn>,<Rm>{,<shift>}" +
+	"cond:4|0|0|0|0|1|0|1|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4" +
+	"ADD{S}<c> <Rd>,<Rn>,#<const>" +
+	"cond:4|0|0|1|0|1|0|0|S|Rn:4|Rd:4|imm12:12" +
+	"ADD{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs>" +
+	"cond:4|0|0|0|0|1|0|0|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4" +
+	"ADD{S}<c> <Rd>,<Rn>,<Rm>{,<shift>}" +
+	"cond:4|0|0|0|0|1|0|0|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4" +
+	"ADD{S}<c> <Rd>,SP,#<const>" +
+	"cond:4|0|0|1|0|1|0|0|S|1|1|0|1|Rd:4|imm12:12" +
+	"ADD{S}<c> <Rd>,SP,<Rm>{,<shift>}" +
+	"cond:4|0|0|0|0|1|0|0|S|1|1|0|1|Rd:4|imm5:5|type:2|0|Rm:4" +
+	"AND{S}<c> <Rd>,<Rn>,#<const>" +
+	"cond:4|0|0|1|0|0|0|0|S|Rn:4|Rd:4|imm12:12" +
+	"AND{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs>" +
+	"cond:4|0|0|0|0|0|0|0|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4" +
+	"AND{S}<c> <Rd>,<Rn>,<Rm>{,<shift>}" +
+	"cond:4|0|0|0|0|0|0|0|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4" +
+	"ASR{S}<c> <Rd>,<Rm>,#<imm5_32>" +
+	"cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|imm5:5|1|0|0|Rm:4" +
+	"ASR{S}<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|Rm:4|0|1|0|1|Rn:4" +
+	"B<c> <label24>cond:4|1|0|1|0|imm24:24BFC<c> <Rd>,#<lsb>,#<width>" +
+	"cond:4|0|1|1|1|1|1|0|msb:5|Rd:4|lsb:5|0|0|1|1|1|1|1" +
+	"BFI<c> <Rd>,<Rn>,#<lsb>,#<width>" +
+	"cond:4|0|1|1|1|1|1|0|msb:5|Rd:4|lsb:5|0|0|1|Rn:4" +
+	"BIC{S}<c> <Rd>,<Rn>,#<const>" +
+	"cond:4|0|0|1|1|1|1|0|S|Rn:4|Rd:4|imm12:12" +
+	"BIC{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs>" +
+	"cond:4|0|0|0|1|1|1|0|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4" +
+	"BIC{S}<c> <Rd>,<Rn>,<Rm>{,<shift>}" +
+	"cond:4|0|0|0|1|1|1|0|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4" +
+	"BKPT<c> #<imm12+4>cond:4|0|0|0|1|0|0|1|0|imm12:12|0|1|1|1|imm4:4" +
+	"BL<c> <label24>cond:4|1|0|1|1|imm24:24BLX <label24H>" +
+	"1|1|1|1|1|0|1|H|imm24:24BLX<c> <Rm>" +
+	"cond:4|0|0|0|1|0|0|1|0|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4" +
+	"BX<c> <Rm>" +
+	"cond:4|0|0|0|1|0|0|1|0|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4" +
+	"BXJ<c> <Rm>" +
+	"cond:4|0|0|0|1|0|0|1|0|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|0|0|1|0|Rm:4" +
+	"CLREX" +
+	"1|1|1|1|0|1|0|1|0|1|1|1|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|1|(1)|(1)|(1)|(1)" +
+	"CLZ<c> <Rd>,<Rm>" +
+	"cond:4|0|0|0|1|0|1|1|0|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4" +
+	"CMN<c> <Rn>,#<const>" +
+	"cond:4|0|0|1|1|0|1|1|1|Rn:4|(0)|(0)|(0)|(0)|imm12:12" +
+	"CMN<c> <Rn>,<Rm>,<type> <Rs>" +
+	"cond:4|0|0|0|1|0|1|1|1|Rn:4|(0)|(0)|(0)|(0)|Rs:4|0|type:2|1|Rm:4" +
+	"CMN<c> <Rn>,<Rm>{,<shift>}" +
+	"cond:4|0|0|0|1|0|1|1|1|Rn:4|(0)|(0)|(0)|(0)|imm5:5|type:2|0|Rm:4" +
+	"CMP<c> <Rn>,#<const>" +
+	"cond:4|0|0|1|1|0|1|0|1|Rn:4|(0)|(0)|(0)|(0)|imm12:12" +
+	"CMP<c> <Rn>,<Rm>,<type> <Rs>" +
+	"cond:4|0|0|0|1|0|1|0|1|Rn:4|(0)|(0)|(0)|(0)|Rs:4|0|type:2|1|Rm:4" +
+	"CMP<c> <Rn>,<Rm>{,<shift>}" +
+	"cond:4|0|0|0|1|0|1|0|1|Rn:4|(0)|(0)|(0)|(0)|imm5:5|type:2|0|Rm:4" +
+	"DBG<c> #<option>" +
+	"cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|1|1|1|1|option:4" +
+	"DMB #<option>" +
+	"1|1|1|1|0|1|0|1|0|1|1|1|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|1|0|1|option:4" +
+	"DSB #<option>" +
+	"1|1|1|1|0|1|0|1|0|1|1|1|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|1|0|0|option:4" +
+	"EOR{S}<c> <Rd>,<Rn>,#<const>" +
+	"cond:4|0|0|1|0|0|0|1|S|Rn:4|Rd:4|imm12:12" +
+	"EOR{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs>" +
+	"cond:4|0|0|0|0|0|0|1|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4" +
+	"EOR{S}<c> <Rd>,<Rn>,<Rm>{,<shift>}" +
+	"cond:4|0|0|0|0|0|0|1|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4ERET<c>" +
+	"cond:4|0|0|0|1|0|1|1|0|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)|0|1|1|0|1|1|1|0" +
+	"ISB #<option>" +
+	"1|1|1|1|0|1|0|1|0|1|1|1|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|1|1|0|option:4" +
+	"LDM<c> <Rn>{!},<registers>" +
+	"cond:4|1|0|0|0|1|0|W|1|Rn:4|register_list:16" +
+	"LDMDA<c> <Rn>{!},<registers>" +
+	"cond:4|1|0|0|0|0|0|W|1|Rn:4|register_list:16" +
+	"LDMDB<c> <Rn>{!},<registers>" +
+	"cond:4|1|0|0|1|0|0|W|1|Rn:4|register_list:16" +
+	"LDMIB<c> <Rn>{!},<registers>" +
+	"cond:4|1|0|0|1|1|0|W|1|Rn:4|register_list:16" +
+	"LDR<c> <Rt>,<label+/-12>" +
+	"cond:4|0|1|0|(1)|U|0|(0)|1|1|1|1|1|Rt:4|imm12:12" +
+	"LDR<c> <Rt>,[<Rn>,+/-<Rm>{, <shift>}]{!}" +
+	"cond:4|0|1|1|P|U|0|W|1|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4" +
+	"LDR<c> <Rt>,[<Rn>{,#+/-<imm12>}]{!}" +
+	"cond:4|0|1|0|P|U|0|W|1|Rn:4|Rt:4|imm12:12" +
+	"LDRB<c> <Rt>,<label+/-12>" +
+	"cond:4|0|1|0|(1)|U|1|(0)|1|1|1|1|1|Rt:4|imm12:12" +
+	"LDRB<c> <Rt>,[<Rn>,+/-<Rm>{, <shift>}]{!}" +
+	"cond:4|0|1|1|P|U|1|W|1|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4" +
+	"LDRB<c> <Rt>,[<Rn>{,#+/-<imm12>}]{!}" +
+	"cond:4|0|1|0|P|U|1|W|1|Rn:4|Rt:4|imm12:12" +
+	"LDRBT<c> <Rt>,[<Rn>],#+/-<imm12>" +
+	"cond:4|0|1|0|0|U|1|1|1|Rn:4|Rt:4|imm12:12" +
+	"LDRBT<c> <Rt>,[<Rn>],+/-<Rm>{, <shift>}" +
+	"cond:4|0|1|1|0|U|1|1|1|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4" +
+	"LDRD<c> <Rt1>,<Rt2>,[<Rn>,+/-<Rm>]{!}" +
+	"cond:4|0|0|0|P|U|0|W|0|Rn:4|Rt:4|(0)|(0)|(0)|(0)|1|1|0|1|Rm:4" +
+	"LDRD<c> <Rt1>,<Rt2>,[<Rn>{,#+/-<imm8>}]{!}" +
+	"cond:4|0|0|0|P|U|1|W|0|Rn:4|Rt:4|imm4H:4|1|1|0|1|imm4L:4" +
+	"LDREX<c> <Rt>,[<Rn>]" +
+	"cond:4|0|0|0|1|1|0|0|1|Rn:4|Rt:4|(1)|(1)|(1)|(1)|1|0|0|1|(1)|(1)|(1)|(1)" +
+	"LDREXB<c> <Rt>, [<Rn>]" +
+	"cond:4|0|0|0|1|1|1|0|1|Rn:4|Rt:4|(1)|(1)|(1)|(1)|1|0|0|1|(1)|(1)|(1)|(1)" +
+	"LDREXD<c> <Rt1>,<Rt2>,[<Rn>]" +
+	"cond:4|0|0|0|1|1|0|1|1|Rn:4|Rt:4|(1)|(1)|(1)|(1)|1|0|0|1|(1)|(1)|(1)|(1)" +
+	"LDREXH<c> <Rt>, [<Rn>]" +
+	"cond:4|0|0|0|1|1|1|1|1|Rn:4|Rt:4|(1)|(1)|(1)|(1)|1|0|0|1|(1)|(1)|(1)|(1)" +
+	"LDRH<c> <Rt>,[<Rn>,+/-<Rm>]{!}" +
+	"cond:4|0|0|0|P|U|0|W|1|Rn:4|Rt:4|0|0|0|0|1|0|1|1|Rm:4" +
+	"LDRH<c> <Rt>,[<Rn>{,#+/-<imm8>}]{!}" +
+	"cond:4|0|0|0|P|U|1|W|1|Rn:4|Rt:4|imm4H:4|1|0|1|1|imm4L:4" +
+	"LDRHT<c> <Rt>, [<Rn>] {,#+/-<imm8>}" +
+	"cond:4|0|0|0|0|U|1|1|1|Rn:4|Rt:4|imm4H:4|1|0|1|1|imm4L:4" +
+	"LDRHT<c> <Rt>, [<Rn>], +/-<Rm>" +
+	"cond:4|0|0|0|0|U|0|1|1|Rn:4|Rt:4|0|0|0|0|1|0|1|1|Rm:4" +
+	"LDRSB<c> <Rt>,[<Rn>,+/-<Rm>]{!}" +
+	"cond:4|0|0|0|P|U|0|W|1|Rn:4|Rt:4|0|0|0|0|1|1|0|1|Rm:4" +
+	"LDRSB<c> <Rt>,[<Rn>{,#+/-<imm8>}]{!}" +
+	"cond:4|0|0|0|P|U|1|W|1|Rn:4|Rt:4|imm4H:4|1|1|0|1|imm4L:4" +
+	"LDRSBT<c> <Rt>, [<Rn>] {,#+/-<imm8>}" +
+	"cond:4|0|0|0|0|U|1|1|1|Rn:4|Rt:4|imm4H:4|1|1|0|1|imm4L:4" +
+	"LDRSBT<c> <Rt>, [<Rn>], +/-<Rm>" +
+	"cond:4|0|0|0|0|U|0|1|1|Rn:4|Rt:4|0|0|0|0|1|1|0|1|Rm:4" +
+	"LDRSH<c> <Rt>,[<Rn>,+/-<Rm>]{!}" +
+	"cond:4|0|0|0|P|U|0|W|1|Rn:4|Rt:4|0|0|0|0|1|1|1|1|Rm:4" +
+	"LDRSH<c> <Rt>,[<Rn>{,#+/-<imm8>}]{!}" +
+	"cond:4|0|0|0|P|U|1|W|1|Rn:4|Rt:4|imm4H:4|1|1|1|1|imm4L:4" +
+	"LDRSHT<c> <Rt>, [<Rn>] {,#+/-<imm8>}" +
+	"cond:4|0|0|0|0|U|1|1|1|Rn:4|Rt:4|imm4H:4|1|1|1|1|imm4L:4" +
+	"LDRSHT<c> <Rt>, [<Rn>], +/-<Rm>" +
+	"cond:4|0|0|0|0|U|0|1|1|Rn:4|Rt:4|0|0|0|0|1|1|1|1|Rm:4" +
+	"LDRT<c> <Rt>, [<Rn>] {,#+/-<imm12>}" +
+	"cond:4|0|1|0|0|U|0|1|1|Rn:4|Rt:4|imm12:12" +
+	"LDRT<c> <Rt>,[<Rn>],+/-<Rm>{, <shift>}" +
+	"cond:4|0|1|1|0|U|0|1|1|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4" +
+	"LSL{S}<c> <Rd>,<Rm>,#<imm5_nz>" +
+	"cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|imm5:5|0|0|0|Rm:4" +
+	"LSL{S}<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|Rm:4|0|0|0|1|Rn:4" +
+	"LSR{S}<c> <Rd>,<Rm>,#<imm5_32>" +
+	"cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|imm5:5|0|1|0|Rm:4" +
+	"LSR{S}<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|Rm:4|0|0|1|1|Rn:4" +
+	"MLA{S}<c> <Rd>,<Rn>,<Rm>,<Ra>" +
+	"cond:4|0|0|0|0|0|0|1|S|Rd:4|Ra:4|Rm:4|1|0|0|1|Rn:4" +
+	"MLS<c> <Rd>,<Rn>,<Rm>,<Ra>" +
+	"cond:4|0|0|0|0|0|1|1|0|Rd:4|Ra:4|Rm:4|1|0|0|1|Rn:4" +
+	"MOVT<c> <Rd>,#<imm12+4>" +
+	"cond:4|0|0|1|1|0|1|0|0|imm4:4|Rd:4|imm12:12" +
+	"MOVW<c> <Rd>,#<imm12+4>" +
+	"cond:4|0|0|1|1|0|0|0|0|imm4:4|Rd:4|imm12:12" +
+	"MOV{S}<c> <Rd>,#<const>" +
+	"cond:4|0|0|1|1|1|0|1|S|0|0|0|0|Rd:4|imm12:12MOV{S}<c> <Rd>,<Rm>" +
+	"cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|0|0|0|0|0|0|0|0|Rm:4" +
+	"MRS<c> <Rd>,APSR" +
+	"cond:4|0|0|0|1|0|0|0|0|(1)|(1)|(1)|(1)|Rd:4|(0)|(0)|(0)|(0)|0|0|0|0|(0)|(0)|(0)|(0)" +
+	"MRS<c> <Rd>,SPSR" +
+	"cond:4|0|0|0|1|0|1|0|0|(1)|(1)|(1)|(1)|Rd:4|(0)|(0)|(0)|(0)|0|0|0|0|(0)|(0)|(0)|(0)" +
+	"MUL{S}<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|0|0|0|0|0|0|S|Rd:4|(0)|(0)|(0)|(0)|Rm:4|1|0|0|1|Rn:4" +
+	"MVN{S}<c> <Rd>,#<const>" +
+	"cond:4|0|0|1|1|1|1|1|S|(0)|(0)|(0)|(0)|Rd:4|imm12:12" +
+	"MVN{S}<c> <Rd>,<Rm>,<type> <Rs>" +
+	"cond:4|0|0|0|1|1|1|1|S|(0)|(0)|(0)|(0)|Rd:4|Rs:4|0|type:2|1|Rm:4" +
+	"MVN{S}<c> <Rd>,<Rm>{,<shift>}" +
+	"cond:4|0|0|0|1|1|1|1|S|(0)|(0)|(0)|(0)|Rd:4|imm5:5|type:2|0|Rm:4" +
+	"NOP<c>" +
+	"cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|0|0|0" +
+	"ORR{S}<c> <Rd>,<Rn>,#<const>" +
+	"cond:4|0|0|1|1|1|0|0|S|Rn:4|Rd:4|imm12:12" +
+	"ORR{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs>" +
+	"cond:4|0|0|0|1|1|0|0|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4" +
+	"ORR{S}<c> <Rd>,<Rn>,<Rm>{,<shift>}" +
+	"cond:4|0|0|0|1|1|0|0|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4" +
+	"PKH<BT,TB><c> <Rd>,<Rn>,<Rm>{,LSL #<imm5>}" +
+	"cond:4|0|1|1|0|1|0|0|0|Rn:4|Rd:4|imm5:5|tb|0|1|Rm:4" +
+	"PLD <label+/-12>" +
+	"1|1|1|1|0|1|0|1|U|(1)|0|1|1|1|1|1|(1)|(1)|(1)|(1)|imm12:12" +
+	"PLD{W} [<Rn>,#+/-<imm12>]" +
+	"1|1|1|1|0|1|0|1|U|R|0|1|Rn:4|(1)|(1)|(1)|(1)|imm12:12" +
+	"PLD{W} [<Rn>,+/-<Rm>{, <shift>}]" +
+	"1|1|1|1|0|1|1|1|U|R|0|1|Rn:4|(1)|(1)|(1)|(1)|imm5:5|type:2|0|Rm:4" +
+	"PLI [<Rn>,#+/-<imm12>]" +
+	"1|1|1|1|0|1|0|0|U|1|0|1|Rn:4|(1)|(1)|(1)|(1)|imm12:12" +
+	"PLI [<Rn>,+/-<Rm>{, <shift>}]" +
+	"1|1|1|1|0|1|1|0|U|1|0|1|Rn:4|(1)|(1)|(1)|(1)|imm5:5|type:2|0|Rm:4" +
+	"POP<c> <registers2>" +
+	"cond:4|1|0|0|0|1|0|1|1|1|1|0|1|register_list:16" +
+	"POP<c> <registers1>" +
+	"cond:4|0|1|0|0|1|0|0|1|1|1|0|1|Rt:4|0|0|0|0|0|0|0|0|0|1|0|0" +
+	"PUSH<c> <registers2>" +
+	"cond:4|1|0|0|1|0|0|1|0|1|1|0|1|register_list:16" +
+	"PUSH<c> <registers1>" +
+	"cond:4|0|1|0|1|0|0|1|0|1|1|0|1|Rt:4|0|0|0|0|0|0|0|0|0|1|0|0" +
+	"QADD16<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4" +
+	"QADD8<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4" +
+	"QADD<c> <Rd>,<Rm>,<Rn>" +
+	"cond:4|0|0|0|1|0|0|0|0|Rn:4|Rd:4|(0)|(0)|(0)|(0)|0|1|0|1|Rm:4" +
+	"QASX<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4" +
+	"QDADD<c> <Rd>,<Rm>,<Rn>" +
+	"cond:4|0|0|0|1|0|1|0|0|Rn:4|Rd:4|(0)|(0)|(0)|(0)|0|1|0|1|Rm:4" +
+	"QDSUB<c> <Rd>,<Rm>,<Rn>" +
+	"cond:4|0|0|0|1|0|1|1|0|Rn:4|Rd:4|0|0|0|0|0|1|0|1|Rm:4" +
+	"QSAX<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4" +
+	"QSUB16<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4" +
+	"QSUB8<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|0|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4" +
+	"QSUB<c> <Rd>,<Rm>,<Rn>" +
+	"cond:4|0|0|0|1|0|0|1|0|Rn:4|Rd:4|0|0|0|0|0|1|0|1|Rm:4" +
+	"RBIT<c> <Rd>,<Rm>" +
+	"cond:4|0|1|1|0|1|1|1|1|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4" +
+	"REV16<c> <Rd>,<Rm>" +
+	"cond:4|0|1|1|0|1|0|1|1|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|1|0|1|1|Rm:4" +
+	"REV<c> <Rd>,<Rm>" +
+	"cond:4|0|1|1|0|1|0|1|1|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4" +
+	"REVSH<c> <Rd>,<Rm>" +
+	"cond:4|0|1|1|0|1|1|1|1|(1)|(1)|(1)|(1)|Rd:4|(1)|(1)|(1)|(1)|1|0|1|1|Rm:4" +
+	"ROR{S}<c> <Rd>,<Rm>,#<imm5>" +
+	"cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|imm5:5|1|1|0|Rm:4" +
+	"ROR{S}<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|Rm:4|0|1|1|1|Rn:4" +
+	"RRX{S}<c> <Rd>,<Rm>" +
+	"cond:4|0|0|0|1|1|0|1|S|0|0|0|0|Rd:4|0|0|0|0|0|1|1|0|Rm:4" +
+	"RSB{S}<c> <Rd>,<Rn>,#<const>" +
+	"cond:4|0|0|1|0|0|1|1|S|Rn:4|Rd:4|imm12:12" +
+	"RSB{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs>" +
+	"cond:4|0|0|0|0|0|1|1|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4" +
+	"RSB{S}<c> <Rd>,<Rn>,<Rm>{,<shift>}" +
+	"cond:4|0|0|0|0|0|1|1|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4" +
+	"RSC{S}<c> <Rd>,<Rn>,#<const>" +
+	"cond:4|0|0|1|0|1|1|1|S|Rn:4|Rd:4|imm12:12" +
+	"RSC{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs>" +
+	"cond:4|0|0|0|0|1|1|1|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4" +
+	"RSC{S}<c> <Rd>,<Rn>,<Rm>{,<shift>}" +
+	"cond:4|0|0|0|0|1|1|1|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4" +
+	"SADD16<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4" +
+	"SADD8<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4" +
+	"SASX<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4" +
+	"SBC{S}<c> <Rd>,<Rn>,#<const>" +
+	"cond:4|0|0|1|0|1|1|0|S|Rn:4|Rd:4|imm12:12" +
+	"SBC{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs>" +
+	"cond:4|0|0|0|0|1|1|0|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4" +
+	"SBC{S}<c> <Rd>,<Rn>,<Rm>{,<shift>}" +
+	"cond:4|0|0|0|0|1|1|0|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4" +
+	"SBFX<c> <Rd>,<Rn>,#<lsb>,#<widthm1>" +
+	"cond:4|0|1|1|1|1|0|1|widthm1:5|Rd:4|lsb:5|1|0|1|Rn:4" +
+	"SEL<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|1|0|0|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|1|1|Rm:4" +
+	"SETEND <endian_specifier>" +
+	"1|1|1|1|0|0|0|1|0|0|0|0|0|0|0|1|0|0|0|0|0|0|E|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)|(0)" +
+	"SEV<c>" +
+	"cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|1|0|0" +
+	"SHADD16<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4" +
+	"SHADD8<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4" +
+	"SHASX<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4" +
+	"SHSAX<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4" +
+	"SHSUB16<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4" +
+	"SHSUB8<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|0|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4" +
+	"SMLA<x><y><c> <Rd>,<Rn>,<Rm>,<Ra>" +
+	"cond:4|0|0|0|1|0|0|0|0|Rd:4|Ra:4|Rm:4|1|M|N|0|Rn:4" +
+	"SMLAD{X}<c> <Rd>,<Rn>,<Rm>,<Ra>" +
+	"cond:4|0|1|1|1|0|0|0|0|Rd:4|Ra:4|Rm:4|0|0|M|1|Rn:4" +
+	"SMLAL<x><y><c> <RdLo>,<RdHi>,<Rn>,<Rm>" +
+	"cond:4|0|0|0|1|0|1|0|0|RdHi:4|RdLo:4|Rm:4|1|M|N|0|Rn:4" +
+	"SMLALD{X}<c> <RdLo>,<RdHi>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|1|0|1|0|0|RdHi:4|RdLo:4|Rm:4|0|0|M|1|Rn:4" +
+	"SMLAL{S}<c> <RdLo>,<RdHi>,<Rn>,<Rm>" +
+	"cond:4|0|0|0|0|1|1|1|S|RdHi:4|RdLo:4|Rm:4|1|0|0|1|Rn:4" +
+	"SMLAW<y><c> <Rd>,<Rn>,<Rm>,<Ra>" +
+	"cond:4|0|0|0|1|0|0|1|0|Rd:4|Ra:4|Rm:4|1|M|0|0|Rn:4" +
+	"SMLSD{X}<c> <Rd>,<Rn>,<Rm>,<Ra>" +
+	"cond:4|0|1|1|1|0|0|0|0|Rd:4|Ra:4|Rm:4|0|1|M|1|Rn:4" +
+	"SMLSLD{X}<c> <RdLo>,<RdHi>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|1|0|1|0|0|RdHi:4|RdLo:4|Rm:4|0|1|M|1|Rn:4" +
+	"SMMLA{R}<c> <Rd>,<Rn>,<Rm>,<Ra>" +
+	"cond:4|0|1|1|1|0|1|0|1|Rd:4|Ra:4|Rm:4|0|0|R|1|Rn:4" +
+	"SMMLS{R}<c> <Rd>,<Rn>,<Rm>,<Ra>" +
+	"cond:4|0|1|1|1|0|1|0|1|Rd:4|Ra:4|Rm:4|1|1|R|1|Rn:4" +
+	"SMMUL{R}<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|1|0|1|0|1|Rd:4|1|1|1|1|Rm:4|0|0|R|1|Rn:4" +
+	"SMUAD{X}<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|1|0|0|0|0|Rd:4|1|1|1|1|Rm:4|0|0|M|1|Rn:4" +
+	"SMUL<x><y><c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|0|0|1|0|1|1|0|Rd:4|0|0|0|0|Rm:4|1|M|N|0|Rn:4" +
+	"SMULL{S}<c> <RdLo>,<RdHi>,<Rn>,<Rm>" +
+	"cond:4|0|0|0|0|1|1|0|S|RdHi:4|RdLo:4|Rm:4|1|0|0|1|Rn:4" +
+	"SMULW<y><c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|0|0|1|0|0|1|0|Rd:4|0|0|0|0|Rm:4|1|M|1|0|Rn:4" +
+	"SMUSD{X}<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|1|0|0|0|0|Rd:4|1|1|1|1|Rm:4|0|1|M|1|Rn:4" +
+	"SSAT16<c> <Rd>,#<sat_imm4m1>,<Rn>" +
+	"cond:4|0|1|1|0|1|0|1|0|sat_imm:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rn:4" +
+	"SSAT<c> <Rd>,#<sat_imm5m1>,<Rn>{,<shift>}" +
+	"cond:4|0|1|1|0|1|0|1|sat_imm:5|Rd:4|imm5:5|sh|0|1|Rn:4" +
+	"SSAX<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4" +
+	"SSUB16<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4" +
+	"SSUB8<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|0|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4" +
+	"STM<c> <Rn>{!},<registers>" +
+	"cond:4|1|0|0|0|1|0|W|0|Rn:4|register_list:16" +
+	"STMDA<c> <Rn>{!},<registers>" +
+	"cond:4|1|0|0|0|0|0|W|0|Rn:4|register_list:16" +
+	"STMDB<c> <Rn>{!},<registers>" +
+	"cond:4|1|0|0|1|0|0|W|0|Rn:4|register_list:16" +
+	"STMIB<c> <Rn>{!},<registers>" +
+	"cond:4|1|0|0|1|1|0|W|0|Rn:4|register_list:16" +
+	"STR<c> <Rt>,[<Rn>,+/-<Rm>{, <shift>}]{!}" +
+	"cond:4|0|1|1|P|U|0|W|0|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4" +
+	"STR<c> <Rt>,[<Rn>{,#+/-<imm12>}]{!}" +
+	"cond:4|0|1|0|P|U|0|W|0|Rn:4|Rt:4|imm12:12" +
+	"STRB<c> <Rt>,[<Rn>,+/-<Rm>{, <shift>}]{!}" +
+	"cond:4|0|1|1|P|U|1|W|0|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4" +
+	"STRB<c> <Rt>,[<Rn>{,#+/-<imm12>}]{!}" +
+	"cond:4|0|1|0|P|U|1|W|0|Rn:4|Rt:4|imm12:12" +
+	"STRBT<c> <Rt>,[<Rn>],#+/-<imm12>" +
+	"cond:4|0|1|0|0|U|1|1|0|Rn:4|Rt:4|imm12:12" +
+	"STRBT<c> <Rt>,[<Rn>],+/-<Rm>{, <shift>}" +
+	"cond:4|0|1|1|0|U|1|1|0|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4" +
+	"STRD<c> <Rt1>,<Rt2>,[<Rn>,+/-<Rm>]{!}" +
+	"cond:4|0|0|0|P|U|0|W|0|Rn:4|Rt:4|(0)|(0)|(0)|(0)|1|1|1|1|Rm:4" +
+	"STRD<c> <Rt1>,<Rt2>,[<Rn>{,#+/-<imm8>}]{!}" +
+	"cond:4|0|0|0|P|U|1|W|0|Rn:4|Rt:4|imm4H:4|1|1|1|1|imm4L:4" +
+	"STREX<c> <Rd>,<Rt>,[<Rn>]" +
+	"cond:4|0|0|0|1|1|0|0|0|Rn:4|Rd:4|1|1|1|1|1|0|0|1|Rt:4" +
+	"STREXB<c> <Rd>,<Rt>,[<Rn>]" +
+	"cond:4|0|0|0|1|1|1|0|0|Rn:4|Rd:4|1|1|1|1|1|0|0|1|Rt:4" +
+	"STREXD<c> <Rd>,<Rt1>,<Rt2>,[<Rn>]" +
+	"cond:4|0|0|0|1|1|0|1|0|Rn:4|Rd:4|1|1|1|1|1|0|0|1|Rt:4" +
+	"STREXH<c> <Rd>,<Rt>,[<Rn>]" +
+	"cond:4|0|0|0|1|1|1|1|0|Rn:4|Rd:4|1|1|1|1|1|0|0|1|Rt:4" +
+	"STRH<c> <Rt>,[<Rn>,+/-<Rm>]{!}" +
+	"cond:4|0|0|0|P|U|0|W|0|Rn:4|Rt:4|0|0|0|0|1|0|1|1|Rm:4" +
+	"STRH<c> <Rt>,[<Rn>{,#+/-<imm8>}]{!}" +
+	"cond:4|0|0|0|P|U|1|W|0|Rn:4|Rt:4|imm4H:4|1|0|1|1|imm4L:4" +
+	"STRHT<c> <Rt>, [<Rn>] {,#+/-<imm8>}" +
+	"cond:4|0|0|0|0|U|1|1|0|Rn:4|Rt:4|imm4H:4|1|0|1|1|imm4L:4" +
+	"STRHT<c> <Rt>, [<Rn>], +/-<Rm>" +
+	"cond:4|0|0|0|0|U|0|1|0|Rn:4|Rt:4|0|0|0|0|1|0|1|1|Rm:4" +
+	"STRT<c> <Rt>, [<Rn>] {,#+/-<imm12>}" +
+	"cond:4|0|1|0|0|U|0|1|0|Rn:4|Rt:4|imm12:12" +
+	"STRT<c> <Rt>,[<Rn>],+/-<Rm>{, <shift>}" +
+	"cond:4|0|1|1|0|U|0|1|0|Rn:4|Rt:4|imm5:5|type:2|0|Rm:4" +
+	"SUB{S}<c> <Rd>,<Rn>,#<const>" +
+	"cond:4|0|0|1|0|0|1|0|S|Rn:4|Rd:4|imm12:12" +
+	"SUB{S}<c> <Rd>,<Rn>,<Rm>,<type> <Rs>" +
+	"cond:4|0|0|0|0|0|1|0|S|Rn:4|Rd:4|Rs:4|0|type:2|1|Rm:4" +
+	"SUB{S}<c> <Rd>,<Rn>,<Rm>{,<shift>}" +
+	"cond:4|0|0|0|0|0|1|0|S|Rn:4|Rd:4|imm5:5|type:2|0|Rm:4" +
+	"SUB{S}<c> <Rd>,SP,#<const>" +
+	"cond:4|0|0|1|0|0|1|0|S|1|1|0|1|Rd:4|imm12:12" +
+	"SUB{S}<c> <Rd>,SP,<Rm>{,<shift>}" +
+	"cond:4|0|0|0|0|0|1|0|S|1|1|0|1|Rd:4|imm5:5|type:2|0|Rm:4" +
+	"SVC<c> #<imm24>cond:4|1|1|1|1|imm24:24SWP{B}<c> <Rt>,<Rm>,[<Rn>]" +
+	"cond:4|0|0|0|1|0|B|0|0|Rn:4|Rt:4|0|0|0|0|1|0|0|1|Rm:4" +
+	"SXTAB16<c> <Rd>,<Rn>,<Rm>{,<rotation>}" +
+	"cond:4|0|1|1|0|1|0|0|0|Rn:4|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4" +
+	"SXTAB<c> <Rd>,<Rn>,<Rm>{,<rotation>}" +
+	"cond:4|0|1|1|0|1|0|1|0|Rn:4|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4" +
+	"SXTAH<c> <Rd>,<Rn>,<Rm>{,<rotation>}" +
+	"cond:4|0|1|1|0|1|0|1|1|Rn:4|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4" +
+	"SXTB16<c> <Rd>,<Rm>{,<rotation>}" +
+	"cond:4|0|1|1|0|1|0|0|0|1|1|1|1|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4" +
+	"SXTB<c> <Rd>,<Rm>{,<rotation>}" +
+	"cond:4|0|1|1|0|1|0|1|0|1|1|1|1|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4" +
+	"SXTH<c> <Rd>,<Rm>{,<rotation>}" +
+	"cond:4|0|1|1|0|1|0|1|1|1|1|1|1|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4" +
+	"TEQ<c> <Rn>,#<const>" +
+	"cond:4|0|0|1|1|0|0|1|1|Rn:4|(0)|(0)|(0)|(0)|imm12:12" +
+	"TEQ<c> <Rn>,<Rm>,<type> <Rs>" +
+	"cond:4|0|0|0|1|0|0|1|1|Rn:4|(0)|(0)|(0)|(0)|Rs:4|0|type:2|1|Rm:4" +
+	"TEQ<c> <Rn>,<Rm>{,<shift>}" +
+	"cond:4|0|0|0|1|0|0|1|1|Rn:4|(0)|(0)|(0)|(0)|imm5:5|type:2|0|Rm:4" +
+	"TST<c> <Rn>,#<const>" +
+	"cond:4|0|0|1|1|0|0|0|1|Rn:4|(0)|(0)|(0)|(0)|imm12:12" +
+	"TST<c> <Rn>,<Rm>,<type> <Rs>" +
+	"cond:4|0|0|0|1|0|0|0|1|Rn:4|(0)|(0)|(0)|(0)|Rs:4|0|type:2|1|Rm:4" +
+	"TST<c> <Rn>,<Rm>{,<shift>}" +
+	"cond:4|0|0|0|1|0|0|0|1|Rn:4|(0)|(0)|(0)|(0)|imm5:5|type:2|0|Rm:4" +
+	"UADD16<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4" +
+	"UADD8<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4" +
+	"UASX<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4" +
+	"UBFX<c> <Rd>,<Rn>,#<lsb>,#<widthm1>" +
+	"cond:4|0|1|1|1|1|1|1|widthm1:5|Rd:4|lsb:5|1|0|1|Rn:4" +
+	"UHADD16<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4" +
+	"UHADD8<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4" +
+	"UHASX<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4" +
+	"UHSAX<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4" +
+	"UHSUB16<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4" +
+	"UHSUB8<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|1|1|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4" +
+	"UMAAL<c> <RdLo>,<RdHi>,<Rn>,<Rm>" +
+	"cond:4|0|0|0|0|0|1|0|0|RdHi:4|RdLo:4|Rm:4|1|0|0|1|Rn:4" +
+	"UMLAL{S}<c> <RdLo>,<RdHi>,<Rn>,<Rm>" +
+	"cond:4|0|0|0|0|1|0|1|S|RdHi:4|RdLo:4|Rm:4|1|0|0|1|Rn:4" +
+	"UMULL{S}<c> <RdLo>,<RdHi>,<Rn>,<Rm>" +
+	"cond:4|0|0|0|0|1|0|0|S|RdHi:4|RdLo:4|Rm:4|1|0|0|1|Rn:4" +
+	"UQADD16<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|0|1|Rm:4" +
+	"UQADD8<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|0|0|1|Rm:4" +
+	"UQASX<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rm:4" +
+	"UQSAX<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4" +
+	"UQSUB16<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4" +
+	"UQSUB8<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|1|1|0|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4" +
+	"USAD8<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|1|1|0|0|0|Rd:4|1|1|1|1|Rm:4|0|0|0|1|Rn:4" +
+	"USADA8<c> <Rd>,<Rn>,<Rm>,<Ra>" +
+	"cond:4|0|1|1|1|1|0|0|0|Rd:4|Ra:4|Rm:4|0|0|0|1|Rn:4" +
+	"USAT16<c> <Rd>,#<sat_imm4>,<Rn>" +
+	"cond:4|0|1|1|0|1|1|1|0|sat_imm:4|Rd:4|(1)|(1)|(1)|(1)|0|0|1|1|Rn:4" +
+	"USAT<c> <Rd>,#<sat_imm5>,<Rn>{,<shift>}" +
+	"cond:4|0|1|1|0|1|1|1|sat_imm:5|Rd:4|imm5:5|sh|0|1|Rn:4" +
+	"USAX<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|0|1|Rm:4" +
+	"USUB16<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|0|1|1|1|Rm:4" +
+	"USUB8<c> <Rd>,<Rn>,<Rm>" +
+	"cond:4|0|1|1|0|0|1|0|1|Rn:4|Rd:4|(1)|(1)|(1)|(1)|1|1|1|1|Rm:4" +
+	"UXTAB16<c> <Rd>,<Rn>,<Rm>{,<rotation>}" +
+	"cond:4|0|1|1|0|1|1|0|0|Rn:4|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4" +
+	"UXTAB<c> <Rd>,<Rn>,<Rm>{,<rotation>}" +
+	"cond:4|0|1|1|0|1|1|1|0|Rn:4|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4" +
+	"UXTAH<c> <Rd>,<Rn>,<Rm>{,<rotation>}" +
+	"cond:4|0|1|1|0|1|1|1|1|Rn:4|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4" +
+	"UXTB16<c> <Rd>,<Rm>{,<rotation>}" +
+	"cond:4|0|1|1|0|1|1|0|0|1|1|1|1|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4" +
+	"UXTB<c> <Rd>,<Rm>{,<rotation>}" +
+	"cond:4|0|1|1|0|1|1|1|0|1|1|1|1|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4" +
+	"UXTH<c> <Rd>,<Rm>{,<rotation>}" +
+	"cond:4|0|1|1|0|1|1|1|1|1|1|1|1|Rd:4|rotate:2|0|0|0|1|1|1|Rm:4" +
+	"V<MLA,MLS><c>.F<32,64> <Sd,Dd>, <Sn,Dn>, <Sm,Dm>" +
+	"cond:4|1|1|1|0|0|D|0|0|Vn:4|Vd:4|1|0|1|sz|N|op|M|0|Vm:4" +
+	"VABS<c>.F<32,64> <Sd,Dd>, <Sm,Dm>" +
+	"cond:4|1|1|1|0|1|D|1|1|0|0|0|0|Vd:4|1|0|1|sz|1|1|M|0|Vm:4" +
+	"VADD<c>.F<32,64> <Sd,Dd>, <Sn,Dn>, <Sm,Dm>" +
+	"cond:4|1|1|1|0|0|D|1|1|Vn:4|Vd:4|1|0|1|sz|N|0|M|0|Vm:4" +
+	"VCMP{E}<c>.F<32,64> <Sd,Dd>, #0.0" +
+	"cond:4|1|1|1|0|1|D|1|1|0|1|0|1|Vd:4|1|0|1|sz|E|1|0|0|(0)|(0)|(0)|(0)" +
+	"VCMP{E}<c>.F<32,64> <Sd,Dd>, <Sm,Dm>" +
+	"cond:4|1|1|1|0|1|D|1|1|0|1|0|0|Vd:4|1|0|1|sz|E|1|M|0|Vm:4" +
+	"VCVT<c>.F<32,64>.FX<S,U><16,32> <Sd,Dd>, <Sd,Dd>, #<fbits>" +
+	"cond:4|1|1|1|0|1|D|1|1|1|0|1|U|Vd:4|1|0|1|sz|sx|1|i|0|imm4:4" +
+	"VCVT<c>.FX<S,U><16,32>.F<32,64> <Sd,Dd>, <Sd,Dd>, #<fbits>" +
+	"cond:4|1|1|1|0|1|D|1|1|1|1|1|U|Vd:4|1|0|1|sz|sx|1|i|0|imm4:4" +
+	"VCVT<c>.<F64.F32,F32.F64> <Dd,Sd>, <Sm,Dm>" +
+	"cond:4|1|1|1|0|1|D|1|1|0|1|1|1|Vd:4|1|0|1|sz|1|1|M|0|Vm:4" +
+	"VCVT<B,T><c>.<F32.F16,F16.F32> <Sd>, <Sm>" +
+	"cond:4|1|1|1|0|1|D|1|1|0|0|1|op|Vd:4|1|0|1|0|T|1|M|0|Vm:4" +
+	"VCVT<c>.F<32,64>.<U,S>32 <Sd,Dd>, <Sm>" +
+	"cond:4|1|1|1|0|1|D|1|1|1|0|0|0|Vd:4|1|0|1|sz|op|1|M|0|Vm:4" +
+	"VCVT<R,><c>.<U,S>32.F<32,64> <Sd>, <Sm,Dm>" +
+	"cond:4|1|1|1|0|1|D|1|1|1|1|0|signed|Vd:4|1|0|1|sz|op|1|M|0|Vm:4" +
+	"VDIV<c>.F<32,64> <Sd,Dd>, <Sn,Dn>, <Sm,Dm>" +
+	"cond:4|1|1|1|0|1|D|0|0|Vn:4|Vd:4|1|0|1|sz|N|0|M|0|Vm:4" +
+	"VLDMIA<c> <Rn>{!}, <vlist32>" +
+	"cond:4|1|1|0|0|1|D|W|1|Rn:4|Vd:4|1|0|1|0|imm8:8" +
+	"VLDMIA<c> <Rn>{!}, <vlist64>" +
+	"cond:4|1|1|0|0|1|D|W|1|Rn:4|Vd:4|1|0|1|1|imm8:8" +
+	"VLDMDB<c> <Rn>{!}, <vlist32>" +
+	"cond:4|1|1|0|1|0|D|W|1|Rn:4|Vd:4|1|0|1|0|imm8:8" +
+	"VLDMDB<c> <Rn>{!}, <vlist64>" +
+	"cond:4|1|1|0|1|0|D|W|1|Rn:4|Vd:4|1|0|1|1|imm8:8" +
+	"VLDR<c> <Sd,Dd>, [<Rn>{,#+/-<imm8>}]" +
+	"cond:4|1|1|0|1|U|D|0|1|Rn:4|Vd:4|1|0|1|sz|imm8:8" +
+	"VMOV<c> <Sn>, <Rt>" +
+	"cond:4|1|1|1|0|0|0|0|0|Vn:4|Rt:4|1|0|1|0|N|0|0|1|0|0|0|0" +
+	"VMOV<c> <Rt>, <Sn>" +
+	"cond:4|1|1|1|0|0|0|0|1|Vn:4|Rt:4|1|0|1|0|N|0|0|1|0|0|0|0" +
+	"VMOV<c>.32 <Rt>, <Dn[x]>" +
+	"cond:4|1|1|1|0|0|0|opc1|1|Vn:4|Rt:4|1|0|1|1|N|0|0|1|0|0|0|0" +
+	"VMOV<c>.32 <Dd[x]>, <Rt>" +
+	"cond:4|1|1|1|0|0|0|opc1|0|Vd:4|Rt:4|1|0|1|1|D|0|0|1|0|0|0|0" +
+	"VMOV<c>.F<32,64> <Sd,Dd>, #<imm_vfp>" +
+	"cond:4|1|1|1|0|1|D|1|1|imm4H:4|Vd:4|1|0|1|sz|0|0|0|0|imm4L:4" +
+	"VMOV<c>.F<32,64> <Sd,Dd>, <Sm,Dm>" +
+	"cond:4|1|1|1|0|1|D|1|1|0|0|0|0|Vd:4|1|0|1|sz|0|1|M|0|Vm:4" +
+	"VMRS<c> <Rt_nzcv>, FPSCR" +
+	"cond:4|1|1|1|0|1|1|1|1|0|0|0|1|Rt:4|1|0|1|0|0|0|0|1|0|0|0|0" +
+	"VMRS<c> <Rt>, <spec_reg>" +
+	"cond:4|1|1|1|0|1|1|1|1|reg:4|Rt:4|1|0|1|0|0|0|0|1|0|0|0|0" +
+	"VMSR<c> FPSCR, <Rt>" +
+	"cond:4|1|1|1|0|1|1|1|0|0|0|0|1|Rt:4|1|0|1|0|0|0|0|1|0|0|0|0" +
+	"VMSR<c> <spec_reg_msr>, <Rt>" +
+	"cond:4|1|1|1|0|1|1|1|0|reg:4|Rt:4|1|0|1|0|0|0|0|1|0|0|0|0" +
+	"VMUL<c>.F<32,64> <Sd,Dd>, <Sn,Dn>, <Sm,Dm>" +
+	"cond:4|1|1|1|0|0|D|1|0|Vn:4|Vd:4|1|0|1|sz|N|0|M|0|Vm:4" +
+	"VNEG<c>.F<32,64> <Sd,Dd>, <Sm,Dm>" +
+	"cond:4|1|1|1|0|1|D|1|1|0|0|0|1|Vd:4|1|0|1|sz|0|1|M|0|Vm:4" +
+	"VN<MLS,MLA><c>.F<32,64> <Sd,Dd>, <Sn,Dn>, <Sm,Dm>" +
+	"cond:4|1|1|1|0|0|D|0|1|Vn:4|Vd:4|1|0|1|sz|N|op|M|0|Vm:4" +
+	"VNMUL<c>.F<32,64> <Sd,Dd>, <Sn,Dn>, <Sm,Dm>" +
+	"cond:4|1|1|1|0|0|D|1|0|Vn:4|Vd:4|1|0|1|sz|N|1|M|0|Vm:4" +
+	"VPOP<c> <vlist32>" +
+	"cond:4|1|1|0|0|1|D|1|1|1|1|0|1|Vd:4|1|0|1|0|imm8:8" +
+	"VPOP<c> <vlist64>" +
+	"cond:4|1|1|0|0|1|D|1|1|1|1|0|1|Vd:4|1|0|1|1|imm8:8" +
+	"VPUSH<c> <vlist32>" +
+	"cond:4|1|1|0|1|0|D|1|0|1|1|0|1|Vd:4|1|0|1|0|imm8:8" +
+	"VPUSH<c> <vlist64>" +
+	"cond:4|1|1|0|1|0|D|1|0|1|1|0|1|Vd:4|1|0|1|1|imm8:8" +
+	"VSQRT<c>.F<32,64> <Sd,Dd>, <Sm,Dm>" +
+	"cond:4|1|1|1|0|1|D|1|1|0|0|0|1|Vd:4|1|0|1|sz|1|1|M|0|Vm:4" +
+	"VSTMIA<c> <Rn>{!}, <vlist32>" +
+	"cond:4|1|1|0|0|1|D|W|0|Rn:4|Vd:4|1|0|1|0|imm8:8" +
+	"VSTMIA<c> <Rn>{!}, <vlist64>" +
+	"cond:4|1|1|0|0|1|D|W|0|Rn:4|Vd:4|1|0|1|1|imm8:8" +
+	"VSTMDB<c> <Rn>{!}, <vlist32>" +
+	"cond:4|1|1|0|1|0|D|W|0|Rn:4|Vd:4|1|0|1|0|imm8:8" +
+	"VSTMDB<c> <Rn>{!}, <vlist64>" +
+	"cond:4|1|1|0|1|0|D|W|0|Rn:4|Vd:4|1|0|1|1|imm8:8" +
+	"VSTR<c> <Sd,Dd>, [<Rn>{,#+/-<imm8>}]" +
+	"cond:4|1|1|0|1|U|D|0|0|Rn:4|Vd:4|1|0|1|sz|imm8:8" +
+	"VSUB<c>.F<32,64> <Sd,Dd>, <Sn,Dn>, <Sm,Dm>" +
+	"cond:4|1|1|1|0|0|D|1|1|Vn:4|Vd:4|1|0|1|sz|N|1|M|0|Vm:4WFE<c>" +
+	"cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|0|1|0" +
+	"WFI<c>" +
+	"cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|0|1|1" +
+	"YIELD<c>" +
+	"cond:4|0|0|1|1|0|0|1|0|0|0|0|0|(1)|(1)|(1)|(1)|(0)|(0)|(0)|(0)|0|0|0|0|0|0|0|1" +
+	"UNDEF" +
+	"1|1|1|1|0|1|1|1|1|1|1|1|1|0|1|0|1|0|1|1|1|1|0|0|1|1|1|1|1|1|0|1"
+
+var instSyntaxIndex = [...][4]uint16{
+	{0, 28, 28, 69},
+	{69, 105, 105, 158},
+	{158, 192, 192, 245},
+	{245, 273, 273, 314},
+	{314, 350, 350, 403},
+	{403, 437, 437, 490},
+	{490, 516, 516, 560},
+	{560, 592, 592, 648},
+	{648, 676, 676, 717},
+	{717, 753, 753, 806},
+	{806, 840, 840, 893},
+	{893, 923, 923, 976},
+	{976, 1000, 1000, 1053},
+	{1053, 1067, 1067, 1090},
+	{1090, 1117, 1117, 1168},
+	{1168, 1200, 1200, 1248},
+	{1248, 1276, 1276, 1317},
+	{1317, 1353, 1353, 1406},
+	{1406, 1440, 1440, 1493},
+	{1493, 1511, 1511, 1557},
+	{1557, 1572, 1572, 1595},
+	{1595, 1609, 1609, 1633},
+	{1633, 1644, 1644, 1727},
+	{1633, 1644, 1644, 1727},
+	{1727, 1737, 1737, 1820},
+	{1727, 1737, 1737, 1820},
+	{1820, 1831, 1831, 1914},
+	{1820, 1831, 1831, 1914},
+	{1914, 1919, 1919, 2014},
+	{1914, 1919, 1919, 2014},
+	{2014, 2030, 2030, 2102},
+	{2014, 2030, 2030, 2102},
+	{2102, 2122, 2122, 2174},
+	{2102, 2122, 2122, 2174},
+	{2174, 2202, 2202, 2266},
+	{2174, 2202, 2202, 2266},
+	{2266, 2292, 2292, 2356},
+	{2266, 2292, 2292, 2356},
+	{2356, 2376, 2376, 2428},
+	{2356, 2376, 2376, 2428},
+	{2428, 2456, 2456, 2520},
+	{2428, 2456, 2456, 2520},
+	{2520, 2546, 2546, 2610},
+	{2520, 2546, 2546, 2610},
+	{2610, 2626, 2626, 2705},
+	{2610, 2626, 2626, 2705},
+	{2705, 2718, 2718, 2806},
+	{2705, 2718, 2718, 2806},
+	{2806, 2819, 2819, 2907},
+	{2806, 2819, 2819, 2907},
+	{2907, 2935, 2935, 2976},
+	{2976, 3012, 3012, 3065},
+	{3065, 3099, 3099, 3152},
+	{3152, 3159, 3159, 3245},
+	{3152, 3159, 3159, 3245},
+	{3245, 3258, 3258, 3346},
+	{3245, 3258, 3258, 3346},
+	{3346, 3372, 3372, 3416},
+	{3416, 3444, 3444, 3488},
+	{3488, 3516, 3516, 3560},
+	{3560, 3588, 3588, 3632},
+	{3632, 3656, 3656, 3704},
+	{3632, 3656, 3656, 3704},
+	{3704, 3744, 3744, 3797},
+	{3797, 3832, 3832, 3873},
+	{3873, 3898, 3898, 3946},
+	{3873, 3898, 3898, 3946},
+	{3946, 3987, 3987, 4040},
+	{4040, 4076, 4076, 4117},
+	{4117, 4149, 4149, 4190},
+	{4190, 4229, 4229, 4282},
+	{4282, 4319, 4319, 4380},
+	{4282, 4319, 4319, 4380},
+	{4380, 4422, 4422, 4478},
+	{4478, 4498, 4498, 4570},
+	{4478, 4498, 4498, 4570},
+	{4570, 4592, 4592, 4664},
+	{4570, 4592, 4592, 4664},
+	{4664, 4692, 4692, 4764},
+	{4664, 4692, 4692, 4764},
+	{4764, 4786, 4786, 4858},
+	{4764, 4786, 4786, 4858},
+	{4858, 4888, 4888, 4941},
+	{4941, 4976, 4976, 5032},
+	{5032, 5067, 5067, 5123},
+	{5123, 5153, 5153, 5206},
+	{5206, 5237, 5237, 5290},
+	{5290, 5326, 5326, 5382},
+	{5382, 5418, 5418, 5474},
+	{5474, 5505, 5505, 5558},
+	{5558, 5589, 5589, 5642},
+	{5642, 5678, 5678, 5734},
+	{5734, 5770, 5770, 5826},
+	{5826, 5857, 5857, 5910},
+	{5910, 5945, 5945, 5986},
+	{5986, 6024, 6024, 6077},
+	{6077, 6107, 6107, 6160},
+	{6160, 6184, 6184, 6237},
+	{6237, 6267, 6267, 6320},
+	{6320, 6344, 6344, 6397},
+	{6397, 6426, 6426, 6476},
+	{6476, 6502, 6502, 6552},
+	{6552, 6575, 6575, 6618},
+	{6618, 6641, 6641, 6684},
+	{6684, 6707, 6707, 6751},
+	{6751, 6770, 6770, 6826},
+	{6826, 6842, 6842, 6925},
+	{6826, 6842, 6842, 6925},
+	{6925, 6941, 6941, 7024},
+	{6925, 6941, 6941, 7024},
+	{7024, 7048, 7048, 7109},
+	{7024, 7048, 7048, 7109},
+	{7109, 7132, 7132, 7184},
+	{7109, 7132, 7132, 7184},
+	{7184, 7215, 7215, 7279},
+	{7184, 7215, 7215, 7279},
+	{7279, 7308, 7308, 7372},
+	{7279, 7308, 7308, 7372},
+	{7372, 7378, 7378, 7456},
+	{7372, 7378, 7378, 7456},
+	{7456, 7484, 7484, 7525},
+	{7525, 7561, 7561, 7614},
+	{7614, 7648, 7648, 7701},
+	{7701, 7743, 7743, 7794},
+	{7794, 7810, 7810, 7868},
+	{7794, 7810, 7810, 7868},
+	{7868, 7893, 7893, 7946},
+	{7868, 7893, 7893, 7946},
+	{7946, 7978, 7978, 8043},
+	{7946, 7978, 7978, 8043},
+	{8043, 8065, 8065, 8118},
+	{8043, 8065, 8065, 8118},
+	{8118, 8147, 8147, 8212},
+	{8118, 8147, 8147, 8212},
+	{8212, 8231, 8231, 8278},
+	{8278, 8297, 8297, 8356},
+	{8356, 8376, 8376, 8423},
+	{8423, 8443, 8443, 8502},
+	{8502, 8526, 8526, 8587},
+	{8502, 8526, 8526, 8587},
+	{8587, 8610, 8610, 8671},
+	{8587, 8610, 8610, 8671},
+	{8671, 8693, 8693, 8754},
+	{8671, 8693, 8693, 8754},
+	{8754, 8776, 8776, 8837},
+	{8754, 8776, 8776, 8837},
+	{8837, 8860, 8860, 8921},
+	{8837, 8860, 8860, 8921},
+	{8921, 8944, 8944, 8997},
+	{8997, 9019, 9019, 9080},
+	{8997, 9019, 9019, 9080},
+	{9080, 9104, 9104, 9165},
+	{9080, 9104, 9104, 9165},
+	{9165, 9188, 9188, 9249},
+	{9165, 9188, 9188, 9249},
+	{9249, 9271, 9271, 9324},
+	{9324, 9341, 9341, 9413},
+	{9324, 9341, 9341, 9413},
+	{9413, 9431, 9431, 9503},
+	{9413, 9431, 9431, 9503},
+	{9503, 9519, 9519, 9591},
+	{9503, 9519, 9519, 9591},
+	{9591, 9609, 9609, 9681},
+	{9591, 9609, 9609, 9681},
+	{9681, 9708, 9708, 9761},
+	{9761, 9785, 9785, 9838},
+	{9838, 9857, 9857, 9913},
+	{9913, 9941, 9941, 9982},
+	{9982, 10018, 10018, 10071},
+	{10071, 10105, 10105, 10158},
+	{10158, 10186, 10186, 10227},
+	{10227, 10263, 10263, 10316},
+	{10316, 10350, 10350, 10403},
+	{10403, 10427, 10427, 10488},
+	{10403, 10427, 10427, 10488},
+	{10488, 10511, 10511, 10572},
+	{10488, 10511, 10511, 10572},
+	{10572, 10594, 10594, 10655},
+	{10572, 10594, 10594, 10655},
+	{10655, 10683, 10683, 10724},
+	{10724, 10760, 10760, 10813},
+	{10813, 10847, 10847, 10900},
+	{10900, 10935, 10935, 10987},
+	{10987, 11008, 11008, 11069},
+	{10987, 11008, 11008, 11069},
+	{11069, 11094, 11094, 11175},
+	{11069, 11094, 11094, 11175},
+	{11175, 11181, 11181, 11259},
+	{11175, 11181, 11181, 11259},
+	{11259, 11284, 11284, 11345},
+	{11259, 11284, 11284, 11345},
+	{11345, 11369, 11369, 11430},
+	{11345, 11369, 11369, 11430},
+	{11430, 11453, 11453, 11514},
+	{11430, 11453, 11453, 11514},
+	{11514, 11537, 11537, 11598},
+	{11514, 11537, 11537, 11598},
+	{11598, 11623, 11623, 11684},
+	{11598, 11623, 11623, 11684},
+	{11684, 11708, 11708, 11769},
+	{11684, 11708, 11708, 11769},
+	{11769, 11802, 11802, 11852},
+	{11852, 11883, 11883, 11933},
+	{11933, 11971, 11971, 12025},
+	{12025, 12061, 12061, 12115},
+	{12115, 12150, 12150, 12204},
+	{12204, 12235, 12235, 12285},
+	{12285, 12316, 12316, 12366},
+	{12366, 12402, 12402, 12456},
+	{12456, 12487, 12487, 12537},
+	{12537, 12568, 12568, 12618},
+	{12618, 12644, 12644, 12697},
+	{12697, 12723, 12723, 12776},
+	{12776, 12804, 12804, 12857},
+	{12857, 12892, 12892, 12946},
+	{12946, 12972, 12972, 13025},
+	{13025, 13051, 13051, 13104},
+	{13104, 13137, 13137, 13203},
+	{13104, 13137, 13137, 13203},
+	{13203, 13244, 13244, 13298},
+	{13298, 13320, 13320, 13381},
+	{13298, 13320, 13320, 13381},
+	{13381, 13405, 13405, 13466},
+	{13381, 13405, 13405, 13466},
+	{13466, 13489, 13489, 13550},
+	{13466, 13489, 13489, 13550},
+	{13550, 13576, 13576, 13620},
+	{13620, 13648, 13648, 13692},
+	{13692, 13720, 13720, 13764},
+	{13764, 13792, 13792, 13836},
+	{13836, 13876, 13876, 13929},
+	{13929, 13964, 13964, 14005},
+	{14005, 14046, 14046, 14099},
+	{14099, 14135, 14135, 14176},
+	{14176, 14208, 14208, 14249},
+	{14249, 14288, 14288, 14341},
+	{14341, 14378, 14378, 14439},
+	{14341, 14378, 14378, 14439},
+	{14439, 14481, 14481, 14537},
+	{14537, 14562, 14562, 14615},
+	{14615, 14641, 14641, 14694},
+	{14694, 14727, 14727, 14780},
+	{14780, 14806, 14806, 14859},
+	{14859, 14889, 14889, 14942},
+	{14942, 14977, 14977, 15033},
+	{15033, 15068, 15068, 15124},
+	{15124, 15154, 15154, 15207},
+	{15207, 15242, 15242, 15283},
+	{15283, 15321, 15321, 15374},
+	{15374, 15402, 15402, 15443},
+	{15443, 15479, 15479, 15532},
+	{15532, 15566, 15566, 15619},
+	{15619, 15645, 15645, 15689},
+	{15689, 15721, 15721, 15777},
+	{15777, 15792, 15792, 15815},
+	{15815, 15841, 15841, 15894},
+	{15894, 15932, 15932, 15990},
+	{15990, 16026, 16026, 16084},
+	{16084, 16120, 16120, 16178},
+	{16178, 16210, 16210, 16271},
+	{16271, 16301, 16301, 16362},
+	{16362, 16392, 16392, 16453},
+	{16453, 16473, 16473, 16525},
+	{16453, 16473, 16473, 16525},
+	{16525, 16553, 16553, 16617},
+	{16525, 16553, 16553, 16617},
+	{16617, 16643, 16643, 16707},
+	{16617, 16643, 16643, 16707},
+	{16707, 16727, 16727, 16779},
+	{16707, 16727, 16727, 16779},
+	{16779, 16807, 16807, 16871},
+	{16779, 16807, 16807, 16871},
+	{16871, 16897, 16897, 16961},
+	{16871, 16897, 16897, 16961},
+	{16961, 16985, 16985, 17046},
+	{16961, 16985, 16985, 17046},
+	{17046, 17069, 17069, 17130},
+	{17046, 17069, 17069, 17130},
+	{17130, 17152, 17152, 17213},
+	{17130, 17152, 17152, 17213},
+	{17213, 17248, 17248, 17300},
+	{17300, 17325, 17325, 17386},
+	{17300, 17325, 17325, 17386},
+	{17386, 17410, 17410, 17471},
+	{17386, 17410, 17410, 17471},
+	{17471, 17494, 17494, 17555},
+	{17471, 17494, 17494, 17555},
+	{17555, 17578, 17578, 17639},
+	{17555, 17578, 17578, 17639},
+	{17639, 17664, 17664, 17725},
+	{17639, 17664, 17664, 17725},
+	{17725, 17749, 17749, 17810},
+	{17725, 17749, 17749, 17810},
+	{17810, 17842, 17842, 17896},
+	{17896, 17931, 17931, 17985},
+	{17985, 18020, 18020, 18074},
+	{18074, 18099, 18099, 18160},
+	{18074, 18099, 18099, 18160},
+	{18160, 18184, 18184, 18245},
+	{18160, 18184, 18184, 18245},
+	{18245, 18268, 18268, 18329},
+	{18245, 18268, 18268, 18329},
+	{18329, 18352, 18352, 18413},
+	{18329, 18352, 18352, 18413},
+	{18413, 18438, 18438, 18499},
+	{18413, 18438, 18438, 18499},
+	{18499, 18523, 18523, 18584},
+	{18499, 18523, 18523, 18584},
+	{18584, 18607, 18607, 18660},
+	{18660, 18689, 18689, 18739},
+	{18739, 18770, 18770, 18836},
+	{18739, 18770, 18770, 18836},
+	{18836, 18875, 18875, 18929},
+	{18929, 18951, 18951, 19012},
+	{18929, 18951, 18951, 19012},
+	{19012, 19036, 19036, 19097},
+	{19012, 19036, 19036, 19097},
+	{19097, 19120, 19120, 19181},
+	{19097, 19120, 19120, 19181},
+	{19181, 19219, 19219, 19277},
+	{19277, 19313, 19313, 19371},
+	{19371, 19407, 19407, 19465},
+	{19465, 19497, 19497, 19558},
+	{19558, 19588, 19588, 19649},
+	{19649, 19679, 19679, 19740},
+	{19740, 19788, 19788, 19843},
+	{19843, 19876, 19876, 19933},
+	{19933, 19975, 19975, 20029},
+	{20029, 20062, 20062, 20130},
+	{20029, 20062, 20062, 20130},
+	{20130, 20166, 20166, 20223},
+	{20223, 20281, 20281, 20341},
+	{20341, 20399, 20399, 20459},
+	{20459, 20501, 20501, 20558},
+	{20558, 20599, 20599, 20656},
+	{20656, 20694, 20694, 20752},
+	{20752, 20794, 20794, 20857},
+	{20857, 20899, 20899, 20953},
+	{20953, 20981, 20981, 21028},
+	{21028, 21056, 21056, 21103},
+	{21103, 21131, 21131, 21178},
+	{21178, 21206, 21206, 21253},
+	{21253, 21289, 21289, 21337},
+	{21337, 21355, 21355, 21411},
+	{21411, 21429, 21429, 21485},
+	{21485, 21509, 21509, 21568},
+	{21568, 21592, 21592, 21651},
+	{21651, 21687, 21687, 21747},
+	{21747, 21780, 21780, 21837},
+	{21837, 21861, 21861, 21920},
+	{21920, 21944, 21944, 22001},
+	{22001, 22020, 22020, 22079},
+	{22079, 22107, 22107, 22164},
+	{22164, 22206, 22206, 22260},
+	{22260, 22293, 22293, 22350},
+	{22350, 22399, 22399, 22454},
+	{22454, 22497, 22497, 22551},
+	{22551, 22568, 22568, 22618},
+	{22618, 22635, 22635, 22685},
+	{22685, 22703, 22703, 22753},
+	{22753, 22771, 22771, 22821},
+	{22821, 22855, 22855, 22912},
+	{22912, 22940, 22940, 22987},
+	{22987, 23015, 23015, 23062},
+	{23062, 23090, 23090, 23137},
+	{23137, 23165, 23165, 23212},
+	{23212, 23248, 23248, 23296},
+	{23296, 23338, 23338, 23392},
+	{23392, 23398, 23398, 23476},
+	{23392, 23398, 23398, 23476},
+	{23476, 23482, 23482, 23560},
+	{23476, 23482, 23482, 23560},
+	{23560, 23568, 23568, 23646},
+	{23560, 23568, 23568, 23646},
+	{23646, 23651, 23651, 23714},
 }
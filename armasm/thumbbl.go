@@ -0,0 +1,72 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import "encoding/binary"
+
+// DecodeThumbBL decodes the leading bytes of src as a Thumb BL or BLX
+// (immediate) instruction in the form used before Thumb-2, on ARMv4T and ARMv5,
+// where the call is encoded as two separate 16-bit instructions:
+// a prefix (H=10) holding the high bits of the offset, followed by
+// a suffix holding the low bits, H=11 for BL or H=01 for BLX.
+// The Thumb-2 encodings of BL and BLX with J1 = J2 = 1 are the same pairs,
+// so DecodeThumbBL also decodes the calls of Thumb-2 code within ±4 MB.
+//
+// For a complete pair, the result is a 4-byte BL or BLX with a PCRel argument
+// giving the offset of the target from the Thumb PC, the address of the
+// instruction plus 4. The target of a BLX is that sum rounded down to a
+// multiple of 4. The Enc field holds the two halfwords as the
+// little-endian word read from src: the prefix in the low 16 bits.
+// The Mode of the result is ModeThumb, so that Inst.Bytes writes
+// a pair as two halfwords.
+//
+// If src holds only half of a pair, either a prefix not followed by a suffix
+// or a suffix alone, DecodeThumbBL returns a 2-byte Inst for the halfword
+// and sets half. The PCRel argument of a half holds only the part of the
+// offset encoded in that halfword. Any other halfword is an error.
+func DecodeThumbBL(src []byte) (inst Inst, half bool, err error) {
+	if len(src) < 2 {
+		return Inst{}, false, errShort
+	}
+	hw1 := uint32(binary.LittleEndian.Uint16(src))
+	switch hw1 >> 11 {
+	case 0x1e: // prefix
+		hi := int32(hw1<<21) >> 9
+		if len(src) >= 4 {
+			hw2 := uint32(binary.LittleEndian.Uint16(src[2:]))
+			if op, ok := thumbBLSuffix(hw2); ok {
+				inst := Inst{
+					Op:   op,
+					Enc:  hw1 | hw2<<16,
+					Len:  4,
+					Mode: ModeThumb,
+					Args: Args{PCRel(hi | int32(hw2&0x7ff)<<1)},
+				}
+				return inst, false, nil
+			}
+		}
+		return Inst{Op: BL, Enc: hw1, Len: 2, Mode: ModeThumb, Args: Args{PCRel(hi)}}, true, nil
+
+	case 0x1f, 0x1d: // suffix without prefix
+		if op, ok := thumbBLSuffix(hw1); ok {
+			return Inst{Op: op, Enc: hw1, Len: 2, Mode: ModeThumb, Args: Args{PCRel(int32(hw1&0x7ff) << 1)}}, true, nil
+		}
+	}
+	return Inst{}, false, errUnknown
+}
+
+// thumbBLSuffix reports whether hw is the suffix of a BL or BLX pair,
+// and if so returns the instruction's opcode.
+func thumbBLSuffix(hw uint32) (Op, bool) {
+	switch hw >> 11 {
+	case 0x1f:
+		return BL, true
+	case 0x1d:
+		if hw&1 == 0 {
+			return BLX, true
+		}
+	}
+	return 0, false
+}
@@ -0,0 +1,61 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"encoding/hex"
+	"testing"
+)
+
+var thumbBLTests = []struct {
+	hex  string
+	inst Inst
+	half bool
+	err  error
+}{
+	{"00f003f8", Inst{Op: BL, Enc: 0xf803f000, Len: 4, Mode: ModeThumb, Args: Args{PCRel(6)}}, false, nil},
+	{"fff7fdff", Inst{Op: BL, Enc: 0xfffdf7ff, Len: 4, Mode: ModeThumb, Args: Args{PCRel(-6)}}, false, nil},
+	{"00f002e8", Inst{Op: BLX, Enc: 0xe802f000, Len: 4, Mode: ModeThumb, Args: Args{PCRel(4)}}, false, nil},
+	{"01f000f8", Inst{Op: BL, Enc: 0xf800f001, Len: 4, Mode: ModeThumb, Args: Args{PCRel(0x1000)}}, false, nil},
+	{"00f0", Inst{Op: BL, Enc: 0xf000, Len: 2, Mode: ModeThumb, Args: Args{PCRel(0)}}, true, nil},
+	{"ff077047", Inst{}, false, errUnknown},
+	{"fff77047", Inst{Op: BL, Enc: 0xf7ff, Len: 2, Mode: ModeThumb, Args: Args{PCRel(-0x1000)}}, true, nil},
+	{"03f8", Inst{Op: BL, Enc: 0xf803, Len: 2, Mode: ModeThumb, Args: Args{PCRel(6)}}, true, nil},
+	{"02e8", Inst{Op: BLX, Enc: 0xe802, Len: 2, Mode: ModeThumb, Args: Args{PCRel(4)}}, true, nil},
+	{"00f001e8", Inst{Op: BL, Enc: 0xf000, Len: 2, Mode: ModeThumb, Args: Args{PCRel(0)}}, true, nil},
+	{"7047", Inst{}, false, errUnknown},
+	{"00", Inst{}, false, errShort},
+}
+
+func TestDecodeThumbBL(t *testing.T) {
+	for _, tt := range thumbBLTests {
+		src, err := hex.DecodeString(tt.hex)
+		if err != nil {
+			t.Fatal(err)
+		}
+		inst, half, err := DecodeThumbBL(src)
+		if err != tt.err || half != tt.half || inst != tt.inst {
+			t.Errorf("DecodeThumbBL(%s) = %+v, %v, %v, want %+v, %v, %v", tt.hex, inst, half, err, tt.inst, tt.half, tt.err)
+		}
+	}
+}
+
+func TestThumbBLBytes(t *testing.T) {
+	src := []byte{0x00, 0xf0, 0x00, 0xf8}
+	inst, _, err := DecodeThumbBL(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := inst.Bytes(binary.LittleEndian); !bytes.Equal(b, src) {
+		t.Errorf("%v.Bytes(LittleEndian) = % x, want % x", inst, b, src)
+	}
+
+	// Big-endian Thumb code stores each halfword big-endian.
+	if b, want := inst.Bytes(binary.BigEndian), []byte{0xf0, 0x00, 0xf8, 0x00}; !bytes.Equal(b, want) {
+		t.Errorf("%v.Bytes(BigEndian) = % x, want % x", inst, b, want)
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+)
+
+// A TraceResult says what became of a decoding table entry
+// whose fixed bits matched an instruction.
+type TraceResult uint8
+
+const (
+	// TraceMatched is the entry that produced the decoded instruction.
+	TraceMatched TraceResult = iota
+
+	// TraceOverridden entries decoded the instruction
+	// but were replaced by a later entry with higher priority.
+	TraceOverridden
+
+	// TraceShadowed entries were not tried, because an earlier entry
+	// with the same or higher priority had already decoded the instruction.
+	TraceShadowed
+
+	// TraceRejected entries were rejected by a constraint on the
+	// instruction's operands or condition.
+	TraceRejected
+)
+
+var traceResultNames = []string{
+	TraceMatched:    "matched",
+	TraceOverridden: "overridden",
+	TraceShadowed:   "shadowed",
+	TraceRejected:   "rejected",
+}
+
+func (r TraceResult) String() string {
+	if int(r) < len(traceResultNames) {
+		return traceResultNames[r]
+	}
+	return "TraceResult(?)"
+}
+
+// A TraceEntry records the decoder's treatment of one decoding table entry.
+type TraceEntry struct {
+	Op     Op          // the opcode the entry decodes to, or its base opcode if the entry was not tried
+	Mask   uint32      // bits fixed by the entry
+	Value  uint32      // values of the fixed bits
+	Syntax string      // assembly syntax in the ARM manual, like "ADC{S}<c> <Rd>,<Rn>,#<const>"
+	Bits   string      // bit layout in the ARM manual, like "cond:4|0|0|1|0|1|0|1|S|Rn:4|Rd:4|imm12:12"
+	Result TraceResult // what became of the entry
+	Reason string      // why the entry did not produce the instruction, if it did not
+
+	index int // index in instFormats
+}
+
+// A DecodeTrace explains the decoding of a single ARM instruction.
+type DecodeTrace struct {
+	Enc     uint32       // the instruction word
+	Entries []TraceEntry // the entries whose fixed bits match Enc, in table order
+}
+
+// TraceDecode decodes the leading bytes in src as a single instruction,
+// as Decode does, and also returns a trace listing each decoding table entry
+// that partially matched the instruction and why it was or was not used.
+// An entry partially matches if its fixed bits match the instruction,
+// or would except for a condition field of 0b1111.
+// When decoding fails, the trace usually names the constraint that
+// rejected the instruction, such as an addressing mode or register list
+// that the ARM manual does not allow; an empty trace means that no entry's
+// fixed bits match, so that the word is outside the known encodings.
+//
+// Tracing is much slower than Decode and is meant for debugging
+// the decoder and for understanding why a particular word does not decode.
+// Only ARM mode is supported; for other modes or truncated input,
+// the trace is nil.
+func TraceDecode(src []byte, mode Mode) (Inst, *DecodeTrace, error) {
+	if mode != ModeARM {
+		return Inst{}, nil, errMode
+	}
+	if len(src) < 4 {
+		return Inst{}, nil, errShort
+	}
+	x := binary.LittleEndian.Uint32(src)
+	t := &DecodeTrace{Enc: x}
+	inst, i := decodeTrace(x, t)
+	if i < 0 {
+		return Inst{}, t, errUnknown
+	}
+	return inst, t, nil
+}
+
+// add records the treatment of instFormats[i], which decodes to op.
+func (t *DecodeTrace) add(i int, op Op, result TraceResult, reason string) {
+	f := &instFormats[i]
+	syntax, bits := instSyntax(i)
+	t.Entries = append(t.Entries, TraceEntry{
+		Op:     op,
+		Mask:   f.mask,
+		Value:  f.value,
+		Syntax: syntax,
+		Bits:   bits,
+		Result: result,
+		Reason: reason,
+		index:  i,
+	})
+}
+
+// override marks the entry matched so far, if any, as overridden by op.
+func (t *DecodeTrace) override(op Op) {
+	for i := range t.Entries {
+		e := &t.Entries[i]
+		if e.Result == TraceMatched {
+			e.Result = TraceOverridden
+			e.Reason = fmt.Sprintf("entry for %v has higher priority", op)
+		}
+	}
+}
+
+// String returns a multi-line report of the trace, one entry per line.
+func (t *DecodeTrace) String() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%08x:", t.Enc)
+	if len(t.Entries) == 0 {
+		buf.WriteString(" no matching entries\n")
+		return buf.String()
+	}
+	buf.WriteString("\n")
+	for _, e := range t.Entries {
+		fmt.Fprintf(&buf, "\t%s\t%v\t%s", e.Result, e.Op, e.Syntax)
+		if e.Reason != "" {
+			fmt.Fprintf(&buf, "\t(%s)", e.Reason)
+		}
+		buf.WriteString("\n")
+	}
+	return buf.String()
+}
+
+// argConstraint describes the constraint that makes decodeArg reject an argument.
+func argConstraint(aop instArg) string {
+	switch aop {
+	case arg_spec_reg:
+		return "reg must be FPSID, FPSCR, MVFR1, MVFR0, or FPEXC (0, 1, 6, 7, or 8)"
+	case arg_spec_reg_msr:
+		return "reg must be FPSID, FPSCR, or FPEXC (0, 1, or 8); MVFR0 and MVFR1 are read-only"
+	case arg_imm5_nz:
+		return "imm5 must be nonzero"
+	case arg_lsb_width:
+		return "msb must be at least lsb"
+	case arg_mem_R_pm_R_shift_imm_W, arg_mem_R_pm_imm12_W, arg_mem_R_pm_imm8_W:
+		return "P=0 and W=1 selects the unprivileged (T) form"
+	case arg_registers2:
+		return "register list must hold at least 2 registers"
+	case arg_vlist32:
+		return "register list must be nonempty and end at or before S31"
+	case arg_vlist64:
+		return "imm8 must be even (odd selects FLDMX/FSTMX), and the register list must hold 1 to 16 registers ending at or before D31"
+	}
+	return "invalid value"
+}
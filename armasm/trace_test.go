@@ -0,0 +1,108 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"encoding/binary"
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+var traceTests = []struct {
+	enc     uint32
+	ok      bool
+	results []TraceResult
+	reason  string // reason of the first entry that did not match
+}{
+	{0xe3a00001, true, []TraceResult{TraceMatched}, ""},
+	{0xe92d0010, true, []TraceResult{TraceRejected, TraceMatched}, "argument 1: register list must hold at least 2 registers"},
+	{0xe1a00000, true, []TraceResult{TraceRejected, TraceMatched}, "argument 3: imm5 must be nonzero"},
+	{0xe4b00004, true, []TraceResult{TraceRejected, TraceMatched}, "argument 2: P=0 and W=1 selects the unprivileged (T) form"},
+	{0x08bdfab0, true, []TraceResult{TraceOverridden, TraceMatched}, "entry for POP.EQ has higher priority"},
+	{0x229d4d6d, true, []TraceResult{TraceMatched, TraceShadowed}, "entry for ADD.S.CS matched first"},
+	{0xf3a00001, false, []TraceResult{TraceRejected}, "condition 0b1111 selects an unconditional instruction"},
+	{0xec900b00, false, []TraceResult{TraceRejected}, "argument 2: imm8 must be even"},
+	{0xe6000010, false, nil, ""},
+}
+
+func TestTraceDecode(t *testing.T) {
+	for _, tt := range traceTests {
+		var src [4]byte
+		binary.LittleEndian.PutUint32(src[:], tt.enc)
+		inst, tr, err := TraceDecode(src[:], ModeARM)
+		if (err == nil) != tt.ok {
+			t.Errorf("TraceDecode(%08x): error %v, want ok=%v", tt.enc, err, tt.ok)
+			continue
+		}
+		var results []TraceResult
+		reason := ""
+		for _, e := range tr.Entries {
+			results = append(results, e.Result)
+			if e.Result != TraceMatched && reason == "" {
+				reason = e.Reason
+			}
+		}
+		if len(results) != len(tt.results) || !strings.HasPrefix(reason, tt.reason) {
+			t.Errorf("TraceDecode(%08x) =\n%v\nwant results %v, reason %q", tt.enc, tr, tt.results, tt.reason)
+			continue
+		}
+		for i := range results {
+			if results[i] != tt.results[i] {
+				t.Errorf("TraceDecode(%08x) =\n%v\nwant results %v", tt.enc, tr, tt.results)
+				break
+			}
+		}
+		if tt.ok {
+			want, _ := Decode(src[:], ModeARM)
+			if inst != want {
+				t.Errorf("TraceDecode(%08x) = %v, want %v", tt.enc, inst, want)
+			}
+		}
+	}
+
+	if _, tr, err := TraceDecode(make([]byte, 2), ModeThumb); err == nil || tr != nil {
+		t.Errorf("TraceDecode(Thumb) = %v, %v, want nil trace and error", tr, err)
+	}
+}
+
+func TestTraceDecodeRandom(t *testing.T) {
+	// Tracing must not change the decoding.
+	r := rand.New(rand.NewSource(1))
+	var src [4]byte
+	for i := 0; i < 10000; i++ {
+		binary.LittleEndian.PutUint32(src[:], r.Uint32())
+		want, werr := Decode(src[:], ModeARM)
+		inst, tr, err := TraceDecode(src[:], ModeARM)
+		if inst != want || (err == nil) != (werr == nil) {
+			t.Fatalf("TraceDecode(%x) = %v, %v, want %v, %v", src, inst, err, want, werr)
+		}
+		matched := 0
+		for _, e := range tr.Entries {
+			if e.Result == TraceMatched {
+				matched++
+				if e.Op != inst.Op {
+					t.Fatalf("TraceDecode(%x) matched %v, decoded %v", src, e.Op, inst.Op)
+				}
+			}
+		}
+		if matched != 1 && err == nil || matched != 0 && err != nil {
+			t.Fatalf("TraceDecode(%x) = %v with %d matched entries", src, err, matched)
+		}
+	}
+}
+
+func TestTraceString(t *testing.T) {
+	_, tr, _ := TraceDecode([]byte{0x10, 0x00, 0x2d, 0xe9}, ModeARM)
+	want := "e92d0010:\n" +
+		"\trejected\tPUSH\tPUSH<c> <registers2>\t(argument 1: register list must hold at least 2 registers)\n" +
+		"\tmatched\tSTMDB\tSTMDB<c> <Rn>{!},<registers>\n"
+	if s := tr.String(); s != want {
+		t.Errorf("String() = %q, want %q", s, want)
+	}
+	if s := (&DecodeTrace{Enc: 0xe6000010}).String(); s != "e6000010: no matching entries\n" {
+		t.Errorf("String() = %q", s)
+	}
+}
@@ -0,0 +1,75 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+// ForEachReg calls fn for each register mentioned by the arguments of inst,
+// in argument order. It visits the register of a Reg, RegX, or RegShift;
+// the register and shift count register of a RegShiftReg; each register
+// in a RegList or RegRange, in increasing order; and the base of a Mem,
+// followed by its index register if the index expression uses one.
+// A register mentioned more than once is visited more than once.
+//
+// Registers used implicitly, such as SP by PUSH or LR by BL, are not visited.
+func ForEachReg(inst Inst, fn func(Reg)) {
+	for _, arg := range inst.Args {
+		switch a := arg.(type) {
+		case Reg:
+			fn(a)
+		case RegX:
+			fn(a.Reg)
+		case RegShift:
+			fn(a.Reg)
+		case RegShiftReg:
+			fn(a.Reg)
+			fn(a.RegCount)
+		case RegList:
+			for r := R0; r <= R15; r++ {
+				if a&(1<<(r-R0)) != 0 {
+					fn(r)
+				}
+			}
+		case RegRange:
+			for _, r := range a.Regs() {
+				fn(r)
+			}
+		case Mem:
+			fn(a.Base)
+			if a.Sign != 0 {
+				fn(a.Index)
+			}
+		}
+	}
+}
+
+// Registers returns the registers mentioned by the arguments of inst,
+// in the order visited by ForEachReg.
+func Registers(inst Inst) []Reg {
+	var regs []Reg
+	ForEachReg(inst, func(r Reg) { regs = append(regs, r) })
+	return regs
+}
+
+// Immediates returns the integer constants among the arguments of inst,
+// in argument order: the value of an Imm or ImmAlt, the signed offset
+// of a PCRel, and the offset of a Mem whose index expression is a constant.
+// Shift counts, labels, and floating-point constants are not included.
+func Immediates(inst Inst) []int64 {
+	var imms []int64
+	for _, arg := range inst.Args {
+		switch a := arg.(type) {
+		case Imm:
+			imms = append(imms, int64(uint32(a)))
+		case ImmAlt:
+			imms = append(imms, int64(uint32(a.Imm())))
+		case PCRel:
+			imms = append(imms, int64(a))
+		case Mem:
+			if a.Sign == 0 && a.Mode != AddrLDM && a.Mode != AddrLDM_WB {
+				imms = append(imms, int64(a.Offset))
+			}
+		}
+	}
+	return imms
+}
@@ -0,0 +1,83 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armasm
+
+import (
+	"reflect"
+	"testing"
+)
+
+var visitTests = []struct {
+	inst Inst
+	regs []Reg
+	imms []int64
+}{
+	{
+		Inst{Op: ADD, Args: Args{R0, R1, Imm(4)}},
+		[]Reg{R0, R1},
+		[]int64{4},
+	},
+	{
+		Inst{Op: ADD, Args: Args{R0, R1, RegShiftReg{R2, ShiftRight, R3}}},
+		[]Reg{R0, R1, R2, R3},
+		nil,
+	},
+	{
+		Inst{Op: MOV, Args: Args{R0, RegShift{R1, ShiftLeft, 2}}},
+		[]Reg{R0, R1},
+		nil,
+	},
+	{
+		Inst{Op: MOV, Args: Args{R0, ImmAlt{1, 2}}},
+		[]Reg{R0},
+		[]int64{0x40000000},
+	},
+	{
+		Inst{Op: PUSH, Args: Args{RegList(1<<4 | 1<<5 | 1<<14)}},
+		[]Reg{R4, R5, R14},
+		nil,
+	},
+	{
+		Inst{Op: LDM, Args: Args{Mem{Base: R1, Mode: AddrLDM_WB}, RegList(1<<0 | 1<<1)}},
+		[]Reg{R1, R0, R1},
+		nil,
+	},
+	{
+		Inst{Op: LDR, Args: Args{R0, Mem{Base: R1, Mode: AddrOffset, Offset: -8}}},
+		[]Reg{R0, R1},
+		[]int64{-8},
+	},
+	{
+		Inst{Op: STR, Args: Args{R0, Mem{Base: R1, Mode: AddrPreIndex, Sign: -1, Index: R2}}},
+		[]Reg{R0, R1, R2},
+		nil,
+	},
+	{
+		Inst{Op: VMOV, Args: Args{RegX{D0, 1}, R2}},
+		[]Reg{D0, R2},
+		nil,
+	},
+	{
+		Inst{Op: VPUSH, Args: Args{RegRange{D8, 3}}},
+		[]Reg{D8, D9, D10},
+		nil,
+	},
+	{
+		Inst{Op: B, Args: Args{PCRel(-16)}},
+		nil,
+		[]int64{-16},
+	},
+}
+
+func TestVisit(t *testing.T) {
+	for _, tt := range visitTests {
+		if regs := Registers(tt.inst); !reflect.DeepEqual(regs, tt.regs) {
+			t.Errorf("Registers(%v) = %v, want %v", tt.inst, regs, tt.regs)
+		}
+		if imms := Immediates(tt.inst); !reflect.DeepEqual(imms, tt.imms) {
+			t.Errorf("Immediates(%v) = %v, want %v", tt.inst, imms, tt.imms)
+		}
+	}
+}
@@ -0,0 +1,261 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package armattr parses ARM ELF build attributes.
+//
+// The attributes, described in the ARM document "Addenda to, and Errata in,
+// the ABI for the ARM Architecture", are stored in the ELF section
+// .ARM.attributes. They record the architecture and floating-point
+// hardware the code was compiled for, which determines the instructions
+// a disassembler should accept.
+package armattr
+
+import (
+	"debug/elf"
+	"encoding/binary"
+	"errors"
+	"fmt"
+
+	"rsc.io/arm/armasm"
+)
+
+// Attribute tags used by this package.
+const (
+	TagCPURawName     = 4
+	TagCPUName        = 5
+	TagCPUArch        = 6
+	TagCPUArchProfile = 7
+	TagFPArch         = 10
+	TagAdvancedSIMD   = 12
+	TagCompatibility  = 32
+	TagConformance    = 67
+)
+
+const (
+	formatVersion      = 'A'
+	vendorAEABI        = "aeabi"
+	tagFile            = 1                  // sub-subsection of attributes applying to the whole file
+	sectionTypeARMAttr = elf.SHT_LOPROC + 3 // SHT_ARM_ATTRIBUTES
+)
+
+// Attributes are the file-scope public ("aeabi") build attributes of an object.
+type Attributes struct {
+	CPUName    string // Tag_CPU_name, like "cortex-a8", or ""
+	CPUArch    int    // Tag_CPU_arch
+	CPUProfile byte   // Tag_CPU_arch_profile: 'A', 'R', 'M', 'S', or 0
+	FPArch     int    // Tag_FP_arch
+	SIMDArch   int    // Tag_Advanced_SIMD_arch
+
+	// Ints and Strings hold every attribute, by tag.
+	Ints    map[int]uint64
+	Strings map[int]string
+}
+
+var errShort = errors.New("build attributes truncated")
+
+// Parse parses the contents of an .ARM.attributes section.
+// Attributes of vendors other than "aeabi" and attributes that apply
+// only to particular sections or symbols are skipped.
+func Parse(data []byte) (*Attributes, error) {
+	if len(data) == 0 || data[0] != formatVersion {
+		return nil, fmt.Errorf("unknown build attributes format")
+	}
+	a := &Attributes{Ints: make(map[int]uint64), Strings: make(map[int]string)}
+	data = data[1:]
+	for len(data) > 0 {
+		if len(data) < 4 {
+			return nil, errShort
+		}
+		n := binary.LittleEndian.Uint32(data)
+		if n < 4 || uint64(n) > uint64(len(data)) {
+			return nil, errShort
+		}
+		sub := data[4:n]
+		data = data[n:]
+		vendor, sub, err := cstring(sub)
+		if err != nil {
+			return nil, err
+		}
+		if vendor != vendorAEABI {
+			continue
+		}
+		for len(sub) > 0 {
+			if len(sub) < 5 {
+				return nil, errShort
+			}
+			tag := sub[0]
+			n := binary.LittleEndian.Uint32(sub[1:])
+			if n < 5 || uint64(n) > uint64(len(sub)) {
+				return nil, errShort
+			}
+			attrs := sub[5:n]
+			sub = sub[n:]
+			if tag != tagFile {
+				continue
+			}
+			if err := a.parse(attrs); err != nil {
+				return nil, err
+			}
+		}
+	}
+	a.CPUName = a.Strings[TagCPUName]
+	a.CPUArch = int(a.Ints[TagCPUArch])
+	a.CPUProfile = byte(a.Ints[TagCPUArchProfile])
+	a.FPArch = int(a.Ints[TagFPArch])
+	a.SIMDArch = int(a.Ints[TagAdvancedSIMD])
+	return a, nil
+}
+
+// parse parses a list of attributes into a.
+func (a *Attributes) parse(data []byte) error {
+	for len(data) > 0 {
+		tag, data1, err := uleb(data)
+		if err != nil {
+			return err
+		}
+		data = data1
+		switch {
+		case tag == TagCompatibility:
+			// A flag followed by a vendor name.
+			v, rest, err := uleb(data)
+			if err != nil {
+				return err
+			}
+			s, rest, err := cstring(rest)
+			if err != nil {
+				return err
+			}
+			a.Ints[int(tag)] = v
+			a.Strings[int(tag)] = s
+			data = rest
+		case tag == TagCPURawName || tag == TagCPUName || tag > TagCompatibility && tag&1 != 0:
+			s, rest, err := cstring(data)
+			if err != nil {
+				return err
+			}
+			a.Strings[int(tag)] = s
+			data = rest
+		default:
+			v, rest, err := uleb(data)
+			if err != nil {
+				return err
+			}
+			a.Ints[int(tag)] = v
+			data = rest
+		}
+	}
+	return nil
+}
+
+// uleb decodes an unsigned LEB128 number from the start of data.
+func uleb(data []byte) (uint64, []byte, error) {
+	var v uint64
+	for i, b := range data {
+		if i >= 10 {
+			break
+		}
+		v |= uint64(b&0x7f) << (7 * uint(i))
+		if b&0x80 == 0 {
+			return v, data[i+1:], nil
+		}
+	}
+	return 0, nil, errShort
+}
+
+// cstring decodes a NUL-terminated string from the start of data.
+func cstring(data []byte) (string, []byte, error) {
+	for i, b := range data {
+		if b == 0 {
+			return string(data[:i]), data[i+1:], nil
+		}
+	}
+	return "", nil, errShort
+}
+
+// FromELF parses the build attributes of f.
+// It returns nil and no error if f has no .ARM.attributes section.
+func FromELF(f *elf.File) (*Attributes, error) {
+	s := f.Section(".ARM.attributes")
+	if s == nil {
+		for _, sect := range f.Sections {
+			if sect.Type == sectionTypeARMAttr {
+				s = sect
+				break
+			}
+		}
+	}
+	if s == nil {
+		return nil, nil
+	}
+	data, err := s.Data()
+	if err != nil {
+		return nil, err
+	}
+	return Parse(data)
+}
+
+// cpuArch maps Tag_CPU_arch values to architecture versions.
+var cpuArch = [...]armasm.Arch{
+	0:  armasm.ARMv4, // pre-v4
+	1:  armasm.ARMv4,
+	2:  armasm.ARMv4T,
+	3:  armasm.ARMv5T,
+	4:  armasm.ARMv5TE,
+	5:  armasm.ARMv5TEJ,
+	6:  armasm.ARMv6,
+	7:  armasm.ARMv6K, // v6KZ
+	8:  armasm.ARMv6T2,
+	9:  armasm.ARMv6K,
+	10: armasm.ARMv7,
+	11: armasm.ARMv6M,
+	12: armasm.ARMv6M, // v6S-M
+	13: armasm.ARMv7EM,
+	14: armasm.ARMv8,
+	15: armasm.ARMv8,   // v8-R
+	16: armasm.ARMv6M,  // v8-M baseline
+	17: armasm.ARMv7EM, // v8-M mainline
+}
+
+// fpArch maps Tag_FP_arch values to floating-point architectures.
+var fpArch = [...]armasm.FPArch{
+	0: armasm.FPNone,
+	1: armasm.VFPv2, // VFPv1
+	2: armasm.VFPv2,
+	3: armasm.VFPv3,
+	4: armasm.VFPv3D16,
+	5: armasm.VFPv4,
+	6: armasm.VFPv4D16,
+	7: armasm.FPARMv8,
+	8: armasm.FPARMv8D16,
+}
+
+// Decoder returns a decoder accepting the instructions
+// available on the architecture, profile, and floating-point unit recorded in a.
+// Unrecognized architecture values impose no restriction.
+// A nil *Attributes, as FromELF returns for a file without attributes,
+// yields a nil *Decoder, which accepts all instructions.
+//
+// Tag_Advanced_SIMD_arch does not affect the result:
+// armasm does not decode Advanced SIMD instructions.
+func (a *Attributes) Decoder() *armasm.Decoder {
+	if a == nil {
+		return nil
+	}
+	d := new(armasm.Decoder)
+	if _, ok := a.Ints[TagCPUArch]; ok && a.CPUArch < len(cpuArch) {
+		d.Arch = cpuArch[a.CPUArch]
+	}
+	if a.FPArch < len(fpArch) {
+		d.FP = fpArch[a.FPArch]
+	}
+	switch a.CPUProfile {
+	case 'A':
+		d.Profile = armasm.ProfileA
+	case 'R':
+		d.Profile = armasm.ProfileR
+	case 'M':
+		d.Profile = armasm.ProfileM
+	}
+	return d
+}
@@ -0,0 +1,96 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armattr
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"rsc.io/arm/armasm"
+)
+
+// section builds an .ARM.attributes section with a single vendor subsection
+// holding the given file-scope attributes.
+func section(vendor string, attrs []byte) []byte {
+	file := []byte{tagFile, 0, 0, 0, 0}
+	file = append(file, attrs...)
+	binary.LittleEndian.PutUint32(file[1:], uint32(len(file)))
+
+	sub := []byte{0, 0, 0, 0}
+	sub = append(sub, vendor...)
+	sub = append(sub, 0)
+	sub = append(sub, file...)
+	binary.LittleEndian.PutUint32(sub, uint32(len(sub)))
+	return append([]byte{'A'}, sub...)
+}
+
+func TestParse(t *testing.T) {
+	attrs := []byte{
+		TagCPUName, 'c', 'o', 'r', 't', 'e', 'x', '-', 'a', '8', 0,
+		TagCPUArch, 10,
+		TagCPUArchProfile, 'A',
+		8, 1, // Tag_ARM_ISA_use
+		TagFPArch, 3,
+		TagAdvancedSIMD, 1,
+		TagCompatibility, 1, 'g', 'n', 'u', 0,
+		34, 0x81, 0x01, // Tag_CPU_unaligned_access, as a two-byte ULEB128
+		TagConformance, '2', '.', '0', '9', 0,
+	}
+	a, err := Parse(append(section("aeabi", attrs), section("gnu", []byte{4, 5})[1:]...))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if a.CPUName != "cortex-a8" || a.CPUArch != 10 || a.CPUProfile != 'A' || a.FPArch != 3 || a.SIMDArch != 1 {
+		t.Errorf("Parse = %+v", a)
+	}
+	if a.Ints[8] != 1 || a.Ints[34] != 0x81 || a.Strings[TagCompatibility] != "gnu" || a.Strings[TagConformance] != "2.09" {
+		t.Errorf("Parse: Ints = %v, Strings = %v", a.Ints, a.Strings)
+	}
+	if d := a.Decoder(); d.Arch != armasm.ARMv7 || d.FP != armasm.VFPv3 {
+		t.Errorf("Decoder = %+v, want ARMv7 VFPv3", d)
+	}
+
+	for _, bad := range [][]byte{
+		nil,
+		{'B'},
+		section("aeabi", attrs)[:20],
+		section("aeabi", []byte{TagCPUName, 'x'}),
+	} {
+		if _, err := Parse(bad); err == nil {
+			t.Errorf("Parse(% x) succeeded, want error", bad)
+		}
+	}
+}
+
+func TestDecoder(t *testing.T) {
+	a, err := Parse(section("aeabi", []byte{TagCPUArch, 4}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d := a.Decoder()
+	if d.Arch != armasm.ARMv5TE || d.FP != armasm.FPNone {
+		t.Errorf("Decoder = %+v, want ARMv5TE with no FP", d)
+	}
+	if _, err := d.Decode([]byte{0x34, 0x02, 0x01, 0xe3}, armasm.ModeARM); err == nil {
+		t.Errorf("ARMv5TE decoded MOVW")
+	}
+
+	// A file for an unknown M-profile processor rejects ARM code.
+	a, err = Parse(section("aeabi", []byte{TagCPUArchProfile, 'M'}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	d = a.Decoder()
+	if d.Arch != armasm.ArchAny || d.Profile != armasm.ProfileM {
+		t.Errorf("Decoder = %+v, want M profile", d)
+	}
+	if _, err := d.Decode([]byte{0x01, 0x00, 0x80, 0xe0}, armasm.ModeARM); err == nil {
+		t.Errorf("M profile decoded ARM code")
+	}
+
+	if d := (*Attributes)(nil).Decoder(); d != nil {
+		t.Errorf("nil Attributes: Decoder = %+v, want nil", d)
+	}
+}
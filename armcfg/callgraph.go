@@ -0,0 +1,162 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armcfg
+
+import (
+	"debug/elf"
+	"fmt"
+	"sort"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armdis"
+	"rsc.io/arm/armmem"
+)
+
+// A CallSite is a call instruction.
+type CallSite struct {
+	PC       uint64    // address of the call instruction
+	Target   uint64    // call target; zero for an indirect call
+	Indirect bool      // target is computed (BLX Rn)
+	Exchange bool      // target is in the other instruction set (BLX <label>)
+	Callee   *FuncNode // called function, or nil if indirect or outside the graph
+	Veneer   *Veneer   // veneer skipped to reach Target, if any (see SkipVeneers)
+}
+
+// A FuncNode is a function in a call graph.
+type FuncNode struct {
+	Entry   uint64
+	Blocks  []*Block    // blocks reachable from Entry without following calls, sorted by Start
+	Calls   []*CallSite // calls made by the function, sorted by PC
+	Callers []*CallSite // calls to the function
+}
+
+func (f *FuncNode) String() string {
+	return fmt.Sprintf("func %#x", f.Entry)
+}
+
+// A CallGraph is the call graph of an image.
+type CallGraph struct {
+	Graph *Graph
+	Funcs []*FuncNode // sorted by Entry
+}
+
+// Func returns the function with the given entry address, or nil if there is none.
+func (cg *CallGraph) Func(entry uint64) *FuncNode {
+	i := sort.Search(len(cg.Funcs), func(i int) bool { return cg.Funcs[i].Entry >= entry })
+	if i < len(cg.Funcs) && cg.Funcs[i].Entry == entry {
+		return cg.Funcs[i]
+	}
+	return nil
+}
+
+// BuildCallGraph disassembles code, loaded at address pc, by recursive
+// traversal from entries and returns its call graph,
+// with calls through linker veneers redirected to their targets.
+// See (*Graph).CallGraph and (*CallGraph).SkipVeneers.
+func BuildCallGraph(code []byte, pc uint64, mode armasm.Mode, entries ...uint64) *CallGraph {
+	cg := Build(armdis.Recursive(code, pc, mode, entries...)).CallGraph(entries...)
+	var text armmem.Image
+	text.Add(pc, code, false)
+	cg.SkipVeneers(&text)
+	return cg
+}
+
+// CallGraph returns the call graph of g. The functions are the given
+// entries together with every direct call target that begins a block in g.
+// The body of a function is the set of blocks reachable from its entry
+// without following calls; a block reachable from several entries,
+// such as code shared by a tail call, belongs to each of them.
+// Indirect call sites are recorded with Indirect set and a nil Callee.
+func (g *Graph) CallGraph(entries ...uint64) *CallGraph {
+	cg := &CallGraph{Graph: g}
+	seen := make(map[uint64]bool)
+	addFunc := func(entry uint64) {
+		if b := g.Block(entry); b != nil && b.Start == entry && !seen[entry] {
+			seen[entry] = true
+			cg.Funcs = append(cg.Funcs, &FuncNode{Entry: entry})
+		}
+	}
+	for _, e := range entries {
+		addFunc(e)
+	}
+	for _, b := range g.Blocks {
+		for _, r := range b.Insts {
+			if f := armdis.Classify(r.Inst, r.Start, g.Mode); f.Kind == armdis.FlowCall && !f.Exchange {
+				addFunc(f.Target)
+			}
+		}
+	}
+	sort.Slice(cg.Funcs, func(i, j int) bool { return cg.Funcs[i].Entry < cg.Funcs[j].Entry })
+
+	for _, fn := range cg.Funcs {
+		visited := make(map[*Block]bool)
+		work := []*Block{g.Block(fn.Entry)}
+		for len(work) > 0 {
+			b := work[len(work)-1]
+			work = work[:len(work)-1]
+			if visited[b] {
+				continue
+			}
+			visited[b] = true
+			fn.Blocks = append(fn.Blocks, b)
+			for _, e := range b.Succs {
+				if e.To != nil {
+					work = append(work, e.To)
+				}
+			}
+		}
+		sort.Slice(fn.Blocks, func(i, j int) bool { return fn.Blocks[i].Start < fn.Blocks[j].Start })
+
+		for _, b := range fn.Blocks {
+			for _, r := range b.Insts {
+				f := armdis.Classify(r.Inst, r.Start, g.Mode)
+				switch f.Kind {
+				case armdis.FlowCall:
+					cs := &CallSite{PC: r.Start, Target: f.Target, Exchange: f.Exchange}
+					if !f.Exchange {
+						cs.Callee = cg.Func(f.Target)
+					}
+					fn.Calls = append(fn.Calls, cs)
+				case armdis.FlowIndirectCall:
+					fn.Calls = append(fn.Calls, &CallSite{PC: r.Start, Indirect: true})
+				}
+			}
+		}
+		for _, cs := range fn.Calls {
+			if cs.Callee != nil {
+				cs.Callee.Callers = append(cs.Callee.Callers, cs)
+			}
+		}
+	}
+	return cg
+}
+
+// SymbolEntries returns the addresses of the function symbols in f
+// whose code is in the given instruction set, along with f's entry point
+// if it is in that instruction set. Thumb function symbols are identified
+// by the low bit of their value, which is cleared in the result.
+// For a Cortex-M firmware image without symbols, the handlers in its
+// vector table serve instead; see armcortexm.VectorTable.Handlers.
+func SymbolEntries(f *elf.File, mode armasm.Mode) ([]uint64, error) {
+	syms, err := f.Symbols()
+	if err != nil {
+		return nil, err
+	}
+	var out []uint64
+	add := func(addr uint64) {
+		if (addr&1 != 0) == (mode == armasm.ModeThumb) {
+			out = append(out, addr&^1)
+		}
+	}
+	if f.Entry != 0 {
+		add(f.Entry)
+	}
+	for _, s := range syms {
+		if elf.ST_TYPE(s.Info) == elf.STT_FUNC && s.Section != elf.SHN_UNDEF && s.Value != 0 {
+			add(s.Value)
+		}
+	}
+	return out, nil
+}
@@ -0,0 +1,48 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armcfg
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/internal/armtest"
+)
+
+func TestCallGraph(t *testing.T) {
+	code := armtest.Words(
+		0xe92d4010, // 0x1000: push {r4, lr}
+		0xeb000002, // 0x1004: bl 0x1014
+		0xe12fff33, // 0x1008: blx r3
+		0xeb000002, // 0x100c: bl 0x101c
+		0xe8bd8010, // 0x1010: pop {r4, pc}
+		0xea000000, // 0x1014: b 0x101c
+		0xe12fff1e, // 0x1018: bx lr
+		0xe3a00000, // 0x101c: mov r0, #0
+		0xe12fff1e, // 0x1020: bx lr
+	)
+	cg := BuildCallGraph(code, 0x1000, armasm.ModeARM, 0x1000)
+	var lines []string
+	for _, fn := range cg.Funcs {
+		line := fmt.Sprintf("%v: %d blocks", fn, len(fn.Blocks))
+		for _, cs := range fn.Calls {
+			if cs.Indirect {
+				line += fmt.Sprintf(" %#x->?", cs.PC)
+			} else {
+				line += fmt.Sprintf(" %#x->%v", cs.PC, cs.Callee)
+			}
+		}
+		line += fmt.Sprintf(" (%d callers)", len(fn.Callers))
+		lines = append(lines, line)
+	}
+	want := `func 0x1000: 1 blocks 0x1004->func 0x1014 0x1008->? 0x100c->func 0x101c (0 callers)
+func 0x1014: 2 blocks (1 callers)
+func 0x101c: 1 blocks (1 callers)`
+	if out := strings.Join(lines, "\n"); out != want {
+		t.Errorf("CallGraph:\n%s\nwant:\n%s", out, want)
+	}
+}
@@ -0,0 +1,175 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package armcfg builds control flow graphs for decoded ARM code.
+//
+// A graph is built from an armdis.Map by splitting its instructions
+// into basic blocks, each a maximal straight-line sequence of instructions
+// entered only at its first instruction and left only at its last.
+// Each block records the edges to its successors.
+package armcfg
+
+import (
+	"fmt"
+	"sort"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armdis"
+)
+
+// An EdgeKind describes how control passes from a block to a successor.
+type EdgeKind uint8
+
+const (
+	EdgeFallthrough EdgeKind = iota // sequential flow, including a conditional branch not taken
+	EdgeJump                        // unconditional branch
+	EdgeCond                        // conditional branch taken
+	EdgeComputed                    // computed branch; the target is unknown
+	EdgeSwitch                      // computed branch to a case target recovered from a jump table
+)
+
+var edgeKindName = [...]string{
+	EdgeFallthrough: "fallthrough",
+	EdgeJump:        "jump",
+	EdgeCond:        "cond",
+	EdgeComputed:    "computed",
+	EdgeSwitch:      "switch",
+}
+
+func (k EdgeKind) String() string {
+	if int(k) < len(edgeKindName) {
+		return edgeKindName[k]
+	}
+	return fmt.Sprintf("EdgeKind(%d)", int(k))
+}
+
+// An Edge is a control flow edge from one block to another.
+type Edge struct {
+	Kind   EdgeKind
+	Target uint64 // target address; zero for EdgeComputed
+	To     *Block // target block, or nil if the target is unknown or outside the graph
+}
+
+// A Block is a basic block.
+type Block struct {
+	Start uint64         // address of first instruction
+	End   uint64         // address following last instruction
+	Insts []armdis.Range // the Code ranges making up the block, in order
+	Flow  armdis.Flow    // control flow effect of the last instruction
+	Succs []Edge
+	Preds []*Block
+}
+
+func (b *Block) String() string {
+	return fmt.Sprintf("block %#x-%#x", b.Start, b.End)
+}
+
+// A Graph is a control flow graph.
+type Graph struct {
+	Mode   armasm.Mode
+	Blocks []*Block // sorted by Start
+}
+
+// Block returns the block containing addr, or nil if there is none.
+func (g *Graph) Block(addr uint64) *Block {
+	i := sort.Search(len(g.Blocks), func(i int) bool { return g.Blocks[i].End > addr })
+	if i < len(g.Blocks) && g.Blocks[i].Start <= addr {
+		return g.Blocks[i]
+	}
+	return nil
+}
+
+// Build builds the control flow graph for the code in m.
+// Calls do not end a basic block: control is assumed to return
+// to the instruction following the call.
+func Build(m *armdis.Map) *Graph {
+	insts := m.Insts()
+	index := make(map[uint64]int, len(insts))
+	for i, r := range insts {
+		index[r.Start] = i
+	}
+
+	// Find block leaders: the first instruction, any instruction not
+	// immediately preceded by code, every branch and call target, and
+	// every instruction following a control transfer.
+	leader := make([]bool, len(insts))
+	flows := make([]armdis.Flow, len(insts))
+	for i, r := range insts {
+		if i == 0 || insts[i-1].End != r.Start {
+			leader[i] = true
+		}
+		f := armdis.Classify(r.Inst, r.Start, m.Mode)
+		flows[i] = f
+		if f.Kind == armdis.FlowNone {
+			continue
+		}
+		if f.Kind != armdis.FlowCall && f.Kind != armdis.FlowIndirectCall && i+1 < len(insts) {
+			leader[i+1] = true
+		}
+		if f.Kind == armdis.FlowJump || f.Kind == armdis.FlowCall {
+			if j, ok := index[f.Target]; ok && !f.Exchange {
+				leader[j] = true
+			}
+		}
+	}
+
+	g := &Graph{Mode: m.Mode}
+	for i := 0; i < len(insts); {
+		b := &Block{Start: insts[i].Start}
+		j := i
+		for {
+			b.Insts = append(b.Insts, insts[j])
+			j++
+			if j >= len(insts) || leader[j] {
+				break
+			}
+		}
+		last := j - 1
+		b.End = insts[last].End
+		b.Flow = flows[last]
+		g.Blocks = append(g.Blocks, b)
+		i = j
+	}
+
+	for _, b := range g.Blocks {
+		f := b.Flow
+		switch f.Kind {
+		case armdis.FlowJump:
+			if !f.Exchange {
+				kind := EdgeJump
+				if f.Cond {
+					kind = EdgeCond
+				}
+				b.Succs = append(b.Succs, Edge{Kind: kind, Target: f.Target})
+			}
+		case armdis.FlowIndirectJump:
+			b.Succs = append(b.Succs, Edge{Kind: EdgeComputed})
+		}
+		if f.Fallthrough() {
+			b.Succs = append(b.Succs, Edge{Kind: EdgeFallthrough, Target: b.End})
+		}
+	}
+	g.link()
+	return g
+}
+
+// link fills in the To and Preds fields from the edge targets.
+func (g *Graph) link() {
+	for _, b := range g.Blocks {
+		b.Preds = nil
+	}
+	for _, b := range g.Blocks {
+		for i := range b.Succs {
+			e := &b.Succs[i]
+			e.To = nil
+			if e.Kind == EdgeComputed {
+				continue
+			}
+			if t := g.Block(e.Target); t != nil && t.Start == e.Target {
+				e.To = t
+				t.Preds = append(t.Preds, b)
+			}
+		}
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armcfg
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armdis"
+	"rsc.io/arm/internal/armtest"
+)
+
+func dump(g *Graph) string {
+	var lines []string
+	for _, b := range g.Blocks {
+		line := b.String() + ":"
+		for _, e := range b.Succs {
+			if e.To != nil {
+				line += fmt.Sprintf(" %s->%#x", e.Kind, e.To.Start)
+			} else {
+				line += fmt.Sprintf(" %s->?", e.Kind)
+			}
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestBuild(t *testing.T) {
+	code := armtest.Words(
+		0xe3500000, // 0x1000: cmp r0, #0
+		0x0a000002, // 0x1004: beq 0x1014
+		0xeb000003, // 0x1008: bl 0x101c
+		0xe2800001, // 0x100c: add r0, r0, #1
+		0xe12fff13, // 0x1010: bx r3
+		0xe3a00000, // 0x1014: mov r0, #0
+		0xe12fff1e, // 0x1018: bx lr
+		0xe12fff1e, // 0x101c: bx lr
+	)
+	m := armdis.Recursive(code, 0x1000, armasm.ModeARM, 0x1000)
+	g := Build(m)
+	want := `block 0x1000-0x1008: cond->0x1014 fallthrough->0x1008
+block 0x1008-0x1014: computed->?
+block 0x1014-0x101c:
+block 0x101c-0x1020:`
+	if out := dump(g); out != want {
+		t.Errorf("Build:\n%s\nwant:\n%s", out, want)
+	}
+	if b := g.Block(0x100c); b == nil || b.Start != 0x1008 {
+		t.Errorf("Block(0x100c) = %v", b)
+	}
+	if b := g.Block(0x1014); len(b.Preds) != 1 || b.Preds[0].Start != 0x1000 {
+		t.Errorf("Block(0x1014).Preds = %v", b.Preds)
+	}
+}
@@ -0,0 +1,59 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armcfg
+
+import (
+	"rsc.io/arm/armconst"
+	"rsc.io/arm/armcortexm"
+	"rsc.io/arm/armdis"
+	"rsc.io/arm/armmem"
+)
+
+// MarkExcReturns marks the exception returns in g, which must hold
+// M-profile code, and returns the addresses of the marked instructions.
+//
+// An M-profile exception handler returns by loading an EXC_RETURN value
+// into PC, usually with BX LR or POP {..., PC}, which are already
+// classified as returns, but also with forms like LDR PC, =0xFFFFFFF9
+// or LDR R0, =0xFFFFFFFD; BX R0, which Build records as computed branches.
+// For each block ending in a computed branch or return whose target,
+// determined by constant propagation within the block (see armconst.Propagate),
+// is an EXC_RETURN value, MarkExcReturns sets Flow.Kind to FlowReturn and
+// Flow.ExcReturn, and removes the block's computed edge, so that the graph
+// of the handler terminates there instead of at an unknown target.
+// Literal pools are read from text, which may be nil.
+//
+// MarkExcReturns must not be used for A- or R-profile code,
+// in which the EXC_RETURN range is ordinary memory.
+func (g *Graph) MarkExcReturns(text armmem.Reader) []uint64 {
+	var marked []uint64
+	for _, b := range g.Blocks {
+		if b.Flow.Kind != armdis.FlowIndirectJump && b.Flow.Kind != armdis.FlowReturn {
+			continue
+		}
+		last := b.Insts[len(b.Insts)-1].Start
+		exc := false
+		for _, ref := range armconst.Propagate(b.Insts, text) {
+			if ref.PC == last && ref.Kind == armconst.RefJump && armcortexm.IsExcReturn(uint32(ref.Target)) {
+				exc = true
+			}
+		}
+		if !exc {
+			continue
+		}
+		b.Flow.Kind = armdis.FlowReturn
+		b.Flow.ExcReturn = true
+		var succs []Edge
+		for _, e := range b.Succs {
+			if e.Kind != EdgeComputed {
+				succs = append(succs, e)
+			}
+		}
+		b.Succs = succs
+		marked = append(marked, last)
+	}
+	g.link()
+	return marked
+}
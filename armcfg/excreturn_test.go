@@ -0,0 +1,54 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armcfg
+
+import (
+	"reflect"
+	"testing"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armdis"
+	"rsc.io/arm/armmem"
+	"rsc.io/arm/internal/armtest"
+)
+
+func TestMarkExcReturns(t *testing.T) {
+	code := armtest.Words(
+		0xe3e00006, // 0x1000: mvn r0, #6
+		0xe12fff10, // 0x1004: bx r0
+		0xe59ff000, // 0x1008: ldr pc, [pc, #0]
+		0xe12fff13, // 0x100c: bx r3
+		0xfffffffd, // 0x1010: .word 0xfffffffd
+	)
+	text := new(armmem.Image)
+	text.Add(0x1000, code, false)
+	m := armdis.Recursive(code, 0x1000, armasm.ModeARM, 0x1000, 0x1008, 0x100c)
+	g := Build(m)
+	want := `block 0x1000-0x1008: computed->?
+block 0x1008-0x100c: computed->?
+block 0x100c-0x1010: computed->?`
+	if out := dump(g); out != want {
+		t.Fatalf("Build:\n%s\nwant:\n%s", out, want)
+	}
+
+	marked := g.MarkExcReturns(text)
+	if want := []uint64{0x1004, 0x1008}; !reflect.DeepEqual(marked, want) {
+		t.Errorf("MarkExcReturns = %#x, want %#x", marked, want)
+	}
+	want = `block 0x1000-0x1008:
+block 0x1008-0x100c:
+block 0x100c-0x1010: computed->?`
+	if out := dump(g); out != want {
+		t.Errorf("after MarkExcReturns:\n%s\nwant:\n%s", out, want)
+	}
+	for _, b := range g.Blocks[:2] {
+		if b.Flow.Kind != armdis.FlowReturn || !b.Flow.ExcReturn {
+			t.Errorf("%v: Flow = %+v, want exception return", b, b.Flow)
+		}
+	}
+	if f := g.Blocks[2].Flow; f.Kind != armdis.FlowIndirectJump || f.ExcReturn {
+		t.Errorf("%v: Flow = %+v, want indirect jump", g.Blocks[2], f)
+	}
+}
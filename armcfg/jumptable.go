@@ -0,0 +1,324 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armcfg
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armdis"
+	"rsc.io/arm/armmem"
+)
+
+// A TableKind identifies a switch dispatch idiom.
+type TableKind uint8
+
+const (
+	TableBranch TableKind = iota // ADD PC, PC, Rn, LSL #2 into a table of branch instructions
+	TableAddr                    // LDR PC, [PC, Rn, LSL #2] from a table of addresses
+	TableByte                    // Thumb TBB [PC, Rn] from a table of halfword counts
+	TableHalf                    // Thumb TBH [PC, Rn, LSL #1] from a table of halfword counts
+)
+
+var tableKindName = [...]string{
+	TableBranch: "branch",
+	TableAddr:   "addr",
+	TableByte:   "tbb",
+	TableHalf:   "tbh",
+}
+
+func (k TableKind) String() string {
+	if int(k) < len(tableKindName) {
+		return tableKindName[k]
+	}
+	return fmt.Sprintf("TableKind(%d)", int(k))
+}
+
+// A JumpTable is a recovered switch dispatch.
+type JumpTable struct {
+	Kind    TableKind
+	PC      uint64     // address of the dispatching instruction
+	Index   armasm.Reg // register holding the case index
+	Table   uint64     // address of the table
+	Targets []uint64   // case targets, in index order
+}
+
+// FindJumpTables finds the ARM-mode switch dispatches in g
+// and resolves their case targets. The number of cases is taken from
+// a CMP of the index register against a constant followed either by
+// an unsigned conditional branch around the dispatch or by a dispatch
+// that is itself conditional, as in the ADDLS PC, PC, Rn, LSL #2 and
+// LDRLS PC, [PC, Rn, LSL #2] idioms, where the instruction following
+// the dispatch branches to the default case. The bounds check must be
+// in the dispatching block or, if the block has a single predecessor,
+// in that predecessor.
+// Table contents are read from text, which is indexed by address.
+// Dispatches whose bounds or tables cannot be determined are omitted.
+func FindJumpTables(g *Graph, text armmem.Reader) []*JumpTable {
+	if g.Mode != armasm.ModeARM {
+		return nil
+	}
+	var out []*JumpTable
+	for _, b := range g.Blocks {
+		for i, r := range b.Insts {
+			kind, index, ok := dispatch(r.Inst)
+			if !ok {
+				continue
+			}
+			n := caseCount(preceding(b, i), r.Inst.Op, index)
+			if n <= 0 {
+				continue
+			}
+			jt := &JumpTable{Kind: kind, PC: r.Start, Index: index, Table: r.Start + 8}
+			if err := jt.read(text, n); err != nil {
+				continue
+			}
+			out = append(out, jt)
+		}
+	}
+	return out
+}
+
+// preceding returns the instructions known to execute before b.Insts[i]:
+// those before it in b, preceded by those of b's predecessor
+// if b has exactly one.
+func preceding(b *Block, i int) []armdis.Range {
+	prev := b.Insts[:i:i]
+	if len(b.Preds) == 1 && b.Preds[0] != b {
+		prev = append(append([]armdis.Range(nil), b.Preds[0].Insts...), prev...)
+	}
+	return prev
+}
+
+// dispatch reports whether inst is an ARM-mode table dispatch
+// and if so returns its kind and index register.
+func dispatch(inst armasm.Inst) (TableKind, armasm.Reg, bool) {
+	if inst.Args[0] != armasm.PC {
+		return 0, 0, false
+	}
+	switch inst.Op &^ 15 {
+	case armasm.ADD_EQ:
+		rs, ok := inst.Args[2].(armasm.RegShift)
+		if ok && inst.Args[1] == armasm.PC && rs.Shift == armasm.ShiftLeft && rs.Count == 2 {
+			return TableBranch, rs.Reg, true
+		}
+	case armasm.LDR_EQ:
+		mem, ok := inst.Args[1].(armasm.Mem)
+		if ok && mem.Base == armasm.PC && mem.Mode == armasm.AddrOffset && mem.Sign > 0 && mem.Shift == armasm.ShiftLeft && mem.Count == 2 {
+			return TableAddr, mem.Index, true
+		}
+	}
+	return 0, 0, false
+}
+
+// caseCount looks backward through the instructions preceding the
+// dispatch op for the bounds check on index and returns the number of cases,
+// or 0 if it cannot be determined.
+func caseCount(prev []armdis.Range, op armasm.Op, index armasm.Reg) int {
+	// Expect CMP index, #n; Bcc default; where cc is HI (cases 0..n)
+	// or CS (cases 0..n-1). Allow a few unrelated instructions
+	// as long as they do not write index. A dispatch conditional on
+	// LS or CC is equivalent to a preceding BHI or BCS.
+	var branch armasm.Op
+	switch op & 15 {
+	case armasm.B_LS & 15:
+		branch = armasm.B_HI
+	case armasm.B_CC & 15:
+		branch = armasm.B_CS
+	case armasm.B & 15, armasm.B_ZZ & 15:
+		// unconditional
+	default:
+		return 0
+	}
+	for i := len(prev) - 1; i >= 0 && i >= len(prev)-8; i-- {
+		inst := prev[i].Inst
+		switch inst.Op &^ 15 {
+		case armasm.B_EQ:
+			if branch == 0 {
+				branch = inst.Op
+			}
+			continue
+		case armasm.CMP_EQ:
+			if inst.Args[0] != index {
+				return 0
+			}
+			v, ok := armdis.ImmValue(inst.Args[1])
+			if !ok || v < 0 {
+				return 0
+			}
+			switch branch {
+			case armasm.B_HI:
+				return int(v) + 1
+			case armasm.B_CS:
+				return int(v)
+			}
+			return 0
+		}
+		if writes(inst, index) {
+			return 0
+		}
+	}
+	return 0
+}
+
+// writes reports whether inst may write reg.
+func writes(inst armasm.Inst, reg armasm.Reg) bool {
+	switch inst.Op &^ 15 {
+	case armasm.CMP_EQ, armasm.CMN_EQ, armasm.TST_EQ, armasm.TEQ_EQ,
+		armasm.STR_EQ, armasm.STRB_EQ, armasm.STRH_EQ, armasm.STRD_EQ,
+		armasm.STM_EQ, armasm.STMDA_EQ, armasm.STMDB_EQ, armasm.STMIB_EQ, armasm.PUSH_EQ,
+		armasm.B_EQ:
+		return false
+	}
+	for _, arg := range inst.Args {
+		switch arg := arg.(type) {
+		case armasm.Reg:
+			if arg == reg {
+				return true
+			}
+		case armasm.RegList:
+			if arg&(1<<reg) != 0 {
+				return true
+			}
+		case armasm.Mem:
+			if arg.Base == reg && arg.Mode != armasm.AddrOffset {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// read fills in jt.Targets from the n-entry table at jt.Table.
+func (jt *JumpTable) read(text armmem.Reader, n int) error {
+	jt.Targets = make([]uint64, n)
+	switch jt.Kind {
+	case TableBranch:
+		for i := range jt.Targets {
+			jt.Targets[i] = jt.Table + 4*uint64(i)
+		}
+		return nil
+	case TableAddr:
+		buf := make([]byte, 4*n)
+		if _, err := text.ReadAt(buf, int64(jt.Table)); err != nil {
+			return err
+		}
+		for i := range jt.Targets {
+			jt.Targets[i] = uint64(binary.LittleEndian.Uint32(buf[4*i:]))
+		}
+		return nil
+	case TableByte, TableHalf:
+		size := 1
+		if jt.Kind == TableHalf {
+			size = 2
+		}
+		buf := make([]byte, size*n)
+		if _, err := text.ReadAt(buf, int64(jt.Table)); err != nil {
+			return err
+		}
+		for i := range jt.Targets {
+			var v uint64
+			if size == 1 {
+				v = uint64(buf[i])
+			} else {
+				v = uint64(binary.LittleEndian.Uint16(buf[2*i:]))
+			}
+			jt.Targets[i] = jt.Table + 2*v
+		}
+		return nil
+	}
+	return fmt.Errorf("unknown table kind %v", jt.Kind)
+}
+
+// ThumbTable decodes the Thumb TBB or TBH instruction at pc,
+// reading it and its n-entry table from text, which is indexed by address.
+// Only the PC-relative form, in which the table immediately
+// follows the instruction, is recognized.
+// The case count n must be supplied by the caller.
+func ThumbTable(text armmem.Reader, pc uint64, n int) (*JumpTable, error) {
+	w, err := armmem.ReadUint32(text, pc)
+	if err != nil {
+		return nil, err
+	}
+	hw1 := uint16(w)
+	hw2 := uint16(w >> 16)
+	// TBB/TBH: 1110 1000 1101 Rn | 1111 0000 000 H Rm
+	if hw1&0xfff0 != 0xe8d0 || hw2&0xffe0 != 0xf000 {
+		return nil, fmt.Errorf("not a TBB or TBH instruction at %#x", pc)
+	}
+	if hw1&0xf != 0xf {
+		return nil, fmt.Errorf("TBB or TBH at %#x does not use a PC-relative table", pc)
+	}
+	jt := &JumpTable{Kind: TableByte, PC: pc, Index: armasm.Reg(hw2 & 0xf), Table: pc + 4}
+	if hw2&0x10 != 0 {
+		jt.Kind = TableHalf
+	}
+	if err := jt.read(text, n); err != nil {
+		return nil, err
+	}
+	return jt, nil
+}
+
+// AddJumpTable records the case targets of jt as EdgeSwitch successors
+// of the block ending at jt.PC, replacing its EdgeComputed edge.
+// Blocks are split as needed so that each target begins a block.
+// Targets not yet decoded have a nil Edge.To; they can be passed to
+// armdis.Recursive as additional entry points to extend the graph.
+func (g *Graph) AddJumpTable(jt *JumpTable) {
+	b := g.Block(jt.PC)
+	if b == nil || b.Insts[len(b.Insts)-1].Start != jt.PC {
+		return
+	}
+	var succs []Edge
+	for _, e := range b.Succs {
+		if e.Kind != EdgeComputed {
+			succs = append(succs, e)
+		}
+	}
+	seen := make(map[uint64]bool)
+	for _, t := range jt.Targets {
+		if !seen[t] {
+			seen[t] = true
+			succs = append(succs, Edge{Kind: EdgeSwitch, Target: t})
+		}
+	}
+	b.Succs = succs
+	for _, t := range jt.Targets {
+		g.split(t)
+	}
+	g.link()
+}
+
+// split splits the block containing addr so that a block starts at addr.
+func (g *Graph) split(addr uint64) {
+	b := g.Block(addr)
+	if b == nil || b.Start == addr {
+		return
+	}
+	for i, r := range b.Insts {
+		if r.Start != addr {
+			continue
+		}
+		nb := &Block{
+			Start: addr,
+			End:   b.End,
+			Insts: b.Insts[i:],
+			Flow:  b.Flow,
+			Succs: b.Succs,
+		}
+		b.Insts = b.Insts[:i:i]
+		b.End = addr
+		b.Flow = armdis.Classify(b.Insts[i-1].Inst, b.Insts[i-1].Start, g.Mode)
+		b.Succs = []Edge{{Kind: EdgeFallthrough, Target: addr}}
+		for j, x := range g.Blocks {
+			if x == b {
+				g.Blocks = append(g.Blocks[:j+1], append([]*Block{nb}, g.Blocks[j+1:]...)...)
+				break
+			}
+		}
+		return
+	}
+}
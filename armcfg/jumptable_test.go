@@ -0,0 +1,183 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armcfg
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armdis"
+	"rsc.io/arm/internal/armtest"
+)
+
+func TestJumpTableAddr(t *testing.T) {
+	code := armtest.Words(
+		0xe3500002, // 0x00: cmp r0, #2
+		0x8a000005, // 0x04: bhi 0x20
+		0xe79ff100, // 0x08: ldr pc, [pc, r0, lsl #2]
+		0xe1a00000, // 0x0c: nop
+		0x0000001c, // 0x10: .word 0x1c
+		0x00000020, // 0x14: .word 0x20
+		0x0000001c, // 0x18: .word 0x1c
+		0xe12fff1e, // 0x1c: bx lr
+		0xe3a00000, // 0x20: mov r0, #0
+		0xe12fff1e, // 0x24: bx lr
+	)
+	g := Build(armdis.Recursive(code, 0, armasm.ModeARM, 0))
+	jts := FindJumpTables(g, bytes.NewReader(code))
+	if len(jts) != 1 {
+		t.Fatalf("FindJumpTables found %d tables, want 1", len(jts))
+	}
+	jt := jts[0]
+	if jt.Kind != TableAddr || jt.PC != 0x08 || jt.Table != 0x10 || jt.Index != armasm.R0 {
+		t.Errorf("jump table = %+v", jt)
+	}
+	if want := []uint64{0x1c, 0x20, 0x1c}; !reflect.DeepEqual(jt.Targets, want) {
+		t.Errorf("Targets = %#x, want %#x", jt.Targets, want)
+	}
+
+	g.AddJumpTable(jt)
+	b := g.Block(0x08)
+	var kinds []EdgeKind
+	var targets []uint64
+	for _, e := range b.Succs {
+		kinds = append(kinds, e.Kind)
+		targets = append(targets, e.Target)
+	}
+	if !reflect.DeepEqual(kinds, []EdgeKind{EdgeSwitch, EdgeSwitch}) || !reflect.DeepEqual(targets, []uint64{0x1c, 0x20}) {
+		t.Errorf("Succs = %v %#x", kinds, targets)
+	}
+	if b.Succs[0].To != nil || b.Succs[1].To == nil || b.Succs[1].To.Start != 0x20 {
+		t.Errorf("Succs links = %v, %v", b.Succs[0].To, b.Succs[1].To)
+	}
+}
+
+func TestJumpTablePreds(t *testing.T) {
+	// The bounds check is in a predecessor of the dispatch,
+	// but not its only one, so it does not bound the index.
+	code := armtest.Words(
+		0xe3500002, // 0x00: cmp r0, #2
+		0x2a000003, // 0x04: bcs 0x18
+		0xe79ff100, // 0x08: ldr pc, [pc, r0, lsl #2]
+		0xe1a00000, // 0x0c: nop
+		0x0000001c, // 0x10: .word 0x1c
+		0x0000001c, // 0x14: .word 0x1c
+		0xeafffffa, // 0x18: b 0x08
+		0xe12fff1e, // 0x1c: bx lr
+	)
+	g := Build(armdis.Recursive(code, 0, armasm.ModeARM, 0))
+	if n := len(g.Block(0x08).Preds); n != 2 {
+		t.Fatalf("dispatch block has %d predecessors, want 2", n)
+	}
+	if jts := FindJumpTables(g, bytes.NewReader(code)); len(jts) != 0 {
+		t.Errorf("FindJumpTables found %d tables, want 0", len(jts))
+	}
+}
+
+func TestJumpTableBranch(t *testing.T) {
+	code := armtest.Words(
+		0xe3500002, // 0x00: cmp r0, #2
+		0x2a000004, // 0x04: bcs 0x1c
+		0xe08ff100, // 0x08: add pc, pc, r0, lsl #2
+		0xe1a00000, // 0x0c: nop
+		0xea000001, // 0x10: b 0x1c
+		0xea000000, // 0x14: b 0x1c
+		0xe1a00000, // 0x18: nop
+		0xe12fff1e, // 0x1c: bx lr
+	)
+	g := Build(armdis.Linear(code, 0, armasm.ModeARM))
+	jts := FindJumpTables(g, bytes.NewReader(code))
+	if len(jts) != 1 {
+		t.Fatalf("FindJumpTables found %d tables, want 1", len(jts))
+	}
+	if want := []uint64{0x10, 0x14}; !reflect.DeepEqual(jts[0].Targets, want) {
+		t.Errorf("Targets = %#x, want %#x", jts[0].Targets, want)
+	}
+}
+
+func TestJumpTableCond(t *testing.T) {
+	code := armtest.Words(
+		0xe3500002, // 0x00: cmp r0, #2
+		0x979ff100, // 0x04: ldrls pc, [pc, r0, lsl #2]
+		0xea000004, // 0x08: b 0x20
+		0x00000018, // 0x0c: .word 0x18
+		0x0000001c, // 0x10: .word 0x1c
+		0x00000018, // 0x14: .word 0x18
+		0xe12fff1e, // 0x18: bx lr
+		0xe3a00000, // 0x1c: mov r0, #0
+		0xe12fff1e, // 0x20: bx lr
+	)
+	g := Build(armdis.Recursive(code, 0, armasm.ModeARM, 0))
+	jts := FindJumpTables(g, bytes.NewReader(code))
+	if len(jts) != 1 {
+		t.Fatalf("FindJumpTables found %d tables, want 1", len(jts))
+	}
+	jt := jts[0]
+	if jt.Kind != TableAddr || jt.PC != 0x04 || jt.Table != 0x0c || jt.Index != armasm.R0 {
+		t.Errorf("jump table = %+v", jt)
+	}
+	if want := []uint64{0x18, 0x1c, 0x18}; !reflect.DeepEqual(jt.Targets, want) {
+		t.Errorf("Targets = %#x, want %#x", jt.Targets, want)
+	}
+
+	// The default case remains a successor of the dispatch.
+	g.AddJumpTable(jt)
+	var targets []uint64
+	for _, e := range g.Block(0x04).Succs {
+		targets = append(targets, e.Target)
+	}
+	if want := []uint64{0x08, 0x18, 0x1c}; !reflect.DeepEqual(targets, want) {
+		t.Errorf("Succs targets = %#x, want %#x", targets, want)
+	}
+
+	code = armtest.Words(
+		0xe3500003, // 0x00: cmp r0, #3
+		0x308ff100, // 0x04: addcc pc, pc, r0, lsl #2
+		0xea000003, // 0x08: b 0x1c
+		0xea000002, // 0x0c: b 0x1c
+		0xea000001, // 0x10: b 0x1c
+		0xea000000, // 0x14: b 0x1c
+		0xe1a00000, // 0x18: nop
+		0xe12fff1e, // 0x1c: bx lr
+	)
+	g = Build(armdis.Linear(code, 0, armasm.ModeARM))
+	jts = FindJumpTables(g, bytes.NewReader(code))
+	if len(jts) != 1 {
+		t.Fatalf("FindJumpTables found %d tables, want 1", len(jts))
+	}
+	if want := []uint64{0x0c, 0x10, 0x14}; !reflect.DeepEqual(jts[0].Targets, want) {
+		t.Errorf("Targets = %#x, want %#x", jts[0].Targets, want)
+	}
+
+	// A dispatch on any other condition is not a bounds check.
+	binary.LittleEndian.PutUint32(code[4:], 0x008ff100) // addeq pc, pc, r0, lsl #2
+	g = Build(armdis.Linear(code, 0, armasm.ModeARM))
+	if jts := FindJumpTables(g, bytes.NewReader(code)); len(jts) != 0 {
+		t.Errorf("FindJumpTables with ADDEQ found %d tables, want 0", len(jts))
+	}
+}
+
+func TestThumbTable(t *testing.T) {
+	text := []byte{
+		0xdf, 0xe8, 0x01, 0xf0, // 0x00: tbb [pc, r1]
+		2, 5, 9, 0, // 0x04: table
+	}
+	jt, err := ThumbTable(bytes.NewReader(text), 0, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if jt.Kind != TableByte || jt.Index != armasm.R1 {
+		t.Errorf("jump table = %+v", jt)
+	}
+	if want := []uint64{0x08, 0x0e, 0x16}; !reflect.DeepEqual(jt.Targets, want) {
+		t.Errorf("Targets = %#x, want %#x", jt.Targets, want)
+	}
+	if _, err := ThumbTable(bytes.NewReader(text[4:]), 0, 1); err == nil {
+		t.Errorf("ThumbTable accepted non-TBB bytes")
+	}
+}
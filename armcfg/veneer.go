@@ -0,0 +1,195 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armcfg
+
+import (
+	"fmt"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armmem"
+)
+
+// A VeneerKind identifies a linker-generated stub.
+type VeneerKind uint8
+
+const (
+	VeneerLong       VeneerKind = iota // LDR PC, [PC, #-4] with the target in a literal
+	VeneerInterwork                    // LDR IP, [PC]; BX IP with the target in a literal
+	VeneerPIC                          // LDR IP, [PC, #4]; ADD IP, PC, IP; BX IP with a PC-relative literal
+	VeneerThumbLong                    // Thumb LDR.W PC, [PC] with the target in a literal
+	VeneerThumbToARM                   // Thumb BX PC; NOP followed by ARM B
+	VeneerPLT                          // ADD IP, PC, #x; ADD IP, IP, #y; LDR PC, [IP, #z]! through a GOT slot
+)
+
+var veneerKindName = [...]string{
+	VeneerLong:       "long",
+	VeneerInterwork:  "interwork",
+	VeneerPIC:        "pic",
+	VeneerThumbLong:  "thumb-long",
+	VeneerThumbToARM: "thumb-to-arm",
+	VeneerPLT:        "plt",
+}
+
+func (k VeneerKind) String() string {
+	if int(k) < len(veneerKindName) {
+		return veneerKindName[k]
+	}
+	return fmt.Sprintf("VeneerKind(%d)", int(k))
+}
+
+// A Veneer is a linker-generated stub that branches to another address:
+// a long-branch or interworking veneer, or an ELF PLT entry.
+type Veneer struct {
+	Kind   VeneerKind
+	Addr   uint64      // address of the first instruction
+	Size   int         // size in bytes, including any literal
+	Mode   armasm.Mode // instruction set of the target
+	Target uint64      // target address, with the Thumb bit cleared; zero for VeneerPLT
+	GOT    uint64      // for VeneerPLT, the address of the GOT slot holding the target
+}
+
+func (v *Veneer) String() string {
+	if v.Kind == VeneerPLT {
+		return fmt.Sprintf("%s veneer %#x -> [%#x]", v.Kind, v.Addr, v.GOT)
+	}
+	return fmt.Sprintf("%s veneer %#x -> %#x", v.Kind, v.Addr, v.Target)
+}
+
+// MatchVeneer reports whether the code at addr in text, in the given mode,
+// is one of the veneers generated by the GNU and LLVM linkers
+// and if so returns it. A Thumb BX PC; NOP stub leading into
+// an ARM-mode veneer or PLT entry is returned as that veneer,
+// with Addr and Size extended to cover the stub.
+//
+// The target of a PLT entry is loaded from the GOT at run time,
+// so MatchVeneer returns only the address of its GOT slot,
+// which the slot's R_ARM_JUMP_SLOT relocation associates with a symbol.
+func MatchVeneer(text armmem.Reader, addr uint64, mode armasm.Mode) (*Veneer, bool) {
+	if mode == armasm.ModeThumb {
+		return matchThumbVeneer(text, addr)
+	}
+	return matchARMVeneer(text, addr)
+}
+
+func matchARMVeneer(text armmem.Reader, addr uint64) (*Veneer, bool) {
+	var w [4]uint32
+	n := 0
+	for ; n < len(w); n++ {
+		x, err := armmem.ReadUint32(text, addr+4*uint64(n))
+		if err != nil {
+			break
+		}
+		w[n] = x
+	}
+	literal := func(v *Veneer, lit uint64) (*Veneer, bool) {
+		v.Addr = addr
+		v.Mode = armasm.ModeARM
+		if lit&1 != 0 {
+			v.Mode = armasm.ModeThumb
+		}
+		v.Target = lit &^ 1
+		return v, true
+	}
+	switch {
+	case n >= 2 && w[0] == 0xe51ff004: // ldr pc, [pc, #-4]
+		return literal(&Veneer{Kind: VeneerLong, Size: 8}, uint64(w[1]))
+	case n >= 3 && w[0] == 0xe59fc000 && w[1] == 0xe12fff1c: // ldr ip, [pc]; bx ip
+		return literal(&Veneer{Kind: VeneerInterwork, Size: 12}, uint64(w[2]))
+	case n >= 4 && w[0] == 0xe59fc004 && w[1] == 0xe08fc00c && w[2] == 0xe12fff1c: // ldr ip, [pc, #4]; add ip, pc, ip; bx ip
+		// The ADD at addr+4 reads PC.
+		return literal(&Veneer{Kind: VeneerPIC, Size: 16}, armasm.PCRel(w[3]).Target(addr+4, armasm.ModeARM))
+	case n >= 3 && w[0]&^0xff == 0xe28fc600 && w[1]&^0xff == 0xe28cca00 && w[2]&^0xfff == 0xe5bcf000:
+		// add ip, pc, #x<<20; add ip, ip, #y<<12; ldr pc, [ip, #z]!
+		got := armasm.PCRel(w[0]&0xff<<20+w[1]&0xff<<12+w[2]&0xfff).Target(addr, armasm.ModeARM)
+		return &Veneer{Kind: VeneerPLT, Addr: addr, Size: 12, Mode: armasm.ModeARM, GOT: got}, true
+	}
+	return nil, false
+}
+
+func matchThumbVeneer(text armmem.Reader, addr uint64) (*Veneer, bool) {
+	h0, err0 := armmem.ReadUint16(text, addr)
+	h1, err1 := armmem.ReadUint16(text, addr+2)
+	if err0 != nil || err1 != nil {
+		return nil, false
+	}
+	switch {
+	case h0 == 0xf8df && h1 == 0xf000 && addr&2 == 0: // ldr.w pc, [pc]
+		lit, err := armmem.ReadUint32(text, addr+4)
+		if err != nil {
+			return nil, false
+		}
+		v := &Veneer{Kind: VeneerThumbLong, Addr: addr, Size: 8, Mode: armasm.ModeARM, Target: uint64(lit &^ 1)}
+		if lit&1 != 0 {
+			v.Mode = armasm.ModeThumb
+		}
+		return v, true
+	case h0 == 0x4778 && h1 == 0x46c0 && addr&2 == 0: // bx pc; nop
+		arm := addr + 4
+		if v, ok := matchARMVeneer(text, arm); ok {
+			v.Addr = addr
+			v.Size += 4
+			return v, true
+		}
+		w, err := armmem.ReadUint32(text, arm)
+		if err != nil || w&0xff000000 != 0xea000000 { // b
+			return nil, false
+		}
+		target := armasm.PCRel(int32(w<<8)>>6).Target(arm, armasm.ModeARM)
+		return &Veneer{Kind: VeneerThumbToARM, Addr: addr, Size: 8, Mode: armasm.ModeARM, Target: target}, true
+	}
+	return nil, false
+}
+
+// SkipVeneers redirects the direct calls in cg that reach a veneer to
+// the veneer's ultimate target, reading veneers from text.
+// Each redirected CallSite records the veneer in its Veneer field
+// and has its Target set to the veneer's target, or to zero for a PLT entry.
+// Its Callee becomes the target's function, if the target is in cg and in cg's
+// instruction set, and is otherwise nil.
+func (cg *CallGraph) SkipVeneers(text armmem.Reader) {
+	mode := cg.Graph.Mode
+	other := armasm.ModeThumb
+	if mode == armasm.ModeThumb {
+		other = armasm.ModeARM
+	}
+	for _, fn := range cg.Funcs {
+		for _, cs := range fn.Calls {
+			if cs.Indirect || cs.Veneer != nil {
+				continue
+			}
+			m := mode
+			if cs.Exchange {
+				m = other
+			}
+			v, ok := MatchVeneer(text, cs.Target, m)
+			if !ok {
+				continue
+			}
+			if cs.Callee != nil {
+				cs.Callee.Callers = removeCall(cs.Callee.Callers, cs)
+			}
+			cs.Veneer = v
+			cs.Target = v.Target
+			cs.Exchange = v.Mode != mode
+			cs.Callee = nil
+			if v.Kind != VeneerPLT && !cs.Exchange {
+				cs.Callee = cg.Func(v.Target)
+			}
+			if cs.Callee != nil {
+				cs.Callee.Callers = append(cs.Callee.Callers, cs)
+			}
+		}
+	}
+}
+
+// removeCall returns list with cs removed.
+func removeCall(list []*CallSite, cs *CallSite) []*CallSite {
+	for i, c := range list {
+		if c == cs {
+			return append(list[:i:i], list[i+1:]...)
+		}
+	}
+	return list
+}
@@ -0,0 +1,104 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armcfg
+
+import (
+	"fmt"
+	"testing"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armmem"
+	"rsc.io/arm/internal/armtest"
+)
+
+var veneerTests = []struct {
+	mode armasm.Mode
+	code []byte
+	want string
+}{
+	{armasm.ModeARM, armtest.Words(0xe51ff004, 0x00002001), "long veneer 0x1000 -> 0x2000 (8 bytes, Thumb)"},
+	{armasm.ModeARM, armtest.Words(0xe59fc000, 0xe12fff1c, 0x00002000), "interwork veneer 0x1000 -> 0x2000 (12 bytes, ARM)"},
+	{armasm.ModeARM, armtest.Words(0xe59fc004, 0xe08fc00c, 0xe12fff1c, 0x00000ff4), "pic veneer 0x1000 -> 0x2000 (16 bytes, ARM)"},
+	{armasm.ModeARM, armtest.Words(0xe28fc600, 0xe28cca01, 0xe5bcf010), "plt veneer 0x1000 -> [0x2018] (12 bytes, ARM)"},
+	{armasm.ModeThumb, armtest.Words(0xf000f8df, 0x00002001), "thumb-long veneer 0x1000 -> 0x2000 (8 bytes, Thumb)"},
+	{armasm.ModeThumb, armtest.Words(0x46c04778, 0xea0003fd), "thumb-to-arm veneer 0x1000 -> 0x2000 (8 bytes, ARM)"},
+	{armasm.ModeThumb, armtest.Words(0x46c04778, 0xe28fc600, 0xe28cca01, 0xe5bcf010), "plt veneer 0x1000 -> [0x201c] (16 bytes, ARM)"},
+	{armasm.ModeARM, armtest.Words(0xe3a00000, 0xe12fff1e), "none"},
+	{armasm.ModeARM, armtest.Words(0xe51ff004), "none"},
+}
+
+func TestMatchVeneer(t *testing.T) {
+	for _, tt := range veneerTests {
+		var text armmem.Image
+		text.Add(0x1000, tt.code, false)
+		out := "none"
+		if v, ok := MatchVeneer(&text, 0x1000, tt.mode); ok {
+			mode := "ARM"
+			if v.Mode == armasm.ModeThumb {
+				mode = "Thumb"
+			}
+			out = fmt.Sprintf("%v (%d bytes, %s)", v, v.Size, mode)
+		}
+		if out != tt.want {
+			t.Errorf("MatchVeneer(% x) = %s, want %s", tt.code, out, tt.want)
+		}
+	}
+}
+
+func TestMatchVeneerHigh(t *testing.T) {
+	// Veneers in 32-bit code mapped above 4 GB reach targets above 4 GB.
+	tests := []struct {
+		mode armasm.Mode
+		code []byte
+		want string
+	}{
+		{armasm.ModeARM, armtest.Words(0xe59fc004, 0xe08fc00c, 0xe12fff1c, 0x00000ff4), "pic veneer 0x100001000 -> 0x100002000"},
+		{armasm.ModeARM, armtest.Words(0xe28fc600, 0xe28cca01, 0xe5bcf010), "plt veneer 0x100001000 -> [0x100002018]"},
+		{armasm.ModeThumb, armtest.Words(0x46c04778, 0xea0003fd), "thumb-to-arm veneer 0x100001000 -> 0x100002000"},
+	}
+	for _, tt := range tests {
+		var text armmem.Image
+		text.Add(0x100001000, tt.code, false)
+		out := "none"
+		if v, ok := MatchVeneer(&text, 0x100001000, tt.mode); ok {
+			out = v.String()
+		}
+		if out != tt.want {
+			t.Errorf("MatchVeneer(% x) = %s, want %s", tt.code, out, tt.want)
+		}
+	}
+}
+
+func TestCallGraphVeneers(t *testing.T) {
+	code := armtest.Words(
+		0xe92d4010, // 0x1000: push {r4, lr}
+		0xeb000001, // 0x1004: bl 0x1010
+		0xeb000002, // 0x1008: bl 0x1018
+		0xe8bd8010, // 0x100c: pop {r4, pc}
+		0xe51ff004, // 0x1010: ldr pc, [pc, #-4]
+		0x00001024, // 0x1014: .word 0x1024
+		0xe28fc600, // 0x1018: add ip, pc, #0
+		0xe28cca01, // 0x101c: add ip, ip, #0x1000
+		0xe5bcf010, // 0x1020: ldr pc, [ip, #0x10]!
+		0xe3a00000, // 0x1024: mov r0, #0
+		0xe12fff1e, // 0x1028: bx lr
+	)
+	cg := BuildCallGraph(code, 0x1000, armasm.ModeARM, 0x1000, 0x1024)
+	fn := cg.Func(0x1000)
+	var out string
+	for _, cs := range fn.Calls {
+		out += fmt.Sprintf("%#x->%#x %v via %v; ", cs.PC, cs.Target, cs.Callee, cs.Veneer)
+	}
+	want := "0x1004->0x1024 func 0x1024 via long veneer 0x1010 -> 0x1024; 0x1008->0x0 <nil> via plt veneer 0x1018 -> [0x2030]; "
+	if out != want {
+		t.Errorf("calls:\n%s\nwant:\n%s", out, want)
+	}
+	if n := len(cg.Func(0x1024).Callers); n != 1 {
+		t.Errorf("func 0x1024 has %d callers, want 1", n)
+	}
+	if n := len(cg.Func(0x1010).Callers); n != 0 {
+		t.Errorf("veneer 0x1010 has %d callers, want 0", n)
+	}
+}
@@ -0,0 +1,113 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package armconst recovers constants materialized in registers by ARM code.
+//
+// ARMv7 code without position-independent addressing builds 32-bit
+// addresses and constants in registers, most often with a MOVW/MOVT pair.
+// The analyses in this package recover those values so that the address
+// references in the code can be reported.
+package armconst
+
+import (
+	"fmt"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armdis"
+)
+
+// A Const is a constant value known to be held in a register.
+type Const struct {
+	Reg   armasm.Reg
+	Value uint32
+	Start uint64 // address of the first instruction contributing to the value
+	PC    uint64 // address of the instruction completing the value
+	Avail uint64 // address of the first instruction that sees the value
+}
+
+func (c Const) String() string {
+	return fmt.Sprintf("%#x: %s = %#x", c.Avail, c.Reg, c.Value)
+}
+
+// MovPairs finds the MOVW/MOVT pairs in insts that build a 32-bit constant
+// in a single register. The instructions in insts are assumed to execute in
+// sequence; pairing does not continue past a control transfer,
+// past a change to the register, or between instructions with
+// different conditions.
+func MovPairs(insts []armdis.Range) []Const {
+	var out []Const
+	for i, r := range insts {
+		if r.Inst.Op&^15 != armasm.MOVW_EQ {
+			continue
+		}
+		rd, ok1 := r.Inst.Args[0].(armasm.Reg)
+		lo, ok2 := r.Inst.Args[1].(armasm.Imm)
+		if !ok1 || !ok2 {
+			continue
+		}
+		for _, t := range insts[i+1:] {
+			if t.Inst.Op&^15 == armasm.MOVT_EQ && t.Inst.Args[0] == rd {
+				if hi, ok := t.Inst.Args[1].(armasm.Imm); ok && t.Inst.Enc>>28 == r.Inst.Enc>>28 {
+					out = append(out, Const{
+						Reg:   rd,
+						Value: uint32(hi)<<16 | uint32(lo)&0xffff,
+						Start: r.Start,
+						PC:    t.Start,
+						Avail: t.End,
+					})
+				}
+				break
+			}
+			// The mode only affects branch targets, which are not used here.
+			if writesReg(t.Inst, rd) || armdis.Classify(t.Inst, t.Start, armasm.ModeARM).Kind != armdis.FlowNone {
+				break
+			}
+		}
+	}
+	return out
+}
+
+// writesReg reports whether inst may write reg.
+func writesReg(inst armasm.Inst, reg armasm.Reg) bool {
+	for _, arg := range inst.Args {
+		if mem, ok := arg.(armasm.Mem); ok && mem.Base == reg && mem.Mode != armasm.AddrOffset && mem.Mode != armasm.AddrLDM {
+			return true
+		}
+	}
+	switch inst.Op &^ 15 {
+	case armasm.CMP_EQ, armasm.CMN_EQ, armasm.TST_EQ, armasm.TEQ_EQ,
+		armasm.B_EQ, armasm.BX_EQ, armasm.BXJ_EQ, armasm.BKPT_EQ,
+		armasm.STR_EQ, armasm.STRB_EQ, armasm.STRH_EQ, armasm.STRD_EQ,
+		armasm.STRT_EQ, armasm.STRBT_EQ, armasm.STRHT_EQ,
+		armasm.STM_EQ, armasm.STMDA_EQ, armasm.STMDB_EQ, armasm.STMIB_EQ, armasm.PUSH_EQ,
+		armasm.VSTR_EQ, armasm.VMSR_EQ, armasm.NOP_EQ:
+		return false
+	case armasm.BL_EQ, armasm.BLX_EQ:
+		// Calls write LR and, under the procedure call standard,
+		// may clobber the argument and scratch registers.
+		return reg <= armasm.R3 || reg == armasm.R12 || reg == armasm.LR
+	case armasm.SVC_EQ:
+		// System calls return a result in R0.
+		return reg == armasm.R0
+	case armasm.LDM_EQ, armasm.LDMDA_EQ, armasm.LDMDB_EQ, armasm.LDMIB_EQ, armasm.POP_EQ:
+		for _, arg := range inst.Args {
+			if list, ok := arg.(armasm.RegList); ok && list&(1<<reg) != 0 {
+				return true
+			}
+		}
+		return false
+	case armasm.LDRD_EQ, armasm.LDREXD_EQ,
+		armasm.SMULL_EQ, armasm.SMULL_S_EQ, armasm.UMULL_EQ, armasm.UMULL_S_EQ,
+		armasm.SMLAL_EQ, armasm.SMLAL_S_EQ, armasm.UMLAL_EQ, armasm.UMLAL_S_EQ,
+		armasm.UMAAL_EQ, armasm.SMLALD_EQ, armasm.SMLSLD_EQ,
+		armasm.SMLALBB_EQ, armasm.SMLALBT_EQ, armasm.SMLALTB_EQ, armasm.SMLALTT_EQ:
+		// Two destination registers.
+		if inst.Args[1] == reg {
+			return true
+		}
+	case armasm.STREX_EQ, armasm.STREXB_EQ, armasm.STREXH_EQ, armasm.STREXD_EQ:
+		// Status register only.
+	}
+	return inst.Args[0] == reg
+}
@@ -0,0 +1,33 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armconst
+
+import (
+	"reflect"
+	"testing"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armdis"
+	"rsc.io/arm/internal/armtest"
+)
+
+func TestMovPairs(t *testing.T) {
+	insts := armdis.Linear(armtest.Words(
+		0xe3050678, // 0x00: movw r0, #0x5678
+		0xe3001001, // 0x04: movw r1, #1
+		0xe3410234, // 0x08: movt r0, #0x1234
+		0xe2811001, // 0x0c: add r1, r1, #1
+		0xe3401002, // 0x10: movt r1, #2
+		0xe30f2fff, // 0x14: movw r2, #0xffff
+		0xeb000000, // 0x18: bl 0x20
+		0xe34f2fff, // 0x1c: movt r2, #0xffff
+	), 0, armasm.ModeARM).Insts()
+	want := []Const{
+		{Reg: armasm.R0, Value: 0x12345678, Start: 0x00, PC: 0x08, Avail: 0x0c},
+	}
+	if out := MovPairs(insts); !reflect.DeepEqual(out, want) {
+		t.Errorf("MovPairs = %v, want %v", out, want)
+	}
+}
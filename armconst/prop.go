@@ -0,0 +1,316 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armconst
+
+import (
+	"fmt"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armdis"
+	"rsc.io/arm/armmem"
+)
+
+// A RefKind describes how an instruction uses a resolved address.
+type RefKind uint8
+
+const (
+	RefLoad  RefKind = iota // memory load
+	RefStore                // memory store
+	RefJump                 // branch through a register
+	RefCall                 // call through a register
+)
+
+var refKindName = [...]string{
+	RefLoad:  "load",
+	RefStore: "store",
+	RefJump:  "jump",
+	RefCall:  "call",
+}
+
+func (k RefKind) String() string {
+	if int(k) < len(refKindName) {
+		return refKindName[k]
+	}
+	return fmt.Sprintf("RefKind(%d)", int(k))
+}
+
+// A Ref is an address used by an instruction, resolved by constant propagation.
+type Ref struct {
+	PC     uint64 // address of the instruction
+	Kind   RefKind
+	Target uint64 // resolved address
+}
+
+func (r Ref) String() string {
+	return fmt.Sprintf("%#x: %s %#x", r.PC, r.Kind, r.Target)
+}
+
+// A State records which registers hold known constant values.
+type State struct {
+	known uint16
+	val   [16]uint32
+}
+
+// Get returns the value of reg, if known.
+func (s *State) Get(reg armasm.Reg) (uint32, bool) {
+	if reg > armasm.R15 || s.known&(1<<reg) == 0 {
+		return 0, false
+	}
+	return s.val[reg], true
+}
+
+func (s *State) set(reg armasm.Reg, v uint32) {
+	if reg <= armasm.R14 {
+		s.known |= 1 << reg
+		s.val[reg] = v
+	}
+}
+
+func (s *State) clear(reg armasm.Reg) {
+	if reg <= armasm.R15 {
+		s.known &^= 1 << reg
+	}
+}
+
+// Propagate tracks constant register values through insts,
+// which are assumed to form a single basic block executing in ARM mode.
+// Values come from MOV, MVN, MOVW, MOVT, arithmetic with immediates
+// and known registers, PC-relative address computation, and loads from
+// literal pools, which are read from text, indexed by address.
+// Text may be nil, in which case literal loads produce unknown values.
+// Propagate returns the addresses resolved for loads, stores, and
+// register branches and calls.
+func Propagate(insts []armdis.Range, text armmem.Reader) []Ref {
+	var refs []Ref
+	Walk(insts, text, func(r armdis.Range, s *State) {
+		refs = append(refs, resolve(r, s, text)...)
+	})
+	return refs
+}
+
+// Walk is like Propagate but calls f for each instruction
+// with the state holding just before the instruction executes.
+func Walk(insts []armdis.Range, text armmem.Reader, f func(armdis.Range, *State)) {
+	var s State
+	for _, r := range insts {
+		f(r, &s)
+		step(&s, r, text)
+	}
+}
+
+// pcValue returns the value read from PC by the ARM instruction at pc.
+func pcValue(pc uint64) uint32 {
+	return uint32(pc + 8)
+}
+
+// regValue returns the value of a register argument in state s.
+func regValue(s *State, arg armasm.Arg, pc uint64) (uint32, bool) {
+	reg, ok := arg.(armasm.Reg)
+	if !ok {
+		return 0, false
+	}
+	if reg == armasm.PC {
+		return pcValue(pc), true
+	}
+	return s.Get(reg)
+}
+
+// operand returns the value of a flexible second operand in state s.
+func operand(s *State, arg armasm.Arg, pc uint64) (uint32, bool) {
+	switch arg := arg.(type) {
+	case armasm.Imm:
+		return uint32(arg), true
+	case armasm.ImmAlt:
+		return uint32(arg.Imm()), true
+	case armasm.Reg:
+		return regValue(s, arg, pc)
+	case armasm.RegShift:
+		v, ok := regValue(s, arg.Reg, pc)
+		if !ok {
+			return 0, false
+		}
+		return shift(v, arg.Shift, uint(arg.Count))
+	}
+	return 0, false
+}
+
+// shift applies a constant shift to v.
+// It fails for RRX, whose result depends on the carry flag.
+func shift(v uint32, typ armasm.Shift, n uint) (uint32, bool) {
+	switch typ {
+	case armasm.ShiftLeft:
+		return v << n, true
+	case armasm.ShiftRight:
+		return v >> n, true
+	case armasm.ShiftRightSigned:
+		return uint32(int32(v) >> n), true
+	case armasm.RotateRight:
+		return v>>n | v<<(32-n), true
+	}
+	return 0, false
+}
+
+// address returns the address accessed by mem in state s.
+func address(s *State, mem armasm.Mem, pc uint64) (uint32, bool) {
+	var base uint32
+	if mem.Base == armasm.PC {
+		base = pcValue(pc)
+	} else {
+		v, ok := s.Get(mem.Base)
+		if !ok {
+			return 0, false
+		}
+		base = v
+	}
+	switch mem.Mode {
+	case armasm.AddrPostIndex, armasm.AddrLDM, armasm.AddrLDM_WB:
+		return base, true
+	}
+	if mem.Sign == 0 {
+		return base + uint32(int32(mem.Offset)), true
+	}
+	x, ok := regValue(s, mem.Index, pc)
+	if !ok {
+		return 0, false
+	}
+	if x, ok = shift(x, mem.Shift, uint(mem.Count)); !ok {
+		return 0, false
+	}
+	if mem.Sign < 0 {
+		return base - x, true
+	}
+	return base + x, true
+}
+
+// resolve returns the references made by r in state s.
+func resolve(r armdis.Range, s *State, text armmem.Reader) []Ref {
+	inst := r.Inst
+	var refs []Ref
+	kind := RefLoad
+	switch inst.Op &^ 15 {
+	case armasm.STR_EQ, armasm.STRB_EQ, armasm.STRH_EQ, armasm.STRD_EQ,
+		armasm.STRT_EQ, armasm.STRBT_EQ, armasm.STRHT_EQ,
+		armasm.STREX_EQ, armasm.STREXB_EQ, armasm.STREXH_EQ, armasm.STREXD_EQ,
+		armasm.STM_EQ, armasm.STMDA_EQ, armasm.STMDB_EQ, armasm.STMIB_EQ, armasm.VSTR_EQ:
+		kind = RefStore
+	case armasm.BX_EQ, armasm.BLX_EQ:
+		if v, ok := regValue(s, inst.Args[0], r.Start); ok {
+			kind := RefJump
+			if inst.Op&^15 == armasm.BLX_EQ {
+				kind = RefCall
+			}
+			refs = append(refs, Ref{PC: r.Start, Kind: kind, Target: uint64(v)})
+		}
+		return refs
+	}
+	for _, arg := range inst.Args {
+		if mem, ok := arg.(armasm.Mem); ok && mem.Mode != armasm.AddrLDM && mem.Mode != armasm.AddrLDM_WB {
+			if a, ok := address(s, mem, r.Start); ok {
+				refs = append(refs, Ref{PC: r.Start, Kind: kind, Target: uint64(a)})
+			}
+		}
+	}
+	if inst.Args[0] == armasm.PC && kind == RefLoad {
+		if v, ok := evaluate(s, r, text); ok {
+			refs = append(refs, Ref{PC: r.Start, Kind: RefJump, Target: uint64(v)})
+		}
+	}
+	return refs
+}
+
+// step updates s to reflect the execution of r.
+func step(s *State, r armdis.Range, text armmem.Reader) {
+	inst := r.Inst
+	dst, isReg := inst.Args[0].(armasm.Reg)
+	v, ok := evaluate(s, r, text)
+
+	// Invalidate everything the instruction may write,
+	// including base register writeback.
+	for reg := armasm.R0; reg <= armasm.R15; reg++ {
+		if s.known&(1<<reg) != 0 && writesReg(inst, reg) {
+			s.clear(reg)
+		}
+	}
+	if ok && isReg && inst.Enc>>28 >= 0xE {
+		s.set(dst, v)
+	}
+}
+
+// evaluate returns the value written to the first argument of r,
+// if it can be computed from s (and text, for literal loads).
+func evaluate(s *State, r armdis.Range, text armmem.Reader) (uint32, bool) {
+	inst := r.Inst
+	pc := r.Start
+	switch inst.Op &^ 15 {
+	case armasm.MOV_EQ, armasm.MOV_S_EQ:
+		return operand(s, inst.Args[1], pc)
+	case armasm.MVN_EQ, armasm.MVN_S_EQ:
+		v, ok := operand(s, inst.Args[1], pc)
+		return ^v, ok
+	case armasm.MOVW_EQ:
+		v, ok := inst.Args[1].(armasm.Imm)
+		return uint32(v), ok
+	case armasm.MOVT_EQ:
+		lo, ok1 := regValue(s, inst.Args[0], pc)
+		hi, ok2 := inst.Args[1].(armasm.Imm)
+		return uint32(hi)<<16 | lo&0xffff, ok1 && ok2
+	case armasm.ADD_EQ, armasm.ADD_S_EQ, armasm.SUB_EQ, armasm.SUB_S_EQ,
+		armasm.RSB_EQ, armasm.RSB_S_EQ, armasm.ORR_EQ, armasm.ORR_S_EQ,
+		armasm.AND_EQ, armasm.AND_S_EQ, armasm.EOR_EQ, armasm.EOR_S_EQ,
+		armasm.BIC_EQ, armasm.BIC_S_EQ:
+		x, ok1 := regValue(s, inst.Args[1], pc)
+		y, ok2 := operand(s, inst.Args[2], pc)
+		if !ok1 || !ok2 {
+			return 0, false
+		}
+		switch inst.Op &^ 15 {
+		case armasm.ADD_EQ, armasm.ADD_S_EQ:
+			return x + y, true
+		case armasm.SUB_EQ, armasm.SUB_S_EQ:
+			return x - y, true
+		case armasm.RSB_EQ, armasm.RSB_S_EQ:
+			return y - x, true
+		case armasm.ORR_EQ, armasm.ORR_S_EQ:
+			return x | y, true
+		case armasm.AND_EQ, armasm.AND_S_EQ:
+			return x & y, true
+		case armasm.EOR_EQ, armasm.EOR_S_EQ:
+			return x ^ y, true
+		case armasm.BIC_EQ, armasm.BIC_S_EQ:
+			return x &^ y, true
+		}
+	case armasm.LSL_EQ, armasm.LSL_S_EQ, armasm.LSR_EQ, armasm.LSR_S_EQ,
+		armasm.ASR_EQ, armasm.ASR_S_EQ:
+		x, ok1 := regValue(s, inst.Args[1], pc)
+		n, ok2 := inst.Args[2].(armasm.Imm)
+		if !ok1 || !ok2 {
+			return 0, false
+		}
+		typ := armasm.ShiftLeft
+		switch inst.Op &^ 15 {
+		case armasm.LSR_EQ, armasm.LSR_S_EQ:
+			typ = armasm.ShiftRight
+		case armasm.ASR_EQ, armasm.ASR_S_EQ:
+			typ = armasm.ShiftRightSigned
+		}
+		return shift(x, typ, uint(n))
+	case armasm.LDR_EQ:
+		mem, ok := inst.Args[1].(armasm.Mem)
+		if !ok || text == nil {
+			return 0, false
+		}
+		// Only literal pool loads have contents known to be constant.
+		if mem.Base != armasm.PC {
+			return 0, false
+		}
+		a, ok := address(s, mem, pc)
+		if !ok {
+			return 0, false
+		}
+		v, err := armmem.ReadUint32(text, uint64(a))
+		return v, err == nil
+	}
+	return 0, false
+}
@@ -0,0 +1,45 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armconst
+
+import (
+	"bytes"
+	"reflect"
+	"testing"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armdis"
+	"rsc.io/arm/internal/armtest"
+)
+
+func TestPropagate(t *testing.T) {
+	ws := []uint32{
+		0xe3a00a01, // 0x00: mov r0, #0x1000
+		0xe2800004, // 0x04: add r0, r0, #4
+		0xe5901008, // 0x08: ldr r1, [r0, #8]
+		0xe5801000, // 0x0c: str r1, [r0]
+		0xe59f2008, // 0x10: ldr r2, [pc, #8]
+		0xe12fff32, // 0x14: blx r2
+		0xe5901000, // 0x18: ldr r1, [r0]
+		0xe12fff1e, // 0x1c: bx lr
+		0x00008000, // 0x20: .word 0x8000
+	}
+	text := armtest.Words(ws...)
+	insts := armdis.Linear(text[:4*8], 0, armasm.ModeARM).Insts()
+	want := []Ref{
+		{PC: 0x08, Kind: RefLoad, Target: 0x100c},
+		{PC: 0x0c, Kind: RefStore, Target: 0x1004},
+		{PC: 0x10, Kind: RefLoad, Target: 0x20},
+		{PC: 0x14, Kind: RefCall, Target: 0x8000},
+	}
+	if refs := Propagate(insts, bytes.NewReader(text)); !reflect.DeepEqual(refs, want) {
+		t.Errorf("Propagate = %v, want %v", refs, want)
+	}
+
+	// Without text, the literal load is still resolved but its value is not.
+	if refs := Propagate(insts, nil); !reflect.DeepEqual(refs, want[:3]) {
+		t.Errorf("Propagate(nil text) = %v, want %v", refs, want[:3])
+	}
+}
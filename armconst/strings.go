@@ -0,0 +1,168 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armconst
+
+import (
+	"debug/elf"
+	"fmt"
+	"strconv"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armdis"
+	"rsc.io/arm/armmem"
+)
+
+// A DataRef is a reference from an instruction to a string or to a data section.
+type DataRef struct {
+	PC      uint64 // address of the instruction
+	Target  uint64 // referenced address
+	Section string // name of the section containing Target, or ""
+	Preview string // preview of the string at Target, or ""
+}
+
+func (r DataRef) String() string {
+	s := fmt.Sprintf("%#x: %#x", r.PC, r.Target)
+	if r.Section != "" {
+		s += " " + r.Section
+	}
+	if r.Preview != "" {
+		s += " " + strconv.Quote(r.Preview)
+	}
+	return s
+}
+
+// A Section is a named range of memory, such as an ELF section.
+type Section struct {
+	Name string
+	Addr uint64
+	Size uint64
+}
+
+// DataSections returns the sections of f that are loaded into memory
+// but not executable, such as .rodata, .data, and .bss.
+func DataSections(f *elf.File) []Section {
+	var out []Section
+	for _, s := range f.Sections {
+		if s.Flags&elf.SHF_ALLOC != 0 && s.Flags&elf.SHF_EXECINSTR == 0 && s.Size > 0 {
+			out = append(out, Section{s.Name, s.Addr, s.Size})
+		}
+	}
+	return out
+}
+
+const (
+	minString  = 4    // minimum length of a string, as in strings(1)
+	maxString  = 4096 // maximum length of a string
+	maxPreview = 64   // length of DataRef.Preview, excluding "..."
+)
+
+// DataRefs finds the references in insts to printable strings read from text
+// and to the given data sections. As for Propagate, insts are assumed to form
+// a single basic block executing in ARM mode. A reference is an address
+// computed into a register, such as by a literal load, ADR, or MOVW/MOVT pair,
+// or the address accessed by a load or store other than a literal load.
+// A string is at least 4 and at most 4096 printable ASCII characters
+// followed by a NUL byte; DataRef.Preview holds its first 64 characters,
+// followed by "..." if it is longer. Addresses that are neither strings
+// nor in a data section are not reported.
+func DataRefs(insts []armdis.Range, text armmem.Reader, sections []Section) []DataRef {
+	var out []DataRef
+	add := func(pc, target uint64) {
+		ref := DataRef{PC: pc, Target: target}
+		for _, sect := range sections {
+			if sect.Addr <= target && target-sect.Addr < sect.Size {
+				ref.Section = sect.Name
+				break
+			}
+		}
+		if text != nil {
+			ref.Preview = readString(text, target)
+		}
+		if ref.Section == "" && ref.Preview == "" {
+			return
+		}
+		for _, old := range out {
+			if old.PC == ref.PC && old.Target == ref.Target {
+				return
+			}
+		}
+		out = append(out, ref)
+	}
+
+	var s State
+	for i, r := range insts {
+		for _, ref := range resolve(r, &s, text) {
+			if (ref.Kind == RefLoad || ref.Kind == RefStore) && !literalLoad(r.Inst) {
+				add(r.Start, ref.Target)
+			}
+		}
+		if dst, ok := r.Inst.Args[0].(armasm.Reg); ok && dst < armasm.PC && !completedByMOVT(insts[i:]) {
+			if v, ok := evaluate(&s, r, text); ok {
+				add(r.Start, uint64(v))
+			}
+		}
+		step(&s, r, text)
+	}
+	return out
+}
+
+// literalLoad reports whether inst loads from a literal pool.
+func literalLoad(inst armasm.Inst) bool {
+	for _, arg := range inst.Args {
+		if mem, ok := arg.(armasm.Mem); ok && mem.Base == armasm.PC {
+			return true
+		}
+	}
+	return false
+}
+
+// completedByMOVT reports whether insts[0] is a MOVW setting the low half
+// of a register whose high half is set by a later MOVT in insts,
+// in which case the MOVW alone does not compute an address.
+func completedByMOVT(insts []armdis.Range) bool {
+	if insts[0].Inst.Op&^15 != armasm.MOVW_EQ {
+		return false
+	}
+	reg, ok := insts[0].Inst.Args[0].(armasm.Reg)
+	if !ok {
+		return false
+	}
+	for _, r := range insts[1:] {
+		if r.Inst.Op&^15 == armasm.MOVT_EQ && r.Inst.Args[0] == reg {
+			return true
+		}
+		if writesReg(r.Inst, reg) {
+			return false
+		}
+	}
+	return false
+}
+
+// readString returns a preview of the string at addr in text,
+// or "" if there is none.
+func readString(text armmem.Reader, addr uint64) string {
+	var buf []byte
+	var b [1]byte
+	for len(buf) <= maxString {
+		if _, err := text.ReadAt(b[:], int64(addr)+int64(len(buf))); err != nil {
+			return ""
+		}
+		c := b[0]
+		if c == 0 {
+			break
+		}
+		if (c < ' ' || c > '~') && c != '\t' && c != '\n' && c != '\r' {
+			return ""
+		}
+		buf = append(buf, c)
+	}
+	if len(buf) < minString || len(buf) > maxString {
+		return ""
+	}
+	if len(buf) > maxPreview {
+		return string(buf[:maxPreview]) + "..."
+	}
+	return string(buf)
+}
@@ -0,0 +1,91 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armconst
+
+import (
+	"debug/elf"
+	"reflect"
+	"strings"
+	"testing"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armdis"
+	"rsc.io/arm/armmem"
+	"rsc.io/arm/internal/armtest"
+)
+
+func TestDataRefs(t *testing.T) {
+	ws := []uint32{
+		0xe59f0010, // 0x00: ldr r0, [pc, #16]
+		0xe28f1010, // 0x04: add r1, pc, #16
+		0xe3022000, // 0x08: movw r2, #0x2000
+		0xe3402000, // 0x0c: movt r2, #0
+		0xe5923004, // 0x10: ldr r3, [r2, #4]
+		0xe12fff1e, // 0x14: bx lr
+		0x00001000, // 0x18: .word 0x1000
+		0x21524441, // 0x1c: .ascii "ADR!"
+		0x00000000, // 0x20: .word 0
+	}
+	code := armtest.Words(ws...)
+	var text armmem.Image
+	text.Add(0, code, false)
+	text.Add(0x1000, []byte("hello, world\x00"), false)
+	text.Add(0x2000, make([]byte, 16), true)
+	sections := []Section{{".rodata", 0x1000, 13}, {".data", 0x2000, 16}}
+
+	want := []DataRef{
+		{PC: 0x00, Target: 0x1000, Section: ".rodata", Preview: "hello, world"},
+		{PC: 0x04, Target: 0x1c, Preview: "ADR!"},
+		{PC: 0x0c, Target: 0x2000, Section: ".data"},
+		{PC: 0x10, Target: 0x2004, Section: ".data"},
+	}
+	refs := DataRefs(armdis.Linear(code[:4*6], 0, armasm.ModeARM).Insts(), &text, sections)
+	if !reflect.DeepEqual(refs, want) {
+		t.Errorf("DataRefs:\n%v\nwant:\n%v", refs, want)
+	}
+	if s, want := refs[0].String(), `0x0: 0x1000 .rodata "hello, world"`; s != want {
+		t.Errorf("DataRef.String() = %s, want %s", s, want)
+	}
+
+	// Without text, only the section references remain.
+	refs = DataRefs(armdis.Linear(code[:4*6], 0, armasm.ModeARM).Insts(), nil, sections)
+	if want := want[2:]; !reflect.DeepEqual(refs, want) {
+		t.Errorf("DataRefs(nil text):\n%v\nwant:\n%v", refs, want)
+	}
+}
+
+func TestReadString(t *testing.T) {
+	long := strings.Repeat("x", 100)
+	tests := []struct {
+		data string
+		want string
+	}{
+		{"hello\x00", "hello"},
+		{"tab\there\n\x00", "tab\there\n"},
+		{"abc\x00", ""},
+		{"bad\x01byte\x00", ""},
+		{"unterminated", ""},
+		{long + "\x00", long[:64] + "..."},
+	}
+	for _, tt := range tests {
+		var text armmem.Image
+		text.Add(0x100, []byte(tt.data), false)
+		if s := readString(&text, 0x100); s != tt.want {
+			t.Errorf("readString(%q) = %q, want %q", tt.data, s, tt.want)
+		}
+	}
+}
+
+func TestDataSections(t *testing.T) {
+	f, err := elf.Open("../armdis/testdata/reloc.o")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	want := []Section{{".data", 0, 8}}
+	if sects := DataSections(f); !reflect.DeepEqual(sects, want) {
+		t.Errorf("DataSections = %v, want %v", sects, want)
+	}
+}
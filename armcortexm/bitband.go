@@ -0,0 +1,73 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armcortexm
+
+import "fmt"
+
+// A bitBandRegion is a bit-band region and its alias region.
+// Each word in the 32 MB alias region maps to one bit of the 1 MB region:
+// the word at Alias + 32*n + 4*b maps to bit b of the byte at Base + n.
+type bitBandRegion struct {
+	Base  uint64
+	Alias uint64
+}
+
+// bitBandRegions lists the bit-band regions of the ARMv7-M memory map.
+var bitBandRegions = []bitBandRegion{
+	{0x20000000, 0x22000000}, // SRAM
+	{0x40000000, 0x42000000}, // peripherals
+}
+
+const (
+	bitBandSize = 1 << 20 // size of a bit-band region
+	aliasSize   = 32 << 20
+)
+
+// A BitBand is a word in a bit-band alias region and the bit it maps to.
+type BitBand struct {
+	Alias uint64 // address of the alias word
+	Addr  uint64 // address of the byte holding the bit
+	Bit   uint   // bit number within the byte, 0 to 7
+}
+
+// String returns a description of the bit suitable for annotating
+// an access to the alias word, like "0x40010c0c bit 3".
+func (b BitBand) String() string {
+	return fmt.Sprintf("%#x bit %d", b.Addr, b.Bit)
+}
+
+// LookupBitBand reports whether addr is in one of the bit-band alias regions
+// of the ARMv7-M memory map, 0x22000000-0x23FFFFFF for SRAM and
+// 0x42000000-0x43FFFFFF for peripherals, and if so returns the byte address
+// and bit number to which it maps. Writes of 0 or 1 to the alias word clear or set
+// the bit atomically; reads return the bit in bit 0. The alias address is
+// rounded down to a word boundary, as the hardware does.
+//
+// Bit-banding is optional in ARMv7-M and absent in ARMv6-M and ARMv8-M,
+// so LookupBitBand should be used only for processors that implement it,
+// such as the Cortex-M3 and Cortex-M4.
+func LookupBitBand(addr uint64) (BitBand, bool) {
+	for _, r := range bitBandRegions {
+		if r.Alias <= addr && addr < r.Alias+aliasSize {
+			off := (addr - r.Alias) &^ 3
+			return BitBand{Alias: r.Alias + off, Addr: r.Base + off/32, Bit: uint(off/4) % 8}, true
+		}
+	}
+	return BitBand{}, false
+}
+
+// BitBandAlias returns the address of the alias word for bit of the byte at addr,
+// reporting whether addr is in a bit-band region and bit is at most 7.
+func BitBandAlias(addr uint64, bit uint) (uint64, bool) {
+	if bit > 7 {
+		return 0, false
+	}
+	for _, r := range bitBandRegions {
+		if r.Base <= addr && addr < r.Base+bitBandSize {
+			return r.Alias + 32*(addr-r.Base) + 4*uint64(bit), true
+		}
+	}
+	return 0, false
+}
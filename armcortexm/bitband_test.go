@@ -0,0 +1,55 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armcortexm
+
+import "testing"
+
+var bitBandTests = []struct {
+	alias uint64
+	ok    bool
+	addr  uint64
+	bit   uint
+}{
+	{0x22000000, true, 0x20000000, 0},
+	{0x22000004, true, 0x20000000, 1},
+	{0x2200001c, true, 0x20000000, 7},
+	{0x22000020, true, 0x20000001, 0},
+	{0x23fffffc, true, 0x200fffff, 7},
+	{0x42218180, true, 0x40010c0c, 0},
+	{0x422181ac, true, 0x40010c0d, 3},
+	{0x43fffffc, true, 0x400fffff, 7},
+	{0x21ffffff, false, 0, 0},
+	{0x24000000, false, 0, 0},
+	{0x40010800, false, 0, 0},
+	{0x44000000, false, 0, 0},
+	{0xe000e010, false, 0, 0},
+}
+
+func TestLookupBitBand(t *testing.T) {
+	for _, tt := range bitBandTests {
+		b, ok := LookupBitBand(tt.alias)
+		if ok != tt.ok || ok && (b.Addr != tt.addr || b.Bit != tt.bit || b.Alias != tt.alias) {
+			t.Errorf("LookupBitBand(%#x) = %+v, %v, want %#x bit %d, %v", tt.alias, b, ok, tt.addr, tt.bit, tt.ok)
+			continue
+		}
+		if !ok {
+			continue
+		}
+		alias, ok := BitBandAlias(tt.addr, tt.bit)
+		if !ok || alias != tt.alias {
+			t.Errorf("BitBandAlias(%#x, %d) = %#x, %v, want %#x, true", tt.addr, tt.bit, alias, ok, tt.alias)
+		}
+	}
+
+	if b, _ := LookupBitBand(0x422181ae); b.Alias != 0x422181ac || b.String() != "0x40010c0d bit 3" {
+		t.Errorf("LookupBitBand(0x422181ae) = %+v (%v), want alias 0x422181ac (0x40010c0d bit 3)", b, b)
+	}
+	if _, ok := BitBandAlias(0x20000000, 8); ok {
+		t.Errorf("BitBandAlias(0x20000000, 8) succeeded")
+	}
+	if _, ok := BitBandAlias(0x20100000, 0); ok {
+		t.Errorf("BitBandAlias(0x20100000, 0) succeeded")
+	}
+}
@@ -0,0 +1,26 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armcortexm
+
+// Common EXC_RETURN values, loaded into LR on exception entry.
+// Loading one of them into PC in Handler mode returns from the exception.
+const (
+	ExcReturnHandler   = 0xFFFFFFF1 // return to Handler mode, using the main stack
+	ExcReturnThreadMSP = 0xFFFFFFF9 // return to Thread mode, using the main stack
+	ExcReturnThreadPSP = 0xFFFFFFFD // return to Thread mode, using the process stack
+	ExcReturnFPBit     = 0x10       // clear if the exception frame includes floating-point state
+)
+
+// IsExcReturn reports whether loading v into PC is an exception return:
+// whether v is in the range 0xF0000000-0xFFFFFFFF, which M-profile
+// processors reserve for EXC_RETURN values (and, on ARMv8-M,
+// for the FNC_RETURN values of secure function returns)
+// rather than treating as a branch target.
+//
+// The test is meaningful only for M-profile code; on other profiles
+// the range is ordinary memory, holding, for example, the high vectors.
+func IsExcReturn(v uint32) bool {
+	return v>>28 == 0xF
+}
@@ -0,0 +1,26 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armcortexm
+
+import "testing"
+
+func TestIsExcReturn(t *testing.T) {
+	tests := []struct {
+		v   uint32
+		exc bool
+	}{
+		{ExcReturnHandler, true},
+		{ExcReturnThreadMSP, true},
+		{ExcReturnThreadPSP &^ ExcReturnFPBit, true},
+		{0xFEFFFFFF, true},
+		{0xEFFFFFFF, false},
+		{0x08000101, false},
+	}
+	for _, tt := range tests {
+		if exc := IsExcReturn(tt.v); exc != tt.exc {
+			t.Errorf("IsExcReturn(%#x) = %v, want %v", tt.v, exc, tt.exc)
+		}
+	}
+}
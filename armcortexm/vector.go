@@ -0,0 +1,142 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package armcortexm provides helpers for analyzing firmware
+// for M-profile (Cortex-M) processors.
+package armcortexm
+
+import (
+	"fmt"
+	"sort"
+
+	"rsc.io/arm/armmem"
+)
+
+// MaxVectors is the largest number of entries in an M-profile vector table:
+// the initial stack pointer, 15 system exceptions, and 496 external interrupts.
+const MaxVectors = 16 + 496
+
+// exceptionNames gives the names of the system exceptions, by number.
+var exceptionNames = [...]string{
+	1:  "Reset",
+	2:  "NMI",
+	3:  "HardFault",
+	4:  "MemManage",
+	5:  "BusFault",
+	6:  "UsageFault",
+	7:  "SecureFault",
+	11: "SVCall",
+	12: "DebugMonitor",
+	14: "PendSV",
+	15: "SysTick",
+}
+
+// ExceptionName returns the name of exception number n,
+// like "HardFault" for 3 or "IRQ5" for 21.
+// Reserved exception numbers are named "Reserved7" and so on.
+func ExceptionName(n int) string {
+	switch {
+	case n >= 16:
+		return fmt.Sprintf("IRQ%d", n-16)
+	case n > 0 && n < len(exceptionNames) && exceptionNames[n] != "":
+		return exceptionNames[n]
+	}
+	return fmt.Sprintf("Reserved%d", n)
+}
+
+// A Vector is an exception entry in a vector table.
+type Vector struct {
+	Exception int    // exception number: 1 for Reset, 16+n for IRQn
+	Addr      uint64 // address of the entry
+	Value     uint32 // the entry
+	Handler   uint64 // handler address: Value with the Thumb bit cleared
+}
+
+// Name returns the name of the exception, as given by ExceptionName.
+func (v Vector) Name() string {
+	return ExceptionName(v.Exception)
+}
+
+// Valid reports whether the entry is a handler address: whether it is
+// nonzero and has the Thumb bit set, as M-profile processors require.
+// Unused and reserved entries are usually zero.
+func (v Vector) Valid() bool {
+	return v.Value&1 != 0
+}
+
+func (v Vector) String() string {
+	if !v.Valid() {
+		return fmt.Sprintf("%s: %#x", v.Name(), v.Value)
+	}
+	return fmt.Sprintf("%s: %#x", v.Name(), v.Handler)
+}
+
+// A VectorTable is an M-profile vector table.
+type VectorTable struct {
+	Addr      uint64   // address of the table
+	InitialSP uint32   // initial main stack pointer, the first entry
+	Vectors   []Vector // exceptions 1 and up, indexed by exception number minus 1
+}
+
+// ReadVectorTable reads the vector table at addr in m.
+// If n is positive, the table has n entries, including the
+// initial stack pointer. Otherwise the size is inferred:
+// the table ends before the first entry, after the system exceptions,
+// that is neither zero nor a Thumb address, or that cannot be read,
+// up to MaxVectors entries.
+// The reset vector must be a Thumb address.
+func ReadVectorTable(m armmem.Reader, addr uint64, n int) (*VectorTable, error) {
+	if n > MaxVectors {
+		return nil, fmt.Errorf("vector table too large: %d entries", n)
+	}
+	sp, err := armmem.ReadUint32(m, addr)
+	if err != nil {
+		return nil, err
+	}
+	t := &VectorTable{Addr: addr, InitialSP: sp}
+	limit := n
+	if limit <= 0 {
+		limit = MaxVectors
+	}
+	for i := 1; i < limit; i++ {
+		a := addr + 4*uint64(i)
+		w, err := armmem.ReadUint32(m, a)
+		if err != nil {
+			if n > 0 || i < 16 {
+				return nil, err
+			}
+			break
+		}
+		v := Vector{Exception: i, Addr: a, Value: w, Handler: uint64(w &^ 1)}
+		if n <= 0 && i >= 16 && w != 0 && !v.Valid() {
+			break
+		}
+		t.Vectors = append(t.Vectors, v)
+	}
+	if len(t.Vectors) == 0 || !t.Vectors[0].Valid() {
+		return nil, fmt.Errorf("invalid reset vector in vector table at %#x", addr)
+	}
+	return t, nil
+}
+
+// Reset returns the reset vector.
+func (t *VectorTable) Reset() Vector {
+	return t.Vectors[0]
+}
+
+// Handlers returns the distinct handler addresses in the table,
+// in increasing order. They are entry points of Thumb code, suitable
+// for seeding recursive disassembly of a firmware image.
+func (t *VectorTable) Handlers() []uint64 {
+	seen := make(map[uint64]bool)
+	var list []uint64
+	for _, v := range t.Vectors {
+		if v.Valid() && !seen[v.Handler] {
+			seen[v.Handler] = true
+			list = append(list, v.Handler)
+		}
+	}
+	sort.Slice(list, func(i, j int) bool { return list[i] < list[j] })
+	return list
+}
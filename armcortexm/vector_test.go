@@ -0,0 +1,142 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armcortexm
+
+import (
+	"encoding/binary"
+	"reflect"
+	"testing"
+
+	"rsc.io/arm/armmem"
+)
+
+// image returns an image holding words at addr.
+func image(addr uint64, words ...uint32) *armmem.Image {
+	data := make([]byte, 4*len(words))
+	for i, w := range words {
+		binary.LittleEndian.PutUint32(data[4*i:], w)
+	}
+	m := new(armmem.Image)
+	m.Add(addr, data, false)
+	return m
+}
+
+var firmware = []uint32{
+	0x20001000, // initial SP
+	0x08000101, // Reset
+	0x08000141, // NMI
+	0x08000141, // HardFault
+	0, 0, 0, 0, 0, 0, 0,
+	0x08000141, // SVCall
+	0,
+	0,
+	0x08000151, // PendSV
+	0x08000161, // SysTick
+	0x08000171, // IRQ0
+	0,          // IRQ1
+	0x08000171, // IRQ2
+	0x4770bf00, // code following the table
+	0x12345678,
+}
+
+func TestReadVectorTable(t *testing.T) {
+	m := image(0x08000000, firmware...)
+	vt, err := ReadVectorTable(m, 0x08000000, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if vt.InitialSP != 0x20001000 {
+		t.Errorf("InitialSP = %#x, want 0x20001000", vt.InitialSP)
+	}
+	if len(vt.Vectors) != 18 {
+		t.Fatalf("have %d vectors, want 18", len(vt.Vectors))
+	}
+	if r := vt.Reset(); r.Handler != 0x08000100 || r.Name() != "Reset" || r.Addr != 0x08000004 {
+		t.Errorf("Reset() = %+v", r)
+	}
+	want := []string{
+		"Reset: 0x8000100",
+		"NMI: 0x8000140",
+		"HardFault: 0x8000140",
+		"MemManage: 0x0",
+		"BusFault: 0x0",
+		"UsageFault: 0x0",
+		"SecureFault: 0x0",
+		"Reserved8: 0x0",
+		"Reserved9: 0x0",
+		"Reserved10: 0x0",
+		"SVCall: 0x8000140",
+		"DebugMonitor: 0x0",
+		"Reserved13: 0x0",
+		"PendSV: 0x8000150",
+		"SysTick: 0x8000160",
+		"IRQ0: 0x8000170",
+		"IRQ1: 0x0",
+		"IRQ2: 0x8000170",
+	}
+	for i, v := range vt.Vectors {
+		if s := v.String(); s != want[i] {
+			t.Errorf("Vectors[%d] = %q, want %q", i, s, want[i])
+		}
+	}
+	handlers := []uint64{0x08000100, 0x08000140, 0x08000150, 0x08000160, 0x08000170}
+	if h := vt.Handlers(); !reflect.DeepEqual(h, handlers) {
+		t.Errorf("Handlers() = %#x, want %#x", h, handlers)
+	}
+
+	vt, err = ReadVectorTable(m, 0x08000000, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vt.Vectors) != 3 {
+		t.Errorf("with n=4, have %d vectors, want 3", len(vt.Vectors))
+	}
+
+	// The table ends at the end of memory.
+	m = image(0x08000000, firmware[:18]...)
+	vt, err = ReadVectorTable(m, 0x08000000, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vt.Vectors) != 17 {
+		t.Errorf("at end of memory, have %d vectors, want 17", len(vt.Vectors))
+	}
+}
+
+func TestReadVectorTableErrors(t *testing.T) {
+	m := image(0, 0x20001000, 0x08000100)
+	if _, err := ReadVectorTable(m, 0, 2); err == nil {
+		t.Errorf("ReadVectorTable with ARM reset vector succeeded")
+	}
+	if _, err := ReadVectorTable(m, 0, 0); err == nil {
+		t.Errorf("ReadVectorTable of truncated table succeeded")
+	}
+	if _, err := ReadVectorTable(m, 0, 3); err == nil {
+		t.Errorf("ReadVectorTable past end of memory succeeded")
+	}
+	if _, err := ReadVectorTable(m, 0, MaxVectors+1); err == nil {
+		t.Errorf("ReadVectorTable of %d entries succeeded", MaxVectors+1)
+	}
+}
+
+func TestExceptionName(t *testing.T) {
+	tests := []struct {
+		n    int
+		name string
+	}{
+		{0, "Reserved0"},
+		{1, "Reset"},
+		{3, "HardFault"},
+		{13, "Reserved13"},
+		{15, "SysTick"},
+		{16, "IRQ0"},
+		{255, "IRQ239"},
+	}
+	for _, tt := range tests {
+		if name := ExceptionName(tt.n); name != tt.name {
+			t.Errorf("ExceptionName(%d) = %q, want %q", tt.n, name, tt.name)
+		}
+	}
+}
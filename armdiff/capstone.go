@@ -0,0 +1,58 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build cgo && capstone
+// +build cgo,capstone
+
+package armdiff
+
+/*
+#cgo LDFLAGS: -lcapstone
+#include <stdlib.h>
+#include <capstone/capstone.h>
+*/
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+type capstone struct{}
+
+// NewCapstone returns a Disassembler that uses the Capstone library.
+func NewCapstone() (Disassembler, error) {
+	return capstone{}, nil
+}
+
+func (capstone) Disassemble(arch Arch, words []uint32) ([]string, error) {
+	var (
+		csArch C.cs_arch = C.CS_ARCH_ARM
+		csMode C.cs_mode = C.CS_MODE_ARM
+	)
+	if arch == ARM64 {
+		csArch, csMode = C.CS_ARCH_ARM64, C.CS_MODE_ARM
+	}
+	var h C.csh
+	if e := C.cs_open(csArch, csMode, &h); e != C.CS_ERR_OK {
+		return nil, fmt.Errorf("capstone: cs_open: %s", C.GoString(C.cs_strerror(e)))
+	}
+	defer C.cs_close(&h)
+
+	buf := (*C.uint8_t)(C.malloc(4))
+	defer C.free(unsafe.Pointer(buf))
+	src := (*[4]byte)(unsafe.Pointer(buf))
+	texts := make([]string, len(words))
+	for i, w := range words {
+		src[0], src[1], src[2], src[3] = byte(w), byte(w>>8), byte(w>>16), byte(w>>24)
+		var insn *C.cs_insn
+		n := C.cs_disasm(h, buf, 4, 0, 1, &insn)
+		if n == 0 {
+			continue
+		}
+		texts[i] = C.GoString(&insn.mnemonic[0]) + " " + C.GoString(&insn.op_str[0])
+		C.cs_free(insn, n)
+	}
+	return texts, nil
+}
@@ -0,0 +1,142 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Armdiff compares the armasm and arm64asm disassemblers against
+// a reference disassembler on generated instruction words.
+//
+// Usage:
+//
+//	armdiff [-arch arm|arm64] [-ref objdump|capstone] [-objdump path]
+//		[-gen random|structured] [-n count] [-seed n] [-kind kinds]
+//
+// Each disagreement is printed to standard output as a JSON object on a line
+// by itself, with fields Arch, Word, Kind, Ours, and Theirs, as described by
+// the armdiff package's Diff type. A count of each kind is printed to standard
+// error at the end. The exit status is 1 if there were any disagreements.
+//
+// The -gen flag selects how words are generated: random generates -n
+// uniformly random words, and structured generates -n words for each
+// encoding in the decoder tables. The -kind flag restricts the output to a
+// comma-separated list of kinds: decode, mnemonic, and operands.
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"log"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+
+	"rsc.io/arm/armdiff"
+)
+
+var (
+	archFlag    = flag.String("arch", "arm", "instruction set: arm or arm64")
+	refFlag     = flag.String("ref", "objdump", "reference disassembler: objdump or capstone")
+	objdumpFlag = flag.String("objdump", "objdump", "objdump binary `path`")
+	genFlag     = flag.String("gen", "random", "word generator: random or structured")
+	nFlag       = flag.Int("n", 10000, "number of words (per encoding, for -gen=structured)")
+	seedFlag    = flag.Int64("seed", 0, "random seed (default: time-based)")
+	kindFlag    = flag.String("kind", "decode,mnemonic,operands", "report only these `kinds` of disagreement")
+)
+
+// batch is the number of words passed to the reference at once.
+const batch = 1 << 16
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: armdiff [flags]\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("armdiff: ")
+
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() != 0 {
+		usage()
+	}
+
+	var arch armdiff.Arch
+	switch *archFlag {
+	case "arm":
+		arch = armdiff.ARM
+	case "arm64":
+		arch = armdiff.ARM64
+	default:
+		log.Fatalf("unknown -arch %q", *archFlag)
+	}
+
+	var ref armdiff.Disassembler
+	switch *refFlag {
+	case "objdump":
+		ref = &armdiff.Objdump{Path: *objdumpFlag}
+	case "capstone":
+		var err error
+		ref, err = armdiff.NewCapstone()
+		if err != nil {
+			log.Fatal(err)
+		}
+	default:
+		log.Fatalf("unknown -ref %q", *refFlag)
+	}
+
+	seed := *seedFlag
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	log.Printf("seed %d", seed)
+	r := rand.New(rand.NewSource(seed))
+	var words []uint32
+	switch *genFlag {
+	case "random":
+		words = armdiff.Random(r, *nFlag)
+	case "structured":
+		words = armdiff.Structured(arch, r, *nFlag)
+	default:
+		log.Fatalf("unknown -gen %q", *genFlag)
+	}
+
+	want := map[armdiff.Kind]bool{}
+	for _, k := range strings.Split(*kindFlag, ",") {
+		want[armdiff.Kind(strings.TrimSpace(k))] = true
+	}
+
+	enc := json.NewEncoder(os.Stdout)
+	count := map[armdiff.Kind]int{}
+	for len(words) > 0 {
+		n := len(words)
+		if n > batch {
+			n = batch
+		}
+		diffs, err := armdiff.Compare(arch, ref, words[:n])
+		if err != nil {
+			log.Fatal(err)
+		}
+		words = words[n:]
+		for _, d := range diffs {
+			if !want[d.Kind] {
+				continue
+			}
+			count[d.Kind]++
+			if err := enc.Encode(d); err != nil {
+				log.Fatal(err)
+			}
+		}
+	}
+
+	total := 0
+	for _, k := range []armdiff.Kind{armdiff.KindDecode, armdiff.KindMnemonic, armdiff.KindOperands} {
+		log.Printf("%s: %d", k, count[k])
+		total += count[k]
+	}
+	if total > 0 {
+		os.Exit(1)
+	}
+}
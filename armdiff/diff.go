@@ -0,0 +1,191 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package armdiff compares the armasm and arm64asm disassemblers
+// against an external reference disassembler.
+//
+// Compare disassembles a batch of instruction words both ways and reports
+// each disagreement as a Diff, classified by how serious it is: one side
+// decodes the word and the other does not, the two decode it as different
+// instructions, or they agree on the instruction but print its operands
+// differently. Random and Structured generate the batches: Structured
+// fills in the variable bits of every known encoding, so that it reaches
+// instructions that uniformly random words rarely hit.
+//
+// The reference is GNU objdump, run as a subprocess, or the Capstone
+// library, which requires building with cgo and the capstone build tag.
+package armdiff
+
+import (
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"rsc.io/arm/arm64asm"
+	"rsc.io/arm/armasm"
+)
+
+// An Arch is an instruction set.
+type Arch int
+
+const (
+	_     Arch = iota
+	ARM        // 32-bit ARM, in ARM mode
+	ARM64      // A64
+)
+
+func (a Arch) String() string {
+	switch a {
+	case ARM:
+		return "arm"
+	case ARM64:
+		return "arm64"
+	}
+	return fmt.Sprintf("Arch(%d)", int(a))
+}
+
+// A Disassembler is an external reference disassembler.
+type Disassembler interface {
+	// Disassemble returns the GNU-style assembly text for each of the
+	// little-endian instruction words, or "" for a word it cannot decode.
+	Disassemble(arch Arch, words []uint32) ([]string, error)
+}
+
+// A Kind classifies a disagreement.
+type Kind string
+
+const (
+	KindDecode   Kind = "decode"   // only one side decodes the word
+	KindMnemonic Kind = "mnemonic" // the sides decode different instructions
+	KindOperands Kind = "operands" // the sides agree on the mnemonic but not the operands
+)
+
+// A Diff is a disagreement about a single instruction word.
+// Ours and Theirs are the normalized texts, "" for a word that does not decode.
+type Diff struct {
+	Arch   string
+	Word   uint32
+	Kind   Kind
+	Ours   string
+	Theirs string
+}
+
+func (d Diff) String() string {
+	return fmt.Sprintf("%s %#08x: %s: %q vs %q", d.Arch, d.Word, d.Kind, d.Ours, d.Theirs)
+}
+
+// Compare disassembles words with our decoder and with ref
+// and returns the disagreements, in the order of words.
+func Compare(arch Arch, ref Disassembler, words []uint32) ([]Diff, error) {
+	theirs, err := ref.Disassemble(arch, words)
+	if err != nil {
+		return nil, err
+	}
+	if len(theirs) != len(words) {
+		return nil, fmt.Errorf("reference disassembled %d of %d words", len(theirs), len(words))
+	}
+	var diffs []Diff
+	for i, w := range words {
+		ours := Disassemble(arch, w)
+		t := Normalize(theirs[i])
+		var kind Kind
+		switch {
+		case ours == t:
+			continue
+		case ours == "" || t == "":
+			kind = KindDecode
+		case mnemonic(ours) != mnemonic(t):
+			kind = KindMnemonic
+		default:
+			kind = KindOperands
+		}
+		diffs = append(diffs, Diff{Arch: arch.String(), Word: w, Kind: kind, Ours: ours, Theirs: t})
+	}
+	return diffs, nil
+}
+
+// Disassemble returns the normalized GNU syntax for w,
+// or "" if w does not decode.
+func Disassemble(arch Arch, w uint32) string {
+	src := []byte{byte(w), byte(w >> 8), byte(w >> 16), byte(w >> 24)}
+	switch arch {
+	case ARM:
+		inst, err := armasm.Decode(src, armasm.ModeARM)
+		if err != nil {
+			return ""
+		}
+		return Normalize(armasm.GNUSyntax(inst))
+	case ARM64:
+		inst, err := arm64asm.Decode(src)
+		if err != nil {
+			return ""
+		}
+		return Normalize(arm64asm.GNUSyntax(inst))
+	}
+	return ""
+}
+
+// Normalize rewrites assembly text into a canonical form for comparison:
+// lower case, comments and <symbol> annotations removed,
+// and runs of spaces and tabs collapsed into single spaces.
+func Normalize(text string) string {
+	for _, c := range []string{";", "//"} {
+		if i := strings.Index(text, c); i >= 0 {
+			text = text[:i]
+		}
+	}
+	if i := strings.Index(text, "<"); i >= 0 {
+		if j := strings.Index(text[i:], ">"); j >= 0 {
+			text = text[:i] + text[i+j+1:]
+		}
+	}
+	return strings.ToLower(strings.Join(strings.Fields(text), " "))
+}
+
+// mnemonic returns the first word of the normalized text.
+func mnemonic(text string) string {
+	if i := strings.Index(text, " "); i >= 0 {
+		return text[:i]
+	}
+	return text
+}
+
+// Random returns n uniformly random instruction words.
+func Random(r *rand.Rand, n int) []uint32 {
+	words := make([]uint32, n)
+	for i := range words {
+		words[i] = r.Uint32()
+	}
+	return words
+}
+
+// Structured returns n words for each encoding known to our decoder,
+// with the bits the encoding does not fix chosen at random.
+func Structured(arch Arch, r *rand.Rand, n int) []uint32 {
+	var words []uint32
+	add := func(mask, value uint32) {
+		for i := 0; i < n; i++ {
+			words = append(words, value|r.Uint32()&^mask)
+		}
+	}
+	switch arch {
+	case ARM:
+		for op := 1; op < 1<<16; op++ {
+			for _, enc := range armasm.Encodings(armasm.Op(op)) {
+				add(enc.Mask, enc.Value)
+			}
+		}
+	case ARM64:
+		for op := 1; op < 1<<16; op++ {
+			encs := arm64asm.Encodings(arm64asm.Op(op))
+			if encs == nil {
+				break
+			}
+			for _, enc := range encs {
+				add(enc.Mask, enc.Value)
+			}
+		}
+	}
+	return words
+}
@@ -0,0 +1,99 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdiff
+
+import (
+	"math/rand"
+	"reflect"
+	"testing"
+)
+
+// fakeRef is a Disassembler that returns fixed texts.
+type fakeRef map[uint32]string
+
+func (f fakeRef) Disassemble(arch Arch, words []uint32) ([]string, error) {
+	texts := make([]string, len(words))
+	for i, w := range words {
+		texts[i] = f[w]
+	}
+	return texts, nil
+}
+
+func TestCompare(t *testing.T) {
+	ref := fakeRef{
+		0xe0810002: "add\tr0, r1, r2",        // agree
+		0xe0a10002: "adc\tr0, r1, r3 ; oops", // operands
+		0xe0410002: "add\tr0, r1, r2",        // mnemonic (sub)
+		0xe7f000f0: "udf\t#0",                // decode
+		0xe12fff1e: "bx\tlr\t; <return>",     // agree
+		0xffffffff: "",                       // agree: neither decodes
+	}
+	words := []uint32{0xe0810002, 0xe0a10002, 0xe0410002, 0xe7f000f0, 0xe12fff1e, 0xffffffff}
+	diffs, err := Compare(ARM, ref, words)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Diff{
+		{"arm", 0xe0a10002, KindOperands, "adc r0, r1, r2", "adc r0, r1, r3"},
+		{"arm", 0xe0410002, KindMnemonic, "sub r0, r1, r2", "add r0, r1, r2"},
+		{"arm", 0xe7f000f0, KindDecode, "", "udf #0"},
+	}
+	if !reflect.DeepEqual(diffs, want) {
+		t.Errorf("Compare:\nhave %v\nwant %v", diffs, want)
+	}
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct{ in, out string }{
+		{"ADD\tR0,  R1, R2", "add r0, r1, r2"},
+		{"bl\t0x100 <main+0x10>", "bl 0x100"},
+		{"ldr\tr0, [pc, #4]\t; 0x10", "ldr r0, [pc, #4]"},
+		{"ldr\tx0, 8 // #8", "ldr x0, 8"},
+		{"", ""},
+	}
+	for _, tt := range tests {
+		if out := Normalize(tt.in); out != tt.out {
+			t.Errorf("Normalize(%q) = %q, want %q", tt.in, out, tt.out)
+		}
+	}
+}
+
+func TestParseObjdumpLine(t *testing.T) {
+	tests := []struct {
+		line string
+		addr uint64
+		text string
+		ok   bool
+	}{
+		{"   4:\te0810002 \tadd\tr0, r1, r2", 4, "add\tr0, r1, r2", true},
+		{"   8:\tf7f0a000 \tundefined instruction 0xf7f0a000", 8, "", true},
+		{"  1c:\t00000000 \t.inst\t0x00000000 ; undefined", 0x1c, "", true},
+		{"00000000 <.data>:", 0, "", false},
+		{"Disassembly of section .data:", 0, "", false},
+	}
+	for _, tt := range tests {
+		addr, text, ok := parseObjdumpLine(tt.line)
+		if addr != tt.addr || text != tt.text || ok != tt.ok {
+			t.Errorf("parseObjdumpLine(%q) = %#x, %q, %v, want %#x, %q, %v", tt.line, addr, text, ok, tt.addr, tt.text, tt.ok)
+		}
+	}
+}
+
+func TestStructured(t *testing.T) {
+	for _, arch := range []Arch{ARM, ARM64} {
+		words := Structured(arch, rand.New(rand.NewSource(1)), 1)
+		decoded := 0
+		for _, w := range words {
+			if Disassemble(arch, w) != "" {
+				decoded++
+			}
+		}
+		// Random operand bits sometimes hit reserved values,
+		// but most generated words should decode.
+		if len(words) < 500 || decoded < len(words)*3/4 {
+			t.Errorf("Structured(%v): %d of %d words decode", arch, decoded, len(words))
+		}
+	}
+}
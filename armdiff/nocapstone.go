@@ -0,0 +1,17 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+//go:build !cgo || !capstone
+// +build !cgo !capstone
+
+package armdiff
+
+import "errors"
+
+// NewCapstone returns a Disassembler that uses the Capstone library.
+// This build does not include Capstone support;
+// rebuild with cgo enabled and -tags capstone.
+func NewCapstone() (Disassembler, error) {
+	return nil, errors.New("capstone: not supported in this build (use -tags capstone)")
+}
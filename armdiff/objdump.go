@@ -0,0 +1,93 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdiff
+
+import (
+	"bufio"
+	"encoding/binary"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"strconv"
+	"strings"
+)
+
+// Objdump is a Disassembler that runs GNU objdump on a raw binary file.
+type Objdump struct {
+	Path string // path to an objdump built with ARM and AArch64 support
+}
+
+func (o *Objdump) Disassemble(arch Arch, words []uint32) ([]string, error) {
+	machine := "arm"
+	if arch == ARM64 {
+		machine = "aarch64"
+	}
+	f, err := ioutil.TempFile("", "armdiff")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	w := bufio.NewWriter(f)
+	var buf [4]byte
+	for _, x := range words {
+		binary.LittleEndian.PutUint32(buf[:], x)
+		w.Write(buf[:])
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+
+	out, err := exec.Command(o.Path, "-D", "-z", "-b", "binary", "-m", machine, "-EL", f.Name()).Output()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", o.Path, err)
+	}
+	texts := make([]string, len(words))
+	seen := make([]bool, len(words))
+	for _, line := range strings.Split(string(out), "\n") {
+		addr, text, ok := parseObjdumpLine(line)
+		if !ok || addr%4 != 0 || addr/4 >= uint64(len(words)) {
+			continue
+		}
+		texts[addr/4] = text
+		seen[addr/4] = true
+	}
+	for i, ok := range seen {
+		if !ok {
+			return nil, fmt.Errorf("%s: no output for word %d (%#08x)", o.Path, i, words[i])
+		}
+	}
+	return texts, nil
+}
+
+// parseObjdumpLine parses an objdump -D line like
+//
+//	4:	e0810002 	add	r0, r1, r2
+//
+// returning the address and the assembly text,
+// or "" if objdump could not decode the word.
+func parseObjdumpLine(line string) (addr uint64, text string, ok bool) {
+	f := strings.SplitN(line, "\t", 3)
+	if len(f) < 2 || !strings.HasSuffix(f[0], ":") {
+		return 0, "", false
+	}
+	addr, err := strconv.ParseUint(strings.TrimSpace(strings.TrimSuffix(f[0], ":")), 16, 64)
+	if err != nil {
+		return 0, "", false
+	}
+	if len(f) == 3 {
+		text = f[2]
+	}
+	switch fs := strings.Fields(text); {
+	case len(fs) == 0,
+		fs[0] == "undefined", fs[0] == ".inst", fs[0] == ".word", fs[0] == "udf":
+		text = ""
+	}
+	return addr, text, true
+}
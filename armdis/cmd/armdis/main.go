@@ -0,0 +1,470 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Armdis disassembles a raw binary file or ELF file of ARM code.
+//
+// Usage:
+//
+//	armdis [-mode arm|thumb|auto] [-pc addr] [-offset start:end] [-vma addr] [-addr start:end] [-entry addr,...] [-resync] [-segment] [-j n] [-padding] [-syscalls] [-l] [-S] [-color auto|always|never] [-format text|json|csv] [-template text] file
+//
+// A raw binary file is loaded at address -pc (default 0).
+// The -offset flag loads only the bytes at the given range of file offsets,
+// such as a partial extraction from a flash dump, and the -vma flag gives
+// the load address of the first of those bytes (default: -pc plus the start offset).
+// Either end of the range may be omitted, as in -offset 0x1000: for the rest of the file.
+// For an ELF file, armdis disassembles the .text section at its load
+// address, accepting only the instructions available on the architecture
+// recorded in the file's build attributes. Branch targets and values
+// loaded from literal pools are shown with the symbols containing them,
+// as in "<memcpy+0x10>", and in a relocatable object, instructions with
+// relocations are annotated with the symbols they refer to. Where the
+// value loaded from a literal pool is unknown, as in a raw binary file or
+// a relocatable object, the literal's address is shown instead.
+//
+// The -mode flag selects the instruction set: arm (the default), thumb,
+// or auto, which chooses between them by scoring the code with
+// armdis.ScoreMode, for raw images without mapping symbols.
+// If the code does not look like either, auto reports a warning
+// and uses ARM. Note that armasm cannot yet decode Thumb instructions,
+// so Thumb code is shown as undecodable.
+//
+// The -addr flag, which applies to both raw binary and ELF files,
+// disassembles only the code at the given range of load addresses,
+// written like the -offset range. Literals outside the range
+// are still read from the loaded file.
+//
+// By default the code is disassembled by linear sweep; if -entry lists
+// one or more entry points, it is disassembled by following control flow
+// from them instead, as described in the armdis package documentation.
+// During a linear sweep, the -resync flag skips the bytes following
+// an undecodable instruction up to a plausible point to resume decoding,
+// as described for armdis.LinearResync. Otherwise a linear sweep of
+// a large file is split across -j goroutines (default GOMAXPROCS);
+// see armdis.LinearParallel. The -segment flag first divides the code
+// into code and data regions using armdis.Segment, for raw images that
+// mix the two, and sweeps only the regions in the selected mode,
+// showing the rest as data. When following control flow,
+// armdis warns about branches into the middle of decoded instructions.
+// The -padding flag shows the filler between functions, such as NOPs
+// and zero words, as padding rather than code; see armdis.Map.MarkPadding.
+//
+// The -syscalls flag annotates the Linux system calls in ARM code
+// with the name and number of the call, as in "; write (4)",
+// when the number can be determined; see package armsyscall.
+// It applies only to the text format.
+//
+// For an ELF file with DWARF debugging information, the -l flag shows
+// the source file and line number before the instructions compiled from
+// each line, and the -S flag also shows the text of the line itself,
+// read from the source file if it can be found.
+//
+// The -color flag controls the use of ANSI terminal colors in the output.
+// The default, auto, uses color when standard output is a terminal
+// and the NO_COLOR environment variable is unset or empty.
+//
+// The -format flag selects the output format: text (the default),
+// json, or csv, as written by armdis.WriteText, armdis.WriteJSON,
+// and armdis.WriteCSV. The json and csv formats list every range of the
+// disassembly, including data and unknown ones, and ignore -l, -S, and -color.
+//
+// The -template flag replaces the usual output with the result of executing
+// the given Go text/template for each instruction, followed by a newline.
+// The template's data is an armdis.TemplateInst. For example:
+//
+//	armdis -template '{{printf "%#x" .Addr}} {{.Op}}{{if .HasTarget}} {{printf "%#x" .Flow.Target}}{{end}}' file
+package main
+
+import (
+	"bytes"
+	"debug/elf"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armattr"
+	"rsc.io/arm/armcfg"
+	"rsc.io/arm/armdis"
+	"rsc.io/arm/armmem"
+	"rsc.io/arm/armsyscall"
+)
+
+var (
+	modeFlag     = flag.String("mode", "arm", "instruction set `mode`: arm, thumb, or auto")
+	pcFlag       = flag.String("pc", "0", "load `address` of the file")
+	offsetFlag   = flag.String("offset", "", "disassemble only the file offset `range` start:end of a raw binary file")
+	vmaFlag      = flag.String("vma", "", "load `address` of the first byte of the -offset range")
+	addrFlag     = flag.String("addr", "", "disassemble only the address `range` start:end")
+	entryFlag    = flag.String("entry", "", "comma-separated entry point `addresses` (default: linear sweep)")
+	resyncFlag   = flag.Bool("resync", false, "resynchronize linear sweep after undecodable bytes")
+	segmentFlag  = flag.Bool("segment", false, "sweep only the code regions found by segmenting the image")
+	jFlag        = flag.Int("j", runtime.GOMAXPROCS(0), "sweep with up to `n` goroutines")
+	paddingFlag  = flag.Bool("padding", false, "show alignment padding between functions as padding, not code")
+	syscallFlag  = flag.Bool("syscalls", false, "annotate Linux system calls with their names and numbers")
+	lineFlag     = flag.Bool("l", false, "show source file and line numbers from DWARF debugging information")
+	sourceFlag   = flag.Bool("S", false, "show source lines from DWARF debugging information (implies -l)")
+	colorFlag    = flag.String("color", "auto", "use color: auto, always, or never")
+	formatFlag   = flag.String("format", "text", "output `format`: text, json, or csv")
+	templateFlag = flag.String("template", "", "print each instruction using the Go `template`")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: armdis [flags] file\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("armdis: ")
+
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() != 1 {
+		usage()
+	}
+
+	pc, err := strconv.ParseUint(*pcFlag, 0, 64)
+	if err != nil {
+		log.Fatalf("invalid -pc: %v", err)
+	}
+	var entries []uint64
+	if *entryFlag != "" {
+		for _, s := range strings.Split(*entryFlag, ",") {
+			e, err := strconv.ParseUint(strings.TrimSpace(s), 0, 64)
+			if err != nil {
+				log.Fatalf("invalid -entry: %v", err)
+			}
+			entries = append(entries, e)
+		}
+	}
+	var opts armdis.TextOptions
+	switch *colorFlag {
+	case "auto":
+		opts.Color = useColor(os.Stdout)
+	case "always":
+		opts.Color = true
+	case "never":
+	default:
+		log.Fatalf("unknown -color %q", *colorFlag)
+	}
+
+	switch *formatFlag {
+	case "text", "json", "csv":
+	default:
+		log.Fatalf("unknown -format %q", *formatFlag)
+	}
+	var tmpl *template.Template
+	if *templateFlag != "" {
+		if *formatFlag != "text" {
+			log.Fatalf("-template cannot be used with -format %s", *formatFlag)
+		}
+		text := *templateFlag
+		if !strings.HasSuffix(text, "\n") {
+			text += "\n"
+		}
+		tmpl, err = template.New("armdis").Parse(text)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	code, err := ioutil.ReadFile(flag.Arg(0))
+	if err != nil {
+		log.Fatal(err)
+	}
+	img := &image{text: code, addr: pc}
+	if bytes.HasPrefix(code, []byte(elf.ELFMAG)) {
+		if *offsetFlag != "" || *vmaFlag != "" {
+			log.Fatalf("-offset and -vma apply only to raw binary files")
+		}
+		img, err = loadELF(code)
+		if err != nil {
+			log.Fatalf("%s: %v", flag.Arg(0), err)
+		}
+	} else if *offsetFlag != "" || *vmaFlag != "" {
+		start, end, err := parseRange(*offsetFlag, 0, uint64(len(code)))
+		if err != nil {
+			log.Fatalf("invalid -offset: %v", err)
+		}
+		img.text = code[start:end]
+		img.addr = pc + start
+		if *vmaFlag != "" {
+			img.addr, err = strconv.ParseUint(*vmaFlag, 0, 64)
+			if err != nil {
+				log.Fatalf("invalid -vma: %v", err)
+			}
+		}
+	}
+	if img.mem == nil {
+		var mem armmem.Image
+		mem.Add(img.addr, img.text, false)
+		img.mem = &mem
+	}
+	code, pc, dec := img.text, img.addr, img.dec
+	if *addrFlag != "" {
+		start, end, err := parseRange(*addrFlag, pc, pc+uint64(len(code)))
+		if err != nil {
+			log.Fatalf("invalid -addr: %v", err)
+		}
+		code, pc = code[start-pc:end-pc], start
+	}
+	opts.Relocs = img.relocs
+	opts.Text = img.mem
+	if img.syms != nil {
+		opts.Symname = img.syms.lookup
+	}
+	if img.lines != nil {
+		opts.Line = img.lines.Lookup
+		if *sourceFlag {
+			opts.Source = newSourceCache().line
+		}
+	}
+	var mode armasm.Mode
+	switch *modeFlag {
+	case "arm":
+		mode = armasm.ModeARM
+	case "thumb":
+		mode = armasm.ModeThumb
+	case "auto":
+		s := armdis.ScoreMode(dec, code, pc)
+		mode = s.Mode()
+		switch mode {
+		case 0:
+			log.Printf("warning: code does not look like ARM or Thumb: %v; using ARM", s)
+			mode = armasm.ModeARM
+		case armasm.ModeThumb:
+			log.Printf("warning: code looks like Thumb, which cannot yet be decoded: %v", s)
+		}
+	default:
+		log.Fatalf("unknown -mode %q", *modeFlag)
+	}
+	var m *armdis.Map
+	if len(entries) > 0 {
+		m = armdis.RecursiveDecoder(dec, code, pc, mode, entries...)
+	} else if *segmentFlag {
+		m = armdis.LinearRegions(dec, code, pc, mode, armdis.Segment(dec, code, pc))
+	} else if *resyncFlag {
+		m = armdis.LinearResync(dec, code, pc, mode)
+	} else {
+		m = armdis.LinearParallel(dec, code, pc, mode, *jFlag)
+	}
+	if *paddingFlag {
+		m.MarkPadding(code)
+	}
+	for _, o := range m.Overlaps {
+		log.Printf("warning: overlapping instructions: %v", o)
+	}
+	if *syscallFlag {
+		calls := syscalls(m, img.mem)
+		opts.Comment = func(addr uint64) string { return calls[addr] }
+	}
+	switch {
+	case tmpl != nil:
+		err = armdis.WriteTemplate(os.Stdout, m, tmpl)
+	case *formatFlag == "json":
+		err = armdis.WriteJSON(os.Stdout, m)
+	case *formatFlag == "csv":
+		err = armdis.WriteCSV(os.Stdout, m)
+	default:
+		err = armdis.WriteText(os.Stdout, m, &opts)
+	}
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// syscalls returns the annotations for the Linux system calls in m
+// whose numbers can be determined, such as "write (4)", keyed by address.
+// The call numbers are tracked through each basic block of m;
+// calls in Thumb code are not found.
+func syscalls(m *armdis.Map, text armmem.Reader) map[uint64]string {
+	calls := make(map[uint64]string)
+	if m.Mode != armasm.ModeARM {
+		return calls
+	}
+	for _, b := range armcfg.Build(m).Blocks {
+		for _, c := range armsyscall.Find(b.Insts, text) {
+			name := c.Name()
+			if name == "" {
+				name = "unknown"
+			}
+			calls[c.PC] = fmt.Sprintf("%s (%d)", name, c.Num)
+		}
+	}
+	return calls
+}
+
+// parseRange parses a range start:end within [lo, hi),
+// in which a missing start or end defaults to lo or hi.
+func parseRange(s string, lo, hi uint64) (start, end uint64, err error) {
+	i := strings.Index(s, ":")
+	if i < 0 {
+		return 0, 0, fmt.Errorf("%q is not of the form start:end", s)
+	}
+	start, end = lo, hi
+	if x := strings.TrimSpace(s[:i]); x != "" {
+		if start, err = strconv.ParseUint(x, 0, 64); err != nil {
+			return 0, 0, err
+		}
+	}
+	if x := strings.TrimSpace(s[i+1:]); x != "" {
+		if end, err = strconv.ParseUint(x, 0, 64); err != nil {
+			return 0, 0, err
+		}
+	}
+	if start < lo || end > hi || start > end {
+		return 0, 0, fmt.Errorf("range %#x:%#x not within %#x:%#x", start, end, lo, hi)
+	}
+	return start, end, nil
+}
+
+// An image is the code to disassemble and the information about it
+// available from the file holding it.
+type image struct {
+	text   []byte            // code
+	addr   uint64            // address of text
+	dec    *armasm.Decoder   // decoder for the file's architecture, or nil
+	relocs []armdis.Reloc    // relocations applying to text
+	mem    armmem.Reader     // memory for reading literals
+	syms   *symtab           // symbol table, or nil
+	lines  *armdis.LineTable // DWARF line table, or nil
+}
+
+// loadELF returns the .text section of the ELF file held in data,
+// along with a decoder configured from the file's build attributes,
+// the file's symbols, its loadable segments for reading literals,
+// and, for a relocatable object, the relocations applying to the section.
+// If -l or -S is set, it also reads the DWARF line table.
+func loadELF(data []byte) (*image, error) {
+	f, err := elf.NewFile(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if f.Machine != elf.EM_ARM {
+		return nil, fmt.Errorf("not an ARM file (machine %v)", f.Machine)
+	}
+	s := f.Section(".text")
+	if s == nil {
+		return nil, fmt.Errorf("no .text section")
+	}
+	img := &image{addr: s.Addr}
+	img.text, err = s.Data()
+	if err != nil {
+		return nil, err
+	}
+	attrs, err := armattr.FromELF(f)
+	if err != nil {
+		return nil, fmt.Errorf("reading build attributes: %v", err)
+	}
+	img.dec = attrs.Decoder()
+	if f.Type == elf.ET_REL {
+		img.relocs, err = armdis.Relocs(f, s)
+		if err != nil {
+			return nil, fmt.Errorf("reading relocations: %v", err)
+		}
+		for i := range img.relocs {
+			img.relocs[i].Addr += s.Addr
+		}
+	} else {
+		img.mem, err = armmem.FromELF(f)
+		if err != nil {
+			return nil, err
+		}
+	}
+	syms, err := f.Symbols()
+	if err != nil && err != elf.ErrNoSymbols {
+		return nil, err
+	}
+	img.syms = newSymtab(syms)
+	if (*lineFlag || *sourceFlag) && f.Section(".debug_info") != nil {
+		d, err := f.DWARF()
+		if err != nil {
+			return nil, fmt.Errorf("reading DWARF: %v", err)
+		}
+		img.lines, err = armdis.DWARFLines(d)
+		if err != nil {
+			return nil, fmt.Errorf("reading DWARF: %v", err)
+		}
+	}
+	return img, nil
+}
+
+// A sourceCache holds the lines of the source files read so far.
+type sourceCache struct {
+	files map[string][]string // nil for a file that cannot be read
+}
+
+func newSourceCache() *sourceCache {
+	return &sourceCache{files: make(map[string][]string)}
+}
+
+// line returns the text of the given line of file.
+func (c *sourceCache) line(file string, line int) (string, bool) {
+	lines, ok := c.files[file]
+	if !ok {
+		data, err := ioutil.ReadFile(file)
+		if err == nil {
+			lines = strings.Split(string(data), "\n")
+		}
+		c.files[file] = lines
+	}
+	if line < 1 || line > len(lines) {
+		return "", false
+	}
+	return strings.TrimSuffix(lines[line-1], "\r"), true
+}
+
+// A symtab is a table of the defined function and object symbols
+// in an ELF file, sorted by address.
+type symtab struct {
+	syms []elf.Symbol
+}
+
+func newSymtab(syms []elf.Symbol) *symtab {
+	t := new(symtab)
+	for _, s := range syms {
+		switch elf.ST_TYPE(s.Info) {
+		case elf.STT_FUNC, elf.STT_OBJECT, elf.STT_NOTYPE:
+			if s.Section != elf.SHN_UNDEF && s.Name != "" && !strings.HasPrefix(s.Name, "$") {
+				s.Value &^= 1 // Thumb bit
+				t.syms = append(t.syms, s)
+			}
+		}
+	}
+	sort.SliceStable(t.syms, func(i, j int) bool { return t.syms[i].Value < t.syms[j].Value })
+	return t
+}
+
+// lookup returns the name and address of the symbol containing addr,
+// or "", 0 if there is none.
+func (t *symtab) lookup(addr uint64) (string, uint64) {
+	i := sort.Search(len(t.syms), func(i int) bool { return t.syms[i].Value > addr }) - 1
+	if i < 0 {
+		return "", 0
+	}
+	s := t.syms[i]
+	if s.Size != 0 && addr >= s.Value+s.Size {
+		return "", 0
+	}
+	return s.Name, s.Value
+}
+
+// useColor reports whether to color output written to f:
+// f must be a terminal, and NO_COLOR must not be set to a non-empty value
+// (see https://no-color.org).
+func useColor(f *os.File) bool {
+	if os.Getenv("NO_COLOR") != "" {
+		return false
+	}
+	fi, err := f.Stat()
+	return err == nil && fi.Mode()&os.ModeCharDevice != 0
+}
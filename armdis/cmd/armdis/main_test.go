@@ -0,0 +1,51 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"bytes"
+	"encoding/binary"
+	"strings"
+	"testing"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armdis"
+)
+
+func TestSyscalls(t *testing.T) {
+	var code []byte
+	for _, w := range []uint32{
+		0xe3a07004, // MOV R7, #4
+		0xef000000, // SVC #0
+		0xef900001, // SVC #0x900001
+		0xe12fff1e, // BX LR
+		0xef000000, // SVC #0 (R7 unknown)
+	} {
+		code = binary.LittleEndian.AppendUint32(code, w)
+	}
+	m := armdis.Linear(code, 0x1000, armasm.ModeARM)
+	calls := syscalls(m, nil)
+	opts := &armdis.TextOptions{
+		Comment: func(addr uint64) string { return calls[addr] },
+	}
+	var buf bytes.Buffer
+	if err := armdis.WriteText(&buf, m, opts); err != nil {
+		t.Fatal(err)
+	}
+	want := strings.TrimLeft(`
+0x1000	e3a07004	MOV R7, #0x4
+0x1004	ef000000	SVC #0x0	; write (4)
+0x1008	ef900001	SVC #0x900001	; exit (1)
+0x100c	e12fff1e	BX LR
+0x1010	ef000000	SVC #0x0
+`, "\n")
+	if buf.String() != want {
+		t.Errorf("WriteText with -syscalls:\n%s\nwant:\n%s", buf.String(), want)
+	}
+
+	if calls := syscalls(armdis.Linear(code, 0x1000, armasm.ModeThumb), nil); len(calls) != 0 {
+		t.Errorf("syscalls in Thumb mode = %v, want none", calls)
+	}
+}
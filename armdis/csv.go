@@ -0,0 +1,80 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strconv"
+
+	"rsc.io/arm/armasm"
+)
+
+// csvHeader is the header row written by WriteCSV.
+var csvHeader = []string{
+	"addr", "end", "kind", "reason", "bytes", "op",
+	"arg1", "arg2", "arg3", "arg4",
+	"flow", "cond", "target",
+}
+
+// WriteCSV writes m to w in CSV format, one row per range,
+// preceded by a header row naming the columns:
+//
+//	addr    start address, in hex
+//	end     end address (exclusive), in hex
+//	kind    code, data, unknown, or padding
+//	reason  the reason for the classification, like sweep or jump
+//	bytes   the instruction bytes in memory order, in hex
+//	op      the instruction mnemonic
+//	arg1-4  the instruction arguments
+//	flow    the control flow kind, like None, Jump, or Return
+//	cond    true if the instruction is conditional
+//	target  the branch or call target, in hex, if known
+//
+// For ranges that are not code, the instruction columns are empty.
+func WriteCSV(w io.Writer, m *Map) error {
+	cw := csv.NewWriter(w)
+	cw.Write(csvHeader)
+	row := make([]string, len(csvHeader))
+	for _, r := range m.Ranges {
+		for i := range row {
+			row[i] = ""
+		}
+		row[0] = fmt.Sprintf("%#x", r.Start)
+		row[1] = fmt.Sprintf("%#x", r.End)
+		row[2] = r.Kind.String()
+		row[3] = r.Reason.String()
+		if r.Kind == Code {
+			inst := r.Inst
+			row[4] = instBytes(inst)
+			row[5] = inst.Op.String()
+			for i, arg := range inst.Args {
+				if arg != nil {
+					row[6+i] = arg.String()
+				}
+			}
+			f := Classify(inst, r.Start, m.Mode)
+			row[10] = f.Kind.String()
+			row[11] = strconv.FormatBool(f.Cond)
+			if f.Kind == FlowJump || f.Kind == FlowCall {
+				row[12] = fmt.Sprintf("%#x", f.Target)
+			}
+		}
+		cw.Write(row)
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+// instBytes returns the bytes of inst's encoding in memory order, in hex.
+// ARM instructions are stored little-endian.
+func instBytes(inst armasm.Inst) string {
+	var s string
+	for i := 0; i < inst.Len; i++ {
+		s += fmt.Sprintf("%02x", byte(inst.Enc>>(8*uint(i))))
+	}
+	return s
+}
@@ -0,0 +1,33 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"rsc.io/arm/armasm"
+)
+
+func TestWriteCSV(t *testing.T) {
+	m := Recursive(testCode, 0x1000, armasm.ModeARM, 0x1000)
+	var buf bytes.Buffer
+	if err := WriteCSV(&buf, m); err != nil {
+		t.Fatal(err)
+	}
+	want := strings.TrimLeft(`
+addr,end,kind,reason,bytes,op,arg1,arg2,arg3,arg4,flow,cond,target
+0x1000,0x1004,code,entry,0100a0e3,MOV,R0,#0x1,,,None,false,
+0x1004,0x1008,code,fallthrough,010000ea,B,PC+0x4,,,,Jump,false,0x1010
+0x1008,0x1010,unknown,unreached,,,,,,,,,
+0x1010,0x1014,code,jump,0000000a,B.EQ,PC+0x0,,,,Jump,true,0x1018
+0x1014,0x1018,code,fallthrough,1eff2fe1,BX,LR,,,,Return,false,
+0x1018,0x101c,code,jump,1080bde8,POP,"{R4,PC}",,,,Return,false,
+`, "\n")
+	if buf.String() != want {
+		t.Errorf("WriteCSV:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
@@ -0,0 +1,260 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"rsc.io/arm/armasm"
+)
+
+// Parameters of the mode detection heuristic used by ScoreMode.
+const (
+	idiomDensity  = 0.05 // fraction of instructions that are idioms in typical code
+	codeThreshold = 0.7  // score needed to classify a window as code
+)
+
+// A ModeScore describes how strongly a window of bytes resembles
+// ARM code, Thumb code, and data. Each score is between 0 and 1.
+type ModeScore struct {
+	ARM   float64 // plausibility as ARM code
+	Thumb float64 // plausibility as Thumb code
+	Data  float64 // fraction of words that look like data: zero or all-ones fill, or text
+}
+
+// Mode returns the most likely interpretation of the window:
+// armasm.ModeARM, armasm.ModeThumb, or 0 for data.
+// The window is data if at least half its words look like data
+// or if neither code score reaches 0.7; otherwise it is the mode
+// with the higher score, preferring ARM in a tie.
+func (s ModeScore) Mode() armasm.Mode {
+	switch {
+	case s.Data >= 0.5:
+		return 0
+	case s.ARM >= codeThreshold && s.ARM >= s.Thumb:
+		return armasm.ModeARM
+	case s.Thumb >= codeThreshold:
+		return armasm.ModeThumb
+	}
+	return 0
+}
+
+func (s ModeScore) String() string {
+	name := "data"
+	if m := s.Mode(); m != 0 {
+		name = m.String()
+	}
+	return fmt.Sprintf("%s (ARM %.2f, Thumb %.2f, data %.2f)", name, s.ARM, s.Thumb, s.Data)
+}
+
+// ScoreMode scores code, loaded at address pc, for being ARM code,
+// Thumb code, or data, for use on raw images without mapping symbols
+// or other indications of the instruction set. ARM instructions are
+// decoded using d; Thumb instructions, which armasm cannot yet decode,
+// are judged by their encoding class and length.
+//
+// Each code score averages two measures. The first is the fraction
+// of instructions that are valid, discounting conditional ones, which
+// are a minority in compiled code but the majority of random words
+// in ARM mode. Zero and all-ones fill and undecodable or undefined
+// encodings count as invalid, as do direct branches to an invalid
+// instruction elsewhere in the window. The second is the density of
+// idioms common in compiled code but rare in random bytes: BL within
+// 1 MB, PUSH with LR and POP with PC of a run of registers, and BX LR,
+// relative to a density of 5%.
+// Random bytes usually decode, especially as Thumb, but rarely
+// contain idioms, so windows of a few hundred bytes or more
+// give the clearest results.
+func ScoreMode(d *armasm.Decoder, code []byte, pc uint64) ModeScore {
+	return ModeScore{
+		ARM:   scoreARM(d, code, pc),
+		Thumb: scoreThumb(code, pc),
+		Data:  dataFraction(code),
+	}
+}
+
+// codeScore combines the validity and idiom counts of n instructions into a score.
+func codeScore(valid, idioms float64, n int) float64 {
+	if n == 0 {
+		return 0
+	}
+	density := idioms / float64(n) / idiomDensity
+	if density > 1 {
+		density = 1
+	}
+	return (valid/float64(n) + density) / 2
+}
+
+// scoreARM returns the ARM score of code, loaded at pc.
+func scoreARM(d *armasm.Decoder, code []byte, pc uint64) float64 {
+	n := len(code) / 4
+	ok := make([]bool, n)
+	insts := make([]armasm.Inst, n)
+	for i := range ok {
+		w := binary.LittleEndian.Uint32(code[4*i:])
+		if w == 0 || w == 0xffffffff {
+			continue
+		}
+		inst, err := d.Decode(code[4*i:], armasm.ModeARM)
+		ok[i], insts[i] = err == nil, inst
+	}
+	var valid, idioms float64
+	for i, inst := range insts {
+		if !ok[i] {
+			continue
+		}
+		addr := pc + 4*uint64(i)
+		f := Classify(inst, addr, armasm.ModeARM)
+		if (f.Kind == FlowJump || f.Kind == FlowCall) && f.Target >= pc && f.Target-pc < 4*uint64(n) && f.Target&3 == 0 && !ok[(f.Target-pc)/4] {
+			continue
+		}
+		switch inst.Enc >> 28 {
+		case 0xe:
+			valid += 1
+		case 0xf:
+			valid += 0.5
+		default:
+			valid += 0.25
+		}
+		w := inst.Enc
+		switch {
+		case w>>24 == 0xeb && near(int32(w<<8)>>6), // BL
+			w&0xffff4000 == 0xe92d4000, // PUSH {..., LR}
+			w&0xffff8000 == 0xe8bd8000, // POP {..., PC}
+			w == 0xe12fff1e:            // BX LR
+			idioms++
+		}
+	}
+	return codeScore(valid, idioms, n)
+}
+
+// scoreThumb returns the Thumb score of code, loaded at pc.
+func scoreThumb(code []byte, pc uint64) float64 {
+	var starts []uint64
+	var valid []float64
+	var idioms float64
+	index := make(map[uint64]int) // instruction address -> index in starts
+	for off := uint64(0); off+2 <= uint64(len(code)); {
+		src := code[off:]
+		size := thumbLen(src)
+		if off+size > uint64(len(code)) {
+			break
+		}
+		v, idiom := thumbValid(src, size)
+		if idiom {
+			idioms++
+		}
+		index[pc+off] = len(starts)
+		starts = append(starts, pc+off)
+		valid = append(valid, v)
+		off += size
+	}
+
+	// Discount branches to invalid instructions or into the middle of one.
+	var total float64
+	for i, addr := range starts {
+		if valid[i] == 0 {
+			continue
+		}
+		if target, ok := thumbTarget(code[addr-pc:], addr); ok && target >= pc && target < pc+uint64(len(code)) {
+			if j, ok := index[target]; !ok || valid[j] == 0 {
+				continue
+			}
+		}
+		total += valid[i]
+	}
+	return codeScore(total, idioms, len(starts))
+}
+
+// thumbValid returns the validity of the size-byte Thumb instruction
+// at the start of src and reports whether it is an idiom.
+func thumbValid(src []byte, size uint64) (valid float64, idiom bool) {
+	hw := binary.LittleEndian.Uint16(src)
+	if size == 4 {
+		if inst, half, err := armasm.DecodeThumbBL(src); err == nil && !half {
+			return 1, near(int32(inst.Args[0].(armasm.PCRel)))
+		}
+		hw2 := binary.LittleEndian.Uint16(src[2:])
+		if hw == 0xffff && hw2 == 0xffff {
+			return 0, false
+		}
+		if hw>>11 == 0x1e && hw2&0x8000 != 0 && hw2&0x5000 == 0 && hw&0x0380 != 0x0380 {
+			return 0.25, false // conditional B.W
+		}
+		return 1, false
+	}
+	switch {
+	case hw == 0:
+		return 0, false
+	case hw>>8 == 0xde: // UDF
+		return 0, false
+	case hw>>12 == 0xd: // B<c>, SVC
+		return 0.25, false
+	case hw>>8 == 0xb5 && contiguous(uint8(hw)), // PUSH {..., LR}
+		hw>>8 == 0xbd && contiguous(uint8(hw)), // POP {..., PC}
+		hw == 0x4770:                           // BX LR
+		return 1, true
+	}
+	return 1, false
+}
+
+// near reports whether the branch offset off is within 1 MB.
+// Calls in compiled code are usually near, while the offsets in
+// random bytes are spread over the full range.
+func near(off int32) bool {
+	return -1<<20 <= off && off < 1<<20
+}
+
+// contiguous reports whether the low registers in list form a single run,
+// as in the registers saved by compiled code.
+func contiguous(list uint8) bool {
+	low := list & -list
+	return (list+low)&list == 0
+}
+
+// thumbTarget returns the target of the Thumb B, B<c>, or BL at the start
+// of src, at address addr, and reports whether it is such a branch.
+func thumbTarget(src []byte, addr uint64) (uint64, bool) {
+	hw := binary.LittleEndian.Uint16(src)
+	switch {
+	case hw>>11 == 0x1c: // B
+		return addr + 4 + uint64(int64(int16(hw<<5)>>4)), true
+	case hw>>12 == 0xd && hw>>9&7 != 7: // B<c>
+		return addr + 4 + uint64(int64(int8(hw))<<1), true
+	}
+	if inst, half, err := armasm.DecodeThumbBL(src); err == nil && !half && inst.Op == armasm.BL {
+		return addr + 4 + uint64(int64(inst.Args[0].(armasm.PCRel))), true
+	}
+	return 0, false
+}
+
+// dataFraction returns the fraction of the words in code that look like data:
+// zero or all ones, or four bytes of ASCII text.
+func dataFraction(code []byte) float64 {
+	n := len(code) / 4
+	if n == 0 {
+		return 0
+	}
+	data := 0
+	for i := 0; i < n; i++ {
+		w := code[4*i : 4*i+4]
+		switch binary.LittleEndian.Uint32(w) {
+		case 0, 0xffffffff:
+			data++
+			continue
+		}
+		text := true
+		for _, c := range w {
+			if !(0x20 <= c && c < 0x7f || c == '\t' || c == '\n' || c == '\r' || c == 0) {
+				text = false
+			}
+		}
+		if text {
+			data++
+		}
+	}
+	return float64(data) / float64(n)
+}
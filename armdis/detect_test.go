@@ -0,0 +1,113 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/internal/armtest"
+)
+
+// armFuncs is a few small ARM functions.
+var armFuncs = armtest.Words(
+	0xe92d4010, // push {r4, lr}
+	0xe1a04000, // mov r4, r0
+	0xe59f0010, // ldr r0, [pc, #16]
+	0xeb000006, // bl 0x30
+	0xe0800004, // add r0, r0, r4
+	0xe3500000, // cmp r0, #0
+	0x03a00001, // moveq r0, #1
+	0xe8bd8010, // pop {r4, pc}
+	0xe5901004, // ldr r1, [r0, #4]
+	0xe0811002, // add r1, r1, r2
+	0xe5801004, // str r1, [r0, #4]
+	0xe12fff1e, // bx lr
+	0xe2400001, // sub r0, r0, #1
+	0xe12fff1e, // bx lr
+)
+
+// thumbFuncs is a few small Thumb functions.
+var thumbFuncs = halfwords(
+	0xb570,         // push {r4, r5, r6, lr}
+	0x4604,         // mov r4, r0
+	0x2500,         // movs r5, #0
+	0x4b04,         // ldr r3, [pc, #16]
+	0x428d,         // cmp r5, r1
+	0xda04,         // bge
+	0x5d66,         // ldrb r6, [r4, r5]
+	0x3501,         // adds r5, #1
+	0xf000, 0xf80a, // bl
+	0xe7f8,         // b
+	0xbd70,         // pop {r4, r5, r6, pc}
+	0x6840,         // ldr r0, [r0, #4]
+	0x6001,         // str r1, [r0]
+	0x4770,         // bx lr
+	0xb510,         // push {r4, lr}
+	0xb082,         // sub sp, #8
+	0xf8d3, 0x2004, // ldr.w r2, [r3, #4]
+	0xb108,         // cbz r0
+	0xb2c0,         // uxtb r0, r0
+	0xbf08,         // it eq
+	0x2001,         // moveq r0, #1
+	0xb002,         // add sp, #8
+	0xbd10,         // pop {r4, pc}
+	0xb538,         // push {r3, r4, r5, lr}
+	0x4605,         // mov r5, r0
+	0x460c,         // mov r4, r1
+	0xf7ff, 0xffe0, // bl
+	0x2800,         // cmp r0, #0
+	0xd003,         // beq
+	0x4620,         // mov r0, r4
+	0xf7ff, 0xffd0, // bl
+	0xbd38, // pop {r3, r4, r5, pc}
+	0x2000, // movs r0, #0
+	0xbd38, // pop {r3, r4, r5, pc}
+)
+
+// halfwords returns the little-endian encoding of the halfwords hs.
+func halfwords(hs ...uint16) []byte {
+	var b []byte
+	for _, h := range hs {
+		b = append(b, byte(h), byte(h>>8))
+	}
+	return b
+}
+
+func TestScoreMode(t *testing.T) {
+	random := make([]byte, 4096)
+	rand.New(rand.NewSource(1)).Read(random)
+	tests := []struct {
+		name string
+		code []byte
+		mode armasm.Mode
+	}{
+		{"arm", armFuncs, armasm.ModeARM},
+		{"thumb", thumbFuncs, armasm.ModeThumb},
+		{"zero", make([]byte, 256), 0},
+		{"fill", bytes.Repeat([]byte{0xff}, 256), 0},
+		{"text", bytes.Repeat([]byte("Hello, world.\n"), 20), 0},
+		{"random", random, 0},
+	}
+	for _, tt := range tests {
+		s := ScoreMode(nil, tt.code, 0x1000)
+		if m := s.Mode(); m != tt.mode {
+			t.Errorf("%s: ScoreMode = %v, want mode %v", tt.name, s, tt.mode)
+		}
+	}
+
+	s := ModeScore{ARM: 0.25, Thumb: 0.9, Data: 0.1}
+	if str := s.String(); str != "Thumb (ARM 0.25, Thumb 0.90, data 0.10)" {
+		t.Errorf("String() = %q", str)
+	}
+	if m := (ModeScore{ARM: 0.9, Thumb: 0.9}).Mode(); m != armasm.ModeARM {
+		t.Errorf("tie: Mode() = %v, want ARM", m)
+	}
+	if m := (ModeScore{ARM: 0.9, Data: 0.5}).Mode(); m != 0 {
+		t.Errorf("mostly data: Mode() = %v, want 0", m)
+	}
+}
@@ -0,0 +1,178 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package armdis implements a disassembly driver for regions of ARM code.
+//
+// The driver decodes a region either by linear sweep, decoding every
+// instruction from the start of the region to its end, or by recursive
+// traversal, following control flow from a set of entry points.
+// Either way the result is a Map that classifies every byte of the region
+// as code, data, or unknown, along with the reason for the classification.
+package armdis
+
+import (
+	"sort"
+
+	"rsc.io/arm/armasm"
+)
+
+// align returns the instruction alignment for mode.
+func align(mode armasm.Mode) uint64 {
+	if mode == armasm.ModeThumb {
+		return 2
+	}
+	return 4
+}
+
+// Linear disassembles code, loaded at address pc, by linear sweep.
+// Bytes that do not decode are recorded as Data with ReasonUndecodable,
+// and the sweep resumes at the next aligned address.
+func Linear(code []byte, pc uint64, mode armasm.Mode) *Map {
+	return LinearDecoder(nil, code, pc, mode)
+}
+
+// LinearDecoder is like Linear but decodes instructions using d,
+// so that instructions d rejects are recorded as undecodable.
+func LinearDecoder(d *armasm.Decoder, code []byte, pc uint64, mode armasm.Mode) *Map {
+	return linear(d, code, pc, mode, false)
+}
+
+// linear implements LinearDecoder and, if resync is set, LinearResync.
+func linear(d *armasm.Decoder, code []byte, pc uint64, mode armasm.Mode, resync bool) *Map {
+	m := &Map{PC: pc, Mode: mode}
+	sweep(m, d, code, pc, pc, pc+uint64(len(code)), mode, resync)
+	return m
+}
+
+// sweep decodes code, loaded at address pc, linearly from addr, adding
+// ranges to m until reaching an instruction that starts at or after limit.
+// Instructions starting before limit may extend past it.
+// It returns the address at which the sweep stopped.
+func sweep(m *Map, d *armasm.Decoder, code []byte, pc, addr, limit uint64, mode armasm.Mode, resync bool) uint64 {
+	step := align(mode)
+	end := pc + uint64(len(code))
+	for addr < limit {
+		src := code[addr-pc:]
+		if uint64(len(src)) < step {
+			m.add(Range{Start: addr, End: end, Kind: Data, Reason: ReasonTruncated})
+			return end
+		}
+		inst, err := d.Decode(src, mode)
+		if err != nil {
+			m.add(Range{Start: addr, End: addr + step, Kind: Data, Reason: ReasonUndecodable})
+			addr += step
+			if resync {
+				next := resyncPoint(d, code, pc, addr, mode)
+				if next > addr {
+					m.add(Range{Start: addr, End: next, Kind: Data, Reason: ReasonSkipped})
+					addr = next
+				}
+			}
+			continue
+		}
+		next := addr + uint64(inst.Len)
+		m.add(Range{Start: addr, End: next, Kind: Code, Reason: ReasonSweep, Inst: inst})
+		addr = next
+	}
+	return addr
+}
+
+// Recursive disassembles code, loaded at address pc, by following
+// control flow from the given entry points. Branch and call targets
+// outside the region, targets in the other instruction set, and computed
+// targets are not followed. Bytes never reached are recorded as Unknown
+// with ReasonUnreached. Reached bytes that do not decode are recorded as
+// Data with ReasonUndecodable. If control flow reaches an address inside
+// an instruction already decoded, the two decodings conflict: the map
+// keeps the one starting first and records the conflict in Overlaps.
+func Recursive(code []byte, pc uint64, mode armasm.Mode, entries ...uint64) *Map {
+	return RecursiveDecoder(nil, code, pc, mode, entries...)
+}
+
+// RecursiveDecoder is like Recursive but decodes instructions using d,
+// so that instructions d rejects are recorded as undecodable.
+func RecursiveDecoder(d *armasm.Decoder, code []byte, pc uint64, mode armasm.Mode, entries ...uint64) *Map {
+	type work struct {
+		addr   uint64
+		reason Reason
+		from   uint64
+	}
+	step := align(mode)
+	end := pc + uint64(len(code))
+	seen := make(map[uint64]Range)
+	var queue []work
+	for _, e := range entries {
+		queue = append(queue, work{e, ReasonEntry, 0})
+	}
+	for len(queue) > 0 {
+		w := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+		if w.addr < pc || w.addr >= end || w.addr%step != 0 {
+			continue
+		}
+		if _, ok := seen[w.addr]; ok {
+			continue
+		}
+		src := code[w.addr-pc:]
+		if uint64(len(src)) < step {
+			seen[w.addr] = Range{Start: w.addr, End: end, Kind: Data, Reason: ReasonTruncated}
+			continue
+		}
+		inst, err := d.Decode(src, mode)
+		if err != nil {
+			seen[w.addr] = Range{Start: w.addr, End: w.addr + step, Kind: Data, Reason: ReasonUndecodable}
+			continue
+		}
+		next := w.addr + uint64(inst.Len)
+		seen[w.addr] = Range{Start: w.addr, End: next, Kind: Code, Reason: w.reason, From: w.from, Inst: inst}
+
+		f := Classify(inst, w.addr, mode)
+		if f.Fallthrough() {
+			queue = append(queue, work{next, ReasonFallthrough, w.addr})
+		}
+		if !f.Exchange {
+			switch f.Kind {
+			case FlowJump:
+				queue = append(queue, work{f.Target, ReasonJump, w.addr})
+			case FlowCall:
+				queue = append(queue, work{f.Target, ReasonCall, w.addr})
+			}
+		}
+	}
+
+	return collect(pc, end, mode, seen)
+}
+
+// collect returns the Map for the region [pc, end) holding the ranges in seen,
+// which are indexed by start address, with the gaps between them marked
+// as unreached. A range starting inside an earlier one, which is only possible
+// with mixed-length encodings, is left out of the map and recorded in Overlaps.
+func collect(pc, end uint64, mode armasm.Mode, seen map[uint64]Range) *Map {
+	var starts []uint64
+	for addr := range seen {
+		starts = append(starts, addr)
+	}
+	sort.Slice(starts, func(i, j int) bool { return starts[i] < starts[j] })
+
+	m := &Map{PC: pc, Mode: mode}
+	addr := pc
+	var last Range
+	for _, start := range starts {
+		r := seen[start]
+		if start < addr {
+			m.Overlaps = append(m.Overlaps, Overlap{Kept: last, Dropped: r})
+			continue
+		}
+		if start > addr {
+			m.add(Range{Start: addr, End: start, Kind: Unknown, Reason: ReasonUnreached})
+		}
+		m.add(r)
+		addr = r.End
+		last = r
+	}
+	if addr < end {
+		m.add(Range{Start: addr, End: end, Kind: Unknown, Reason: ReasonUnreached})
+	}
+	return m
+}
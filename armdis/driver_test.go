@@ -0,0 +1,192 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"strings"
+	"testing"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/internal/armtest"
+)
+
+var testCode = armtest.Words(
+	0xe3a00001, // 0x1000: mov r0, #1
+	0xea000001, // 0x1004: b 0x1010
+	0xffffffff, // 0x1008: (data)
+	0xe3a00002, // 0x100c: mov r0, #2
+	0x0a000000, // 0x1010: beq 0x1018
+	0xe12fff1e, // 0x1014: bx lr
+	0xe8bd8010, // 0x1018: pop {r4, pc}
+)
+
+func dump(m *Map) string {
+	var lines []string
+	for _, r := range m.Ranges {
+		lines = append(lines, r.String())
+	}
+	return strings.Join(lines, "\n")
+}
+
+func TestLinear(t *testing.T) {
+	m := Linear(append(testCode, 1, 2), 0x1000, armasm.ModeARM)
+	want := `0x1000-0x1004 code (sweep) MOV R0, #0x1
+0x1004-0x1008 code (sweep) B PC+0x4
+0x1008-0x100c data (undecodable)
+0x100c-0x1010 code (sweep) MOV R0, #0x2
+0x1010-0x1014 code (sweep) B.EQ PC+0x0
+0x1014-0x1018 code (sweep) BX LR
+0x1018-0x101c code (sweep) POP {R4,PC}
+0x101c-0x101e data (truncated)`
+	if out := dump(m); out != want {
+		t.Errorf("Linear:\n%s\nwant:\n%s", out, want)
+	}
+}
+
+func TestRecursive(t *testing.T) {
+	m := Recursive(testCode, 0x1000, armasm.ModeARM, 0x1000)
+	want := `0x1000-0x1004 code (entry) MOV R0, #0x1
+0x1004-0x1008 code (fallthrough from 0x1000) B PC+0x4
+0x1008-0x1010 unknown (unreached)
+0x1010-0x1014 code (jump from 0x1004) B.EQ PC+0x0
+0x1014-0x1018 code (fallthrough from 0x1010) BX LR
+0x1018-0x101c code (jump from 0x1010) POP {R4,PC}`
+	if out := dump(m); out != want {
+		t.Errorf("Recursive:\n%s\nwant:\n%s", out, want)
+	}
+
+	r, ok := m.Lookup(0x100e)
+	if !ok || r.Kind != Unknown || r.Start != 0x1008 {
+		t.Errorf("Lookup(0x100e) = %v, %v", r, ok)
+	}
+	if _, ok := m.Lookup(0x2000); ok {
+		t.Errorf("Lookup(0x2000) succeeded")
+	}
+}
+
+func TestClassify(t *testing.T) {
+	tests := []struct {
+		enc  uint32
+		kind FlowKind
+		cond bool
+	}{
+		{0xe3a00001, FlowNone, false},
+		{0xea000001, FlowJump, false},
+		{0x0a000000, FlowJump, true},
+		{0xeb000000, FlowCall, false},
+		{0xe12fff1e, FlowReturn, false},
+		{0xe12fff13, FlowIndirectJump, false},
+		{0xe12fff33, FlowIndirectCall, false},
+		{0xe8bd8010, FlowReturn, false},
+		{0xe49df004, FlowReturn, false},
+		{0xe1a0f00e, FlowReturn, false},
+		{0xe08ff100, FlowIndirectJump, false},
+		{0xe91ba800, FlowReturn, false},
+		{0xe8908000, FlowIndirectJump, false},
+	}
+	for _, tt := range tests {
+		inst, err := armasm.Decode(armtest.Words(tt.enc), armasm.ModeARM)
+		if err != nil {
+			t.Errorf("Decode(%#08x): %v", tt.enc, err)
+			continue
+		}
+		f := Classify(inst, 0, armasm.ModeARM)
+		if f.Kind != tt.kind || f.Cond != tt.cond {
+			t.Errorf("Classify(%v) = %v, cond=%v, want %v, cond=%v", inst, f.Kind, f.Cond, tt.kind, tt.cond)
+		}
+	}
+}
+
+func TestClassifyThumbTarget(t *testing.T) {
+	// Thumb BL uses PC+4; Thumb BLX, switching to ARM, uses Align(PC, 4).
+	bl := armasm.Inst{Op: armasm.BL, Len: 4, Args: armasm.Args{armasm.PCRel(0x10)}}
+	if f := Classify(bl, 0x1002, armasm.ModeThumb); f.Target != 0x1016 {
+		t.Errorf("Classify(%v at 0x1002).Target = %#x, want 0x1016", bl, f.Target)
+	}
+	blx := armasm.Inst{Op: armasm.BLX, Len: 4, Args: armasm.Args{armasm.PCRel(0x10)}}
+	if f := Classify(blx, 0x1002, armasm.ModeThumb); f.Target != 0x1014 || !f.Exchange {
+		t.Errorf("Classify(%v at 0x1002) = target %#x, exchange %v, want 0x1014, true", blx, f.Target, f.Exchange)
+	}
+}
+
+func TestIsFunctionReturn(t *testing.T) {
+	for _, tt := range []struct {
+		enc  uint32
+		want bool
+	}{
+		{0xe12fff1e, true},  // bx lr
+		{0x012fff1e, true},  // bxeq lr
+		{0xe1a0f00e, true},  // mov pc, lr
+		{0xe1b0f00e, true},  // movs pc, lr
+		{0xe25ef004, true},  // subs pc, lr, #4
+		{0xe160006e, true},  // eret
+		{0x1160006e, true},  // eretne
+		{0xe8bd8010, true},  // pop {r4, pc}
+		{0xe49df004, true},  // pop {pc} (ldr pc, [sp], #4)
+		{0xe91ba800, true},  // ldmdb fp, {fp, sp, pc}
+		{0xe8908000, false}, // ldm r0, {pc}
+		{0xe12fff13, false}, // bx r3
+		{0xe1a0f003, false}, // mov pc, r3
+		{0xe8bd0010, false}, // pop {r4}
+	} {
+		inst, err := armasm.Decode(armtest.Words(tt.enc), armasm.ModeARM)
+		if err != nil {
+			t.Errorf("Decode(%#08x): %v", tt.enc, err)
+			continue
+		}
+		if got := IsFunctionReturn(inst); got != tt.want {
+			t.Errorf("IsFunctionReturn(%v) = %v, want %v", inst, got, tt.want)
+		}
+	}
+}
+
+func TestImmValue(t *testing.T) {
+	for _, tt := range []struct {
+		arg armasm.Arg
+		v   int64
+		ok  bool
+	}{
+		{armasm.Imm(5), 5, true},
+		{armasm.Imm(0xffffffff), -1, true},
+		{armasm.ImmAlt{Val: 1, Rot: 2}, 0x40000000, true},
+		{armasm.R0, 0, false},
+	} {
+		if v, ok := ImmValue(tt.arg); v != tt.v || ok != tt.ok {
+			t.Errorf("ImmValue(%v) = %d, %v, want %d, %v", tt.arg, v, ok, tt.v, tt.ok)
+		}
+	}
+}
+
+func TestCollectOverlap(t *testing.T) {
+	// Recursive cannot produce overlapping instructions in ARM mode,
+	// so build the ranges by hand, as if from a Thumb traversal in which
+	// a branch targets the second halfword of a 32-bit instruction.
+	bl := armasm.Inst{Op: armasm.BL, Len: 4, Args: armasm.Args{armasm.PCRel(0x100)}}
+	b := armasm.Inst{Op: armasm.B, Len: 2, Args: armasm.Args{armasm.PCRel(-4)}}
+	seen := map[uint64]Range{
+		0x1000: {Start: 0x1000, End: 0x1004, Kind: Code, Reason: ReasonEntry, Inst: bl},
+		0x1002: {Start: 0x1002, End: 0x1004, Kind: Code, Reason: ReasonJump, From: 0x1008, Inst: b},
+		0x1008: {Start: 0x1008, End: 0x100a, Kind: Code, Reason: ReasonFallthrough, From: 0x1006, Inst: b},
+	}
+	m := collect(0x1000, 0x100c, armasm.ModeThumb, seen)
+	want := `0x1000-0x1004 code (entry) BL PC+0x100
+0x1004-0x1008 unknown (unreached)
+0x1008-0x100a code (fallthrough from 0x1006) B PC-0x4
+0x100a-0x100c unknown (unreached)`
+	if out := dump(m); out != want {
+		t.Errorf("collect:\n%s\nwant:\n%s", out, want)
+	}
+	if len(m.Overlaps) != 1 || m.Overlaps[0].Kept != seen[0x1000] || m.Overlaps[0].Dropped != seen[0x1002] {
+		t.Fatalf("Overlaps = %v, want 0x1002 inside 0x1000", m.Overlaps)
+	}
+	wantStr := "0x1002-0x1004 code (jump from 0x1008) B PC-0x4 overlaps 0x1000-0x1004 code (entry) BL PC+0x100"
+	if s := m.Overlaps[0].String(); s != wantStr {
+		t.Errorf("Overlap.String() = %q, want %q", s, wantStr)
+	}
+
+	if m := Recursive(testCode, 0x1000, armasm.ModeARM, 0x1000); len(m.Overlaps) != 0 {
+		t.Errorf("Recursive found overlaps in ARM code: %v", m.Overlaps)
+	}
+}
@@ -0,0 +1,231 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"fmt"
+
+	"rsc.io/arm/armasm"
+)
+
+// A FlowKind describes how an instruction affects control flow.
+type FlowKind uint8
+
+const (
+	FlowNone         FlowKind = iota // ordinary instruction; continues at the next instruction
+	FlowJump                         // branch to a known target
+	FlowCall                         // call to a known target; returns to the next instruction
+	FlowIndirectJump                 // branch to a computed target
+	FlowIndirectCall                 // call to a computed target; returns to the next instruction
+	FlowReturn                       // function return
+	FlowTrap                         // breakpoint or undefined instruction
+)
+
+var flowKindName = [...]string{
+	FlowNone:         "None",
+	FlowJump:         "Jump",
+	FlowCall:         "Call",
+	FlowIndirectJump: "IndirectJump",
+	FlowIndirectCall: "IndirectCall",
+	FlowReturn:       "Return",
+	FlowTrap:         "Trap",
+}
+
+func (k FlowKind) String() string {
+	if int(k) < len(flowKindName) {
+		return flowKindName[k]
+	}
+	return fmt.Sprintf("FlowKind(%d)", int(k))
+}
+
+// A Flow describes the control flow effect of a single instruction.
+type Flow struct {
+	Kind      FlowKind
+	Cond      bool   // instruction is conditional, so it may also continue at the next instruction
+	Target    uint64 // branch target, for FlowJump and FlowCall
+	Exchange  bool   // branch target is in the other instruction set (BLX <label>)
+	ExcReturn bool   // return is an M-profile exception return; see armcfg's MarkExcReturns
+}
+
+// Fallthrough reports whether execution may continue
+// at the instruction following the one described by f.
+func (f Flow) Fallthrough() bool {
+	switch f.Kind {
+	case FlowNone, FlowCall, FlowIndirectCall:
+		return true
+	}
+	return f.Cond
+}
+
+// writesArg0 lists the opcodes (as op&^15) whose first argument
+// is a destination register.
+var writesArg0 = map[armasm.Op]bool{
+	armasm.ADC_EQ:   true,
+	armasm.ADC_S_EQ: true,
+	armasm.ADD_EQ:   true,
+	armasm.ADD_S_EQ: true,
+	armasm.AND_EQ:   true,
+	armasm.AND_S_EQ: true,
+	armasm.ASR_EQ:   true,
+	armasm.ASR_S_EQ: true,
+	armasm.BIC_EQ:   true,
+	armasm.BIC_S_EQ: true,
+	armasm.EOR_EQ:   true,
+	armasm.EOR_S_EQ: true,
+	armasm.LDR_EQ:   true,
+	armasm.LSL_EQ:   true,
+	armasm.LSL_S_EQ: true,
+	armasm.LSR_EQ:   true,
+	armasm.LSR_S_EQ: true,
+	armasm.MOV_EQ:   true,
+	armasm.MOV_S_EQ: true,
+	armasm.MVN_EQ:   true,
+	armasm.MVN_S_EQ: true,
+	armasm.ORR_EQ:   true,
+	armasm.ORR_S_EQ: true,
+	armasm.ROR_EQ:   true,
+	armasm.ROR_S_EQ: true,
+	armasm.RRX_EQ:   true,
+	armasm.RRX_S_EQ: true,
+	armasm.RSB_EQ:   true,
+	armasm.RSB_S_EQ: true,
+	armasm.RSC_EQ:   true,
+	armasm.RSC_S_EQ: true,
+	armasm.SBC_EQ:   true,
+	armasm.SBC_S_EQ: true,
+	armasm.SUB_EQ:   true,
+	armasm.SUB_S_EQ: true,
+}
+
+// Classify returns the control flow effect of inst,
+// which was decoded in the given mode at address pc.
+func Classify(inst armasm.Inst, pc uint64, mode armasm.Mode) Flow {
+	var f Flow
+	// In ARM mode the top four bits hold the condition;
+	// 0xE is always and 0xF is the unconditional instruction space.
+	if mode == armasm.ModeARM && inst.Enc>>28 < 0xE {
+		f.Cond = true
+	}
+
+	switch inst.Op &^ 15 {
+	case armasm.B_EQ, armasm.BL_EQ:
+		rel, ok := inst.Args[0].(armasm.PCRel)
+		if !ok {
+			break
+		}
+		f.Kind = FlowJump
+		if inst.Op&^15 == armasm.BL_EQ {
+			f.Kind = FlowCall
+		}
+		f.Target = rel.Target(pc, mode)
+		return f
+
+	case armasm.ERET_EQ:
+		f.Kind = FlowReturn
+		return f
+
+	case armasm.BX_EQ, armasm.BXJ_EQ:
+		if inst.Args[0] == armasm.LR {
+			f.Kind = FlowReturn
+		} else {
+			f.Kind = FlowIndirectJump
+		}
+		return f
+
+	case armasm.BKPT_EQ:
+		f.Kind = FlowTrap
+		return f
+
+	case armasm.POP_EQ, armasm.LDM_EQ, armasm.LDMDA_EQ, armasm.LDMDB_EQ, armasm.LDMIB_EQ:
+		var list armasm.RegList
+		var base armasm.Reg = armasm.SP
+		for _, arg := range inst.Args {
+			switch arg := arg.(type) {
+			case armasm.RegList:
+				list = arg
+			case armasm.Mem:
+				base = arg.Base
+			}
+		}
+		if list&(1<<armasm.PC) == 0 {
+			break
+		}
+		// Loading PC from the stack is a return, as is restoring
+		// SP along with PC, as in the APCS frame return LDMDB FP, {FP, SP, PC}.
+		if base == armasm.SP || list&(1<<armasm.SP) != 0 {
+			f.Kind = FlowReturn
+		} else {
+			f.Kind = FlowIndirectJump
+		}
+		return f
+	}
+
+	switch inst.Op {
+	case armasm.BLX:
+		if rel, ok := inst.Args[0].(armasm.PCRel); ok {
+			f.Kind = FlowCall
+			if mode == armasm.ModeThumb {
+				// Thumb BLX computes the ARM target from Align(PC, 4).
+				pc &^= 3
+			}
+			f.Target = rel.Target(pc, mode)
+			f.Exchange = true
+			return f
+		}
+	case armasm.UNDEF:
+		f.Kind = FlowTrap
+		return f
+	}
+	if inst.Op&^15 == armasm.BLX_EQ {
+		f.Kind = FlowIndirectCall
+		return f
+	}
+
+	if writesArg0[inst.Op&^15] && inst.Args[0] == armasm.PC {
+		switch {
+		case inst.Op&^15 == armasm.MOV_EQ && inst.Args[1] == armasm.LR,
+			inst.Op&^15 == armasm.SUB_S_EQ && inst.Args[1] == armasm.LR,
+			inst.Op&^15 == armasm.MOV_S_EQ && inst.Args[1] == armasm.LR:
+			f.Kind = FlowReturn
+		case inst.Op&^15 == armasm.LDR_EQ && isPopMem(inst.Args[1]):
+			f.Kind = FlowReturn
+		default:
+			f.Kind = FlowIndirectJump
+		}
+		return f
+	}
+
+	return f
+}
+
+// IsFunctionReturn reports whether inst returns from a function.
+// On ARM a return can take many forms: BX LR; MOV PC, LR;
+// the exception returns ERET, MOVS PC, LR, and SUBS PC, LR, #imm;
+// a POP or LDR of PC from the stack; or an LDM that loads PC
+// from the stack or restores SP along with PC.
+// The instruction may be conditional.
+// It is equivalent to checking for FlowReturn from Classify.
+func IsFunctionReturn(inst armasm.Inst) bool {
+	return Classify(inst, 0, armasm.ModeARM).Kind == FlowReturn
+}
+
+// ImmValue returns the value of the immediate argument arg,
+// sign-extended from 32 bits. It returns false if arg is not an
+// Imm or ImmAlt.
+func ImmValue(arg armasm.Arg) (int64, bool) {
+	switch arg := arg.(type) {
+	case armasm.Imm:
+		return int64(int32(arg)), true
+	case armasm.ImmAlt:
+		return int64(int32(arg.Imm())), true
+	}
+	return 0, false
+}
+
+// isPopMem reports whether arg is the [SP], #4 post-indexed form used by single-register POP.
+func isPopMem(arg armasm.Arg) bool {
+	mem, ok := arg.(armasm.Mem)
+	return ok && mem.Base == armasm.SP && mem.Mode == armasm.AddrPostIndex && mem.Sign == 0
+}
@@ -0,0 +1,143 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+
+	"rsc.io/arm/armasm"
+)
+
+// WriteHTML writes m to w as a self-contained HTML page.
+// Each instruction is shown with its encoding broken into the bit fields
+// of the ARM manual, each field in its own color; hovering over a field or
+// an operand shows its name and value. Branch targets inside the region
+// link to the target instruction, whose anchor is its hex address, like #1000.
+func WriteHTML(w io.Writer, m *Map) error {
+	var rows []htmlRow
+	for _, r := range m.Ranges {
+		row := htmlRow{
+			ID:   fmt.Sprintf("%x", r.Start),
+			Addr: fmt.Sprintf("%#x", r.Start),
+		}
+		if r.Kind != Code {
+			row.Note = fmt.Sprintf("%s, %d bytes (%s)", r.Kind, r.End-r.Start, r.Reason)
+			rows = append(rows, row)
+			continue
+		}
+		inst := r.Inst
+		row.Code = true
+		row.Enc = fmt.Sprintf("%08x", inst.Enc)
+		row.Bits = htmlBits(inst)
+		row.Op = inst.Op.String()
+		flow := Classify(inst, r.Start, m.Mode)
+		for _, arg := range inst.Args {
+			if arg == nil {
+				break
+			}
+			a := htmlArg{Text: arg.String(), Title: fmt.Sprintf("%T %v", arg, arg)}
+			if _, ok := arg.(armasm.PCRel); ok && (flow.Kind == FlowJump || flow.Kind == FlowCall) {
+				a.Title = fmt.Sprintf("target %#x", flow.Target)
+				if t, ok := m.Lookup(flow.Target); ok && t.Kind == Code && t.Start == flow.Target {
+					a.Href = fmt.Sprintf("#%x", flow.Target)
+				}
+			}
+			row.Args = append(row.Args, a)
+		}
+		rows = append(rows, row)
+	}
+	return htmlTemplate.Execute(w, rows)
+}
+
+type htmlRow struct {
+	ID   string // anchor name
+	Addr string
+	Code bool
+	Enc  string
+	Bits []htmlBit
+	Op   string
+	Args []htmlArg
+	Note string // description of a non-code range
+}
+
+// An htmlBit is a run of encoding bits shown as a unit:
+// either a named field or a run of fixed bits.
+type htmlBit struct {
+	Text  string // the bits, most significant first
+	Class string // CSS class
+	Title string // hover text
+}
+
+type htmlArg struct {
+	Text  string
+	Title string // hover text
+	Href  string // link to branch target, if any
+}
+
+// numFieldColors is the number of field colors in the style sheet.
+const numFieldColors = 8
+
+// htmlBits splits the encoding of inst into its named fields and fixed bits.
+func htmlBits(inst armasm.Inst) []htmlBit {
+	var bits []htmlBit
+	fixed := func(hi, lo uint) {
+		if hi > lo {
+			f := armasm.Field{Shift: lo, Width: hi - lo}
+			bits = append(bits, htmlBit{Text: bitString(f.Value(inst.Enc), f.Width), Class: "fixed"})
+		}
+	}
+	next := uint(32)
+	for i, f := range inst.Fields() {
+		fixed(next, f.Shift+f.Width)
+		v := f.Value(inst.Enc)
+		bits = append(bits, htmlBit{
+			Text:  bitString(v, f.Width),
+			Class: fmt.Sprintf("f%d", i%numFieldColors),
+			Title: fmt.Sprintf("%s = %#x (bits %d-%d)", f.Name, v, f.Shift+f.Width-1, f.Shift),
+		})
+		next = f.Shift
+	}
+	fixed(next, 0)
+	return bits
+}
+
+// bitString returns the low n bits of v in binary.
+func bitString(v uint32, n uint) string {
+	s := fmt.Sprintf("%032b", v)
+	return s[32-n:]
+}
+
+var htmlTemplate = template.Must(template.New("armdis").Parse(strings.TrimSpace(`
+<!DOCTYPE html>
+<html>
+<head>
+<meta charset="utf-8">
+<title>Disassembly</title>
+<style>
+body { font-family: monospace; }
+table { border-collapse: collapse; }
+td { padding: 0 0.75em; white-space: pre; }
+tr:target { background: #ffffcc; }
+.addr { color: #666; }
+.note { color: #999; font-style: italic; }
+.fixed { color: #999; }
+.f0 { color: #c00; } .f1 { color: #060; } .f2 { color: #00c; } .f3 { color: #a50; }
+.f4 { color: #909; } .f5 { color: #077; } .f6 { color: #660; } .f7 { color: #555; }
+.bits span[title], .args span[title] { cursor: help; }
+</style>
+</head>
+<body>
+<table>
+{{range .}}<tr id="{{.ID}}"><td class="addr">{{.Addr}}</td>
+{{- if .Code}}<td>{{.Enc}}</td><td class="bits">{{range .Bits}}<span class="{{.Class}}"{{if .Title}} title="{{.Title}}"{{end}}>{{.Text}}</span> {{end}}</td><td>{{.Op}}</td><td class="args">
+{{- range $i, $a := .Args}}{{if $i}}, {{end}}{{if $a.Href}}<a href="{{$a.Href}}" title="{{$a.Title}}">{{$a.Text}}</a>{{else}}<span title="{{$a.Title}}">{{$a.Text}}</span>{{end}}{{end}}</td>
+{{- else}}<td class="note" colspan="4">{{.Note}}</td>{{end}}</tr>
+{{end}}</table>
+</body>
+</html>
+`) + "\n"))
@@ -0,0 +1,34 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"rsc.io/arm/armasm"
+)
+
+func TestWriteHTML(t *testing.T) {
+	m := Recursive(testCode, 0x1000, armasm.ModeARM, 0x1000)
+	var buf bytes.Buffer
+	if err := WriteHTML(&buf, m); err != nil {
+		t.Fatal(err)
+	}
+	out := buf.String()
+	for _, want := range []string{
+		`<tr id="1000">`,
+		`<span class="f0" title="cond = 0xe (bits 31-28)">1110</span>`,
+		`<span class="f3" title="imm12 = 0x1 (bits 11-0)">000000000001</span>`,
+		`<a href="#1010" title="target 0x1010">PC&#43;0x4</a>`,
+		`<span title="armasm.Reg R0">R0</span>, <span title="armasm.Imm #0x1">#0x1</span>`,
+		`unknown, 8 bytes (unreached)`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %s:\n%s", want, out)
+		}
+	}
+}
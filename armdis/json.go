@@ -0,0 +1,72 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// A jsonRange is the JSON form of a Range written by WriteJSON.
+type jsonRange struct {
+	Addr   string   `json:"addr"`
+	End    string   `json:"end"`
+	Kind   string   `json:"kind"`
+	Reason string   `json:"reason"`
+	Bytes  string   `json:"bytes,omitempty"`
+	Op     string   `json:"op,omitempty"`
+	Args   []string `json:"args,omitempty"`
+	Flow   string   `json:"flow,omitempty"`
+	Cond   bool     `json:"cond,omitempty"`
+	Target string   `json:"target,omitempty"`
+}
+
+// WriteJSON writes m to w as a JSON array with one object per range,
+// each on its own line. The objects have the same fields as the columns
+// written by WriteCSV, with the instruction arguments gathered into
+// a single "args" array, and with empty fields omitted.
+// Addresses are hex strings, as in WriteCSV, so that 64-bit
+// addresses survive JSON decoders that use floating-point numbers.
+func WriteJSON(w io.Writer, m *Map) error {
+	bw := bufio.NewWriter(w)
+	bw.WriteString("[")
+	for i, r := range m.Ranges {
+		j := jsonRange{
+			Addr:   fmt.Sprintf("%#x", r.Start),
+			End:    fmt.Sprintf("%#x", r.End),
+			Kind:   r.Kind.String(),
+			Reason: r.Reason.String(),
+		}
+		if r.Kind == Code {
+			inst := r.Inst
+			j.Bytes = instBytes(inst)
+			j.Op = inst.Op.String()
+			for _, arg := range inst.Args {
+				if arg != nil {
+					j.Args = append(j.Args, arg.String())
+				}
+			}
+			f := Classify(inst, r.Start, m.Mode)
+			j.Flow = f.Kind.String()
+			j.Cond = f.Cond
+			if f.Kind == FlowJump || f.Kind == FlowCall {
+				j.Target = fmt.Sprintf("%#x", f.Target)
+			}
+		}
+		data, err := json.Marshal(&j)
+		if err != nil {
+			return err
+		}
+		if i > 0 {
+			bw.WriteString(",")
+		}
+		bw.WriteString("\n")
+		bw.Write(data)
+	}
+	bw.WriteString("\n]\n")
+	return bw.Flush()
+}
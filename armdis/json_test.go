@@ -0,0 +1,44 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"rsc.io/arm/armasm"
+)
+
+func TestWriteJSON(t *testing.T) {
+	m := Recursive(testCode, 0x1000, armasm.ModeARM, 0x1000)
+	var buf bytes.Buffer
+	if err := WriteJSON(&buf, m); err != nil {
+		t.Fatal(err)
+	}
+	want := strings.TrimLeft(`
+[
+{"addr":"0x1000","end":"0x1004","kind":"code","reason":"entry","bytes":"0100a0e3","op":"MOV","args":["R0","#0x1"],"flow":"None"},
+{"addr":"0x1004","end":"0x1008","kind":"code","reason":"fallthrough","bytes":"010000ea","op":"B","args":["PC+0x4"],"flow":"Jump","target":"0x1010"},
+{"addr":"0x1008","end":"0x1010","kind":"unknown","reason":"unreached"},
+{"addr":"0x1010","end":"0x1014","kind":"code","reason":"jump","bytes":"0000000a","op":"B.EQ","args":["PC+0x0"],"flow":"Jump","cond":true,"target":"0x1018"},
+{"addr":"0x1014","end":"0x1018","kind":"code","reason":"fallthrough","bytes":"1eff2fe1","op":"BX","args":["LR"],"flow":"Return"},
+{"addr":"0x1018","end":"0x101c","kind":"code","reason":"jump","bytes":"1080bde8","op":"POP","args":["{R4,PC}"],"flow":"Return"}
+]
+`, "\n")
+	if buf.String() != want {
+		t.Errorf("WriteJSON:\n%s\nwant:\n%s", buf.String(), want)
+	}
+	var v []map[string]interface{}
+	if err := json.Unmarshal(buf.Bytes(), &v); err != nil || len(v) != len(m.Ranges) {
+		t.Errorf("WriteJSON output does not decode as %d objects: %v", len(m.Ranges), err)
+	}
+
+	buf.Reset()
+	if err := WriteJSON(&buf, &Map{}); err != nil || buf.String() != "[\n]\n" {
+		t.Errorf("WriteJSON(empty) = %q, %v, want %q", buf.String(), err, "[\n]\n")
+	}
+}
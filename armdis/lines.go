@@ -0,0 +1,88 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"debug/dwarf"
+	"io"
+	"sort"
+)
+
+// A LineTable maps instruction addresses to source positions.
+type LineTable struct {
+	rows []lineRow // sorted by addr
+}
+
+// A lineRow is a row of a DWARF line number table: the instructions
+// from addr up to the next row's address come from file:line.
+// A row with end set marks the end of a sequence of instructions.
+type lineRow struct {
+	addr uint64
+	file string
+	line int
+	end  bool
+}
+
+// DWARFLines returns the line table recorded in the DWARF
+// debugging information d.
+func DWARFLines(d *dwarf.Data) (*LineTable, error) {
+	t := new(LineTable)
+	r := d.Reader()
+	for {
+		e, err := r.Next()
+		if err != nil {
+			return nil, err
+		}
+		if e == nil {
+			break
+		}
+		if e.Tag != dwarf.TagCompileUnit {
+			r.SkipChildren()
+			continue
+		}
+		lr, err := d.LineReader(e)
+		if err != nil {
+			return nil, err
+		}
+		r.SkipChildren()
+		if lr == nil {
+			continue
+		}
+		var le dwarf.LineEntry
+		for {
+			if err := lr.Next(&le); err != nil {
+				if err == io.EOF {
+					break
+				}
+				return nil, err
+			}
+			row := lineRow{addr: le.Address, line: le.Line, end: le.EndSequence}
+			if le.File != nil {
+				row.file = le.File.Name
+			}
+			t.rows = append(t.rows, row)
+		}
+	}
+	// Where one sequence ends at the address another begins,
+	// the end must sort first so that the new sequence applies.
+	sort.SliceStable(t.rows, func(i, j int) bool {
+		ri, rj := &t.rows[i], &t.rows[j]
+		if ri.addr != rj.addr {
+			return ri.addr < rj.addr
+		}
+		return ri.end && !rj.end
+	})
+	return t, nil
+}
+
+// Lookup returns the source file and line number of the instruction
+// at addr, or "", 0 if t does not cover addr.
+func (t *LineTable) Lookup(addr uint64) (file string, line int) {
+	i := sort.Search(len(t.rows), func(i int) bool { return t.rows[i].addr > addr }) - 1
+	if i < 0 || t.rows[i].end {
+		return "", 0
+	}
+	return t.rows[i].file, t.rows[i].line
+}
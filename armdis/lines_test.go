@@ -0,0 +1,51 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"debug/elf"
+	"testing"
+)
+
+var lookupTests = []struct {
+	addr uint64
+	file string
+	line int
+}{
+	{0, "lines.c", 7},
+	{2, "lines.c", 7},
+	{3, "lines.c", 8},
+	{4, "lines.c", 7},
+	{7, "lines.c", 14},
+	{8, "", 0},
+	{100, "", 0},
+}
+
+func TestDWARFLines(t *testing.T) {
+	f, err := elf.Open("testdata/lines.o")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	d, err := f.DWARF()
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines, err := DWARFLines(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tt := range lookupTests {
+		file, line := lines.Lookup(tt.addr)
+		if file != tt.file || line != tt.line {
+			t.Errorf("Lookup(%#x) = %q, %d, want %q, %d", tt.addr, file, line, tt.file, tt.line)
+		}
+	}
+
+	var empty LineTable
+	if file, line := empty.Lookup(0); file != "" || line != 0 {
+		t.Errorf("empty Lookup(0) = %q, %d, want \"\", 0", file, line)
+	}
+}
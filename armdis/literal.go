@@ -0,0 +1,121 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armmem"
+)
+
+// A Literal is a value read from a literal pool by a PC-relative load.
+type Literal struct {
+	Addr  uint64 // address of the literal
+	Size  int    // size of the literal in bytes: 1, 2, 4, or 8
+	Value uint64 // the literal, sign-extended for LDRSB and LDRSH
+}
+
+// LoadLiteral reports whether inst, at address pc, is a PC-relative load
+// (LDR, LDRB, LDRH, LDRSB, LDRSH, LDRD, or VLDR) from a literal pool,
+// and if so returns the literal, read from text, which is indexed by address.
+// It returns false if the literal cannot be read.
+func LoadLiteral(inst armasm.Inst, pc uint64, mode armasm.Mode, text armmem.Reader) (Literal, bool) {
+	if text == nil {
+		return Literal{}, false
+	}
+	addr, size, signed, ok := literalAddr(inst, pc, mode)
+	if !ok {
+		return Literal{}, false
+	}
+
+	buf := make([]byte, 8)
+	if _, err := text.ReadAt(buf[:size], int64(addr)); err != nil {
+		return Literal{}, false
+	}
+	lit := Literal{Addr: addr, Size: size, Value: binary.LittleEndian.Uint64(buf)}
+	switch {
+	case signed && size == 1:
+		lit.Value = uint64(int64(int8(lit.Value)))
+	case signed && size == 2:
+		lit.Value = uint64(int64(int16(lit.Value)))
+	}
+	return lit, true
+}
+
+// literalAddr reports whether inst, at address pc, is a PC-relative load
+// from a literal pool, and if so returns the address and size of the literal
+// and whether the load sign-extends it.
+func literalAddr(inst armasm.Inst, pc uint64, mode armasm.Mode) (addr uint64, size int, signed bool, ok bool) {
+	switch inst.Op &^ 15 {
+	case armasm.LDR_EQ:
+		size = 4
+	case armasm.LDRB_EQ:
+		size = 1
+	case armasm.LDRH_EQ:
+		size = 2
+	case armasm.LDRSB_EQ:
+		size, signed = 1, true
+	case armasm.LDRSH_EQ:
+		size, signed = 2, true
+	case armasm.LDRD_EQ:
+		size = 8
+	case armasm.VLDR_EQ:
+		size = 4
+		if r, ok := inst.Args[0].(armasm.Reg); ok && armasm.D0 <= r && r <= armasm.D31 {
+			size = 8
+		}
+	default:
+		return 0, 0, false, false
+	}
+
+	if mode == armasm.ModeThumb {
+		// Thumb literal loads use Align(PC, 4).
+		pc &^= 3
+	}
+	for _, arg := range inst.Args {
+		switch arg := arg.(type) {
+		case armasm.Mem:
+			if arg.Base == armasm.PC && arg.Mode == armasm.AddrOffset && arg.Sign == 0 {
+				addr, ok = armasm.PCRel(arg.Offset).Target(pc, mode), true
+			}
+		case armasm.PCRel:
+			addr, ok = arg.Target(pc, mode), true
+		}
+	}
+	if !ok {
+		return 0, 0, false, false
+	}
+	return addr, size, signed, true
+}
+
+// literalComment returns the comment describing lit used by WriteText,
+// like "=0x2004 <buf+0x4>". The symbol is looked up in symname
+// only for 4-byte literals, since only they may be addresses.
+func literalComment(lit Literal, symname func(uint64) (string, uint64)) string {
+	v := lit.Value
+	if lit.Size < 8 {
+		v = uint64(uint32(v))
+	}
+	s := fmt.Sprintf("=%#x", v)
+	if lit.Size == 4 {
+		s += symComment(v, symname)
+	}
+	return s
+}
+
+// symComment returns the text " <sym+off>" naming the symbol
+// containing addr, or "" if symname is nil or finds no symbol.
+func symComment(addr uint64, symname func(uint64) (string, uint64)) string {
+	if symname == nil {
+		return ""
+	}
+	name, base := symname(addr)
+	if name == "" {
+		return ""
+	}
+	return " <" + symOff(name, int64(addr-base)) + ">"
+}
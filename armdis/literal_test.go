@@ -0,0 +1,111 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armmem"
+	"rsc.io/arm/internal/armtest"
+)
+
+var literalCode = armtest.Words(
+	0xe59f0004, // 0x1000: ldr r0, [pc, #4]
+	0xe1df10b4, // 0x1004: ldrh r1, [pc, #4]
+	0xebfffffc, // 0x1008: bl 0x1000
+	0x00002004, // 0x100c: .word 0x2004
+	0x0000ff80, // 0x1010: .word 0xff80
+	0xe15f20dc, // 0x1014: ldrsb r2, [pc, #-12]
+)
+
+func TestLoadLiteral(t *testing.T) {
+	var text armmem.Image
+	text.Add(0x1000, literalCode, false)
+	tests := []struct {
+		addr uint64
+		lit  Literal
+		ok   bool
+	}{
+		{0x1000, Literal{0x100c, 4, 0x2004}, true},
+		{0x1004, Literal{0x1010, 2, 0xff80}, true},
+		{0x1008, Literal{}, false},
+		{0x1014, Literal{0x1010, 1, 0xffffffffffffff80}, true},
+	}
+	for _, tt := range tests {
+		inst, err := armasm.Decode(literalCode[tt.addr-0x1000:], armasm.ModeARM)
+		if err != nil {
+			t.Fatalf("%#x: %v", tt.addr, err)
+		}
+		lit, ok := LoadLiteral(inst, tt.addr, armasm.ModeARM, &text)
+		if lit != tt.lit || ok != tt.ok {
+			t.Errorf("LoadLiteral(%v) = %+v, %v, want %+v, %v", inst, lit, ok, tt.lit, tt.ok)
+		}
+	}
+}
+
+func TestLoadLiteralHigh(t *testing.T) {
+	// 32-bit code mapped above 4 GB loads from literals above 4 GB.
+	const base = 0x100001000
+	var text armmem.Image
+	text.Add(base, literalCode, false)
+	inst, err := armasm.Decode(literalCode, armasm.ModeARM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lit, ok := LoadLiteral(inst, base, armasm.ModeARM, &text)
+	if want := (Literal{base + 0xc, 4, 0x2004}); lit != want || !ok {
+		t.Errorf("LoadLiteral(%v at %#x) = %+v, %v, want %+v, true", inst, uint64(base), lit, ok, want)
+	}
+}
+
+func TestWriteTextLiteral(t *testing.T) {
+	var text armmem.Image
+	text.Add(0x1000, literalCode, false)
+	symname := func(addr uint64) (string, uint64) {
+		switch {
+		case 0x1000 <= addr && addr < 0x1014:
+			return "f", 0x1000
+		case 0x2000 <= addr && addr < 0x2010:
+			return "buf", 0x2000
+		}
+		return "", 0
+	}
+	m := Linear(literalCode, 0x1000, armasm.ModeARM)
+	var buf bytes.Buffer
+	if err := WriteText(&buf, m, &TextOptions{Text: &text, Symname: symname}); err != nil {
+		t.Fatal(err)
+	}
+	want := strings.TrimLeft(`
+0x1000	e59f0004	LDR R0, [PC, #4]	; =0x2004 <buf+0x4>
+0x1004	e1df10b4	LDRH R1, [PC, #4]	; =0xff80
+0x1008	ebfffffc	BL PC-0x10	; 0x1000 <f>
+0x100c	00002004	AND.EQ R2, R0, R4
+0x1010	0000ff80	AND.EQ PC, R0, R0 LSL #31
+0x1014	e15f20dc	LDRSB R2, [PC, #-12]	; =0xffffff80
+`, "\n")
+	if buf.String() != want {
+		t.Errorf("WriteText:\n%s\nwant:\n%s", buf.String(), want)
+	}
+
+	// Without the text, loads are annotated with the literal's address.
+	buf.Reset()
+	if err := WriteText(&buf, m, &TextOptions{Symname: symname}); err != nil {
+		t.Fatal(err)
+	}
+	want = strings.TrimLeft(`
+0x1000	e59f0004	LDR R0, [PC, #4]	; 0x100c <f+0xc>
+0x1004	e1df10b4	LDRH R1, [PC, #4]	; 0x1010 <f+0x10>
+0x1008	ebfffffc	BL PC-0x10	; 0x1000 <f>
+0x100c	00002004	AND.EQ R2, R0, R4
+0x1010	0000ff80	AND.EQ PC, R0, R0 LSL #31
+0x1014	e15f20dc	LDRSB R2, [PC, #-12]	; 0x1010 <f+0x10>
+`, "\n")
+	if buf.String() != want {
+		t.Errorf("WriteText without Text:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
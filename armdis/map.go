@@ -0,0 +1,159 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"fmt"
+	"sort"
+
+	"rsc.io/arm/armasm"
+)
+
+// A Kind classifies a range of bytes in a Map.
+type Kind uint8
+
+const (
+	Unknown Kind = iota // not classified
+	Code                // a decoded instruction
+	Data                // bytes that are not (or cannot be) instructions
+	Padding             // alignment padding between functions; see MarkPadding
+)
+
+var kindName = [...]string{
+	Unknown: "unknown",
+	Code:    "code",
+	Data:    "data",
+	Padding: "padding",
+}
+
+func (k Kind) String() string {
+	if int(k) < len(kindName) {
+		return kindName[k]
+	}
+	return fmt.Sprintf("Kind(%d)", int(k))
+}
+
+// A Reason records why a range of bytes was classified as it was.
+type Reason uint8
+
+const (
+	_                 Reason = iota
+	ReasonSweep              // decoded during a linear sweep
+	ReasonEntry              // reached from an entry point
+	ReasonFallthrough        // reached by falling through from the previous instruction
+	ReasonJump               // reached as the target of a branch
+	ReasonCall               // reached as the target of a call
+	ReasonUndecodable        // bytes do not decode as an instruction
+	ReasonTruncated          // too few bytes remain to hold an instruction
+	ReasonUnreached          // not reached by following control flow
+	ReasonSkipped            // skipped while resynchronizing after undecodable bytes
+	ReasonAlignment          // filler following the end of a function
+	ReasonClassified         // classified as data or another mode by Segment
+)
+
+var reasonName = [...]string{
+	ReasonSweep:       "sweep",
+	ReasonEntry:       "entry",
+	ReasonFallthrough: "fallthrough",
+	ReasonJump:        "jump",
+	ReasonCall:        "call",
+	ReasonUndecodable: "undecodable",
+	ReasonTruncated:   "truncated",
+	ReasonUnreached:   "unreached",
+	ReasonSkipped:     "skipped",
+	ReasonAlignment:   "alignment",
+	ReasonClassified:  "classified",
+}
+
+func (r Reason) String() string {
+	if int(r) < len(reasonName) && reasonName[r] != "" {
+		return reasonName[r]
+	}
+	return fmt.Sprintf("Reason(%d)", int(r))
+}
+
+// A Range is a classified range of bytes [Start, End).
+// A Code range holds exactly one instruction;
+// adjacent Data and Unknown ranges with the same Reason are merged.
+type Range struct {
+	Start  uint64
+	End    uint64
+	Kind   Kind
+	Reason Reason
+	From   uint64      // address of the instruction that led here, for ReasonFallthrough, ReasonJump, ReasonCall
+	Inst   armasm.Inst // the decoded instruction, for Code ranges
+}
+
+func (r Range) String() string {
+	s := fmt.Sprintf("%#x-%#x %s (%s", r.Start, r.End, r.Kind, r.Reason)
+	switch r.Reason {
+	case ReasonFallthrough, ReasonJump, ReasonCall:
+		s += fmt.Sprintf(" from %#x", r.From)
+	}
+	s += ")"
+	if r.Kind == Code {
+		s += " " + r.Inst.String()
+	}
+	return s
+}
+
+// A Map is the classification of every byte in a code region.
+// The Ranges are sorted by address, do not overlap,
+// and together cover the entire region.
+type Map struct {
+	PC     uint64 // address of the first byte of the region
+	Mode   armasm.Mode
+	Ranges []Range
+
+	// Overlaps lists the conflicting decodings found by a recursive
+	// traversal, in order of the dropped range's address.
+	Overlaps []Overlap
+}
+
+// An Overlap records two decodings of overlapping bytes,
+// as happens when control flow reaches the middle of an instruction.
+// Branching into the middle of an instruction, so that its bytes
+// are executed as a different instruction, is a common obfuscation.
+type Overlap struct {
+	Kept    Range // the range in the map, which starts first
+	Dropped Range // the range starting inside Kept, omitted from the map
+}
+
+func (o Overlap) String() string {
+	return fmt.Sprintf("%s overlaps %s", o.Dropped, o.Kept)
+}
+
+// Lookup returns the range containing addr.
+// It returns false if addr lies outside the region.
+func (m *Map) Lookup(addr uint64) (Range, bool) {
+	i := sort.Search(len(m.Ranges), func(i int) bool { return m.Ranges[i].End > addr })
+	if i < len(m.Ranges) && m.Ranges[i].Start <= addr {
+		return m.Ranges[i], true
+	}
+	return Range{}, false
+}
+
+// Insts returns the Code ranges of m in address order.
+func (m *Map) Insts() []Range {
+	var out []Range
+	for _, r := range m.Ranges {
+		if r.Kind == Code {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// add appends r to the map, merging it into the previous range if possible.
+func (m *Map) add(r Range) {
+	if n := len(m.Ranges); n > 0 && r.Kind != Code {
+		last := &m.Ranges[n-1]
+		if last.Kind == r.Kind && last.Reason == r.Reason && last.End == r.Start {
+			last.End = r.End
+			return
+		}
+	}
+	m.Ranges = append(m.Ranges, r)
+}
@@ -0,0 +1,126 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"encoding/binary"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armmem"
+)
+
+// padAlign is the alignment that a run of filler must reach
+// to be considered padding, unless it reaches the end of the region.
+// Assemblers pad to align the next function to 8 bytes or more;
+// requiring this keeps isolated zero or NOP words from being mistaken for padding.
+const padAlign = 8
+
+// fillARM and fillThumb are the units of filler emitted by assemblers and
+// linkers between functions: zeros, the 0xd4 fill byte, and the NOP forms.
+var (
+	fillARM = map[uint32]bool{
+		0x00000000: true, // ANDEQ R0, R0, R0
+		0xd4d4d4d4: true, // fill byte 0xd4
+		0xe1a00000: true, // MOV R0, R0
+		0xe320f000: true, // NOP
+	}
+	fillThumb = map[uint16]bool{
+		0x0000: true, // MOVS R0, R0
+		0xd4d4: true, // fill byte 0xd4
+		0x46c0: true, // MOV R8, R8
+		0xbf00: true, // NOP
+	}
+)
+
+// isFill reports whether b, one unit of alignment in mode, is filler.
+func isFill(b []byte, mode armasm.Mode) bool {
+	if mode == armasm.ModeThumb {
+		return fillThumb[binary.LittleEndian.Uint16(b)]
+	}
+	return fillARM[binary.LittleEndian.Uint32(b)]
+}
+
+// MarkPadding reclassifies the alignment padding in m as Padding with ReasonAlignment.
+// Code holds the bytes of the region m describes.
+//
+// Padding is a run of filler: zero words, 0xd4 fill bytes, or NOP and MOV R0, R0
+// instructions (MOVS R0, R0 and MOV R8, R8 in Thumb code), that begins
+// immediately after an instruction that does not fall through, such as a
+// return or unconditional branch, and ends at an 8-byte-aligned address or
+// at the end of the region. Instructions reached from an entry point or as a
+// branch target and literals loaded by PC-relative loads in m are never padding.
+// A Data or Unknown range that begins with padding is split;
+// instructions are never split.
+//
+// Marking padding keeps it from being counted as part of the preceding function
+// or shown as code, and keeps differences in padding out of diffs of disassembly.
+func (m *Map) MarkPadding(code []byte) {
+	step := align(m.Mode)
+	end := m.PC + uint64(len(code))
+
+	var mem armmem.Image
+	mem.Add(m.PC, code, false)
+	literal := make(map[uint64]bool)
+	for _, r := range m.Ranges {
+		if r.Kind != Code {
+			continue
+		}
+		if lit, ok := LoadLiteral(r.Inst, r.Start, m.Mode, &mem); ok {
+			for a := lit.Addr &^ (step - 1); a < lit.Addr+uint64(lit.Size); a += step {
+				literal[a] = true
+			}
+		}
+	}
+
+	// padEnd returns the end of the padding starting at addr,
+	// or addr if there is none.
+	padEnd := func(addr uint64) uint64 {
+		p := addr
+		for p+step <= end && isFill(code[p-m.PC:p-m.PC+step], m.Mode) && !literal[p] {
+			if r, ok := m.Lookup(p); ok && r.Kind == Code {
+				switch r.Reason {
+				case ReasonEntry, ReasonJump, ReasonCall:
+					return addr
+				}
+			}
+			p += step
+		}
+		// Do not split an instruction.
+		if r, ok := m.Lookup(p); ok && r.Kind == Code && r.Start < p {
+			p = r.Start
+		}
+		if p != end && p%padAlign != 0 {
+			return addr
+		}
+		return p
+	}
+
+	var out []Range
+	for i := 0; i < len(m.Ranges); {
+		r := m.Ranges[i]
+		if n := len(out); n == 0 || out[n-1].Kind != Code || Classify(out[n-1].Inst, out[n-1].Start, m.Mode).Fallthrough() {
+			out = append(out, r)
+			i++
+			continue
+		}
+		p := padEnd(r.Start)
+		if p == r.Start {
+			out = append(out, r)
+			i++
+			continue
+		}
+		out = append(out, Range{Start: r.Start, End: p, Kind: Padding, Reason: ReasonAlignment})
+		for i < len(m.Ranges) && m.Ranges[i].End <= p {
+			i++
+		}
+		if i < len(m.Ranges) && m.Ranges[i].Start < p {
+			rest := m.Ranges[i]
+			rest.Start = p
+			out = append(out, rest)
+			i++
+		}
+	}
+	m.Ranges = out
+}
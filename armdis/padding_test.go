@@ -0,0 +1,87 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"testing"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/internal/armtest"
+)
+
+var paddingCode = armtest.Words(
+	0xe3a00001, // 0x1000: mov r0, #1
+	0xe12fff1e, // 0x1004: bx lr
+	0xe1a00000, // 0x1008: mov r0, r0 (padding)
+	0xe320f000, // 0x100c: nop (padding)
+	0xe3a00002, // 0x1010: mov r0, #2
+	0xe59f1000, // 0x1014: ldr r1, [pc, #0]
+	0xe12fff1e, // 0x1018: bx lr
+	0x00000000, // 0x101c: literal 0, not padding
+	0xe320f000, // 0x1020: nop (function body)
+	0xe8bd8000, // 0x1024: ldm sp!, {pc}
+	0x00000000, // 0x1028: zero, not padding: 0x102c is not aligned
+	0xe3a00003, // 0x102c: mov r0, #3
+	0xeafffffe, // 0x1030: b .
+	0xd4d4d4d4, // 0x1034: fill (padding)
+	0xd4d4d4d4, // 0x1038: fill (padding)
+	0xd4d4d4d4, // 0x103c: fill (padding)
+)
+
+func TestMarkPadding(t *testing.T) {
+	m := Linear(paddingCode, 0x1000, armasm.ModeARM)
+	m.MarkPadding(paddingCode)
+	want := `0x1000-0x1004 code (sweep) MOV R0, #0x1
+0x1004-0x1008 code (sweep) BX LR
+0x1008-0x1010 padding (alignment)
+0x1010-0x1014 code (sweep) MOV R0, #0x2
+0x1014-0x1018 code (sweep) LDR R1, [PC]
+0x1018-0x101c code (sweep) BX LR
+0x101c-0x1020 code (sweep) AND.EQ R0, R0, R0
+0x1020-0x1024 code (sweep) NOP
+0x1024-0x1028 code (sweep) LDM SP!, {PC}
+0x1028-0x102c code (sweep) AND.EQ R0, R0, R0
+0x102c-0x1030 code (sweep) MOV R0, #0x3
+0x1030-0x1034 code (sweep) B PC-0x8
+0x1034-0x1040 padding (alignment)`
+	if out := dump(m); out != want {
+		t.Errorf("MarkPadding after Linear:\n%s\nwant:\n%s", out, want)
+	}
+
+	// In a recursive traversal the padding is unreached,
+	// and the unreached range following it is split.
+	code := armtest.Words(
+		0xe3a00001, // 0x1000: mov r0, #1
+		0xe12fff1e, // 0x1004: bx lr
+		0x00000000, // 0x1008: padding
+		0x00000000, // 0x100c: padding
+		0xe3a00002, // 0x1010: mov r0, #2 (unreached)
+		0xe12fff1e, // 0x1014: bx lr (unreached)
+	)
+	m = Recursive(code, 0x1000, armasm.ModeARM, 0x1000)
+	m.MarkPadding(code)
+	want = `0x1000-0x1004 code (entry) MOV R0, #0x1
+0x1004-0x1008 code (fallthrough from 0x1000) BX LR
+0x1008-0x1010 padding (alignment)
+0x1010-0x1018 unknown (unreached)`
+	if out := dump(m); out != want {
+		t.Errorf("MarkPadding after Recursive:\n%s\nwant:\n%s", out, want)
+	}
+
+	// A branch target is never padding.
+	code = armtest.Words(
+		0xe12fff1e, // 0x1000: bx lr
+		0xe320f000, // 0x1004: nop (entry)
+		0xe12fff1e, // 0x1008: bx lr
+	)
+	m = Recursive(code, 0x1000, armasm.ModeARM, 0x1000, 0x1004)
+	m.MarkPadding(code)
+	want = `0x1000-0x1004 code (entry) BX LR
+0x1004-0x1008 code (entry) NOP
+0x1008-0x100c code (fallthrough from 0x1004) BX LR`
+	if out := dump(m); out != want {
+		t.Errorf("MarkPadding with entry:\n%s\nwant:\n%s", out, want)
+	}
+}
@@ -0,0 +1,106 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"runtime"
+	"sync"
+
+	"rsc.io/arm/armasm"
+)
+
+// minChunk is the smallest region LinearParallel hands to a single worker.
+const minChunk = 64 << 10
+
+// LinearParallel is like LinearDecoder but splits code into word-aligned
+// chunks and sweeps them concurrently using up to workers goroutines.
+// If workers is not positive, it uses runtime.GOMAXPROCS(0).
+//
+// The result is the same as LinearDecoder's. When an instruction
+// straddles a chunk boundary, as a 32-bit Thumb instruction can,
+// the following chunk is swept again from the end of that instruction
+// until the second sweep reaches an address at which the first one
+// started an instruction; from there on the two sweeps agree.
+func LinearParallel(d *armasm.Decoder, code []byte, pc uint64, mode armasm.Mode, workers int) *Map {
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	size := uint64(len(code)) / uint64(workers)
+	if size < minChunk {
+		size = minChunk
+	}
+	return linearParallel(d, code, pc, mode, workers, size&^3)
+}
+
+// A chunk is the result of sweeping one part of a region.
+type chunk struct {
+	start, limit uint64  // sweep covers instructions starting in [start, limit)
+	ranges       []Range // ranges found
+	next         uint64  // address at which the sweep stopped
+}
+
+// linearParallel implements LinearParallel, using chunks of the given size.
+func linearParallel(d *armasm.Decoder, code []byte, pc uint64, mode armasm.Mode, workers int, size uint64) *Map {
+	end := pc + uint64(len(code))
+	var chunks []chunk
+	for addr := pc; addr < end; addr += size {
+		limit := addr + size
+		if limit > end || limit < addr {
+			limit = end
+		}
+		chunks = append(chunks, chunk{start: addr, limit: limit})
+	}
+
+	todo := make(chan *chunk)
+	var wg sync.WaitGroup
+	for i := 0; i < workers && i < len(chunks); i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range todo {
+				cm := &Map{}
+				c.next = sweep(cm, d, code, pc, c.start, c.limit, mode, false)
+				c.ranges = cm.Ranges
+			}
+		}()
+	}
+	for i := range chunks {
+		todo <- &chunks[i]
+	}
+	close(todo)
+	wg.Wait()
+
+	m := &Map{PC: pc, Mode: mode}
+	addr := pc
+	for i := range chunks {
+		c := &chunks[i]
+		if addr == c.start {
+			for _, r := range c.ranges {
+				m.add(r)
+			}
+			addr = c.next
+			continue
+		}
+		// The previous sweep stopped somewhere other than c.start.
+		// Discard c's ranges up to addr and sweep c again from addr,
+		// one instruction at a time, until reaching the start of one
+		// of c's ranges; the rest of c's ranges are then correct.
+		j := 0
+		for addr < c.limit {
+			for j < len(c.ranges) && c.ranges[j].Start < addr {
+				j++
+			}
+			if j < len(c.ranges) && c.ranges[j].Start == addr {
+				for _, r := range c.ranges[j:] {
+					m.add(r)
+				}
+				addr = c.next
+				break
+			}
+			addr = sweep(m, d, code, pc, addr, addr+1, mode, false)
+		}
+	}
+	return m
+}
@@ -0,0 +1,52 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"math/rand"
+	"testing"
+
+	"rsc.io/arm/armasm"
+)
+
+func TestLinearParallel(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	code := make([]byte, 4002)
+	r.Read(code)
+	// Runs of undecodable words exercise merging across chunk boundaries.
+	for i := 1000; i < 1100; i++ {
+		code[i] = 0xff
+	}
+	tests := []struct {
+		mode armasm.Mode
+		size uint64
+	}{
+		{armasm.ModeARM, 4},
+		{armasm.ModeARM, 6}, // misaligned chunks must be swept again
+		{armasm.ModeARM, 64},
+		{armasm.ModeARM, 1 << 20},
+		{armasm.ModeThumb, 6},
+		{armasm.ModeThumb, 64},
+	}
+	for _, tt := range tests {
+		want := dump(LinearDecoder(nil, code, 0x8000, tt.mode))
+		for _, workers := range []int{1, 3} {
+			m := linearParallel(nil, code, 0x8000, tt.mode, workers, tt.size)
+			if m.PC != 0x8000 || m.Mode != tt.mode {
+				t.Errorf("mode %v, size %d, workers %d: PC=%#x Mode=%v", tt.mode, tt.size, workers, m.PC, m.Mode)
+			}
+			if out := dump(m); out != want {
+				t.Errorf("mode %v, size %d, workers %d: differs from LinearDecoder:\n%s\nwant:\n%s", tt.mode, tt.size, workers, out, want)
+			}
+		}
+	}
+
+	if out, want := dump(LinearParallel(nil, testCode, 0x1000, armasm.ModeARM, 0)), dump(Linear(testCode, 0x1000, armasm.ModeARM)); out != want {
+		t.Errorf("LinearParallel:\n%s\nwant:\n%s", out, want)
+	}
+	if m := LinearParallel(nil, nil, 0x1000, armasm.ModeARM, 2); len(m.Ranges) != 0 {
+		t.Errorf("LinearParallel(nil) = %d ranges, want 0", len(m.Ranges))
+	}
+}
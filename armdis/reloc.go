@@ -0,0 +1,147 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"debug/elf"
+	"encoding/binary"
+	"fmt"
+	"sort"
+)
+
+// A Reloc is a relocation applied to the code of a relocatable object.
+type Reloc struct {
+	Addr   uint64    // offset of the relocated instruction or word in its section
+	Type   elf.R_ARM // relocation type
+	Sym    string    // target symbol, or the section name for a section symbol
+	Addend int64     // addend, from the relocation entry or, for REL sections, the placeholder
+}
+
+// Relocs returns the relocations that apply to section s of the relocatable
+// file f, sorted by Addr. For SHT_REL sections, which do not record addends,
+// the addend is taken from the placeholder in the relocated instruction or word.
+func Relocs(f *elf.File, s *elf.Section) ([]Reloc, error) {
+	index := -1
+	for i, sect := range f.Sections {
+		if sect == s {
+			index = i
+		}
+	}
+	if index < 0 {
+		return nil, fmt.Errorf("section %s not in file", s.Name)
+	}
+	syms, err := f.Symbols()
+	if err != nil && err != elf.ErrNoSymbols {
+		return nil, err
+	}
+	var text []byte
+	var out []Reloc
+	for _, rs := range f.Sections {
+		if rs.Type != elf.SHT_REL && rs.Type != elf.SHT_RELA || int(rs.Info) != index {
+			continue
+		}
+		data, err := rs.Data()
+		if err != nil {
+			return nil, err
+		}
+		size := 8
+		if rs.Type == elf.SHT_RELA {
+			size = 12
+		} else if text == nil {
+			if text, err = s.Data(); err != nil {
+				return nil, err
+			}
+		}
+		for ; len(data) >= size; data = data[size:] {
+			off := f.ByteOrder.Uint32(data)
+			info := f.ByteOrder.Uint32(data[4:])
+			r := Reloc{Addr: uint64(off), Type: elf.R_ARM(elf.R_TYPE32(info))}
+			if n := int(elf.R_SYM32(info)); n > 0 && n <= len(syms) {
+				sym := syms[n-1]
+				r.Sym = sym.Name
+				if elf.ST_TYPE(sym.Info) == elf.STT_SECTION && int(sym.Section) < len(f.Sections) {
+					r.Sym = f.Sections[sym.Section].Name
+				}
+			}
+			if rs.Type == elf.SHT_RELA {
+				r.Addend = int64(int32(f.ByteOrder.Uint32(data[8:])))
+			} else if uint64(off)+4 <= uint64(len(text)) {
+				r.Addend = placeholder(r.Type, text[off:])
+			}
+			out = append(out, r)
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Addr < out[j].Addr })
+	return out, nil
+}
+
+// placeholder returns the addend encoded in the relocated bytes b
+// for a relocation of type t.
+func placeholder(t elf.R_ARM, b []byte) int64 {
+	w := binary.LittleEndian.Uint32(b)
+	switch t {
+	case elf.R_ARM_CALL, elf.R_ARM_JUMP24, elf.R_ARM_PC24:
+		return int64(int32(w<<8) >> 6)
+	case elf.R_ARM_MOVW_ABS_NC, elf.R_ARM_MOVT_ABS, elf.R_ARM_MOVW_PREL_NC, elf.R_ARM_MOVT_PREL:
+		return int64(int16(w>>4&0xf000 | w&0xfff))
+	case elf.R_ARM_THM_PC22, elf.R_ARM_THM_JUMP24:
+		hw1, hw2 := w&0xffff, w>>16
+		s := hw1 >> 10 & 1
+		i1 := ^(hw2>>13 ^ s) & 1
+		i2 := ^(hw2>>11 ^ s) & 1
+		off := s<<24 | i1<<23 | i2<<22 | (hw1&0x3ff)<<12 | (hw2&0x7ff)<<1
+		return int64(int32(off<<7) >> 7)
+	case elf.R_ARM_THM_MOVW_ABS_NC, elf.R_ARM_THM_MOVT_ABS:
+		hw1, hw2 := w&0xffff, w>>16
+		return int64(int16((hw1&0xf)<<12 | (hw1>>10&1)<<11 | (hw2>>12&7)<<8 | hw2&0xff))
+	case elf.R_ARM_ABS32, elf.R_ARM_REL32, elf.R_ARM_PREL31, elf.R_ARM_TARGET1:
+		return int64(int32(w))
+	}
+	return 0
+}
+
+// String returns the symbolic value the relocation supplies, such as
+// "memcpy" for a call, ":lower16:buf+0x4" for a MOVW, or
+// ":upper16:buf+0x4" for a MOVT. For branches and calls the addend is
+// adjusted for the PC offset, so that the result names the branch target.
+// Other relocation types are shown as the type followed by the symbol.
+func (r Reloc) String() string {
+	switch r.Type {
+	case elf.R_ARM_CALL, elf.R_ARM_JUMP24, elf.R_ARM_PC24:
+		return symOff(r.Sym, r.Addend+8)
+	case elf.R_ARM_THM_PC22, elf.R_ARM_THM_JUMP24:
+		return symOff(r.Sym, r.Addend+4)
+	case elf.R_ARM_MOVW_ABS_NC, elf.R_ARM_THM_MOVW_ABS_NC:
+		return ":lower16:" + symOff(r.Sym, r.Addend)
+	case elf.R_ARM_MOVT_ABS, elf.R_ARM_THM_MOVT_ABS:
+		return ":upper16:" + symOff(r.Sym, r.Addend)
+	case elf.R_ARM_MOVW_PREL_NC:
+		return ":lower16:" + symOff(r.Sym, r.Addend) + "-."
+	case elf.R_ARM_MOVT_PREL:
+		return ":upper16:" + symOff(r.Sym, r.Addend) + "-."
+	}
+	return r.Type.String() + " " + symOff(r.Sym, r.Addend)
+}
+
+// symOff formats the symbol sym plus the offset off.
+func symOff(sym string, off int64) string {
+	switch {
+	case off > 0:
+		return fmt.Sprintf("%s+%#x", sym, off)
+	case off < 0:
+		return fmt.Sprintf("%s-%#x", sym, -off)
+	}
+	return sym
+}
+
+// lookupReloc returns the relocation in list, sorted by Addr,
+// applying to the instruction at addr.
+func lookupReloc(list []Reloc, addr uint64) (Reloc, bool) {
+	i := sort.Search(len(list), func(i int) bool { return list[i].Addr >= addr })
+	if i < len(list) && list[i].Addr == addr {
+		return list[i], true
+	}
+	return Reloc{}, false
+}
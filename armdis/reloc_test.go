@@ -0,0 +1,83 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"bytes"
+	"debug/elf"
+	"fmt"
+	"strings"
+	"testing"
+
+	"rsc.io/arm/armasm"
+)
+
+func TestRelocs(t *testing.T) {
+	f, err := elf.Open("testdata/reloc.o")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	s := f.Section(".text")
+	relocs, err := Relocs(f, s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out []string
+	for _, r := range relocs {
+		out = append(out, fmt.Sprintf("%#x %v %s%+d = %v", r.Addr, r.Type, r.Sym, r.Addend, r))
+	}
+	want := `0x4 R_ARM_CALL memcpy-8 = memcpy
+0x8 R_ARM_MOVW_ABS_NC buf+4 = :lower16:buf+0x4
+0xc R_ARM_MOVT_ABS buf+4 = :upper16:buf+0x4`
+	if got := strings.Join(out, "\n"); got != want {
+		t.Errorf("Relocs:\n%s\nwant:\n%s", got, want)
+	}
+
+	code, err := s.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var buf bytes.Buffer
+	if err := WriteText(&buf, Linear(code, 0, armasm.ModeARM), &TextOptions{Relocs: relocs}); err != nil {
+		t.Fatal(err)
+	}
+	want = strings.TrimLeft(`
+0x0	e92d4010	PUSH {R4,LR}
+0x4	ebfffffe	BL PC-0x8	; memcpy
+0x8	e3000004	MOVW R0, #0x4	; :lower16:buf+0x4
+0xc	e3400004	MOVT R0, #0x4	; :upper16:buf+0x4
+0x10	ea000000	B PC+0x0	; 0x18
+0x14	e8bd8010	POP {R4,PC}
+0x18	e12fff1e	BX LR
+`, "\n")
+	if buf.String() != want {
+		t.Errorf("WriteText:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+var placeholderTests = []struct {
+	typ  elf.R_ARM
+	enc  uint32
+	want int64
+}{
+	{elf.R_ARM_CALL, 0xebfffffe, -8},
+	{elf.R_ARM_JUMP24, 0xea000001, 4},
+	{elf.R_ARM_MOVW_ABS_NC, 0xe30f0fff, -1},
+	{elf.R_ARM_MOVT_ABS, 0xe3412234, 0x1234},
+	{elf.R_ARM_THM_PC22, 0xfffef7ff, -4},
+	{elf.R_ARM_THM_MOVW_ABS_NC, 0x0034f241, 0x1034},
+	{elf.R_ARM_ABS32, 0x00000010, 16},
+	{elf.R_ARM_NONE, 0x12345678, 0},
+}
+
+func TestPlaceholder(t *testing.T) {
+	for _, tt := range placeholderTests {
+		b := []byte{byte(tt.enc), byte(tt.enc >> 8), byte(tt.enc >> 16), byte(tt.enc >> 24)}
+		if got := placeholder(tt.typ, b); got != tt.want {
+			t.Errorf("placeholder(%v, %#08x) = %d, want %d", tt.typ, tt.enc, got, tt.want)
+		}
+	}
+}
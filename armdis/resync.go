@@ -0,0 +1,91 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import "rsc.io/arm/armasm"
+
+// Parameters of the resynchronization heuristic used by LinearResync.
+const (
+	resyncRun   = 8   // instructions decoded to score a candidate
+	resyncMin   = 4   // score needed to accept a candidate
+	resyncLimit = 256 // bytes searched for a candidate
+)
+
+// LinearResync is like LinearDecoder but resynchronizes after bytes that
+// do not decode. Undecodable bytes usually mean the sweep has run into data,
+// such as a literal pool or string table, or into code of the other
+// instruction set, where the following bytes may still decode by chance.
+// After recording the undecodable instruction as Data with ReasonUndecodable,
+// LinearResync scores each following aligned address as a candidate
+// resynchronization point by the number of consecutive instructions that
+// decode from it, stopping at an unconditional branch or return,
+// which counts as a full run. Zero words, which are more likely padding
+// than ANDEQ R0, R0, R0, end a run. The sweep resumes at the first
+// candidate with a full run of 8 instructions, or else at the highest
+// scoring candidate with a run of at least 4, among those within 256 bytes.
+// If there is none, all 256 bytes are skipped. Skipped bytes are recorded
+// as Data with ReasonSkipped.
+func LinearResync(d *armasm.Decoder, code []byte, pc uint64, mode armasm.Mode) *Map {
+	return linear(d, code, pc, mode, true)
+}
+
+// resyncPoint returns the address at which to resume a linear sweep
+// of code, loaded at pc, after an undecodable instruction ending at addr.
+func resyncPoint(d *armasm.Decoder, code []byte, pc, addr uint64, mode armasm.Mode) uint64 {
+	step := align(mode)
+	end := pc + uint64(len(code))
+	limit := addr + resyncLimit
+	if limit > end {
+		limit = end
+	}
+	best, bestScore := limit, resyncMin-1
+	for c := addr; c < limit; c += step {
+		score := resyncScore(d, code, pc, c, mode)
+		if score == resyncRun {
+			return c
+		}
+		if score > bestScore {
+			best, bestScore = c, score
+		}
+	}
+	return best
+}
+
+// resyncScore returns the number of consecutive instructions, up to resyncRun,
+// that decode starting at addr. A run ending in an unconditional control
+// transfer, or at the end of code, scores resyncRun.
+func resyncScore(d *armasm.Decoder, code []byte, pc, addr uint64, mode armasm.Mode) int {
+	end := pc + uint64(len(code))
+	for n := 0; n < resyncRun; n++ {
+		if addr >= end {
+			return resyncRun
+		}
+		src := code[addr-pc:]
+		if uint64(len(src)) < align(mode) || isZero(src[:align(mode)]) {
+			return n
+		}
+		inst, err := d.Decode(src, mode)
+		if err != nil {
+			return n
+		}
+		switch f := Classify(inst, addr, mode); f.Kind {
+		case FlowJump, FlowIndirectJump, FlowReturn:
+			if !f.Cond {
+				return resyncRun
+			}
+		}
+		addr += uint64(inst.Len)
+	}
+	return resyncRun
+}
+
+func isZero(b []byte) bool {
+	for _, c := range b {
+		if c != 0 {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,47 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"testing"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/internal/armtest"
+)
+
+func TestLinearResync(t *testing.T) {
+	code := armtest.Words(
+		0xe3a00001, // 0x1000: mov r0, #1
+		0xffffffff, // 0x1004: (data)
+		0x00000000, // 0x1008: (data)
+		0xe3a00002, // 0x100c: (data that decodes)
+		0xffffffff, // 0x1010: (data)
+		0xe3a00003, // 0x1014: mov r0, #3
+		0xe0800001, // 0x1018: add r0, r0, r1
+		0xe12fff1e, // 0x101c: bx lr
+		0x6c6c6568, // 0x1020: "hello"
+		0x0000006f, // 0x1024
+	)
+	m := LinearResync(nil, code, 0x1000, armasm.ModeARM)
+	want := `0x1000-0x1004 code (sweep) MOV R0, #0x1
+0x1004-0x1008 data (undecodable)
+0x1008-0x1014 data (skipped)
+0x1014-0x1018 code (sweep) MOV R0, #0x3
+0x1018-0x101c code (sweep) ADD R0, R0, R1
+0x101c-0x1020 code (sweep) BX LR
+0x1020-0x1024 data (undecodable)
+0x1024-0x1028 code (sweep) AND.EQ R0, R0, PC RRX #1`
+	if out := dump(m); out != want {
+		t.Errorf("LinearResync:\n%s\nwant:\n%s", out, want)
+	}
+
+	// Without a plausible resynchronization point, the rest is skipped.
+	m = LinearResync(nil, armtest.Words(0xffffffff, 0xe3a00002, 0xffffffff, 0, 0), 0x1000, armasm.ModeARM)
+	want = `0x1000-0x1004 data (undecodable)
+0x1004-0x1014 data (skipped)`
+	if out := dump(m); out != want {
+		t.Errorf("LinearResync:\n%s\nwant:\n%s", out, want)
+	}
+}
@@ -0,0 +1,252 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"fmt"
+	"math"
+
+	"rsc.io/arm/armasm"
+)
+
+// Parameters of the image segmentation used by Segment.
+const (
+	segBlock    = 64   // bytes classified together
+	segWindow   = 256  // bytes scored around each block
+	entropyHigh = 7.0  // bits per byte of a window above which it is compressed or random data
+	entropyLow  = 1.5  // bits per byte of a window below which it is a repetitive table or fill
+	weakScore   = 0.35 // code score at which a called or surrounded block is taken to be code
+	bridgeGap   = 8    // most blocks between code blocks taken to be code
+)
+
+// A Region is a part of an image classified by Segment.
+type Region struct {
+	Start uint64
+	End   uint64
+	Mode  armasm.Mode // armasm.ModeARM or armasm.ModeThumb for code, 0 for data
+	Score ModeScore   // the score of the whole region
+}
+
+func (r Region) String() string {
+	name := "data"
+	if r.Mode != 0 {
+		name = r.Mode.String()
+	}
+	return fmt.Sprintf("%#x-%#x %s", r.Start, r.End, name)
+}
+
+// Segment divides code, an entire image loaded at address pc, into
+// regions of ARM code, Thumb code, and data, for images without
+// symbols or other indications of where the code is. ARM instructions
+// are decoded using d. The regions are sorted by address, do not
+// overlap, and together cover the image; adjacent regions differ in mode.
+//
+// Segment classifies each 64-byte block using ScoreMode on the
+// 256 bytes around it, except that a window whose byte entropy is
+// above 7 bits, like compressed or encrypted data, or below 1.5 bits,
+// like fill or a table of repeated values, is data. It then checks
+// that calls agree: a block that is the target of a BL, or the Thumb
+// BL pair, from a code block of the same mode, or of a BLX from
+// the other mode, is taken to be code in the target mode if its score
+// for that mode is at least 0.35. Code without the idioms ScoreMode
+// looks for, such as that generated by the Go toolchain, often scores
+// about 0.4, so runs of up to 8 blocks between two code blocks of the
+// same mode that all score at least 0.35 for that mode are taken to be
+// code as well. Finally Segment merges adjacent blocks with the same
+// classification into regions.
+//
+// Literal pools and other data embedded in code are usually too small
+// to form regions of their own; a linear sweep of a code region,
+// as done by LinearRegions, reports them as undecodable bytes.
+func Segment(d *armasm.Decoder, code []byte, pc uint64) []Region {
+	n := (len(code) + segBlock - 1) / segBlock
+	if n == 0 {
+		return nil
+	}
+	modes := make([]armasm.Mode, n)
+	scores := make([]ModeScore, n)
+	fixed := make([]bool, n) // data by entropy
+	for i := range modes {
+		lo, hi := segWindowAt(i, len(code))
+		w := code[lo:hi]
+		scores[i] = ScoreMode(d, w, pc+uint64(lo))
+		if e := entropy(w); e > entropyHigh || e < entropyLow {
+			fixed[i] = true
+			continue
+		}
+		modes[i] = scores[i].Mode()
+	}
+	// weak reports whether block i is plausible as code in mode.
+	weak := func(i int, mode armasm.Mode) bool {
+		if fixed[i] || scores[i].Data >= 0.5 {
+			return false
+		}
+		if mode == armasm.ModeThumb {
+			return scores[i].Thumb >= weakScore
+		}
+		return scores[i].ARM >= weakScore
+	}
+
+	// Promote the targets of calls from code blocks.
+	for i, mode := range modes {
+		if mode == 0 {
+			continue
+		}
+		lo := i * segBlock
+		hi := lo + segBlock
+		if hi > len(code) {
+			hi = len(code)
+		}
+		for _, c := range calls(d, code[lo:hi], pc+uint64(lo), mode) {
+			if c.target < pc || c.target-pc >= uint64(len(code)) {
+				continue
+			}
+			j := int((c.target - pc) / segBlock)
+			if modes[j] != c.mode && weak(j, c.mode) {
+				modes[j] = c.mode
+			}
+		}
+	}
+
+	// Bridge short gaps between code blocks of the same mode.
+	for i := 0; i < n; i++ {
+		mode := modes[i]
+		j := i + 1
+		for j < n && modes[j] != mode {
+			j++
+		}
+		if mode != 0 && j < n && j-i-1 <= bridgeGap {
+			ok := true
+			for k := i + 1; k < j; k++ {
+				ok = ok && weak(k, mode)
+			}
+			if ok {
+				for k := i + 1; k < j; k++ {
+					modes[k] = mode
+				}
+			}
+		}
+	}
+
+	var regions []Region
+	for i, mode := range modes {
+		start := pc + uint64(i*segBlock)
+		end := start + segBlock
+		if i == n-1 {
+			end = pc + uint64(len(code))
+		}
+		if k := len(regions) - 1; k >= 0 && regions[k].Mode == mode {
+			regions[k].End = end
+			continue
+		}
+		regions = append(regions, Region{Start: start, End: end, Mode: mode})
+	}
+	for i := range regions {
+		r := &regions[i]
+		r.Score = ScoreMode(d, code[r.Start-pc:r.End-pc], r.Start)
+	}
+	return regions
+}
+
+// segWindowAt returns the bounds of the window of code scored for block i
+// in code of length n: segWindow bytes centered on the block where possible.
+func segWindowAt(i, n int) (lo, hi int) {
+	lo = i*segBlock - (segWindow-segBlock)/2
+	if lo < 0 {
+		lo = 0
+	}
+	hi = lo + segWindow
+	if hi > n {
+		hi = n
+		if lo = hi - segWindow; lo < 0 {
+			lo = 0
+		}
+	}
+	return lo, hi
+}
+
+// entropy returns the Shannon entropy of the bytes in b, in bits per byte.
+func entropy(b []byte) float64 {
+	var count [256]int
+	for _, c := range b {
+		count[c]++
+	}
+	var e float64
+	for _, n := range count {
+		if n > 0 {
+			p := float64(n) / float64(len(b))
+			e -= p * math.Log2(p)
+		}
+	}
+	return e
+}
+
+// A call is a direct call found by calls.
+type call struct {
+	target uint64
+	mode   armasm.Mode // mode at the target
+}
+
+// calls returns the direct calls in code, loaded at pc, decoded in mode.
+func calls(d *armasm.Decoder, code []byte, pc uint64, mode armasm.Mode) []call {
+	var list []call
+	if mode == armasm.ModeThumb {
+		for off := uint64(0); off+2 <= uint64(len(code)); {
+			inst, half, err := armasm.DecodeThumbBL(code[off:])
+			if err == nil && !half {
+				addr := pc + off
+				target := addr + 4 + uint64(int64(inst.Args[0].(armasm.PCRel)))
+				if inst.Op == armasm.BL {
+					list = append(list, call{target, armasm.ModeThumb})
+				} else {
+					list = append(list, call{target &^ 3, armasm.ModeARM})
+				}
+			}
+			off += thumbLen(code[off:])
+		}
+		return list
+	}
+	for off := 0; off+4 <= len(code); off += 4 {
+		inst, err := d.Decode(code[off:], armasm.ModeARM)
+		if err != nil {
+			continue
+		}
+		addr := pc + uint64(off)
+		f := Classify(inst, addr, armasm.ModeARM)
+		if f.Kind != FlowCall || f.Cond {
+			continue
+		}
+		if inst.Op == armasm.BLX {
+			list = append(list, call{f.Target, armasm.ModeThumb})
+		} else {
+			list = append(list, call{f.Target, armasm.ModeARM})
+		}
+	}
+	return list
+}
+
+// LinearRegions disassembles code, loaded at pc, by linear sweep
+// of each of the regions with the given mode, as found by Segment.
+// The other regions are recorded as Data with ReasonClassified.
+// An instruction at the end of a code region may extend into the next.
+func LinearRegions(d *armasm.Decoder, code []byte, pc uint64, mode armasm.Mode, regions []Region) *Map {
+	m := &Map{PC: pc, Mode: mode}
+	addr := pc
+	for _, r := range regions {
+		if addr >= r.End {
+			continue
+		}
+		if addr < r.Start {
+			addr = r.Start
+		}
+		if r.Mode != mode {
+			m.add(Range{Start: addr, End: r.End, Kind: Data, Reason: ReasonClassified})
+			addr = r.End
+			continue
+		}
+		addr = sweep(m, d, code, pc, addr, r.End, mode, false)
+	}
+	return m
+}
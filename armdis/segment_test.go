@@ -0,0 +1,127 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"bytes"
+	"math/rand"
+	"testing"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/internal/armtest"
+)
+
+func TestSegment(t *testing.T) {
+	random := make([]byte, 512)
+	rand.New(rand.NewSource(1)).Read(random)
+	var img []byte
+	img = append(img, bytes.Repeat(armFuncs, 10)[:512]...)
+	img = append(img, bytes.Repeat([]byte("Hello, world.\n"), 20)[:256]...)
+	img = append(img, random...)
+	img = append(img, bytes.Repeat(thumbFuncs, 10)[:512]...)
+	img = append(img, make([]byte, 256)...)
+
+	// Boundaries may be off by a block, since windows straddle them.
+	want := []struct {
+		start uint64
+		mode  armasm.Mode
+	}{
+		{0x1000, armasm.ModeARM},
+		{0x1200, 0},
+		{0x1500, armasm.ModeThumb},
+		{0x1700, 0},
+	}
+	regions := Segment(nil, img, 0x1000)
+	if len(regions) != len(want) {
+		t.Fatalf("Segment = %v, want %d regions", regions, len(want))
+	}
+	end := uint64(0x1000)
+	for i, r := range regions {
+		w := want[i]
+		if r.Start != end || r.End <= r.Start || r.Mode != w.mode || r.Start+segBlock < w.start || r.Start > w.start+segBlock {
+			t.Errorf("region %d = %v, want mode %v near %#x", i, r, w.mode, w.start)
+		}
+		end = r.End
+	}
+	if end != 0x1000+uint64(len(img)) {
+		t.Errorf("regions end at %#x, want %#x", end, 0x1000+len(img))
+	}
+
+	if regions := Segment(nil, random, 0); len(regions) != 1 || regions[0].Mode != 0 {
+		t.Errorf("Segment(random) = %v, want data", regions)
+	}
+	if regions := Segment(nil, nil, 0); regions != nil {
+		t.Errorf("Segment(nil) = %v, want nil", regions)
+	}
+}
+
+func TestSegmentBridge(t *testing.T) {
+	// ARM code with no idioms scores below the code threshold,
+	// but a short run of it between idiomatic code is still code.
+	plain := armtest.Words(
+		0xe1a04000, // mov r4, r0
+		0xe5901004, // ldr r1, [r0, #4]
+		0xe0811002, // add r1, r1, r2
+		0xe5801004, // str r1, [r0, #4]
+		0xe2400001, // sub r0, r0, #1
+		0xe3500000, // cmp r0, #0
+		0xe0800004, // add r0, r0, r4
+	)
+	var img []byte
+	img = append(img, bytes.Repeat(armFuncs, 10)[:512]...)
+	img = append(img, bytes.Repeat(plain, 20)[:512]...)
+	img = append(img, bytes.Repeat(armFuncs, 10)[:512]...)
+	if s := ScoreMode(nil, img[512:1024], 0); s.Mode() != 0 || s.ARM < weakScore {
+		t.Fatalf("ScoreMode(plain) = %v, want weak ARM", s)
+	}
+	regions := Segment(nil, img, 0)
+	if len(regions) != 1 || regions[0].Mode != armasm.ModeARM {
+		t.Errorf("Segment = %v, want one ARM region", regions)
+	}
+
+	// A longer run is not.
+	img = append(img[:512], bytes.Repeat(plain, 40)[:1024]...)
+	img = append(img, bytes.Repeat(armFuncs, 10)[:512]...)
+	regions = Segment(nil, img, 0)
+	if len(regions) != 3 || regions[1].Mode != 0 {
+		t.Errorf("Segment = %v, want data between ARM regions", regions)
+	}
+}
+
+func TestLinearRegions(t *testing.T) {
+	code := append(append([]byte{}, armFuncs...), "Hello, world.\n\x00\x00"...)
+	regions := []Region{
+		{Start: 0x1000, End: 0x1038, Mode: armasm.ModeARM},
+		{Start: 0x1038, End: 0x1048},
+	}
+	m := LinearRegions(nil, code, 0x1000, armasm.ModeARM, regions)
+	if n := len(m.Insts()); n != len(armFuncs)/4 {
+		t.Errorf("LinearRegions decoded %d instructions, want %d", n, len(armFuncs)/4)
+	}
+	last := m.Ranges[len(m.Ranges)-1]
+	if last.Start != 0x1038 || last.End != 0x1048 || last.Kind != Data || last.Reason != ReasonClassified {
+		t.Errorf("last range = %v, want 0x1038-0x1048 data (classified)", last)
+	}
+}
+
+func TestEntropy(t *testing.T) {
+	all := make([]byte, 256)
+	for i := range all {
+		all[i] = byte(i)
+	}
+	tests := []struct {
+		b []byte
+		e float64
+	}{
+		{make([]byte, 64), 0},
+		{[]byte{0, 1, 0, 1}, 1},
+		{all, 8},
+	}
+	for _, tt := range tests {
+		if e := entropy(tt.b); e != tt.e {
+			t.Errorf("entropy(%x) = %v, want %v", tt.b, e, tt.e)
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"bufio"
+	"io"
+	"text/template"
+
+	"rsc.io/arm/armasm"
+)
+
+// A TemplateInst is the data passed to the template by WriteTemplate
+// for a single instruction.
+type TemplateInst struct {
+	Addr      uint64       // address of the instruction
+	Inst      armasm.Inst  // the instruction itself
+	Op        armasm.Op    // Inst.Op
+	Cond      string       // condition, like "EQ" or "AL", or "" for an unconditional instruction
+	Args      []armasm.Arg // Inst.Args, without the trailing nil entries
+	Enc       uint32       // Inst.Enc
+	Len       int          // Inst.Len
+	Flow      Flow         // control flow effect of the instruction
+	HasTarget bool         // Flow.Target holds a resolved branch or call target
+}
+
+var condName = [...]string{
+	"EQ", "NE", "CS", "CC", "MI", "PL", "VS", "VC",
+	"HI", "LS", "GE", "LT", "GT", "LE", "AL",
+}
+
+// WriteTemplate executes tmpl for each instruction in m, in address order,
+// passing a *TemplateInst as the data and writing the result to w.
+// The template must supply its own line endings. For example,
+// this template prints each instruction's address and text:
+//
+//	{{printf "%#x" .Addr}}: {{.Inst}}{{"\n"}}
+//
+// Ranges of m that are not instructions are skipped.
+func WriteTemplate(w io.Writer, m *Map, tmpl *template.Template) error {
+	b := bufio.NewWriter(w)
+	for _, r := range m.Ranges {
+		if r.Kind != Code {
+			continue
+		}
+		t := &TemplateInst{
+			Addr: r.Start,
+			Inst: r.Inst,
+			Op:   r.Inst.Op,
+			Enc:  r.Inst.Enc,
+			Len:  r.Inst.Len,
+			Flow: Classify(r.Inst, r.Start, m.Mode),
+		}
+		if m.Mode == armasm.ModeARM && r.Inst.Enc>>28 < 0xF {
+			t.Cond = condName[r.Inst.Enc>>28]
+		}
+		for _, arg := range r.Inst.Args {
+			if arg == nil {
+				break
+			}
+			t.Args = append(t.Args, arg)
+		}
+		t.HasTarget = t.Flow.Kind == FlowJump || t.Flow.Kind == FlowCall
+		if err := tmpl.Execute(b, t); err != nil {
+			return err
+		}
+	}
+	return b.Flush()
+}
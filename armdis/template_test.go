@@ -0,0 +1,41 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+	"text/template"
+
+	"rsc.io/arm/armasm"
+)
+
+func TestWriteTemplate(t *testing.T) {
+	m := Recursive(testCode, 0x1000, armasm.ModeARM, 0x1000)
+	tmpl := template.Must(template.New("").Parse(
+		`{{printf "%x" .Addr}} {{.Op}} cond={{.Cond}} len={{.Len}} args={{len .Args}}` +
+			`{{range .Args}} {{printf "%T" .}}{{end}}` +
+			`{{if .HasTarget}} -> {{printf "%#x" .Flow.Target}}{{end}}{{"\n"}}`))
+	var buf bytes.Buffer
+	if err := WriteTemplate(&buf, m, tmpl); err != nil {
+		t.Fatal(err)
+	}
+	want := strings.TrimLeft(`
+1000 MOV cond=AL len=4 args=2 armasm.Reg armasm.Imm
+1004 B cond=AL len=4 args=1 armasm.PCRel -> 0x1010
+1010 B.EQ cond=EQ len=4 args=1 armasm.PCRel -> 0x1018
+1014 BX cond=AL len=4 args=1 armasm.Reg
+1018 POP cond=AL len=4 args=1 armasm.RegList
+`, "\n")
+	if buf.String() != want {
+		t.Errorf("WriteTemplate:\n%s\nwant:\n%s", buf.String(), want)
+	}
+
+	bad := template.Must(template.New("").Parse(`{{.Missing}}`))
+	if err := WriteTemplate(&buf, m, bad); err == nil {
+		t.Errorf("WriteTemplate with bad template succeeded")
+	}
+}
@@ -0,0 +1,14 @@
+// Compiled with: gcc -g -O1 -c -fdebug-prefix-map=$PWD=. -o lines.o lines.c
+// The line table is independent of the target architecture.
+
+int
+add(int a, int b)
+{
+	return a + b;
+}
+
+int
+twice(int a)
+{
+	return add(a, a);
+}
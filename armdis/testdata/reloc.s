@@ -0,0 +1,17 @@
+	// Assembled with: llvm-mc -triple=armv7-none-eabi -filetype=obj -o reloc.o reloc.s
+	.syntax unified
+	.arm
+	.text
+	.globl f
+f:
+	push {r4, lr}
+	bl memcpy
+	movw r0, #:lower16:buf+4
+	movt r0, #:upper16:buf+4
+	b g
+	pop {r4, pc}
+g:
+	bx lr
+	.data
+buf:
+	.word 0, 0
@@ -0,0 +1,213 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armmem"
+)
+
+// TextOptions controls the output of WriteText.
+type TextOptions struct {
+	Color bool // color the output using ANSI terminal escape sequences
+
+	// Relocs lists the relocations applying to the code, sorted by Addr,
+	// when disassembling a relocatable object (see Relocs).
+	Relocs []Reloc
+
+	// Text, if non-nil, is used to read the literals loaded by
+	// PC-relative loads (see LoadLiteral). It is indexed by address.
+	Text armmem.Reader
+
+	// Symname, if non-nil, returns the name and base address of the
+	// symbol containing an address, or "", 0 if there is none.
+	// It is used to name branch targets and literals that are addresses.
+	Symname func(addr uint64) (name string, base uint64)
+
+	// Mark, if nonzero, is the address of a range to flag with "=>",
+	// such as the faulting PC in a crash report (see Window).
+	// The other lines are indented to match.
+	Mark uint64
+
+	// Line, if non-nil, returns the source file and line number of the
+	// instruction at addr, or "", 0 if they are unknown (see LineTable).
+	Line func(addr uint64) (file string, line int)
+
+	// Source, if non-nil, returns the text of the given source line,
+	// or false if it is unavailable. It is used only if Line is set.
+	Source func(file string, line int) (text string, ok bool)
+
+	// Comment, if non-nil, returns an additional comment for the
+	// instruction at addr, or "" for none, such as the name of
+	// a system call (see package armsyscall).
+	Comment func(addr uint64) string
+}
+
+// ANSI escape sequences used by WriteText.
+const (
+	colorReset  = "\x1b[0m"
+	colorAddr   = "\x1b[2m"    // faint
+	colorOp     = "\x1b[1;36m" // bold cyan
+	colorReg    = "\x1b[32m"   // green
+	colorImm    = "\x1b[33m"   // yellow
+	colorTarget = "\x1b[35m"   // magenta
+	colorBad    = "\x1b[1;31m" // bold red
+)
+
+// WriteText writes m to w as text, one line per range, like
+//
+//	0x1000	e3a00001	MOV R0, #0x1
+//	0x1004	ea000001	B PC+0x4	; 0x1010
+//	0x1008	(data, 8 bytes: undecodable)
+//
+// Branches and calls to known targets are followed by a comment
+// giving the target address. Instructions with a relocation in opts.Relocs
+// are instead followed by a comment giving the relocation's symbolic value,
+// such as "; memcpy" or "; :lower16:buf", since their encodings
+// hold only placeholders. If opts.Text is set, PC-relative loads are
+// followed by a comment giving the loaded value, like "; =0x2004";
+// otherwise, or if the literal cannot be read, they are followed by
+// a comment giving its address, like "; 0x100c". If opts.Symname is set,
+// branch targets, literal addresses, and loaded values are followed by
+// the symbol containing them, like "<buf+0x4>". If opts.Color is set, the mnemonic,
+// registers, immediates, and branch targets are shown in distinct colors,
+// and bytes that do not decode are highlighted. If opts.Mark is set,
+// the range containing it is flagged with "=>". If opts.Line is set,
+// each change of source position is shown on a line of its own,
+// like "main.c:12", followed by the source line if opts.Source has it.
+// If opts.Comment is set, the comments it returns follow any others,
+// separated by commas.
+func WriteText(w io.Writer, m *Map, opts *TextOptions) error {
+	if opts == nil {
+		opts = new(TextOptions)
+	}
+	color := func(c, s string) string {
+		if !opts.Color {
+			return s
+		}
+		return c + s + colorReset
+	}
+
+	b := bufio.NewWriter(w)
+	var lastFile string
+	var lastLine int
+	for _, r := range m.Ranges {
+		if opts.Line != nil {
+			if file, line := opts.Line(r.Start); file != "" && (file != lastFile || line != lastLine) {
+				lastFile, lastLine = file, line
+				fmt.Fprintf(b, "%s\n", color(colorAddr, fmt.Sprintf("%s:%d", file, line)))
+				if opts.Source != nil {
+					if text, ok := opts.Source(file, line); ok {
+						fmt.Fprintf(b, "%s\n", text)
+					}
+				}
+			}
+		}
+		if opts.Mark != 0 {
+			if r.Start <= opts.Mark && opts.Mark < r.End {
+				b.WriteString(color(colorBad, "=>") + " ")
+			} else {
+				b.WriteString("   ")
+			}
+		}
+		fmt.Fprintf(b, "%s\t", color(colorAddr, fmt.Sprintf("%#x", r.Start)))
+		if r.Kind != Code {
+			note := fmt.Sprintf("(%s, %d bytes: %s)", r.Kind, r.End-r.Start, r.Reason)
+			if r.Reason == ReasonUndecodable || r.Reason == ReasonTruncated {
+				note = color(colorBad, note)
+			}
+			fmt.Fprintf(b, "%s\n", note)
+			continue
+		}
+		inst := r.Inst
+		fmt.Fprintf(b, "%08x\t%s", inst.Enc, color(colorOp, inst.Op.String()))
+		for i, arg := range inst.Args {
+			if arg == nil {
+				break
+			}
+			if i == 0 {
+				b.WriteString(" ")
+			} else {
+				b.WriteString(", ")
+			}
+			if !opts.Color {
+				b.WriteString(arg.String())
+			} else if _, ok := arg.(armasm.PCRel); ok {
+				b.WriteString(color(colorTarget, arg.String()))
+			} else {
+				b.WriteString(colorArg(arg.String()))
+			}
+		}
+		sep := "\t; "
+		if rel, ok := lookupReloc(opts.Relocs, r.Start); ok {
+			fmt.Fprintf(b, "%s%s", sep, color(colorTarget, rel.String()))
+			sep = ", "
+		} else if lit, ok := LoadLiteral(inst, r.Start, m.Mode, opts.Text); ok {
+			fmt.Fprintf(b, "%s%s", sep, color(colorImm, literalComment(lit, opts.Symname)))
+			sep = ", "
+		} else if addr, _, _, ok := literalAddr(inst, r.Start, m.Mode); ok {
+			fmt.Fprintf(b, "%s%s", sep, color(colorTarget, fmt.Sprintf("%#x", addr)+symComment(addr, opts.Symname)))
+			sep = ", "
+		} else if f := Classify(inst, r.Start, m.Mode); f.Kind == FlowJump || f.Kind == FlowCall {
+			fmt.Fprintf(b, "%s%s", sep, color(colorTarget, fmt.Sprintf("%#x", f.Target)+symComment(f.Target, opts.Symname)))
+			sep = ", "
+		}
+		if opts.Comment != nil {
+			if c := opts.Comment(r.Start); c != "" {
+				fmt.Fprintf(b, "%s%s", sep, c)
+			}
+		}
+		b.WriteString("\n")
+	}
+	return b.Flush()
+}
+
+// regNames is the set of register names printed by armasm.
+var regNames = func() map[string]bool {
+	m := make(map[string]bool)
+	for r := 0; r < 256; r++ {
+		if s := armasm.Reg(r).String(); !strings.HasPrefix(s, "Reg(") {
+			m[s] = true
+		}
+	}
+	return m
+}()
+
+// colorArg colors the register names and immediates in the argument text s.
+func colorArg(s string) string {
+	var out []byte
+	for i := 0; i < len(s); {
+		j := i + 1
+		switch c := s[i]; {
+		case c == '#':
+			for j < len(s) && isWordByte(s[j]) || j < len(s) && (s[j] == '-' || s[j] == '.') {
+				j++
+			}
+			out = append(out, colorImm+s[i:j]+colorReset...)
+		case isWordByte(c):
+			for j < len(s) && isWordByte(s[j]) {
+				j++
+			}
+			if regNames[s[i:j]] {
+				out = append(out, colorReg+s[i:j]+colorReset...)
+			} else {
+				out = append(out, s[i:j]...)
+			}
+		default:
+			out = append(out, c)
+		}
+		i = j
+	}
+	return string(out)
+}
+
+func isWordByte(c byte) bool {
+	return 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || c == '_'
+}
@@ -0,0 +1,120 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"rsc.io/arm/armasm"
+)
+
+func TestWriteText(t *testing.T) {
+	m := Recursive(testCode, 0x1000, armasm.ModeARM, 0x1000)
+	var buf bytes.Buffer
+	if err := WriteText(&buf, m, nil); err != nil {
+		t.Fatal(err)
+	}
+	want := strings.TrimLeft(`
+0x1000	e3a00001	MOV R0, #0x1
+0x1004	ea000001	B PC+0x4	; 0x1010
+0x1008	(unknown, 8 bytes: unreached)
+0x1010	0a000000	B.EQ PC+0x0	; 0x1018
+0x1014	e12fff1e	BX LR
+0x1018	e8bd8010	POP {R4,PC}
+`, "\n")
+	if buf.String() != want {
+		t.Errorf("WriteText:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestWriteTextColor(t *testing.T) {
+	m := Linear(testCode[:12], 0x1000, armasm.ModeARM)
+	var buf bytes.Buffer
+	if err := WriteText(&buf, m, &TextOptions{Color: true}); err != nil {
+		t.Fatal(err)
+	}
+	want := strings.TrimLeft(`
+\x1b[2m0x1000\x1b[0m	e3a00001	\x1b[1;36mMOV\x1b[0m \x1b[32mR0\x1b[0m, \x1b[33m#0x1\x1b[0m
+\x1b[2m0x1004\x1b[0m	ea000001	\x1b[1;36mB\x1b[0m \x1b[35mPC+0x4\x1b[0m	; \x1b[35m0x1010\x1b[0m
+\x1b[2m0x1008\x1b[0m	\x1b[1;31m(data, 4 bytes: undecodable)\x1b[0m
+`, "\n")
+	want = strings.Replace(want, `\x1b`, "\x1b", -1)
+	if buf.String() != want {
+		t.Errorf("WriteText:\n%q\nwant:\n%q", buf.String(), want)
+	}
+}
+
+func TestColorArg(t *testing.T) {
+	for _, tt := range []struct {
+		in, out string
+	}{
+		{"[R1,#-4]!", "[\x1b[32mR1\x1b[0m,\x1b[33m#-4\x1b[0m]!"},
+		{"R2, LSL #2", "\x1b[32mR2\x1b[0m, LSL \x1b[33m#2\x1b[0m"},
+		{"{R4,PC}", "{\x1b[32mR4\x1b[0m,\x1b[32mPC\x1b[0m}"},
+	} {
+		if out := colorArg(tt.in); out != tt.out {
+			t.Errorf("colorArg(%q) = %q, want %q", tt.in, out, tt.out)
+		}
+	}
+}
+
+func TestWriteTextLines(t *testing.T) {
+	m := Linear(testCode[:16], 0x1000, armasm.ModeARM)
+	opts := &TextOptions{
+		Line: func(addr uint64) (string, int) {
+			switch {
+			case addr < 0x1008:
+				return "f.c", 3
+			case addr < 0x100c:
+				return "", 0
+			}
+			return "g.c", 7
+		},
+		Source: func(file string, line int) (string, bool) {
+			if file != "f.c" {
+				return "", false
+			}
+			return "\tx = 1;", true
+		},
+	}
+	var buf bytes.Buffer
+	if err := WriteText(&buf, m, opts); err != nil {
+		t.Fatal(err)
+	}
+	want := strings.TrimLeft(`
+f.c:3
+	x = 1;
+0x1000	e3a00001	MOV R0, #0x1
+0x1004	ea000001	B PC+0x4	; 0x1010
+0x1008	(data, 4 bytes: undecodable)
+g.c:7
+0x100c	e3a00002	MOV R0, #0x2
+`, "\n")
+	if buf.String() != want {
+		t.Errorf("WriteText:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
+
+func TestWriteTextComment(t *testing.T) {
+	m := Linear(testCode[:8], 0x1000, armasm.ModeARM)
+	opts := &TextOptions{
+		Comment: func(addr uint64) string {
+			return map[uint64]string{0x1000: "one", 0x1004: "skip"}[addr]
+		},
+	}
+	var buf bytes.Buffer
+	if err := WriteText(&buf, m, opts); err != nil {
+		t.Fatal(err)
+	}
+	want := strings.TrimLeft(`
+0x1000	e3a00001	MOV R0, #0x1	; one
+0x1004	ea000001	B PC+0x4	; 0x1010, skip
+`, "\n")
+	if buf.String() != want {
+		t.Errorf("WriteText:\n%s\nwant:\n%s", buf.String(), want)
+	}
+}
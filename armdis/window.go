@@ -0,0 +1,132 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"encoding/binary"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armmem"
+)
+
+// Window disassembles the instructions around pc in mem, such as
+// the faulting PC in a crash report: up to before instructions
+// preceding pc, the instruction at pc, and up to after instructions
+// following it. The window stops early where mem cannot be read;
+// Window returns an error only if the instruction at pc cannot be read.
+// The result can be printed with WriteText, setting TextOptions.Mark
+// to pc to flag the instruction at pc.
+//
+// In ARM mode the preceding instructions are simply the preceding words.
+// In Thumb mode, where instructions are 2 or 4 bytes long,
+// the boundaries before pc are ambiguous: a halfword may be
+// an instruction or the second half of one. Window finds them by
+// walking forward from successively later halfwords, using the length
+// given by each instruction's first halfword, and takes the first
+// (farthest) walk that lands exactly on pc.
+//
+// Unlike in a sweep, each instruction that does not decode has its own
+// Data range with ReasonUndecodable, so that it counts as one instruction;
+// in Thumb mode the range has the instruction's full 2- or 4-byte length.
+func Window(mem armmem.Reader, pc uint64, mode armasm.Mode, before, after int) (*Map, error) {
+	step := align(mode)
+	pc &^= step - 1
+
+	// Read up to the longest possible instructions on each side,
+	// one unit at a time, so that a fault ends the window
+	// rather than losing it.
+	first := make([]byte, step)
+	if _, err := mem.ReadAt(first, int64(pc)); err != nil {
+		return nil, err
+	}
+	var back []byte // in reverse order of units
+	lo := pc
+	for lo >= step && uint64(len(back)) < uint64(before)*4 {
+		unit := make([]byte, step)
+		if _, err := mem.ReadAt(unit, int64(lo-step)); err != nil {
+			break
+		}
+		back = append(back, unit...)
+		lo -= step
+	}
+	code := make([]byte, 0, len(back)+int(step)*(after+1))
+	for i := len(back); i > 0; i -= int(step) {
+		code = append(code, back[i-int(step):i]...)
+	}
+	code = append(code, first...)
+	hi := pc + step
+	for hi-pc < uint64(after+1)*4 {
+		unit := make([]byte, step)
+		if _, err := mem.ReadAt(unit, int64(hi)); err != nil {
+			break
+		}
+		code = append(code, unit...)
+		hi += step
+	}
+
+	start := lo
+	if mode == armasm.ModeThumb {
+		for start = lo; start < pc; start += 2 {
+			if thumbWalk(code[start-lo : pc-lo]) {
+				break
+			}
+		}
+	}
+
+	m := &Map{Mode: mode}
+	fault := -1
+	for addr := start; addr < hi; {
+		if addr == pc {
+			fault = len(m.Ranges)
+		}
+		src := code[addr-lo:]
+		inst, err := armasm.Decode(src, mode)
+		if err == nil {
+			m.Ranges = append(m.Ranges, Range{Start: addr, End: addr + uint64(inst.Len), Kind: Code, Reason: ReasonSweep, Inst: inst})
+			addr += uint64(inst.Len)
+			continue
+		}
+		n := step
+		if mode == armasm.ModeThumb {
+			n = thumbLen(src)
+		}
+		if uint64(len(src)) < n {
+			m.Ranges = append(m.Ranges, Range{Start: addr, End: hi, Kind: Data, Reason: ReasonTruncated})
+			break
+		}
+		m.Ranges = append(m.Ranges, Range{Start: addr, End: addr + n, Kind: Data, Reason: ReasonUndecodable})
+		addr += n
+	}
+
+	if fault > before {
+		m.Ranges = m.Ranges[fault-before:]
+		fault = before
+	}
+	if len(m.Ranges) > fault+1+after {
+		m.Ranges = m.Ranges[:fault+1+after]
+	}
+	m.PC = m.Ranges[0].Start
+	return m, nil
+}
+
+// thumbLen returns the length of the Thumb instruction beginning
+// with the halfword at the start of src: 4 if the top five bits are
+// 0b11101, 0b11110, or 0b11111, and otherwise 2.
+func thumbLen(src []byte) uint64 {
+	if len(src) >= 2 && binary.LittleEndian.Uint16(src)>>11 >= 0x1d {
+		return 4
+	}
+	return 2
+}
+
+// thumbWalk reports whether stepping through code by Thumb
+// instruction lengths lands exactly on its end.
+func thumbWalk(code []byte) bool {
+	n := uint64(0)
+	for n < uint64(len(code)) {
+		n += thumbLen(code[n:])
+	}
+	return n == uint64(len(code))
+}
@@ -0,0 +1,106 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armdis
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armmem"
+)
+
+var thumbCode = []byte{
+	0x01, 0x20, // 0x2000: movs r0, #1
+	0x2d, 0xe9, 0x10, 0x40, // 0x2002: push.w {r4, lr}
+	0x70, 0x47, // 0x2006: bx lr
+	0x00, 0xf0, 0x00, 0xf8, // 0x2008: bl 0x200c
+	0x00, 0xbf, // 0x200c: nop
+}
+
+var windowTests = []struct {
+	mode          armasm.Mode
+	pc            uint64
+	before, after int
+	out           string
+}{
+	{armasm.ModeARM, 0x1010, 2, 1, `
+   0x1008	(data, 4 bytes: undecodable)
+   0x100c	e3a00002	MOV R0, #0x2
+=> 0x1010	0a000000	B.EQ PC+0x0	; 0x1018
+   0x1014	e12fff1e	BX LR
+`},
+	// Window stops at the ends of memory.
+	{armasm.ModeARM, 0x1004, 3, 1, `
+   0x1000	e3a00001	MOV R0, #0x1
+=> 0x1004	ea000001	B PC+0x4	; 0x1010
+   0x1008	(data, 4 bytes: undecodable)
+`},
+	{armasm.ModeARM, 0x1018, 1, 4, `
+   0x1014	e12fff1e	BX LR
+=> 0x1018	e8bd8010	POP {R4,PC}
+`},
+	// The low bits of pc are ignored.
+	{armasm.ModeARM, 0x1007, 0, 0, `
+=> 0x1004	ea000001	B PC+0x4	; 0x1010
+`},
+	{armasm.ModeThumb, 0x2008, 2, 1, `
+   0x2002	(data, 4 bytes: undecodable)
+   0x2006	(data, 2 bytes: undecodable)
+=> 0x2008	(data, 4 bytes: undecodable)
+   0x200c	(data, 2 bytes: undecodable)
+`},
+	{armasm.ModeThumb, 0x2007, 1, 0, `
+   0x2002	(data, 4 bytes: undecodable)
+=> 0x2006	(data, 2 bytes: undecodable)
+`},
+	// A 4-byte instruction cut off by the end of memory.
+	{armasm.ModeThumb, 0x200c, 0, 0, `
+=> 0x200c	(data, 2 bytes: undecodable)
+`},
+}
+
+func TestWindow(t *testing.T) {
+	var mem armmem.Image
+	mem.Add(0x1000, testCode, false)
+	mem.Add(0x2000, thumbCode, false)
+	for _, tt := range windowTests {
+		m, err := Window(&mem, tt.pc, tt.mode, tt.before, tt.after)
+		if err != nil {
+			t.Errorf("Window(%#x, %v, %d, %d): %v", tt.pc, tt.mode, tt.before, tt.after, err)
+			continue
+		}
+		var buf bytes.Buffer
+		if err := WriteText(&buf, m, &TextOptions{Mark: tt.pc &^ 1}); err != nil {
+			t.Fatal(err)
+		}
+		if want := strings.TrimLeft(tt.out, "\n"); buf.String() != want {
+			t.Errorf("Window(%#x, %v, %d, %d):\n%s\nwant:\n%s", tt.pc, tt.mode, tt.before, tt.after, buf.String(), want)
+		}
+	}
+
+	if _, err := Window(&mem, 0x3000, armasm.ModeARM, 2, 2); err == nil {
+		t.Errorf("Window(0x3000) succeeded, want fault")
+	}
+}
+
+func TestThumbWalk(t *testing.T) {
+	for _, tt := range []struct {
+		start, end uint64
+		ok         bool
+	}{
+		{0x2000, 0x2008, true},
+		{0x2002, 0x2008, true},
+		{0x2004, 0x2008, true}, // 0x4010 and 0x4770 are both 2-byte instructions
+		{0x2000, 0x2004, false},
+		{0x2008, 0x200a, false},
+		{0x2008, 0x200c, true},
+	} {
+		if ok := thumbWalk(thumbCode[tt.start-0x2000 : tt.end-0x2000]); ok != tt.ok {
+			t.Errorf("thumbWalk(%#x-%#x) = %v, want %v", tt.start, tt.end, ok, tt.ok)
+		}
+	}
+}
@@ -0,0 +1,142 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package armehabi decodes ARM exception-handling unwind tables.
+//
+// The tables, described in the ARM document "Exception Handling ABI for the
+// ARM Architecture" (EHABI), are stored in the ELF sections .ARM.exidx and
+// .ARM.extab. The index has one entry per function, in address order;
+// each entry gives either a compact sequence of unwind opcodes or a
+// reference to a longer entry in the table. The opcodes describe how to
+// undo the function's prologue, so they also identify function boundaries
+// and frame layouts.
+package armehabi
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+)
+
+// Personality routine indexes for the compact model.
+const (
+	Su16    = 0  // short frame, 16-bit scope descriptors
+	Lu16    = 1  // long frame, 16-bit scope descriptors
+	Lu32    = 2  // long frame, 32-bit scope descriptors
+	Generic = -1 // personality routine given by address
+)
+
+// An Entry is the unwind information for one function.
+type Entry struct {
+	Func        uint64 // address of the function
+	CantUnwind  bool   // function cannot be unwound (EXIDX_CANTUNWIND)
+	Personality int    // personality routine index, or Generic
+	Routine     uint64 // address of the personality routine, for Generic
+	Table       uint64 // address of the .ARM.extab entry, or 0 if inline
+	Ops         []Op   // unwind opcodes, for the compact model
+	Data        []byte // personality data following the routine address, for Generic
+}
+
+func (e *Entry) String() string {
+	switch {
+	case e.CantUnwind:
+		return fmt.Sprintf("%#x: cantunwind", e.Func)
+	case e.Personality == Generic:
+		return fmt.Sprintf("%#x: personality %#x", e.Func, e.Routine)
+	}
+	s := fmt.Sprintf("%#x: personality %d:", e.Func, e.Personality)
+	for i, op := range e.Ops {
+		if i > 0 {
+			s += ";"
+		}
+		s += " " + op.String()
+	}
+	return s
+}
+
+var errShort = errors.New("unwind table truncated")
+
+// prel31 returns the address encoded in w, a 31-bit offset
+// relative to addr, the address of w itself.
+func prel31(w uint32, addr uint64) uint64 {
+	return addr + uint64(int64(int32(w<<1)>>1))
+}
+
+// Parse decodes the index exidx, loaded at exidxAddr, and the entries it
+// references in extab, loaded at extabAddr. Extab may be nil if all entries
+// are inline or cannot unwind.
+func Parse(exidx []byte, exidxAddr uint64, extab []byte, extabAddr uint64) ([]*Entry, error) {
+	if len(exidx)%8 != 0 {
+		return nil, fmt.Errorf("exidx size %d not a multiple of 8", len(exidx))
+	}
+	var out []*Entry
+	for off := 0; off < len(exidx); off += 8 {
+		addr := exidxAddr + uint64(off)
+		w0 := binary.LittleEndian.Uint32(exidx[off:])
+		w1 := binary.LittleEndian.Uint32(exidx[off+4:])
+		e := &Entry{Func: prel31(w0, addr)}
+		switch {
+		case w1 == 1:
+			e.CantUnwind = true
+		case w1&(1<<31) != 0:
+			if err := e.compact(w1, nil); err != nil {
+				return nil, fmt.Errorf("exidx entry at %#x: %v", addr, err)
+			}
+		default:
+			e.Table = prel31(w1, addr+4)
+			if e.Table < extabAddr || e.Table >= extabAddr+uint64(len(extab)) {
+				return nil, fmt.Errorf("exidx entry at %#x: extab address %#x out of range", addr, e.Table)
+			}
+			if err := e.parseTable(extab[e.Table-extabAddr:], e.Table); err != nil {
+				return nil, fmt.Errorf("extab entry at %#x: %v", e.Table, err)
+			}
+		}
+		out = append(out, e)
+	}
+	return out, nil
+}
+
+// parseTable parses the .ARM.extab entry b, loaded at addr.
+func (e *Entry) parseTable(b []byte, addr uint64) error {
+	if len(b) < 4 {
+		return errShort
+	}
+	w := binary.LittleEndian.Uint32(b)
+	if w&(1<<31) == 0 {
+		e.Personality = Generic
+		e.Routine = prel31(w, addr)
+		e.Data = b[4:]
+		return nil
+	}
+	return e.compact(w, b[4:])
+}
+
+// compact decodes a compact model entry whose first word is w,
+// followed by the words in rest.
+func (e *Entry) compact(w uint32, rest []byte) error {
+	e.Personality = int(w >> 24 & 0xf)
+	var ops []byte
+	switch e.Personality {
+	case Su16:
+		ops = []byte{byte(w >> 16), byte(w >> 8), byte(w)}
+	case Lu16, Lu32:
+		if rest == nil {
+			return fmt.Errorf("personality %d used inline", e.Personality)
+		}
+		n := int(w >> 16 & 0xff)
+		if len(rest) < 4*n {
+			return errShort
+		}
+		ops = []byte{byte(w >> 8), byte(w)}
+		for i := 0; i < n; i++ {
+			x := binary.LittleEndian.Uint32(rest[4*i:])
+			ops = append(ops, byte(x>>24), byte(x>>16), byte(x>>8), byte(x))
+		}
+	default:
+		return fmt.Errorf("unknown personality index %d", e.Personality)
+	}
+	var err error
+	e.Ops, err = DecodeOps(ops)
+	return err
+}
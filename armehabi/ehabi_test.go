@@ -0,0 +1,91 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armehabi
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"rsc.io/arm/internal/armtest"
+)
+
+// rel returns the prel31 encoding of target relative to addr.
+func rel(target, addr uint64) uint32 {
+	return uint32(target-addr) & 0x7fffffff
+}
+
+func TestParse(t *testing.T) {
+	exidx := armtest.Words(
+		rel(0x1000, 0x2000), 0x80a8b0b0, // pop {r4, r14}; finish
+		rel(0x1100, 0x2008), 1, // cantunwind
+		rel(0x1200, 0x2010), rel(0x3000, 0x2014),
+		rel(0x1300, 0x2018), rel(0x3008, 0x201c),
+	)
+	extab := armtest.Words(
+		0x81010284, 0x00c931b0, // Lu16: vsp += 12; pop {r14}; pop {d3-d4}; finish
+		rel(0x4000, 0x3008), 0x12345678, // generic personality
+	)
+	entries, err := Parse(exidx, 0x2000, extab, 0x3000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var out []string
+	for _, e := range entries {
+		out = append(out, e.String())
+	}
+	want := []string{
+		"0x1000: personality 0: pop {r4, r14}; finish",
+		"0x1100: cantunwind",
+		"0x1200: personality 1: vsp = vsp + 12; pop {r14}; pop {d3-d4}; finish",
+		"0x1300: personality 0x4000",
+	}
+	if strings.Join(out, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Parse:\n%s\nwant:\n%s", strings.Join(out, "\n"), strings.Join(want, "\n"))
+	}
+	if e := entries[3]; !bytes.Equal(e.Data, armtest.Words(0x12345678)) || e.Table != 0x3008 {
+		t.Errorf("generic entry: Table=%#x Data=%x", e.Table, e.Data)
+	}
+}
+
+var opTests = []struct {
+	enc  []byte
+	want string
+}{
+	{[]byte{0x3f}, "vsp = vsp + 256"},
+	{[]byte{0x41}, "vsp = vsp - 8"},
+	{[]byte{0x80, 0x00}, "refuse to unwind"},
+	{[]byte{0x9b}, "vsp = r11"},
+	{[]byte{0xa1}, "pop {r4, r5}"},
+	{[]byte{0xb1, 0x03}, "pop {r0, r1}"},
+	{[]byte{0xb2, 0x81, 0x01}, "vsp = vsp + 1032"},
+	{[]byte{0xb9}, "pop {d8-d9} (fstmfdx)"},
+	{[]byte{0xc8, 0x01}, "pop {d16-d17}"},
+	{[]byte{0xd7}, "pop {d8-d15}"},
+	{[]byte{0xe0}, "spare 0xe0"},
+}
+
+func TestDecodeOps(t *testing.T) {
+	for _, tt := range opTests {
+		ops, err := DecodeOps(tt.enc)
+		if err != nil || len(ops) != 1 || ops[0].String() != tt.want {
+			t.Errorf("DecodeOps(%x) = %v, %v, want %s", tt.enc, ops, err, tt.want)
+		}
+	}
+}
+
+func TestUnwind(t *testing.T) {
+	mem := make([]byte, 0x200)
+	copy(mem[0x108:], armtest.Words(0x44, 0x1234))
+	ops, _ := DecodeOps([]byte{0x01, 0xa8, 0xb0}) // vsp += 8; pop {r4, r14}; finish
+	var regs [16]uint32
+	regs[13] = 0x100
+	if err := Unwind(ops, &regs, bytes.NewReader(mem)); err != nil {
+		t.Fatal(err)
+	}
+	if regs[4] != 0x44 || regs[13] != 0x110 || regs[14] != 0x1234 || regs[15] != 0x1234 {
+		t.Errorf("Unwind: r4=%#x sp=%#x lr=%#x pc=%#x", regs[4], regs[13], regs[14], regs[15])
+	}
+}
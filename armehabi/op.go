@@ -0,0 +1,253 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armehabi
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// An OpKind is the kind of an unwind opcode.
+type OpKind uint8
+
+const (
+	OpFinish  OpKind = iota // finish unwinding
+	OpAddVSP                // vsp += Value (Value may be negative)
+	OpSetVSP                // vsp = r[Reg]
+	OpPop                   // pop core registers in Regs
+	OpPopVFP                // pop Count double registers starting at First
+	OpPopVFPX               // pop Count double registers starting at First, in FSTMFDX format
+	OpPopWMMX               // pop Count iWMMXt data registers starting at First
+	OpPopWCGR               // pop iWMMXt control registers in Regs
+	OpRefuse                // refuse to unwind
+	OpSpare                 // reserved or spare opcode
+)
+
+// An Op is a decoded unwind opcode.
+type Op struct {
+	Kind  OpKind
+	Value int    // vsp adjustment, for OpAddVSP
+	Reg   uint8  // register, for OpSetVSP
+	Regs  uint16 // register mask, for OpPop and OpPopWCGR
+	First uint8  // first register, for the ranged pops
+	Count uint8  // number of registers, for the ranged pops
+	Enc   []byte // encoding
+}
+
+func (op Op) String() string {
+	switch op.Kind {
+	case OpFinish:
+		return "finish"
+	case OpAddVSP:
+		if op.Value < 0 {
+			return fmt.Sprintf("vsp = vsp - %d", -op.Value)
+		}
+		return fmt.Sprintf("vsp = vsp + %d", op.Value)
+	case OpSetVSP:
+		return fmt.Sprintf("vsp = r%d", op.Reg)
+	case OpPop, OpPopWCGR:
+		prefix := "r"
+		if op.Kind == OpPopWCGR {
+			prefix = "wCGR"
+		}
+		s := "pop {"
+		sep := ""
+		for i := uint(0); i < 16; i++ {
+			if op.Regs&(1<<i) != 0 {
+				s += fmt.Sprintf("%s%s%d", sep, prefix, i)
+				sep = ", "
+			}
+		}
+		return s + "}"
+	case OpPopVFP, OpPopVFPX, OpPopWMMX:
+		prefix := "d"
+		if op.Kind == OpPopWMMX {
+			prefix = "wR"
+		}
+		s := fmt.Sprintf("pop {%s%d-%s%d}", prefix, op.First, prefix, int(op.First)+int(op.Count)-1)
+		if op.Kind == OpPopVFPX {
+			s += " (fstmfdx)"
+		}
+		return s
+	case OpRefuse:
+		return "refuse to unwind"
+	}
+	return fmt.Sprintf("spare %#x", op.Enc)
+}
+
+// DecodeOps decodes the unwind opcodes in b.
+// Decoding stops at the first finish opcode.
+func DecodeOps(b []byte) ([]Op, error) {
+	var ops []Op
+	for i := 0; i < len(b); {
+		op, n, err := decodeOp(b[i:])
+		if err != nil {
+			return ops, err
+		}
+		op.Enc = b[i : i+n]
+		ops = append(ops, op)
+		i += n
+		if op.Kind == OpFinish {
+			break
+		}
+	}
+	return ops, nil
+}
+
+// decodeOp decodes the opcode at the start of b, returning it and its length.
+func decodeOp(b []byte) (Op, int, error) {
+	x := b[0]
+	next := func() (byte, error) {
+		if len(b) < 2 {
+			return 0, errShort
+		}
+		return b[1], nil
+	}
+	switch {
+	case x&0xc0 == 0x00:
+		return Op{Kind: OpAddVSP, Value: int(x&0x3f)<<2 + 4}, 1, nil
+	case x&0xc0 == 0x40:
+		return Op{Kind: OpAddVSP, Value: -(int(x&0x3f)<<2 + 4)}, 1, nil
+	case x&0xf0 == 0x80:
+		y, err := next()
+		if err != nil {
+			return Op{}, 0, err
+		}
+		mask := uint16(x&0xf)<<8 | uint16(y)
+		if mask == 0 {
+			return Op{Kind: OpRefuse}, 2, nil
+		}
+		return Op{Kind: OpPop, Regs: mask << 4}, 2, nil
+	case x&0xf0 == 0x90:
+		if x == 0x9d || x == 0x9f {
+			return Op{Kind: OpSpare}, 1, nil
+		}
+		return Op{Kind: OpSetVSP, Reg: x & 0xf}, 1, nil
+	case x&0xf0 == 0xa0:
+		n := uint(x & 7)
+		mask := uint16(1<<(n+1)-1) << 4
+		if x&8 != 0 {
+			mask |= 1 << 14
+		}
+		return Op{Kind: OpPop, Regs: mask}, 1, nil
+	case x == 0xb0:
+		return Op{Kind: OpFinish}, 1, nil
+	case x == 0xb1:
+		y, err := next()
+		if err != nil {
+			return Op{}, 0, err
+		}
+		if y == 0 || y&0xf0 != 0 {
+			return Op{Kind: OpSpare}, 2, nil
+		}
+		return Op{Kind: OpPop, Regs: uint16(y)}, 2, nil
+	case x == 0xb2:
+		v, n := binary.Uvarint(b[1:])
+		if n <= 0 {
+			return Op{}, 0, errShort
+		}
+		return Op{Kind: OpAddVSP, Value: 0x204 + int(v)<<2}, 1 + n, nil
+	case x == 0xb3, x == 0xc8, x == 0xc9, x == 0xc6:
+		y, err := next()
+		if err != nil {
+			return Op{}, 0, err
+		}
+		op := Op{First: y >> 4, Count: y&0xf + 1}
+		switch x {
+		case 0xb3:
+			op.Kind = OpPopVFPX
+		case 0xc8:
+			op.Kind = OpPopVFP
+			op.First += 16
+		case 0xc9:
+			op.Kind = OpPopVFP
+		case 0xc6:
+			op.Kind = OpPopWMMX
+		}
+		return op, 2, nil
+	case x&0xf8 == 0xb8:
+		return Op{Kind: OpPopVFPX, First: 8, Count: x&7 + 1}, 1, nil
+	case x == 0xc7:
+		y, err := next()
+		if err != nil {
+			return Op{}, 0, err
+		}
+		if y == 0 || y&0xf0 != 0 {
+			return Op{Kind: OpSpare}, 2, nil
+		}
+		return Op{Kind: OpPopWCGR, Regs: uint16(y)}, 2, nil
+	case x&0xf8 == 0xc0:
+		return Op{Kind: OpPopWMMX, First: 10, Count: x&7 + 1}, 1, nil
+	case x&0xf8 == 0xd0:
+		return Op{Kind: OpPopVFP, First: 8, Count: x&7 + 1}, 1, nil
+	}
+	return Op{Kind: OpSpare}, 1, nil
+}
+
+// Unwind applies ops to the core registers regs, reading the stack from mem,
+// to compute the register values in the caller. Floating-point and iWMMXt
+// registers are skipped over but not restored. If the opcodes do not restore
+// the PC, it is set to the restored LR, as the EHABI specifies.
+func Unwind(ops []Op, regs *[16]uint32, mem io.ReaderAt) error {
+	vsp := regs[13]
+	setPC := false
+	var buf [4]byte
+	for _, op := range ops {
+		switch op.Kind {
+		case OpFinish:
+			// Handled below.
+		case OpAddVSP:
+			vsp += uint32(op.Value)
+		case OpSetVSP:
+			vsp = regs[op.Reg]
+		case OpPop:
+			var vals [16]uint32
+			for i := uint(0); i < 16; i++ {
+				if op.Regs&(1<<i) == 0 {
+					continue
+				}
+				if _, err := mem.ReadAt(buf[:], int64(vsp)); err != nil {
+					return fmt.Errorf("reading stack at %#x: %v", vsp, err)
+				}
+				vals[i] = binary.LittleEndian.Uint32(buf[:])
+				vsp += 4
+			}
+			for i := uint(0); i < 16; i++ {
+				if op.Regs&(1<<i) != 0 {
+					regs[i] = vals[i]
+				}
+			}
+			if op.Regs&(1<<13) != 0 {
+				vsp = regs[13]
+			}
+			if op.Regs&(1<<15) != 0 {
+				setPC = true
+			}
+		case OpPopVFP:
+			vsp += 8 * uint32(op.Count)
+		case OpPopVFPX:
+			vsp += 8*uint32(op.Count) + 4
+		case OpPopWMMX:
+			vsp += 8 * uint32(op.Count)
+		case OpPopWCGR:
+			for m := op.Regs; m != 0; m &= m - 1 {
+				vsp += 4
+			}
+		case OpRefuse:
+			return fmt.Errorf("function refuses to unwind")
+		default:
+			return fmt.Errorf("spare unwind opcode %#x", op.Enc)
+		}
+		if op.Kind == OpFinish {
+			break
+		}
+	}
+	regs[13] = vsp
+	if !setPC {
+		regs[15] = regs[14]
+	}
+	return nil
+}
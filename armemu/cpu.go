@@ -0,0 +1,294 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package armemu implements an interpreter for the ARM integer instruction set.
+//
+// The interpreter executes instructions decoded by armasm against a register
+// file, the NZCV condition flags, and a caller-supplied memory. It covers
+// the instructions that compilers use for ordinary integer code: data
+// processing, shifts, multiplies, loads and stores, load and store multiple,
+// and branches. It is meant for running small code fragments, such as to
+// resolve a computed jump or to check the decoder's view of an instruction
+// against its execution, not for running whole programs: there are no
+// processor modes, exceptions, coprocessors, or floating point.
+//
+// In Thumb state, the interpreter executes the 16-bit Thumb integer
+// instructions and the BL and BLX pairs, but not the 32-bit Thumb-2
+// instructions or IT blocks.
+package armemu
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armmem"
+)
+
+// A CPUState is the register file of an emulated ARM processor.
+type CPUState struct {
+	R          [16]uint32 // R0 through R15; R15 is the address of the next instruction
+	N, Z, C, V bool       // condition flags
+	Thumb      bool       // processor is in Thumb state
+}
+
+// An Access is a data memory access made by an instruction.
+type Access struct {
+	Addr  uint32
+	Size  int    // size in bytes: 1, 2, or 4
+	Write bool   // access was a write
+	Value uint32 // value read or written
+}
+
+func (a Access) String() string {
+	op := "read"
+	if a.Write {
+		op = "write"
+	}
+	return fmt.Sprintf("%s%d %#x = %#x", op, 8*a.Size, a.Addr, a.Value)
+}
+
+// A CPU is an emulated ARM processor.
+type CPU struct {
+	CPUState
+	Mem armmem.Memory
+
+	// OnTrap, if non-nil, is called for SVC, HVC, SMC, BKPT,
+	// and undefined instructions, such as to model
+	// Linux EABI system calls or semihosting.
+	OnTrap TrapHandler
+
+	// Accesses records the data memory accesses made by the
+	// most recently executed instruction, in order.
+	Accesses []Access
+
+	pc     uint32 // address of the executing instruction
+	branch bool   // executing instruction wrote PC
+}
+
+// Step executes the single instruction at state.R[15], the PC,
+// using mem for instruction fetch and data accesses.
+// It returns the data accesses made by the instruction,
+// including those made before a fault.
+// If the instruction cannot be executed, Step returns an error
+// and leaves state unchanged.
+func Step(state *CPUState, mem armmem.Memory) ([]Access, error) {
+	c := &CPU{CPUState: *state, Mem: mem}
+	err := c.Step()
+	*state = c.CPUState
+	return c.Accesses, err
+}
+
+// A TrapKind identifies the instruction that caused a trap.
+type TrapKind uint8
+
+const (
+	TrapSVC   TrapKind = iota // supervisor call (system call)
+	TrapHVC                   // hypervisor call
+	TrapSMC                   // secure monitor call
+	TrapBKPT                  // breakpoint
+	TrapUndef                 // undefined instruction
+)
+
+var trapKindName = [...]string{
+	TrapSVC:   "SVC",
+	TrapHVC:   "HVC",
+	TrapSMC:   "SMC",
+	TrapBKPT:  "BKPT",
+	TrapUndef: "UNDEF",
+}
+
+func (k TrapKind) String() string {
+	if int(k) < len(trapKindName) {
+		return trapKindName[k]
+	}
+	return fmt.Sprintf("TrapKind(%d)", int(k))
+}
+
+// A Trap describes an instruction that transfers control to the
+// operating system, a hypervisor, secure firmware, or a debugger.
+// If the CPU has no trap handler, the emulator returns the Trap as an error.
+type Trap struct {
+	Kind TrapKind
+	PC   uint32      // address of the trapping instruction
+	Imm  uint32      // immediate operand: the SVC number, BKPT comment, and so on
+	Inst armasm.Inst // the instruction; only Enc and Len are set for HVC and SMC
+}
+
+func (t *Trap) Error() string {
+	return fmt.Sprintf("%v trap at %#x: %v", t.Kind, t.PC, t.Inst)
+}
+
+// A TrapHandler handles a trap taken by the CPU c.
+// When the handler is called, c.R[15] holds the address of the
+// instruction following the trapping one; the handler may change it
+// and any other state, for example to store a system call result in R0.
+// If the handler returns nil, execution continues from c.R[15].
+// Otherwise the instruction fails with the returned error
+// and the CPU state is restored.
+type TrapHandler func(c *CPU, t *Trap) error
+
+// A Fault is the error returned when a memory access fails.
+type Fault struct {
+	PC    uint32 // address of the faulting instruction
+	Addr  uint32 // address being accessed
+	Write bool   // access was a write
+	Err   error  // error from the Memory, usually an *armmem.Fault
+}
+
+func (f *Fault) Error() string {
+	op := "read"
+	if f.Write {
+		op = "write"
+	}
+	return fmt.Sprintf("fault at %#x: %s %#x: %v", f.PC, op, f.Addr, f.Err)
+}
+
+// Step executes the single instruction at c.R[15].
+// If the instruction cannot be executed, Step returns an error
+// and leaves the registers and flags unchanged. A store multiple
+// that faults part way through may have written some memory;
+// c.Accesses records the writes.
+func (c *CPU) Step() error {
+	c.Accesses = c.Accesses[:0]
+	pc := c.R[15]
+	if c.Thumb {
+		return c.stepThumb(pc)
+	}
+	var buf [4]byte
+	if _, err := c.Mem.ReadAt(buf[:], int64(pc)); err != nil {
+		return &Fault{PC: pc, Addr: pc, Err: err}
+	}
+	inst, err := armasm.Decode(buf[:], armasm.ModeARM)
+	if err != nil {
+		// The decoder does not handle HVC and SMC.
+		if t := rawTrap(binary.LittleEndian.Uint32(buf[:]), pc); t != nil {
+			return c.do(pc, 4, func() error {
+				if !armasm.EvalCond(uint8(t.Inst.Enc>>28), c.APSR()) {
+					return nil
+				}
+				return c.trap(t)
+			})
+		}
+		return fmt.Errorf("decoding instruction at %#x: %v", pc, err)
+	}
+	return c.Exec(inst, pc)
+}
+
+// rawTrap returns the trap for x if it is an HVC or SMC encoding.
+func rawTrap(x, pc uint32) *Trap {
+	inst := armasm.Inst{Enc: x, Len: 4}
+	switch {
+	case x&0x0ff000f0 == 0x01400070 && x>>28 == 0xE:
+		return &Trap{Kind: TrapHVC, PC: pc, Imm: x>>4&0xfff0 | x&0xf, Inst: inst}
+	case x&0x0ffffff0 == 0x01600070 && x>>28 != 0xF:
+		return &Trap{Kind: TrapSMC, PC: pc, Imm: x & 0xf, Inst: inst}
+	}
+	return nil
+}
+
+// trap takes the trap t, calling the trap handler if there is one.
+func (c *CPU) trap(t *Trap) error {
+	if c.OnTrap == nil {
+		return t
+	}
+	c.branch = true
+	c.R[15] = t.PC + uint32(t.Inst.Len)
+	return c.OnTrap(c, t)
+}
+
+// Run executes up to n instructions, stopping early at the first error.
+// It returns the number of instructions executed.
+func (c *CPU) Run(n int) (int, error) {
+	for i := 0; i < n; i++ {
+		if err := c.Step(); err != nil {
+			return i, err
+		}
+	}
+	return n, nil
+}
+
+// Exec executes the ARM instruction inst as though it had been fetched
+// from address pc. It is like Step but does not read the instruction
+// from memory. Exec returns an error if the processor is in Thumb state.
+func (c *CPU) Exec(inst armasm.Inst, pc uint32) error {
+	if c.Thumb {
+		return fmt.Errorf("executing ARM instruction at %#x in Thumb state", pc)
+	}
+	return c.do(pc, 4, func() error { return c.exec(inst) })
+}
+
+// do runs f to execute the n-byte instruction at pc,
+// restoring the state if f fails.
+func (c *CPU) do(pc, n uint32, f func() error) error {
+	saved := c.CPUState
+	c.pc = pc
+	c.branch = false
+	c.Accesses = c.Accesses[:0]
+	c.R[15] = pc + 8
+	if c.Thumb {
+		c.R[15] = pc + 4
+	}
+	if err := f(); err != nil {
+		c.CPUState = saved
+		return err
+	}
+	if !c.branch {
+		c.R[15] = pc + n
+	}
+	return nil
+}
+
+// reg returns the value of r, which reads as the instruction address + 8
+// for PC, or + 4 in Thumb state.
+func (c *CPU) reg(r armasm.Reg) uint32 {
+	return c.R[r&15]
+}
+
+// setReg sets r to v. A write to PC is a branch with interworking:
+// an odd address switches to Thumb state and an even one to ARM state.
+func (c *CPU) setReg(r armasm.Reg, v uint32) {
+	if r&15 != 15 {
+		c.R[r&15] = v
+		return
+	}
+	c.branch = true
+	c.Thumb = v&1 != 0
+	if c.Thumb {
+		c.R[15] = v &^ 1
+		return
+	}
+	c.R[15] = v &^ 3
+}
+
+func (c *CPU) read(addr uint32, n int) (uint32, error) {
+	var buf [4]byte
+	if _, err := c.Mem.ReadAt(buf[:n], int64(addr)); err != nil {
+		return 0, &Fault{PC: c.pc, Addr: addr, Err: err}
+	}
+	v := binary.LittleEndian.Uint32(buf[:])
+	c.Accesses = append(c.Accesses, Access{Addr: addr, Size: n, Value: v})
+	return v, nil
+}
+
+func (c *CPU) write(addr uint32, n int, v uint32) error {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], v)
+	if _, err := c.Mem.WriteAt(buf[:n], int64(addr)); err != nil {
+		return &Fault{PC: c.pc, Addr: addr, Write: true, Err: err}
+	}
+	c.Accesses = append(c.Accesses, Access{Addr: addr, Size: n, Write: true, Value: v & (1<<(8*uint(n)) - 1)})
+	return nil
+}
+
+// APSR returns the condition flags in the layout of the APSR register.
+func (c *CPU) APSR() uint32 {
+	var apsr uint32
+	for i, f := range []bool{c.V, c.C, c.Z, c.N} {
+		if f {
+			apsr |= 1 << uint(28+i)
+		}
+	}
+	return apsr
+}
@@ -0,0 +1,290 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armemu
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"testing"
+
+	"rsc.io/arm/armasm"
+)
+
+// ram is a Memory starting at address 0.
+type ram []byte
+
+func (m ram) ReadAt(b []byte, off int64) (int, error) {
+	if off < 0 || off+int64(len(b)) > int64(len(m)) {
+		return 0, fmt.Errorf("out of range")
+	}
+	return copy(b, m[off:]), nil
+}
+
+func (m ram) WriteAt(b []byte, off int64) (int, error) {
+	if off < 0 || off+int64(len(b)) > int64(len(m)) {
+		return 0, fmt.Errorf("out of range")
+	}
+	return copy(m[off:], b), nil
+}
+
+func load(size int, ws ...uint32) ram {
+	m := make(ram, size)
+	for i, w := range ws {
+		binary.LittleEndian.PutUint32(m[4*i:], w)
+	}
+	return m
+}
+
+func TestRun(t *testing.T) {
+	mem := load(0x1000,
+		0xe3a00000, // 0x00: mov r0, #0
+		0xe3a0100a, // 0x04: mov r1, #10
+		0xe0800001, // 0x08: add r0, r0, r1
+		0xe2511001, // 0x0c: subs r1, r1, #1
+		0x1afffffc, // 0x10: bne 0x08
+		0xe92d4001, // 0x14: push {r0, lr}
+		0xeb000001, // 0x18: bl 0x24
+		0xe8bd4001, // 0x1c: pop {r0, lr}
+		0xe1200070, // 0x20: bkpt
+		0xe59f2000, // 0x24: ldr r2, [pc]
+		0xe12fff1e, // 0x28: bx lr
+		0xdeadbeef, // 0x2c: .word
+	)
+	c := &CPU{Mem: mem}
+	c.R[13] = 0x1000
+	c.R[14] = 0x1234
+	n, err := c.Run(100)
+	if trap, ok := err.(*Trap); !ok || trap.PC != 0x20 || n != 37 {
+		t.Fatalf("Run = %d, %v, want 37, trap at 0x20", n, err)
+	}
+	if c.R[0] != 55 || c.R[1] != 0 || c.R[2] != 0xdeadbeef || c.R[13] != 0x1000 || c.R[14] != 0x1234 || !c.Z {
+		t.Errorf("after Run: R=%#x Z=%v", c.R, c.Z)
+	}
+	if c.R[15] != 0x20 {
+		t.Errorf("after trap, PC = %#x, want 0x20", c.R[15])
+	}
+}
+
+// loadThumb writes the halfwords hs to m at addr.
+func loadThumb(m ram, addr int, hs ...uint16) {
+	for i, h := range hs {
+		binary.LittleEndian.PutUint16(m[addr+2*i:], h)
+	}
+}
+
+func TestRunThumb(t *testing.T) {
+	mem := load(0x1000,
+		0xe28f4001, // 0x00: add r4, pc, #1
+		0xe12fff14, // 0x04: bx r4
+	)
+	loadThumb(mem, 0x08,
+		0x2000,         // 0x08: movs r0, #0
+		0x210a,         // 0x0a: movs r1, #10
+		0x1840,         // 0x0c: adds r0, r0, r1
+		0x3901,         // 0x0e: subs r1, #1
+		0xd1fc,         // 0x10: bne 0x0c
+		0xb501,         // 0x12: push {r0, lr}
+		0xf000, 0xf804, // 0x14: bl 0x20
+		0xbc09,         // 0x18: pop {r0, r3}
+		0xf000, 0xe808, // 0x1a: blx 0x2c
+		0xbf00,         // 0x1e: nop
+		0x4a01,         // 0x20: ldr r2, [pc, #4]
+		0x4770,         // 0x22: bx lr
+		0xbf00,         // 0x24: nop
+		0xbf00,         // 0x26: nop
+		0xbeef, 0xdead, // 0x28: .word 0xdeadbeef
+	)
+	binary.LittleEndian.PutUint32(mem[0x2c:], 0xe1200070) // 0x2c: bkpt
+	c := &CPU{Mem: mem}
+	c.R[13] = 0x1000
+	c.R[14] = 0x1234
+	n, err := c.Run(100)
+	if trap, ok := err.(*Trap); !ok || trap.PC != 0x2c || n != 40 {
+		t.Fatalf("Run = %d, %v, want 40, trap at 0x2c", n, err)
+	}
+	if c.R[0] != 55 || c.R[1] != 0 || c.R[2] != 0xdeadbeef || c.R[3] != 0x1234 || c.R[13] != 0x1000 || c.R[14] != 0x1f || !c.Z || c.Thumb {
+		t.Errorf("after Run: R=%#x Z=%v Thumb=%v", c.R, c.Z, c.Thumb)
+	}
+}
+
+var thumbExecTests = []struct {
+	enc        uint16
+	r0, r1, r2 uint32
+	c          bool // carry in
+	want       uint32
+	flags      string // NZCV after execution
+}{
+	{0x1888, 0, 0x7fffffff, 1, false, 0x80000000, "N..V"}, // adds r0, r1, r2
+	{0x1a88, 0, 5, 5, false, 0, ".ZC."},                   // subs r0, r1, r2
+	{0x3801, 0, 0, 0, false, 0xffffffff, "N..."},          // subs r0, #1
+	{0x2080, 7, 0, 0, true, 0x80, "..C."},                 // movs r0, #0x80
+	{0x0848, 0, 3, 0, false, 1, "..C."},                   // lsrs r0, r1, #1
+	{0x1008, 0, 0x80000000, 0, false, 0xffffffff, "N.C."}, // asrs r0, r1, #32
+	{0x41c8, 0x11, 4, 0, false, 0x10000001, "...."},       // rors r0, r1
+	{0x4148, 0, 0xffffffff, 0, true, 0, ".ZC."},           // adcs r0, r1
+	{0x4248, 0, 1, 0, false, 0xffffffff, "N..."},          // rsbs r0, r1, #0
+	{0x4348, 6, 7, 0, false, 42, "...."},                  // muls r0, r1
+	{0x4408, 0xffffffff, 1, 0, false, 0, "...."},          // add r0, r1
+	{0xba08, 0, 0x11223344, 0, false, 0x44332211, "...."}, // rev r0, r1
+	{0xbac8, 0, 0x1280, 0, false, 0xffff8012, "...."},     // revsh r0, r1
+	{0xb248, 0, 0x80, 0, false, 0xffffff80, "...."},       // sxtb r0, r1
+}
+
+func TestExecThumb(t *testing.T) {
+	for _, tt := range thumbExecTests {
+		mem := make(ram, 16)
+		loadThumb(mem, 0, tt.enc)
+		c := &CPU{Mem: mem}
+		c.Thumb = true
+		c.R[0], c.R[1], c.R[2] = tt.r0, tt.r1, tt.r2
+		c.C = tt.c
+		if err := c.Step(); err != nil {
+			t.Errorf("%04x: %v", tt.enc, err)
+			continue
+		}
+		flags := []byte("....")
+		for i, f := range []bool{c.N, c.Z, c.C, c.V} {
+			if f {
+				flags[i] = "NZCV"[i]
+			}
+		}
+		if c.R[0] != tt.want || string(flags) != tt.flags || c.R[15] != 2 || !c.Thumb {
+			t.Errorf("%04x: r0=%#x flags=%s pc=%#x, want %#x %s 0x2", tt.enc, c.R[0], flags, c.R[15], tt.want, tt.flags)
+		}
+	}
+
+	// SVC traps, and execution resumes after the 2-byte instruction.
+	mem := make(ram, 16)
+	loadThumb(mem, 0, 0xdf05) // svc #5
+	c := &CPU{Mem: mem}
+	c.Thumb = true
+	var imm uint32
+	c.OnTrap = func(c *CPU, t *Trap) error {
+		imm = t.Imm
+		return nil
+	}
+	if err := c.Step(); err != nil || imm != 5 || c.R[15] != 2 || !c.Thumb {
+		t.Errorf("svc #5: %v, imm=%d pc=%#x", err, imm, c.R[15])
+	}
+}
+
+var execTests = []struct {
+	enc        uint32
+	r0, r1, r2 uint32
+	c          bool // carry in
+	want       uint32
+	flags      string // NZCV after execution
+}{
+	{0xe0910002, 0x7fffffff, 0x7fffffff, 1, false, 0x80000000, "N..V"}, // adds r0, r1, r2
+	{0xe0510002, 0, 0, 1, false, 0xffffffff, "N..."},                   // subs r0, r1, r2
+	{0xe0510002, 0, 5, 5, false, 0, ".ZC."},                            // subs r0, r1, r2
+	{0xe0b10002, 0, 0xffffffff, 0, true, 0, ".ZC."},                    // adcs r0, r1, r2
+	{0xe1b000a1, 0, 3, 0, false, 1, "..C."},                            // lsrs r0, r1, #1
+	{0xe1b00061, 0, 3, 0, true, 0x80000001, "N.C."},                    // rrxs r0, r1
+	{0xe3b004ff, 0, 0, 0, false, 0xff000000, "N.C."},                   // movs r0, #0xff000000
+	{0xe6bf0f31, 0, 0x11223344, 0, false, 0x44332211, "...."},          // rev r0, r1
+	{0xe16f0f11, 0, 0x00010000, 0, false, 15, "...."},                  // clz r0, r1
+	{0xe0c10392, 0, 0, 0xffffffff, false, 0xfffffffd, "...."},          // smull r0, r1, r2, r3 (r3 = 3)
+	{0xe6af0071, 0, 0x80, 0, false, 0xffffff80, "...."},                // sxtb r0, r1
+	{0x03a00001, 7, 0, 0, false, 7, "...."},                            // moveq r0, #1 (not taken)
+}
+
+func TestExec(t *testing.T) {
+	for _, tt := range execTests {
+		c := &CPU{Mem: make(ram, 16)}
+		c.R[0], c.R[1], c.R[2], c.R[3] = tt.r0, tt.r1, tt.r2, 3
+		c.C = tt.c
+		var buf [4]byte
+		binary.LittleEndian.PutUint32(buf[:], tt.enc)
+		inst, err := armasm.Decode(buf[:], armasm.ModeARM)
+		if err != nil {
+			t.Errorf("%08x: %v", tt.enc, err)
+			continue
+		}
+		if err := c.Exec(inst, 0); err != nil {
+			t.Errorf("%v: %v", inst, err)
+			continue
+		}
+		flags := []byte("....")
+		for i, f := range []bool{c.N, c.Z, c.C, c.V} {
+			if f {
+				flags[i] = "NZCV"[i]
+			}
+		}
+		if c.R[0] != tt.want || string(flags) != tt.flags || c.R[15] != 4 {
+			t.Errorf("%v: r0=%#x flags=%s pc=%#x, want %#x %s 0x4", inst, c.R[0], flags, c.R[15], tt.want, tt.flags)
+		}
+	}
+}
+
+func TestStep(t *testing.T) {
+	mem := load(0x100,
+		0xe92d0006, // 0x00: push {r1, r2}
+		0xe5d03001, // 0x04: ldrb r3, [r0, #1]
+	)
+	var st CPUState
+	st.R[0] = 0x10
+	st.R[1] = 0x11111111
+	st.R[2] = 0x22222222
+	st.R[13] = 0x100
+	acc, err := Step(&st, mem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []Access{
+		{Addr: 0xf8, Size: 4, Write: true, Value: 0x11111111},
+		{Addr: 0xfc, Size: 4, Write: true, Value: 0x22222222},
+	}
+	if fmt.Sprint(acc) != fmt.Sprint(want) || st.R[13] != 0xf8 || st.R[15] != 4 {
+		t.Errorf("push: accesses %v, sp=%#x pc=%#x", acc, st.R[13], st.R[15])
+	}
+	mem[0x11] = 0x99
+	acc, err = Step(&st, mem)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []Access{{Addr: 0x11, Size: 1, Value: 0x99}}; fmt.Sprint(acc) != fmt.Sprint(want) || st.R[3] != 0x99 {
+		t.Errorf("ldrb: accesses %v, r3=%#x", acc, st.R[3])
+	}
+
+	// A faulting access leaves the state unchanged.
+	st.R[0] = 0x1000
+	st.R[15] = 4
+	saved := st
+	if _, err := Step(&st, mem); err == nil || st != saved {
+		t.Errorf("faulting ldrb: err=%v, state changed=%v", err, st != saved)
+	}
+}
+
+func TestTrapHandler(t *testing.T) {
+	mem := load(0x100,
+		0xe3a07004, // 0x00: mov r7, #4
+		0xef000000, // 0x04: svc #0
+		0xe1400071, // 0x08: hvc #1
+		0xe3a07001, // 0x0c: mov r7, #1
+		0xef000000, // 0x10: svc #0
+	)
+	var traps []string
+	exited := errors.New("exit")
+	c := &CPU{Mem: mem}
+	c.R[2] = 5
+	c.OnTrap = func(c *CPU, t *Trap) error {
+		traps = append(traps, fmt.Sprintf("%v %#x %#x r7=%d", t.Kind, t.PC, t.Imm, c.R[7]))
+		if t.Kind == TrapSVC && c.R[7] == 1 {
+			return exited
+		}
+		c.R[0] = c.R[2] // write returns its count
+		return nil
+	}
+	n, err := c.Run(10)
+	want := "[SVC 0x4 0x0 r7=4 HVC 0x8 0x1 r7=4 SVC 0x10 0x0 r7=1]"
+	if err != exited || n != 4 || fmt.Sprint(traps) != want {
+		t.Errorf("Run = %d, %v; traps %v, want 4, exit; %s", n, err, traps, want)
+	}
+	if c.R[0] != 5 || c.R[15] != 0x10 {
+		t.Errorf("r0=%d pc=%#x, want 5 0x10", c.R[0], c.R[15])
+	}
+}
@@ -0,0 +1,557 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armemu
+
+import (
+	"fmt"
+	"math/bits"
+
+	"rsc.io/arm/armasm"
+)
+
+// flagOps maps the flag-setting opcodes (as op&^15)
+// to the corresponding opcodes that do not set flags.
+var flagOps = map[armasm.Op]armasm.Op{
+	armasm.ADC_S_EQ:   armasm.ADC_EQ,
+	armasm.ADD_S_EQ:   armasm.ADD_EQ,
+	armasm.AND_S_EQ:   armasm.AND_EQ,
+	armasm.ASR_S_EQ:   armasm.ASR_EQ,
+	armasm.BIC_S_EQ:   armasm.BIC_EQ,
+	armasm.EOR_S_EQ:   armasm.EOR_EQ,
+	armasm.LSL_S_EQ:   armasm.LSL_EQ,
+	armasm.LSR_S_EQ:   armasm.LSR_EQ,
+	armasm.MLA_S_EQ:   armasm.MLA_EQ,
+	armasm.MOV_S_EQ:   armasm.MOV_EQ,
+	armasm.MUL_S_EQ:   armasm.MUL_EQ,
+	armasm.MVN_S_EQ:   armasm.MVN_EQ,
+	armasm.ORR_S_EQ:   armasm.ORR_EQ,
+	armasm.ROR_S_EQ:   armasm.ROR_EQ,
+	armasm.RRX_S_EQ:   armasm.RRX_EQ,
+	armasm.RSB_S_EQ:   armasm.RSB_EQ,
+	armasm.RSC_S_EQ:   armasm.RSC_EQ,
+	armasm.SBC_S_EQ:   armasm.SBC_EQ,
+	armasm.SMLAL_S_EQ: armasm.SMLAL_EQ,
+	armasm.SMULL_S_EQ: armasm.SMULL_EQ,
+	armasm.SUB_S_EQ:   armasm.SUB_EQ,
+	armasm.UMLAL_S_EQ: armasm.UMLAL_EQ,
+	armasm.UMULL_S_EQ: armasm.UMULL_EQ,
+}
+
+// shiftOps maps the shift opcodes (as op&^15) to their shift types.
+var shiftOps = map[armasm.Op]armasm.Shift{
+	armasm.LSL_EQ: armasm.ShiftLeft,
+	armasm.LSR_EQ: armasm.ShiftRight,
+	armasm.ASR_EQ: armasm.ShiftRightSigned,
+	armasm.ROR_EQ: armasm.RotateRight,
+	armasm.RRX_EQ: armasm.RotateRightExt,
+}
+
+func (c *CPU) unsupported(inst armasm.Inst) error {
+	return fmt.Errorf("unsupported instruction at %#x: %v", c.pc, inst)
+}
+
+// exec executes inst. The caller has set c.pc and set c.R[15] to c.pc+8.
+func (c *CPU) exec(inst armasm.Inst) error {
+	if inst.Op == armasm.UNDEF {
+		return c.trap(&Trap{Kind: TrapUndef, PC: c.pc, Inst: inst})
+	}
+	if !armasm.EvalCond(uint8(inst.Enc>>28), c.APSR()) {
+		return nil
+	}
+	op := inst.Op &^ 15
+	setFlags := false
+	if base, ok := flagOps[op]; ok {
+		op, setFlags = base, true
+	}
+	args := inst.Args
+	switch op {
+	case armasm.ADD_EQ, armasm.ADC_EQ, armasm.SUB_EQ, armasm.SBC_EQ, armasm.RSB_EQ, armasm.RSC_EQ,
+		armasm.AND_EQ, armasm.EOR_EQ, armasm.ORR_EQ, armasm.BIC_EQ, armasm.MOV_EQ, armasm.MVN_EQ,
+		armasm.TST_EQ, armasm.TEQ_EQ, armasm.CMP_EQ, armasm.CMN_EQ:
+		return c.dataProc(inst, op, setFlags)
+
+	case armasm.LSL_EQ, armasm.LSR_EQ, armasm.ASR_EQ, armasm.ROR_EQ, armasm.RRX_EQ:
+		rd, _ := args[0].(armasm.Reg)
+		rm, _ := args[1].(armasm.Reg)
+		typ := shiftOps[op]
+		var n uint32 = 1
+		switch arg := args[2].(type) {
+		case armasm.Imm:
+			n = uint32(arg)
+		case armasm.Reg:
+			n = c.reg(arg) & 0xff
+		}
+		v, carry := shiftC(c.reg(rm), typ, n, c.C)
+		if setFlags {
+			if rd == armasm.PC {
+				return c.unsupported(inst)
+			}
+			c.N, c.Z, c.C = v>>31 != 0, v == 0, carry
+		}
+		c.setReg(rd, v)
+		return nil
+
+	case armasm.MUL_EQ, armasm.MLA_EQ, armasm.MLS_EQ:
+		rd, _ := args[0].(armasm.Reg)
+		rn, _ := args[1].(armasm.Reg)
+		rm, _ := args[2].(armasm.Reg)
+		v := c.reg(rn) * c.reg(rm)
+		switch op {
+		case armasm.MLA_EQ:
+			v += c.reg(args[3].(armasm.Reg))
+		case armasm.MLS_EQ:
+			v = c.reg(args[3].(armasm.Reg)) - v
+		}
+		if setFlags {
+			c.N, c.Z = v>>31 != 0, v == 0
+		}
+		c.setReg(rd, v)
+		return nil
+
+	case armasm.UMULL_EQ, armasm.SMULL_EQ, armasm.UMLAL_EQ, armasm.SMLAL_EQ:
+		lo, _ := args[0].(armasm.Reg)
+		hi, _ := args[1].(armasm.Reg)
+		rn, _ := args[2].(armasm.Reg)
+		rm, _ := args[3].(armasm.Reg)
+		var v uint64
+		if op == armasm.SMULL_EQ || op == armasm.SMLAL_EQ {
+			v = uint64(int64(int32(c.reg(rn))) * int64(int32(c.reg(rm))))
+		} else {
+			v = uint64(c.reg(rn)) * uint64(c.reg(rm))
+		}
+		if op == armasm.UMLAL_EQ || op == armasm.SMLAL_EQ {
+			v += uint64(c.reg(hi))<<32 | uint64(c.reg(lo))
+		}
+		if setFlags {
+			c.N, c.Z = v>>63 != 0, v == 0
+		}
+		c.setReg(lo, uint32(v))
+		c.setReg(hi, uint32(v>>32))
+		return nil
+
+	case armasm.MOVW_EQ, armasm.MOVT_EQ:
+		rd, _ := args[0].(armasm.Reg)
+		imm, _ := args[1].(armasm.Imm)
+		v := uint32(imm)
+		if op == armasm.MOVT_EQ {
+			v = v<<16 | c.reg(rd)&0xffff
+		}
+		c.setReg(rd, v)
+		return nil
+
+	case armasm.CLZ_EQ, armasm.REV_EQ, armasm.REV16_EQ, armasm.REVSH_EQ:
+		rd, _ := args[0].(armasm.Reg)
+		rm, _ := args[1].(armasm.Reg)
+		x := c.reg(rm)
+		var v uint32
+		switch op {
+		case armasm.CLZ_EQ:
+			v = uint32(bits.LeadingZeros32(x))
+		case armasm.REV_EQ:
+			v = bits.ReverseBytes32(x)
+		case armasm.REV16_EQ:
+			v = x>>8&0x00ff00ff | x<<8&0xff00ff00
+		case armasm.REVSH_EQ:
+			v = uint32(int32(int16(bits.ReverseBytes16(uint16(x)))))
+		}
+		c.setReg(rd, v)
+		return nil
+
+	case armasm.UXTB_EQ, armasm.UXTH_EQ, armasm.SXTB_EQ, armasm.SXTH_EQ:
+		rd, _ := args[0].(armasm.Reg)
+		var x uint32
+		switch arg := args[1].(type) {
+		case armasm.Reg:
+			x = c.reg(arg)
+		case armasm.RegShift:
+			x = bits.RotateLeft32(c.reg(arg.Reg), -int(arg.Count))
+		default:
+			return c.unsupported(inst)
+		}
+		switch op {
+		case armasm.UXTB_EQ:
+			x = x & 0xff
+		case armasm.UXTH_EQ:
+			x = x & 0xffff
+		case armasm.SXTB_EQ:
+			x = uint32(int32(int8(x)))
+		case armasm.SXTH_EQ:
+			x = uint32(int32(int16(x)))
+		}
+		c.setReg(rd, x)
+		return nil
+
+	case armasm.LDR_EQ, armasm.LDRB_EQ, armasm.LDRH_EQ, armasm.LDRSB_EQ, armasm.LDRSH_EQ, armasm.LDREX_EQ,
+		armasm.STR_EQ, armasm.STRB_EQ, armasm.STRH_EQ:
+		return c.loadStore(inst, op)
+
+	case armasm.LDRD_EQ, armasm.STRD_EQ:
+		return c.loadStoreDouble(inst, op)
+
+	case armasm.STREX_EQ:
+		// Without other processors the exclusive store always succeeds.
+		rd, _ := args[0].(armasm.Reg)
+		rt, _ := args[1].(armasm.Reg)
+		mem, _ := args[2].(armasm.Mem)
+		addr, _ := c.address(mem)
+		if err := c.write(addr, 4, c.reg(rt)); err != nil {
+			return err
+		}
+		c.setReg(rd, 0)
+		return nil
+
+	case armasm.LDM_EQ, armasm.LDMIB_EQ, armasm.LDMDA_EQ, armasm.LDMDB_EQ, armasm.POP_EQ,
+		armasm.STM_EQ, armasm.STMIB_EQ, armasm.STMDA_EQ, armasm.STMDB_EQ, armasm.PUSH_EQ:
+		return c.multiple(inst, op)
+
+	case armasm.B_EQ, armasm.BL_EQ:
+		rel, ok := args[0].(armasm.PCRel)
+		if !ok {
+			return c.unsupported(inst)
+		}
+		if op == armasm.BL_EQ {
+			c.R[14] = c.pc + 4
+		}
+		c.branch = true
+		c.R[15] = uint32(rel.Target(uint64(c.pc), armasm.ModeARM)) &^ 3
+		return nil
+
+	case armasm.BX_EQ, armasm.BLX_EQ:
+		switch arg := args[0].(type) {
+		case armasm.Reg:
+			target := c.reg(arg)
+			if op == armasm.BLX_EQ {
+				c.R[14] = c.pc + 4
+			}
+			c.setReg(armasm.PC, target)
+			return nil
+		case armasm.PCRel:
+			// BLX <label> always switches to Thumb state.
+			c.R[14] = c.pc + 4
+			c.setReg(armasm.PC, uint32(arg.Target(uint64(c.pc), armasm.ModeARM))|1)
+			return nil
+		}
+		return c.unsupported(inst)
+
+	case armasm.NOP_EQ:
+		return nil
+
+	case armasm.SVC_EQ, armasm.BKPT_EQ:
+		kind := TrapSVC
+		if op == armasm.BKPT_EQ {
+			kind = TrapBKPT
+		}
+		imm, _ := args[0].(armasm.Imm)
+		return c.trap(&Trap{Kind: kind, PC: c.pc, Imm: uint32(imm), Inst: inst})
+	}
+	return c.unsupported(inst)
+}
+
+// dataProc executes a data processing instruction.
+func (c *CPU) dataProc(inst armasm.Inst, op armasm.Op, setFlags bool) error {
+	args := inst.Args
+	var rd, rn armasm.Reg
+	var op2 armasm.Arg
+	switch op {
+	case armasm.MOV_EQ, armasm.MVN_EQ:
+		rd, _ = args[0].(armasm.Reg)
+		op2 = args[1]
+	case armasm.TST_EQ, armasm.TEQ_EQ, armasm.CMP_EQ, armasm.CMN_EQ:
+		rn, _ = args[0].(armasm.Reg)
+		op2 = args[1]
+		setFlags = true
+	default:
+		rd, _ = args[0].(armasm.Reg)
+		rn, _ = args[1].(armasm.Reg)
+		op2 = args[2]
+	}
+	y, carry, ok := c.operand(inst, op2)
+	if !ok {
+		return c.unsupported(inst)
+	}
+	x := c.reg(rn)
+	overflow := c.V
+	var v uint32
+	switch op {
+	case armasm.AND_EQ, armasm.TST_EQ:
+		v = x & y
+	case armasm.EOR_EQ, armasm.TEQ_EQ:
+		v = x ^ y
+	case armasm.ORR_EQ:
+		v = x | y
+	case armasm.BIC_EQ:
+		v = x &^ y
+	case armasm.MOV_EQ:
+		v = y
+	case armasm.MVN_EQ:
+		v = ^y
+	case armasm.ADD_EQ, armasm.CMN_EQ:
+		v, carry, overflow = addWithCarry(x, y, false)
+	case armasm.ADC_EQ:
+		v, carry, overflow = addWithCarry(x, y, c.C)
+	case armasm.SUB_EQ, armasm.CMP_EQ:
+		v, carry, overflow = addWithCarry(x, ^y, true)
+	case armasm.SBC_EQ:
+		v, carry, overflow = addWithCarry(x, ^y, c.C)
+	case armasm.RSB_EQ:
+		v, carry, overflow = addWithCarry(y, ^x, true)
+	case armasm.RSC_EQ:
+		v, carry, overflow = addWithCarry(y, ^x, c.C)
+	}
+	switch op {
+	case armasm.TST_EQ, armasm.TEQ_EQ, armasm.CMP_EQ, armasm.CMN_EQ:
+	default:
+		if setFlags && rd == armasm.PC {
+			// Exception return, which needs processor modes.
+			return c.unsupported(inst)
+		}
+		c.setReg(rd, v)
+	}
+	if setFlags {
+		c.N, c.Z, c.C, c.V = v>>31 != 0, v == 0, carry, overflow
+	}
+	return nil
+}
+
+// operand returns the value of the flexible second operand arg
+// and the carry out of the shifter.
+func (c *CPU) operand(inst armasm.Inst, arg armasm.Arg) (v uint32, carry, ok bool) {
+	switch arg := arg.(type) {
+	case armasm.Imm:
+		// The decoder reports the canonical encoding of a modified
+		// immediate as an Imm; its rotation, which determines
+		// the carry out, is in the encoding.
+		alt := armasm.ImmAlt{Val: uint8(inst.Enc), Rot: uint8(inst.Enc >> 8 & 0xf * 2)}
+		return uint32(arg), alt.Carry(c.C), true
+	case armasm.ImmAlt:
+		return uint32(arg.Imm()), arg.Carry(c.C), true
+	case armasm.Reg:
+		return c.reg(arg), c.C, true
+	case armasm.RegShift:
+		v, carry = shiftC(c.reg(arg.Reg), arg.Shift, uint32(arg.Count), c.C)
+		return v, carry, true
+	case armasm.RegShiftReg:
+		v, carry = shiftC(c.reg(arg.Reg), arg.Shift, c.reg(arg.RegCount)&0xff, c.C)
+		return v, carry, true
+	}
+	return 0, false, false
+}
+
+// shiftC returns v shifted by n and the carry out of the shift.
+// A shift by 0 returns v and the carry in, cin.
+func shiftC(v uint32, typ armasm.Shift, n uint32, cin bool) (uint32, bool) {
+	if n == 0 && typ != armasm.RotateRightExt {
+		return v, cin
+	}
+	switch typ {
+	case armasm.ShiftLeft:
+		switch {
+		case n < 32:
+			return v << n, v>>(32-n)&1 != 0
+		case n == 32:
+			return 0, v&1 != 0
+		}
+		return 0, false
+	case armasm.ShiftRight:
+		switch {
+		case n < 32:
+			return v >> n, v>>(n-1)&1 != 0
+		case n == 32:
+			return 0, v>>31 != 0
+		}
+		return 0, false
+	case armasm.ShiftRightSigned:
+		if n >= 32 {
+			return uint32(int32(v) >> 31), v>>31 != 0
+		}
+		return uint32(int32(v) >> n), v>>(n-1)&1 != 0
+	case armasm.RotateRight:
+		v = bits.RotateLeft32(v, -int(n&31))
+		return v, v>>31 != 0
+	case armasm.RotateRightExt:
+		var c uint32
+		if cin {
+			c = 1
+		}
+		return c<<31 | v>>1, v&1 != 0
+	}
+	return v, cin
+}
+
+// addWithCarry returns x + y + cin and the resulting carry and overflow flags.
+func addWithCarry(x, y uint32, cin bool) (v uint32, carry, overflow bool) {
+	var c uint64
+	if cin {
+		c = 1
+	}
+	sum := uint64(x) + uint64(y) + c
+	v = uint32(sum)
+	carry = sum>>32 != 0
+	overflow = (x^v)&(y^v)>>31 != 0
+	return v, carry, overflow
+}
+
+// address returns the address accessed by mem
+// and the value to write back to the base register.
+func (c *CPU) address(mem armasm.Mem) (addr, wb uint32) {
+	base := c.reg(mem.Base)
+	off := uint32(int32(mem.Offset))
+	if mem.Sign != 0 {
+		off, _ = shiftC(c.reg(mem.Index), mem.Shift, uint32(mem.Count), c.C)
+		if mem.Sign < 0 {
+			off = -off
+		}
+	}
+	switch mem.Mode {
+	case armasm.AddrPostIndex:
+		return base, base + off
+	case armasm.AddrPreIndex:
+		return base + off, base + off
+	}
+	return base + off, base
+}
+
+// loadStore executes a single-register load or store.
+func (c *CPU) loadStore(inst armasm.Inst, op armasm.Op) error {
+	rt, _ := inst.Args[0].(armasm.Reg)
+	mem, ok := inst.Args[1].(armasm.Mem)
+	if !ok {
+		return c.unsupported(inst)
+	}
+	addr, wb := c.address(mem)
+	size := 4
+	switch op {
+	case armasm.LDRB_EQ, armasm.STRB_EQ, armasm.LDRSB_EQ:
+		size = 1
+	case armasm.LDRH_EQ, armasm.STRH_EQ, armasm.LDRSH_EQ:
+		size = 2
+	}
+	switch op {
+	case armasm.STR_EQ, armasm.STRB_EQ, armasm.STRH_EQ:
+		if err := c.write(addr, size, c.reg(rt)); err != nil {
+			return err
+		}
+		c.writeback(mem, wb)
+		return nil
+	}
+	v, err := c.read(addr, size)
+	if err != nil {
+		return err
+	}
+	switch op {
+	case armasm.LDRSB_EQ:
+		v = uint32(int32(int8(v)))
+	case armasm.LDRSH_EQ:
+		v = uint32(int32(int16(v)))
+	}
+	c.writeback(mem, wb)
+	c.setReg(rt, v)
+	return nil
+}
+
+// loadStoreDouble executes LDRD or STRD.
+func (c *CPU) loadStoreDouble(inst armasm.Inst, op armasm.Op) error {
+	rt, _ := inst.Args[0].(armasm.Reg)
+	rt2, _ := inst.Args[1].(armasm.Reg)
+	mem, ok := inst.Args[2].(armasm.Mem)
+	if !ok {
+		return c.unsupported(inst)
+	}
+	addr, wb := c.address(mem)
+	if op == armasm.STRD_EQ {
+		if err := c.write(addr, 4, c.reg(rt)); err != nil {
+			return err
+		}
+		if err := c.write(addr+4, 4, c.reg(rt2)); err != nil {
+			return err
+		}
+		c.writeback(mem, wb)
+		return nil
+	}
+	lo, err := c.read(addr, 4)
+	if err != nil {
+		return err
+	}
+	hi, err := c.read(addr+4, 4)
+	if err != nil {
+		return err
+	}
+	c.writeback(mem, wb)
+	c.setReg(rt, lo)
+	c.setReg(rt2, hi)
+	return nil
+}
+
+func (c *CPU) writeback(mem armasm.Mem, wb uint32) {
+	if mem.Mode == armasm.AddrPreIndex || mem.Mode == armasm.AddrPostIndex {
+		c.setReg(mem.Base, wb)
+	}
+}
+
+// multiple executes a load or store multiple, including PUSH and POP.
+func (c *CPU) multiple(inst armasm.Inst, op armasm.Op) error {
+	var base armasm.Reg
+	var list armasm.RegList
+	writeback := true
+	switch op {
+	case armasm.PUSH_EQ, armasm.POP_EQ:
+		base = armasm.SP
+		list, _ = inst.Args[0].(armasm.RegList)
+		if op == armasm.PUSH_EQ {
+			op = armasm.STMDB_EQ
+		} else {
+			op = armasm.LDM_EQ
+		}
+	default:
+		mem, ok1 := inst.Args[0].(armasm.Mem)
+		l, ok2 := inst.Args[1].(armasm.RegList)
+		if !ok1 || !ok2 {
+			return c.unsupported(inst)
+		}
+		base, list = mem.Base, l
+		writeback = mem.Mode == armasm.AddrLDM_WB
+	}
+	n := uint32(bits.OnesCount16(uint16(list)))
+	b := c.reg(base)
+	var addr, wb uint32
+	switch op {
+	case armasm.LDM_EQ, armasm.STM_EQ:
+		addr, wb = b, b+4*n
+	case armasm.LDMIB_EQ, armasm.STMIB_EQ:
+		addr, wb = b+4, b+4*n
+	case armasm.LDMDA_EQ, armasm.STMDA_EQ:
+		addr, wb = b-4*n+4, b-4*n
+	case armasm.LDMDB_EQ, armasm.STMDB_EQ:
+		addr, wb = b-4*n, b-4*n
+	}
+	load := op == armasm.LDM_EQ || op == armasm.LDMIB_EQ || op == armasm.LDMDA_EQ || op == armasm.LDMDB_EQ
+	var vals [16]uint32
+	for r := armasm.R0; r <= armasm.R15; r++ {
+		if list&(1<<r) == 0 {
+			continue
+		}
+		if load {
+			v, err := c.read(addr, 4)
+			if err != nil {
+				return err
+			}
+			vals[r] = v
+		} else if err := c.write(addr, 4, c.reg(r)); err != nil {
+			return err
+		}
+		addr += 4
+	}
+	if writeback {
+		c.setReg(base, wb)
+	}
+	if load {
+		for r := armasm.R0; r <= armasm.R15; r++ {
+			if list&(1<<r) != 0 {
+				c.setReg(r, vals[r])
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,352 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armemu
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/bits"
+
+	"rsc.io/arm/armasm"
+)
+
+// armasm decodes only ARM mode and the Thumb BL pairs, so the emulator
+// executes the 16-bit Thumb instructions directly from their encodings,
+// as armasm.DecodeThumbBL does for BL. Outside an IT block, which is not
+// supported, the 16-bit data-processing instructions all set the flags,
+// except for the high-register forms of ADD and MOV.
+
+// stepThumb executes the Thumb instruction at pc.
+func (c *CPU) stepThumb(pc uint32) error {
+	var buf [4]byte
+	if _, err := c.Mem.ReadAt(buf[:2], int64(pc)); err != nil {
+		return &Fault{PC: pc, Addr: pc, Err: err}
+	}
+	x := uint32(binary.LittleEndian.Uint16(buf[:]))
+	if x>>11 < 0x1d {
+		return c.do(pc, 2, func() error { return c.execThumb(x) })
+	}
+
+	// A 32-bit instruction. Only BL and BLX pairs are supported.
+	if _, err := c.Mem.ReadAt(buf[2:], int64(pc+2)); err != nil {
+		return &Fault{PC: pc, Addr: pc + 2, Err: err}
+	}
+	inst, half, err := armasm.DecodeThumbBL(buf[:])
+	if err != nil || half {
+		return fmt.Errorf("unsupported Thumb instruction at %#x: %#08x", pc, binary.LittleEndian.Uint32(buf[:]))
+	}
+	return c.do(pc, 4, func() error {
+		target := uint32(inst.Args[0].(armasm.PCRel).Target(uint64(pc), armasm.ModeThumb))
+		c.R[14] = pc + 4 | 1
+		if inst.Op == armasm.BLX {
+			// BLX <label> always switches to ARM state.
+			c.setReg(armasm.PC, target&^3)
+			return nil
+		}
+		c.branchTo(target)
+		return nil
+	})
+}
+
+func (c *CPU) unsupportedThumb(x uint32) error {
+	return fmt.Errorf("unsupported Thumb instruction at %#x: %#04x", c.pc, x)
+}
+
+// thumbShifts maps the shift opcodes of the Thumb
+// data-processing instructions to shift types.
+var thumbShifts = [...]armasm.Shift{
+	0x2: armasm.ShiftLeft,
+	0x3: armasm.ShiftRight,
+	0x4: armasm.ShiftRightSigned,
+	0x7: armasm.RotateRight,
+}
+
+// execThumb executes the 16-bit Thumb instruction x.
+// The caller has set c.pc and set c.R[15] to c.pc+4.
+func (c *CPU) execThumb(x uint32) error {
+	// r returns the low register in the 3-bit field at bit n.
+	r := func(n uint) armasm.Reg { return armasm.Reg(x >> n & 7) }
+
+	switch {
+	case x>>11 < 3: // LSL, LSR, ASR (immediate); LSL #0 is MOVS
+		typ := thumbShifts[x>>11+2]
+		n := x >> 6 & 31
+		if n == 0 && typ != armasm.ShiftLeft {
+			n = 32
+		}
+		v, carry := shiftC(c.reg(r(3)), typ, n, c.C)
+		c.setReg(r(0), v)
+		c.N, c.Z, c.C = v>>31 != 0, v == 0, carry
+
+	case x>>11 == 3: // ADD, SUB (register or 3-bit immediate)
+		y := x >> 6 & 7
+		if x&(1<<10) == 0 {
+			y = c.reg(armasm.Reg(y))
+		}
+		c.setReg(r(0), c.addSub(c.reg(r(3)), y, x&(1<<9) != 0))
+
+	case x>>13 == 1: // MOV, CMP, ADD, SUB (8-bit immediate)
+		rdn, imm := r(8), x&0xff
+		switch x >> 11 & 3 {
+		case 0:
+			c.setReg(rdn, imm)
+			c.N, c.Z = false, imm == 0
+		case 1:
+			c.addSub(c.reg(rdn), imm, true)
+		case 2:
+			c.setReg(rdn, c.addSub(c.reg(rdn), imm, false))
+		case 3:
+			c.setReg(rdn, c.addSub(c.reg(rdn), imm, true))
+		}
+
+	case x>>10 == 0x10: // data processing
+		op, rdn := x>>6&15, r(0)
+		a, b := c.reg(rdn), c.reg(r(3))
+		v, carry, overflow := uint32(0), c.C, c.V
+		switch op {
+		case 0x0, 0x8: // AND, TST
+			v = a & b
+		case 0x1: // EOR
+			v = a ^ b
+		case 0x2, 0x3, 0x4, 0x7: // LSL, LSR, ASR, ROR
+			v, carry = shiftC(a, thumbShifts[op], b&0xff, c.C)
+		case 0x5: // ADC
+			v, carry, overflow = addWithCarry(a, b, c.C)
+		case 0x6: // SBC
+			v, carry, overflow = addWithCarry(a, ^b, c.C)
+		case 0x9: // RSB #0
+			v, carry, overflow = addWithCarry(0, ^b, true)
+		case 0xa: // CMP
+			v, carry, overflow = addWithCarry(a, ^b, true)
+		case 0xb: // CMN
+			v, carry, overflow = addWithCarry(a, b, false)
+		case 0xc: // ORR
+			v = a | b
+		case 0xd: // MUL
+			v = a * b
+		case 0xe: // BIC
+			v = a &^ b
+		case 0xf: // MVN
+			v = ^b
+		}
+		if op != 0x8 && op != 0xa && op != 0xb {
+			c.setReg(rdn, v)
+		}
+		c.N, c.Z, c.C, c.V = v>>31 != 0, v == 0, carry, overflow
+
+	case x>>10 == 0x11: // ADD, CMP, MOV (high registers), BX, BLX
+		rdn := armasm.Reg(x>>4&8 | x&7)
+		rm := armasm.Reg(x >> 3 & 15)
+		switch x >> 8 & 3 {
+		case 0:
+			c.setRegThumb(rdn, c.reg(rdn)+c.reg(rm))
+		case 1:
+			c.addSub(c.reg(rdn), c.reg(rm), true)
+		case 2:
+			c.setRegThumb(rdn, c.reg(rm))
+		case 3:
+			target := c.reg(rm)
+			if x&0x80 != 0 {
+				c.R[14] = c.pc + 2 | 1
+			}
+			c.setReg(armasm.PC, target)
+		}
+
+	case x>>11 == 0x9: // LDR (literal)
+		v, err := c.read(c.R[15]&^3+(x&0xff)*4, 4)
+		if err != nil {
+			return err
+		}
+		c.setReg(r(8), v)
+
+	case x>>12 == 0x5: // load and store (register offset)
+		return c.loadStoreThumb(x>>9&7, r(0), c.reg(r(3))+c.reg(r(6)))
+
+	case x>>13 == 0x3: // LDR, STR, LDRB, STRB (immediate)
+		imm := x >> 6 & 31
+		op := uint32(0) // STR
+		if x&(1<<12) != 0 {
+			op = 2 // STRB
+		} else {
+			imm *= 4
+		}
+		if x&(1<<11) != 0 {
+			op += 4 // LDR, LDRB
+		}
+		return c.loadStoreThumb(op, r(0), c.reg(r(3))+imm)
+
+	case x>>12 == 0x8: // LDRH, STRH (immediate)
+		op := uint32(1) // STRH
+		if x&(1<<11) != 0 {
+			op = 5 // LDRH
+		}
+		return c.loadStoreThumb(op, r(0), c.reg(r(3))+(x>>6&31)*2)
+
+	case x>>12 == 0x9: // LDR, STR (SP-relative)
+		op := uint32(0) // STR
+		if x&(1<<11) != 0 {
+			op = 4 // LDR
+		}
+		return c.loadStoreThumb(op, r(8), c.R[13]+(x&0xff)*4)
+
+	case x>>11 == 0x14: // ADR
+		c.setReg(r(8), c.R[15]&^3+(x&0xff)*4)
+
+	case x>>11 == 0x15: // ADD Rd, SP, #imm
+		c.setReg(r(8), c.R[13]+(x&0xff)*4)
+
+	case x>>12 == 0xb:
+		return c.miscThumb(x)
+
+	case x>>12 == 0xc: // STM, LDM
+		rn := r(8)
+		list := armasm.RegList(x & 0xff)
+		mem := armasm.Mem{Base: rn, Mode: armasm.AddrLDM_WB}
+		op := armasm.STM_EQ
+		if x&(1<<11) != 0 {
+			op = armasm.LDM_EQ
+			if list&(1<<rn) != 0 {
+				mem.Mode = armasm.AddrLDM
+			}
+		}
+		return c.multiple(armasm.Inst{Args: armasm.Args{mem, list}}, op)
+
+	case x>>12 == 0xd: // B<c>, UDF, SVC
+		inst := armasm.Inst{Enc: x, Len: 2, Mode: armasm.ModeThumb, Args: armasm.Args{armasm.Imm(x & 0xff)}}
+		switch cond := x >> 8 & 15; cond {
+		case 0xe:
+			inst.Op = armasm.UNDEF
+			return c.trap(&Trap{Kind: TrapUndef, PC: c.pc, Inst: inst})
+		case 0xf:
+			inst.Op = armasm.SVC
+			return c.trap(&Trap{Kind: TrapSVC, PC: c.pc, Imm: x & 0xff, Inst: inst})
+		default:
+			if armasm.EvalCond(uint8(cond), c.APSR()) {
+				c.branchTo(c.R[15] + uint32(int32(int8(x))<<1))
+			}
+		}
+
+	case x>>11 == 0x1c: // B
+		c.branchTo(c.R[15] + uint32(int32(x<<21)>>20))
+
+	default:
+		return c.unsupportedThumb(x)
+	}
+	return nil
+}
+
+// miscThumb executes the 16-bit Thumb instruction x,
+// which is one of the miscellaneous instructions, 1011xxxx_xxxxxxxx.
+func (c *CPU) miscThumb(x uint32) error {
+	rd, rm := armasm.Reg(x&7), armasm.Reg(x>>3&7)
+	switch {
+	case x&0xff00 == 0xb000: // ADD, SUB SP, SP, #imm
+		imm := (x & 0x7f) * 4
+		if x&0x80 != 0 {
+			imm = -imm
+		}
+		c.R[13] += imm
+
+	case x&0xf500 == 0xb100: // CBZ, CBNZ
+		if (c.reg(rd) != 0) == (x&(1<<11) != 0) {
+			c.branchTo(c.R[15] + (x>>9&1)<<6 + (x>>3&31)<<1)
+		}
+
+	case x&0xff00 == 0xb200: // SXTH, SXTB, UXTH, UXTB
+		v := c.reg(rm)
+		switch x >> 6 & 3 {
+		case 0:
+			v = uint32(int16(v))
+		case 1:
+			v = uint32(int8(v))
+		case 2:
+			v = uint32(uint16(v))
+		case 3:
+			v = uint32(uint8(v))
+		}
+		c.setReg(rd, v)
+
+	case x&0xfe00 == 0xb400: // PUSH
+		list := armasm.RegList(x&0xff | x>>8&1<<14)
+		return c.multiple(armasm.Inst{Args: armasm.Args{list}}, armasm.PUSH_EQ)
+
+	case x&0xfe00 == 0xbc00: // POP
+		list := armasm.RegList(x&0xff | x>>8&1<<15)
+		return c.multiple(armasm.Inst{Args: armasm.Args{list}}, armasm.POP_EQ)
+
+	case x&0xff00 == 0xba00 && x>>6&3 != 2: // REV, REV16, REVSH
+		v := c.reg(rm)
+		switch x >> 6 & 3 {
+		case 0:
+			v = bits.ReverseBytes32(v)
+		case 1:
+			v = v>>8&0x00ff00ff | v<<8&0xff00ff00
+		case 3:
+			v = uint32(int16(bits.ReverseBytes16(uint16(v))))
+		}
+		c.setReg(rd, v)
+
+	case x&0xff00 == 0xbe00: // BKPT
+		inst := armasm.Inst{Op: armasm.BKPT, Enc: x, Len: 2, Mode: armasm.ModeThumb, Args: armasm.Args{armasm.Imm(x & 0xff)}}
+		return c.trap(&Trap{Kind: TrapBKPT, PC: c.pc, Imm: x & 0xff, Inst: inst})
+
+	case x&0xff0f == 0xbf00: // NOP, YIELD, WFE, WFI, SEV
+
+	default:
+		// IT, CPS, SETEND, and the reserved encodings.
+		return c.unsupportedThumb(x)
+	}
+	return nil
+}
+
+// loadStoreThumb executes the load or store with the register-offset
+// opcode op (STR, STRH, STRB, LDRSB, LDR, LDRH, LDRB, LDRSH)
+// of the register rt at addr.
+func (c *CPU) loadStoreThumb(op uint32, rt armasm.Reg, addr uint32) error {
+	size := [...]int{4, 2, 1, 1, 4, 2, 1, 2}[op]
+	if op < 3 {
+		return c.write(addr, size, c.reg(rt))
+	}
+	v, err := c.read(addr, size)
+	if err != nil {
+		return err
+	}
+	switch op {
+	case 3:
+		v = uint32(int8(v))
+	case 7:
+		v = uint32(int16(v))
+	}
+	c.setReg(rt, v)
+	return nil
+}
+
+// addSub returns x+y, or x-y if sub is set, and sets the flags.
+func (c *CPU) addSub(x, y uint32, sub bool) uint32 {
+	var v uint32
+	if sub {
+		v, c.C, c.V = addWithCarry(x, ^y, true)
+	} else {
+		v, c.C, c.V = addWithCarry(x, y, false)
+	}
+	c.N, c.Z = v>>31 != 0, v == 0
+	return v
+}
+
+// setRegThumb sets r to v. Unlike setReg, a write to PC
+// is a branch without interworking, staying in Thumb state.
+func (c *CPU) setRegThumb(r armasm.Reg, v uint32) {
+	if r&15 != 15 {
+		c.R[r&15] = v
+		return
+	}
+	c.branchTo(v)
+}
+
+// branchTo branches to the Thumb address addr.
+func (c *CPU) branchTo(addr uint32) {
+	c.branch = true
+	c.R[15] = addr &^ 1
+}
@@ -0,0 +1,215 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armfunc
+
+import (
+	"fmt"
+	"sort"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armcfg"
+	"rsc.io/arm/armdis"
+	"rsc.io/arm/arminst"
+)
+
+// An ExclusivePair is an exclusive load matched with an exclusive store
+// that it reaches without passing another exclusive access.
+type ExclusivePair struct {
+	Load  uint64 // address of the LDREX
+	Store uint64 // address of the STREX
+}
+
+// An ExclusiveProblemKind describes a malformed exclusive access sequence.
+type ExclusiveProblemKind uint8
+
+const (
+	ExclusiveAccess   ExclusiveProblemKind = iota // memory access or call between LDREX and STREX
+	ExclusiveNoClear                              // path leaves the function after LDREX without STREX or CLREX
+	ExclusiveMismatch                             // STREX size or address register differs from the LDREX
+	ExclusiveOrphan                               // STREX not preceded by any LDREX
+)
+
+var exclusiveProblemKindName = [...]string{
+	ExclusiveAccess:   "access",
+	ExclusiveNoClear:  "no-clear",
+	ExclusiveMismatch: "mismatch",
+	ExclusiveOrphan:   "orphan",
+}
+
+func (k ExclusiveProblemKind) String() string {
+	if int(k) < len(exclusiveProblemKindName) {
+		return exclusiveProblemKindName[k]
+	}
+	return fmt.Sprintf("ExclusiveProblemKind(%d)", int(k))
+}
+
+// An ExclusiveProblem is a single malformed exclusive access sequence.
+type ExclusiveProblem struct {
+	Kind ExclusiveProblemKind
+	Addr uint64 // address of the offending instruction
+	Load uint64 // address of the LDREX, or 0 for ExclusiveOrphan
+}
+
+func (p ExclusiveProblem) String() string {
+	if p.Kind == ExclusiveOrphan {
+		return fmt.Sprintf("%#x: %s", p.Addr, p.Kind)
+	}
+	return fmt.Sprintf("%#x: %s (LDREX at %#x)", p.Addr, p.Kind, p.Load)
+}
+
+// Exclusives describes the exclusive access sequences in a function.
+type Exclusives struct {
+	Pairs    []ExclusivePair    // sorted by Load, then Store
+	Problems []ExclusiveProblem // sorted by Load, then Addr
+}
+
+// CheckExclusives matches the exclusive loads (LDREX, LDREXB, LDREXH, LDREXD)
+// in fn with the exclusive stores they reach along the function's control flow,
+// reporting as problems any memory accesses or calls between a load and its store,
+// paths that leave the function after a load without a store or CLREX,
+// stores that do not match the size or address register of their load,
+// and stores that no load reaches.
+// A path reaching another exclusive load is taken to abandon the first.
+func CheckExclusives(fn *Func) *Exclusives {
+	ex := &Exclusives{}
+	if len(fn.Insts) == 0 {
+		return ex
+	}
+	m := &armdis.Map{PC: fn.Insts[0].Start, Mode: fn.Mode, Ranges: fn.Insts}
+	g := armcfg.Build(m)
+
+	// succs returns the instructions following the i'th instruction of b.
+	type pos struct {
+		b *armcfg.Block
+		i int
+	}
+	succs := func(p pos) (next []pos, exits bool) {
+		if p.i+1 < len(p.b.Insts) {
+			return []pos{{p.b, p.i + 1}}, false
+		}
+		exits = p.b.Flow.Kind == armdis.FlowReturn || len(p.b.Succs) == 0
+		for _, e := range p.b.Succs {
+			if e.To == nil {
+				exits = true
+				continue
+			}
+			next = append(next, pos{e.To, 0})
+		}
+		return next, exits
+	}
+
+	reached := make(map[uint64]bool) // stores reached by some load
+	problems := make(map[ExclusiveProblem]bool)
+	for _, b := range g.Blocks {
+		for i, r := range b.Insts {
+			if !isExclusiveLoad(r.Inst) {
+				continue
+			}
+			load := r
+			seen := make(map[pos]bool)
+			next, exits := succs(pos{b, i})
+			if exits {
+				problems[ExclusiveProblem{ExclusiveNoClear, load.Start, load.Start}] = true
+			}
+			work := next
+			for len(work) > 0 {
+				p := work[len(work)-1]
+				work = work[:len(work)-1]
+				if seen[p] {
+					continue
+				}
+				seen[p] = true
+				r := p.b.Insts[p.i]
+				inst := r.Inst
+				switch {
+				case isExclusiveStore(inst):
+					reached[r.Start] = true
+					ex.Pairs = append(ex.Pairs, ExclusivePair{load.Start, r.Start})
+					if !exclusiveMatch(load.Inst, inst) {
+						problems[ExclusiveProblem{ExclusiveMismatch, r.Start, load.Start}] = true
+					}
+					continue
+				case inst.Op == armasm.CLREX, isExclusiveLoad(inst):
+					continue
+				}
+				a := arminst.ARM{Inst: inst, Mode: fn.Mode}
+				switch a.Class() {
+				case arminst.ClassLoad, arminst.ClassStore, arminst.ClassCall, arminst.ClassIndirectCall:
+					problems[ExclusiveProblem{ExclusiveAccess, r.Start, load.Start}] = true
+				}
+				next, exits := succs(p)
+				if exits {
+					problems[ExclusiveProblem{ExclusiveNoClear, r.Start, load.Start}] = true
+				}
+				work = append(work, next...)
+			}
+		}
+	}
+	for _, r := range fn.Insts {
+		if isExclusiveStore(r.Inst) && !reached[r.Start] {
+			problems[ExclusiveProblem{Kind: ExclusiveOrphan, Addr: r.Start}] = true
+		}
+	}
+
+	for p := range problems {
+		ex.Problems = append(ex.Problems, p)
+	}
+	sort.Slice(ex.Problems, func(i, j int) bool {
+		p, q := ex.Problems[i], ex.Problems[j]
+		if p.Load != q.Load {
+			return p.Load < q.Load
+		}
+		if p.Addr != q.Addr {
+			return p.Addr < q.Addr
+		}
+		return p.Kind < q.Kind
+	})
+	sort.Slice(ex.Pairs, func(i, j int) bool {
+		p, q := ex.Pairs[i], ex.Pairs[j]
+		if p.Load != q.Load {
+			return p.Load < q.Load
+		}
+		return p.Store < q.Store
+	})
+	return ex
+}
+
+func isExclusiveLoad(inst armasm.Inst) bool {
+	switch inst.Op &^ 15 {
+	case armasm.LDREX_EQ, armasm.LDREXB_EQ, armasm.LDREXH_EQ, armasm.LDREXD_EQ:
+		return true
+	}
+	return false
+}
+
+func isExclusiveStore(inst armasm.Inst) bool {
+	switch inst.Op &^ 15 {
+	case armasm.STREX_EQ, armasm.STREXB_EQ, armasm.STREXH_EQ, armasm.STREXD_EQ:
+		return true
+	}
+	return false
+}
+
+// exclusiveMatch reports whether the exclusive store st
+// accesses the same size and address register as the exclusive load ld.
+func exclusiveMatch(ld, st armasm.Inst) bool {
+	size := map[armasm.Op]armasm.Op{
+		armasm.LDREX_EQ:  armasm.STREX_EQ,
+		armasm.LDREXB_EQ: armasm.STREXB_EQ,
+		armasm.LDREXH_EQ: armasm.STREXH_EQ,
+		armasm.LDREXD_EQ: armasm.STREXD_EQ,
+	}
+	return size[ld.Op&^15] == st.Op&^15 && memBase(ld) == memBase(st)
+}
+
+// memBase returns the base register of inst's memory operand.
+func memBase(inst armasm.Inst) armasm.Reg {
+	for _, arg := range inst.Args {
+		if mem, ok := arg.(armasm.Mem); ok {
+			return mem.Base
+		}
+	}
+	return armasm.PC // no memory operand
+}
@@ -0,0 +1,42 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armfunc
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestCheckExclusives(t *testing.T) {
+	ex := CheckExclusives(linearFunc(
+		0xe1902f9f, // 0x00: ldrex r2, [r0]
+		0xe0822001, // 0x04: add r2, r2, r1
+		0xe1803f92, // 0x08: strex r3, r2, [r0]
+		0xe3530000, // 0x0c: cmp r3, #0
+		0x1afffffa, // 0x10: bne 0x00
+		0xe12fff1e, // 0x14: bx lr
+	))
+	if want := []ExclusivePair{{0x00, 0x08}}; !reflect.DeepEqual(ex.Pairs, want) || len(ex.Problems) != 0 {
+		t.Errorf("atomic add: Pairs = %v, Problems = %v, want %v and none", ex.Pairs, ex.Problems, want)
+	}
+
+	ex = CheckExclusives(linearFunc(
+		0xe1903f9f, // 0x00: ldrex r3, [r0]
+		0xe1530001, // 0x04: cmp r3, r1
+		0x112fff1e, // 0x08: bxne lr
+		0xe5854000, // 0x0c: str r4, [r5]
+		0xe1c0cf92, // 0x10: strexb ip, r2, [r0]
+		0xe12fff1e, // 0x14: bx lr
+		0xe181cf92, // 0x18: strex ip, r2, [r1]
+	))
+	if want := []ExclusivePair{{0x00, 0x10}}; !reflect.DeepEqual(ex.Pairs, want) {
+		t.Errorf("Pairs = %v, want %v", ex.Pairs, want)
+	}
+	want := "[0x8: no-clear (LDREX at 0x0) 0xc: access (LDREX at 0x0) 0x10: mismatch (LDREX at 0x0) 0x18: orphan]"
+	if got := fmt.Sprint(ex.Problems); got != want {
+		t.Errorf("Problems:\n%s\nwant:\n%s", got, want)
+	}
+}
@@ -0,0 +1,293 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package armfunc implements analyses of individual ARM functions.
+//
+// Each analysis takes a Func, the decoded instructions of a single function.
+package armfunc
+
+import (
+	"fmt"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armdis"
+)
+
+// A Func is the code of a single function.
+type Func struct {
+	Mode  armasm.Mode
+	Insts []armdis.Range // Code ranges, in address order
+}
+
+// FuncAt returns the function made up of the instructions in m
+// with addresses in the range [start, end).
+func FuncAt(m *armdis.Map, start, end uint64) *Func {
+	fn := &Func{Mode: m.Mode}
+	for _, r := range m.Ranges {
+		if r.Kind == armdis.Code && start <= r.Start && r.Start < end {
+			fn.Insts = append(fn.Insts, r)
+		}
+	}
+	return fn
+}
+
+// A FrameKind describes how a function addresses its stack frame.
+type FrameKind uint8
+
+const (
+	FrameNone FrameKind = iota // no stack frame
+	FrameSP                    // frame addressed relative to SP
+	FrameFP                    // frame addressed relative to a frame pointer
+)
+
+var frameKindName = [...]string{
+	FrameNone: "none",
+	FrameSP:   "sp",
+	FrameFP:   "fp",
+}
+
+func (k FrameKind) String() string {
+	if int(k) < len(frameKindName) {
+		return frameKindName[k]
+	}
+	return fmt.Sprintf("FrameKind(%d)", int(k))
+}
+
+// An Epilogue is a sequence of instructions tearing down the frame
+// and returning from the function.
+type Epilogue struct {
+	Start  uint64 // address of first epilogue instruction
+	Return uint64 // address of the returning instruction
+}
+
+// A Frame describes the prologue, epilogues, and frame layout of a function.
+// Offsets are relative to the value of SP on entry to the function,
+// so saved registers and locals have negative offsets.
+type Frame struct {
+	Kind        FrameKind
+	PrologueEnd uint64             // address of the first instruction after the prologue
+	Epilogues   []Epilogue         // epilogues, in address order
+	Saved       armasm.RegList     // core registers saved by the prologue, including LR
+	SaveOffset  map[armasm.Reg]int // stack offset of each saved register, including VPUSH's S and D registers
+	FP          armasm.Reg         // frame pointer register, for FrameFP
+	FPOffset    int                // stack offset held in FP after the prologue, for FrameFP
+	Locals      int                // bytes allocated by explicit SP adjustment after saving registers
+	Size        int                // total bytes below the entry SP at the end of the prologue
+}
+
+// Prologue recognizes the prologue and epilogues of fn.
+// The prologue is the leading run of instructions that save registers,
+// set up a frame pointer, or allocate stack space.
+func Prologue(fn *Func) *Frame {
+	insts := fn.Insts
+	fr := &Frame{SaveOffset: make(map[armasm.Reg]int)}
+	if len(insts) > 0 {
+		fr.PrologueEnd = insts[0].Start
+	}
+	sp := 0                                  // current SP offset from entry SP
+	regs := map[armasm.Reg]int{armasm.SP: 0} // registers known to hold entry SP + offset
+	for _, r := range insts {
+		inst := r.Inst
+		if !always(inst) {
+			break
+		}
+		if list, ok := pushList(inst); ok {
+			n := countRegs(list)
+			sp -= 4 * n
+			off := sp
+			for i := 0; i < 16; i++ {
+				if list&(1<<uint(i)) != 0 {
+					if fr.Saved&(1<<uint(i)) == 0 {
+						fr.SaveOffset[armasm.Reg(i)] = off
+					}
+					off += 4
+				}
+			}
+			fr.Saved |= list
+			regs[armasm.SP] = sp
+			fr.PrologueEnd = r.End
+			continue
+		}
+		if n, ok := vpushSize(inst); ok {
+			sp -= n
+			rr := inst.Args[0].(armasm.RegRange)
+			for i, reg := range rr.Regs() {
+				if _, saved := fr.SaveOffset[reg]; !saved {
+					fr.SaveOffset[reg] = sp + i*n/int(rr.Count)
+				}
+			}
+			regs[armasm.SP] = sp
+			fr.PrologueEnd = r.End
+			continue
+		}
+		if dst, src, delta, ok := addImm(inst); ok {
+			base, known := regs[src]
+			if !known {
+				break
+			}
+			if dst == armasm.SP {
+				if delta > 0 || src != armasm.SP {
+					break
+				}
+				fr.Locals -= delta
+				sp = base + delta
+				regs[armasm.SP] = sp
+				fr.PrologueEnd = r.End
+				continue
+			}
+			if dst == armasm.R11 || dst == armasm.R7 || dst == armasm.R12 {
+				regs[dst] = base + delta
+				if dst != armasm.R12 {
+					fr.Kind = FrameFP
+					fr.FP = dst
+					fr.FPOffset = base + delta
+				}
+				fr.PrologueEnd = r.End
+				continue
+			}
+		}
+		break
+	}
+	fr.Size = -sp
+	if fr.Kind == FrameNone && fr.Size > 0 {
+		fr.Kind = FrameSP
+	}
+
+	// Find epilogues by walking backward from each return
+	// over instructions that restore SP or pop saved registers.
+	for i, r := range insts {
+		f := armdis.Classify(r.Inst, r.Start, fn.Mode)
+		if f.Kind != armdis.FlowReturn {
+			continue
+		}
+		start := i
+		for start > 0 && isEpilogueInst(insts[start-1].Inst) && !isReturn(insts[start-1], fn.Mode) {
+			start--
+		}
+		fr.Epilogues = append(fr.Epilogues, Epilogue{Start: insts[start].Start, Return: r.Start})
+	}
+	return fr
+}
+
+// isReturn reports whether r is a return instruction.
+func isReturn(r armdis.Range, mode armasm.Mode) bool {
+	return armdis.Classify(r.Inst, r.Start, mode).Kind == armdis.FlowReturn
+}
+
+// always reports whether inst executes unconditionally.
+func always(inst armasm.Inst) bool {
+	return inst.Enc>>28 >= 0xE
+}
+
+// isEpilogueInst reports whether inst is one that commonly
+// appears in an epilogue: restoring SP or popping registers.
+func isEpilogueInst(inst armasm.Inst) bool {
+	if _, ok := popList(inst); ok {
+		return true
+	}
+	if _, ok := vpopSize(inst); ok {
+		return true
+	}
+	dst, _, _, ok := addImm(inst)
+	return ok && dst == armasm.SP
+}
+
+// pushList returns the registers stored by inst
+// if it is a PUSH or an equivalent STMDB SP!.
+func pushList(inst armasm.Inst) (armasm.RegList, bool) {
+	switch inst.Op &^ 15 {
+	case armasm.PUSH_EQ:
+		list, ok := inst.Args[0].(armasm.RegList)
+		return list, ok
+	case armasm.STMDB_EQ:
+		mem, ok := inst.Args[0].(armasm.Mem)
+		list, ok1 := inst.Args[1].(armasm.RegList)
+		if ok && ok1 && mem.Base == armasm.SP && mem.Mode == armasm.AddrLDM_WB {
+			return list, true
+		}
+	}
+	return 0, false
+}
+
+// popList returns the registers loaded by inst
+// if it is a POP or an equivalent LDM SP!.
+func popList(inst armasm.Inst) (armasm.RegList, bool) {
+	switch inst.Op &^ 15 {
+	case armasm.POP_EQ:
+		list, ok := inst.Args[0].(armasm.RegList)
+		return list, ok
+	case armasm.LDM_EQ:
+		mem, ok := inst.Args[0].(armasm.Mem)
+		list, ok1 := inst.Args[1].(armasm.RegList)
+		if ok && ok1 && mem.Base == armasm.SP && mem.Mode == armasm.AddrLDM_WB {
+			return list, true
+		}
+	}
+	return 0, false
+}
+
+// vpushSize returns the number of bytes stored by inst if it is a VPUSH.
+func vpushSize(inst armasm.Inst) (int, bool) {
+	if inst.Op&^15 != armasm.VPUSH_EQ {
+		return 0, false
+	}
+	return rangeSize(inst.Args[0])
+}
+
+// vpopSize returns the number of bytes loaded by inst if it is a VPOP.
+func vpopSize(inst armasm.Inst) (int, bool) {
+	if inst.Op&^15 != armasm.VPOP_EQ {
+		return 0, false
+	}
+	return rangeSize(inst.Args[0])
+}
+
+// rangeSize returns the number of bytes occupied in memory
+// by the floating-point registers in arg, a RegRange.
+func rangeSize(arg armasm.Arg) (int, bool) {
+	r, ok := arg.(armasm.RegRange)
+	if !ok {
+		return 0, false
+	}
+	if r.First.Class() == armasm.ClassDouble {
+		return 8 * int(r.Count), true
+	}
+	return 4 * int(r.Count), true
+}
+
+// addImm reports whether inst is an ADD, SUB, or MOV
+// computing dst = src + delta for a constant delta.
+// The instruction may be conditional.
+func addImm(inst armasm.Inst) (dst, src armasm.Reg, delta int, ok bool) {
+	switch inst.Op &^ 15 {
+	case armasm.ADD_EQ, armasm.SUB_EQ:
+		dst, ok1 := inst.Args[0].(armasm.Reg)
+		src, ok2 := inst.Args[1].(armasm.Reg)
+		v, ok3 := armdis.ImmValue(inst.Args[2])
+		if !ok1 || !ok2 || !ok3 {
+			return 0, 0, 0, false
+		}
+		if inst.Op&^15 == armasm.SUB_EQ {
+			v = -v
+		}
+		return dst, src, int(v), true
+	case armasm.MOV_EQ:
+		dst, ok1 := inst.Args[0].(armasm.Reg)
+		src, ok2 := inst.Args[1].(armasm.Reg)
+		if !ok1 || !ok2 {
+			return 0, 0, 0, false
+		}
+		return dst, src, 0, true
+	}
+	return 0, 0, 0, false
+}
+
+// countRegs returns the number of registers in list.
+func countRegs(list armasm.RegList) int {
+	n := 0
+	for ; list != 0; list &= list - 1 {
+		n++
+	}
+	return n
+}
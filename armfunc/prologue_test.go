@@ -0,0 +1,84 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armfunc
+
+import (
+	"testing"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armdis"
+	"rsc.io/arm/internal/armtest"
+)
+
+// linearFunc decodes ws at address 0 as a single ARM function.
+func linearFunc(ws ...uint32) *Func {
+	m := armdis.Linear(armtest.Words(ws...), 0, armasm.ModeARM)
+	return FuncAt(m, 0, uint64(4*len(ws)))
+}
+
+func TestPrologueFP(t *testing.T) {
+	fn := linearFunc(
+		0xe92d4800, // 0x00: push {fp, lr}
+		0xe28db004, // 0x04: add fp, sp, #4
+		0xe24dd010, // 0x08: sub sp, sp, #16
+		0xe3a00000, // 0x0c: mov r0, #0
+		0xe24bd004, // 0x10: sub sp, fp, #4
+		0xe8bd8800, // 0x14: pop {fp, pc}
+	)
+	fr := Prologue(fn)
+	if fr.Kind != FrameFP || fr.FP != armasm.R11 || fr.FPOffset != -4 {
+		t.Errorf("frame = %v %v %d, want fp R11 -4", fr.Kind, fr.FP, fr.FPOffset)
+	}
+	if fr.PrologueEnd != 0x0c || fr.Size != 24 || fr.Locals != 16 {
+		t.Errorf("PrologueEnd=%#x Size=%d Locals=%d, want 0xc 24 16", fr.PrologueEnd, fr.Size, fr.Locals)
+	}
+	if fr.Saved != 1<<11|1<<14 || fr.SaveOffset[armasm.LR] != -4 || fr.SaveOffset[armasm.R11] != -8 {
+		t.Errorf("Saved=%v SaveOffset=%v", fr.Saved, fr.SaveOffset)
+	}
+	if len(fr.Epilogues) != 1 || fr.Epilogues[0] != (Epilogue{0x10, 0x14}) {
+		t.Errorf("Epilogues = %v", fr.Epilogues)
+	}
+}
+
+func TestPrologueSP(t *testing.T) {
+	fn := linearFunc(
+		0xe92d4030, // 0x00: push {r4, r5, lr}
+		0xe24dd008, // 0x04: sub sp, sp, #8
+		0xe3500000, // 0x08: cmp r0, #0
+		0x028dd008, // 0x0c: addeq sp, sp, #8
+		0x08bd8030, // 0x10: popeq {r4, r5, pc}
+		0xe28dd008, // 0x14: add sp, sp, #8
+		0xe8bd8030, // 0x18: pop {r4, r5, pc}
+	)
+	fr := Prologue(fn)
+	if fr.Kind != FrameSP || fr.Size != 20 || fr.PrologueEnd != 0x08 {
+		t.Errorf("Kind=%v Size=%d PrologueEnd=%#x, want sp 20 0x8", fr.Kind, fr.Size, fr.PrologueEnd)
+	}
+	if len(fr.Epilogues) != 2 || fr.Epilogues[0] != (Epilogue{0x0c, 0x10}) || fr.Epilogues[1] != (Epilogue{0x14, 0x18}) {
+		t.Errorf("Epilogues = %v", fr.Epilogues)
+	}
+}
+
+func TestPrologueVPUSH(t *testing.T) {
+	fn := linearFunc(
+		0xe92d4010, // 0x00: push {r4, lr}
+		0xed2d8b04, // 0x04: vpush {d8, d9}
+		0xe24dd008, // 0x08: sub sp, sp, #8
+		0xe3a00000, // 0x0c: mov r0, #0
+		0xe28dd008, // 0x10: add sp, sp, #8
+		0xecbd8b04, // 0x14: vpop {d8, d9}
+		0xe8bd8010, // 0x18: pop {r4, pc}
+	)
+	fr := Prologue(fn)
+	if fr.Kind != FrameSP || fr.Size != 32 || fr.Locals != 8 || fr.PrologueEnd != 0x0c {
+		t.Errorf("Kind=%v Size=%d Locals=%d PrologueEnd=%#x, want sp 32 8 0xc", fr.Kind, fr.Size, fr.Locals, fr.PrologueEnd)
+	}
+	if fr.Saved != 1<<4|1<<14 || fr.SaveOffset[armasm.D8] != -24 || fr.SaveOffset[armasm.D9] != -16 || fr.SaveOffset[armasm.R4] != -8 {
+		t.Errorf("Saved=%v SaveOffset=%v", fr.Saved, fr.SaveOffset)
+	}
+	if len(fr.Epilogues) != 1 || fr.Epilogues[0] != (Epilogue{0x10, 0x18}) {
+		t.Errorf("Epilogues = %v", fr.Epilogues)
+	}
+}
@@ -0,0 +1,228 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armfunc
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armcfg"
+	"rsc.io/arm/armdis"
+	"rsc.io/arm/arminst"
+)
+
+// A Signature is a rough estimate of a function's AAPCS signature,
+// inferred from the argument registers it reads before writing them
+// and the result registers it defines before returning.
+type Signature struct {
+	IntArgs   int // number of core argument registers used, 0 to 4 (R0-R3)
+	FloatArgs int // number of single-precision VFP argument registers used, 0 to 16 (S0-S15)
+	StackArgs int // bytes of incoming stack arguments read
+	Result    int // bytes of core register result: 0, 4 (R0) or 8 (R0:R1)
+}
+
+// String returns the signature in a form like "(R0, R1, S0-S3, SP+8) R0:R1".
+func (s *Signature) String() string {
+	var args []string
+	for i := 0; i < s.IntArgs; i++ {
+		args = append(args, fmt.Sprintf("R%d", i))
+	}
+	switch s.FloatArgs {
+	case 0:
+	case 1:
+		args = append(args, "S0")
+	default:
+		args = append(args, fmt.Sprintf("S0-S%d", s.FloatArgs-1))
+	}
+	if s.StackArgs > 0 {
+		args = append(args, fmt.Sprintf("SP+%d", s.StackArgs))
+	}
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "(%s)", strings.Join(args, ", "))
+	switch s.Result {
+	case 4:
+		buf.WriteString(" R0")
+	case 8:
+		buf.WriteString(" R0:R1")
+	}
+	return buf.String()
+}
+
+// Bits in the register sets tracked by InferSignature.
+const (
+	sigR0   = 0  // R0-R3 are bits 0-3
+	sigS0   = 4  // S0-S15 are bits 4-19
+	sigRes0 = 20 // R0 holds a result
+	sigRes1 = 21 // R1 holds a result
+
+	sigArgs = 1<<sigRes0 - 1 // all argument registers
+	sigAll  = 1<<(sigRes1+1) - 1
+)
+
+// InferSignature estimates the signature of fn under the AAPCS.
+//
+// A core register R0-R3 or VFP register S0-S15 (or the D and Q registers
+// overlapping them) that is read on some path before being written
+// on every path from the entry is taken to be an argument, and the
+// argument count is one more than the highest such register, since
+// arguments are allocated in order. Calls are assumed to read no
+// argument registers and to clobber all of them, leaving a result in R0.
+// Incoming stack arguments are found by TrackSP.
+//
+// The result is R0 if every path to some return writes R0 and R0:R1
+// if every such path also writes R1; the returns disagreeing is resolved
+// in favor of the wider result. Floating-point results, which the
+// hard-float variant returns in S0 or D0, are not reported.
+//
+// Only unconditional instructions count as writes.
+func InferSignature(fn *Func) *Signature {
+	sig := &Signature{}
+	if len(fn.Insts) == 0 {
+		return sig
+	}
+	m := &armdis.Map{PC: fn.Insts[0].Start, Mode: fn.Mode, Ranges: fn.Insts}
+	g := armcfg.Build(m)
+
+	// Forward must-write analysis: in[b] is the set of registers
+	// written on every path from the entry to the start of b.
+	in := make(map[*armcfg.Block]uint32)
+	var work []*armcfg.Block
+	for _, b := range g.Blocks {
+		if b.Start == fn.Insts[0].Start {
+			in[b] = 0
+			work = append(work, b)
+		}
+	}
+	var read uint32
+	for len(work) > 0 {
+		b := work[len(work)-1]
+		work = work[:len(work)-1]
+		written := in[b]
+		for _, r := range b.Insts {
+			uses, defs := sigEffect(r, fn.Mode)
+			read |= uses &^ written
+			if always(r.Inst) {
+				written |= defs
+			}
+		}
+		for _, e := range b.Succs {
+			if e.To == nil {
+				continue
+			}
+			old, ok := in[e.To]
+			if !ok {
+				old = sigAll
+			}
+			if w := old & written; !ok || w != old {
+				in[e.To] = w
+				work = append(work, e.To)
+			}
+		}
+	}
+
+	// Results come from the state at each reachable return.
+	for _, b := range g.Blocks {
+		written, ok := in[b]
+		if !ok {
+			continue
+		}
+		for _, r := range b.Insts {
+			if isReturn(r, fn.Mode) {
+				res := 0
+				if written&(1<<sigRes0) != 0 {
+					res = 4
+					if written&(1<<sigRes1) != 0 {
+						res = 8
+					}
+				}
+				if res > sig.Result {
+					sig.Result = res
+				}
+			}
+			_, defs := sigEffect(r, fn.Mode)
+			if always(r.Inst) {
+				written |= defs
+			}
+		}
+	}
+
+	for i := 0; i < 4; i++ {
+		if read&(1<<(sigR0+uint(i))) != 0 {
+			sig.IntArgs = i + 1
+		}
+	}
+	for i := 0; i < 16; i++ {
+		if read&(1<<(sigS0+uint(i))) != 0 {
+			sig.FloatArgs = i + 1
+		}
+	}
+	for _, a := range TrackSP(fn).Accesses {
+		if !a.Store && a.Offset >= 0 {
+			size := a.Size
+			if size == 0 {
+				size = 4
+			}
+			if end := (a.Offset + size + 3) &^ 3; end > sig.StackArgs {
+				sig.StackArgs = end
+			}
+		}
+	}
+	return sig
+}
+
+// sigEffect returns the argument registers read by r and
+// the argument and result registers it writes.
+func sigEffect(r armdis.Range, mode armasm.Mode) (uses, defs uint32) {
+	a := arminst.ARM{Inst: r.Inst, Mode: mode}
+	for _, u := range a.Uses() {
+		uses |= sigRegs(u)
+	}
+	switch class := a.Class(); {
+	case class == arminst.ClassCall, class == arminst.ClassIndirectCall:
+		return uses, sigArgs | 1<<sigRes0
+	case strings.HasPrefix(r.Inst.Op.String(), "VCMP"):
+		// Uses treats the first operand as a result,
+		// but VCMP only sets the FPSCR flags.
+		for _, arg := range r.Inst.Args {
+			if arg != nil {
+				uses |= sigRegs(arg)
+			}
+		}
+		return uses, 0
+	}
+	for _, d := range a.Defs() {
+		bits := sigRegs(d)
+		defs |= bits
+		if bits&(1<<(sigR0+0)) != 0 {
+			defs |= 1 << sigRes0
+		}
+		if bits&(1<<(sigR0+1)) != 0 {
+			defs |= 1 << sigRes1
+		}
+	}
+	return uses, defs
+}
+
+// sigRegs returns the argument register bits for the register or register list arg.
+func sigRegs(arg arminst.Arg) uint32 {
+	switch arg := arg.(type) {
+	case armasm.Reg:
+		switch {
+		case arg <= armasm.R3:
+			return 1 << (sigR0 + uint(arg-armasm.R0))
+		case armasm.S0 <= arg && arg <= armasm.S15:
+			return 1 << (sigS0 + uint(arg-armasm.S0))
+		case armasm.D0 <= arg && arg <= armasm.D7:
+			return 3 << (sigS0 + 2*uint(arg-armasm.D0))
+		case armasm.Q0 <= arg && arg <= armasm.Q3:
+			return 15 << (sigS0 + 4*uint(arg-armasm.Q0))
+		}
+	case armasm.RegList:
+		return uint32(arg) & 15
+	}
+	return 0
+}
@@ -0,0 +1,127 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armfunc
+
+import "testing"
+
+var signatureTests = []struct {
+	name  string
+	insts []uint32
+	sig   Signature
+	str   string
+}{
+	{
+		"empty",
+		[]uint32{
+			0xe12fff1e, // bx lr
+		},
+		Signature{},
+		"()",
+	},
+	{
+		"add",
+		[]uint32{
+			0xe0800001, // add r0, r0, r1
+			0xe12fff1e, // bx lr
+		},
+		Signature{IntArgs: 2, Result: 4},
+		"(R0, R1) R0",
+	},
+	{
+		"gap",
+		[]uint32{
+			0xe1a00002, // mov r0, r2
+			0xe12fff1e, // bx lr
+		},
+		Signature{IntArgs: 3, Result: 4},
+		"(R0, R1, R2) R0",
+	},
+	{
+		"int64",
+		[]uint32{
+			0xe3a00000, // mov r0, #0
+			0xe3a01000, // mov r1, #0
+			0xe12fff1e, // bx lr
+		},
+		Signature{Result: 8},
+		"() R0:R1",
+	},
+	{
+		"cond",
+		[]uint32{
+			0xe3500000, // cmp r0, #0
+			0x03a01001, // moveq r1, #1
+			0xe1a00001, // mov r0, r1
+			0xe12fff1e, // bx lr
+		},
+		Signature{IntArgs: 2, Result: 4},
+		"(R0, R1) R0",
+	},
+	{
+		"branch",
+		[]uint32{
+			0xe3500000, // 0x00: cmp r0, #0
+			0x0a000001, // 0x04: beq 0x10
+			0xe3a01001, // 0x08: mov r1, #1
+			0xe12fff1e, // 0x0c: bx lr
+			0xe3a00001, // 0x10: mov r0, #1
+			0xe12fff1e, // 0x14: bx lr
+		},
+		Signature{IntArgs: 1, Result: 4},
+		"(R0) R0",
+	},
+	{
+		"call",
+		[]uint32{
+			0xe92d4010, // 0x00: push {r4, lr}
+			0xe1a04002, // 0x04: mov r4, r2
+			0xebfffffe, // 0x08: bl 0x08
+			0xe0800004, // 0x0c: add r0, r0, r4
+			0xe8bd8010, // 0x10: pop {r4, pc}
+		},
+		Signature{IntArgs: 3, Result: 4},
+		"(R0, R1, R2) R0",
+	},
+	{
+		"float",
+		[]uint32{
+			0xee300a20, // vadd.f32 s0, s0, s1
+			0xe12fff1e, // bx lr
+		},
+		Signature{FloatArgs: 2},
+		"(S0-S1)",
+	},
+	{
+		"vcmp",
+		[]uint32{
+			0xeeb40a60, // vcmp.f32 s0, s1
+			0xeef1fa10, // vmrs APSR_nzcv, fpscr
+			0xe12fff1e, // bx lr
+		},
+		Signature{FloatArgs: 2},
+		"(S0-S1)",
+	},
+	{
+		"stack",
+		[]uint32{
+			0xe59d0004, // ldr r0, [sp, #4]
+			0xe12fff1e, // bx lr
+		},
+		Signature{StackArgs: 8, Result: 4},
+		"(SP+8) R0",
+	},
+}
+
+func TestInferSignature(t *testing.T) {
+	for _, tt := range signatureTests {
+		sig := InferSignature(linearFunc(tt.insts...))
+		if *sig != tt.sig {
+			t.Errorf("%s: InferSignature = %+v, want %+v", tt.name, *sig, tt.sig)
+		}
+		if s := sig.String(); s != tt.str {
+			t.Errorf("%s: String() = %q, want %q", tt.name, s, tt.str)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armfunc
+
+import (
+	"sort"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armdis"
+)
+
+// An SPTrack records the stack pointer at each instruction of a function
+// and the stack slots accessed by the function.
+// Offsets are relative to the value of SP on entry to the function,
+// so slots in the function's own frame have negative offsets
+// and incoming stack arguments have non-negative ones.
+type SPTrack struct {
+	// SP maps the address of each instruction to the offset held in SP
+	// before the instruction executes. Instructions where the offset
+	// is not known statically, such as after an alloca, are omitted.
+	SP map[uint64]int
+
+	// Accesses lists the memory accesses at known stack offsets,
+	// in instruction address order.
+	Accesses []StackAccess
+
+	// Dynamic reports that SP is adjusted by an amount
+	// not known statically somewhere in the function.
+	Dynamic bool
+}
+
+// A StackAccess is a memory access to a known stack slot.
+type StackAccess struct {
+	Addr   uint64     // address of the accessing instruction
+	Base   armasm.Reg // register used for the address: SP or a frame pointer
+	Offset int        // offset of the lowest accessed byte from the entry SP
+	Size   int        // number of bytes accessed, or 0 if unknown
+	Store  bool       // access writes memory
+}
+
+// TrackSP tracks the SP offset at each instruction of fn through PUSH and POP,
+// LDM and STM with SP writeback, VPUSH and VPOP, pre- and post-indexed loads and stores
+// using SP, ADD and SUB of constants, and copies to and from a frame pointer,
+// as StackUsage does. It also records each load or store whose address is
+// a known offset from the entry SP, whether addressed through SP itself or
+// through a register holding a copy of it, such as a frame pointer.
+func TrackSP(fn *Func) *SPTrack {
+	t := &SPTrack{SP: make(map[uint64]int)}
+	var st Stack
+	accesses := make(map[uint64]StackAccess)
+	walkStack(fn, &st, func(r armdis.Range, regs, next map[armasm.Reg]int) {
+		if sp, ok := regs[armasm.SP]; ok {
+			t.SP[r.Start] = sp
+		} else {
+			delete(t.SP, r.Start)
+		}
+		if a, ok := stackAccess(r, regs); ok {
+			accesses[r.Start] = a
+		} else {
+			delete(accesses, r.Start)
+		}
+	})
+	t.Dynamic = st.Dynamic
+	for _, a := range accesses {
+		t.Accesses = append(t.Accesses, a)
+	}
+	sort.Slice(t.Accesses, func(i, j int) bool { return t.Accesses[i].Addr < t.Accesses[j].Addr })
+	return t
+}
+
+// stackAccess returns the stack access made by r, given the register state regs.
+func stackAccess(r armdis.Range, regs map[armasm.Reg]int) (StackAccess, bool) {
+	inst := r.Inst
+	if list, ok := pushList(inst); ok {
+		sp, known := regs[armasm.SP]
+		n := 4 * countRegs(list)
+		return StackAccess{Addr: r.Start, Base: armasm.SP, Offset: sp - n, Size: n, Store: true}, known
+	}
+	if list, ok := popList(inst); ok {
+		sp, known := regs[armasm.SP]
+		return StackAccess{Addr: r.Start, Base: armasm.SP, Offset: sp, Size: 4 * countRegs(list)}, known
+	}
+	if n, ok := vpushSize(inst); ok {
+		sp, known := regs[armasm.SP]
+		return StackAccess{Addr: r.Start, Base: armasm.SP, Offset: sp - n, Size: n, Store: true}, known
+	}
+	if n, ok := vpopSize(inst); ok {
+		sp, known := regs[armasm.SP]
+		return StackAccess{Addr: r.Start, Base: armasm.SP, Offset: sp, Size: n}, known
+	}
+	size, store, ok := memSize(inst)
+	if !ok {
+		return StackAccess{}, false
+	}
+	for _, arg := range inst.Args {
+		mem, ok := arg.(armasm.Mem)
+		if !ok || mem.Sign != 0 {
+			continue
+		}
+		base, known := regs[mem.Base]
+		if !known {
+			return StackAccess{}, false
+		}
+		off := base
+		if mem.Mode == armasm.AddrOffset || mem.Mode == armasm.AddrPreIndex {
+			off += int(mem.Offset)
+		}
+		return StackAccess{Addr: r.Start, Base: mem.Base, Offset: off, Size: size, Store: store}, true
+	}
+	return StackAccess{}, false
+}
+
+// memSize returns the number of bytes accessed by the single load or store inst,
+// and whether it is a store.
+func memSize(inst armasm.Inst) (size int, store, ok bool) {
+	switch inst.Op &^ 15 {
+	case armasm.LDR_EQ, armasm.LDRT_EQ, armasm.LDREX_EQ:
+		return 4, false, true
+	case armasm.LDRB_EQ, armasm.LDRBT_EQ, armasm.LDRSB_EQ, armasm.LDRSBT_EQ, armasm.LDREXB_EQ:
+		return 1, false, true
+	case armasm.LDRH_EQ, armasm.LDRHT_EQ, armasm.LDRSH_EQ, armasm.LDRSHT_EQ, armasm.LDREXH_EQ:
+		return 2, false, true
+	case armasm.LDRD_EQ, armasm.LDREXD_EQ:
+		return 8, false, true
+	case armasm.STR_EQ, armasm.STRT_EQ:
+		return 4, true, true
+	case armasm.STRB_EQ, armasm.STRBT_EQ:
+		return 1, true, true
+	case armasm.STRH_EQ, armasm.STRHT_EQ:
+		return 2, true, true
+	case armasm.STRD_EQ:
+		return 8, true, true
+	case armasm.VLDR_EQ, armasm.VSTR_EQ:
+		size := 4
+		if r, ok := inst.Args[0].(armasm.Reg); ok && armasm.D0 <= r && r <= armasm.D31 {
+			size = 8
+		}
+		return size, inst.Op&^15 == armasm.VSTR_EQ, true
+	}
+	return 0, false, false
+}
@@ -0,0 +1,97 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armfunc
+
+import (
+	"reflect"
+	"testing"
+
+	"rsc.io/arm/armasm"
+)
+
+func TestTrackSP(t *testing.T) {
+	fn := linearFunc(
+		0xe92d4810, // 0x00: push {r4, fp, lr}
+		0xe28db004, // 0x04: add fp, sp, #4
+		0xe24dd008, // 0x08: sub sp, sp, #8
+		0xe58d0004, // 0x0c: str r0, [sp, #4]
+		0xe51b100c, // 0x10: ldr r1, [fp, #-12]
+		0xe5dd2000, // 0x14: ldrb r2, [sp]
+		0xe59d3014, // 0x18: ldr r3, [sp, #20]
+		0xe28dd008, // 0x1c: add sp, sp, #8
+		0xe8bd8810, // 0x20: pop {r4, fp, pc}
+	)
+	tr := TrackSP(fn)
+	wantSP := map[uint64]int{
+		0x00: 0, 0x04: -12, 0x08: -12, 0x0c: -20, 0x10: -20,
+		0x14: -20, 0x18: -20, 0x1c: -20, 0x20: -12,
+	}
+	if !reflect.DeepEqual(tr.SP, wantSP) {
+		t.Errorf("SP = %v, want %v", tr.SP, wantSP)
+	}
+	wantAccesses := []StackAccess{
+		{0x00, armasm.SP, -12, 12, true},
+		{0x0c, armasm.SP, -16, 4, true},
+		{0x10, armasm.R11, -20, 4, false},
+		{0x14, armasm.SP, -20, 1, false},
+		{0x18, armasm.SP, 0, 4, false},
+		{0x20, armasm.SP, -12, 12, false},
+	}
+	if !reflect.DeepEqual(tr.Accesses, wantAccesses) {
+		t.Errorf("Accesses:\n%v\nwant:\n%v", tr.Accesses, wantAccesses)
+	}
+	if tr.Dynamic {
+		t.Errorf("Dynamic = true, want false")
+	}
+
+	// After an alloca, SP is unknown but FP-relative accesses still resolve.
+	tr = TrackSP(linearFunc(
+		0xe92d4800, // 0x00: push {fp, lr}
+		0xe28db004, // 0x04: add fp, sp, #4
+		0xe04dd000, // 0x08: sub sp, sp, r0
+		0xe50b0008, // 0x0c: str r0, [fp, #-8]
+		0xe24bd004, // 0x10: sub sp, fp, #4
+		0xe8bd8800, // 0x14: pop {fp, pc}
+	))
+	if _, ok := tr.SP[0x0c]; ok || !tr.Dynamic {
+		t.Errorf("alloca: SP[0xc] known or Dynamic not set: %+v", tr)
+	}
+	if sp := tr.SP[0x14]; sp != -8 {
+		t.Errorf("alloca: SP[0x14] = %d, want -8", sp)
+	}
+	if len(tr.Accesses) < 2 || tr.Accesses[1] != (StackAccess{0x0c, armasm.R11, -12, 4, true}) {
+		t.Errorf("alloca: Accesses = %v", tr.Accesses)
+	}
+
+	// VPUSH and VPOP move SP by 8 bytes per D register and 4 per S register.
+	tr = TrackSP(linearFunc(
+		0xe92d4010, // 0x00: push {r4, lr}
+		0xed2d8b04, // 0x04: vpush {d8, d9}
+		0xed2d0a01, // 0x08: vpush {s0}
+		0xed9d8b01, // 0x0c: vldr d8, [sp, #4]
+		0xecbd0a01, // 0x10: vpop {s0}
+		0xecbd8b04, // 0x14: vpop {d8, d9}
+		0xe8bd8010, // 0x18: pop {r4, pc}
+	))
+	wantSP = map[uint64]int{
+		0x00: 0, 0x04: -8, 0x08: -24, 0x0c: -28,
+		0x10: -28, 0x14: -24, 0x18: -8,
+	}
+	if !reflect.DeepEqual(tr.SP, wantSP) {
+		t.Errorf("vpush: SP = %v, want %v", tr.SP, wantSP)
+	}
+	wantAccesses = []StackAccess{
+		{0x00, armasm.SP, -8, 8, true},
+		{0x04, armasm.SP, -24, 16, true},
+		{0x08, armasm.SP, -28, 4, true},
+		{0x0c, armasm.SP, -24, 8, false},
+		{0x10, armasm.SP, -28, 4, false},
+		{0x14, armasm.SP, -24, 16, false},
+		{0x18, armasm.SP, -8, 8, false},
+	}
+	if !reflect.DeepEqual(tr.Accesses, wantAccesses) {
+		t.Errorf("vpush: Accesses:\n%v\nwant:\n%v", tr.Accesses, wantAccesses)
+	}
+}
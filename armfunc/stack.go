@@ -0,0 +1,185 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armfunc
+
+import (
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armcfg"
+	"rsc.io/arm/armdis"
+)
+
+// A Stack describes the stack usage of a function.
+type Stack struct {
+	Max     int  // maximum number of bytes below the entry SP, not counting callees
+	Dynamic bool // SP is adjusted by an amount not known statically; Max is a lower bound
+}
+
+// StackUsage estimates the maximum static stack usage of fn,
+// in the manner of GCC's -fstack-usage.
+// It follows the function's control flow, tracking SP through PUSH and POP,
+// LDM and STM with SP writeback, VPUSH and VPOP, pre- and post-indexed loads and stores
+// using SP, ADD and SUB of constants, and copies to and from a frame pointer.
+// Conditional instructions contribute to Max but are otherwise assumed
+// to belong to a path that leaves the function, such as a conditional epilogue.
+func StackUsage(fn *Func) *Stack {
+	st := &Stack{}
+	walkStack(fn, st, func(r armdis.Range, regs, next map[armasm.Reg]int) {
+		if sp, ok := next[armasm.SP]; ok && -sp > st.Max {
+			st.Max = -sp
+		}
+	})
+	return st
+}
+
+// walkStack follows the control flow of fn, tracking the registers
+// known to hold the entry SP plus a constant offset.
+// It calls visit for each instruction r with the register state
+// before and after r; for conditional instructions, the state after
+// is only used for visit, and the next instruction starts from regs.
+// An instruction may be visited more than once, as information about
+// its block is refined; the last visit reflects the final state.
+// It sets st.Dynamic if SP is changed by an amount not known statically.
+func walkStack(fn *Func, st *Stack, visit func(r armdis.Range, regs, next map[armasm.Reg]int)) {
+	if len(fn.Insts) == 0 {
+		return
+	}
+	m := &armdis.Map{PC: fn.Insts[0].Start, Mode: fn.Mode, Ranges: fn.Insts}
+	g := armcfg.Build(m)
+
+	// in records, for each block, the registers known to hold
+	// entry SP + offset on entry to the block.
+	in := make(map[*armcfg.Block]map[armasm.Reg]int)
+	entry := g.Block(fn.Insts[0].Start)
+	in[entry] = map[armasm.Reg]int{armasm.SP: 0}
+	work := []*armcfg.Block{entry}
+	for len(work) > 0 {
+		b := work[len(work)-1]
+		work = work[:len(work)-1]
+		regs := copyRegs(in[b])
+		for _, r := range b.Insts {
+			next := stackStep(st, regs, r, fn.Mode)
+			visit(r, regs, next)
+			if always(r.Inst) {
+				regs = next
+			}
+		}
+		for _, e := range b.Succs {
+			if e.To == nil {
+				continue
+			}
+			old, ok := in[e.To]
+			if !ok {
+				in[e.To] = copyRegs(regs)
+				work = append(work, e.To)
+				continue
+			}
+			// Paths disagree: keep only the agreeing values.
+			// Disagreement about SP means the stack depth
+			// depends on the path taken, as in a loop that pushes.
+			changed := false
+			for reg, v := range old {
+				if w, ok := regs[reg]; !ok || w != v {
+					if reg == armasm.SP {
+						st.Dynamic = true
+					}
+					delete(old, reg)
+					changed = true
+				}
+			}
+			if changed {
+				work = append(work, e.To)
+			}
+		}
+	}
+}
+
+func copyRegs(regs map[armasm.Reg]int) map[armasm.Reg]int {
+	c := make(map[armasm.Reg]int, len(regs))
+	for reg, v := range regs {
+		c[reg] = v
+	}
+	return c
+}
+
+// stackStep returns the register state following r, given the state regs.
+// It sets st.Dynamic if r changes SP by an unknown amount.
+func stackStep(st *Stack, regs map[armasm.Reg]int, r armdis.Range, mode armasm.Mode) map[armasm.Reg]int {
+	inst := r.Inst
+	next := copyRegs(regs)
+	sp, known := regs[armasm.SP]
+	if list, ok := pushList(inst); ok {
+		if known {
+			next[armasm.SP] = sp - 4*countRegs(list)
+		}
+		return next
+	}
+	if list, ok := popList(inst); ok {
+		if known {
+			next[armasm.SP] = sp + 4*countRegs(list)
+		}
+		for reg := armasm.R0; reg < armasm.SP; reg++ {
+			if list&(1<<reg) != 0 {
+				delete(next, reg)
+			}
+		}
+		return next
+	}
+	if n, ok := vpushSize(inst); ok {
+		if known {
+			next[armasm.SP] = sp - n
+		}
+		return next
+	}
+	if n, ok := vpopSize(inst); ok {
+		if known {
+			next[armasm.SP] = sp + n
+		}
+		return next
+	}
+	if dst, src, delta, ok := addImm(inst); ok {
+		if base, ok := regs[src]; ok {
+			next[dst] = base + delta
+		} else {
+			delete(next, dst)
+			if dst == armasm.SP {
+				st.Dynamic = true
+			}
+		}
+		return next
+	}
+	for _, arg := range inst.Args {
+		if mem, ok := arg.(armasm.Mem); ok && mem.Base == armasm.SP && mem.Sign == 0 &&
+			(mem.Mode == armasm.AddrPreIndex || mem.Mode == armasm.AddrPostIndex) {
+			if known {
+				next[armasm.SP] = sp + int(mem.Offset)
+			}
+		}
+	}
+	if f := armdis.Classify(inst, r.Start, mode); f.Kind == armdis.FlowCall || f.Kind == armdis.FlowIndirectCall {
+		for _, reg := range []armasm.Reg{armasm.R0, armasm.R1, armasm.R2, armasm.R3, armasm.R12, armasm.LR} {
+			delete(next, reg)
+		}
+	}
+	if dst, ok := inst.Args[0].(armasm.Reg); ok && writesArg0(inst) {
+		delete(next, dst)
+		if dst == armasm.SP {
+			st.Dynamic = true
+		}
+	}
+	return next
+}
+
+// writesArg0 reports whether the first argument of inst,
+// if a register, is written by inst.
+func writesArg0(inst armasm.Inst) bool {
+	switch inst.Op &^ 15 {
+	case armasm.CMP_EQ, armasm.CMN_EQ, armasm.TST_EQ, armasm.TEQ_EQ,
+		armasm.STR_EQ, armasm.STRB_EQ, armasm.STRH_EQ, armasm.STRD_EQ,
+		armasm.STRT_EQ, armasm.STRBT_EQ, armasm.STRHT_EQ,
+		armasm.BX_EQ, armasm.BLX_EQ, armasm.BXJ_EQ, armasm.VMSR_EQ:
+		return false
+	}
+	return true
+}
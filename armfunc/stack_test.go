@@ -0,0 +1,70 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armfunc
+
+import "testing"
+
+var stackTests = []struct {
+	name string
+	ws   []uint32
+	want Stack
+}{
+	{
+		"leaf",
+		[]uint32{
+			0xe12fff1e, // bx lr
+		},
+		Stack{Max: 0},
+	},
+	{
+		"cond-epilogue",
+		[]uint32{
+			0xe92d4030, // 0x00: push {r4, r5, lr}
+			0xe24dd008, // 0x04: sub sp, sp, #8
+			0xe3500000, // 0x08: cmp r0, #0
+			0x028dd008, // 0x0c: addeq sp, sp, #8
+			0x08bd8030, // 0x10: popeq {r4, r5, pc}
+			0xe52d0004, // 0x14: push {r0} (str r0, [sp, #-4]!)
+			0xe49d0004, // 0x18: pop {r0} (ldr r0, [sp], #4)
+			0xe28dd008, // 0x1c: add sp, sp, #8
+			0xe8bd8030, // 0x20: pop {r4, r5, pc}
+		},
+		Stack{Max: 24},
+	},
+	{
+		"alloca",
+		[]uint32{
+			0xe92d4800, // 0x00: push {fp, lr}
+			0xe28db004, // 0x04: add fp, sp, #4
+			0xe04dd000, // 0x08: sub sp, sp, r0
+			0xe24bd004, // 0x0c: sub sp, fp, #4
+			0xe8bd8800, // 0x10: pop {fp, pc}
+		},
+		Stack{Max: 8, Dynamic: true},
+	},
+	{
+		"vpush",
+		[]uint32{
+			0xe92d4010, // 0x00: push {r4, lr}
+			0xed2d8b04, // 0x04: vpush {d8, d9}
+			0xed2d0a01, // 0x08: vpush {s0}
+			0xe24dd008, // 0x0c: sub sp, sp, #8
+			0xe28dd008, // 0x10: add sp, sp, #8
+			0xecbd0a01, // 0x14: vpop {s0}
+			0xecbd8b04, // 0x18: vpop {d8, d9}
+			0xe8bd8010, // 0x1c: pop {r4, pc}
+		},
+		Stack{Max: 36},
+	},
+}
+
+func TestStackUsage(t *testing.T) {
+	for _, tt := range stackTests {
+		st := StackUsage(linearFunc(tt.ws...))
+		if *st != tt.want {
+			t.Errorf("%s: StackUsage = %+v, want %+v", tt.name, *st, tt.want)
+		}
+	}
+}
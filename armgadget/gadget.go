@@ -0,0 +1,94 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package armgadget finds return-oriented programming gadgets in ARM code.
+//
+// A gadget is a short sequence of instructions ending in an unconditional
+// transfer of control to an address taken from a register or the stack,
+// such as POP {..., PC}, BX LR, or BLX Rn. Gadget listings are used to
+// evaluate exploit mitigations, for example by comparing the gadgets
+// available in a binary before and after a compiler change.
+package armgadget
+
+import (
+	"bytes"
+	"fmt"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armdis"
+)
+
+// A Gadget is an instruction sequence ending in a computed control transfer.
+type Gadget struct {
+	Addr  uint64        // address of the first instruction
+	Insts []armasm.Inst // instructions, ending with the control transfer
+}
+
+// End returns the address following the last instruction of g.
+func (g Gadget) End() uint64 {
+	end := g.Addr
+	for _, inst := range g.Insts {
+		end += uint64(inst.Len)
+	}
+	return end
+}
+
+func (g Gadget) String() string {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "%#x:", g.Addr)
+	for i, inst := range g.Insts {
+		if i > 0 {
+			buf.WriteString(";")
+		}
+		buf.WriteString(" ")
+		buf.WriteString(armasm.GNUSyntax(inst))
+	}
+	return buf.String()
+}
+
+// Find returns the gadgets of at most maxInsts instructions in code,
+// which is loaded at address pc. Every instruction-aligned offset in code
+// is tried as a gadget start, including offsets inside instructions found
+// by an ordinary disassembly, so overlapping gadgets are reported.
+// A gadget may not contain a control transfer before its last instruction,
+// and its last instruction must be unconditional.
+// Gadgets are returned in address order, shorter gadgets first.
+func Find(code []byte, pc uint64, mode armasm.Mode, maxInsts int) []Gadget {
+	step := uint64(4)
+	if mode == armasm.ModeThumb {
+		step = 2
+	}
+	var out []Gadget
+	start := (pc + step - 1) &^ (step - 1)
+	for addr := start; addr < pc+uint64(len(code)); addr += step {
+		if g, ok := gadgetAt(code, pc, addr, mode, maxInsts); ok {
+			out = append(out, g)
+		}
+	}
+	return out
+}
+
+// gadgetAt returns the gadget starting at addr, if any.
+func gadgetAt(code []byte, pc, addr uint64, mode armasm.Mode, maxInsts int) (Gadget, bool) {
+	g := Gadget{Addr: addr}
+	for off := addr - pc; len(g.Insts) < maxInsts && off < uint64(len(code)); {
+		inst, err := armasm.Decode(code[off:], mode)
+		if err != nil {
+			break
+		}
+		g.Insts = append(g.Insts, inst)
+		f := armdis.Classify(inst, pc+off, mode)
+		switch f.Kind {
+		case armdis.FlowNone:
+			off += uint64(inst.Len)
+			continue
+		case armdis.FlowReturn, armdis.FlowIndirectJump, armdis.FlowIndirectCall:
+			if !f.Cond {
+				return g, true
+			}
+		}
+		break
+	}
+	return Gadget{}, false
+}
@@ -0,0 +1,39 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armgadget
+
+import (
+	"strings"
+	"testing"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/internal/armtest"
+)
+
+func TestFind(t *testing.T) {
+	ws := []uint32{
+		0xe3a00001, // 0x1000: mov r0, #1
+		0xe8bd8010, // 0x1004: pop {r4, pc}
+		0xe1a01002, // 0x1008: mov r1, r2
+		0xeb000000, // 0x100c: bl 0x1014
+		0xe12fff1e, // 0x1010: bx lr
+		0x112fff33, // 0x1014: blxne r3
+		0xe12fff33, // 0x1018: blx r3
+	}
+	code := armtest.Words(ws...)
+	var out []string
+	for _, g := range Find(code, 0x1000, armasm.ModeARM, 3) {
+		out = append(out, g.String())
+	}
+	want := []string{
+		"0x1000: mov r0, #1; pop {r4, pc}",
+		"0x1004: pop {r4, pc}",
+		"0x1010: bx lr",
+		"0x1018: blx r3",
+	}
+	if strings.Join(out, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Find:\n%s\nwant:\n%s", strings.Join(out, "\n"), strings.Join(want, "\n"))
+	}
+}
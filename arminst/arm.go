@@ -0,0 +1,194 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arminst
+
+import (
+	"strings"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armdis"
+)
+
+// ARM is a 32-bit ARM or Thumb instruction,
+// along with the mode it was decoded in.
+type ARM struct {
+	armasm.Inst
+	Mode armasm.Mode
+}
+
+func (i ARM) Mnemonic() string {
+	return i.Op.String()
+}
+
+func (i ARM) Operands() []Arg {
+	var args []Arg
+	for _, a := range i.Args {
+		if a == nil {
+			break
+		}
+		args = append(args, a)
+	}
+	return args
+}
+
+func (i ARM) Size() int {
+	return i.Len
+}
+
+func (i ARM) Class() Class {
+	switch armdis.Classify(i.Inst, 0, i.Mode).Kind {
+	case armdis.FlowJump:
+		return ClassJump
+	case armdis.FlowCall:
+		return ClassCall
+	case armdis.FlowIndirectJump:
+		return ClassIndirectJump
+	case armdis.FlowIndirectCall:
+		return ClassIndirectCall
+	case armdis.FlowReturn:
+		return ClassReturn
+	case armdis.FlowTrap:
+		return ClassTrap
+	}
+	switch name := armName(i.Op); {
+	case name == "PLD" || name == "PLI":
+		return ClassOther
+	case strings.HasPrefix(name, "LD"), name == "POP", name == "VLDR", name == "SWP":
+		return ClassLoad
+	case strings.HasPrefix(name, "ST"), name == "PUSH", name == "VSTR":
+		return ClassStore
+	}
+	return ClassOther
+}
+
+func (i ARM) Uses() []Arg {
+	var uses []Arg
+	name := armName(i.Op)
+	n, rmw := armResults(name)
+	if name == "PUSH" || name == "POP" {
+		uses = append(uses, armasm.SP)
+	}
+	for j, a := range i.Args {
+		switch a := a.(type) {
+		case armasm.Reg:
+			if j >= n || rmw {
+				uses = append(uses, a)
+			}
+		case armasm.RegX:
+			uses = append(uses, a.Reg)
+		case armasm.RegList:
+			switch name {
+			case "STM", "STMDA", "STMDB", "STMIB", "PUSH":
+				uses = append(uses, a)
+			}
+		case armasm.RegShift:
+			uses = append(uses, a.Reg)
+		case armasm.RegShiftReg:
+			uses = append(uses, a.Reg, a.RegCount)
+		case armasm.Mem:
+			uses = append(uses, a.Base)
+			if a.Sign != 0 {
+				uses = append(uses, a.Index)
+			}
+		}
+	}
+	return uses
+}
+
+func (i ARM) Defs() []Arg {
+	var defs []Arg
+	name := armName(i.Op)
+	n, _ := armResults(name)
+	for j := 0; j < n; j++ {
+		if r, ok := i.Args[j].(armasm.Reg); ok {
+			defs = append(defs, r)
+		}
+	}
+	switch name {
+	case "BL", "BLX":
+		defs = append(defs, armasm.LR)
+	}
+	for _, a := range i.Args {
+		switch a := a.(type) {
+		case armasm.RegList:
+			switch name {
+			case "LDM", "LDMDA", "LDMDB", "LDMIB", "POP":
+				defs = append(defs, a)
+			}
+		case armasm.Mem:
+			switch a.Mode {
+			case armasm.AddrPreIndex, armasm.AddrPostIndex, armasm.AddrLDM_WB:
+				defs = append(defs, a.Base)
+			}
+		}
+	}
+	if name == "PUSH" || name == "POP" {
+		defs = append(defs, armasm.SP)
+	}
+	return defs
+}
+
+func (i ARM) ReadsPC() bool {
+	for _, a := range i.Args {
+		if _, ok := a.(armasm.PCRel); ok {
+			return true
+		}
+	}
+	return hasPC(i.Uses())
+}
+
+func (i ARM) WritesPC() bool {
+	return i.Class().IsBranch() || hasPC(i.Defs())
+}
+
+// hasPC reports whether the register list regs includes PC.
+func hasPC(regs []Arg) bool {
+	for _, r := range regs {
+		switch r := r.(type) {
+		case armasm.Reg:
+			if r == armasm.PC {
+				return true
+			}
+		case armasm.RegList:
+			if r&(1<<armasm.PC) != 0 {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// armName returns the name of op without its condition and other suffixes:
+// "ADD" for ADD_S_EQ.
+func armName(op armasm.Op) string {
+	name := op.String()
+	if i := strings.Index(name, "."); i >= 0 {
+		name = name[:i]
+	}
+	return name
+}
+
+// armResults returns the number of leading register arguments
+// written by the instruction with the given name, and whether
+// the instruction also reads them.
+func armResults(name string) (n int, rmw bool) {
+	switch name {
+	case "CMP", "CMN", "TST", "TEQ",
+		"B", "BL", "BX", "BXJ", "BLX", "BKPT", "SVC", "UNDEF",
+		"NOP", "SEV", "WFE", "WFI", "YIELD", "DBG", "DMB", "DSB", "ISB", "CLREX",
+		"PLD", "PLI", "SETEND", "VMSR",
+		"STR", "STRB", "STRH", "STRD", "STRT", "STRBT", "STRHT", "VSTR",
+		"STM", "STMDA", "STMDB", "STMIB", "PUSH",
+		"LDM", "LDMDA", "LDMDB", "LDMIB", "POP":
+		return 0, false
+	case "LDRD", "LDREXD", "SMULL", "UMULL":
+		return 2, false
+	case "SMLAL", "UMLAL", "UMAAL", "SMLALBB", "SMLALBT", "SMLALTB", "SMLALTT", "SMLALD", "SMLSLD":
+		return 2, true
+	case "MOVT", "BFI", "BFC":
+		return 1, true
+	}
+	return 1, false
+}
@@ -0,0 +1,204 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arminst
+
+import (
+	"strings"
+
+	"rsc.io/arm/arm64asm"
+)
+
+// ARM64 is an A64 instruction.
+type ARM64 struct {
+	arm64asm.Inst
+}
+
+func (i ARM64) Mnemonic() string {
+	return i.Op.String()
+}
+
+func (i ARM64) Operands() []Arg {
+	var args []Arg
+	for _, a := range i.Args {
+		if a == nil {
+			break
+		}
+		args = append(args, a)
+	}
+	return args
+}
+
+func (i ARM64) Size() int {
+	return i.Len()
+}
+
+func (i ARM64) Class() Class {
+	switch i.Op {
+	case arm64asm.B, arm64asm.CBZ, arm64asm.CBNZ, arm64asm.TBZ, arm64asm.TBNZ:
+		return ClassJump
+	case arm64asm.BL:
+		return ClassCall
+	case arm64asm.BR, arm64asm.BRAA, arm64asm.BRAB, arm64asm.BRAAZ, arm64asm.BRABZ:
+		return ClassIndirectJump
+	case arm64asm.BLR, arm64asm.BLRAA, arm64asm.BLRAB, arm64asm.BLRAAZ, arm64asm.BLRABZ:
+		return ClassIndirectCall
+	case arm64asm.RET, arm64asm.RETAA, arm64asm.RETAB,
+		arm64asm.ERET, arm64asm.ERETAA, arm64asm.ERETAB:
+		return ClassReturn
+	case arm64asm.BRK, arm64asm.HLT:
+		return ClassTrap
+	}
+	switch name := i.Op.String(); {
+	case strings.HasPrefix(name, "LD"), strings.HasPrefix(name, "CAS"), strings.HasPrefix(name, "SWP"):
+		return ClassLoad
+	case strings.HasPrefix(name, "ST"):
+		return ClassStore
+	}
+	return ClassOther
+}
+
+func (i ARM64) Uses() []Arg {
+	var uses []Arg
+	first, n, rmw := arm64Results(i.Op.String())
+	if i.Op == arm64asm.RET && i.Args[0] == nil {
+		// RET with no operand returns through X30.
+		uses = append(uses, arm64asm.X30)
+	}
+	for j, a := range i.Args {
+		switch a := a.(type) {
+		case arm64asm.Reg, arm64asm.VReg, arm64asm.VElem, arm64asm.ZReg, arm64asm.PReg:
+			if j < first || j >= first+n || rmw {
+				uses = appendReg(uses, a)
+			}
+		case arm64asm.VRegList:
+			if !strings.HasPrefix(i.Op.String(), "LD") {
+				uses = append(uses, a)
+			}
+		case arm64asm.ZRegList:
+			if !strings.HasPrefix(i.Op.String(), "LD") {
+				uses = append(uses, a)
+			}
+		case arm64asm.RegShift:
+			uses = appendReg(uses, a.Reg)
+		case arm64asm.RegExtend:
+			uses = appendReg(uses, a.Reg)
+		case arm64asm.MemImm:
+			uses = appendReg(uses, a.Base)
+		case arm64asm.MemExtend:
+			uses = appendReg(uses, a.Base)
+			uses = appendReg(uses, a.Index.Reg)
+		case arm64asm.MemPostReg:
+			uses = appendReg(uses, a.Base)
+			uses = appendReg(uses, a.Index)
+		case arm64asm.MemVL:
+			uses = appendReg(uses, a.Base)
+		}
+	}
+	return uses
+}
+
+func (i ARM64) Defs() []Arg {
+	var defs []Arg
+	first, n, _ := arm64Results(i.Op.String())
+	for j := first; j < first+n; j++ {
+		switch a := i.Args[j].(type) {
+		case arm64asm.Reg, arm64asm.VReg, arm64asm.VElem, arm64asm.ZReg, arm64asm.PReg:
+			defs = appendReg(defs, a)
+		}
+	}
+	switch i.Op {
+	case arm64asm.BL, arm64asm.BLR, arm64asm.BLRAA, arm64asm.BLRAB, arm64asm.BLRAAZ, arm64asm.BLRABZ:
+		defs = append(defs, arm64asm.X30)
+	}
+	for _, a := range i.Args {
+		switch a := a.(type) {
+		case arm64asm.VRegList:
+			if strings.HasPrefix(i.Op.String(), "LD") {
+				defs = append(defs, a)
+			}
+		case arm64asm.ZRegList:
+			if strings.HasPrefix(i.Op.String(), "LD") {
+				defs = append(defs, a)
+			}
+		case arm64asm.MemImm:
+			if a.Mode == arm64asm.AddrPreIndex || a.Mode == arm64asm.AddrPostIndex {
+				defs = appendReg(defs, a.Base)
+			}
+		case arm64asm.MemPostReg:
+			defs = appendReg(defs, a.Base)
+		}
+	}
+	return defs
+}
+
+// appendReg appends the register r to list,
+// omitting the zero registers, which are never really read or written.
+func (i ARM64) ReadsPC() bool {
+	for _, a := range i.Args {
+		if _, ok := a.(arm64asm.PCRel); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// WritesPC reports whether i is a branch:
+// A64 has no instructions that write PC as a general register.
+func (i ARM64) WritesPC() bool {
+	return i.Class().IsBranch()
+}
+
+func appendReg(list []Arg, r Arg) []Arg {
+	if r == arm64asm.XZR || r == arm64asm.WZR {
+		return list
+	}
+	return append(list, r)
+}
+
+// arm64Results returns the range of register arguments, args[first:first+n],
+// written by the instruction with the given name, and whether the instruction
+// also reads them.
+func arm64Results(name string) (first, n int, rmw bool) {
+	switch name {
+	case "CMP", "CMN", "TST", "CCMP", "CCMN",
+		"B", "BL", "BR", "BLR", "BRAA", "BRAB", "BRAAZ", "BRABZ", "BLRAA", "BLRAB", "BLRAAZ", "BLRABZ",
+		"RET", "RETAA", "RETAB", "ERET", "ERETAA", "ERETAB", "DRPS",
+		"CBZ", "CBNZ", "TBZ", "TBNZ",
+		"BRK", "HLT", "SVC", "HVC", "SMC", "NOP", "YIELD", "WFE", "WFI", "SEV", "SEVL", "HINT", "BTI",
+		"CLREX", "DMB", "DSB", "ISB", "MSR", "SYS", "PRFM":
+		return 0, 0, false
+	case "STXR", "STLXR", "STXRB", "STLXRB", "STXRH", "STLXRH", "STXP", "STLXP":
+		// The status result.
+		return 0, 1, false
+	case "LDP", "LDPSW", "LDNP", "LDXP", "LDAXP":
+		return 0, 2, false
+	case "MOVK", "BFM", "BFI", "BFXIL":
+		return 0, 1, true
+	}
+	switch {
+	case strings.HasPrefix(name, "ST"):
+		// Stores, including the STADD-style atomics.
+		return 0, 0, false
+	case strings.HasPrefix(name, "CASP"):
+		return 0, 2, true
+	case strings.HasPrefix(name, "CAS"):
+		return 0, 1, true
+	case isAtomic(name):
+		// LDADD <Ws>, <Wt>, [<Xn|SP>] writes only Wt.
+		return 1, 1, false
+	}
+	return 0, 1, false
+}
+
+// isAtomic reports whether name is an LSE atomic memory operation
+// such as LDADD, LDCLRAL, or SWPB.
+func isAtomic(name string) bool {
+	for _, p := range []string{"LDADD", "LDCLR", "LDEOR", "LDSET", "LDSMAX", "LDSMIN", "LDUMAX", "LDUMIN", "SWP"} {
+		if strings.HasPrefix(name, p) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,119 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package arminst defines an instruction interface shared by the
+// 32-bit ARM and 64-bit A64 decoders, so that tools such as tracers
+// and objdump-like listers can be written once for both.
+//
+// The decoders' own Inst types cannot implement the interface directly:
+// their Op, Args, and Len fields would collide with its methods, and
+// classifying a 32-bit instruction requires the mode it was decoded in.
+// Instead the ARM and ARM64 types wrap a decoded instruction,
+// keeping its fields accessible by embedding.
+package arminst
+
+import (
+	"fmt"
+
+	"rsc.io/arm/arm64asm"
+	"rsc.io/arm/armasm"
+)
+
+// An Inst is a decoded instruction from either instruction set.
+type Inst interface {
+	// Mnemonic returns the opcode name, as printed by the decoder's String method.
+	Mnemonic() string
+
+	// Operands returns the instruction arguments, in ARM manual order.
+	// Each is an armasm.Arg or an arm64asm.Arg.
+	Operands() []Arg
+
+	// Size returns the length of the instruction encoding in bytes.
+	Size() int
+
+	// Class returns the broad class of the instruction.
+	Class() Class
+
+	// Uses returns the registers read by the instruction.
+	// Defs returns the registers written by the instruction.
+	// The result lists registers explicitly named by the operands,
+	// including base registers of memory operands, along with
+	// the link register written by calls.
+	// Each register is an armasm.Reg or armasm.RegList,
+	// or an arm64asm register argument such as Reg, VReg, or ZReg.
+	Uses() []Arg
+	Defs() []Arg
+
+	// ReadsPC reports whether the instruction reads the program counter,
+	// either as an operand, such as the PC in ADD R0, PC, #8 or in
+	// a PC-relative memory operand, or through a PC-relative branch target.
+	// WritesPC reports whether the instruction may write the program counter,
+	// either as a branch or by writing PC as a destination register,
+	// as in LDR PC, [R0] or POP {R4, PC}. Both ignore any condition.
+	ReadsPC() bool
+	WritesPC() bool
+
+	String() string
+}
+
+// An Arg is a single instruction argument from either instruction set.
+type Arg interface {
+	String() string
+}
+
+// A Class is a broad classification of an instruction.
+type Class uint8
+
+const (
+	ClassOther        Class = iota // any other instruction; continues at the next instruction
+	ClassJump                      // branch, possibly conditional, to a PC-relative target
+	ClassCall                      // call to a PC-relative target
+	ClassIndirectJump              // branch to a computed target
+	ClassIndirectCall              // call to a computed target
+	ClassReturn                    // function return
+	ClassTrap                      // breakpoint or undefined instruction
+	ClassLoad                      // memory load, including atomic read-modify-write
+	ClassStore                     // memory store
+)
+
+var className = [...]string{
+	ClassOther:        "Other",
+	ClassJump:         "Jump",
+	ClassCall:         "Call",
+	ClassIndirectJump: "IndirectJump",
+	ClassIndirectCall: "IndirectCall",
+	ClassReturn:       "Return",
+	ClassTrap:         "Trap",
+	ClassLoad:         "Load",
+	ClassStore:        "Store",
+}
+
+func (c Class) String() string {
+	if int(c) < len(className) {
+		return className[c]
+	}
+	return fmt.Sprintf("Class(%d)", int(c))
+}
+
+// IsBranch reports whether c is one of the control flow classes.
+func (c Class) IsBranch() bool {
+	return ClassJump <= c && c <= ClassReturn
+}
+
+var (
+	_ Inst = ARM{}
+	_ Inst = ARM64{}
+)
+
+// DecodeARM decodes the 32-bit ARM or Thumb instruction at the start of src.
+func DecodeARM(src []byte, mode armasm.Mode) (ARM, error) {
+	inst, err := armasm.Decode(src, mode)
+	return ARM{inst, mode}, err
+}
+
+// DecodeARM64 decodes the A64 instruction at the start of src.
+func DecodeARM64(src []byte) (ARM64, error) {
+	inst, err := arm64asm.Decode(src)
+	return ARM64{inst}, err
+}
@@ -0,0 +1,149 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package arminst
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"rsc.io/arm/armasm"
+)
+
+var instTests = []struct {
+	arch  string // "arm" or "arm64"
+	enc   uint32
+	class Class
+	uses  string
+	defs  string
+}{
+	{"arm", 0xe0810002, ClassOther, "[R1 R2]", "[R0]"},                // ADD R0, R1, R2
+	{"arm", 0xe0a10312, ClassOther, "[R1 R2 R3]", "[R0]"},             // ADC R0, R1, R2, LSL R3
+	{"arm", 0xe3400001, ClassOther, "[R0]", "[R0]"},                   // MOVT R0, #1
+	{"arm", 0xe0c10392, ClassOther, "[R2 R3]", "[R0 R1]"},             // SMULL R0, R1, R2, R3
+	{"arm", 0xe1500001, ClassOther, "[R0 R1]", "[]"},                  // CMP R0, R1
+	{"arm", 0xe5b10004, ClassLoad, "[R1]", "[R0 R1]"},                 // LDR R0, [R1, #4]!
+	{"arm", 0xe7810102, ClassStore, "[R0 R1 R2]", "[]"},               // STR R0, [R1, R2, LSL #2]
+	{"arm", 0xe92d4010, ClassStore, "[SP {R4,LR}]", "[SP]"},           // PUSH {R4, LR}
+	{"arm", 0xe8bd8010, ClassReturn, "[SP]", "[{R4,PC} SP]"},          // POP {R4, PC}
+	{"arm", 0xeb000000, ClassCall, "[]", "[LR]"},                      // BL
+	{"arm", 0xe12fff33, ClassIndirectCall, "[R3]", "[LR]"},            // BLX R3
+	{"arm", 0xe12fff1e, ClassReturn, "[LR]", "[]"},                    // BX LR
+	{"arm", 0x0a000000, ClassJump, "[]", "[]"},                        // BEQ
+	{"arm64", 0x8b020020, ClassOther, "[X1 X2]", "[X0]"},              // ADD X0, X1, X2
+	{"arm64", 0xf2a00020, ClassOther, "[X0]", "[X0]"},                 // MOVK X0, #1, LSL #16
+	{"arm64", 0xeb01001f, ClassOther, "[X0 X1]", "[]"},                // CMP X0, X1
+	{"arm64", 0xa9bf7bfd, ClassStore, "[X29 X30 SP]", "[SP]"},         // STP X29, X30, [SP, #-16]!
+	{"arm64", 0xa8c17bfd, ClassLoad, "[SP]", "[X29 X30 SP]"},          // LDP X29, X30, [SP], #16
+	{"arm64", 0xf8617800, ClassLoad, "[X0 X1]", "[X0]"},               // LDR X0, [X0, X1, LSL #3]
+	{"arm64", 0x88007c22, ClassStore, "[W2 X1]", "[W0]"},              // STXR W0, W2, [X1]
+	{"arm64", 0xb8200020, ClassLoad, "[W0 X1]", "[W0]"},               // LDADD W0, W0, [X1]
+	{"arm64", 0xb820003f, ClassStore, "[W0 X1]", "[]"},                // STADD W0, [X1]
+	{"arm64", 0x88a07c41, ClassLoad, "[W0 W1 X2]", "[W0]"},            // CAS W0, W1, [X2]
+	{"arm64", 0x4ea28420, ClassOther, "[V1.4S V2.4S]", "[V0.4S]"},     // ADD V0.4S, V1.4S, V2.4S
+	{"arm64", 0x4cdfa000, ClassLoad, "[X0]", "[{V0.16B, V1.16B} X0]"}, // LD1 {V0.16B, V1.16B}, [X0], #32
+	{"arm64", 0x94000000, ClassCall, "[]", "[X30]"},                   // BL
+	{"arm64", 0xd63f0200, ClassIndirectCall, "[X16]", "[X30]"},        // BLR X16
+	{"arm64", 0xd65f03c0, ClassReturn, "[X30]", "[]"},                 // RET
+	{"arm64", 0xb4000040, ClassJump, "[X0]", "[]"},                    // CBZ X0
+	{"arm64", 0xd4200000, ClassTrap, "[]", "[]"},                      // BRK #0
+}
+
+func TestInst(t *testing.T) {
+	for _, tt := range instTests {
+		var inst Inst
+		var err error
+		switch tt.arch {
+		case "arm":
+			buf := make([]byte, 4)
+			binary.LittleEndian.PutUint32(buf, tt.enc)
+			inst, err = DecodeARM(buf, armasm.ModeARM)
+		case "arm64":
+			buf := make([]byte, 4)
+			binary.LittleEndian.PutUint32(buf, tt.enc)
+			inst, err = DecodeARM64(buf)
+		}
+		if err != nil {
+			t.Errorf("%s %#x: %v", tt.arch, tt.enc, err)
+			continue
+		}
+		if c := inst.Class(); c != tt.class {
+			t.Errorf("%s %v: Class() = %v, want %v", tt.arch, inst, c, tt.class)
+		}
+		if uses := fmt.Sprint(inst.Uses()); uses != tt.uses {
+			t.Errorf("%s %v: Uses() = %s, want %s", tt.arch, inst, uses, tt.uses)
+		}
+		if defs := fmt.Sprint(inst.Defs()); defs != tt.defs {
+			t.Errorf("%s %v: Defs() = %s, want %s", tt.arch, inst, defs, tt.defs)
+		}
+	}
+}
+
+func TestInstSize(t *testing.T) {
+	arm, err := DecodeARM([]byte{0x02, 0x00, 0x81, 0xe0}, armasm.ModeARM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	a64, err := DecodeARM64([]byte{0x20, 0x00, 0x02, 0x8b})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, inst := range []Inst{arm, a64} {
+		if inst.Size() != 4 {
+			t.Errorf("%v: Size() = %d, want 4", inst, inst.Size())
+		}
+		if len(inst.Operands()) != 3 {
+			t.Errorf("%v: Operands() = %v, want 3 operands", inst, inst.Operands())
+		}
+		if inst.Mnemonic() != "ADD" && inst.Mnemonic() != "ADD.EQ" {
+			t.Errorf("%v: Mnemonic() = %q", inst, inst.Mnemonic())
+		}
+	}
+}
+
+func TestPC(t *testing.T) {
+	for _, tt := range []struct {
+		arch          string
+		enc           uint32
+		reads, writes bool
+	}{
+		{"arm", 0xe0810002, false, false},   // ADD R0, R1, R2
+		{"arm", 0xe28f0008, true, false},    // ADD R0, PC, #8
+		{"arm", 0xe59f0004, true, false},    // LDR R0, [PC, #4]
+		{"arm", 0xe92dc000, true, false},    // PUSH {LR, PC}
+		{"arm", 0xe1a0f003, false, true},    // MOV PC, R3
+		{"arm", 0xe590f000, false, true},    // LDR PC, [R0]
+		{"arm", 0xe8bd8010, false, true},    // POP {R4, PC}
+		{"arm", 0xe12fff1e, false, true},    // BX LR
+		{"arm", 0x0a000000, true, true},     // BEQ
+		{"arm", 0xeb000000, true, true},     // BL
+		{"arm64", 0x8b020020, false, false}, // ADD X0, X1, X2
+		{"arm64", 0x10000040, true, false},  // ADR X0, .+8
+		{"arm64", 0x58000040, true, false},  // LDR X0, .+8
+		{"arm64", 0x94000000, true, true},   // BL
+		{"arm64", 0xd61f0200, false, true},  // BR X16
+		{"arm64", 0xd65f03c0, false, true},  // RET
+	} {
+		buf := make([]byte, 4)
+		binary.LittleEndian.PutUint32(buf, tt.enc)
+		var inst Inst
+		var err error
+		if tt.arch == "arm" {
+			inst, err = DecodeARM(buf, armasm.ModeARM)
+		} else {
+			inst, err = DecodeARM64(buf)
+		}
+		if err != nil {
+			t.Errorf("%s %#x: %v", tt.arch, tt.enc, err)
+			continue
+		}
+		if r := inst.ReadsPC(); r != tt.reads {
+			t.Errorf("%s %v: ReadsPC() = %v, want %v", tt.arch, inst, r, tt.reads)
+		}
+		if w := inst.WritesPC(); w != tt.writes {
+			t.Errorf("%s %v: WritesPC() = %v, want %v", tt.arch, inst, w, tt.writes)
+		}
+	}
+}
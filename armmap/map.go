@@ -11,11 +11,21 @@
 //
 //  text (default) - print decoding tree in text form
 //  decoder - print decoding tables for the armasm package
+//  json - print every encoding in JSON form, for use by other tools
+//
+// The JSON output is an array with one object per decoding table entry.
+// Each object gives the entry's mask and value (an instruction word w
+// matches if w&mask == value), its decoding priority (higher wins),
+// the opcode mnemonic, the encoding fields and their bit offsets,
+// the arguments with the fields each one is decoded from and the
+// name of the armasm decoder for it, and the architecture extensions
+// the entry requires, if any, beyond the base ARMv7 instruction set.
 package main
 
 import (
 	"bufio"
 	"encoding/csv"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
@@ -25,7 +35,7 @@ import (
 	"strings"
 )
 
-var format = flag.String("fmt", "text", "output format: text, decoder")
+var format = flag.String("fmt", "text", "output format: text, decoder, json")
 
 var inputFile string
 
@@ -54,6 +64,8 @@ func main() {
 		print = printText
 	case "decoder":
 		print = printDecoder
+	case "json":
+		print = printJSON
 	}
 
 	p, err := readCSV(flag.Arg(0))
@@ -121,6 +133,16 @@ type Inst struct {
 	OpBase   string
 	OpBits   uint64
 	Args     []string
+	Op       string   // mnemonic without suffixes
+	Fields   []Field  // encoding fields, excluding fixed bits
+	Arch     []string // required architecture extensions
+}
+
+// A Field is a named field in an instruction encoding.
+type Field struct {
+	Name   string
+	Offset int
+	Width  int
 }
 
 type Arg struct {
@@ -157,17 +179,24 @@ func add(p *Prog, maskstr, valuestr, text, encoding, tags string) {
 	fuzzy := uint32(0) // mask of 'should be' bits
 	fieldOffset := map[string]int{}
 	fieldWidth := map[string]int{}
+	var fields []Field
 	off := 32
 	for _, f := range strings.Split(encoding, "|") {
 		n := 1
+		name := f
 		if i := strings.Index(f, ":"); i >= 0 {
 			n, _ = strconv.Atoi(f[i+1:])
+			name = f[:i]
 		}
 		off -= n
 		fieldOffset[f] = off
 		fieldWidth[f] = n
-		if f == "(0)" || f == "(1)" {
+		switch f {
+		case "(0)", "(1)":
 			fuzzy |= 1 << uint(off)
+		case "0", "1":
+		default:
+			fields = append(fields, Field{name, off, n})
 		}
 	}
 	if off != 0 {
@@ -388,6 +417,11 @@ func add(p *Prog, maskstr, valuestr, text, encoding, tags string) {
 		pri = 2
 	}
 
+	var arch []string
+	if strings.Contains(tags, "vfp") {
+		arch = append(arch, "VFP")
+	}
+
 	inst := Inst{
 		Text:     text,
 		Encoding: encoding,
@@ -397,6 +431,9 @@ func add(p *Prog, maskstr, valuestr, text, encoding, tags string) {
 		OpBase:   ops[0],
 		OpBits:   opBits,
 		Args:     args,
+		Op:       op,
+		Fields:   fields,
+		Arch:     arch,
 	}
 	p.Inst = append(p.Inst, inst)
 
@@ -443,6 +480,225 @@ var choices = map[string][]string{
 	"y": {"B", "T"},
 }
 
+// opDescription gives a short description of each opcode mnemonic,
+// following the instruction titles in the ARM Architecture Reference Manual.
+var opDescription = map[string]string{
+	"ADC":     "Add with Carry",
+	"ADD":     "Add",
+	"AND":     "Bitwise AND",
+	"ASR":     "Arithmetic Shift Right",
+	"B":       "Branch",
+	"BFC":     "Bit Field Clear",
+	"BFI":     "Bit Field Insert",
+	"BIC":     "Bitwise Bit Clear",
+	"BKPT":    "Breakpoint",
+	"BL":      "Branch with Link",
+	"BLX":     "Branch with Link and Exchange",
+	"BX":      "Branch and Exchange",
+	"BXJ":     "Branch and Exchange Jazelle",
+	"CLREX":   "Clear-Exclusive",
+	"CLZ":     "Count Leading Zeros",
+	"CMN":     "Compare Negative",
+	"CMP":     "Compare",
+	"DBG":     "Debug Hint",
+	"DMB":     "Data Memory Barrier",
+	"DSB":     "Data Synchronization Barrier",
+	"EOR":     "Bitwise Exclusive OR",
+	"ERET":    "Exception Return",
+	"ISB":     "Instruction Synchronization Barrier",
+	"LDM":     "Load Multiple (Increment After)",
+	"LDMDA":   "Load Multiple Decrement After",
+	"LDMDB":   "Load Multiple Decrement Before",
+	"LDMIB":   "Load Multiple Increment Before",
+	"LDR":     "Load Register",
+	"LDRB":    "Load Register Byte",
+	"LDRBT":   "Load Register Byte Unprivileged",
+	"LDRD":    "Load Register Dual",
+	"LDREX":   "Load Register Exclusive",
+	"LDREXB":  "Load Register Exclusive Byte",
+	"LDREXD":  "Load Register Exclusive Doubleword",
+	"LDREXH":  "Load Register Exclusive Halfword",
+	"LDRH":    "Load Register Halfword",
+	"LDRHT":   "Load Register Halfword Unprivileged",
+	"LDRSB":   "Load Register Signed Byte",
+	"LDRSBT":  "Load Register Signed Byte Unprivileged",
+	"LDRSH":   "Load Register Signed Halfword",
+	"LDRSHT":  "Load Register Signed Halfword Unprivileged",
+	"LDRT":    "Load Register Unprivileged",
+	"LSL":     "Logical Shift Left",
+	"LSR":     "Logical Shift Right",
+	"MLA":     "Multiply Accumulate",
+	"MLS":     "Multiply and Subtract",
+	"MOV":     "Move",
+	"MOVT":    "Move Top",
+	"MOVW":    "Move Wide",
+	"MRS":     "Move to Register from Special Register",
+	"MUL":     "Multiply",
+	"MVN":     "Bitwise NOT",
+	"NOP":     "No Operation",
+	"ORR":     "Bitwise OR",
+	"PKHBT":   "Pack Halfword Bottom Top",
+	"PKHTB":   "Pack Halfword Top Bottom",
+	"PLD":     "Preload Data",
+	"PLI":     "Preload Instruction",
+	"POP":     "Pop Multiple Registers",
+	"PUSH":    "Push Multiple Registers",
+	"QADD":    "Saturating Add",
+	"QADD16":  "Saturating Add 16",
+	"QADD8":   "Saturating Add 8",
+	"QASX":    "Saturating Add and Subtract with Exchange",
+	"QDADD":   "Saturating Double and Add",
+	"QDSUB":   "Saturating Double and Subtract",
+	"QSAX":    "Saturating Subtract and Add with Exchange",
+	"QSUB":    "Saturating Subtract",
+	"QSUB16":  "Saturating Subtract 16",
+	"QSUB8":   "Saturating Subtract 8",
+	"RBIT":    "Reverse Bits",
+	"REV":     "Byte-Reverse Word",
+	"REV16":   "Byte-Reverse Packed Halfword",
+	"REVSH":   "Byte-Reverse Signed Halfword",
+	"ROR":     "Rotate Right",
+	"RRX":     "Rotate Right with Extend",
+	"RSB":     "Reverse Subtract",
+	"RSC":     "Reverse Subtract with Carry",
+	"SADD16":  "Signed Add 16",
+	"SADD8":   "Signed Add 8",
+	"SASX":    "Signed Add and Subtract with Exchange",
+	"SBC":     "Subtract with Carry",
+	"SBFX":    "Signed Bit Field Extract",
+	"SEL":     "Select Bytes",
+	"SETEND":  "Set Endianness",
+	"SEV":     "Send Event",
+	"SHADD16": "Signed Halving Add 16",
+	"SHADD8":  "Signed Halving Add 8",
+	"SHASX":   "Signed Halving Add and Subtract with Exchange",
+	"SHSAX":   "Signed Halving Subtract and Add with Exchange",
+	"SHSUB16": "Signed Halving Subtract 16",
+	"SHSUB8":  "Signed Halving Subtract 8",
+	"SMLABB":  "Signed Multiply Accumulate (halfwords)",
+	"SMLABT":  "Signed Multiply Accumulate (halfwords)",
+	"SMLAD":   "Signed Multiply Accumulate Dual",
+	"SMLAL":   "Signed Multiply Accumulate Long",
+	"SMLALBB": "Signed Multiply Accumulate Long (halfwords)",
+	"SMLALBT": "Signed Multiply Accumulate Long (halfwords)",
+	"SMLALD":  "Signed Multiply Accumulate Long Dual",
+	"SMLALTB": "Signed Multiply Accumulate Long (halfwords)",
+	"SMLALTT": "Signed Multiply Accumulate Long (halfwords)",
+	"SMLATB":  "Signed Multiply Accumulate (halfwords)",
+	"SMLATT":  "Signed Multiply Accumulate (halfwords)",
+	"SMLAWB":  "Signed Multiply Accumulate (word by halfword)",
+	"SMLAWT":  "Signed Multiply Accumulate (word by halfword)",
+	"SMLSD":   "Signed Multiply Subtract Dual",
+	"SMLSLD":  "Signed Multiply Subtract Long Dual",
+	"SMMLA":   "Signed Most Significant Word Multiply Accumulate",
+	"SMMLS":   "Signed Most Significant Word Multiply Subtract",
+	"SMMUL":   "Signed Most Significant Word Multiply",
+	"SMUAD":   "Signed Dual Multiply Add",
+	"SMULBB":  "Signed Multiply (halfwords)",
+	"SMULBT":  "Signed Multiply (halfwords)",
+	"SMULL":   "Signed Multiply Long",
+	"SMULTB":  "Signed Multiply (halfwords)",
+	"SMULTT":  "Signed Multiply (halfwords)",
+	"SMULWB":  "Signed Multiply (word by halfword)",
+	"SMULWT":  "Signed Multiply (word by halfword)",
+	"SMUSD":   "Signed Dual Multiply Subtract",
+	"SSAT":    "Signed Saturate",
+	"SSAT16":  "Signed Saturate 16",
+	"SSAX":    "Signed Subtract and Add with Exchange",
+	"SSUB16":  "Signed Subtract 16",
+	"SSUB8":   "Signed Subtract 8",
+	"STM":     "Store Multiple (Increment After)",
+	"STMDA":   "Store Multiple Decrement After",
+	"STMDB":   "Store Multiple Decrement Before",
+	"STMIB":   "Store Multiple Increment Before",
+	"STR":     "Store Register",
+	"STRB":    "Store Register Byte",
+	"STRBT":   "Store Register Byte Unprivileged",
+	"STRD":    "Store Register Dual",
+	"STREX":   "Store Register Exclusive",
+	"STREXB":  "Store Register Exclusive Byte",
+	"STREXD":  "Store Register Exclusive Doubleword",
+	"STREXH":  "Store Register Exclusive Halfword",
+	"STRH":    "Store Register Halfword",
+	"STRHT":   "Store Register Halfword Unprivileged",
+	"STRT":    "Store Register Unprivileged",
+	"SUB":     "Subtract",
+	"SVC":     "Supervisor Call",
+	"SWP":     "Swap",
+	"SXTAB":   "Signed Extend and Add Byte",
+	"SXTAB16": "Signed Extend and Add Byte 16",
+	"SXTAH":   "Signed Extend and Add Halfword",
+	"SXTB":    "Signed Extend Byte",
+	"SXTB16":  "Signed Extend Byte 16",
+	"SXTH":    "Signed Extend Halfword",
+	"TEQ":     "Test Equivalence",
+	"TST":     "Test",
+	"UADD16":  "Unsigned Add 16",
+	"UADD8":   "Unsigned Add 8",
+	"UASX":    "Unsigned Add and Subtract with Exchange",
+	"UBFX":    "Unsigned Bit Field Extract",
+	"UHADD16": "Unsigned Halving Add 16",
+	"UHADD8":  "Unsigned Halving Add 8",
+	"UHASX":   "Unsigned Halving Add and Subtract with Exchange",
+	"UHSAX":   "Unsigned Halving Subtract and Add with Exchange",
+	"UHSUB16": "Unsigned Halving Subtract 16",
+	"UHSUB8":  "Unsigned Halving Subtract 8",
+	"UMAAL":   "Unsigned Multiply Accumulate Accumulate Long",
+	"UMLAL":   "Unsigned Multiply Accumulate Long",
+	"UMULL":   "Unsigned Multiply Long",
+	"UNDEF":   "Permanently Undefined",
+	"UQADD16": "Unsigned Saturating Add 16",
+	"UQADD8":  "Unsigned Saturating Add 8",
+	"UQASX":   "Unsigned Saturating Add and Subtract with Exchange",
+	"UQSAX":   "Unsigned Saturating Subtract and Add with Exchange",
+	"UQSUB16": "Unsigned Saturating Subtract 16",
+	"UQSUB8":  "Unsigned Saturating Subtract 8",
+	"USAD8":   "Unsigned Sum of Absolute Differences",
+	"USADA8":  "Unsigned Sum of Absolute Differences and Accumulate",
+	"USAT":    "Unsigned Saturate",
+	"USAT16":  "Unsigned Saturate 16",
+	"USAX":    "Unsigned Subtract and Add with Exchange",
+	"USUB16":  "Unsigned Subtract 16",
+	"USUB8":   "Unsigned Subtract 8",
+	"UXTAB":   "Unsigned Extend and Add Byte",
+	"UXTAB16": "Unsigned Extend and Add Byte 16",
+	"UXTAH":   "Unsigned Extend and Add Halfword",
+	"UXTB":    "Unsigned Extend Byte",
+	"UXTB16":  "Unsigned Extend Byte 16",
+	"UXTH":    "Unsigned Extend Halfword",
+	"VABS":    "Floating-point Absolute",
+	"VADD":    "Floating-point Add",
+	"VCMP":    "Floating-point Compare",
+	"VCVT":    "Floating-point Convert",
+	"VCVTB":   "Convert to or from Half-precision Bottom",
+	"VCVTR":   "Convert Floating-point to Integer with Rounding Mode",
+	"VCVTT":   "Convert to or from Half-precision Top",
+	"VDIV":    "Floating-point Divide",
+	"VLDMDB":  "Load Multiple Floating-point Registers Decrement Before",
+	"VLDMIA":  "Load Multiple Floating-point Registers Increment After",
+	"VLDR":    "Load Floating-point Register",
+	"VMLA":    "Floating-point Multiply Accumulate",
+	"VMLS":    "Floating-point Multiply Subtract",
+	"VMOV":    "Floating-point Move",
+	"VMRS":    "Move to Register from Floating-point Special Register",
+	"VMSR":    "Move to Floating-point Special Register from Register",
+	"VMUL":    "Floating-point Multiply",
+	"VNEG":    "Floating-point Negate",
+	"VNMLA":   "Floating-point Negate Multiply Accumulate",
+	"VNMLS":   "Floating-point Negate Multiply Subtract",
+	"VNMUL":   "Floating-point Negate Multiply",
+	"VPOP":    "Pop Floating-point Registers",
+	"VPUSH":   "Push Floating-point Registers",
+	"VSQRT":   "Floating-point Square Root",
+	"VSTMDB":  "Store Multiple Floating-point Registers Decrement Before",
+	"VSTMIA":  "Store Multiple Floating-point Registers Increment After",
+	"VSTR":    "Store Floating-point Register",
+	"VSUB":    "Floating-point Subtract",
+	"WFE":     "Wait For Event",
+	"WFI":     "Wait For Interrupt",
+	"YIELD":   "Yield",
+}
+
 // argOps maps from argument descriptions to internal decoder name.
 var argOps = map[string]string{
 	// 4-bit register encodings
@@ -521,8 +777,12 @@ var argOps = map[string]string{
 
 	"SP":    "arg_SP",
 	"APSR":  "arg_APSR",
+	"SPSR":  "arg_SPSR",
 	"FPSCR": "arg_FPSCR",
 
+	"<spec_reg>|reg:4@16":     "arg_spec_reg",
+	"<spec_reg_msr>|reg:4@16": "arg_spec_reg_msr",
+
 	// VFP floating point registers
 	"<Sd>|Vd:4@12|D@22":         "arg_Sd",
 	"<Sd,Dd>|Vd:4@12|D@22|sz@8": "arg_Sd_Dd",
@@ -536,6 +796,8 @@ var argOps = map[string]string{
 	"#<fbits>|sx@7|imm4:4@0|i@5":           "arg_fbits",
 	"<Dn[x]>|N@7|Vn:4@16|opc1@21":          "arg_Dn_half",
 	"<Dd[x]>|D@7|Vd:4@16|opc1@21":          "arg_Dn_half",
+	"<vlist32>|D@22|Vd:4@12|imm8:8@0":      "arg_vlist32",
+	"<vlist64>|D@22|Vd:4@12|imm8:8@0":      "arg_vlist64",
 }
 
 // argSuffixes describes the encoding fields needed for a particular suffix.
@@ -623,6 +885,9 @@ var argSuffixes = map[string]string{
 	"APSR":                         "",
 	"<Rm>,<type> <Rs>":             "Rm:4,Rs:4,type:2",
 	"FPSCR":                        "",
+	"SPSR":                         "",
+	"<spec_reg>":                   "reg:4",
+	"<spec_reg_msr>":               "reg:4",
 	"SP":                           "",
 	"[<Rn>,#+/-<imm12>]":           "Rn:4,U,imm12:12",
 	"[<Rn>,+/-<Rm>]{!}":            "Rn:4,U,Rm:4,P,W",
@@ -702,6 +967,7 @@ func printDecoder(p *Prog) {
 	iota := 0
 	fmt.Printf("\t_ Op = iota\n")
 	iota++
+	opnames := []string{""} // opnames[op] is the name of op, or "" for a blank
 	for _, r := range ranges {
 		for _, op := range strings.Split(r, ",") {
 			if op == "" {
@@ -713,24 +979,33 @@ func printDecoder(p *Prog) {
 			if strings.Contains(op, ".EQ") {
 				for iota&15 != 0 {
 					fmt.Printf("\t_\n")
+					opnames = append(opnames, "")
 					iota++
 				}
 			}
 			fmt.Printf("\t%s\n", strings.Replace(op, ".", "_", -1))
+			opnames = append(opnames, op)
 			iota++
 		}
 	}
 	fmt.Printf(")\n")
 
-	// Emit slice mapping opcode number to name string.
-	fmt.Printf("\nvar opstr = [...]string{\n")
-	for _, r := range ranges {
-		for _, op := range strings.Split(r, ",") {
-			if op == "" {
-				continue
-			}
-			fmt.Printf("\t%s: %q,\n", strings.Replace(op, ".", "_", -1), op)
-		}
+	// Emit opcode name strings, packed into a single string
+	// to avoid a string header per opcode.
+	fmt.Printf("\n// opstrData holds the opcode names, concatenated in opcode order.\n")
+	fmt.Printf("// The name of op is opstrData[opstrIndex[op]:opstrIndex[op+1]].\n")
+	printPacked("opstrData", "opstrIndex", opnames)
+
+	// Emit table from opcode mnemonic (without suffixes) to description,
+	// sorted by mnemonic for binary search.
+	var names []string
+	for name := range opDescription {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	fmt.Printf("\nvar opdesc = [...]struct{ name, desc string }{\n")
+	for _, name := range names {
+		fmt.Printf("\t{%q, %q},\n", name, opDescription[name])
 	}
 	fmt.Printf("}\n")
 
@@ -738,7 +1013,7 @@ func printDecoder(p *Prog) {
 	unknown := map[string]bool{}
 	fmt.Printf("\nvar instFormats = [...]instFormat{\n")
 	for _, inst := range p.Inst {
-		fmt.Printf("\t{%#08x, %#08x, %d, %s, %#x, instArgs{", inst.Mask, inst.Value, inst.Priority, strings.Replace(inst.OpBase, ".", "_", -1), inst.OpBits)
+		fmt.Printf("\t{%#08x, %#08x, %#x, %s, %d, instArgs{", inst.Mask, inst.Value, inst.OpBits, strings.Replace(inst.OpBase, ".", "_", -1), inst.Priority)
 		for i, a := range inst.Args {
 			if i > 0 {
 				fmt.Printf(", ")
@@ -753,4 +1028,139 @@ func printDecoder(p *Prog) {
 		fmt.Printf("}}, // %s %s\n", inst.Text, inst.Encoding)
 	}
 	fmt.Printf("}\n")
+
+	// Emit manual syntax and bit layout for each decoding table entry.
+	// Emit the syntax and encoding of each instFormats entry,
+	// packed into a single string like the opcode names.
+	// Many entries share a syntax or a layout, so each distinct
+	// string appears only once.
+	var (
+		data  []string
+		size  int
+		index [][4]int
+		off   = map[string]int{}
+	)
+	add := func(s string) (start, end int) {
+		o, ok := off[s]
+		if !ok {
+			o = size
+			off[s] = o
+			data = append(data, s)
+			size += len(s)
+		}
+		return o, o + len(s)
+	}
+	for _, inst := range p.Inst {
+		var x [4]int
+		x[0], x[1] = add(inst.Text)
+		x[2], x[3] = add(inst.Encoding)
+		index = append(index, x)
+	}
+	if size >= 1<<16 {
+		log.Fatalf("instSyntaxData too long for uint16 index")
+	}
+	fmt.Printf("\n// instSyntaxData holds the assembly syntax and encoding bit layout\n")
+	fmt.Printf("// of the instFormats entries. The syntax of entry i is\n")
+	fmt.Printf("// instSyntaxData[x[0]:x[1]] and its layout is instSyntaxData[x[2]:x[3]],\n")
+	fmt.Printf("// where x is instSyntaxIndex[i].\n")
+	printData("instSyntaxData", data)
+	fmt.Printf("\nvar instSyntaxIndex = [...][4]uint16{\n")
+	for _, x := range index {
+		fmt.Printf("\t{%d, %d, %d, %d},\n", x[0], x[1], x[2], x[3])
+	}
+	fmt.Printf("}\n")
+}
+
+// printData prints the concatenation of the strings in list
+// as a string constant named name.
+func printData(name string, list []string) {
+	fmt.Printf("const %s = \"\" +\n", name)
+	line := ""
+	for _, s := range list {
+		if line != "" && len(line)+len(s) > 64 {
+			fmt.Printf("\t%q +\n", line)
+			line = ""
+		}
+		line += s
+	}
+	fmt.Printf("\t%q\n", line)
+}
+
+// printPacked prints the strings in list as a single string constant named
+// data, along with an array named index of their uint16 offsets in data,
+// holding one more offset than there are strings, so that list[i] is
+// data[index[i]:index[i+1]]. Packing avoids a string header per string.
+func printPacked(data, index string, list []string) {
+	printData(data, list)
+	fmt.Printf("\nvar %s = [...]uint16{", index)
+	off := 0
+	for i, s := range list {
+		if i%12 == 0 {
+			fmt.Printf("\n\t")
+		} else {
+			fmt.Printf(" ")
+		}
+		fmt.Printf("%d,", off)
+		off += len(s)
+	}
+	fmt.Printf(" %d,\n}\n", off)
+	if off >= 1<<16 {
+		log.Fatalf("%s too long for uint16 index", data)
+	}
+}
+
+// printJSON implements the -fmt=json mode.
+func printJSON(p *Prog) {
+	type jsonArg struct {
+		Text    string  // argument syntax, like <Rd>
+		Decoder string  // armasm decoder, like arg_R_12
+		Fields  []Field // encoding fields holding the argument
+	}
+	type jsonInst struct {
+		Text     string // instruction syntax from the manual
+		Encoding string // encoding diagram, like cond:4|0|0|1|...
+		Mask     uint32
+		Value    uint32
+		Priority int
+		Op       string
+		Fields   []Field
+		Args     []jsonArg
+		Arch     []string `json:",omitempty"`
+	}
+	var out []jsonInst
+	for _, inst := range p.Inst {
+		j := jsonInst{
+			Text:     inst.Text,
+			Encoding: inst.Encoding,
+			Mask:     inst.Mask,
+			Value:    inst.Value,
+			Priority: inst.Priority,
+			Op:       inst.Op,
+			Fields:   inst.Fields,
+			Arch:     inst.Arch,
+		}
+		for _, a := range inst.Args {
+			// a has the form <argument>|field@off|field@off...
+			parts := strings.Split(a, "|")
+			arg := jsonArg{Text: parts[0], Decoder: argOps[a]}
+			for _, f := range parts[1:] {
+				i := strings.LastIndex(f, "@")
+				off, _ := strconv.Atoi(f[i+1:])
+				name, n := f[:i], 1
+				if k := strings.Index(name, ":"); k >= 0 {
+					n, _ = strconv.Atoi(name[k+1:])
+					name = name[:k]
+				}
+				arg.Fields = append(arg.Fields, Field{name, off, n})
+			}
+			j.Args = append(j.Args, arg)
+		}
+		out = append(out, j)
+	}
+	enc := json.NewEncoder(os.Stdout)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "\t")
+	if err := enc.Encode(out); err != nil {
+		log.Fatal(err)
+	}
 }
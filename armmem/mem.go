@@ -0,0 +1,142 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package armmem defines the memory interface shared by the ARM
+// emulator and analyses.
+//
+// A Memory reads and writes bytes by address. Implementations report
+// inaccessible addresses with a *Fault, so that callers can distinguish
+// a fault from other errors. The Image type implements Memory over
+// a set of regions, such as the loadable segments of an ELF executable
+// or core dump; callers can supply their own implementation to read
+// the memory of a live process.
+package armmem
+
+import (
+	"debug/elf"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sort"
+)
+
+// A Reader is read-only memory, indexed by address.
+// It has the same method as io.ReaderAt.
+type Reader interface {
+	ReadAt(p []byte, addr int64) (n int, err error)
+}
+
+// A Memory is readable and writable memory, indexed by address.
+type Memory interface {
+	Reader
+	WriteAt(p []byte, addr int64) (n int, err error)
+}
+
+// A Fault reports an access to memory that is unmapped or protected.
+type Fault struct {
+	Addr  uint64 // first address of the access
+	Size  int    // size of the access in bytes
+	Write bool   // access was a write
+}
+
+func (f *Fault) Error() string {
+	op := "read"
+	if f.Write {
+		op = "write"
+	}
+	return fmt.Sprintf("memory fault: %s of %d bytes at %#x", op, f.Size, f.Addr)
+}
+
+// ReadUint32 reads the little-endian 32-bit word at addr.
+func ReadUint32(m Reader, addr uint64) (uint32, error) {
+	var buf [4]byte
+	if _, err := m.ReadAt(buf[:], int64(addr)); err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint32(buf[:]), nil
+}
+
+// ReadUint16 reads the little-endian 16-bit halfword at addr.
+func ReadUint16(m Reader, addr uint64) (uint16, error) {
+	var buf [2]byte
+	if _, err := m.ReadAt(buf[:], int64(addr)); err != nil {
+		return 0, err
+	}
+	return binary.LittleEndian.Uint16(buf[:]), nil
+}
+
+// A Region is a contiguous range of memory.
+type Region struct {
+	Addr     uint64
+	Data     []byte
+	Writable bool
+}
+
+// An Image is a Memory made up of non-overlapping regions.
+// Accesses must fall entirely within a single region;
+// any other access faults. The zero Image has no regions.
+type Image struct {
+	Regions []*Region // sorted by Addr
+}
+
+// Add adds a region of memory holding data at addr.
+// The image refers to data directly; it does not make a copy.
+func (m *Image) Add(addr uint64, data []byte, writable bool) {
+	r := &Region{Addr: addr, Data: data, Writable: writable}
+	i := sort.Search(len(m.Regions), func(i int) bool { return m.Regions[i].Addr >= addr })
+	m.Regions = append(m.Regions, nil)
+	copy(m.Regions[i+1:], m.Regions[i:])
+	m.Regions[i] = r
+}
+
+// region returns the region containing [addr, addr+n), or nil.
+func (m *Image) region(addr uint64, n int) *Region {
+	i := sort.Search(len(m.Regions), func(i int) bool {
+		r := m.Regions[i]
+		return r.Addr+uint64(len(r.Data)) > addr
+	})
+	if i < len(m.Regions) {
+		r := m.Regions[i]
+		if r.Addr <= addr && addr+uint64(n) <= r.Addr+uint64(len(r.Data)) {
+			return r
+		}
+	}
+	return nil
+}
+
+// ReadAt implements Reader.
+func (m *Image) ReadAt(p []byte, addr int64) (int, error) {
+	r := m.region(uint64(addr), len(p))
+	if r == nil {
+		return 0, &Fault{Addr: uint64(addr), Size: len(p)}
+	}
+	return copy(p, r.Data[uint64(addr)-r.Addr:]), nil
+}
+
+// WriteAt implements Memory.
+func (m *Image) WriteAt(p []byte, addr int64) (int, error) {
+	r := m.region(uint64(addr), len(p))
+	if r == nil || !r.Writable {
+		return 0, &Fault{Addr: uint64(addr), Size: len(p), Write: true}
+	}
+	return copy(r.Data[uint64(addr)-r.Addr:], p), nil
+}
+
+// FromELF returns an Image holding the loadable segments of f,
+// which may be an executable, a shared library, or a core dump.
+// Each segment's data is read into memory and zero-filled to its memory size.
+func FromELF(f *elf.File) (*Image, error) {
+	m := new(Image)
+	for _, p := range f.Progs {
+		if p.Type != elf.PT_LOAD || p.Memsz == 0 {
+			continue
+		}
+		data := make([]byte, p.Memsz)
+		if _, err := io.ReadFull(p.Open(), data[:p.Filesz]); err != nil {
+			return nil, fmt.Errorf("reading segment at %#x: %v", p.Vaddr, err)
+		}
+		m.Add(p.Vaddr, data, p.Flags&elf.PF_W != 0)
+	}
+	return m, nil
+}
@@ -0,0 +1,31 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armmem
+
+import "testing"
+
+func TestImage(t *testing.T) {
+	var m Image
+	m.Add(0x2000, make([]byte, 0x100), true)
+	m.Add(0x1000, []byte{1, 2, 3, 4, 5, 6, 7, 8}, false)
+
+	if v, err := ReadUint32(&m, 0x1004); err != nil || v != 0x08070605 {
+		t.Errorf("ReadUint32(0x1004) = %#x, %v", v, err)
+	}
+	if _, err := ReadUint32(&m, 0x1006); err == nil {
+		t.Errorf("read past end of region succeeded")
+	} else if f, ok := err.(*Fault); !ok || f.Addr != 0x1006 || f.Size != 4 || f.Write {
+		t.Errorf("read past end of region: %v", err)
+	}
+	if _, err := m.WriteAt([]byte{0}, 0x1000); err == nil {
+		t.Errorf("write to read-only region succeeded")
+	}
+	if _, err := m.WriteAt([]byte{0xaa, 0xbb}, 0x20fe); err != nil {
+		t.Errorf("write to writable region: %v", err)
+	}
+	if v, err := ReadUint16(&m, 0x20fe); err != nil || v != 0xbbaa {
+		t.Errorf("ReadUint16(0x20fe) = %#x, %v", v, err)
+	}
+}
@@ -0,0 +1,254 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package armpat searches ARM code for instruction patterns.
+//
+// A Pattern matches decoded instructions by their assembly text,
+// with wildcards standing for mnemonics or operands.
+// A Bits pattern matches raw instruction encodings by value and mask.
+// Both are meant for signature scanning and for locating patch points.
+package armpat
+
+import (
+	"encoding/binary"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armdis"
+)
+
+// A Pattern is a compiled instruction pattern.
+//
+// The pattern text is written in the syntax of armasm.Inst.String,
+// such as "LDR *, [SP, #*]", ignoring case and spacing. Each token
+// (a mnemonic, register, number, or punctuation mark) in the pattern
+// matches one token of the instruction text. A token containing the
+// wildcards * or ? matches as by path.Match, so that "*" matches any
+// single operand and "LDR*" matches LDR with any condition.
+// Numbers match by value, so "#8" matches "#0x8".
+// Several instruction patterns separated by semicolons match a
+// sequence of consecutive instructions.
+type Pattern struct {
+	text  string
+	insts [][]string
+}
+
+// Compile parses a pattern.
+func Compile(text string) (*Pattern, error) {
+	p := &Pattern{text: text}
+	for _, s := range strings.Split(text, ";") {
+		toks := tokenize(s)
+		if len(toks) == 0 {
+			return nil, fmt.Errorf("empty instruction in pattern %q", text)
+		}
+		for _, tok := range toks {
+			if _, err := path.Match(tok, ""); err != nil && strings.ContainsAny(tok, "*?") {
+				return nil, fmt.Errorf("invalid token %q in pattern %q", tok, text)
+			}
+		}
+		p.insts = append(p.insts, toks)
+	}
+	return p, nil
+}
+
+// MustCompile is like Compile but panics if the pattern cannot be parsed.
+func MustCompile(text string) *Pattern {
+	p, err := Compile(text)
+	if err != nil {
+		panic("armpat: " + err.Error())
+	}
+	return p
+}
+
+func (p *Pattern) String() string {
+	return p.text
+}
+
+// Len returns the number of instructions matched by p.
+func (p *Pattern) Len() int {
+	return len(p.insts)
+}
+
+// tokenize splits assembly text into tokens, upper-casing words.
+func tokenize(s string) []string {
+	var toks []string
+	for i := 0; i < len(s); {
+		c := s[i]
+		switch {
+		case c == ' ' || c == '\t':
+			i++
+		case isWord(c):
+			j := i
+			for j < len(s) && isWord(s[j]) {
+				j++
+			}
+			toks = append(toks, strings.ToUpper(s[i:j]))
+			i = j
+		default:
+			toks = append(toks, s[i:i+1])
+			i++
+		}
+	}
+	return toks
+}
+
+func isWord(c byte) bool {
+	return 'a' <= c && c <= 'z' || 'A' <= c && c <= 'Z' || '0' <= c && c <= '9' ||
+		c == '_' || c == '.' || c == '-' || c == '+' || c == '*' || c == '?'
+}
+
+// matchToken reports whether the instruction token tok matches pattern token pat.
+func matchToken(pat, tok string) (wild, ok bool) {
+	if strings.ContainsAny(pat, "*?") {
+		ok, _ := path.Match(pat, tok)
+		return true, ok
+	}
+	if pat == tok {
+		return false, true
+	}
+	x, err1 := strconv.ParseInt(pat, 0, 64)
+	y, err2 := strconv.ParseInt(tok, 0, 64)
+	return false, err1 == nil && err2 == nil && x == y
+}
+
+// matchInst matches the tokens of inst against pat,
+// appending the tokens matched by wildcards to caps.
+func matchInst(pat []string, inst armasm.Inst, caps []string) ([]string, bool) {
+	toks := tokenize(inst.String())
+	if len(toks) != len(pat) {
+		return caps, false
+	}
+	for i, tok := range toks {
+		wild, ok := matchToken(pat[i], tok)
+		if !ok {
+			return caps, false
+		}
+		if wild {
+			caps = append(caps, tok)
+		}
+	}
+	return caps, true
+}
+
+// Match reports whether the instructions insts, which must be
+// consecutive, match p. If so, it also returns the text of the
+// tokens matched by wildcards, in order.
+func (p *Pattern) Match(insts ...armasm.Inst) ([]string, bool) {
+	if len(insts) != len(p.insts) {
+		return nil, false
+	}
+	caps := []string{}
+	for i, inst := range insts {
+		var ok bool
+		if caps, ok = matchInst(p.insts[i], inst, caps); !ok {
+			return nil, false
+		}
+	}
+	return caps, true
+}
+
+// A Match is a location where a pattern matched.
+type Match struct {
+	Addr     uint64        // address of the first matched instruction
+	Insts    []armasm.Inst // the matched instructions
+	Captures []string      // tokens matched by wildcards
+}
+
+func (m Match) String() string {
+	return fmt.Sprintf("%#x: %s", m.Addr, strings.Join(m.Captures, " "))
+}
+
+// Find returns the matches of p in the decoded code insts,
+// such as the result of armdis.Map.Insts.
+// Instructions are consecutive only if each begins where the
+// previous one ends.
+func (p *Pattern) Find(insts []armdis.Range) []Match {
+	var out []Match
+Outer:
+	for i := 0; i+len(p.insts) <= len(insts); i++ {
+		seq := insts[i : i+len(p.insts)]
+		list := make([]armasm.Inst, len(seq))
+		for j, r := range seq {
+			if j > 0 && seq[j-1].End != r.Start {
+				continue Outer
+			}
+			list[j] = r.Inst
+		}
+		if caps, ok := p.Match(list...); ok {
+			out = append(out, Match{Addr: seq[0].Start, Insts: list, Captures: caps})
+		}
+	}
+	return out
+}
+
+// Bits is a pattern matching an instruction encoding:
+// an encoding x matches if x&Mask == Value.
+// For Thumb, 32-bit encodings are written with the first
+// halfword in the high 16 bits, as in the architecture manual.
+type Bits struct {
+	Value uint32
+	Mask  uint32
+	Size  int // encoding size in bytes: 2 or 4
+}
+
+// ParseBits parses a bit pattern written as a sequence of 16 or 32
+// characters 0, 1, or x (don't care), most significant bit first.
+// Spaces and underscores are ignored, as in "1110 0101 1001 1101 xxxx xxxx xxxx xxxx".
+func ParseBits(s string) (Bits, error) {
+	var b Bits
+	n := 0
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch c {
+		case ' ', '_':
+			continue
+		case '0', '1', 'x', 'X':
+		default:
+			return Bits{}, fmt.Errorf("invalid character %q in bit pattern %q", c, s)
+		}
+		n++
+		b.Value <<= 1
+		b.Mask <<= 1
+		switch c {
+		case '1':
+			b.Value |= 1
+			b.Mask |= 1
+		case '0':
+			b.Mask |= 1
+		}
+	}
+	if n != 16 && n != 32 {
+		return Bits{}, fmt.Errorf("bit pattern %q has %d bits, want 16 or 32", s, n)
+	}
+	b.Size = n / 8
+	return b, nil
+}
+
+// Find returns the addresses of the encodings in code, which is loaded
+// at address pc, that match b. Every instruction-aligned address is tried.
+func (b Bits) Find(code []byte, pc uint64, mode armasm.Mode) []uint64 {
+	step := 4
+	if mode == armasm.ModeThumb {
+		step = 2
+	}
+	var out []uint64
+	for off := 0; off+b.Size <= len(code); off += step {
+		var x uint32
+		switch {
+		case b.Size == 2:
+			x = uint32(binary.LittleEndian.Uint16(code[off:]))
+		case mode == armasm.ModeThumb:
+			x = uint32(binary.LittleEndian.Uint16(code[off:]))<<16 | uint32(binary.LittleEndian.Uint16(code[off+2:]))
+		default:
+			x = binary.LittleEndian.Uint32(code[off:])
+		}
+		if x&b.Mask == b.Value {
+			out = append(out, pc+uint64(off))
+		}
+	}
+	return out
+}
@@ -0,0 +1,66 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armpat
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armdis"
+	"rsc.io/arm/internal/armtest"
+)
+
+var code = armtest.Words(
+	0xe92d4010, // 0x00: push {r4, lr}
+	0xe24dd010, // 0x04: sub sp, sp, #16
+	0xe59d1008, // 0x08: ldr r1, [sp, #8]
+	0x059d2004, // 0x0c: ldreq r2, [sp, #4]
+	0xe5901008, // 0x10: ldr r1, [r0, #8]
+	0xe8bd8010, // 0x14: pop {r4, pc}
+)
+
+var findTests = []struct {
+	pat  string
+	want []string
+}{
+	{"LDR *, [SP, #*]", []string{"0x8: R1 8"}},
+	{"ldr* *, [sp, #*]", []string{"0x8: LDR R1 8", "0xc: LDR.EQ R2 4"}},
+	{"LDR R1, [*, #8]", []string{"0x8: SP", "0x10: R0"}},
+	{"PUSH {*,LR}; SUB SP, SP, #16", []string{"0x0: R4"}},
+	{"POP {R4,PC}; *", nil},
+}
+
+func TestFind(t *testing.T) {
+	insts := armdis.Linear(code, 0, armasm.ModeARM).Insts()
+	for _, tt := range findTests {
+		var out []string
+		for _, m := range MustCompile(tt.pat).Find(insts) {
+			out = append(out, m.String())
+		}
+		if !reflect.DeepEqual(out, tt.want) {
+			t.Errorf("Find(%q) = %q, want %q", tt.pat, out, tt.want)
+		}
+	}
+}
+
+func TestBits(t *testing.T) {
+	// LDR Rt, [SP, #imm12] with any condition.
+	b, err := ParseBits("xxxx 0101 1001 1101 xxxx xxxx xxxx xxxx")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b != (Bits{Value: 0x059d0000, Mask: 0x0fff0000, Size: 4}) {
+		t.Errorf("ParseBits = %+v", b)
+	}
+	out := b.Find(code, 0x1000, armasm.ModeARM)
+	if want := []uint64{0x1008, 0x100c}; fmt.Sprint(out) != fmt.Sprint(want) {
+		t.Errorf("Find = %#x, want %#x", out, want)
+	}
+	if _, err := ParseBits("0101"); err == nil {
+		t.Errorf("ParseBits accepted 4-bit pattern")
+	}
+}
@@ -0,0 +1,215 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package armstat computes statistics over decoded instructions:
+// how often each opcode, instruction class, and register appears,
+// and how large the immediate operands are.
+//
+// A Stats accumulates any number of instructions from either instruction
+// set, in the form of arminst.Inst values, and prints a summary with Report.
+package armstat
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"sort"
+
+	"rsc.io/arm/arm64asm"
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/arminst"
+)
+
+// An ImmRange is a range of immediate values.
+type ImmRange uint8
+
+const (
+	ImmZero     ImmRange = iota // 0
+	Imm8                        // 1 to 255
+	Imm12                       // 256 to 4095
+	Imm16                       // 4096 to 65535
+	ImmLarge                    // 65536 and up
+	ImmNegative                 // less than zero
+	NumImmRanges
+)
+
+var immRangeName = [...]string{
+	ImmZero:     "0",
+	Imm8:        "1-255",
+	Imm12:       "256-4095",
+	Imm16:       "4096-65535",
+	ImmLarge:    ">65535",
+	ImmNegative: "<0",
+}
+
+func (r ImmRange) String() string {
+	if r < NumImmRanges {
+		return immRangeName[r]
+	}
+	return fmt.Sprintf("ImmRange(%d)", int(r))
+}
+
+// rangeOf returns the range holding v.
+func rangeOf(v int64) ImmRange {
+	switch {
+	case v < 0:
+		return ImmNegative
+	case v == 0:
+		return ImmZero
+	case v < 1<<8:
+		return Imm8
+	case v < 1<<12:
+		return Imm12
+	case v < 1<<16:
+		return Imm16
+	}
+	return ImmLarge
+}
+
+// Stats holds statistics about a collection of instructions.
+// The zero value is an empty collection ready to use.
+type Stats struct {
+	Insts   int                   // number of instructions
+	Ops     map[string]int        // instructions by mnemonic
+	Classes map[arminst.Class]int // instructions by class
+	Uses    map[string]int        // register reads by register name
+	Defs    map[string]int        // register writes by register name
+	Imms    [NumImmRanges]int     // immediate operands by value
+	Offsets [NumImmRanges]int     // immediate memory offsets by value
+}
+
+// Add adds inst to the statistics.
+func (s *Stats) Add(inst arminst.Inst) {
+	if s.Ops == nil {
+		s.Ops = make(map[string]int)
+		s.Classes = make(map[arminst.Class]int)
+		s.Uses = make(map[string]int)
+		s.Defs = make(map[string]int)
+	}
+	s.Insts++
+	s.Ops[inst.Mnemonic()]++
+	s.Classes[inst.Class()]++
+	for _, r := range inst.Uses() {
+		countRegs(s.Uses, r)
+	}
+	for _, r := range inst.Defs() {
+		countRegs(s.Defs, r)
+	}
+	for _, a := range inst.Operands() {
+		if v, ok := immValue(a); ok {
+			s.Imms[rangeOf(v)]++
+		}
+		if v, ok := offsetValue(a); ok {
+			s.Offsets[rangeOf(v)]++
+		}
+	}
+}
+
+// countRegs counts the register r in m,
+// counting each register in an armasm.RegList separately.
+func countRegs(m map[string]int, r arminst.Arg) {
+	if list, ok := r.(armasm.RegList); ok {
+		for i := 0; i < 16; i++ {
+			if list&(1<<uint(i)) != 0 {
+				m[(armasm.R0+armasm.Reg(i)).String()]++
+			}
+		}
+		return
+	}
+	m[r.String()]++
+}
+
+// immValue returns the value of an immediate operand.
+func immValue(a arminst.Arg) (int64, bool) {
+	switch a := a.(type) {
+	case armasm.Imm:
+		return int64(a), true
+	case armasm.ImmAlt:
+		return int64(a.Imm()), true
+	case arm64asm.Imm:
+		return int64(a.Imm), true
+	case arm64asm.Imm64:
+		return int64(a), true
+	case arm64asm.ImmShift:
+		return int64(a.Imm) << a.Shift, true
+	case arm64asm.SImmShift:
+		return int64(a.Imm) << a.Shift, true
+	}
+	return 0, false
+}
+
+// offsetValue returns the immediate offset of a memory operand.
+func offsetValue(a arminst.Arg) (int64, bool) {
+	switch a := a.(type) {
+	case armasm.Mem:
+		if a.Sign == 0 {
+			return int64(a.Offset), true
+		}
+	case arm64asm.MemImm:
+		return int64(a.Imm), true
+	}
+	return 0, false
+}
+
+// A Count is a single entry in a histogram.
+type Count struct {
+	Key string
+	N   int
+}
+
+// Sorted returns the entries of m in decreasing order of count,
+// breaking ties by key.
+func Sorted(m map[string]int) []Count {
+	list := make([]Count, 0, len(m))
+	for k, n := range m {
+		list = append(list, Count{k, n})
+	}
+	sort.Slice(list, func(i, j int) bool {
+		if list[i].N != list[j].N {
+			return list[i].N > list[j].N
+		}
+		return list[i].Key < list[j].Key
+	})
+	return list
+}
+
+// Report writes a text summary of s to w.
+// Each histogram lists its entries in decreasing order of count.
+func (s *Stats) Report(w io.Writer) error {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "instructions %d\n", s.Insts)
+
+	classes := map[string]int{}
+	for c, n := range s.Classes {
+		classes[c.String()] = n
+	}
+	for _, h := range []struct {
+		name string
+		m    map[string]int
+	}{
+		{"classes", classes},
+		{"ops", s.Ops},
+		{"uses", s.Uses},
+		{"defs", s.Defs},
+	} {
+		fmt.Fprintf(&buf, "\n%s:\n", h.name)
+		for _, c := range Sorted(h.m) {
+			fmt.Fprintf(&buf, "\t%s\t%d\n", c.Key, c.N)
+		}
+	}
+	for _, h := range []struct {
+		name string
+		r    *[NumImmRanges]int
+	}{
+		{"immediates", &s.Imms},
+		{"offsets", &s.Offsets},
+	} {
+		fmt.Fprintf(&buf, "\n%s:\n", h.name)
+		for r, n := range h.r {
+			fmt.Fprintf(&buf, "\t%s\t%d\n", ImmRange(r), n)
+		}
+	}
+	_, err := w.Write(buf.Bytes())
+	return err
+}
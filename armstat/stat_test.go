@@ -0,0 +1,118 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armstat
+
+import (
+	"bytes"
+	"encoding/binary"
+	"reflect"
+	"strings"
+	"testing"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/arminst"
+)
+
+func decode(t *testing.T, arch string, enc uint32) arminst.Inst {
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, enc)
+	var inst arminst.Inst
+	var err error
+	if arch == "arm" {
+		inst, err = arminst.DecodeARM(buf, armasm.ModeARM)
+	} else {
+		inst, err = arminst.DecodeARM64(buf)
+	}
+	if err != nil {
+		t.Fatalf("decode %s %#08x: %v", arch, enc, err)
+	}
+	return inst
+}
+
+func TestStats(t *testing.T) {
+	var s Stats
+	for _, x := range []struct {
+		arch string
+		enc  uint32
+	}{
+		{"arm", 0xe0810002},   // ADD R0, R1, R2
+		{"arm", 0xe2810001},   // ADD R0, R1, #1
+		{"arm", 0xe5110004},   // LDR R0, [R1, #-4]
+		{"arm", 0xe92d4010},   // PUSH {R4, LR}
+		{"arm", 0xe12fff1e},   // BX LR
+		{"arm64", 0xd2800000}, // MOVZ X0, #0
+		{"arm64", 0xf9400820}, // LDR X0, [X1, #16]
+		{"arm64", 0x91400421}, // ADD X1, X1, #1, LSL #12
+		{"arm64", 0xd65f03c0}, // RET
+	} {
+		s.Add(decode(t, x.arch, x.enc))
+	}
+
+	if s.Insts != 9 {
+		t.Errorf("Insts = %d, want 9", s.Insts)
+	}
+	if n := s.Ops["ADD"]; n != 3 {
+		t.Errorf("Ops[ADD] = %d, want 3", n)
+	}
+	if n := s.Classes[arminst.ClassReturn]; n != 2 {
+		t.Errorf("Classes[ClassReturn] = %d, want 2", n)
+	}
+	if n := s.Classes[arminst.ClassLoad]; n != 2 {
+		t.Errorf("Classes[ClassLoad] = %d, want 2", n)
+	}
+	if n := s.Uses["R4"]; n != 1 {
+		t.Errorf("Uses[R4] = %d, want 1", n)
+	}
+	if n := s.Defs["R0"]; n != 3 {
+		t.Errorf("Defs[R0] = %d, want 3", n)
+	}
+	wantImms := [NumImmRanges]int{ImmZero: 1, Imm8: 1, Imm16: 1}
+	if s.Imms != wantImms {
+		t.Errorf("Imms = %v, want %v", s.Imms, wantImms)
+	}
+	wantOffsets := [NumImmRanges]int{Imm8: 1, ImmNegative: 1}
+	if s.Offsets != wantOffsets {
+		t.Errorf("Offsets = %v, want %v", s.Offsets, wantOffsets)
+	}
+
+	var buf bytes.Buffer
+	if err := s.Report(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"instructions 9\n", "\tADD\t3\n", "\t1-255\t1\n"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Errorf("Report output missing %q:\n%s", want, buf.String())
+		}
+	}
+}
+
+func TestSorted(t *testing.T) {
+	got := Sorted(map[string]int{"B": 2, "A": 2, "C": 5})
+	want := []Count{{"C", 5}, {"A", 2}, {"B", 2}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Sorted = %v, want %v", got, want)
+	}
+}
+
+func TestRangeOf(t *testing.T) {
+	for _, tt := range []struct {
+		v    int64
+		want ImmRange
+	}{
+		{-1, ImmNegative},
+		{0, ImmZero},
+		{1, Imm8},
+		{255, Imm8},
+		{256, Imm12},
+		{4095, Imm12},
+		{4096, Imm16},
+		{65535, Imm16},
+		{65536, ImmLarge},
+	} {
+		if got := rangeOf(tt.v); got != tt.want {
+			t.Errorf("rangeOf(%d) = %v, want %v", tt.v, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,103 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package armsyscall identifies Linux system calls made by ARM code.
+//
+// Under the Linux EABI, a program makes a system call by executing SVC #0
+// with the call number in R7. Under the old ABI (OABI), the number is
+// encoded in the instruction itself, as SWI #0x900000+n.
+// Find recovers the number either way, tracking R7 through a basic block
+// with the constant propagation in package armconst.
+package armsyscall
+
+import (
+	"fmt"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armconst"
+	"rsc.io/arm/armdis"
+	"rsc.io/arm/armmem"
+)
+
+const (
+	oabiBase = 0x900000 // SWI immediate of OABI system call 0
+	armBase  = 0x0f0000 // number of the first ARM-private system call
+)
+
+// Name returns the name of the Linux ARM system call with the given number,
+// such as "write" for 4 or "cacheflush" for 0xf0002.
+// It returns "" for an unknown number.
+func Name(num uint32) string {
+	if num < uint32(len(names)) {
+		return names[num]
+	}
+	if num >= armBase && num-armBase < uint32(len(armNames)) {
+		return armNames[num-armBase]
+	}
+	return ""
+}
+
+// A Call is a system call instruction with a known call number.
+type Call struct {
+	PC   uint64 // address of the SVC instruction
+	Num  uint32 // system call number
+	OABI bool   // number is encoded in the instruction (old ABI)
+}
+
+// Name returns the name of the system call, or "" if the number is unknown.
+func (c Call) Name() string {
+	return Name(c.Num)
+}
+
+// String returns a description of the call suitable for annotating
+// a disassembly listing, such as "0x1000: write (4)".
+func (c Call) String() string {
+	name := c.Name()
+	if name == "" {
+		name = "unknown"
+	}
+	return fmt.Sprintf("%#x: %s (%d)", c.PC, name, c.Num)
+}
+
+// Find returns the system calls in insts whose numbers can be determined.
+// Insts is assumed to form a single basic block executing in ARM mode,
+// as for armconst.Propagate. Text is used to read literal pool loads into R7
+// and may be nil.
+func Find(insts []armdis.Range, text armmem.Reader) []Call {
+	var calls []Call
+	armconst.Walk(insts, text, func(r armdis.Range, s *armconst.State) {
+		if call, ok := Resolve(r, s); ok {
+			calls = append(calls, call)
+		}
+	})
+	return calls
+}
+
+// Resolve returns the system call made by the instruction r,
+// given the register state s holding just before it executes.
+// It returns false if r is not SVC or the call number is unknown.
+// The state s may be nil, in which case only OABI calls are resolved.
+func Resolve(r armdis.Range, s *armconst.State) (Call, bool) {
+	if r.Kind != armdis.Code || r.Inst.Op&^15 != armasm.SVC_EQ {
+		return Call{}, false
+	}
+	imm, ok := r.Inst.Args[0].(armasm.Imm)
+	if !ok {
+		return Call{}, false
+	}
+	if imm != 0 {
+		if imm < oabiBase {
+			return Call{}, false
+		}
+		return Call{PC: r.Start, Num: uint32(imm) - oabiBase, OABI: true}, true
+	}
+	if s == nil {
+		return Call{}, false
+	}
+	num, ok := s.Get(armasm.R7)
+	if !ok {
+		return Call{}, false
+	}
+	return Call{PC: r.Start, Num: num}, true
+}
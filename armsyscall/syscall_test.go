@@ -0,0 +1,65 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armsyscall
+
+import (
+	"reflect"
+	"testing"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/armdis"
+	"rsc.io/arm/internal/armtest"
+)
+
+func TestFind(t *testing.T) {
+	insts := armdis.Linear(armtest.Words(
+		0xe3a07004, // 0x00: mov r7, #4
+		0xef000000, // 0x04: svc #0
+		0xef900001, // 0x08: svc #0x900001
+		0xe3a0780f, // 0x0c: mov r7, #0xf0000
+		0xe2877002, // 0x10: add r7, r7, #2
+		0xef000000, // 0x14: svc #0
+		0xe5917000, // 0x18: ldr r7, [r1]
+		0xef000000, // 0x1c: svc #0
+	), 0, armasm.ModeARM).Insts()
+	want := []Call{
+		{PC: 0x04, Num: 4},
+		{PC: 0x08, Num: 1, OABI: true},
+		{PC: 0x14, Num: 0xf0002},
+	}
+	calls := Find(insts, nil)
+	if !reflect.DeepEqual(calls, want) {
+		t.Fatalf("Find = %v, want %v", calls, want)
+	}
+	for i, name := range []string{"write", "exit", "cacheflush"} {
+		if calls[i].Name() != name {
+			t.Errorf("%v: Name() = %q, want %q", calls[i], calls[i].Name(), name)
+		}
+	}
+	if s := calls[0].String(); s != "0x4: write (4)" {
+		t.Errorf("String() = %q, want %q", s, "0x4: write (4)")
+	}
+}
+
+func TestName(t *testing.T) {
+	tests := []struct {
+		num  uint32
+		name string
+	}{
+		{0, "restart_syscall"},
+		{11, "execve"},
+		{192, "mmap2"},
+		{281, "socket"},
+		{7, ""},
+		{1000, ""},
+		{0xf0005, "set_tls"},
+		{0xf0007, ""},
+	}
+	for _, tt := range tests {
+		if name := Name(tt.num); name != tt.name {
+			t.Errorf("Name(%d) = %q, want %q", tt.num, name, tt.name)
+		}
+	}
+}
@@ -0,0 +1,209 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armtiming
+
+import (
+	"fmt"
+	"strings"
+
+	"rsc.io/arm/armasm"
+	"rsc.io/arm/arminst"
+)
+
+// A Pipe is an execution pipeline.
+type Pipe uint8
+
+const (
+	PipeNone      Pipe = iota // not modeled
+	PipeALU                   // integer arithmetic, logic, and shifts
+	PipeMAC                   // integer multiply and multiply-accumulate
+	PipeLoadStore             // loads and stores, including VLDR and VSTR
+	PipeBranch                // branches
+	PipeFP                    // floating-point arithmetic
+	numPipe
+)
+
+var pipeName = [...]string{
+	PipeNone:      "none",
+	PipeALU:       "ALU",
+	PipeMAC:       "multiply",
+	PipeLoadStore: "load/store",
+	PipeBranch:    "branch",
+	PipeFP:        "floating-point",
+}
+
+func (p Pipe) String() string {
+	if int(p) < len(pipeName) {
+		return pipeName[p]
+	}
+	return fmt.Sprintf("Pipe(%d)", int(p))
+}
+
+// classPipe gives the pipe that executes each class.
+var classPipe = [numClass]Pipe{
+	classALU:           PipeALU,
+	classALUShiftImm:   PipeALU,
+	classALUShiftReg:   PipeALU,
+	classMul:           PipeMAC,
+	classMulLong:       PipeMAC,
+	classLoad:          PipeLoadStore,
+	classLoadShift:     PipeLoadStore,
+	classLoadDual:      PipeLoadStore,
+	classLoadMultiple:  PipeLoadStore,
+	classStore:         PipeLoadStore,
+	classStoreMultiple: PipeLoadStore,
+	classBranch:        PipeBranch,
+	classFPAdd:         PipeFP,
+	classFPAddD:        PipeFP,
+	classFPMul:         PipeFP,
+	classFPMulD:        PipeFP,
+	classFPMAC:         PipeFP,
+	classFPMACD:        PipeFP,
+	classFPDiv:         PipeFP,
+	classFPDivD:        PipeFP,
+	classFPSqrt:        PipeFP,
+	classFPSqrtD:       PipeFP,
+	classFPLoad:        PipeLoadStore,
+	classFPStore:       PipeLoadStore,
+}
+
+// pipeWidth gives the number of instructions each pipe
+// of each core can accept in a single cycle.
+var pipeWidth = [...][numPipe]int{
+	CortexA7:  {PipeALU: 2, PipeMAC: 1, PipeLoadStore: 1, PipeBranch: 1, PipeFP: 1},
+	CortexA9:  {PipeALU: 2, PipeMAC: 1, PipeLoadStore: 1, PipeBranch: 1, PipeFP: 1},
+	CortexA53: {PipeALU: 2, PipeMAC: 1, PipeLoadStore: 1, PipeBranch: 1, PipeFP: 1},
+	CortexM4:  {PipeALU: 1, PipeMAC: 1, PipeLoadStore: 1, PipeBranch: 1, PipeFP: 1},
+	CortexM7:  {PipeALU: 2, PipeMAC: 1, PipeLoadStore: 2, PipeBranch: 1, PipeFP: 1},
+}
+
+// PipeOf returns the pipe that executes inst,
+// or PipeNone if the instruction is not modeled.
+func PipeOf(inst armasm.Inst) Pipe {
+	c, _ := classify(inst)
+	return classPipe[c]
+}
+
+// DualIssue reports whether older and younger, adjacent instructions
+// in program order, can issue in the same cycle on core.
+// If not, it returns the reason, suitable for a diagnostic
+// flagging an avoidable stall, like
+//
+//	LDR R0, [R1] and LDR R2, [R3] both need the load/store pipe
+//
+// The instructions cannot pair if either's timing is unknown,
+// if either is restricted from its position in the pair (see Dual),
+// if they need the same pipe and the core has only one,
+// or if younger depends on older: if it reads a register or flag
+// that older writes, or writes a register that older writes.
+func DualIssue(core Core, older, younger armasm.Inst) (ok bool, reason string) {
+	to, ok1 := Lookup(core, older)
+	ty, ok2 := Lookup(core, younger)
+	switch {
+	case !ok1:
+		return false, fmt.Sprintf("timing of %v on %v is unknown", older, core)
+	case !ok2:
+		return false, fmt.Sprintf("timing of %v on %v is unknown", younger, core)
+	case to.Dual == DualNone:
+		return false, fmt.Sprintf("%v always issues alone on %v", older, core)
+	case ty.Dual == DualNone:
+		return false, fmt.Sprintf("%v always issues alone on %v", younger, core)
+	case to.Dual == DualYounger:
+		return false, fmt.Sprintf("%v cannot issue as the older instruction of a pair on %v", older, core)
+	case ty.Dual == DualOlder:
+		return false, fmt.Sprintf("%v cannot issue as the younger instruction of a pair on %v", younger, core)
+	}
+	if p := PipeOf(older); p == PipeOf(younger) && pipeWidth[core][p] < 2 {
+		return false, fmt.Sprintf("%v and %v both need the %v pipe", older, younger, p)
+	}
+
+	defs := regs(arminst.ARM{Inst: older, Mode: armasm.ModeARM}.Defs())
+	y := arminst.ARM{Inst: younger, Mode: armasm.ModeARM}
+	for _, r := range regs(y.Uses()) {
+		if contains(defs, r) {
+			return false, fmt.Sprintf("%v reads %v, written by %v", younger, r, older)
+		}
+	}
+	for _, r := range regs(y.Defs()) {
+		if contains(defs, r) {
+			return false, fmt.Sprintf("%v and %v both write %v", older, younger, r)
+		}
+	}
+	if setsFlags(older) && readsFlags(younger) {
+		return false, fmt.Sprintf("%v reads the flags set by %v", younger, older)
+	}
+	return true, ""
+}
+
+// regs returns the registers in args, expanding register lists.
+func regs(args []arminst.Arg) []armasm.Reg {
+	var list []armasm.Reg
+	for _, a := range args {
+		switch a := a.(type) {
+		case armasm.Reg:
+			list = append(list, a)
+		case armasm.RegList:
+			for r := armasm.R0; r <= armasm.R15; r++ {
+				if a&(1<<uint(r-armasm.R0)) != 0 {
+					list = append(list, r)
+				}
+			}
+		}
+	}
+	return list
+}
+
+func contains(list []armasm.Reg, r armasm.Reg) bool {
+	for _, x := range list {
+		if x == r {
+			return true
+		}
+	}
+	return false
+}
+
+// condNames is the set of condition suffixes in opcode names.
+var condNames = map[string]bool{
+	"EQ": true, "NE": true, "CS": true, "CC": true, "MI": true, "PL": true, "VS": true,
+	"VC": true, "HI": true, "LS": true, "GE": true, "LT": true, "GT": true, "LE": true,
+}
+
+// setsFlags reports whether inst sets the condition flags.
+func setsFlags(inst armasm.Inst) bool {
+	parts := strings.Split(inst.Op.String(), ".")
+	switch parts[0] {
+	case "CMP", "CMN", "TST", "TEQ":
+		return true
+	case "VMRS":
+		return inst.Args[0] == armasm.APSR_nzcv
+	}
+	for _, p := range parts[1:] {
+		if p == "S" {
+			return true
+		}
+	}
+	return false
+}
+
+// readsFlags reports whether inst reads the condition flags:
+// whether it is conditional or uses the carry flag as an operand.
+func readsFlags(inst armasm.Inst) bool {
+	parts := strings.Split(inst.Op.String(), ".")
+	switch parts[0] {
+	case "ADC", "SBC", "RSC", "RRX":
+		return true
+	}
+	for _, p := range parts[1:] {
+		if condNames[p] {
+			return true
+		}
+	}
+	for _, a := range inst.Args {
+		if s, ok := a.(armasm.RegShift); ok && s.Shift == armasm.RotateRightExt {
+			return true
+		}
+	}
+	return false
+}
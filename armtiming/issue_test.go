@@ -0,0 +1,74 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armtiming
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"rsc.io/arm/armasm"
+)
+
+func decode(t *testing.T, enc uint32) armasm.Inst {
+	buf := make([]byte, 4)
+	binary.LittleEndian.PutUint32(buf, enc)
+	inst, err := armasm.Decode(buf, armasm.ModeARM)
+	if err != nil {
+		t.Fatalf("Decode(%#08x): %v", enc, err)
+	}
+	return inst
+}
+
+var dualIssueTests = []struct {
+	core         Core
+	older, young uint32
+	ok           bool
+	reason       string
+}{
+	{CortexA7, 0xe0810002, 0xe0843005, true, ""}, // ADD R0, R1, R2; ADD R3, R4, R5
+	{CortexA7, 0xe0810002, 0xe0803005, false, "ADD R3, R0, R5 reads R0, written by ADD R0, R1, R2"},
+	{CortexA7, 0xe0000192, 0xe0843005, true, ""}, // MUL R0, R2, R1; ADD R3, R4, R5
+	{CortexA7, 0xe0843005, 0xe0000192, false, "MUL R0, R2, R1 cannot issue as the younger instruction of a pair on Cortex-A7"},
+	{CortexA7, 0xe5910000, 0xe0843005, false, "LDR R0, [R1] cannot issue as the older instruction of a pair on Cortex-A7"},
+	{CortexA53, 0xe5910000, 0xe5932000, false, "LDR R0, [R1] and LDR R2, [R3] both need the load/store pipe"},
+	{CortexM7, 0xe5910000, 0xe5932000, true, ""},
+	{CortexA53, 0xe3500000, 0x0a000000, false, "B.EQ PC+0x0 reads the flags set by CMP R0, #0x0"},
+	{CortexA53, 0xe3500000, 0xea000000, true, ""}, // CMP R0, #0; B
+	{CortexA53, 0xe0810002, 0xe3a00001, false, "ADD R0, R1, R2 and MOV R0, #0x1 both write R0"},
+	{CortexA53, 0xe8bd0003, 0xe0843005, false, "POP {R0-R1} always issues alone on Cortex-A53"},
+	{CortexM4, 0xe0810002, 0xe0843005, false, "ADD R0, R1, R2 always issues alone on Cortex-M4"},
+	{CortexA9, 0xf57ff05f, 0xe0843005, false, "timing of DMB #0xf on Cortex-A9 is unknown"},
+}
+
+func TestDualIssue(t *testing.T) {
+	for _, tt := range dualIssueTests {
+		older, younger := decode(t, tt.older), decode(t, tt.young)
+		ok, reason := DualIssue(tt.core, older, younger)
+		if ok != tt.ok || reason != tt.reason {
+			t.Errorf("DualIssue(%v, %v, %v) = %v, %q, want %v, %q", tt.core, older, younger, ok, reason, tt.ok, tt.reason)
+		}
+	}
+}
+
+func TestPipeOf(t *testing.T) {
+	tests := []struct {
+		enc  uint32
+		pipe Pipe
+	}{
+		{0xe0810002, PipeALU},       // ADD R0, R1, R2
+		{0xe0000192, PipeMAC},       // MUL R0, R2, R1
+		{0xe5910004, PipeLoadStore}, // LDR R0, [R1, #4]
+		{0xed940b00, PipeLoadStore}, // VLDR D0, [R4]
+		{0xea000000, PipeBranch},    // B
+		{0xee300a01, PipeFP},        // VADD.F32 S0, S0, S2
+		{0xf57ff05f, PipeNone},      // DMB SY
+	}
+	for _, tt := range tests {
+		inst := decode(t, tt.enc)
+		if p := PipeOf(inst); p != tt.pipe {
+			t.Errorf("PipeOf(%v) = %v, want %v", inst, p, tt.pipe)
+		}
+	}
+}
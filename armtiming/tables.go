@@ -0,0 +1,162 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armtiming
+
+// tables gives the estimated timing of each class on each core.
+// See the package documentation for the provenance of the values.
+// A zero Latency means the timing is unknown or the class is not implemented.
+var tables = [...]*[numClass]Timing{
+	CortexA7:  &cortexA7,
+	CortexA9:  &cortexA9,
+	CortexA53: &cortexA53,
+	CortexM4:  &cortexM4,
+	CortexM7:  &cortexM7,
+}
+
+// regsPerCycle gives the number of registers moved each cycle
+// by load and store multiple.
+var regsPerCycle = [...]int{
+	CortexA7:  2,
+	CortexA9:  2,
+	CortexA53: 2,
+	CortexM4:  1,
+	CortexM7:  2,
+}
+
+// Cortex-A7: in-order, partial dual issue, VFPv4.
+var cortexA7 = [numClass]Timing{
+	classALU:           {1, 1, DualAny},
+	classALUShiftImm:   {1, 2, DualOlder},
+	classALUShiftReg:   {2, 3, DualNone},
+	classMul:           {1, 3, DualOlder},
+	classMulLong:       {2, 4, DualNone},
+	classLoad:          {1, 3, DualYounger},
+	classLoadShift:     {1, 3, DualYounger},
+	classLoadDual:      {1, 3, DualNone},
+	classLoadMultiple:  {1, 3, DualNone},
+	classStore:         {1, 1, DualYounger},
+	classStoreMultiple: {1, 1, DualNone},
+	classBranch:        {1, 1, DualYounger},
+	classFPAdd:         {1, 4, DualOlder},
+	classFPAddD:        {1, 4, DualOlder},
+	classFPMul:         {1, 4, DualOlder},
+	classFPMulD:        {2, 7, DualNone},
+	classFPMAC:         {1, 8, DualOlder},
+	classFPMACD:        {2, 11, DualNone},
+	classFPDiv:         {18, 18, DualNone},
+	classFPDivD:        {32, 32, DualNone},
+	classFPSqrt:        {17, 17, DualNone},
+	classFPSqrtD:       {32, 32, DualNone},
+	classFPLoad:        {1, 3, DualYounger},
+	classFPStore:       {1, 1, DualYounger},
+}
+
+// Cortex-A9: out-of-order, dual issue, VFPv3.
+var cortexA9 = [numClass]Timing{
+	classALU:           {1, 1, DualAny},
+	classALUShiftImm:   {1, 2, DualAny},
+	classALUShiftReg:   {2, 2, DualNone},
+	classMul:           {1, 4, DualNone},
+	classMulLong:       {2, 5, DualNone},
+	classLoad:          {1, 4, DualAny},
+	classLoadShift:     {2, 5, DualAny},
+	classLoadDual:      {2, 4, DualNone},
+	classLoadMultiple:  {1, 4, DualNone},
+	classStore:         {1, 1, DualAny},
+	classStoreMultiple: {1, 1, DualNone},
+	classBranch:        {1, 1, DualAny},
+	classFPAdd:         {1, 4, DualNone},
+	classFPAddD:        {1, 4, DualNone},
+	classFPMul:         {1, 5, DualNone},
+	classFPMulD:        {2, 6, DualNone},
+	classFPMAC:         {1, 8, DualNone},
+	classFPMACD:        {2, 9, DualNone},
+	classFPDiv:         {15, 15, DualNone},
+	classFPDivD:        {25, 25, DualNone},
+	classFPSqrt:        {17, 17, DualNone},
+	classFPSqrtD:       {32, 32, DualNone},
+	classFPLoad:        {1, 4, DualAny},
+	classFPStore:       {1, 1, DualAny},
+}
+
+// Cortex-A53 in AArch32 state: in-order, dual issue, VFPv4.
+var cortexA53 = [numClass]Timing{
+	classALU:           {1, 1, DualAny},
+	classALUShiftImm:   {1, 2, DualOlder},
+	classALUShiftReg:   {2, 2, DualNone},
+	classMul:           {1, 3, DualOlder},
+	classMulLong:       {2, 4, DualNone},
+	classLoad:          {1, 3, DualAny},
+	classLoadShift:     {1, 3, DualAny},
+	classLoadDual:      {1, 3, DualNone},
+	classLoadMultiple:  {1, 3, DualNone},
+	classStore:         {1, 1, DualAny},
+	classStoreMultiple: {1, 1, DualNone},
+	classBranch:        {1, 1, DualYounger},
+	classFPAdd:         {1, 4, DualOlder},
+	classFPAddD:        {1, 4, DualOlder},
+	classFPMul:         {1, 4, DualOlder},
+	classFPMulD:        {1, 4, DualOlder},
+	classFPMAC:         {1, 8, DualOlder},
+	classFPMACD:        {1, 8, DualOlder},
+	classFPDiv:         {10, 10, DualNone},
+	classFPDivD:        {19, 19, DualNone},
+	classFPSqrt:        {9, 9, DualNone},
+	classFPSqrtD:       {19, 19, DualNone},
+	classFPLoad:        {1, 3, DualAny},
+	classFPStore:       {1, 1, DualAny},
+}
+
+// Cortex-M4: in-order, single issue, single-precision FPv4-SP.
+// There are no register-shifted register operands in Thumb
+// and no double-precision arithmetic.
+var cortexM4 = [numClass]Timing{
+	classALU:           {1, 1, DualNone},
+	classALUShiftImm:   {1, 1, DualNone},
+	classMul:           {1, 1, DualNone},
+	classMulLong:       {1, 1, DualNone},
+	classLoad:          {2, 2, DualNone},
+	classLoadShift:     {2, 2, DualNone},
+	classLoadDual:      {3, 3, DualNone},
+	classLoadMultiple:  {2, 2, DualNone},
+	classStore:         {1, 1, DualNone},
+	classStoreMultiple: {2, 2, DualNone},
+	classBranch:        {2, 2, DualNone},
+	classFPAdd:         {1, 1, DualNone},
+	classFPMul:         {1, 1, DualNone},
+	classFPMAC:         {3, 3, DualNone},
+	classFPDiv:         {14, 14, DualNone},
+	classFPSqrt:        {14, 14, DualNone},
+	classFPLoad:        {2, 2, DualNone},
+	classFPStore:       {2, 2, DualNone},
+}
+
+// Cortex-M7: in-order, dual issue, double-precision FPv5.
+// There are no register-shifted register operands in Thumb.
+var cortexM7 = [numClass]Timing{
+	classALU:           {1, 1, DualAny},
+	classALUShiftImm:   {1, 2, DualOlder},
+	classMul:           {1, 2, DualOlder},
+	classMulLong:       {1, 2, DualOlder},
+	classLoad:          {1, 2, DualAny},
+	classLoadShift:     {1, 2, DualAny},
+	classLoadDual:      {1, 2, DualNone},
+	classLoadMultiple:  {1, 2, DualNone},
+	classStore:         {1, 1, DualAny},
+	classStoreMultiple: {1, 1, DualNone},
+	classBranch:        {1, 1, DualYounger},
+	classFPAdd:         {1, 3, DualAny},
+	classFPAddD:        {1, 3, DualOlder},
+	classFPMul:         {1, 3, DualAny},
+	classFPMulD:        {2, 5, DualNone},
+	classFPMAC:         {1, 6, DualAny},
+	classFPMACD:        {2, 9, DualNone},
+	classFPDiv:         {14, 14, DualNone},
+	classFPDivD:        {30, 30, DualNone},
+	classFPSqrt:        {14, 14, DualNone},
+	classFPSqrtD:       {30, 30, DualNone},
+	classFPLoad:        {1, 2, DualAny},
+	classFPStore:       {1, 1, DualAny},
+}
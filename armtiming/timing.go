@@ -0,0 +1,265 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package armtiming gives approximate instruction timings for common ARM cores,
+// for use by static performance estimation tools.
+//
+// The timings are estimates. ARM does not publish complete instruction
+// timings for most of these cores, and the values here are not taken from
+// any one manual or measurement; they are round figures, consistent with
+// each core's pipeline, meant to rank code sequences rather than to predict
+// cycle counts. They describe the common case: operands ready, no cache misses,
+// no pipeline hazards beyond the instruction itself. They are not cycle-accurate
+// and should not be treated as such.
+//
+// The Cortex-M cores execute only Thumb code; for them the timing of an
+// instruction decoded by armasm describes the Thumb instruction with the same
+// operation and operand form.
+package armtiming
+
+import (
+	"fmt"
+	"strings"
+
+	"rsc.io/arm/armasm"
+)
+
+// A Core is a processor implementation.
+type Core int
+
+const (
+	_ Core = iota
+	CortexA7
+	CortexA9
+	CortexA53 // in AArch32 state
+	CortexM4
+	CortexM7
+)
+
+var coreName = [...]string{
+	CortexA7:  "Cortex-A7",
+	CortexA9:  "Cortex-A9",
+	CortexA53: "Cortex-A53",
+	CortexM4:  "Cortex-M4",
+	CortexM7:  "Cortex-M7",
+}
+
+func (c Core) String() string {
+	if 0 < c && int(c) < len(coreName) {
+		return coreName[c]
+	}
+	return fmt.Sprintf("Core(%d)", int(c))
+}
+
+// A Dual describes the dual-issue restrictions on an instruction.
+type Dual uint8
+
+const (
+	DualNone    Dual = iota // always issues alone
+	DualOlder               // may dual-issue as the older instruction of a pair
+	DualYounger             // may dual-issue as the younger instruction of a pair
+	DualAny                 // may dual-issue in either position
+)
+
+var dualName = [...]string{
+	DualNone:    "none",
+	DualOlder:   "older",
+	DualYounger: "younger",
+	DualAny:     "any",
+}
+
+func (d Dual) String() string {
+	if int(d) < len(dualName) {
+		return dualName[d]
+	}
+	return fmt.Sprintf("Dual(%d)", int(d))
+}
+
+// A Timing is the timing of a single instruction.
+type Timing struct {
+	Issue   int  // cycles before the next instruction can issue
+	Latency int  // cycles before the result is available to a dependent instruction
+	Dual    Dual // dual-issue restrictions
+}
+
+// Lookup returns the timing of inst on core.
+// It returns false if the timing is unknown, either because the instruction
+// is not modeled (for example, barriers and system instructions) or because
+// the core does not implement it (for example, double-precision arithmetic
+// on the Cortex-M4).
+func Lookup(core Core, inst armasm.Inst) (Timing, bool) {
+	if core <= 0 || int(core) >= len(tables) {
+		return Timing{}, false
+	}
+	c, n := classify(inst)
+	t := tables[core][c]
+	if t.Latency == 0 {
+		return Timing{}, false
+	}
+	if n > 1 {
+		// Load and store multiple transfer regsPerCycle registers each cycle.
+		extra := (n - 1) / regsPerCycle[core]
+		t.Issue += extra
+		t.Latency += extra
+	}
+	return t, true
+}
+
+// A class is a group of instructions with the same timing.
+type class int
+
+const (
+	classNone          class = iota
+	classALU                 // data processing
+	classALUShiftImm         // data processing with an immediate-shifted operand
+	classALUShiftReg         // data processing with a register-shifted operand
+	classMul                 // 32-bit multiply and multiply-accumulate
+	classMulLong             // 64-bit result multiply
+	classLoad                // single register load
+	classLoadShift           // single register load with a scaled register offset
+	classLoadDual            // LDRD
+	classLoadMultiple        // LDM, POP
+	classStore               // single or dual register store
+	classStoreMultiple       // STM, PUSH
+	classBranch              // B, BL, BX, BLX
+	classFPAdd               // single-precision add, compare, convert, move
+	classFPAddD              // double-precision add, compare, convert, move
+	classFPMul               // single-precision multiply
+	classFPMulD              // double-precision multiply
+	classFPMAC               // single-precision multiply-accumulate
+	classFPMACD              // double-precision multiply-accumulate
+	classFPDiv               // single-precision divide
+	classFPDivD              // double-precision divide
+	classFPSqrt              // single-precision square root
+	classFPSqrtD             // double-precision square root
+	classFPLoad              // VLDR
+	classFPStore             // VSTR
+	numClass
+)
+
+// classify returns the timing class of inst, along with the number
+// of registers transferred by a load or store multiple.
+func classify(inst armasm.Inst) (class, int) {
+	op := inst.Op.String()
+	name := op
+	if i := strings.Index(name, "."); i >= 0 {
+		name = name[:i]
+	}
+	double := strings.Contains(op, "F64")
+
+	switch name {
+	case "ADC", "ADD", "AND", "BIC", "EOR", "ORR", "RSB", "RSC", "SBC", "SUB",
+		"CMN", "CMP", "TEQ", "TST", "MOV", "MVN", "MOVW", "MOVT",
+		"ASR", "LSL", "LSR", "ROR", "RRX":
+		for _, a := range inst.Args {
+			switch a := a.(type) {
+			case armasm.RegShift:
+				if a.Count != 0 || a.Shift == armasm.RotateRightExt {
+					return classALUShiftImm, 0
+				}
+			case armasm.RegShiftReg:
+				return classALUShiftReg, 0
+			}
+		}
+		return classALU, 0
+
+	case "BFC", "BFI", "CLZ", "PKHBT", "PKHTB", "RBIT", "REV", "REV16", "REVSH",
+		"SBFX", "UBFX", "SEL", "SSAT", "SSAT16", "USAT", "USAT16",
+		"SXTAB", "SXTAB16", "SXTAH", "SXTB", "SXTB16", "SXTH",
+		"UXTAB", "UXTAB16", "UXTAH", "UXTB", "UXTB16", "UXTH",
+		"QADD", "QADD16", "QADD8", "QASX", "QDADD", "QDSUB", "QSAX", "QSUB", "QSUB16", "QSUB8",
+		"SADD16", "SADD8", "SASX", "SSAX", "SSUB16", "SSUB8",
+		"SHADD16", "SHADD8", "SHASX", "SHSAX", "SHSUB16", "SHSUB8",
+		"UADD16", "UADD8", "UASX", "USAX", "USUB16", "USUB8",
+		"UHADD16", "UHADD8", "UHASX", "UHSAX", "UHSUB16", "UHSUB8",
+		"UQADD16", "UQADD8", "UQASX", "UQSAX", "UQSUB16", "UQSUB8":
+		return classALU, 0
+
+	case "MUL", "MLA", "MLS",
+		"SMLABB", "SMLABT", "SMLATB", "SMLATT", "SMLAWB", "SMLAWT", "SMLAD", "SMLSD",
+		"SMULBB", "SMULBT", "SMULTB", "SMULTT", "SMULWB", "SMULWT", "SMUAD", "SMUSD",
+		"SMMLA", "SMMLS", "SMMUL", "USAD8", "USADA8":
+		return classMul, 0
+
+	case "SMULL", "UMULL", "SMLAL", "UMLAL", "UMAAL",
+		"SMLALBB", "SMLALBT", "SMLALTB", "SMLALTT", "SMLALD", "SMLSLD":
+		return classMulLong, 0
+
+	case "LDR", "LDRB", "LDRH", "LDRSB", "LDRSH", "LDRT", "LDRBT", "LDRHT", "LDRSBT", "LDRSHT",
+		"LDREX", "LDREXB", "LDREXH":
+		for _, a := range inst.Args {
+			if m, ok := a.(armasm.Mem); ok && m.Sign != 0 && m.Count != 0 {
+				return classLoadShift, 0
+			}
+		}
+		return classLoad, 0
+
+	case "LDRD", "LDREXD":
+		return classLoadDual, 0
+
+	case "LDM", "LDMDA", "LDMDB", "LDMIB", "POP":
+		return classLoadMultiple, regCount(inst)
+
+	case "STR", "STRB", "STRH", "STRT", "STRBT", "STRHT", "STRD",
+		"STREX", "STREXB", "STREXD", "STREXH":
+		return classStore, 0
+
+	case "STM", "STMDA", "STMDB", "STMIB", "PUSH":
+		return classStoreMultiple, regCount(inst)
+
+	case "B", "BL", "BX", "BLX":
+		return classBranch, 0
+
+	case "VABS", "VADD", "VSUB", "VNEG", "VCMP", "VCVT", "VCVTB", "VCVTT", "VCVTR", "VMOV":
+		if double {
+			return classFPAddD, 0
+		}
+		return classFPAdd, 0
+
+	case "VMUL", "VNMUL":
+		if double {
+			return classFPMulD, 0
+		}
+		return classFPMul, 0
+
+	case "VMLA", "VMLS", "VNMLA", "VNMLS":
+		if double {
+			return classFPMACD, 0
+		}
+		return classFPMAC, 0
+
+	case "VDIV":
+		if double {
+			return classFPDivD, 0
+		}
+		return classFPDiv, 0
+
+	case "VSQRT":
+		if double {
+			return classFPSqrtD, 0
+		}
+		return classFPSqrt, 0
+
+	case "VLDR":
+		return classFPLoad, 0
+
+	case "VSTR":
+		return classFPStore, 0
+	}
+	return classNone, 0
+}
+
+// regCount returns the number of registers in the register list of inst.
+func regCount(inst armasm.Inst) int {
+	for _, a := range inst.Args {
+		if l, ok := a.(armasm.RegList); ok {
+			n := 0
+			for ; l != 0; l &= l - 1 {
+				n++
+			}
+			return n
+		}
+	}
+	return 1
+}
@@ -0,0 +1,50 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package armtiming
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"rsc.io/arm/armasm"
+)
+
+var lookupTests = []struct {
+	core Core
+	enc  uint32
+	ok   bool
+	t    Timing
+}{
+	{CortexA9, 0xe0810002, true, Timing{1, 1, DualAny}},    // ADD R0, R1, R2
+	{CortexA9, 0xe0810102, true, Timing{1, 2, DualAny}},    // ADD R0, R1, R2, LSL #2
+	{CortexA9, 0xe0810312, true, Timing{2, 2, DualNone}},   // ADD R0, R1, R2, LSL R3
+	{CortexA7, 0xe0000192, true, Timing{1, 3, DualOlder}},  // MUL R0, R2, R1
+	{CortexA53, 0xe5910004, true, Timing{1, 3, DualAny}},   // LDR R0, [R1, #4]
+	{CortexA9, 0xe7910102, true, Timing{2, 5, DualAny}},    // LDR R0, [R1, R2, LSL #2]
+	{CortexA9, 0xe8bd80f0, true, Timing{3, 6, DualNone}},   // POP {R4-R7, PC}
+	{CortexM4, 0xe8bd80f0, true, Timing{6, 6, DualNone}},   // POP {R4-R7, PC}
+	{CortexM4, 0xe0810312, false, Timing{}},                // ADD R0, R1, R2, LSL R3
+	{CortexM4, 0xee300a01, true, Timing{1, 1, DualNone}},   // VADD.F32 S0, S0, S2
+	{CortexM4, 0xee300b01, false, Timing{}},                // VADD.F64 D0, D0, D1
+	{CortexM7, 0xee800b01, true, Timing{30, 30, DualNone}}, // VDIV.F64 D0, D0, D1
+	{CortexA9, 0xf57ff05f, false, Timing{}},                // DMB SY
+	{Core(0), 0xe0810002, false, Timing{}},                 // ADD R0, R1, R2
+}
+
+func TestLookup(t *testing.T) {
+	for _, tt := range lookupTests {
+		buf := make([]byte, 4)
+		binary.LittleEndian.PutUint32(buf, tt.enc)
+		inst, err := armasm.Decode(buf, armasm.ModeARM)
+		if err != nil {
+			t.Errorf("Decode(%#08x): %v", tt.enc, err)
+			continue
+		}
+		timing, ok := Lookup(tt.core, inst)
+		if ok != tt.ok || timing != tt.t {
+			t.Errorf("Lookup(%v, %v) = %+v, %v, want %+v, %v", tt.core, inst, timing, ok, tt.t, tt.ok)
+		}
+	}
+}
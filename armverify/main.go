@@ -0,0 +1,326 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Armverify decodes every 32-bit instruction word, checking the decoder
+// and printers for crashes.
+//
+// Usage:
+//
+//	armverify [-arch arm|arm64] [-shard i/n] [-state file] [-objdump path] [-p n]
+//
+// The 2^32 words are split into blocks of 65536 consecutive words.
+// Each block is decoded by one of the -p worker goroutines (default GOMAXPROCS),
+// and every word that decodes is also printed with String and GNUSyntax
+// (and GoSyntax for arm64). A panic in any of these is reported as
+//
+//	armverify: 0xe0810002: panic: message
+//
+// The -shard flag runs only the blocks whose number is i mod n, so that
+// the space can be divided among machines.
+//
+// If the -state flag names a file, armverify appends to it the number of each
+// block as it completes, and on startup skips the blocks already listed there.
+// An interrupted run restarted with the same -state file resumes where it left off.
+//
+// If the -objdump flag names a GNU objdump binary, armverify also disassembles
+// each block with it and reports the words where the two disagree about the
+// mnemonic, or where objdump decodes a word that armverify rejects.
+// Differences in operand syntax are not reported: they are too numerous
+// to be useful in an exhaustive run.
+package main
+
+import (
+	"bufio"
+	"encoding/binary"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"os/exec"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+
+	"rsc.io/arm/arm64asm"
+	"rsc.io/arm/armasm"
+)
+
+const (
+	blockBits = 16
+	numBlocks = 1 << (32 - blockBits)
+)
+
+var (
+	arch    = flag.String("arch", "arm", "instruction set: arm or arm64")
+	shard   = flag.String("shard", "0/1", "run only blocks `i/n`: those numbered i mod n")
+	state   = flag.String("state", "", "record completed blocks in `file`, and skip those already recorded")
+	objdump = flag.String("objdump", "", "compare mnemonics with GNU objdump at `path`")
+	procs   = flag.Int("p", runtime.GOMAXPROCS(0), "run `n` blocks in parallel")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "usage: armverify [-arch arm|arm64] [-shard i/n] [-state file] [-objdump path] [-p n]\n")
+	flag.PrintDefaults()
+	os.Exit(2)
+}
+
+func main() {
+	log.SetFlags(0)
+	log.SetPrefix("armverify: ")
+
+	flag.Usage = usage
+	flag.Parse()
+	if flag.NArg() != 0 {
+		usage()
+	}
+	if *arch != "arm" && *arch != "arm64" {
+		log.Fatalf("unknown -arch %q", *arch)
+	}
+	shardI, shardN, err := parseShard(*shard)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	done := map[int]bool{}
+	var stateFile *os.File
+	if *state != "" {
+		done, err = readState(*state)
+		if err != nil {
+			log.Fatal(err)
+		}
+		stateFile, err = os.OpenFile(*state, os.O_WRONLY|os.O_APPEND|os.O_CREATE, 0666)
+		if err != nil {
+			log.Fatal(err)
+		}
+	}
+
+	blocks := make(chan int)
+	go func() {
+		for b := shardI; b < numBlocks; b += shardN {
+			if !done[b] {
+				blocks <- b
+			}
+		}
+		close(blocks)
+	}()
+
+	var (
+		mu       sync.Mutex
+		wg       sync.WaitGroup
+		problems int
+	)
+	for i := 0; i < *procs; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for b := range blocks {
+				msgs, err := verifyBlock(*arch, b, *objdump)
+				mu.Lock()
+				for _, msg := range msgs {
+					log.Print(msg)
+				}
+				problems += len(msgs)
+				if err != nil {
+					log.Printf("block %d: %v", b, err)
+					problems++
+				} else if stateFile != nil {
+					fmt.Fprintf(stateFile, "%d\n", b)
+				}
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+
+	if stateFile != nil {
+		if err := stateFile.Close(); err != nil {
+			log.Fatal(err)
+		}
+	}
+	if problems > 0 {
+		log.Fatalf("%d problems", problems)
+	}
+}
+
+// parseShard parses a -shard value of the form i/n.
+func parseShard(s string) (i, n int, err error) {
+	j := strings.Index(s, "/")
+	if j < 0 {
+		return 0, 0, fmt.Errorf("invalid -shard %q: want i/n", s)
+	}
+	i, err1 := strconv.Atoi(s[:j])
+	n, err2 := strconv.Atoi(s[j+1:])
+	if err1 != nil || err2 != nil || n <= 0 || i < 0 || i >= n {
+		return 0, 0, fmt.Errorf("invalid -shard %q: want i/n with 0 <= i < n", s)
+	}
+	return i, n, nil
+}
+
+// readState returns the set of block numbers recorded in the state file.
+// A missing file is an empty state.
+func readState(file string) (map[int]bool, error) {
+	done := map[int]bool{}
+	f, err := os.Open(file)
+	if os.IsNotExist(err) {
+		return done, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	s := bufio.NewScanner(f)
+	for s.Scan() {
+		line := strings.TrimSpace(s.Text())
+		if line == "" {
+			continue
+		}
+		b, err := strconv.Atoi(line)
+		if err != nil || b < 0 || b >= numBlocks {
+			// A partial last line from an interrupted run is not worth failing for.
+			continue
+		}
+		done[b] = true
+	}
+	return done, s.Err()
+}
+
+// verifyBlock checks every word in block b, returning a message for each problem.
+// If objdumpPath is not empty, it also compares the mnemonics with objdump's.
+func verifyBlock(arch string, b int, objdumpPath string) ([]string, error) {
+	var ref map[uint32]string
+	if objdumpPath != "" {
+		var err error
+		ref, err = objdumpBlock(objdumpPath, arch, b)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var msgs []string
+	start := uint32(b) << blockBits
+	for i := uint32(0); i < 1<<blockBits; i++ {
+		x := start + i
+		mnemonic, err := check(arch, x)
+		if err != nil {
+			msgs = append(msgs, fmt.Sprintf("%#08x: %v", x, err))
+			continue
+		}
+		if ref == nil {
+			continue
+		}
+		want, ok := ref[x-start]
+		switch {
+		case !ok:
+			msgs = append(msgs, fmt.Sprintf("%#08x: missing from objdump output", x))
+		case mnemonic == "" && want != "":
+			msgs = append(msgs, fmt.Sprintf("%#08x: objdump decodes as %s", x, want))
+		case mnemonic != "" && want != "" && mnemonic != want:
+			msgs = append(msgs, fmt.Sprintf("%#08x: mnemonic %s, objdump %s", x, mnemonic, want))
+		}
+	}
+	return msgs, nil
+}
+
+// check decodes and prints x, returning the GNU mnemonic
+// or "" if x does not decode. A panic is returned as an error.
+func check(arch string, x uint32) (mnemonic string, err error) {
+	defer func() {
+		if e := recover(); e != nil {
+			err = fmt.Errorf("panic: %v", e)
+		}
+	}()
+	var src [4]byte
+	binary.LittleEndian.PutUint32(src[:], x)
+	var gnu string
+	switch arch {
+	case "arm":
+		inst, err := armasm.Decode(src[:], armasm.ModeARM)
+		if err != nil {
+			return "", nil
+		}
+		if inst.Len != 4 {
+			return "", fmt.Errorf("decoded length %d, want 4", inst.Len)
+		}
+		_ = inst.String()
+		gnu = armasm.GNUSyntax(inst)
+	case "arm64":
+		inst, err := arm64asm.Decode(src[:])
+		if err != nil {
+			return "", nil
+		}
+		_ = inst.String()
+		_ = arm64asm.GoSyntax(inst, 0, nil, nil)
+		gnu = arm64asm.GNUSyntax(inst)
+	}
+	if f := strings.Fields(gnu); len(f) > 0 {
+		mnemonic = strings.ToLower(f[0])
+	}
+	return mnemonic, nil
+}
+
+// objdumpBlock disassembles block b with objdump, returning a map
+// from word offset within the block to the lower-case mnemonic.
+// Words that objdump does not decode map to "".
+func objdumpBlock(path, arch string, b int) (map[uint32]string, error) {
+	f, err := ioutil.TempFile("", "armverify")
+	if err != nil {
+		return nil, err
+	}
+	defer os.Remove(f.Name())
+	w := bufio.NewWriter(f)
+	var buf [4]byte
+	for i := uint32(0); i < 1<<blockBits; i++ {
+		binary.LittleEndian.PutUint32(buf[:], uint32(b)<<blockBits+i)
+		w.Write(buf[:])
+	}
+	if err := w.Flush(); err != nil {
+		f.Close()
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+
+	machine := "arm"
+	if arch == "arm64" {
+		machine = "aarch64"
+	}
+	out, err := exec.Command(path, "-D", "-z", "-b", "binary", "-m", machine, "-EL", f.Name()).Output()
+	if err != nil {
+		return nil, fmt.Errorf("%s: %v", path, err)
+	}
+	return parseObjdump(string(out)), nil
+}
+
+// parseObjdump parses objdump -D output lines like
+//
+//	4:	e0810002 	add	r0, r1, r2
+//
+// returning a map from byte offset / 4 to mnemonic.
+func parseObjdump(out string) map[uint32]string {
+	ref := map[uint32]string{}
+	for _, line := range strings.Split(out, "\n") {
+		f := strings.Split(line, "\t")
+		if len(f) < 2 || !strings.HasSuffix(f[0], ":") {
+			continue
+		}
+		addr, err := strconv.ParseUint(strings.TrimSpace(strings.TrimSuffix(f[0], ":")), 16, 32)
+		if err != nil {
+			continue
+		}
+		mnemonic := ""
+		if len(f) >= 3 {
+			if fs := strings.Fields(f[2]); len(fs) > 0 {
+				mnemonic = strings.ToLower(fs[0])
+			}
+		}
+		switch mnemonic {
+		case "undefined", ".inst", ".word", "udf":
+			mnemonic = ""
+		}
+		ref[uint32(addr)/4] = mnemonic
+	}
+	return ref
+}
@@ -0,0 +1,79 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"reflect"
+	"testing"
+)
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		arch     string
+		x        uint32
+		mnemonic string
+	}{
+		{"arm", 0xe0810002, "add"},
+		{"arm", 0xe12fff1e, "bx"},
+		{"arm64", 0x8b020020, "add"},
+		{"arm64", 0x00000000, ""},
+	}
+	for _, tt := range tests {
+		mnemonic, err := check(tt.arch, tt.x)
+		if err != nil || mnemonic != tt.mnemonic {
+			t.Errorf("check(%s, %#08x) = %q, %v, want %q, nil", tt.arch, tt.x, mnemonic, err, tt.mnemonic)
+		}
+	}
+}
+
+func TestParseShard(t *testing.T) {
+	if i, n, err := parseShard("3/8"); i != 3 || n != 8 || err != nil {
+		t.Errorf("parseShard(3/8) = %d, %d, %v", i, n, err)
+	}
+	for _, s := range []string{"", "3", "8/8", "-1/2", "1/0"} {
+		if _, _, err := parseShard(s); err == nil {
+			t.Errorf("parseShard(%q) succeeded, want error", s)
+		}
+	}
+}
+
+func TestReadState(t *testing.T) {
+	f, err := ioutil.TempFile("", "armverify")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(f.Name())
+	f.WriteString("1\n7\n65535\n12")
+	f.Close()
+	done, err := readState(f.Name())
+	if want := map[int]bool{1: true, 7: true, 65535: true, 12: true}; err != nil || !reflect.DeepEqual(done, want) {
+		t.Errorf("readState = %v, %v, want %v", done, err, want)
+	}
+	done, err = readState(f.Name() + ".missing")
+	if err != nil || len(done) != 0 {
+		t.Errorf("readState(missing) = %v, %v, want empty", done, err)
+	}
+}
+
+func TestParseObjdump(t *testing.T) {
+	out := `
+/tmp/armverify:     file format binary
+
+
+Disassembly of section .data:
+
+00000000 <.data>:
+   0:	e0810002 	add	r0, r1, r2
+   4:	e7f000f0 	udf	#0
+   8:	f7f0a000 	undefined instruction 0xf7f0a000
+   c:	e12fff1e 	bx	lr
+`
+	want := map[uint32]string{0: "add", 1: "", 2: "", 3: "bx"}
+	if ref := parseObjdump(out); !reflect.DeepEqual(ref, want) {
+		t.Errorf("parseObjdump = %v, want %v", ref, want)
+	}
+}
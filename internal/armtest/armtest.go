@@ -0,0 +1,18 @@
+// Copyright 2014 The Go Authors.  All rights reserved.
+// Use of this source code is governed by a BSD-style
+// license that can be found in the LICENSE file.
+
+// Package armtest holds helpers shared by the tests of the other packages.
+package armtest
+
+import "encoding/binary"
+
+// Words returns the little-endian encoding of the words ws,
+// usually ARM instructions.
+func Words(ws ...uint32) []byte {
+	b := make([]byte, 0, 4*len(ws))
+	for _, w := range ws {
+		b = binary.LittleEndian.AppendUint32(b, w)
+	}
+	return b
+}